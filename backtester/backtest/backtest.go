@@ -8,6 +8,7 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -18,7 +19,10 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/api"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/csv"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/database"
-	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/live"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/trade"
+	tradecsv "github.com/thrasher-corp/gocryptotrader/backtester/data/trade/csv"
+	tradedatabase "github.com/thrasher-corp/gocryptotrader/backtester/data/trade/database"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/eventholder"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange/slippage"
@@ -27,6 +31,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/risk"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/size"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/sizing"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
@@ -65,9 +70,31 @@ func (bt *BackTest) Reset() {
 	bt.Statistic.Reset()
 	bt.Exchange.Reset()
 	bt.Funding.Reset()
+	for i := range bt.sleeves {
+		bt.sleeves[i].bt.EventQueue.Reset()
+		bt.sleeves[i].bt.Portfolio.Reset()
+		bt.sleeves[i].bt.Statistic.Reset()
+		bt.sleeves[i].bt.Exchange.Reset()
+		bt.sleeves[i].bt.Funding.Reset()
+	}
+	bt.sleeves = nil
+	bt.nextSleeveRebalance = time.Time{}
+	if bt.orderManager != nil && bt.orderManager.IsRunning() {
+		err := bt.orderManager.Stop()
+		if err != nil {
+			log.Error(log.BackTester, err)
+		}
+	}
+	if bt.databaseManager != nil && bt.databaseManager.IsRunning() {
+		err := bt.databaseManager.Stop()
+		if err != nil {
+			log.Error(log.BackTester, err)
+		}
+	}
 	bt.exchangeManager = nil
 	bt.orderManager = nil
 	bt.databaseManager = nil
+	bt.live = nil
 }
 
 // NewFromConfig takes a strategy config and configures a backtester variable to run
@@ -79,7 +106,7 @@ func NewFromConfig(cfg *config.Config, templatePath, output string) (*BackTest,
 	var err error
 	bt := New()
 	bt.exchangeManager = engine.SetupExchangeManager()
-	bt.orderManager, err = engine.SetupOrderManager(bt.exchangeManager, &engine.CommunicationManager{}, &sync.WaitGroup{}, false)
+	bt.orderManager, err = engine.SetupOrderManager(bt.exchangeManager, &engine.CommunicationManager{}, nil, &sync.WaitGroup{}, false)
 	if err != nil {
 		return nil, err
 	}
@@ -179,6 +206,8 @@ func NewFromConfig(cfg *config.Config, templatePath, output string) (*BackTest,
 
 	portfolioRisk := &risk.Risk{
 		CurrencySettings: make(map[string]map[asset.Item]map[currency.Pair]*risk.CurrencySettings),
+		CanUseLeverage:   cfg.PortfolioSettings.Leverage.CanUseLeverage,
+		MaximumLeverage:  cfg.PortfolioSettings.Leverage.MaximumLeverageRate,
 	}
 
 	for i := range cfg.CurrencySettings {
@@ -299,6 +328,24 @@ func NewFromConfig(cfg *config.Config, templatePath, output string) (*BackTest,
 				return nil, err
 			}
 		}
+		if cfg.CurrencySettings[i].FuturesDetails != nil {
+			var pos *funding.Position
+			pos, err = funding.CreatePosition(
+				cfg.CurrencySettings[i].ExchangeName,
+				a,
+				curr,
+				cfg.CurrencySettings[i].FuturesDetails.Leverage,
+				cfg.CurrencySettings[i].FuturesDetails.MaintenanceMarginRate,
+				cfg.CurrencySettings[i].FuturesDetails.FundingRate,
+				cfg.CurrencySettings[i].FuturesDetails.FundingInterval)
+			if err != nil {
+				return nil, err
+			}
+			err = funds.AddPosition(pos)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	bt.Funding = funds
@@ -307,27 +354,50 @@ func NewFromConfig(cfg *config.Config, templatePath, output string) (*BackTest,
 	if err != nil {
 		return nil, err
 	}
-
-	bt.Strategy, err = strategies.LoadStrategyByName(cfg.StrategySettings.Name, cfg.StrategySettings.SimultaneousSignalProcessing)
+	err = setupPositionSizer(p, cfg.PortfolioSettings.PositionSizing)
 	if err != nil {
 		return nil, err
 	}
-	bt.Strategy.SetDefaults()
-	if cfg.StrategySettings.CustomSettings != nil {
-		err = bt.Strategy.SetCustomSettings(cfg.StrategySettings.CustomSettings)
-		if err != nil && !errors.Is(err, base.ErrCustomSettingsUnsupported) {
+
+	var strategyName, strategyDescription string
+	sleeveStrategies := make([]strategies.Handler, len(cfg.StrategySettings.Sleeves))
+	if len(cfg.StrategySettings.Sleeves) == 0 {
+		bt.Strategy, err = loadStrategy(cfg.StrategySettings.Name, cfg.StrategySettings.SimultaneousSignalProcessing, cfg.StrategySettings.CustomSettings)
+		if err != nil {
 			return nil, err
 		}
+		strategyName = bt.Strategy.Name()
+		strategyDescription = bt.Strategy.Description()
+	} else {
+		names := make([]string, len(cfg.StrategySettings.Sleeves))
+		for i := range cfg.StrategySettings.Sleeves {
+			sleeveStrategies[i], err = loadStrategy(cfg.StrategySettings.Sleeves[i].StrategyName,
+				cfg.StrategySettings.Sleeves[i].SimultaneousSignalProcessing,
+				cfg.StrategySettings.Sleeves[i].CustomSettings)
+			if err != nil {
+				return nil, err
+			}
+			names[i] = fmt.Sprintf("%v (%v)", cfg.StrategySettings.Sleeves[i].Name, sleeveStrategies[i].Name())
+		}
+		strategyName = strings.Join(names, ", ")
+		strategyDescription = "Multiple strategies run over the same data, each with its own share of funding"
 	}
 	stats := &statistics.Statistic{
-		StrategyName:                bt.Strategy.Name(),
+		StrategyName:                strategyName,
 		StrategyNickname:            cfg.Nickname,
-		StrategyDescription:         bt.Strategy.Description(),
+		StrategyDescription:         strategyDescription,
 		StrategyGoal:                cfg.Goal,
 		ExchangeAssetPairStatistics: make(map[string]map[asset.Item]map[currency.Pair]*statistics.CurrencyPairStatistic),
 		RiskFreeRate:                cfg.StatisticSettings.RiskFreeRate,
 		CandleInterval:              gctkline.Interval(cfg.DataSettings.Interval),
 		FundManager:                 bt.Funding,
+		RollingWindow:               cfg.StatisticSettings.RollingWindow,
+	}
+	if cfg.StatisticSettings.Benchmark != nil {
+		stats.Benchmark, err = setupBenchmark(cfg.StatisticSettings.Benchmark)
+		if err != nil {
+			return nil, err
+		}
 	}
 	bt.Statistic = stats
 	reports.Statistics = stats
@@ -371,14 +441,53 @@ func NewFromConfig(cfg *config.Config, templatePath, output string) (*BackTest,
 		return nil, err
 	}
 
+	if cfg.StatisticSettings.MonteCarlo != nil {
+		stats.MonteCarlo = setupMonteCarlo(cfg.StatisticSettings.MonteCarlo, &e)
+	}
+
 	bt.Exchange = &e
-	for i := range e.CurrencySettings {
-		var lookup *portfolio.Settings
+	err = setupPortfolioCurrencySettings(p, &e)
+	if err != nil {
+		return nil, err
+	}
+	bt.Portfolio = p
 
-		lookup, err = p.SetupCurrencySettingsMap(&e.CurrencySettings[i])
+	if len(cfg.StrategySettings.Sleeves) > 0 {
+		err = bt.setupSleeves(cfg, sleeveStrategies, funds, stats, &e, sizeManager, portfolioRisk)
 		if err != nil {
 			return nil, err
 		}
+	}
+
+	cfg.PrintSetting()
+
+	return bt, nil
+}
+
+// loadStrategy loads the strategy by name and applies its custom settings
+func loadStrategy(name string, useSimultaneousProcessing bool, customSettings map[string]interface{}) (strategies.Handler, error) {
+	strat, err := strategies.LoadStrategyByName(name, useSimultaneousProcessing)
+	if err != nil {
+		return nil, err
+	}
+	strat.SetDefaults()
+	if customSettings != nil {
+		err = strat.SetCustomSettings(customSettings)
+		if err != nil && !errors.Is(err, base.ErrCustomSettingsUnsupported) {
+			return nil, err
+		}
+	}
+	return strat, nil
+}
+
+// setupPortfolioCurrencySettings sets the portfolio's sizing, fees and
+// compliance for each of the exchange's currencies
+func setupPortfolioCurrencySettings(p *portfolio.Portfolio, e *exchange.Exchange) error {
+	for i := range e.CurrencySettings {
+		lookup, err := p.SetupCurrencySettingsMap(&e.CurrencySettings[i])
+		if err != nil {
+			return err
+		}
 		lookup.Fee = e.CurrencySettings[i].TakerFee
 		lookup.Leverage = e.CurrencySettings[i].Leverage
 		lookup.BuySideSizing = e.CurrencySettings[i].BuySide
@@ -387,11 +496,86 @@ func NewFromConfig(cfg *config.Config, templatePath, output string) (*BackTest,
 			Snapshots: []compliance.Snapshot{},
 		}
 	}
-	bt.Portfolio = p
+	return nil
+}
 
-	cfg.PrintSetting()
+// setupPositionSizer sets the portfolio's position sizing model when one
+// is configured. Each portfolio needs its own sizer to track its trades
+func setupPositionSizer(p *portfolio.Portfolio, ps *config.PositionSizingSettings) error {
+	if ps == nil {
+		return nil
+	}
+	sizer, err := sizing.Setup(sizing.Settings{
+		Model:              ps.Model,
+		Fraction:           ps.Fraction,
+		TargetVolatility:   ps.TargetVolatility,
+		ATRPeriod:          ps.ATRPeriod,
+		KellyMultiplier:    ps.KellyMultiplier,
+		KellyMinimumTrades: ps.KellyMinimumTrades,
+	})
+	if err != nil {
+		return err
+	}
+	p.SetPositionSizer(sizer)
+	return nil
+}
 
-	return bt, nil
+// setupBenchmark converts the benchmark settings into the benchmark used by
+// statistics, loading the index when a csv-index benchmark is set
+func setupBenchmark(b *config.BenchmarkSettings) (*statistics.Benchmark, error) {
+	if b.Type == config.CSVIndexBenchmark {
+		index, err := statistics.LoadBenchmarkIndex(b.CSVFilePath)
+		if err != nil {
+			return nil, err
+		}
+		return &statistics.Benchmark{
+			Name:  fmt.Sprintf("%v %v", b.Type, filepath.Base(b.CSVFilePath)),
+			Index: index,
+		}, nil
+	}
+	resp := &statistics.Benchmark{
+		Components: make([]statistics.BenchmarkComponent, len(b.Components)),
+	}
+	names := make([]string, len(b.Components))
+	for i := range b.Components {
+		a, err := asset.New(b.Components[i].Asset)
+		if err != nil {
+			return nil, err
+		}
+		resp.Components[i] = statistics.BenchmarkComponent{
+			Exchange: strings.ToLower(b.Components[i].ExchangeName),
+			Asset:    a,
+			Pair:     currency.NewPair(currency.NewCode(b.Components[i].Base), currency.NewCode(b.Components[i].Quote)),
+			Weight:   b.Components[i].Weight,
+		}
+		names[i] = fmt.Sprintf("%v %v %v", resp.Components[i].Exchange, a, resp.Components[i].Pair)
+		if b.Type == config.BasketBenchmark {
+			names[i] += " " + b.Components[i].Weight.String()
+		}
+	}
+	resp.Name = b.Type + " " + strings.Join(names, ", ")
+	return resp, nil
+}
+
+// setupMonteCarlo converts the Monte Carlo settings into the settings used by
+// statistics, randomising slippage within each pair's exchange settings
+func setupMonteCarlo(mc *config.MonteCarloSettings, e *exchange.Exchange) *statistics.MonteCarloSettings {
+	resp := &statistics.MonteCarloSettings{
+		Iterations:      mc.Iterations,
+		ConfidenceLevel: mc.ConfidenceLevel,
+		Seed:            mc.Seed,
+		Slippage:        make([]statistics.SlippageRange, len(e.CurrencySettings)),
+	}
+	for i := range e.CurrencySettings {
+		resp.Slippage[i] = statistics.SlippageRange{
+			Exchange:            strings.ToLower(e.CurrencySettings[i].Exchange),
+			Asset:               e.CurrencySettings[i].Asset,
+			Pair:                e.CurrencySettings[i].Pair,
+			MinimumSlippageRate: e.CurrencySettings[i].MinimumSlippageRate,
+			MaximumSlippageRate: e.CurrencySettings[i].MaximumSlippageRate,
+		}
+	}
+	return resp
 }
 
 func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (exchange.Exchange, error) {
@@ -410,20 +594,41 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (exchange.Exchange
 
 		exchangeName := strings.ToLower(exch.GetName())
 		bt.Datas.Setup()
-		klineData, err := bt.loadData(cfg, exch, pair, a, cfg.CurrencySettings[i].USDTrackingPair)
-		if err != nil {
-			return resp, err
+		var klineData *kline.DataFromKline
+		var dataHandler data.Handler
+		switch {
+		case cfg.DataSettings.DataType == common.TickStr:
+			dataHandler, err = bt.loadTradeData(cfg, exch, pair, a)
+			if err != nil {
+				return resp, err
+			}
+		case cfg.DataSettings.OrderbookData != nil:
+			var orderbookData *orderbook.DataFromOrderbook
+			orderbookData, err = bt.loadOrderbookData(cfg, exch, pair, a, cfg.CurrencySettings[i].USDTrackingPair)
+			if err != nil {
+				return resp, err
+			}
+			klineData = &orderbookData.DataFromKline
+			dataHandler = orderbookData
+		default:
+			klineData, err = bt.loadData(cfg, exch, pair, a, cfg.CurrencySettings[i].USDTrackingPair)
+			if err != nil {
+				return resp, err
+			}
+			dataHandler = klineData
 		}
 
-		err = bt.Funding.AddUSDTrackingData(klineData)
-		if err != nil &&
-			!errors.Is(err, trackingcurrencies.ErrCurrencyDoesNotContainsUSD) &&
-			!errors.Is(err, funding.ErrUSDTrackingDisabled) {
-			return resp, err
+		if klineData != nil {
+			err = bt.Funding.AddUSDTrackingData(klineData)
+			if err != nil &&
+				!errors.Is(err, trackingcurrencies.ErrCurrencyDoesNotContainsUSD) &&
+				!errors.Is(err, funding.ErrUSDTrackingDisabled) {
+				return resp, err
+			}
 		}
 
 		if !cfg.CurrencySettings[i].USDTrackingPair {
-			bt.Datas.SetDataForCurrency(exchangeName, a, pair, klineData)
+			bt.Datas.SetDataForCurrency(exchangeName, a, pair, dataHandler)
 			var makerFee, takerFee decimal.Decimal
 			if cfg.CurrencySettings[i].MakerFee.GreaterThan(decimal.Zero) {
 				makerFee = cfg.CurrencySettings[i].MakerFee
@@ -502,8 +707,8 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (exchange.Exchange
 				Pair:                pair,
 				Asset:               a,
 				ExchangeFee:         takerFee,
-				MakerFee:            takerFee,
-				TakerFee:            makerFee,
+				MakerFee:            makerFee,
+				TakerFee:            takerFee,
 				UseRealOrders:       realOrders,
 				BuySide:             buyRule,
 				SellSide:            sellRule,
@@ -702,20 +907,18 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 		if isUSDTrackingPair {
 			return nil, errLiveUSDTrackingNotSupported
 		}
-		if len(cfg.CurrencySettings) > 1 {
-			return nil, errors.New("live data simulation only supports one currency")
-		}
 		err = loadLiveData(cfg, b)
 		if err != nil {
 			return nil, err
 		}
-		go bt.loadLiveDataLoop(
-			resp,
-			cfg,
-			exch,
-			fPair,
-			a,
-			dataType)
+		resp.Item = gctkline.Item{
+			Exchange: strings.ToLower(exch.GetName()),
+			Pair:     fPair,
+			Asset:    a,
+			Interval: gctkline.Interval(cfg.DataSettings.Interval),
+		}
+		// candles are streamed once the live run begins
+		bt.addLiveTarget(cfg, exch, resp, dataType)
 		return resp, nil
 	}
 	if resp == nil {
@@ -737,6 +940,111 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 	return resp, nil
 }
 
+// loadOrderbookData replays recorded orderbook data for an exchange, asset and pair
+// and derives candles from its mid price
+func (bt *BackTest) loadOrderbookData(cfg *config.Config, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item, isUSDTrackingPair bool) (*orderbook.DataFromOrderbook, error) {
+	if exch == nil {
+		return nil, engine.ErrExchangeNotFound
+	}
+	if cfg == nil || cfg.DataSettings.OrderbookData == nil {
+		return nil, common.ErrNilArguments
+	}
+	if cfg.DataSettings.Interval <= 0 {
+		return nil, errIntervalUnset
+	}
+	log.Infof(log.BackTester, "loading orderbook data for %v %v %v...\n", exch.GetName(), a, fPair)
+	resp, err := orderbook.LoadData(
+		cfg.DataSettings.OrderbookData.FullPath,
+		exch.GetName(),
+		cfg.DataSettings.Interval,
+		fPair,
+		a)
+	if err != nil {
+		if isUSDTrackingPair {
+			return nil, fmt.Errorf("%v. Please add USD pair data to your orderbook data or set `disable-usd-tracking` to `true` in your config", err)
+		}
+		return nil, err
+	}
+	summary := resp.RangeHolder.DataSummary(false)
+	if len(summary) > 0 {
+		log.Warnf(log.BackTester, "%v", summary)
+	}
+	err = resp.Load()
+	if err != nil {
+		return nil, err
+	}
+	bt.Reports.AddKlineItem(&resp.Item)
+	return resp, nil
+}
+
+// loadTradeData streams individual trades for an exchange, asset and pair from
+// CSV or the database in chunks. The database is left running so that further
+// chunks can be read while the backtest runs
+func (bt *BackTest) loadTradeData(cfg *config.Config, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item) (*trade.DataFromTrades, error) {
+	if exch == nil {
+		return nil, engine.ErrExchangeNotFound
+	}
+	if cfg == nil {
+		return nil, common.ErrNilArguments
+	}
+	if cfg.DataSettings.Interval <= 0 {
+		return nil, errIntervalUnset
+	}
+	if cfg.DataSettings.CSVData != nil && cfg.DataSettings.DatabaseData != nil {
+		return nil, errAmbiguousDataSource
+	}
+	log.Infof(log.BackTester, "loading trade data for %v %v %v...\n", exch.GetName(), a, fPair)
+	var resp *trade.DataFromTrades
+	var err error
+	switch {
+	case cfg.DataSettings.CSVData != nil:
+		resp, err = tradecsv.LoadData(
+			cfg.DataSettings.CSVData.FullPath,
+			exch.GetName(),
+			cfg.DataSettings.Interval,
+			fPair,
+			a,
+			cfg.DataSettings.TickChunkSize)
+		if err != nil {
+			return nil, err
+		}
+	case cfg.DataSettings.DatabaseData != nil:
+		if cfg.DataSettings.DatabaseData.Path == "" {
+			cfg.DataSettings.DatabaseData.Path = filepath.Join(gctcommon.GetDefaultDataDir(runtime.GOOS), "database")
+		}
+		gctdatabase.DB.DataPath = filepath.Join(cfg.DataSettings.DatabaseData.Path)
+		err = gctdatabase.DB.SetConfig(&cfg.DataSettings.DatabaseData.Config)
+		if err != nil {
+			return nil, err
+		}
+		if !bt.databaseManager.IsRunning() {
+			err = bt.databaseManager.Start(&sync.WaitGroup{})
+			if err != nil {
+				return nil, err
+			}
+		}
+		endDate := cfg.DataSettings.DatabaseData.EndDate
+		if cfg.DataSettings.DatabaseData.InclusiveEndDate {
+			endDate = endDate.Add(cfg.DataSettings.Interval)
+		}
+		resp = tradedatabase.LoadData(
+			cfg.DataSettings.DatabaseData.StartDate,
+			endDate,
+			cfg.DataSettings.Interval,
+			exch.GetName(),
+			fPair,
+			a,
+			cfg.DataSettings.TickChunkSize)
+	default:
+		return nil, errNoDataSource
+	}
+	err = resp.Load()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func loadDatabaseData(cfg *config.Config, name string, fPair currency.Pair, a asset.Item, dataType int64, isUSDTrackingPair bool) (*kline.DataFromKline, error) {
 	if cfg == nil || cfg.DataSettings.DatabaseData == nil {
 		return nil, errors.New("nil config data received")
@@ -824,13 +1132,82 @@ func loadLiveData(cfg *config.Config, base *gctexchange.Base) error {
 	return nil
 }
 
+// DataRange returns the time of the earliest data event and the close of the
+// latest data event across all loaded data
+func (bt *BackTest) DataRange() (start, end time.Time, err error) {
+	dataHandlerMap := bt.Datas.GetAllData()
+	for _, exchMap := range dataHandlerMap {
+		for _, assetMap := range exchMap {
+			for _, dataHandler := range assetMap {
+				stream := dataHandler.GetStream()
+				if len(stream) == 0 {
+					continue
+				}
+				first := stream[0].GetTime()
+				latest := stream[len(stream)-1]
+				last := latest.GetTime().Add(latest.GetInterval().Duration())
+				if start.IsZero() || first.Before(start) {
+					start = first
+				}
+				if last.After(end) {
+					end = last
+				}
+			}
+		}
+	}
+	if start.IsZero() {
+		return time.Time{}, time.Time{}, errNilData
+	}
+	return start, end, nil
+}
+
+// SetDataWindow limits all loaded data to events from start up until end,
+// allowing a single loaded data set to be run over a smaller period
+func (bt *BackTest) SetDataWindow(start, end time.Time) error {
+	if !start.Before(end) {
+		return fmt.Errorf("%w start %v end %v", errInvalidDataWindow, start, end)
+	}
+	dataHandlerMap := bt.Datas.GetAllData()
+	for exchName, exchMap := range dataHandlerMap {
+		for a, assetMap := range exchMap {
+			for p, dataHandler := range assetMap {
+				ws, ok := dataHandler.(data.WindowSetter)
+				if !ok {
+					return fmt.Errorf("%w for %v %v %v", errDataWindowUnsupported, exchName, a, p)
+				}
+				err := ws.SetWindow(start, end)
+				if err != nil {
+					return fmt.Errorf("%v %v %v %w", exchName, a, p, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
 // Run will iterate over loaded data events
 // save them and then handle the event based on its type
 func (bt *BackTest) Run() error {
 	log.Info(log.BackTester, "running backtester against pre-defined data")
+	bt.setupProgress()
 dataLoadingIssue:
 	for ev := bt.EventQueue.NextEvent(); ; ev = bt.EventQueue.NextEvent() {
 		if ev == nil {
+			select {
+			case <-bt.shutdown:
+				log.Info(log.BackTester, "run stopped before all data was processed")
+				return nil
+			default:
+			}
+			if d, isStreaming := bt.nextTradeEvent(); isStreaming {
+				if d == nil {
+					break dataLoadingIssue
+				}
+				atomic.AddInt64(&bt.processedEvents, 1)
+				bt.updateStreamProgress()
+				bt.EventQueue.AppendEvent(d)
+				continue
+			}
 			dataHandlerMap := bt.Datas.GetAllData()
 			for exchangeName, exchangeMap := range dataHandlerMap {
 				for assetItem, assetMap := range exchangeMap {
@@ -843,7 +1220,8 @@ dataLoadingIssue:
 							}
 							break dataLoadingIssue
 						}
-						if bt.Strategy.UsingSimultaneousProcessing() && hasProcessedData {
+						atomic.AddInt64(&bt.processedEvents, 1)
+						if len(bt.sleeves) == 0 && bt.Strategy.UsingSimultaneousProcessing() && hasProcessedData {
 							continue
 						}
 						bt.EventQueue.AppendEvent(d)
@@ -866,11 +1244,92 @@ dataLoadingIssue:
 	return nil
 }
 
+// nextTradeEvent returns the earliest upcoming trade across all data handlers
+// when they stream individual trades, so that trades from different exchanges,
+// assets and pairs are processed in time order rather than in lockstep. Trades
+// at the same time are processed in exchange, asset and pair order
+func (bt *BackTest) nextTradeEvent() (common.DataEventHandler, bool) {
+	var next data.Handler
+	var nextTime time.Time
+	var nextKey string
+	var isStreaming bool
+	for exchangeName, exchangeMap := range bt.Datas.GetAllData() {
+		for assetItem, assetMap := range exchangeMap {
+			for currencyPair, dataHandler := range assetMap {
+				ts, ok := dataHandler.(data.TradeStreamer)
+				if !ok {
+					return nil, false
+				}
+				isStreaming = true
+				upcoming := ts.PeekNext()
+				if upcoming == nil {
+					continue
+				}
+				key := exchangeName + assetItem.String() + currencyPair.String()
+				if next == nil ||
+					upcoming.GetTime().Before(nextTime) ||
+					(upcoming.GetTime().Equal(nextTime) && key < nextKey) {
+					next = dataHandler
+					nextTime = upcoming.GetTime()
+					nextKey = key
+				}
+			}
+		}
+	}
+	if next == nil {
+		return nil, isStreaming
+	}
+	return next.Next(), true
+}
+
+// setupProgress counts the data events to be run so that progress can be
+// reported while running
+func (bt *BackTest) setupProgress() {
+	var total int64
+	for _, exchangeMap := range bt.Datas.GetAllData() {
+		for _, assetMap := range exchangeMap {
+			for _, dataHandler := range assetMap {
+				total += int64(len(dataHandler.List()))
+			}
+		}
+	}
+	atomic.StoreInt64(&bt.processedEvents, 0)
+	atomic.StoreInt64(&bt.totalEvents, total)
+}
+
+// updateStreamProgress estimates the total data events from those processed and
+// those loaded awaiting processing, as streamed trades are loaded in chunks
+func (bt *BackTest) updateStreamProgress() {
+	total := atomic.LoadInt64(&bt.processedEvents)
+	for _, exchangeMap := range bt.Datas.GetAllData() {
+		for _, assetMap := range exchangeMap {
+			for _, dataHandler := range assetMap {
+				total += int64(len(dataHandler.List()))
+			}
+		}
+	}
+	atomic.StoreInt64(&bt.totalEvents, total)
+}
+
+// Progress returns the percentage of data events processed by Run. It is
+// safe to call while running
+func (bt *BackTest) Progress() decimal.Decimal {
+	total := atomic.LoadInt64(&bt.totalEvents)
+	if total == 0 {
+		return decimal.Zero
+	}
+	processed := atomic.LoadInt64(&bt.processedEvents)
+	return decimal.NewFromInt(processed).Div(decimal.NewFromInt(total)).Mul(decimal.NewFromInt(100))
+}
+
 // handleEvent is the main processor of data for the backtester
 // after data has been loaded and Run has appended a data event to the queue,
 // handle event will process events and add further events to the queue if they
 // are required
 func (bt *BackTest) handleEvent(ev common.EventHandler) error {
+	if len(bt.sleeves) > 0 {
+		return bt.processSleeveDataEvent(ev)
+	}
 	funds, err := bt.Funding.GetFundingForEvent(ev)
 	if err != nil {
 		return err
@@ -987,6 +1446,16 @@ func (bt *BackTest) updateStatsForDataEvent(ev common.DataEventHandler, funds fu
 		}
 		log.Error(log.BackTester, err)
 	}
+	// mark any leveraged position, paying funding and liquidating where required
+	err = bt.Funding.UpdatePosition(ev)
+	if err != nil {
+		log.Error(log.BackTester, err)
+	}
+	// match resting limit orders against any replayed orderbook movement
+	err = bt.processRestingOrders(ev)
+	if err != nil {
+		log.Error(log.BackTester, err)
+	}
 	// update portfolio manager with the latest price
 	err = bt.Portfolio.UpdateHoldings(ev, funds)
 	if err != nil {
@@ -1033,6 +1502,28 @@ func (bt *BackTest) processOrderEvent(ev order.Event, funds funding.IPairRelease
 	bt.EventQueue.AppendEvent(f)
 }
 
+// processRestingOrders appends a fill event to the queue for every resting
+// limit order matched by the data event
+func (bt *BackTest) processRestingOrders(ev common.DataEventHandler) error {
+	d := bt.Datas.GetDataForCurrency(ev.GetExchange(), ev.GetAssetType(), ev.Pair())
+	if d == nil {
+		return nil
+	}
+	funds, err := bt.Funding.GetFundingForEAP(ev.GetExchange(), ev.GetAssetType(), ev.Pair())
+	if err != nil {
+		return err
+	}
+	fills, err := bt.Exchange.ProcessRestingOrders(ev, d, bt.orderManager, funds)
+	for i := range fills {
+		statErr := bt.Statistic.SetEventForOffset(fills[i])
+		if statErr != nil {
+			log.Error(log.BackTester, statErr)
+		}
+		bt.EventQueue.AppendEvent(fills[i])
+	}
+	return err
+}
+
 func (bt *BackTest) processFillEvent(ev fill.Event, funds funding.IPairReader) {
 	t, err := bt.Portfolio.OnFill(ev, funds)
 	if err != nil {
@@ -1069,129 +1560,10 @@ func (bt *BackTest) processFillEvent(ev fill.Event, funds funding.IPairReader) {
 	}
 }
 
-// RunLive is a proof of concept function that does not yet support multi currency usage
-// It runs by constantly checking for new live datas and running through the list of events
-// once new data is processed. It will run until application close event has been received
-func (bt *BackTest) RunLive() error {
-	log.Info(log.BackTester, "running backtester against live data")
-	timeoutTimer := time.NewTimer(time.Minute * 5)
-	// a frequent timer so that when a new candle is released by an exchange
-	// that it can be processed quickly
-	processEventTicker := time.NewTicker(time.Second)
-	doneARun := false
-	for {
-		select {
-		case <-bt.shutdown:
-			return nil
-		case <-timeoutTimer.C:
-			return errLiveDataTimeout
-		case <-processEventTicker.C:
-			for e := bt.EventQueue.NextEvent(); ; e = bt.EventQueue.NextEvent() {
-				if e == nil {
-					// as live only supports singular currency, just get the proper reference manually
-					var d data.Handler
-					dd := bt.Datas.GetAllData()
-					for k1, v1 := range dd {
-						for k2, v2 := range v1 {
-							for k3 := range v2 {
-								d = dd[k1][k2][k3]
-							}
-						}
-					}
-					de := d.Next()
-					if de == nil {
-						break
-					}
-
-					bt.EventQueue.AppendEvent(de)
-					doneARun = true
-					continue
-				}
-				err := bt.handleEvent(e)
-				if err != nil {
-					return err
-				}
-			}
-			if doneARun {
-				timeoutTimer = time.NewTimer(time.Minute * 5)
-			}
-		}
-	}
-}
-
-// loadLiveDataLoop is an incomplete function to continuously retrieve exchange data on a loop
-// from live. Its purpose is to be able to perform strategy analysis against current data
-func (bt *BackTest) loadLiveDataLoop(resp *kline.DataFromKline, cfg *config.Config, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item, dataType int64) {
-	startDate := time.Now().Add(-cfg.DataSettings.Interval * 2)
-	dates, err := gctkline.CalculateCandleDateRanges(
-		startDate,
-		startDate.AddDate(1, 0, 0),
-		gctkline.Interval(cfg.DataSettings.Interval),
-		0)
-	if err != nil {
-		log.Errorf(log.BackTester, "%v. Please check your GoCryptoTrader configuration", err)
-		return
-	}
-	candles, err := live.LoadData(context.TODO(),
-		exch,
-		dataType,
-		cfg.DataSettings.Interval,
-		fPair,
-		a)
-	if err != nil {
-		log.Errorf(log.BackTester, "%v. Please check your GoCryptoTrader configuration", err)
-		return
-	}
-	dates.SetHasDataFromCandles(candles.Candles)
-	resp.RangeHolder = dates
-	resp.Item = *candles
-
-	loadNewDataTimer := time.NewTimer(time.Second * 5)
-	for {
-		select {
-		case <-bt.shutdown:
-			return
-		case <-loadNewDataTimer.C:
-			log.Infof(log.BackTester, "fetching data for %v %v %v %v", exch.GetName(), a, fPair, cfg.DataSettings.Interval)
-			loadNewDataTimer.Reset(time.Second * 15)
-			err = bt.loadLiveData(resp, cfg, exch, fPair, a, dataType)
-			if err != nil {
-				log.Error(log.BackTester, err)
-				return
-			}
-		}
-	}
-}
-
-func (bt *BackTest) loadLiveData(resp *kline.DataFromKline, cfg *config.Config, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item, dataType int64) error {
-	if resp == nil {
-		return errNilData
-	}
-	if cfg == nil {
-		return errNilConfig
-	}
-	if exch == nil {
-		return errNilExchange
-	}
-	candles, err := live.LoadData(context.TODO(),
-		exch,
-		dataType,
-		cfg.DataSettings.Interval,
-		fPair,
-		a)
-	if err != nil {
-		return err
-	}
-	if len(candles.Candles) == 0 {
-		return nil
-	}
-	resp.AppendResults(candles)
-	bt.Reports.UpdateItem(&resp.Item)
-	log.Info(log.BackTester, "sleeping for 30 seconds before checking for new candle data")
-	return nil
-}
-
-// Stop shuts down the live data loop
+// Stop shuts down the live data loop, or stops Run before all data is
+// processed
 func (bt *BackTest) Stop() {
-	close(bt.shutdown)
+	bt.stopOnce.Do(func() {
+		close(bt.shutdown)
+	})
 }
@@ -2,7 +2,9 @@ package backtest
 
 import (
 	"errors"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -12,11 +14,14 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/config"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/trade"
+	tradecsv "github.com/thrasher-corp/gocryptotrader/backtester/data/trade/csv"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/eventholder"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/risk"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/size"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/sizing"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
@@ -546,6 +551,62 @@ func TestFullCycle(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
+	if !bt.Progress().Equal(decimal.NewFromInt(100)) {
+		t.Errorf("received '%v' expected '%v'", bt.Progress(), 100)
+	}
+}
+
+func TestProgress(t *testing.T) {
+	t.Parallel()
+	bt := BackTest{}
+	if !bt.Progress().IsZero() {
+		t.Errorf("received '%v' expected '%v'", bt.Progress(), 0)
+	}
+	bt.totalEvents = 4
+	bt.processedEvents = 1
+	if !bt.Progress().Equal(decimal.NewFromInt(25)) {
+		t.Errorf("received '%v' expected '%v'", bt.Progress(), 25)
+	}
+}
+
+func TestRunStopped(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.BTC, currency.USD)
+	tt := time.Now()
+	bt := BackTest{
+		shutdown:   make(chan struct{}),
+		Datas:      &data.HandlerPerCurrency{},
+		EventQueue: &eventholder.Holder{},
+	}
+	bt.Datas.Setup()
+	k := kline.DataFromKline{
+		Item: gctkline.Item{
+			Exchange: testExchange,
+			Pair:     cp,
+			Asset:    asset.Spot,
+			Interval: gctkline.FifteenMin,
+			Candles: []gctkline.Candle{
+				{Time: tt, Open: 1337, High: 1337, Low: 1337, Close: 1337, Volume: 1337},
+				{Time: tt.Add(gctkline.FifteenMin.Duration()), Open: 1337, High: 1337, Low: 1337, Close: 1337, Volume: 1337},
+			},
+		},
+	}
+	err := k.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bt.Datas.SetDataForCurrency(testExchange, asset.Spot, cp, &k)
+	bt.Stop()
+	err = bt.Run()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !bt.Progress().IsZero() {
+		t.Errorf("received '%v' expected '%v'", bt.Progress(), 0)
+	}
+	if bt.totalEvents != 2 {
+		t.Errorf("received '%v' expected '%v'", bt.totalEvents, 2)
+	}
 }
 
 func TestStop(t *testing.T) {
@@ -657,3 +718,359 @@ func TestFullCycleMulti(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestFullCycleTicks(t *testing.T) {
+	t.Parallel()
+	ex := testExchange
+	a := asset.Spot
+	btc := currency.NewPair(currency.BTC, currency.USD)
+	ltc := currency.NewPair(currency.LTC, currency.USD)
+
+	stats := &statistics.Statistic{}
+	port, err := portfolio.Setup(&size.Size{
+		BuySide:  exchange.MinMax{},
+		SellSide: exchange.MinMax{},
+	}, &risk.Risk{}, decimal.Zero)
+	if err != nil {
+		t.Error(err)
+	}
+	f := funding.SetupFundingManager(false, true)
+	bt := BackTest{
+		shutdown:   nil,
+		Datas:      &data.HandlerPerCurrency{},
+		Strategy:   &dollarcostaverage.Strategy{},
+		Portfolio:  port,
+		Exchange:   &exchange.Exchange{},
+		Statistic:  stats,
+		EventQueue: &eventholder.Holder{},
+		Reports:    &report.Data{},
+		Funding:    f,
+	}
+	bt.Datas.Setup()
+	for p, trades := range map[currency.Pair]string{
+		btc: "1635724800,100,1,BUY\n1635724803,101,1,BUY\n1635724804,102,1,BUY\n",
+		ltc: "1635724801,10,1,BUY\n1635724802,11,1,BUY\n1635724805,12,1,BUY\n",
+	} {
+		_, err = port.SetupCurrencySettingsMap(&exchange.Settings{Exchange: ex, Asset: a, Pair: p})
+		if err != nil {
+			t.Error(err)
+		}
+		var b, quote *funding.Item
+		b, err = funding.CreateItem(ex, a, p.Base, decimal.Zero, decimal.Zero)
+		if err != nil {
+			t.Error(err)
+		}
+		quote, err = funding.CreateItem(ex, a, p.Quote, decimal.NewFromInt(1337), decimal.Zero)
+		if err != nil {
+			t.Error(err)
+		}
+		var pair *funding.Pair
+		pair, err = funding.CreatePair(b, quote)
+		if err != nil {
+			t.Error(err)
+		}
+		err = f.AddPair(pair)
+		if err != nil {
+			t.Error(err)
+		}
+		d := &trade.DataFromTrades{
+			Exchange:  ex,
+			Asset:     a,
+			Pair:      p,
+			Interval:  gctkline.OneMin,
+			ChunkSize: 2,
+			Source:    tradecsv.NewSource(ioutil.NopCloser(strings.NewReader(trades)), ex, p, a),
+		}
+		err = d.Load()
+		if err != nil {
+			t.Fatal(err)
+		}
+		bt.Datas.SetDataForCurrency(ex, a, p, d)
+	}
+
+	err = bt.Run()
+	if err != nil {
+		t.Error(err)
+	}
+	if !bt.Progress().Equal(decimal.NewFromInt(100)) {
+		t.Errorf("received '%v' expected '%v'", bt.Progress(), 100)
+	}
+	for _, p := range []currency.Pair{btc, ltc} {
+		events := stats.ExchangeAssetPairStatistics[ex][a][p].Events
+		if len(events) != 3 {
+			t.Fatalf("received '%v' expected '%v'", len(events), 3)
+		}
+		for i := 1; i < len(events); i++ {
+			if events[i].DataEvent.GetTime().Before(events[i-1].DataEvent.GetTime()) {
+				t.Errorf("expected %v trades to be processed in time order", p)
+			}
+		}
+	}
+}
+
+func TestNextTradeEvent(t *testing.T) {
+	t.Parallel()
+	bt := BackTest{Datas: &data.HandlerPerCurrency{}}
+	_, isStreaming := bt.nextTradeEvent()
+	if isStreaming {
+		t.Error("expected no streaming without data")
+	}
+	bt.Datas.SetDataForCurrency(testExchange, asset.Spot, currency.NewPair(currency.BTC, currency.USD), &kline.DataFromKline{})
+	_, isStreaming = bt.nextTradeEvent()
+	if isStreaming {
+		t.Error("expected candle data to not be streamed")
+	}
+
+	bt.Datas.Reset()
+	for p, trades := range map[currency.Pair]string{
+		currency.NewPair(currency.BTC, currency.USD): "1635724801,100,1,BUY\n",
+		currency.NewPair(currency.LTC, currency.USD): "1635724800,10,1,BUY\n1635724801,11,1,BUY\n",
+	} {
+		d := &trade.DataFromTrades{
+			Exchange: testExchange,
+			Asset:    asset.Spot,
+			Pair:     p,
+			Interval: gctkline.OneMin,
+			Source:   tradecsv.NewSource(ioutil.NopCloser(strings.NewReader(trades)), testExchange, p, asset.Spot),
+		}
+		err := d.Load()
+		if err != nil {
+			t.Fatal(err)
+		}
+		bt.Datas.SetDataForCurrency(testExchange, asset.Spot, p, d)
+	}
+	var prices []int64
+	for {
+		ev, isStreaming := bt.nextTradeEvent()
+		if !isStreaming {
+			t.Fatal("expected trade data to be streamed")
+		}
+		if ev == nil {
+			break
+		}
+		prices = append(prices, ev.GetClosePrice().IntPart())
+	}
+	// trades at the same time are processed in pair order
+	expected := []int64{10, 100, 11}
+	if len(prices) != len(expected) {
+		t.Fatalf("received '%v' expected '%v'", prices, expected)
+	}
+	for i := range expected {
+		if prices[i] != expected[i] {
+			t.Errorf("received '%v' expected '%v'", prices, expected)
+		}
+	}
+}
+
+func TestLoadTradeData(t *testing.T) {
+	t.Parallel()
+	bt := New()
+	cfg := &config.Config{}
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	_, err := bt.loadTradeData(cfg, nil, p, asset.Spot)
+	if !errors.Is(err, engine.ErrExchangeNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, engine.ErrExchangeNotFound)
+	}
+	em := engine.SetupExchangeManager()
+	exch, err := em.NewExchangeByName("binance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	_, err = bt.loadTradeData(nil, exch, p, asset.Spot)
+	if !errors.Is(err, common.ErrNilArguments) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilArguments)
+	}
+	_, err = bt.loadTradeData(cfg, exch, p, asset.Spot)
+	if !errors.Is(err, errIntervalUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errIntervalUnset)
+	}
+	cfg.DataSettings.Interval = gctkline.OneMin.Duration()
+	_, err = bt.loadTradeData(cfg, exch, p, asset.Spot)
+	if !errors.Is(err, errNoDataSource) {
+		t.Errorf("received '%v' expected '%v'", err, errNoDataSource)
+	}
+	cfg.DataSettings.CSVData = &config.CSVData{}
+	cfg.DataSettings.DatabaseData = &config.DatabaseData{}
+	_, err = bt.loadTradeData(cfg, exch, p, asset.Spot)
+	if !errors.Is(err, errAmbiguousDataSource) {
+		t.Errorf("received '%v' expected '%v'", err, errAmbiguousDataSource)
+	}
+	cfg.DataSettings.DatabaseData = nil
+	cfg.DataSettings.CSVData.FullPath = filepath.Join("..", "..", "testdata", "binance_BTCUSDT_24h-trades_2020_11_16.csv")
+	cfg.DataSettings.TickChunkSize = 100
+	d, err := bt.loadTradeData(cfg, exch, p, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(d.List()) != 100 {
+		t.Errorf("received '%v' expected '%v'", len(d.List()), 100)
+	}
+	d.Reset()
+}
+
+func TestDataRangeAndWindow(t *testing.T) {
+	t.Parallel()
+	bt := New()
+	_, _, err := bt.DataRange()
+	if !errors.Is(err, errNilData) {
+		t.Errorf("received: %v, expected: %v", err, errNilData)
+	}
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	k := &kline.DataFromKline{
+		Item: gctkline.Item{
+			Exchange: testExchange,
+			Pair:     cp,
+			Asset:    asset.Spot,
+			Interval: gctkline.OneDay,
+		},
+	}
+	for i := 0; i < 10; i++ {
+		k.Item.Candles = append(k.Item.Candles, gctkline.Candle{
+			Time:  start.AddDate(0, 0, i),
+			Close: 1337,
+		})
+	}
+	err = k.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bt.Datas.SetDataForCurrency(testExchange, asset.Spot, cp, k)
+
+	rangeStart, rangeEnd, err := bt.DataRange()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if !rangeStart.Equal(start) || !rangeEnd.Equal(start.AddDate(0, 0, 10)) {
+		t.Errorf("received: %v %v, expected: %v %v", rangeStart, rangeEnd, start, start.AddDate(0, 0, 10))
+	}
+
+	err = bt.SetDataWindow(rangeEnd, rangeStart)
+	if !errors.Is(err, errInvalidDataWindow) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidDataWindow)
+	}
+	err = bt.SetDataWindow(start.AddDate(0, 0, 2), start.AddDate(0, 0, 5))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	rangeStart, rangeEnd, err = bt.DataRange()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if !rangeStart.Equal(start.AddDate(0, 0, 2)) || !rangeEnd.Equal(start.AddDate(0, 0, 5)) {
+		t.Errorf("received: %v %v, expected: %v %v", rangeStart, rangeEnd, start.AddDate(0, 0, 2), start.AddDate(0, 0, 5))
+	}
+}
+
+func TestSetupMonteCarlo(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	resp := setupMonteCarlo(&config.MonteCarloSettings{
+		Iterations:      500,
+		ConfidenceLevel: decimal.NewFromFloat(0.9),
+		Seed:            1337,
+	}, &exchange.Exchange{
+		CurrencySettings: []exchange.Settings{
+			{
+				Exchange:            testExchange,
+				Asset:               asset.Spot,
+				Pair:                cp,
+				MinimumSlippageRate: decimal.NewFromInt(95),
+				MaximumSlippageRate: decimal.NewFromInt(100),
+			},
+		},
+	})
+	if resp.Iterations != 500 || resp.Seed != 1337 || !resp.ConfidenceLevel.Equal(decimal.NewFromFloat(0.9)) {
+		t.Errorf("received '%v' '%v' '%v' expected '%v' '%v' '%v'", resp.Iterations, resp.Seed, resp.ConfidenceLevel, 500, 1337, 0.9)
+	}
+	if len(resp.Slippage) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(resp.Slippage), 1)
+	}
+	if resp.Slippage[0].Exchange != strings.ToLower(testExchange) || !resp.Slippage[0].Pair.Equal(cp) {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", resp.Slippage[0].Exchange, resp.Slippage[0].Pair, strings.ToLower(testExchange), cp)
+	}
+	if !resp.Slippage[0].MinimumSlippageRate.Equal(decimal.NewFromInt(95)) || !resp.Slippage[0].MaximumSlippageRate.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("received '%v' to '%v' expected '%v' to '%v'", resp.Slippage[0].MinimumSlippageRate, resp.Slippage[0].MaximumSlippageRate, 95, 100)
+	}
+}
+
+func TestSetupPositionSizer(t *testing.T) {
+	t.Parallel()
+	p, err := portfolio.Setup(&size.Size{}, &risk.Risk{}, decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = setupPositionSizer(p, nil)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	err = setupPositionSizer(p, &config.PositionSizingSettings{Model: "martingale"})
+	if !errors.Is(err, sizing.ErrUnknownModel) {
+		t.Errorf("received '%v' expected '%v'", err, sizing.ErrUnknownModel)
+	}
+	err = setupPositionSizer(p, &config.PositionSizingSettings{
+		Model:    sizing.FixedFractional,
+		Fraction: decimal.NewFromFloat(0.25),
+	})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+}
+
+func TestSetupBenchmark(t *testing.T) {
+	t.Parallel()
+	_, err := setupBenchmark(&config.BenchmarkSettings{
+		Type:        config.CSVIndexBenchmark,
+		CSVFilePath: filepath.Join(t.TempDir(), "missing.csv"),
+	})
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("received '%v' expected '%v'", err, os.ErrNotExist)
+	}
+
+	fp := filepath.Join(t.TempDir(), "index.csv")
+	err = os.WriteFile(fp, []byte("1609459200,100\n1609545600,101\n"), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := setupBenchmark(&config.BenchmarkSettings{
+		Type:        config.CSVIndexBenchmark,
+		CSVFilePath: fp,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(b.Index) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(b.Index), 2)
+	}
+
+	_, err = setupBenchmark(&config.BenchmarkSettings{
+		Type: config.BuyAndHoldBenchmark,
+		Components: []config.BenchmarkComponent{
+			{ExchangeName: testExchange, Asset: "fake", Base: "BTC", Quote: "USD"},
+		},
+	})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, asset.ErrNotSupported)
+	}
+
+	b, err = setupBenchmark(&config.BenchmarkSettings{
+		Type: config.BasketBenchmark,
+		Components: []config.BenchmarkComponent{
+			{ExchangeName: testExchange, Asset: asset.Spot.String(), Base: "BTC", Quote: "USD", Weight: decimal.NewFromInt(1)},
+			{ExchangeName: testExchange, Asset: asset.Spot.String(), Base: "LTC", Quote: "USD", Weight: decimal.NewFromInt(1)},
+		},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(b.Components) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(b.Components), 2)
+	}
+	if b.Components[0].Exchange != strings.ToLower(testExchange) {
+		t.Errorf("received '%v' expected '%v'", b.Components[0].Exchange, strings.ToLower(testExchange))
+	}
+	if !b.Components[1].Pair.Equal(currency.NewPair(currency.LTC, currency.USD)) {
+		t.Errorf("received '%v' expected '%v'", b.Components[1].Pair, currency.NewPair(currency.LTC, currency.USD))
+	}
+}
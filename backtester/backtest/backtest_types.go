@@ -2,8 +2,11 @@ package backtest
 
 import (
 	"errors"
+	"sync"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/eventholder"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
@@ -11,7 +14,11 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies"
 	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
 	"github.com/thrasher-corp/gocryptotrader/backtester/report"
+	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/engine"
+	gctexchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 )
 
 var (
@@ -21,16 +28,25 @@ var (
 	errNoDataSource                = errors.New("no data settings set in config")
 	errIntervalUnset               = errors.New("candle interval unset")
 	errUnhandledDatatype           = errors.New("unhandled datatype")
-	errLiveDataTimeout             = errors.New("no data returned in 5 minutes, shutting down")
+	errLiveDataTimeout             = errors.New("no new live data processed before timeout, shutting down")
+	errNoLiveData                  = errors.New("no live data has been loaded")
+	errLiveSessionMismatch         = errors.New("live session does not match config")
 	errNilData                     = errors.New("nil data received")
 	errNilExchange                 = errors.New("nil exchange received")
 	errLiveUSDTrackingNotSupported = errors.New("USD tracking not supported for live data")
+	errInvalidDataWindow           = errors.New("data window start must be before its end")
+	errDataWindowUnsupported       = errors.New("data handler does not support data windows")
 )
 
 // BackTest is the main holder of all backtesting functionality
 type BackTest struct {
+	// processedEvents and totalEvents are accessed atomically to report
+	// progress and are kept first for 64-bit alignment
+	processedEvents int64
+	totalEvents     int64
 	hasHandledEvent bool
 	shutdown        chan struct{}
+	stopOnce        sync.Once
 	Datas           data.Holder
 	Strategy        strategies.Handler
 	Portfolio       portfolio.Handler
@@ -42,4 +58,70 @@ type BackTest struct {
 	exchangeManager *engine.ExchangeManager
 	orderManager    *engine.OrderManager
 	databaseManager *engine.DatabaseConnectionManager
+	live            *liveSession
+	// sleeves run multiple strategies over the same data events, each with
+	// its own share of funding
+	sleeves                 []*strategySleeve
+	sleeveRebalanceInterval time.Duration
+	nextSleeveRebalance     time.Time
+}
+
+// strategySleeve runs one strategy with its own portfolio, exchange, statistics
+// and funding, sharing data with the parent backtest
+type strategySleeve struct {
+	name string
+	bt   *BackTest
+	// lastDataTime prevents simultaneous processing strategies handling
+	// the same time more than once
+	lastDataTime time.Time
+}
+
+// liveSession holds the settings and currencies used to stream live data
+type liveSession struct {
+	nickname        string
+	strategyName    string
+	dataType        int64
+	interval        gctkline.Interval
+	dataCheckTimer  time.Duration
+	newEventTimeout time.Duration
+	sessionPath     string
+	targets         []*liveTarget
+	// fetch retrieves the latest candles for a target, allowing tests to
+	// avoid exchange API calls
+	fetch func(*liveTarget) (*gctkline.Item, error)
+}
+
+// liveTarget is a single exchange, asset and pair streamed in a live session
+type liveTarget struct {
+	exchange   gctexchange.IBotExchange
+	asset      asset.Item
+	pair       currency.Pair
+	realOrders bool
+	data       *kline.DataFromKline
+	// next is the start time of the next candle expected for the target
+	next time.Time
+}
+
+// liveUpdate holds the candles retrieved for a target
+type liveUpdate struct {
+	target int
+	item   *gctkline.Item
+}
+
+// LiveSession is the saved state of a live run, allowing a stopped session
+// to be resumed by replaying its processed candles
+type LiveSession struct {
+	Nickname     string            `json:"nickname"`
+	StrategyName string            `json:"strategy-name"`
+	Interval     gctkline.Interval `json:"interval"`
+	LastUpdated  time.Time         `json:"last-updated"`
+	Data         []LiveSessionData `json:"data"`
+}
+
+// LiveSessionData holds the processed candles of an exchange, asset and pair
+type LiveSessionData struct {
+	Exchange string            `json:"exchange"`
+	Asset    asset.Item        `json:"asset"`
+	Pair     currency.Pair     `json:"pair"`
+	Candles  []gctkline.Candle `json:"candles"`
 }
@@ -0,0 +1,464 @@
+package backtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/live"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	gctexchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+const (
+	defaultLiveDataCheckTimer  = time.Second * 15
+	minimumLiveNewEventTimeout = time.Minute * 5
+)
+
+// addLiveTarget registers an exchange, asset and pair to stream candles for
+// once the live run begins
+func (bt *BackTest) addLiveTarget(cfg *config.Config, exch gctexchange.IBotExchange, resp *kline.DataFromKline, dataType int64) {
+	if bt.live == nil {
+		bt.live = newLiveSession(cfg, dataType)
+	}
+	bt.live.targets = append(bt.live.targets, &liveTarget{
+		exchange:   exch,
+		asset:      resp.Item.Asset,
+		pair:       resp.Item.Pair,
+		realOrders: cfg.DataSettings.LiveData.RealOrders,
+		data:       resp,
+	})
+}
+
+// newLiveSession sets up a live session from the config, defaulting any
+// unset timers
+func newLiveSession(cfg *config.Config, dataType int64) *liveSession {
+	s := &liveSession{
+		nickname:        cfg.Nickname,
+		strategyName:    cfg.StrategySettings.Name,
+		dataType:        dataType,
+		interval:        gctkline.Interval(cfg.DataSettings.Interval),
+		dataCheckTimer:  cfg.DataSettings.LiveData.DataCheckTimer,
+		newEventTimeout: cfg.DataSettings.LiveData.NewEventTimeout,
+		sessionPath:     cfg.DataSettings.LiveData.SessionPath,
+	}
+	if s.dataCheckTimer <= 0 {
+		s.dataCheckTimer = defaultLiveDataCheckTimer
+	}
+	if s.newEventTimeout <= 0 {
+		s.newEventTimeout = s.interval.Duration() * 2
+		if s.newEventTimeout < minimumLiveNewEventTimeout {
+			s.newEventTimeout = minimumLiveNewEventTimeout
+		}
+	}
+	s.fetch = s.fetchCandles
+	return s
+}
+
+// fetchCandles retrieves the latest candles for a target from its exchange
+func (s *liveSession) fetchCandles(t *liveTarget) (*gctkline.Item, error) {
+	return live.LoadData(context.TODO(),
+		t.exchange,
+		s.dataType,
+		s.interval.Duration(),
+		t.pair,
+		t.asset)
+}
+
+// RunLive streams candles for every configured currency from their exchanges.
+// Once a candle interval has closed for every currency, it is run through the
+// strategy, portfolio and exchange handlers in the same way as Run. Orders are
+// simulated unless real orders are enabled, where they are submitted via the
+// order manager. Processed candles are saved to the session path, if set, so
+// a stopped session can be resumed. It runs until Stop is called or no new
+// data is processed before the new event timeout
+func (bt *BackTest) RunLive() error {
+	if bt.live == nil || len(bt.live.targets) == 0 {
+		return errNoLiveData
+	}
+	log.Info(log.BackTester, "running backtester against live data")
+	err := bt.resumeLiveSession(time.Now())
+	if err != nil {
+		return err
+	}
+
+	updates := make(chan []liveUpdate)
+	done := make(chan struct{})
+	defer close(done)
+	go bt.fetchLiveData(updates, done)
+
+	timeoutTimer := time.NewTimer(bt.live.newEventTimeout)
+	defer timeoutTimer.Stop()
+	for {
+		select {
+		case <-bt.shutdown:
+			return nil
+		case <-timeoutTimer.C:
+			return fmt.Errorf("%w, waited %v", errLiveDataTimeout, bt.live.newEventTimeout)
+		case u := <-updates:
+			var processed bool
+			processed, err = bt.processLiveUpdates(u, time.Now())
+			if err != nil {
+				return err
+			}
+			if !processed {
+				continue
+			}
+			if !timeoutTimer.Stop() {
+				select {
+				case <-timeoutTimer.C:
+				default:
+				}
+			}
+			timeoutTimer.Reset(bt.live.newEventTimeout)
+			err = bt.saveLiveSession(time.Now())
+			if err != nil {
+				log.Error(log.BackTester, err)
+			}
+		}
+	}
+}
+
+// fetchLiveData retrieves the latest candles for every target on each data
+// check and sends them to be processed. When real orders are enabled, the
+// orderbook is also updated so orders can be sized against it
+func (bt *BackTest) fetchLiveData(updates chan<- []liveUpdate, done <-chan struct{}) {
+	checkTimer := time.NewTimer(0)
+	defer checkTimer.Stop()
+	for {
+		select {
+		case <-bt.shutdown:
+			return
+		case <-done:
+			return
+		case <-checkTimer.C:
+			batch := make([]liveUpdate, len(bt.live.targets))
+			for i, t := range bt.live.targets {
+				batch[i].target = i
+				log.Debugf(log.BackTester, "fetching data for %v %v %v %v", t.data.Item.Exchange, t.asset, t.pair, bt.live.interval)
+				item, err := bt.live.fetch(t)
+				if err != nil {
+					log.Error(log.BackTester, err)
+					continue
+				}
+				batch[i].item = item
+				if t.realOrders {
+					_, err = t.exchange.UpdateOrderbook(context.TODO(), t.pair, t.asset)
+					if err != nil {
+						log.Errorf(log.BackTester, "could not update orderbook for %v %v %v, %v", t.data.Item.Exchange, t.asset, t.pair, err)
+					}
+				}
+			}
+			select {
+			case updates <- batch:
+			case <-bt.shutdown:
+				return
+			case <-done:
+				return
+			}
+			checkTimer.Reset(bt.live.dataCheckTimer)
+		}
+	}
+}
+
+// processLiveUpdates appends the closed candles of each update to its target
+// and processes every interval which now has data for all targets
+func (bt *BackTest) processLiveUpdates(updates []liveUpdate, now time.Time) (bool, error) {
+	for i := range updates {
+		if updates[i].target < 0 || updates[i].target >= len(bt.live.targets) {
+			continue
+		}
+		t := bt.live.targets[updates[i].target]
+		var candles []gctkline.Candle
+		if updates[i].item != nil {
+			candles = updates[i].item.Candles
+		}
+		var closed []gctkline.Candle
+		closed, t.next = closedCandles(candles, t.next, bt.live.interval, now)
+		bt.appendLiveCandles(t, closed)
+	}
+	return bt.processLiveData()
+}
+
+// appendLiveCandles adds candles to a target's data and report item
+func (bt *BackTest) appendLiveCandles(t *liveTarget, candles []gctkline.Candle) {
+	if len(candles) == 0 {
+		return
+	}
+	t.data.AppendResults(&gctkline.Item{
+		Exchange: t.data.Item.Exchange,
+		Pair:     t.data.Item.Pair,
+		Asset:    t.data.Item.Asset,
+		Interval: bt.live.interval,
+		Candles:  candles,
+	})
+	if bt.Reports != nil {
+		bt.Reports.UpdateItem(&t.data.Item)
+	}
+}
+
+// closedCandles returns the candles from next onwards which have closed by
+// now, sorted by time. Intervals the exchange has not returned are filled with
+// empty candles, flagging them as missing data, once another interval has
+// passed since they closed. It also returns the start of the next candle
+// expected
+func closedCandles(candles []gctkline.Candle, next time.Time, interval gctkline.Interval, now time.Time) ([]gctkline.Candle, time.Time) {
+	sorted := make([]gctkline.Candle, len(candles))
+	copy(sorted, candles)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Time.Before(sorted[j].Time)
+	})
+	duration := interval.Duration()
+	if duration <= 0 {
+		return nil, next
+	}
+	if next.IsZero() {
+		if len(sorted) == 0 {
+			return nil, next
+		}
+		next = sorted[0].Time
+	}
+	var resp []gctkline.Candle
+	for i := range sorted {
+		if sorted[i].Time.Before(next) || sorted[i].Time.Add(duration).After(now) {
+			continue
+		}
+		for !next.Add(duration).After(sorted[i].Time) {
+			resp = append(resp, gctkline.Candle{Time: next})
+			next = next.Add(duration)
+		}
+		resp = append(resp, sorted[i])
+		next = sorted[i].Time.Add(duration)
+	}
+	for !next.Add(duration * 2).After(now) {
+		resp = append(resp, gctkline.Candle{Time: next})
+		next = next.Add(duration)
+	}
+	return resp, next
+}
+
+// processLiveData runs every interval which has data for all currencies
+// through the event handlers, returning whether any were processed
+func (bt *BackTest) processLiveData() (bool, error) {
+	var processed bool
+	for bt.hasLiveDataToProcess() {
+		dataHandlerMap := bt.Datas.GetAllData()
+		for _, exchangeMap := range dataHandlerMap {
+			for _, assetMap := range exchangeMap {
+				var hasProcessedData bool
+				for _, dataHandler := range assetMap {
+					d := dataHandler.Next()
+					if bt.Strategy.UsingSimultaneousProcessing() && hasProcessedData {
+						continue
+					}
+					bt.EventQueue.AppendEvent(d)
+					hasProcessedData = true
+				}
+			}
+		}
+		for ev := bt.EventQueue.NextEvent(); ev != nil; ev = bt.EventQueue.NextEvent() {
+			err := bt.handleEvent(ev)
+			if err != nil {
+				return processed, err
+			}
+		}
+		bt.hasHandledEvent = true
+		processed = true
+	}
+	return processed, nil
+}
+
+// hasLiveDataToProcess returns whether every data handler has an unprocessed
+// event, ensuring currencies are processed in step
+func (bt *BackTest) hasLiveDataToProcess() bool {
+	dataHandlerMap := bt.Datas.GetAllData()
+	if len(dataHandlerMap) == 0 {
+		return false
+	}
+	for _, exchangeMap := range dataHandlerMap {
+		for _, assetMap := range exchangeMap {
+			for _, dataHandler := range assetMap {
+				if len(dataHandler.List()) == 0 {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// resumeLiveSession sets where each target starts streaming from. If a saved
+// session exists, its candles are replayed so funding, holdings and statistics
+// continue from where the session was stopped. Orders are always simulated
+// while replaying
+func (bt *BackTest) resumeLiveSession(now time.Time) error {
+	start := now.Truncate(bt.live.interval.Duration()).Add(-bt.live.interval.Duration())
+	for i := range bt.live.targets {
+		if bt.live.targets[i].next.IsZero() {
+			bt.live.targets[i].next = start
+		}
+	}
+	if bt.live.sessionPath == "" {
+		return nil
+	}
+	session, err := loadLiveSession(bt.live.sessionPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	err = bt.live.validateSession(session)
+	if err != nil {
+		return err
+	}
+	for i := range session.Data {
+		if len(session.Data[i].Candles) == 0 {
+			continue
+		}
+		t := bt.live.getTarget(&session.Data[i])
+		bt.appendLiveCandles(t, session.Data[i].Candles)
+		t.next = session.Data[i].Candles[len(session.Data[i].Candles)-1].Time.Add(bt.live.interval.Duration())
+		if t.next.Before(start) {
+			log.Warnf(log.BackTester, "%v %v %v resumed from %v, intervals since will be flagged as missing data", t.data.Item.Exchange, t.asset, t.pair, t.next)
+		}
+	}
+
+	restoreRealOrders, err := bt.simulateLiveOrders()
+	if err != nil {
+		return err
+	}
+	_, err = bt.processLiveData()
+	restoreErr := restoreRealOrders()
+	if err != nil {
+		return err
+	}
+	if restoreErr != nil {
+		return restoreErr
+	}
+	log.Infof(log.BackTester, "resumed live session from %v, last updated %v", bt.live.sessionPath, session.LastUpdated)
+	return nil
+}
+
+// simulateLiveOrders disables real orders for every target, returning a
+// function to restore them
+func (bt *BackTest) simulateLiveOrders() (func() error, error) {
+	var disabled []*liveTarget
+	restore := func() error {
+		for i := range disabled {
+			cs, err := bt.Exchange.GetCurrencySettings(disabled[i].data.Item.Exchange, disabled[i].asset, disabled[i].pair)
+			if err != nil {
+				return err
+			}
+			cs.UseRealOrders = true
+			bt.Exchange.SetExchangeAssetCurrencySettings(disabled[i].data.Item.Exchange, disabled[i].asset, disabled[i].pair, &cs)
+		}
+		return nil
+	}
+	for _, t := range bt.live.targets {
+		if !t.realOrders {
+			continue
+		}
+		cs, err := bt.Exchange.GetCurrencySettings(t.data.Item.Exchange, t.asset, t.pair)
+		if err != nil {
+			return restore, err
+		}
+		cs.UseRealOrders = false
+		bt.Exchange.SetExchangeAssetCurrencySettings(t.data.Item.Exchange, t.asset, t.pair, &cs)
+		disabled = append(disabled, t)
+	}
+	return restore, nil
+}
+
+// saveLiveSession writes every processed candle to the session path,
+// replacing the previous save
+func (bt *BackTest) saveLiveSession(now time.Time) error {
+	if bt.live.sessionPath == "" {
+		return nil
+	}
+	session := LiveSession{
+		Nickname:     bt.live.nickname,
+		StrategyName: bt.live.strategyName,
+		Interval:     bt.live.interval,
+		LastUpdated:  now,
+	}
+	for _, t := range bt.live.targets {
+		processed := t.data.Offset()
+		if processed > len(t.data.Item.Candles) {
+			processed = len(t.data.Item.Candles)
+		}
+		session.Data = append(session.Data, LiveSessionData{
+			Exchange: t.data.Item.Exchange,
+			Asset:    t.asset,
+			Pair:     t.pair,
+			Candles:  t.data.Item.Candles[:processed],
+		})
+	}
+	payload, err := json.MarshalIndent(session, "", " ")
+	if err != nil {
+		return err
+	}
+	// write to a temporary file first so an interrupted save cannot
+	// corrupt the existing session
+	tmp := bt.live.sessionPath + ".tmp"
+	err = file.Write(tmp, payload)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, bt.live.sessionPath)
+}
+
+// loadLiveSession reads a saved live session
+func loadLiveSession(path string) (*LiveSession, error) {
+	payload, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var session LiveSession
+	err = json.Unmarshal(payload, &session)
+	if err != nil {
+		return nil, fmt.Errorf("could not read live session %v, %v", path, err)
+	}
+	return &session, nil
+}
+
+// validateSession ensures a saved session was run with the same strategy,
+// interval and currencies
+func (s *liveSession) validateSession(session *LiveSession) error {
+	if !strings.EqualFold(session.StrategyName, s.strategyName) {
+		return fmt.Errorf("%w, session strategy %v, config strategy %v", errLiveSessionMismatch, session.StrategyName, s.strategyName)
+	}
+	if session.Interval != s.interval {
+		return fmt.Errorf("%w, session interval %v, config interval %v", errLiveSessionMismatch, session.Interval, s.interval)
+	}
+	if len(session.Data) != len(s.targets) {
+		return fmt.Errorf("%w, session has %v currencies, config has %v", errLiveSessionMismatch, len(session.Data), len(s.targets))
+	}
+	for i := range session.Data {
+		if s.getTarget(&session.Data[i]) == nil {
+			return fmt.Errorf("%w, %v %v %v not in config", errLiveSessionMismatch, session.Data[i].Exchange, session.Data[i].Asset, session.Data[i].Pair)
+		}
+	}
+	return nil
+}
+
+// getTarget returns the target matching the session data
+func (s *liveSession) getTarget(d *LiveSessionData) *liveTarget {
+	for _, t := range s.targets {
+		if strings.EqualFold(t.data.Item.Exchange, d.Exchange) &&
+			t.asset == d.Asset &&
+			t.pair.Equal(d.Pair) {
+			return t
+		}
+	}
+	return nil
+}
@@ -0,0 +1,398 @@
+package backtest
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/eventholder"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/risk"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/size"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/dollarcostaverage"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	"github.com/thrasher-corp/gocryptotrader/backtester/report"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+var liveTestPairs = []currency.Pair{
+	currency.NewPair(currency.BTC, currency.USD),
+	currency.NewPair(currency.ETH, currency.USDT),
+}
+
+// setupLiveBackTest creates a backtester streaming a live session for each
+// test pair without connecting to an exchange
+func setupLiveBackTest(t *testing.T, sessionPath string) *BackTest {
+	t.Helper()
+	port, err := portfolio.Setup(&size.Size{}, &risk.Risk{}, decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := funding.SetupFundingManager(false, true)
+	bt := &BackTest{
+		shutdown:   make(chan struct{}),
+		Datas:      &data.HandlerPerCurrency{},
+		Strategy:   &dollarcostaverage.Strategy{},
+		Portfolio:  port,
+		Exchange:   &exchange.Exchange{},
+		Statistic:  &statistics.Statistic{ExchangeAssetPairStatistics: make(map[string]map[asset.Item]map[currency.Pair]*statistics.CurrencyPairStatistic)},
+		EventQueue: &eventholder.Holder{},
+		Reports:    &report.Data{},
+		Funding:    f,
+		live: &liveSession{
+			strategyName:    dollarcostaverage.Name,
+			interval:        gctkline.OneMin,
+			dataCheckTimer:  time.Millisecond,
+			newEventTimeout: time.Minute,
+			sessionPath:     sessionPath,
+		},
+	}
+	bt.Datas.Setup()
+	for i := range liveTestPairs {
+		_, err = port.SetupCurrencySettingsMap(&exchange.Settings{Exchange: testExchange, Asset: asset.Spot, Pair: liveTestPairs[i]})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var b, q *funding.Item
+		b, err = funding.CreateItem(testExchange, asset.Spot, liveTestPairs[i].Base, decimal.Zero, decimal.Zero)
+		if err != nil {
+			t.Fatal(err)
+		}
+		q, err = funding.CreateItem(testExchange, asset.Spot, liveTestPairs[i].Quote, decimal.NewFromInt(1337), decimal.Zero)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var p *funding.Pair
+		p, err = funding.CreatePair(b, q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = f.AddPair(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		k := &kline.DataFromKline{
+			Item: gctkline.Item{
+				Exchange: testExchange,
+				Pair:     liveTestPairs[i],
+				Asset:    asset.Spot,
+				Interval: gctkline.OneMin,
+			},
+		}
+		bt.Datas.SetDataForCurrency(testExchange, asset.Spot, liveTestPairs[i], k)
+		bt.live.targets = append(bt.live.targets, &liveTarget{
+			asset: asset.Spot,
+			pair:  liveTestPairs[i],
+			data:  k,
+		})
+	}
+	return bt
+}
+
+func liveTestCandles(start time.Time, count int) []gctkline.Candle {
+	resp := make([]gctkline.Candle, count)
+	for i := range resp {
+		resp[i] = gctkline.Candle{
+			Time:   start.Add(gctkline.OneMin.Duration() * time.Duration(i)),
+			Open:   1337,
+			High:   1337,
+			Low:    1337,
+			Close:  1337,
+			Volume: 1337,
+		}
+	}
+	return resp
+}
+
+func TestNewLiveSession(t *testing.T) {
+	t.Parallel()
+	cfg := &config.Config{
+		Nickname: "test",
+		DataSettings: config.DataSettings{
+			Interval: gctkline.OneMin.Duration(),
+			LiveData: &config.LiveData{},
+		},
+		StrategySettings: config.StrategySettings{Name: dollarcostaverage.Name},
+	}
+	s := newLiveSession(cfg, 0)
+	if s.dataCheckTimer != defaultLiveDataCheckTimer {
+		t.Errorf("received: %v, expected: %v", s.dataCheckTimer, defaultLiveDataCheckTimer)
+	}
+	if s.newEventTimeout != minimumLiveNewEventTimeout {
+		t.Errorf("received: %v, expected: %v", s.newEventTimeout, minimumLiveNewEventTimeout)
+	}
+	if s.fetch == nil {
+		t.Error("expected fetch to be set")
+	}
+
+	cfg.DataSettings.Interval = gctkline.OneHour.Duration()
+	cfg.DataSettings.LiveData.DataCheckTimer = time.Second
+	s = newLiveSession(cfg, 0)
+	if s.dataCheckTimer != time.Second {
+		t.Errorf("received: %v, expected: %v", s.dataCheckTimer, time.Second)
+	}
+	if s.newEventTimeout != gctkline.OneHour.Duration()*2 {
+		t.Errorf("received: %v, expected: %v", s.newEventTimeout, gctkline.OneHour.Duration()*2)
+	}
+}
+
+func TestClosedCandles(t *testing.T) {
+	t.Parallel()
+	interval := gctkline.OneMin
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	candles := liveTestCandles(start, 3)
+
+	// the final candle is still forming
+	resp, next := closedCandles(candles, start, interval, start.Add(time.Minute*2).Add(time.Second))
+	if len(resp) != 2 {
+		t.Fatalf("received: %v, expected: %v", len(resp), 2)
+	}
+	if !next.Equal(start.Add(time.Minute * 2)) {
+		t.Errorf("received: %v, expected: %v", next, start.Add(time.Minute*2))
+	}
+
+	// previously processed candles are ignored
+	resp, next = closedCandles(candles, next, interval, start.Add(time.Minute*3))
+	if len(resp) != 1 || !resp[0].Time.Equal(start.Add(time.Minute*2)) {
+		t.Errorf("received: %v, expected a single candle at %v", resp, start.Add(time.Minute*2))
+	}
+	if !next.Equal(start.Add(time.Minute * 3)) {
+		t.Errorf("received: %v, expected: %v", next, start.Add(time.Minute*3))
+	}
+
+	// gaps between candles are filled with empty candles
+	resp, _ = closedCandles([]gctkline.Candle{candles[2], candles[0]}, start, interval, start.Add(time.Minute*3))
+	if len(resp) != 3 {
+		t.Fatalf("received: %v, expected: %v", len(resp), 3)
+	}
+	if resp[1].Close != 0 || !resp[1].Time.Equal(start.Add(time.Minute)) {
+		t.Errorf("received: %v, expected an empty candle at %v", resp[1], start.Add(time.Minute))
+	}
+
+	// missing candles are not filled until another interval has passed
+	resp, next = closedCandles(nil, start, interval, start.Add(time.Minute))
+	if len(resp) != 0 {
+		t.Errorf("received: %v, expected: %v", len(resp), 0)
+	}
+	if !next.Equal(start) {
+		t.Errorf("received: %v, expected: %v", next, start)
+	}
+	resp, next = closedCandles(nil, start, interval, start.Add(time.Minute*2))
+	if len(resp) != 1 {
+		t.Errorf("received: %v, expected: %v", len(resp), 1)
+	}
+	if !next.Equal(start.Add(time.Minute)) {
+		t.Errorf("received: %v, expected: %v", next, start.Add(time.Minute))
+	}
+
+	resp, _ = closedCandles(candles, time.Time{}, 0, start)
+	if len(resp) != 0 {
+		t.Errorf("received: %v, expected: %v", len(resp), 0)
+	}
+}
+
+func TestProcessLiveUpdates(t *testing.T) {
+	t.Parallel()
+	bt := setupLiveBackTest(t, "")
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := range bt.live.targets {
+		bt.live.targets[i].next = start
+	}
+	now := start.Add(time.Minute * 3)
+
+	// currencies are only processed once they all have data
+	processed, err := bt.processLiveUpdates([]liveUpdate{
+		{target: 0, item: &gctkline.Item{Candles: liveTestCandles(start, 3)}},
+	}, now)
+	if err != nil {
+		t.Error(err)
+	}
+	if processed {
+		t.Error("expected no data to be processed")
+	}
+
+	processed, err = bt.processLiveUpdates([]liveUpdate{
+		{target: 1, item: &gctkline.Item{Candles: liveTestCandles(start, 2)}},
+	}, now)
+	if err != nil {
+		t.Error(err)
+	}
+	if !processed {
+		t.Error("expected data to be processed")
+	}
+	if bt.live.targets[0].data.Offset() != 2 || bt.live.targets[1].data.Offset() != 2 {
+		t.Errorf("received offsets %v %v, expected: 2",
+			bt.live.targets[0].data.Offset(),
+			bt.live.targets[1].data.Offset())
+	}
+	events := bt.Statistic.(*statistics.Statistic).ExchangeAssetPairStatistics[testExchange][asset.Spot][liveTestPairs[1]].Events
+	if len(events) != 2 {
+		t.Errorf("received: %v, expected: %v", len(events), 2)
+	}
+	if len(bt.Reports.(*report.Data).OriginalCandles) != 2 {
+		t.Errorf("received: %v, expected: %v", len(bt.Reports.(*report.Data).OriginalCandles), 2)
+	}
+}
+
+func TestSaveAndResumeLiveSession(t *testing.T) {
+	t.Parallel()
+	sessionPath := filepath.Join(t.TempDir(), "session.json")
+	bt := setupLiveBackTest(t, sessionPath)
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := bt.resumeLiveSession(start)
+	if err != nil {
+		t.Error(err)
+	}
+	var updates []liveUpdate
+	for i := range bt.live.targets {
+		updates = append(updates, liveUpdate{target: i, item: &gctkline.Item{Candles: liveTestCandles(bt.live.targets[i].next, 3)}})
+	}
+	_, err = bt.processLiveUpdates(updates, start.Add(time.Minute*2))
+	if err != nil {
+		t.Error(err)
+	}
+	err = bt.saveLiveSession(time.Now())
+	if err != nil {
+		t.Error(err)
+	}
+
+	resumed := setupLiveBackTest(t, sessionPath)
+	err = resumed.resumeLiveSession(time.Now())
+	if err != nil {
+		t.Error(err)
+	}
+	for i := range resumed.live.targets {
+		if resumed.live.targets[i].data.Offset() != 3 {
+			t.Errorf("received: %v, expected: %v", resumed.live.targets[i].data.Offset(), 3)
+		}
+		if !resumed.live.targets[i].next.Equal(bt.live.targets[i].next) {
+			t.Errorf("received: %v, expected: %v", resumed.live.targets[i].next, bt.live.targets[i].next)
+		}
+		events := resumed.Statistic.(*statistics.Statistic).ExchangeAssetPairStatistics[testExchange][asset.Spot][liveTestPairs[i]].Events
+		if len(events) != 3 {
+			t.Errorf("received: %v, expected: %v", len(events), 3)
+		}
+	}
+
+	mismatch := setupLiveBackTest(t, sessionPath)
+	mismatch.live.interval = gctkline.FiveMin
+	err = mismatch.resumeLiveSession(time.Now())
+	if !errors.Is(err, errLiveSessionMismatch) {
+		t.Errorf("received: %v, expected: %v", err, errLiveSessionMismatch)
+	}
+}
+
+func TestValidateSession(t *testing.T) {
+	t.Parallel()
+	bt := setupLiveBackTest(t, "")
+	session := &LiveSession{
+		StrategyName: dollarcostaverage.Name,
+		Interval:     gctkline.OneMin,
+	}
+	for i := range liveTestPairs {
+		session.Data = append(session.Data, LiveSessionData{
+			Exchange: testExchange,
+			Asset:    asset.Spot,
+			Pair:     liveTestPairs[i],
+		})
+	}
+	err := bt.live.validateSession(session)
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+
+	session.Data[1].Pair = currency.NewPair(currency.LTC, currency.USDT)
+	err = bt.live.validateSession(session)
+	if !errors.Is(err, errLiveSessionMismatch) {
+		t.Errorf("received: %v, expected: %v", err, errLiveSessionMismatch)
+	}
+
+	session.Data = session.Data[:1]
+	err = bt.live.validateSession(session)
+	if !errors.Is(err, errLiveSessionMismatch) {
+		t.Errorf("received: %v, expected: %v", err, errLiveSessionMismatch)
+	}
+
+	session.StrategyName = "test"
+	err = bt.live.validateSession(session)
+	if !errors.Is(err, errLiveSessionMismatch) {
+		t.Errorf("received: %v, expected: %v", err, errLiveSessionMismatch)
+	}
+}
+
+func TestSimulateLiveOrders(t *testing.T) {
+	t.Parallel()
+	bt := setupLiveBackTest(t, "")
+	bt.live.targets[0].realOrders = true
+	_, err := bt.simulateLiveOrders()
+	if err == nil {
+		t.Error("expected error for missing currency settings")
+	}
+
+	bt.Exchange.SetExchangeAssetCurrencySettings(testExchange, asset.Spot, liveTestPairs[0], &exchange.Settings{
+		Exchange:      testExchange,
+		Asset:         asset.Spot,
+		Pair:          liveTestPairs[0],
+		UseRealOrders: true,
+	})
+	restore, err := bt.simulateLiveOrders()
+	if err != nil {
+		t.Error(err)
+	}
+	cs, err := bt.Exchange.GetCurrencySettings(testExchange, asset.Spot, liveTestPairs[0])
+	if err != nil {
+		t.Error(err)
+	}
+	if cs.UseRealOrders {
+		t.Error("expected real orders to be disabled")
+	}
+	err = restore()
+	if err != nil {
+		t.Error(err)
+	}
+	cs, err = bt.Exchange.GetCurrencySettings(testExchange, asset.Spot, liveTestPairs[0])
+	if err != nil {
+		t.Error(err)
+	}
+	if !cs.UseRealOrders {
+		t.Error("expected real orders to be restored")
+	}
+}
+
+func TestRunLive(t *testing.T) {
+	t.Parallel()
+	bt := &BackTest{shutdown: make(chan struct{})}
+	err := bt.RunLive()
+	if !errors.Is(err, errNoLiveData) {
+		t.Errorf("received: %v, expected: %v", err, errNoLiveData)
+	}
+
+	bt = setupLiveBackTest(t, "")
+	bt.live.newEventTimeout = time.Millisecond * 50
+	bt.live.fetch = func(*liveTarget) (*gctkline.Item, error) {
+		return &gctkline.Item{}, nil
+	}
+	err = bt.RunLive()
+	if !errors.Is(err, errLiveDataTimeout) {
+		t.Errorf("received: %v, expected: %v", err, errLiveDataTimeout)
+	}
+
+	bt = setupLiveBackTest(t, "")
+	bt.live.fetch = func(lt *liveTarget) (*gctkline.Item, error) {
+		return &gctkline.Item{Candles: liveTestCandles(lt.next, 1)}, nil
+	}
+	bt.Stop()
+	err = bt.RunLive()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+}
@@ -0,0 +1,172 @@
+package backtest
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/eventholder"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/risk"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/size"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// setupSleeves creates a sleeve for each strategy, allocating each its
+// weighted share of the funds. Sleeves share the backtest's data and order
+// manager, but have their own portfolio, exchange, statistics and funding
+func (bt *BackTest) setupSleeves(cfg *config.Config, strats []strategies.Handler, funds *funding.FundManager, stats *statistics.Statistic, e *exchange.Exchange, sizeManager *size.Size, portfolioRisk *risk.Risk) error {
+	var totalWeight decimal.Decimal
+	for i := range cfg.StrategySettings.Sleeves {
+		totalWeight = totalWeight.Add(cfg.StrategySettings.Sleeves[i].Weight)
+	}
+	for i := range cfg.StrategySettings.Sleeves {
+		s := cfg.StrategySettings.Sleeves[i]
+		weight := s.Weight.Div(totalWeight)
+		sleeveFunds, err := funds.CreateSleeve(s.Name, weight)
+		if err != nil {
+			return err
+		}
+		p, err := portfolio.Setup(sizeManager, portfolioRisk, cfg.StatisticSettings.RiskFreeRate)
+		if err != nil {
+			return err
+		}
+		err = setupPositionSizer(p, cfg.PortfolioSettings.PositionSizing)
+		if err != nil {
+			return err
+		}
+		// resting orders are held by the exchange, so each sleeve needs its own
+		sleeveExchange := &exchange.Exchange{
+			CurrencySettings: append([]exchange.Settings(nil), e.CurrencySettings...),
+		}
+		err = setupPortfolioCurrencySettings(p, sleeveExchange)
+		if err != nil {
+			return err
+		}
+		sleeveStats := &statistics.Statistic{
+			StrategyName:                strats[i].Name(),
+			StrategyNickname:            s.Name,
+			StrategyDescription:         strats[i].Description(),
+			StrategyGoal:                cfg.Goal,
+			ExchangeAssetPairStatistics: make(map[string]map[asset.Item]map[currency.Pair]*statistics.CurrencyPairStatistic),
+			RiskFreeRate:                cfg.StatisticSettings.RiskFreeRate,
+			CandleInterval:              gctkline.Interval(cfg.DataSettings.Interval),
+			FundManager:                 sleeveFunds,
+			RollingWindow:               cfg.StatisticSettings.RollingWindow,
+		}
+		if cfg.StatisticSettings.Benchmark != nil {
+			sleeveStats.Benchmark, err = setupBenchmark(cfg.StatisticSettings.Benchmark)
+			if err != nil {
+				return err
+			}
+		}
+		stats.Sleeves = append(stats.Sleeves, &statistics.SleeveStatistics{
+			Name:      s.Name,
+			Weight:    weight,
+			Statistic: sleeveStats,
+		})
+		bt.sleeves = append(bt.sleeves, &strategySleeve{
+			name: s.Name,
+			bt: &BackTest{
+				shutdown:        bt.shutdown,
+				Datas:           bt.Datas,
+				Strategy:        strats[i],
+				Portfolio:       p,
+				Exchange:        sleeveExchange,
+				Statistic:       sleeveStats,
+				EventQueue:      &eventholder.Holder{},
+				Funding:         sleeveFunds,
+				exchangeManager: bt.exchangeManager,
+				orderManager:    bt.orderManager,
+			},
+		})
+	}
+	if cfg.StrategySettings.SleeveRebalancing != nil {
+		bt.sleeveRebalanceInterval = cfg.StrategySettings.SleeveRebalancing.Interval
+	}
+	return nil
+}
+
+// processSleeveDataEvent passes the data event to every sleeve, handling all
+// events each sleeve raises before moving to the next. Sleeves are rebalanced
+// before the first data event at or after each rebalance time
+func (bt *BackTest) processSleeveDataEvent(ev common.EventHandler) error {
+	d, ok := ev.(common.DataEventHandler)
+	if !ok {
+		return fmt.Errorf("%w %v received, could not process",
+			errUnhandledDatatype,
+			ev)
+	}
+	t := d.GetTime()
+	if bt.sleeveRebalanceInterval > 0 {
+		if bt.nextSleeveRebalance.IsZero() {
+			bt.nextSleeveRebalance = t.Add(bt.sleeveRebalanceInterval)
+		} else if !t.Before(bt.nextSleeveRebalance) {
+			err := bt.rebalanceSleeves(t)
+			if err != nil {
+				return err
+			}
+			for !t.Before(bt.nextSleeveRebalance) {
+				bt.nextSleeveRebalance = bt.nextSleeveRebalance.Add(bt.sleeveRebalanceInterval)
+			}
+		}
+	}
+	for i := range bt.sleeves {
+		s := bt.sleeves[i]
+		if s.bt.Strategy.UsingSimultaneousProcessing() {
+			if s.lastDataTime.Equal(t) {
+				continue
+			}
+			s.lastDataTime = t
+		}
+		s.bt.EventQueue.AppendEvent(d)
+		for sleeveEvent := s.bt.EventQueue.NextEvent(); sleeveEvent != nil; sleeveEvent = s.bt.EventQueue.NextEvent() {
+			err := s.bt.handleEvent(sleeveEvent)
+			if err != nil {
+				return fmt.Errorf("sleeve %v %w", s.name, err)
+			}
+		}
+	}
+	bt.Funding.CreateSnapshot(t)
+	return nil
+}
+
+// rebalanceSleeves transfers funds between sleeves to restore their weights,
+// then updates each sleeve's holdings to reflect the funds they now hold
+func (bt *BackTest) rebalanceSleeves(t time.Time) error {
+	err := bt.Funding.RebalanceSleeves(t)
+	if err != nil {
+		return err
+	}
+	dataHandlerMap := bt.Datas.GetAllData()
+	for i := range bt.sleeves {
+		for _, exchangeMap := range dataHandlerMap {
+			for _, assetMap := range exchangeMap {
+				for _, dataHandler := range assetMap {
+					latestData := dataHandler.Latest()
+					if latestData == nil {
+						continue
+					}
+					funds, err := bt.sleeves[i].bt.Funding.GetFundingForEAP(latestData.GetExchange(), latestData.GetAssetType(), latestData.Pair())
+					if err != nil {
+						return err
+					}
+					err = bt.sleeves[i].bt.Portfolio.TransferHoldings(latestData, funds)
+					if err != nil {
+						log.Error(log.BackTester, err)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
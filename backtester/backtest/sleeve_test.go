@@ -0,0 +1,203 @@
+package backtest
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/eventholder"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/risk"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/size"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/dollarcostaverage"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func TestSleeveFullCycle(t *testing.T) {
+	t.Parallel()
+	ex := strings.ToLower(testExchange)
+	cp := currency.NewPair(currency.BTC, currency.USD)
+	a := asset.Spot
+	tt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	f := funding.SetupFundingManager(false, false)
+	b, err := funding.CreateItem(ex, a, cp.Base, decimal.Zero, decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	quote, err := funding.CreateItem(ex, a, cp.Quote, decimal.NewFromInt(1000), decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pair, err := funding.CreatePair(b, quote)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = f.AddPair(pair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	k := &kline.DataFromKline{
+		Item: gctkline.Item{
+			Exchange: ex,
+			Pair:     cp,
+			Asset:    a,
+			Interval: gctkline.OneDay,
+		},
+	}
+	for i := 0; i < 3; i++ {
+		k.Item.Candles = append(k.Item.Candles, gctkline.Candle{
+			Time:   tt.Add(gctkline.OneDay.Duration() * time.Duration(i)),
+			Open:   1337,
+			High:   1337,
+			Low:    1337,
+			Close:  1337,
+			Volume: 1337,
+		})
+	}
+	err = k.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = f.AddUSDTrackingData(k)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	sizeManager := &size.Size{}
+	portfolioRisk := &risk.Risk{}
+	port, err := portfolio.Setup(sizeManager, portfolioRisk, decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stats := &statistics.Statistic{
+		ExchangeAssetPairStatistics: make(map[string]map[asset.Item]map[currency.Pair]*statistics.CurrencyPairStatistic),
+		FundManager:                 f,
+		CandleInterval:              gctkline.OneDay,
+	}
+	bt := BackTest{
+		Datas:      &data.HandlerPerCurrency{},
+		Portfolio:  port,
+		Exchange:   &exchange.Exchange{},
+		Statistic:  stats,
+		EventQueue: &eventholder.Holder{},
+		Funding:    f,
+	}
+	bt.Datas.Setup()
+	bt.Datas.SetDataForCurrency(ex, a, cp, k)
+
+	cfg := &config.Config{
+		StrategySettings: config.StrategySettings{
+			Sleeves: []config.SleeveSettings{
+				{
+					Name:                         "simultaneous",
+					StrategyName:                 dollarcostaverage.Name,
+					SimultaneousSignalProcessing: true,
+					Weight:                       decimal.NewFromInt(3),
+				},
+				{
+					Name:         "single",
+					StrategyName: dollarcostaverage.Name,
+					Weight:       decimal.NewFromInt(1),
+				},
+			},
+			SleeveRebalancing: &config.SleeveRebalanceSettings{
+				Interval: gctkline.OneDay.Duration(),
+			},
+		},
+		DataSettings: config.DataSettings{
+			Interval: gctkline.OneDay.Duration(),
+		},
+	}
+	strats := make([]strategies.Handler, len(cfg.StrategySettings.Sleeves))
+	for i := range cfg.StrategySettings.Sleeves {
+		strats[i], err = loadStrategy(cfg.StrategySettings.Sleeves[i].StrategyName, cfg.StrategySettings.Sleeves[i].SimultaneousSignalProcessing, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	e := &exchange.Exchange{
+		CurrencySettings: []exchange.Settings{
+			{
+				Exchange: ex,
+				Asset:    a,
+				Pair:     cp,
+			},
+		},
+	}
+	err = bt.setupSleeves(cfg, strats, f, stats, e, sizeManager, portfolioRisk)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(bt.sleeves) != 2 || len(stats.Sleeves) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(bt.sleeves), 2)
+	}
+	if !stats.Sleeves[0].Weight.Equal(decimal.NewFromFloat(0.75)) {
+		t.Errorf("received '%v' expected '%v'", stats.Sleeves[0].Weight, 0.75)
+	}
+	if bt.sleeveRebalanceInterval != gctkline.OneDay.Duration() {
+		t.Errorf("received '%v' expected '%v'", bt.sleeveRebalanceInterval, gctkline.OneDay.Duration())
+	}
+	singleFunds, err := bt.sleeves[1].bt.Funding.GetFundingForEAP(ex, a, cp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !singleFunds.QuoteInitialFunds().Equal(decimal.NewFromInt(250)) {
+		t.Errorf("received '%v' expected '%v'", singleFunds.QuoteInitialFunds(), 250)
+	}
+	// take the single sleeve over its weight so the first rebalance moves funds
+	singleFunds.IncreaseAvailable(decimal.NewFromInt(1000), gctorder.Sell)
+
+	err = bt.Run()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if bt.nextSleeveRebalance.IsZero() {
+		t.Error("expected rebalancing to be scheduled")
+	}
+	report := f.GenerateReport()
+	if len(report.SleeveTransfers) == 0 {
+		t.Fatal("expected sleeve transfers")
+	}
+	if report.SleeveTransfers[0].From != "single" || report.SleeveTransfers[0].To != "simultaneous" {
+		t.Errorf("received '%v' to '%v' expected '%v' to '%v'", report.SleeveTransfers[0].From, report.SleeveTransfers[0].To, "single", "simultaneous")
+	}
+	for i := range bt.sleeves {
+		sleeveStats, ok := bt.sleeves[i].bt.Statistic.(*statistics.Statistic)
+		if !ok {
+			t.Fatal("expected sleeve statistics")
+		}
+		if len(sleeveStats.ExchangeAssetPairStatistics[ex][a][cp].Events) != 3 {
+			t.Errorf("received '%v' expected '%v'", len(sleeveStats.ExchangeAssetPairStatistics[ex][a][cp].Events), 3)
+		}
+	}
+
+	err = stats.CalculateAllResults()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	combined := stats.ExchangeAssetPairStatistics[ex][a][cp]
+	if combined == nil || len(combined.Events) != 3 {
+		t.Fatal("expected combined sleeve statistics")
+	}
+	if stats.Sleeves[1].NetTransfers.IsZero() {
+		t.Error("expected the single sleeve to have transferred funds")
+	}
+
+	err = bt.processSleeveDataEvent(nil)
+	if !errors.Is(err, errUnhandledDatatype) {
+		t.Errorf("received '%v' expected '%v'", err, errUnhandledDatatype)
+	}
+}
@@ -0,0 +1,307 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/btrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var errJobIDUnset = errors.New("job id unset")
+
+var executeStrategyFromFileCommand = &cli.Command{
+	Name:      "executestrategyfromfile",
+	Usage:     "runs a strategy config file, located on the server, as a job",
+	ArgsUsage: "<path>",
+	Action:    executeStrategyFromFile,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "path",
+			Usage: "the path to the strategy config file on the server",
+		},
+	},
+}
+
+func executeStrategyFromFile(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, c.Command.Name)
+	}
+
+	var path string
+	if c.IsSet("path") {
+		path = c.String("path")
+	} else {
+		path = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := btrpc.NewBacktesterServiceClient(conn)
+	result, err := client.ExecuteStrategyFromFile(c.Context,
+		&btrpc.ExecuteStrategyFromFileRequest{
+			StrategyFilePath: path,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var executeStrategyFromConfigCommand = &cli.Command{
+	Name:      "executestrategyfromconfig",
+	Usage:     "sends a local strategy config file to the server to be run as a job",
+	ArgsUsage: "<path>",
+	Action:    executeStrategyFromConfig,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "path",
+			Usage: "the path to the local strategy config file",
+		},
+	},
+}
+
+func executeStrategyFromConfig(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, c.Command.Name)
+	}
+
+	var path string
+	if c.IsSet("path") {
+		path = c.String("path")
+	} else {
+		path = c.Args().First()
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := btrpc.NewBacktesterServiceClient(conn)
+	result, err := client.ExecuteStrategyFromConfig(c.Context,
+		&btrpc.ExecuteStrategyFromConfigRequest{
+			Config: string(data),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var listJobsCommand = &cli.Command{
+	Name:   "listjobs",
+	Usage:  "returns every job with its status and progress",
+	Action: listJobs,
+}
+
+func listJobs(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := btrpc.NewBacktesterServiceClient(conn)
+	result, err := client.ListJobs(c.Context,
+		&btrpc.ListJobsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var jobIDFlag = &cli.StringFlag{
+	Name:  "id",
+	Usage: "the job id",
+}
+
+var getJobCommand = &cli.Command{
+	Name:      "getjob",
+	Usage:     "returns a job's status and progress",
+	ArgsUsage: "<id>",
+	Action:    getJob,
+	Flags:     []cli.Flag{jobIDFlag},
+}
+
+func getJob(c *cli.Context) error {
+	id, err := jobID(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := btrpc.NewBacktesterServiceClient(conn)
+	result, err := client.GetJob(c.Context,
+		&btrpc.GetJobRequest{
+			Id: id,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var cancelJobCommand = &cli.Command{
+	Name:      "canceljob",
+	Usage:     "stops a queued or running job, live jobs are completed with results up to when they were stopped",
+	ArgsUsage: "<id>",
+	Action:    cancelJob,
+	Flags:     []cli.Flag{jobIDFlag},
+}
+
+func cancelJob(c *cli.Context) error {
+	id, err := jobID(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := btrpc.NewBacktesterServiceClient(conn)
+	result, err := client.CancelJob(c.Context,
+		&btrpc.CancelJobRequest{
+			Id: id,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getJobResultCommand = &cli.Command{
+	Name:      "getjobresult",
+	Usage:     "returns the statistics of a completed job, optionally saving its HTML report",
+	ArgsUsage: "<id> <reportpath>",
+	Action:    getJobResult,
+	Flags: []cli.Flag{
+		jobIDFlag,
+		&cli.StringFlag{
+			Name:  "reportpath",
+			Usage: "the local file the HTML report is written to, the report is not output when unset",
+		},
+	},
+}
+
+func getJobResult(c *cli.Context) error {
+	id, err := jobID(c)
+	if err != nil {
+		return err
+	}
+
+	var reportPath string
+	if c.IsSet("reportpath") {
+		reportPath = c.String("reportpath")
+	} else {
+		reportPath = c.Args().Get(1)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := btrpc.NewBacktesterServiceClient(conn)
+	result, err := client.GetJobResult(c.Context,
+		&btrpc.GetJobResultRequest{
+			Id: id,
+		})
+	if err != nil {
+		return err
+	}
+
+	if reportPath != "" {
+		err = os.MkdirAll(filepath.Dir(reportPath), 0o770)
+		if err != nil {
+			return err
+		}
+		err = os.WriteFile(reportPath, []byte(result.Report), 0o600)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("report written to %v\n", reportPath)
+	}
+	// the report is omitted as it is too large to be useful on the command line
+	result.Report = ""
+	jsonOutput(result)
+	return nil
+}
+
+var removeJobCommand = &cli.Command{
+	Name:      "removejob",
+	Usage:     "removes a finished job from the job list, its report is kept on the server",
+	ArgsUsage: "<id>",
+	Action:    removeJob,
+	Flags:     []cli.Flag{jobIDFlag},
+}
+
+func removeJob(c *cli.Context) error {
+	id, err := jobID(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := btrpc.NewBacktesterServiceClient(conn)
+	result, err := client.RemoveJob(c.Context,
+		&btrpc.RemoveJobRequest{
+			Id: id,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+// jobID returns the job id from the id flag or first argument
+func jobID(c *cli.Context) (string, error) {
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+	if id == "" {
+		return "", errJobIDUnset
+	}
+	return id, nil
+}
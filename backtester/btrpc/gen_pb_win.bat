@@ -0,0 +1,9 @@
+@echo off
+echo GoCryptoTrader Backtester: Generating gRPC, proxy and swagger files.
+REM You may need to include the go mod package for the annotations file:
+REM %GOPATH%\pkg\mod\github.com\grpc-ecosystem\grpc-gateway\v2@v2.0.1\third_party\googleapis
+
+protoc -I=. -I=%GOPATH%\src -I=%GOPATH%\src\github.com\grpc-ecosystem\grpc-gateway\third_party\googleapis --go_out=paths=source_relative:. btrpc.proto
+protoc -I=. -I=%GOPATH%\src -I=%GOPATH%\src\github.com\grpc-ecosystem\grpc-gateway\third_party\googleapis --go-grpc_out=paths=source_relative:. btrpc.proto
+protoc -I=. -I=%GOPATH%\src -I=%GOPATH%\src\github.com\grpc-ecosystem\grpc-gateway\third_party\googleapis --grpc-gateway_out=paths=source_relative,logtostderr=true:. btrpc.proto
+protoc -I=. -I=%GOPATH%\src -I=%GOPATH%\src\github.com\grpc-ecosystem\grpc-gateway\third_party\googleapis --openapiv2_out=logtostderr=true:. btrpc.proto
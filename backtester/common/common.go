@@ -9,6 +9,10 @@ func DataTypeToInt(dataType string) (int64, error) {
 		return DataCandle, nil
 	case TradeStr:
 		return DataTrade, nil
+	case OrderbookStr:
+		return DataOrderbook, nil
+	case TickStr:
+		return DataTick, nil
 	default:
 		return 0, fmt.Errorf("unrecognised dataType '%v'", dataType)
 	}
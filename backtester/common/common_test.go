@@ -22,6 +22,16 @@ func TestDataTypeConversion(t *testing.T) {
 			dataType: TradeStr,
 			want:     DataTrade,
 		},
+		{
+			title:    "Orderbook data type",
+			dataType: OrderbookStr,
+			want:     DataOrderbook,
+		},
+		{
+			title:    "Tick data type",
+			dataType: TickStr,
+			want:     DataTick,
+		},
 		{
 			title:     "Unknown data type",
 			dataType:  "unknown",
@@ -30,12 +30,18 @@ const (
 	CandleStr = "candle"
 	// TradeStr is a config readable data type to tell the backtester to retrieve trade data
 	TradeStr = "trade"
+	// OrderbookStr is a config readable data type to tell the backtester to replay recorded orderbook data
+	OrderbookStr = "orderbook"
+	// TickStr is a config readable data type to tell the backtester to stream individual trades
+	TickStr = "tick"
 )
 
 // DataCandle is an int64 representation of a candle data type
 const (
 	DataCandle = iota
 	DataTrade
+	DataOrderbook
+	DataTick
 )
 
 var (
@@ -8,10 +8,13 @@ import (
 	"strings"
 
 	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/sizing"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
@@ -42,19 +45,35 @@ func (c *Config) PrintSetting() {
 	log.Info(log.BackTester, "-------------------------------------------------------------")
 	log.Info(log.BackTester, "------------------Strategy Settings--------------------------")
 	log.Info(log.BackTester, "-------------------------------------------------------------")
-	log.Infof(log.BackTester, "Strategy: %s", c.StrategySettings.Name)
-	if len(c.StrategySettings.CustomSettings) > 0 {
-		log.Info(log.BackTester, "Custom strategy variables:")
-		for k, v := range c.StrategySettings.CustomSettings {
-			log.Infof(log.BackTester, "%s: %v", k, v)
+	if len(c.StrategySettings.Sleeves) == 0 {
+		log.Infof(log.BackTester, "Strategy: %s", c.StrategySettings.Name)
+		if len(c.StrategySettings.CustomSettings) > 0 {
+			log.Info(log.BackTester, "Custom strategy variables:")
+			for k, v := range c.StrategySettings.CustomSettings {
+				log.Infof(log.BackTester, "%s: %v", k, v)
+			}
+		} else {
+			log.Info(log.BackTester, "Custom strategy variables: unset")
+		}
+		log.Infof(log.BackTester, "Simultaneous Signal Processing: %v", c.StrategySettings.SimultaneousSignalProcessing)
+	}
+	for i := range c.StrategySettings.Sleeves {
+		log.Infof(log.BackTester, "Sleeve %v: strategy %v weight %v simultaneous signal processing %v",
+			c.StrategySettings.Sleeves[i].Name,
+			c.StrategySettings.Sleeves[i].StrategyName,
+			c.StrategySettings.Sleeves[i].Weight,
+			c.StrategySettings.Sleeves[i].SimultaneousSignalProcessing)
+		for k, v := range c.StrategySettings.Sleeves[i].CustomSettings {
+			log.Infof(log.BackTester, "Sleeve %v %s: %v", c.StrategySettings.Sleeves[i].Name, k, v)
 		}
-	} else {
-		log.Info(log.BackTester, "Custom strategy variables: unset")
 	}
-	log.Infof(log.BackTester, "Simultaneous Signal Processing: %v", c.StrategySettings.SimultaneousSignalProcessing)
+	if c.StrategySettings.SleeveRebalancing != nil {
+		log.Infof(log.BackTester, "Sleeve rebalance interval: %v", c.StrategySettings.SleeveRebalancing.Interval)
+	}
 	log.Infof(log.BackTester, "Use Exchange Level Funding: %v", c.StrategySettings.UseExchangeLevelFunding)
 	log.Infof(log.BackTester, "USD value tracking: %v", !c.StrategySettings.DisableUSDTracking)
-	if c.StrategySettings.UseExchangeLevelFunding && c.StrategySettings.SimultaneousSignalProcessing {
+	if c.StrategySettings.UseExchangeLevelFunding &&
+		(c.StrategySettings.SimultaneousSignalProcessing || len(c.StrategySettings.Sleeves) > 0) {
 		log.Info(log.BackTester, "-------------------------------------------------------------")
 		log.Info(log.BackTester, "------------------Funding Settings---------------------------")
 		for i := range c.StrategySettings.ExchangeLevelFunding {
@@ -94,6 +113,11 @@ func (c *Config) PrintSetting() {
 		log.Infof(log.BackTester, "Buy rules: %+v", c.CurrencySettings[i].BuySide)
 		log.Infof(log.BackTester, "Sell rules: %+v", c.CurrencySettings[i].SellSide)
 		log.Infof(log.BackTester, "Leverage rules: %+v", c.CurrencySettings[i].Leverage)
+		if c.CurrencySettings[i].FuturesDetails != nil {
+			log.Infof(log.BackTester, "Futures leverage: %v", c.CurrencySettings[i].FuturesDetails.Leverage.Round(8))
+			log.Infof(log.BackTester, "Maintenance margin rate: %v", c.CurrencySettings[i].FuturesDetails.MaintenanceMarginRate.Round(8))
+			log.Infof(log.BackTester, "Funding rate: %v every %v", c.CurrencySettings[i].FuturesDetails.FundingRate.Round(8), c.CurrencySettings[i].FuturesDetails.FundingInterval)
+		}
 		log.Infof(log.BackTester, "Can use exchange defined order execution limits: %+v", c.CurrencySettings[i].CanUseExchangeLimits)
 	}
 
@@ -103,6 +127,21 @@ func (c *Config) PrintSetting() {
 	log.Infof(log.BackTester, "Buy rules: %+v", c.PortfolioSettings.BuySide)
 	log.Infof(log.BackTester, "Sell rules: %+v", c.PortfolioSettings.SellSide)
 	log.Infof(log.BackTester, "Leverage rules: %+v", c.PortfolioSettings.Leverage)
+	if ps := c.PortfolioSettings.PositionSizing; ps != nil {
+		log.Infof(log.BackTester, "Position sizing model: %v", ps.Model)
+		switch ps.Model {
+		case sizing.VolatilityTarget:
+			log.Infof(log.BackTester, "Target volatility: %v", ps.TargetVolatility)
+		case sizing.Kelly:
+			log.Infof(log.BackTester, "Fraction until %v trades close: %v", ps.KellyMinimumTrades, ps.Fraction)
+			log.Infof(log.BackTester, "Kelly multiplier: %v", ps.KellyMultiplier)
+		default:
+			log.Infof(log.BackTester, "Fraction: %v", ps.Fraction)
+		}
+		if ps.Model == sizing.VolatilityTarget || ps.Model == sizing.RiskParity {
+			log.Infof(log.BackTester, "ATR period: %v", ps.ATRPeriod)
+		}
+	}
 	if c.DataSettings.LiveData != nil {
 		log.Info(log.BackTester, "-------------------------------------------------------------")
 		log.Info(log.BackTester, "------------------Live Settings------------------------------")
@@ -111,6 +150,15 @@ func (c *Config) PrintSetting() {
 		log.Infof(log.BackTester, "Interval: %v", c.DataSettings.Interval)
 		log.Infof(log.BackTester, "REAL ORDERS: %v", c.DataSettings.LiveData.RealOrders)
 		log.Infof(log.BackTester, "Overriding GCT API settings: %v", c.DataSettings.LiveData.APIClientIDOverride != "")
+		if c.DataSettings.LiveData.DataCheckTimer > 0 {
+			log.Infof(log.BackTester, "Data check timer: %v", c.DataSettings.LiveData.DataCheckTimer)
+		}
+		if c.DataSettings.LiveData.NewEventTimeout > 0 {
+			log.Infof(log.BackTester, "New event timeout: %v", c.DataSettings.LiveData.NewEventTimeout)
+		}
+		if c.DataSettings.LiveData.SessionPath != "" {
+			log.Infof(log.BackTester, "Session path: %v", c.DataSettings.LiveData.SessionPath)
+		}
 	}
 	if c.DataSettings.APIData != nil {
 		log.Info(log.BackTester, "-------------------------------------------------------------")
@@ -128,6 +176,9 @@ func (c *Config) PrintSetting() {
 		log.Infof(log.BackTester, "Data type: %v", c.DataSettings.DataType)
 		log.Infof(log.BackTester, "Interval: %v", c.DataSettings.Interval)
 		log.Infof(log.BackTester, "CSV file: %v", c.DataSettings.CSVData.FullPath)
+		if c.DataSettings.TickChunkSize > 0 {
+			log.Infof(log.BackTester, "Tick chunk size: %v", c.DataSettings.TickChunkSize)
+		}
 	}
 	if c.DataSettings.DatabaseData != nil {
 		log.Info(log.BackTester, "-------------------------------------------------------------")
@@ -137,6 +188,69 @@ func (c *Config) PrintSetting() {
 		log.Infof(log.BackTester, "Interval: %v", c.DataSettings.Interval)
 		log.Infof(log.BackTester, "Start date: %v", c.DataSettings.DatabaseData.StartDate.Format(gctcommon.SimpleTimeFormat))
 		log.Infof(log.BackTester, "End date: %v", c.DataSettings.DatabaseData.EndDate.Format(gctcommon.SimpleTimeFormat))
+		if c.DataSettings.TickChunkSize > 0 {
+			log.Infof(log.BackTester, "Tick chunk size: %v", c.DataSettings.TickChunkSize)
+		}
+	}
+	if c.DataSettings.OrderbookData != nil {
+		log.Info(log.BackTester, "-------------------------------------------------------------")
+		log.Info(log.BackTester, "------------------Orderbook Settings-------------------------")
+		log.Info(log.BackTester, "-------------------------------------------------------------")
+		log.Infof(log.BackTester, "Data type: %v", c.DataSettings.DataType)
+		log.Infof(log.BackTester, "Interval: %v", c.DataSettings.Interval)
+		log.Infof(log.BackTester, "Orderbook file: %v", c.DataSettings.OrderbookData.FullPath)
+	}
+	if c.OptimisationSettings != nil {
+		log.Info(log.BackTester, "-------------------------------------------------------------")
+		log.Info(log.BackTester, "------------------Optimisation Settings----------------------")
+		log.Info(log.BackTester, "-------------------------------------------------------------")
+		log.Infof(log.BackTester, "Method: %v", c.OptimisationSettings.Method)
+		log.Infof(log.BackTester, "Metric: %v", c.OptimisationSettings.Metric)
+		for i := range c.OptimisationSettings.Parameters {
+			log.Infof(log.BackTester, "Parameter %v: %v to %v in steps of %v",
+				c.OptimisationSettings.Parameters[i].Name,
+				c.OptimisationSettings.Parameters[i].Minimum,
+				c.OptimisationSettings.Parameters[i].Maximum,
+				c.OptimisationSettings.Parameters[i].Step)
+		}
+		if c.OptimisationSettings.WalkForward != nil {
+			log.Infof(log.BackTester, "Walk forward in-sample window: %v", c.OptimisationSettings.WalkForward.InSampleWindow)
+			log.Infof(log.BackTester, "Walk forward out-of-sample window: %v", c.OptimisationSettings.WalkForward.OutOfSampleWindow)
+			log.Infof(log.BackTester, "Walk forward anchored: %v", c.OptimisationSettings.WalkForward.Anchored)
+		}
+	}
+	if c.StatisticSettings.Benchmark != nil {
+		log.Info(log.BackTester, "-------------------------------------------------------------")
+		log.Info(log.BackTester, "------------------Benchmark Settings-------------------------")
+		log.Info(log.BackTester, "-------------------------------------------------------------")
+		log.Infof(log.BackTester, "Type: %v", c.StatisticSettings.Benchmark.Type)
+		for i := range c.StatisticSettings.Benchmark.Components {
+			log.Infof(log.BackTester, "Component: %v %v %v-%v weight %v",
+				c.StatisticSettings.Benchmark.Components[i].ExchangeName,
+				c.StatisticSettings.Benchmark.Components[i].Asset,
+				c.StatisticSettings.Benchmark.Components[i].Base,
+				c.StatisticSettings.Benchmark.Components[i].Quote,
+				c.StatisticSettings.Benchmark.Components[i].Weight)
+		}
+		if c.StatisticSettings.Benchmark.CSVFilePath != "" {
+			log.Infof(log.BackTester, "CSV index file: %v", c.StatisticSettings.Benchmark.CSVFilePath)
+		}
+	}
+	if c.StatisticSettings.MonteCarlo != nil {
+		log.Info(log.BackTester, "-------------------------------------------------------------")
+		log.Info(log.BackTester, "------------------Monte Carlo Settings-----------------------")
+		log.Info(log.BackTester, "-------------------------------------------------------------")
+		log.Infof(log.BackTester, "Iterations: %v", c.StatisticSettings.MonteCarlo.Iterations)
+		log.Infof(log.BackTester, "Confidence level: %v", c.StatisticSettings.MonteCarlo.ConfidenceLevel)
+		if c.StatisticSettings.MonteCarlo.Seed != 0 {
+			log.Infof(log.BackTester, "Seed: %v", c.StatisticSettings.MonteCarlo.Seed)
+		}
+	}
+	if c.ReportSettings != nil {
+		log.Info(log.BackTester, "-------------------------------------------------------------")
+		log.Info(log.BackTester, "------------------Report Settings----------------------------")
+		log.Info(log.BackTester, "-------------------------------------------------------------")
+		log.Infof(log.BackTester, "Export formats: %v", strings.Join(c.ReportSettings.ExportFormats, ", "))
 	}
 	log.Info(log.BackTester, "-------------------------------------------------------------\n\n")
 }
@@ -147,6 +261,34 @@ func (c *Config) Validate() error {
 	if err != nil {
 		return err
 	}
+	err = c.validateOrderbookData()
+	if err != nil {
+		return err
+	}
+	err = c.validateTickData()
+	if err != nil {
+		return err
+	}
+	err = c.validateLiveData()
+	if err != nil {
+		return err
+	}
+	err = c.validateOptimisationSettings()
+	if err != nil {
+		return err
+	}
+	err = c.validateReportSettings()
+	if err != nil {
+		return err
+	}
+	err = c.validateStatisticSettings()
+	if err != nil {
+		return err
+	}
+	err = c.validatePositionSizing()
+	if err != nil {
+		return err
+	}
 	err = c.validateStrategySettings()
 	if err != nil {
 		return err
@@ -207,9 +349,22 @@ func (c *Config) validateMinMaxes() (err error) {
 }
 
 func (c *Config) validateStrategySettings() error {
+	if len(c.StrategySettings.Sleeves) > 0 || c.StrategySettings.SleeveRebalancing != nil {
+		return c.validateSleeveSettings()
+	}
 	if c.StrategySettings.UseExchangeLevelFunding && !c.StrategySettings.SimultaneousSignalProcessing {
 		return errSimultaneousProcessingRequired
 	}
+	err := c.validateExchangeLevelFunding()
+	if err != nil {
+		return err
+	}
+	return validateStrategyName(c.StrategySettings.Name)
+}
+
+// validateExchangeLevelFunding ensures exchange level funding is only set
+// when enabled and has valid initial funds
+func (c *Config) validateExchangeLevelFunding() error {
 	if len(c.StrategySettings.ExchangeLevelFunding) > 0 && !c.StrategySettings.UseExchangeLevelFunding {
 		return errExchangeLevelFundingRequired
 	}
@@ -228,14 +383,63 @@ func (c *Config) validateStrategySettings() error {
 			}
 		}
 	}
+	return nil
+}
+
+// validateStrategyName ensures the strategy can be loaded
+func validateStrategyName(name string) error {
 	strats := strategies.GetStrategies()
 	for i := range strats {
-		if strings.EqualFold(strats[i].Name(), c.StrategySettings.Name) {
+		if strings.EqualFold(strats[i].Name(), name) {
 			return nil
 		}
 	}
 
-	return fmt.Errorf("strategty %v %w", c.StrategySettings.Name, base.ErrStrategyNotFound)
+	return fmt.Errorf("strategty %v %w", name, base.ErrStrategyNotFound)
+}
+
+// validateSleeveSettings ensures each strategy sleeve can be loaded with its
+// own share of funding and that sleeves can be rebalanced
+func (c *Config) validateSleeveSettings() error {
+	s := c.StrategySettings
+	if c.DataSettings.LiveData != nil || c.OptimisationSettings != nil {
+		return errStrategySleevesUnsupported
+	}
+	if s.SleeveRebalancing != nil {
+		if len(s.Sleeves) < 2 {
+			return errSleeveRebalancingRequiresSleeves
+		}
+		if s.SleeveRebalancing.Interval <= 0 {
+			return errBadSleeveRebalanceInterval
+		}
+		if s.DisableUSDTracking {
+			return errSleeveRebalancingUSDTracking
+		}
+	}
+	if s.Name != "" || len(s.CustomSettings) > 0 {
+		return errStrategySleeveAmbiguous
+	}
+	for i := range s.Sleeves {
+		if s.Sleeves[i].Name == "" {
+			return fmt.Errorf("%w sleeve %v has no name", errBadStrategySleeve, i)
+		}
+		for j := range s.Sleeves[:i] {
+			if strings.EqualFold(s.Sleeves[i].Name, s.Sleeves[j].Name) {
+				return fmt.Errorf("%w sleeve '%v' is duplicated", errBadStrategySleeve, s.Sleeves[i].Name)
+			}
+		}
+		if !s.Sleeves[i].Weight.IsPositive() {
+			return fmt.Errorf("%w received %v for sleeve '%v'", errBadStrategySleeveWeight, s.Sleeves[i].Weight, s.Sleeves[i].Name)
+		}
+		if s.UseExchangeLevelFunding && !s.Sleeves[i].SimultaneousSignalProcessing {
+			return fmt.Errorf("sleeve '%v' %w", s.Sleeves[i].Name, errSimultaneousProcessingRequired)
+		}
+		err := validateStrategyName(s.Sleeves[i].StrategyName)
+		if err != nil {
+			return fmt.Errorf("sleeve '%v' %w", s.Sleeves[i].Name, err)
+		}
+	}
+	return c.validateExchangeLevelFunding()
 }
 
 // validateDate checks whether someone has set a date poorly in their config
@@ -263,6 +467,261 @@ func (c *Config) validateDate() error {
 	return nil
 }
 
+// validateOrderbookData ensures recorded orderbook data is the only data
+// source and is paired with the orderbook data type
+func (c *Config) validateOrderbookData() error {
+	if c.DataSettings.OrderbookData == nil {
+		if c.DataSettings.DataType == common.OrderbookStr {
+			return errOrderbookDataTypeMismatch
+		}
+		return nil
+	}
+	if c.DataSettings.APIData != nil ||
+		c.DataSettings.CSVData != nil ||
+		c.DataSettings.DatabaseData != nil ||
+		c.DataSettings.LiveData != nil {
+		return errOrderbookDataAmbiguous
+	}
+	if c.DataSettings.DataType != common.OrderbookStr {
+		return errOrderbookDataTypeMismatch
+	}
+	if c.DataSettings.OrderbookData.FullPath == "" {
+		return errOrderbookPathUnset
+	}
+	return nil
+}
+
+// validateTickData ensures tick data is streamed from a supported data source
+// and is only used where events can be processed one trade at a time
+func (c *Config) validateTickData() error {
+	if c.DataSettings.TickChunkSize < 0 {
+		return errBadTickChunkSize
+	}
+	if c.DataSettings.DataType != common.TickStr {
+		return nil
+	}
+	if c.DataSettings.CSVData == nil && c.DataSettings.DatabaseData == nil {
+		return errTickDataSourceUnsupported
+	}
+	if !c.StrategySettings.DisableUSDTracking {
+		return errTickDataUSDTracking
+	}
+	if c.StrategySettings.SimultaneousSignalProcessing ||
+		len(c.StrategySettings.Sleeves) > 0 ||
+		c.OptimisationSettings != nil {
+		return errTickDataUnsupportedStrategy
+	}
+	return nil
+}
+
+// validateLiveData ensures live data timers can be used with the candle interval
+func (c *Config) validateLiveData() error {
+	l := c.DataSettings.LiveData
+	if l == nil {
+		return nil
+	}
+	if c.DataSettings.Interval <= 0 {
+		return errLiveDataIntervalUnset
+	}
+	if l.DataCheckTimer < 0 || l.NewEventTimeout < 0 {
+		return errBadLiveDataTimer
+	}
+	if l.NewEventTimeout > 0 && l.NewEventTimeout < c.DataSettings.Interval {
+		return fmt.Errorf("%w received %v for interval %v", errLiveDataTimeoutTooShort, l.NewEventTimeout, c.DataSettings.Interval)
+	}
+	return nil
+}
+
+// validateReportSettings ensures only supported export formats are requested
+func (c *Config) validateReportSettings() error {
+	if c.ReportSettings == nil {
+		return nil
+	}
+	for i := range c.ReportSettings.ExportFormats {
+		switch c.ReportSettings.ExportFormats[i] {
+		case CSVExport, JSONLinesExport, ColumnarExport:
+		default:
+			return fmt.Errorf("%w %v", errUnsupportedExportFormat, c.ReportSettings.ExportFormats[i])
+		}
+		for j := range c.ReportSettings.ExportFormats[:i] {
+			if c.ReportSettings.ExportFormats[i] == c.ReportSettings.ExportFormats[j] {
+				return fmt.Errorf("%w %v", errDuplicateExportFormat, c.ReportSettings.ExportFormats[i])
+			}
+		}
+	}
+	return nil
+}
+
+// validateStatisticSettings ensures the benchmark can be created from the
+// loaded currency data or its csv index
+// validatePositionSizing checks the position sizing model can be set up
+// and that risk-parity is able to split funds between pairs
+func (c *Config) validatePositionSizing() error {
+	ps := c.PortfolioSettings.PositionSizing
+	if ps == nil {
+		return nil
+	}
+	_, err := sizing.Setup(sizing.Settings{
+		Model:              ps.Model,
+		Fraction:           ps.Fraction,
+		TargetVolatility:   ps.TargetVolatility,
+		ATRPeriod:          ps.ATRPeriod,
+		KellyMultiplier:    ps.KellyMultiplier,
+		KellyMinimumTrades: ps.KellyMinimumTrades,
+	})
+	if err != nil {
+		return fmt.Errorf("%w %v", errBadPositionSizing, err)
+	}
+	if ps.Model != sizing.RiskParity {
+		return nil
+	}
+	if !c.StrategySettings.UseExchangeLevelFunding {
+		return errRiskParityUnsupported
+	}
+	if len(c.StrategySettings.Sleeves) == 0 {
+		if !c.StrategySettings.SimultaneousSignalProcessing {
+			return errRiskParityUnsupported
+		}
+		return nil
+	}
+	for i := range c.StrategySettings.Sleeves {
+		if !c.StrategySettings.Sleeves[i].SimultaneousSignalProcessing {
+			return fmt.Errorf("%w sleeve %v", errRiskParityUnsupported, c.StrategySettings.Sleeves[i].Name)
+		}
+	}
+	return nil
+}
+
+func (c *Config) validateStatisticSettings() error {
+	if c.StatisticSettings.RollingWindow < 0 {
+		return errBadRollingWindow
+	}
+	if mc := c.StatisticSettings.MonteCarlo; mc != nil {
+		if mc.Iterations < 0 {
+			return errBadMonteCarloIterations
+		}
+		if mc.ConfidenceLevel.IsNegative() || mc.ConfidenceLevel.GreaterThanOrEqual(decimal.NewFromInt(1)) {
+			return fmt.Errorf("%w received %v", errBadMonteCarloConfidenceLevel, mc.ConfidenceLevel)
+		}
+	}
+	b := c.StatisticSettings.Benchmark
+	if b == nil {
+		return nil
+	}
+	switch b.Type {
+	case BuyAndHoldBenchmark:
+		if len(b.Components) != 1 {
+			return fmt.Errorf("%w buy-and-hold requires one component, received %v", errBadBenchmarkComponents, len(b.Components))
+		}
+	case BasketBenchmark:
+		if len(b.Components) == 0 {
+			return fmt.Errorf("%w basket requires components", errBadBenchmarkComponents)
+		}
+		for i := range b.Components {
+			if !b.Components[i].Weight.IsPositive() {
+				return fmt.Errorf("%w received %v for component %v", errBadBenchmarkWeight, b.Components[i].Weight, i)
+			}
+		}
+	case CSVIndexBenchmark:
+		if len(b.Components) > 0 {
+			return fmt.Errorf("%w csv-index cannot have components", errBadBenchmarkComponents)
+		}
+		if b.CSVFilePath == "" {
+			return errBenchmarkPathUnset
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w %v", errUnknownBenchmarkType, b.Type)
+	}
+	for i := range b.Components {
+		if !c.hasCurrencySetting(b.Components[i].ExchangeName, b.Components[i].Asset, b.Components[i].Base, b.Components[i].Quote) {
+			return fmt.Errorf("%w %v %v %v-%v", errBenchmarkComponentNotLoaded,
+				b.Components[i].ExchangeName,
+				b.Components[i].Asset,
+				b.Components[i].Base,
+				b.Components[i].Quote)
+		}
+		for j := range b.Components[:i] {
+			if strings.EqualFold(b.Components[i].ExchangeName, b.Components[j].ExchangeName) &&
+				strings.EqualFold(b.Components[i].Asset, b.Components[j].Asset) &&
+				strings.EqualFold(b.Components[i].Base, b.Components[j].Base) &&
+				strings.EqualFold(b.Components[i].Quote, b.Components[j].Quote) {
+				return fmt.Errorf("%w duplicate component %v", errBadBenchmarkComponents, i)
+			}
+		}
+	}
+	return nil
+}
+
+// hasCurrencySetting returns whether the exchange, asset and pair are set in
+// the currency settings
+func (c *Config) hasCurrencySetting(exch, a, base, quote string) bool {
+	for i := range c.CurrencySettings {
+		if strings.EqualFold(c.CurrencySettings[i].ExchangeName, exch) &&
+			strings.EqualFold(c.CurrencySettings[i].Asset, a) &&
+			strings.EqualFold(c.CurrencySettings[i].Base, base) &&
+			strings.EqualFold(c.CurrencySettings[i].Quote, quote) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateOptimisationSettings ensures parameter ranges can be searched
+// and that walk forward windows align with the data interval
+func (c *Config) validateOptimisationSettings() error {
+	o := c.OptimisationSettings
+	if o == nil {
+		return nil
+	}
+	if c.DataSettings.LiveData != nil {
+		return errOptimisationLiveData
+	}
+	switch o.Method {
+	case GridSearch:
+	case RandomSearch:
+		if o.Iterations <= 0 {
+			return errBadOptimisationIterations
+		}
+	default:
+		return fmt.Errorf("%w '%v'", errUnknownOptimisationMethod, o.Method)
+	}
+	switch o.Metric {
+	case SharpeRatioMetric, SortinoRatioMetric, CalmarRatioMetric, CAGRMetric, MaxDrawdownMetric, StrategyMovementMetric:
+	default:
+		return fmt.Errorf("%w '%v'", errUnknownOptimisationMetric, o.Metric)
+	}
+	if len(o.Parameters) == 0 {
+		return errNoOptimisationParameters
+	}
+	names := make(map[string]bool)
+	for i := range o.Parameters {
+		p := o.Parameters[i]
+		switch {
+		case p.Name == "":
+			return fmt.Errorf("%w parameter %v has no name", errBadOptimisationParameter, i)
+		case names[p.Name]:
+			return fmt.Errorf("%w parameter '%v' is duplicated", errBadOptimisationParameter, p.Name)
+		case p.Maximum.LessThan(p.Minimum):
+			return fmt.Errorf("%w parameter '%v' maximum %v is less than minimum %v", errBadOptimisationParameter, p.Name, p.Maximum, p.Minimum)
+		case !p.Step.IsPositive():
+			return fmt.Errorf("%w parameter '%v' step must be positive", errBadOptimisationParameter, p.Name)
+		}
+		names[p.Name] = true
+	}
+	if o.WalkForward != nil {
+		interval := c.DataSettings.Interval
+		if interval <= 0 ||
+			o.WalkForward.InSampleWindow <= 0 ||
+			o.WalkForward.OutOfSampleWindow <= 0 ||
+			o.WalkForward.InSampleWindow%interval != 0 ||
+			o.WalkForward.OutOfSampleWindow%interval != 0 {
+			return errBadWalkForwardWindow
+		}
+	}
+	return nil
+}
+
 // validateCurrencySettings checks whether someone has set invalid currency setting data in their config
 func (c *Config) validateCurrencySettings() error {
 	if len(c.CurrencySettings) == 0 {
@@ -318,7 +777,38 @@ func (c *Config) validateCurrencySettings() error {
 			c.CurrencySettings[i].MinimumSlippagePercent.GreaterThan(c.CurrencySettings[i].MaximumSlippagePercent) {
 			return errBadSlippageRates
 		}
+		if c.CurrencySettings[i].FuturesDetails != nil {
+			err := c.CurrencySettings[i].validateFuturesDetails()
+			if err != nil {
+				return err
+			}
+		}
 		c.CurrencySettings[i].ExchangeName = strings.ToLower(c.CurrencySettings[i].ExchangeName)
 	}
 	return nil
 }
+
+// validateFuturesDetails ensures the leveraged position can be simulated
+func (c *CurrencySettings) validateFuturesDetails() error {
+	a, err := asset.New(c.Asset)
+	if err != nil {
+		return fmt.Errorf("%v %w", c.Asset, errFuturesAssetRequired)
+	}
+	if !a.IsFutures() && a != asset.Margin {
+		return fmt.Errorf("%v %w", a, errFuturesAssetRequired)
+	}
+	if c.FuturesDetails.Leverage.LessThan(decimal.NewFromInt(1)) {
+		return errBadFuturesLeverage
+	}
+	if c.FuturesDetails.Leverage.GreaterThan(decimal.NewFromInt(1)) && !c.Leverage.CanUseLeverage {
+		return errLeverageNotAllowed
+	}
+	if c.FuturesDetails.MaintenanceMarginRate.IsNegative() ||
+		c.FuturesDetails.MaintenanceMarginRate.GreaterThanOrEqual(decimal.NewFromInt(1).Div(c.FuturesDetails.Leverage)) {
+		return errBadMaintenanceMarginRate
+	}
+	if c.FuturesDetails.FundingInterval < 0 {
+		return errBadFundingInterval
+	}
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/sizing"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/top2bottom2"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -154,6 +155,9 @@ func TestPrintSettings(t *testing.T) {
 		StatisticSettings: StatisticSettings{
 			RiskFreeRate: decimal.NewFromFloat(0.03),
 		},
+		ReportSettings: &ReportSettings{
+			ExportFormats: []string{CSVExport, JSONLinesExport, ColumnarExport},
+		},
 	}
 	cfg.PrintSetting()
 }
@@ -436,6 +440,257 @@ func TestGenerateConfigForDCAAPICandlesMultipleCurrencies(t *testing.T) {
 	}
 }
 
+func TestGenerateConfigForDCAAPICandlesBenchmark(t *testing.T) {
+	cfg := Config{
+		Nickname: "ExampleStrategyDCAAPICandlesBenchmark",
+		Goal:     "To demonstrate measuring the DCA strategy against a weighted basket benchmark of BTC and ETH",
+		StrategySettings: StrategySettings{
+			Name: dca,
+		},
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName:      testExchange,
+				Asset:             asset.Spot.String(),
+				Base:              currency.BTC.String(),
+				Quote:             currency.USDT.String(),
+				InitialQuoteFunds: initialQuoteFunds2,
+				BuySide:           minMax,
+				SellSide:          minMax,
+				Leverage: Leverage{
+					CanUseLeverage: false,
+				},
+				MakerFee: makerFee,
+				TakerFee: takerFee,
+			},
+			{
+				ExchangeName:      testExchange,
+				Asset:             asset.Spot.String(),
+				Base:              currency.ETH.String(),
+				Quote:             currency.USDT.String(),
+				InitialQuoteFunds: initialQuoteFunds2,
+				BuySide:           minMax,
+				SellSide:          minMax,
+				Leverage: Leverage{
+					CanUseLeverage: false,
+				},
+				MakerFee: makerFee,
+				TakerFee: takerFee,
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.OneDay.Duration(),
+			DataType: common.CandleStr,
+			APIData: &APIData{
+				StartDate:        startDate,
+				EndDate:          endDate,
+				InclusiveEndDate: false,
+			},
+		},
+		PortfolioSettings: PortfolioSettings{
+			BuySide:  minMax,
+			SellSide: minMax,
+			Leverage: Leverage{
+				CanUseLeverage: false,
+			},
+		},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate:  decimal.NewFromFloat(0.03),
+			RollingWindow: 14,
+			Benchmark: &BenchmarkSettings{
+				Type: BasketBenchmark,
+				Components: []BenchmarkComponent{
+					{
+						ExchangeName: testExchange,
+						Asset:        asset.Spot.String(),
+						Base:         currency.BTC.String(),
+						Quote:        currency.USDT.String(),
+						Weight:       decimal.NewFromFloat(0.6),
+					},
+					{
+						ExchangeName: testExchange,
+						Asset:        asset.Spot.String(),
+						Base:         currency.ETH.String(),
+						Quote:        currency.USDT.String(),
+						Weight:       decimal.NewFromFloat(0.4),
+					},
+				},
+			},
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(filepath.Join(p, "examples", "dca-api-candles-benchmark.strat"), result, 0770)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestGenerateConfigForDCAAPICandlesMonteCarlo(t *testing.T) {
+	cfg := Config{
+		Nickname: "ExampleStrategyDCAAPICandlesMonteCarlo",
+		Goal:     "To demonstrate a Monte Carlo analysis of the DCA strategy's trades with randomised slippage",
+		StrategySettings: StrategySettings{
+			Name: dca,
+		},
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName:      testExchange,
+				Asset:             asset.Spot.String(),
+				Base:              currency.BTC.String(),
+				Quote:             currency.USDT.String(),
+				InitialQuoteFunds: initialQuoteFunds2,
+				BuySide:           minMax,
+				SellSide:          minMax,
+				Leverage: Leverage{
+					CanUseLeverage: false,
+				},
+				MakerFee:               makerFee,
+				TakerFee:               takerFee,
+				MinimumSlippagePercent: decimal.NewFromInt(95),
+				MaximumSlippagePercent: decimal.NewFromInt(100),
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.OneDay.Duration(),
+			DataType: common.CandleStr,
+			APIData: &APIData{
+				StartDate:        startDate,
+				EndDate:          endDate,
+				InclusiveEndDate: false,
+			},
+		},
+		PortfolioSettings: PortfolioSettings{
+			BuySide:  minMax,
+			SellSide: minMax,
+			Leverage: Leverage{
+				CanUseLeverage: false,
+			},
+		},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+			MonteCarlo: &MonteCarloSettings{
+				Iterations:      1000,
+				ConfidenceLevel: decimal.NewFromFloat(0.95),
+				Seed:            1337,
+			},
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(filepath.Join(p, "examples", "dca-api-candles-monte-carlo.strat"), result, 0770)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestGenerateConfigForDCARSIAPICandlesSleeves(t *testing.T) {
+	cfg := Config{
+		Nickname: "ExampleStrategyDCARSIAPICandlesSleeves",
+		Goal:     "To demonstrate DCA and RSI strategies sharing capital in sleeves which are rebalanced weekly",
+		StrategySettings: StrategySettings{
+			Sleeves: []SleeveSettings{
+				{
+					Name:         "dca-sleeve",
+					StrategyName: dca,
+					Weight:       decimal.NewFromFloat(0.6),
+				},
+				{
+					Name:         "rsi-sleeve",
+					StrategyName: "rsi",
+					Weight:       decimal.NewFromFloat(0.4),
+					CustomSettings: map[string]interface{}{
+						"rsi-low":    30.0,
+						"rsi-high":   70.0,
+						"rsi-period": 14,
+					},
+				},
+			},
+			SleeveRebalancing: &SleeveRebalanceSettings{
+				Interval: kline.OneWeek.Duration(),
+			},
+		},
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName:      testExchange,
+				Asset:             asset.Spot.String(),
+				Base:              currency.BTC.String(),
+				Quote:             currency.USDT.String(),
+				InitialQuoteFunds: initialQuoteFunds2,
+				BuySide:           minMax,
+				SellSide:          minMax,
+				Leverage: Leverage{
+					CanUseLeverage: false,
+				},
+				MakerFee: makerFee,
+				TakerFee: takerFee,
+			},
+			{
+				ExchangeName:      testExchange,
+				Asset:             asset.Spot.String(),
+				Base:              currency.ETH.String(),
+				Quote:             currency.USDT.String(),
+				InitialQuoteFunds: initialQuoteFunds2,
+				BuySide:           minMax,
+				SellSide:          minMax,
+				Leverage: Leverage{
+					CanUseLeverage: false,
+				},
+				MakerFee: makerFee,
+				TakerFee: takerFee,
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.OneDay.Duration(),
+			DataType: common.CandleStr,
+			APIData: &APIData{
+				StartDate:        startDate,
+				EndDate:          endDate,
+				InclusiveEndDate: false,
+			},
+		},
+		PortfolioSettings: PortfolioSettings{
+			BuySide:  minMax,
+			SellSide: minMax,
+			Leverage: Leverage{
+				CanUseLeverage: false,
+			},
+		},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(filepath.Join(p, "examples", "dca-rsi-api-candles-sleeves.strat"), result, 0770)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
 func TestGenerateConfigForDCAAPICandlesSimultaneousProcessing(t *testing.T) {
 	cfg := Config{
 		Nickname: "ExampleStrategyDCAAPICandlesSimultaneousProcessing",
@@ -503,17 +758,535 @@ func TestGenerateConfigForDCAAPICandlesSimultaneousProcessing(t *testing.T) {
 		if err != nil {
 			t.Fatal(err)
 		}
-		err = ioutil.WriteFile(filepath.Join(p, "examples", "dca-api-candles-simultaneous-processing.strat"), result, 0770)
+		err = ioutil.WriteFile(filepath.Join(p, "examples", "dca-api-candles-simultaneous-processing.strat"), result, 0770)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestGenerateConfigForDCALiveCandles(t *testing.T) {
+	cfg := Config{
+		Nickname: "ExampleStrategyDCALiveCandles",
+		Goal:     "To demonstrate paper trading multiple currencies against live candle data, saving the session so it can be resumed",
+		StrategySettings: StrategySettings{
+			Name:               dca,
+			DisableUSDTracking: true,
+		},
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName:      testExchange,
+				Asset:             asset.Spot.String(),
+				Base:              currency.BTC.String(),
+				Quote:             currency.USDT.String(),
+				InitialQuoteFunds: initialQuoteFunds2,
+				BuySide:           minMax,
+				SellSide:          minMax,
+				Leverage: Leverage{
+					CanUseLeverage: false,
+				},
+				MakerFee: makerFee,
+				TakerFee: takerFee,
+			},
+			{
+				ExchangeName:      testExchange,
+				Asset:             asset.Spot.String(),
+				Base:              currency.ETH.String(),
+				Quote:             currency.USDT.String(),
+				InitialQuoteFunds: initialQuoteFunds2,
+				BuySide:           minMax,
+				SellSide:          minMax,
+				Leverage: Leverage{
+					CanUseLeverage: false,
+				},
+				MakerFee: makerFee,
+				TakerFee: takerFee,
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.OneMin.Duration(),
+			DataType: common.CandleStr,
+			LiveData: &LiveData{
+				APIKeyOverride:        "",
+				APISecretOverride:     "",
+				APIClientIDOverride:   "",
+				API2FAOverride:        "",
+				APISubAccountOverride: "",
+				RealOrders:            false,
+				DataCheckTimer:        time.Second * 15,
+				NewEventTimeout:       time.Minute * 5,
+				SessionPath:           filepath.Join("results", "dca-candles-live-session.json"),
+			},
+		},
+		PortfolioSettings: PortfolioSettings{
+			BuySide:  minMax,
+			SellSide: minMax,
+			Leverage: Leverage{
+				CanUseLeverage: false,
+			},
+		},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(filepath.Join(p, "examples", "dca-candles-live.strat"), result, 0770)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestGenerateConfigForRSIAPICustomSettings(t *testing.T) {
+	cfg := Config{
+		Nickname: "TestGenerateRSICandleAPICustomSettingsStrat",
+		Goal:     "To demonstrate the RSI strategy using API candle data and custom settings",
+		StrategySettings: StrategySettings{
+			Name: "rsi",
+			CustomSettings: map[string]interface{}{
+				"rsi-low":    30.0,
+				"rsi-high":   70.0,
+				"rsi-period": 14,
+			},
+		},
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName:      testExchange,
+				Asset:             asset.Spot.String(),
+				Base:              currency.BTC.String(),
+				Quote:             currency.USDT.String(),
+				InitialQuoteFunds: initialQuoteFunds2,
+				BuySide:           minMax,
+				SellSide:          minMax,
+				Leverage: Leverage{
+					CanUseLeverage: false,
+				},
+				MakerFee: makerFee,
+				TakerFee: takerFee,
+			},
+			{
+				ExchangeName:      testExchange,
+				Asset:             asset.Spot.String(),
+				Base:              currency.ETH.String(),
+				Quote:             currency.USDT.String(),
+				InitialBaseFunds:  initialBaseFunds,
+				InitialQuoteFunds: initialQuoteFunds1,
+				BuySide:           minMax,
+				SellSide:          minMax,
+				Leverage: Leverage{
+					CanUseLeverage: false,
+				},
+				MakerFee: makerFee,
+				TakerFee: takerFee,
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.OneDay.Duration(),
+			DataType: common.CandleStr,
+			APIData: &APIData{
+				StartDate:        startDate,
+				EndDate:          endDate,
+				InclusiveEndDate: false,
+			},
+		},
+		PortfolioSettings: PortfolioSettings{
+			BuySide:  minMax,
+			SellSide: minMax,
+			Leverage: Leverage{
+				CanUseLeverage: false,
+			},
+		},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(filepath.Join(p, "examples", "rsi-api-candles.strat"), result, 0770)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestGenerateConfigForRSIAPIOptimisation(t *testing.T) {
+	cfg := Config{
+		Nickname: "ExampleStrategyRSIAPIOptimisation",
+		Goal:     "To demonstrate optimising the RSI strategy's custom settings using walk forward analysis",
+		StrategySettings: StrategySettings{
+			Name: "rsi",
+			CustomSettings: map[string]interface{}{
+				"rsi-period": 14,
+			},
+		},
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName:      testExchange,
+				Asset:             asset.Spot.String(),
+				Base:              currency.BTC.String(),
+				Quote:             currency.USDT.String(),
+				InitialQuoteFunds: initialQuoteFunds2,
+				BuySide:           minMax,
+				SellSide:          minMax,
+				Leverage: Leverage{
+					CanUseLeverage: false,
+				},
+				MakerFee: makerFee,
+				TakerFee: takerFee,
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.OneDay.Duration(),
+			DataType: common.CandleStr,
+			APIData: &APIData{
+				StartDate:        startDate,
+				EndDate:          endDate,
+				InclusiveEndDate: false,
+			},
+		},
+		PortfolioSettings: PortfolioSettings{
+			BuySide:  minMax,
+			SellSide: minMax,
+			Leverage: Leverage{
+				CanUseLeverage: false,
+			},
+		},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+		},
+		OptimisationSettings: &OptimisationSettings{
+			Method: GridSearch,
+			Metric: SharpeRatioMetric,
+			Parameters: []OptimisationParameter{
+				{
+					Name:    "rsi-low",
+					Minimum: decimal.NewFromInt(20),
+					Maximum: decimal.NewFromInt(40),
+					Step:    decimal.NewFromInt(5),
+				},
+				{
+					Name:    "rsi-high",
+					Minimum: decimal.NewFromInt(60),
+					Maximum: decimal.NewFromInt(80),
+					Step:    decimal.NewFromInt(5),
+				},
+			},
+			WalkForward: &WalkForward{
+				InSampleWindow:    kline.OneDay.Duration() * 60,
+				OutOfSampleWindow: kline.OneDay.Duration() * 30,
+			},
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(filepath.Join(p, "examples", "rsi-api-candles-optimisation.strat"), result, 0770)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestGenerateConfigForGCTScriptAPICandles(t *testing.T) {
+	cfg := Config{
+		Nickname: "ExampleStrategyGCTScriptAPICandles",
+		Goal:     "To demonstrate a strategy defined in gctscript, using simultaneous signal processing to calculate RSI values for multiple currencies",
+		StrategySettings: StrategySettings{
+			Name:                         "gctscript",
+			SimultaneousSignalProcessing: true,
+			CustomSettings: map[string]interface{}{
+				"script-path": filepath.Join("config", "examples", "scripts", "rsi.gct"),
+				"rsi-low":     30.0,
+				"rsi-high":    70.0,
+				"rsi-period":  14,
+			},
+		},
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName:      testExchange,
+				Asset:             asset.Spot.String(),
+				Base:              currency.BTC.String(),
+				Quote:             currency.USDT.String(),
+				InitialQuoteFunds: initialQuoteFunds2,
+				BuySide:           minMax,
+				SellSide:          minMax,
+				Leverage: Leverage{
+					CanUseLeverage: false,
+				},
+				MakerFee: makerFee,
+				TakerFee: takerFee,
+			},
+			{
+				ExchangeName:      testExchange,
+				Asset:             asset.Spot.String(),
+				Base:              currency.ETH.String(),
+				Quote:             currency.USDT.String(),
+				InitialBaseFunds:  initialBaseFunds,
+				InitialQuoteFunds: initialQuoteFunds1,
+				BuySide:           minMax,
+				SellSide:          minMax,
+				Leverage: Leverage{
+					CanUseLeverage: false,
+				},
+				MakerFee: makerFee,
+				TakerFee: takerFee,
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.OneDay.Duration(),
+			DataType: common.CandleStr,
+			APIData: &APIData{
+				StartDate:        startDate,
+				EndDate:          endDate,
+				InclusiveEndDate: false,
+			},
+		},
+		PortfolioSettings: PortfolioSettings{
+			BuySide:  minMax,
+			SellSide: minMax,
+			Leverage: Leverage{
+				CanUseLeverage: false,
+			},
+		},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(filepath.Join(p, "examples", "gctscript-api-candles.strat"), result, 0770)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestGenerateConfigForRSIAPIFuturesCandles(t *testing.T) {
+	cfg := Config{
+		Nickname: "ExampleStrategyRSIAPIFuturesCandles",
+		Goal:     "To demonstrate the RSI strategy trading a leveraged perpetual futures position with funding payments and liquidation",
+		StrategySettings: StrategySettings{
+			Name: "rsi",
+			CustomSettings: map[string]interface{}{
+				"rsi-low":    30.0,
+				"rsi-high":   70.0,
+				"rsi-period": 14,
+			},
+		},
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName:      testExchange,
+				Asset:             asset.USDTMarginedFutures.String(),
+				Base:              currency.BTC.String(),
+				Quote:             currency.USDT.String(),
+				InitialQuoteFunds: initialQuoteFunds2,
+				BuySide:           minMax,
+				SellSide:          minMax,
+				Leverage: Leverage{
+					CanUseLeverage:      true,
+					MaximumLeverageRate: decimal.NewFromInt(10),
+				},
+				FuturesDetails: &FuturesDetails{
+					Leverage:              decimal.NewFromInt(5),
+					MaintenanceMarginRate: decimal.NewFromFloat(0.05),
+					FundingRate:           decimal.NewFromFloat(0.0001),
+					FundingInterval:       time.Hour * 8,
+				},
+				MakerFee: decimal.NewFromFloat(0.0002),
+				TakerFee: decimal.NewFromFloat(0.0004),
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.OneHour.Duration(),
+			DataType: common.CandleStr,
+			APIData: &APIData{
+				StartDate:        startDate,
+				EndDate:          startDate.AddDate(0, 1, 0),
+				InclusiveEndDate: false,
+			},
+		},
+		PortfolioSettings: PortfolioSettings{
+			BuySide:  minMax,
+			SellSide: minMax,
+			Leverage: Leverage{
+				CanUseLeverage:      true,
+				MaximumLeverageRate: decimal.NewFromInt(10),
+			},
+		},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(filepath.Join(p, "examples", "rsi-api-futures-candles.strat"), result, 0770)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestGenerateConfigForDCACSVCandles(t *testing.T) {
+	fp := filepath.Join("..", "testdata", "binance_BTCUSDT_24h_2019_01_01_2020_01_01.csv")
+	cfg := Config{
+		Nickname: "ExampleStrategyDCACSVCandles",
+		Goal:     "To demonstrate the DCA strategy using CSV candle data",
+		StrategySettings: StrategySettings{
+			Name:               dca,
+			DisableUSDTracking: true,
+		},
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName:      testExchange,
+				Asset:             asset.Spot.String(),
+				Base:              currency.BTC.String(),
+				Quote:             currency.USDT.String(),
+				InitialQuoteFunds: initialQuoteFunds2,
+				BuySide:           minMax,
+				SellSide:          minMax,
+				Leverage: Leverage{
+					CanUseLeverage: false,
+				},
+				MakerFee: makerFee,
+				TakerFee: takerFee,
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.OneDay.Duration(),
+			DataType: common.CandleStr,
+			CSVData: &CSVData{
+				FullPath: fp,
+			},
+		},
+		PortfolioSettings: PortfolioSettings{
+			BuySide:  minMax,
+			SellSide: minMax,
+			Leverage: Leverage{
+				CanUseLeverage: false,
+			},
+		},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(filepath.Join(p, "examples", "dca-csv-candles.strat"), result, 0770)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestGenerateConfigForDCACSVCandlesExports(t *testing.T) {
+	fp := filepath.Join("..", "testdata", "binance_BTCUSDT_24h_2019_01_01_2020_01_01.csv")
+	cfg := Config{
+		Nickname: "ExampleStrategyDCACSVCandlesExports",
+		Goal:     "To demonstrate exporting the trade blotter, holdings curve and funding snapshots for analysis",
+		StrategySettings: StrategySettings{
+			Name:               dca,
+			DisableUSDTracking: true,
+		},
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName:      testExchange,
+				Asset:             asset.Spot.String(),
+				Base:              currency.BTC.String(),
+				Quote:             currency.USDT.String(),
+				InitialQuoteFunds: initialQuoteFunds2,
+				BuySide:           minMax,
+				SellSide:          minMax,
+				Leverage: Leverage{
+					CanUseLeverage: false,
+				},
+				MakerFee: makerFee,
+				TakerFee: takerFee,
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.OneDay.Duration(),
+			DataType: common.CandleStr,
+			CSVData: &CSVData{
+				FullPath: fp,
+			},
+		},
+		PortfolioSettings: PortfolioSettings{
+			BuySide:  minMax,
+			SellSide: minMax,
+			Leverage: Leverage{
+				CanUseLeverage: false,
+			},
+		},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+		},
+		ReportSettings: &ReportSettings{
+			ExportFormats: []string{CSVExport, JSONLinesExport, ColumnarExport},
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(filepath.Join(p, "examples", "dca-csv-candles-exports.strat"), result, 0770)
 		if err != nil {
 			t.Error(err)
 		}
 	}
 }
 
-func TestGenerateConfigForDCALiveCandles(t *testing.T) {
+func TestGenerateConfigForDCACSVCandlesVolatilitySizing(t *testing.T) {
+	fp := filepath.Join("..", "testdata", "binance_BTCUSDT_24h_2019_01_01_2020_01_01.csv")
 	cfg := Config{
-		Nickname: "ExampleStrategyDCALiveCandles",
-		Goal:     "To demonstrate live trading proof of concept against candle data",
+		Nickname: "ExampleStrategyDCACSVCandlesVolatilitySizing",
+		Goal:     "To demonstrate sizing DCA orders so each position carries the same ATR based risk",
 		StrategySettings: StrategySettings{
 			Name:               dca,
 			DisableUSDTracking: true,
@@ -535,15 +1308,10 @@ func TestGenerateConfigForDCALiveCandles(t *testing.T) {
 			},
 		},
 		DataSettings: DataSettings{
-			Interval: kline.OneMin.Duration(),
+			Interval: kline.OneDay.Duration(),
 			DataType: common.CandleStr,
-			LiveData: &LiveData{
-				APIKeyOverride:        "",
-				APISecretOverride:     "",
-				APIClientIDOverride:   "",
-				API2FAOverride:        "",
-				APISubAccountOverride: "",
-				RealOrders:            false,
+			CSVData: &CSVData{
+				FullPath: fp,
 			},
 		},
 		PortfolioSettings: PortfolioSettings{
@@ -552,6 +1320,11 @@ func TestGenerateConfigForDCALiveCandles(t *testing.T) {
 			Leverage: Leverage{
 				CanUseLeverage: false,
 			},
+			PositionSizing: &PositionSizingSettings{
+				Model:            sizing.VolatilityTarget,
+				TargetVolatility: decimal.NewFromFloat(0.01),
+				ATRPeriod:        14,
+			},
 		},
 		StatisticSettings: StatisticSettings{
 			RiskFreeRate: decimal.NewFromFloat(0.03),
@@ -566,24 +1339,21 @@ func TestGenerateConfigForDCALiveCandles(t *testing.T) {
 		if err != nil {
 			t.Fatal(err)
 		}
-		err = ioutil.WriteFile(filepath.Join(p, "examples", "dca-candles-live.strat"), result, 0770)
+		err = ioutil.WriteFile(filepath.Join(p, "examples", "dca-csv-candles-volatility-sizing.strat"), result, 0770)
 		if err != nil {
 			t.Error(err)
 		}
 	}
 }
 
-func TestGenerateConfigForRSIAPICustomSettings(t *testing.T) {
+func TestGenerateConfigForDCACSVTrades(t *testing.T) {
+	fp := filepath.Join("..", "testdata", "binance_BTCUSDT_24h-trades_2020_11_16.csv")
 	cfg := Config{
-		Nickname: "TestGenerateRSICandleAPICustomSettingsStrat",
-		Goal:     "To demonstrate the RSI strategy using API candle data and custom settings",
+		Nickname: "ExampleStrategyDCACSVTrades",
+		Goal:     "To demonstrate the DCA strategy using CSV trade data",
 		StrategySettings: StrategySettings{
-			Name: "rsi",
-			CustomSettings: map[string]interface{}{
-				"rsi-low":    30.0,
-				"rsi-high":   70.0,
-				"rsi-period": 14,
-			},
+			Name:               dca,
+			DisableUSDTracking: true,
 		},
 		CurrencySettings: []CurrencySettings{
 			{
@@ -592,23 +1362,6 @@ func TestGenerateConfigForRSIAPICustomSettings(t *testing.T) {
 				Base:              currency.BTC.String(),
 				Quote:             currency.USDT.String(),
 				InitialQuoteFunds: initialQuoteFunds2,
-				BuySide:           minMax,
-				SellSide:          minMax,
-				Leverage: Leverage{
-					CanUseLeverage: false,
-				},
-				MakerFee: makerFee,
-				TakerFee: takerFee,
-			},
-			{
-				ExchangeName:      testExchange,
-				Asset:             asset.Spot.String(),
-				Base:              currency.ETH.String(),
-				Quote:             currency.USDT.String(),
-				InitialBaseFunds:  initialBaseFunds,
-				InitialQuoteFunds: initialQuoteFunds1,
-				BuySide:           minMax,
-				SellSide:          minMax,
 				Leverage: Leverage{
 					CanUseLeverage: false,
 				},
@@ -617,17 +1370,13 @@ func TestGenerateConfigForRSIAPICustomSettings(t *testing.T) {
 			},
 		},
 		DataSettings: DataSettings{
-			Interval: kline.OneDay.Duration(),
-			DataType: common.CandleStr,
-			APIData: &APIData{
-				StartDate:        startDate,
-				EndDate:          endDate,
-				InclusiveEndDate: false,
+			Interval: kline.OneMin.Duration(),
+			DataType: common.TradeStr,
+			CSVData: &CSVData{
+				FullPath: fp,
 			},
 		},
 		PortfolioSettings: PortfolioSettings{
-			BuySide:  minMax,
-			SellSide: minMax,
 			Leverage: Leverage{
 				CanUseLeverage: false,
 			},
@@ -645,18 +1394,18 @@ func TestGenerateConfigForRSIAPICustomSettings(t *testing.T) {
 		if err != nil {
 			t.Fatal(err)
 		}
-		err = ioutil.WriteFile(filepath.Join(p, "examples", "rsi-api-candles.strat"), result, 0770)
+		err = ioutil.WriteFile(filepath.Join(p, "examples", "dca-csv-trades.strat"), result, 0770)
 		if err != nil {
 			t.Error(err)
 		}
 	}
 }
 
-func TestGenerateConfigForDCACSVCandles(t *testing.T) {
-	fp := filepath.Join("..", "testdata", "binance_BTCUSDT_24h_2019_01_01_2020_01_01.csv")
+func TestGenerateConfigForDCACSVTicks(t *testing.T) {
+	fp := filepath.Join("..", "testdata", "binance_BTCUSDT_24h-trades_2020_11_16.csv")
 	cfg := Config{
-		Nickname: "ExampleStrategyDCACSVCandles",
-		Goal:     "To demonstrate the DCA strategy using CSV candle data",
+		Nickname: "ExampleStrategyDCACSVTicks",
+		Goal:     "To demonstrate the DCA strategy reacting to individual CSV trades",
 		StrategySettings: StrategySettings{
 			Name:               dca,
 			DisableUSDTracking: true,
@@ -668,8 +1417,6 @@ func TestGenerateConfigForDCACSVCandles(t *testing.T) {
 				Base:              currency.BTC.String(),
 				Quote:             currency.USDT.String(),
 				InitialQuoteFunds: initialQuoteFunds2,
-				BuySide:           minMax,
-				SellSide:          minMax,
 				Leverage: Leverage{
 					CanUseLeverage: false,
 				},
@@ -678,15 +1425,14 @@ func TestGenerateConfigForDCACSVCandles(t *testing.T) {
 			},
 		},
 		DataSettings: DataSettings{
-			Interval: kline.OneDay.Duration(),
-			DataType: common.CandleStr,
+			Interval: kline.OneMin.Duration(),
+			DataType: common.TickStr,
 			CSVData: &CSVData{
 				FullPath: fp,
 			},
+			TickChunkSize: 250,
 		},
 		PortfolioSettings: PortfolioSettings{
-			BuySide:  minMax,
-			SellSide: minMax,
 			Leverage: Leverage{
 				CanUseLeverage: false,
 			},
@@ -704,18 +1450,18 @@ func TestGenerateConfigForDCACSVCandles(t *testing.T) {
 		if err != nil {
 			t.Fatal(err)
 		}
-		err = ioutil.WriteFile(filepath.Join(p, "examples", "dca-csv-candles.strat"), result, 0770)
+		err = ioutil.WriteFile(filepath.Join(p, "examples", "dca-csv-ticks.strat"), result, 0770)
 		if err != nil {
 			t.Error(err)
 		}
 	}
 }
 
-func TestGenerateConfigForDCACSVTrades(t *testing.T) {
-	fp := filepath.Join("..", "testdata", "binance_BTCUSDT_24h-trades_2020_11_16.csv")
+func TestGenerateConfigForDCAOrderbook(t *testing.T) {
+	fp := filepath.Join("..", "testdata", "binance_BTCUSDT_orderbook_2021_11_01.jsonl")
 	cfg := Config{
-		Nickname: "ExampleStrategyDCACSVTrades",
-		Goal:     "To demonstrate the DCA strategy using CSV trade data",
+		Nickname: "ExampleStrategyDCAOrderbook",
+		Goal:     "To demonstrate the DCA strategy using recorded orderbook data",
 		StrategySettings: StrategySettings{
 			Name:               dca,
 			DisableUSDTracking: true,
@@ -727,6 +1473,8 @@ func TestGenerateConfigForDCACSVTrades(t *testing.T) {
 				Base:              currency.BTC.String(),
 				Quote:             currency.USDT.String(),
 				InitialQuoteFunds: initialQuoteFunds2,
+				BuySide:           minMax,
+				SellSide:          minMax,
 				Leverage: Leverage{
 					CanUseLeverage: false,
 				},
@@ -735,13 +1483,15 @@ func TestGenerateConfigForDCACSVTrades(t *testing.T) {
 			},
 		},
 		DataSettings: DataSettings{
-			Interval: kline.OneMin.Duration(),
-			DataType: common.TradeStr,
-			CSVData: &CSVData{
+			Interval: kline.FifteenMin.Duration(),
+			DataType: common.OrderbookStr,
+			OrderbookData: &OrderbookData{
 				FullPath: fp,
 			},
 		},
 		PortfolioSettings: PortfolioSettings{
+			BuySide:  minMax,
+			SellSide: minMax,
 			Leverage: Leverage{
 				CanUseLeverage: false,
 			},
@@ -759,7 +1509,7 @@ func TestGenerateConfigForDCACSVTrades(t *testing.T) {
 		if err != nil {
 			t.Fatal(err)
 		}
-		err = ioutil.WriteFile(filepath.Join(p, "examples", "dca-csv-trades.strat"), result, 0770)
+		err = ioutil.WriteFile(filepath.Join(p, "examples", "dca-orderbook.strat"), result, 0770)
 		if err != nil {
 			t.Error(err)
 		}
@@ -1005,6 +1755,365 @@ func TestValidateDate(t *testing.T) {
 	}
 }
 
+func TestValidateOrderbookData(t *testing.T) {
+	t.Parallel()
+	c := Config{}
+	err := c.validateOrderbookData()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	c.DataSettings.DataType = common.OrderbookStr
+	err = c.validateOrderbookData()
+	if !errors.Is(err, errOrderbookDataTypeMismatch) {
+		t.Errorf("received: %v, expected: %v", err, errOrderbookDataTypeMismatch)
+	}
+	c.DataSettings.OrderbookData = &OrderbookData{}
+	c.DataSettings.CSVData = &CSVData{}
+	err = c.validateOrderbookData()
+	if !errors.Is(err, errOrderbookDataAmbiguous) {
+		t.Errorf("received: %v, expected: %v", err, errOrderbookDataAmbiguous)
+	}
+	c.DataSettings.CSVData = nil
+	c.DataSettings.DataType = common.CandleStr
+	err = c.validateOrderbookData()
+	if !errors.Is(err, errOrderbookDataTypeMismatch) {
+		t.Errorf("received: %v, expected: %v", err, errOrderbookDataTypeMismatch)
+	}
+	c.DataSettings.DataType = common.OrderbookStr
+	err = c.validateOrderbookData()
+	if !errors.Is(err, errOrderbookPathUnset) {
+		t.Errorf("received: %v, expected: %v", err, errOrderbookPathUnset)
+	}
+	c.DataSettings.OrderbookData.FullPath = "orderbook.jsonl"
+	err = c.validateOrderbookData()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+}
+
+func TestValidatePositionSizing(t *testing.T) {
+	t.Parallel()
+	c := Config{}
+	err := c.validatePositionSizing()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	c.PortfolioSettings.PositionSizing = &PositionSizingSettings{Model: "martingale"}
+	err = c.validatePositionSizing()
+	if !errors.Is(err, errBadPositionSizing) {
+		t.Errorf("received: %v, expected: %v", err, errBadPositionSizing)
+	}
+	c.PortfolioSettings.PositionSizing.Model = sizing.Kelly
+	err = c.validatePositionSizing()
+	if !errors.Is(err, errBadPositionSizing) {
+		t.Errorf("received: %v, expected: %v", err, errBadPositionSizing)
+	}
+	c.PortfolioSettings.PositionSizing.Fraction = decimal.NewFromFloat(0.1)
+	err = c.validatePositionSizing()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	c.PortfolioSettings.PositionSizing.Model = sizing.RiskParity
+	err = c.validatePositionSizing()
+	if !errors.Is(err, errRiskParityUnsupported) {
+		t.Errorf("received: %v, expected: %v", err, errRiskParityUnsupported)
+	}
+	c.StrategySettings.UseExchangeLevelFunding = true
+	err = c.validatePositionSizing()
+	if !errors.Is(err, errRiskParityUnsupported) {
+		t.Errorf("received: %v, expected: %v", err, errRiskParityUnsupported)
+	}
+	c.StrategySettings.SimultaneousSignalProcessing = true
+	err = c.validatePositionSizing()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	c.StrategySettings.Sleeves = []SleeveSettings{{Name: "sleeve"}}
+	err = c.validatePositionSizing()
+	if !errors.Is(err, errRiskParityUnsupported) {
+		t.Errorf("received: %v, expected: %v", err, errRiskParityUnsupported)
+	}
+	c.StrategySettings.Sleeves[0].SimultaneousSignalProcessing = true
+	err = c.validatePositionSizing()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+}
+
+func TestValidateTickData(t *testing.T) {
+	t.Parallel()
+	c := Config{}
+	err := c.validateTickData()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	c.DataSettings.TickChunkSize = -1
+	err = c.validateTickData()
+	if !errors.Is(err, errBadTickChunkSize) {
+		t.Errorf("received: %v, expected: %v", err, errBadTickChunkSize)
+	}
+	c.DataSettings.TickChunkSize = 0
+	c.DataSettings.DataType = common.TickStr
+	c.DataSettings.APIData = &APIData{}
+	err = c.validateTickData()
+	if !errors.Is(err, errTickDataSourceUnsupported) {
+		t.Errorf("received: %v, expected: %v", err, errTickDataSourceUnsupported)
+	}
+	c.DataSettings.APIData = nil
+	c.DataSettings.CSVData = &CSVData{}
+	err = c.validateTickData()
+	if !errors.Is(err, errTickDataUSDTracking) {
+		t.Errorf("received: %v, expected: %v", err, errTickDataUSDTracking)
+	}
+	c.StrategySettings.DisableUSDTracking = true
+	c.StrategySettings.SimultaneousSignalProcessing = true
+	err = c.validateTickData()
+	if !errors.Is(err, errTickDataUnsupportedStrategy) {
+		t.Errorf("received: %v, expected: %v", err, errTickDataUnsupportedStrategy)
+	}
+	c.StrategySettings.SimultaneousSignalProcessing = false
+	c.OptimisationSettings = &OptimisationSettings{}
+	err = c.validateTickData()
+	if !errors.Is(err, errTickDataUnsupportedStrategy) {
+		t.Errorf("received: %v, expected: %v", err, errTickDataUnsupportedStrategy)
+	}
+	c.OptimisationSettings = nil
+	c.DataSettings.TickChunkSize = 100
+	err = c.validateTickData()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+}
+
+func TestValidateOptimisationSettings(t *testing.T) {
+	t.Parallel()
+	c := Config{}
+	err := c.validateOptimisationSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	c.OptimisationSettings = &OptimisationSettings{}
+	c.DataSettings.LiveData = &LiveData{}
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errOptimisationLiveData) {
+		t.Errorf("received: %v, expected: %v", err, errOptimisationLiveData)
+	}
+	c.DataSettings.LiveData = nil
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errUnknownOptimisationMethod) {
+		t.Errorf("received: %v, expected: %v", err, errUnknownOptimisationMethod)
+	}
+	c.OptimisationSettings.Method = RandomSearch
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errBadOptimisationIterations) {
+		t.Errorf("received: %v, expected: %v", err, errBadOptimisationIterations)
+	}
+	c.OptimisationSettings.Iterations = 10
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errUnknownOptimisationMetric) {
+		t.Errorf("received: %v, expected: %v", err, errUnknownOptimisationMetric)
+	}
+	c.OptimisationSettings.Metric = SortinoRatioMetric
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errNoOptimisationParameters) {
+		t.Errorf("received: %v, expected: %v", err, errNoOptimisationParameters)
+	}
+	c.OptimisationSettings.Parameters = []OptimisationParameter{{}}
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errBadOptimisationParameter) {
+		t.Errorf("received: %v, expected: %v", err, errBadOptimisationParameter)
+	}
+	c.OptimisationSettings.Parameters[0] = OptimisationParameter{
+		Name:    "rsi-low",
+		Minimum: decimal.NewFromInt(40),
+		Maximum: decimal.NewFromInt(20),
+		Step:    decimal.NewFromInt(5),
+	}
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errBadOptimisationParameter) {
+		t.Errorf("received: %v, expected: %v", err, errBadOptimisationParameter)
+	}
+	c.OptimisationSettings.Parameters[0].Minimum = decimal.NewFromInt(20)
+	c.OptimisationSettings.Parameters[0].Maximum = decimal.NewFromInt(40)
+	c.OptimisationSettings.Parameters[0].Step = decimal.Zero
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errBadOptimisationParameter) {
+		t.Errorf("received: %v, expected: %v", err, errBadOptimisationParameter)
+	}
+	c.OptimisationSettings.Parameters[0].Step = decimal.NewFromInt(5)
+	c.OptimisationSettings.Parameters = append(c.OptimisationSettings.Parameters, c.OptimisationSettings.Parameters[0])
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errBadOptimisationParameter) {
+		t.Errorf("received: %v, expected: %v", err, errBadOptimisationParameter)
+	}
+	c.OptimisationSettings.Parameters = c.OptimisationSettings.Parameters[:1]
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	c.DataSettings.Interval = kline.OneDay.Duration()
+	c.OptimisationSettings.WalkForward = &WalkForward{
+		InSampleWindow:    kline.OneDay.Duration() * 10,
+		OutOfSampleWindow: kline.OneHour.Duration(),
+	}
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errBadWalkForwardWindow) {
+		t.Errorf("received: %v, expected: %v", err, errBadWalkForwardWindow)
+	}
+	c.OptimisationSettings.WalkForward.OutOfSampleWindow = kline.OneDay.Duration() * 5
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+}
+
+func TestValidateReportSettings(t *testing.T) {
+	t.Parallel()
+	c := Config{}
+	err := c.validateReportSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	c.ReportSettings = &ReportSettings{
+		ExportFormats: []string{CSVExport, "parquet"},
+	}
+	err = c.validateReportSettings()
+	if !errors.Is(err, errUnsupportedExportFormat) {
+		t.Errorf("received: %v, expected: %v", err, errUnsupportedExportFormat)
+	}
+	c.ReportSettings.ExportFormats = []string{CSVExport, JSONLinesExport, CSVExport}
+	err = c.validateReportSettings()
+	if !errors.Is(err, errDuplicateExportFormat) {
+		t.Errorf("received: %v, expected: %v", err, errDuplicateExportFormat)
+	}
+	c.ReportSettings.ExportFormats = []string{CSVExport, JSONLinesExport, ColumnarExport}
+	err = c.validateReportSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+}
+
+func TestValidateStatisticSettings(t *testing.T) {
+	t.Parallel()
+	c := Config{
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName: testExchange,
+				Asset:        asset.Spot.String(),
+				Base:         currency.BTC.String(),
+				Quote:        currency.USDT.String(),
+			},
+			{
+				ExchangeName: testExchange,
+				Asset:        asset.Spot.String(),
+				Base:         currency.ETH.String(),
+				Quote:        currency.USDT.String(),
+			},
+		},
+	}
+	err := c.validateStatisticSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	c.StatisticSettings.RollingWindow = -1
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, errBadRollingWindow) {
+		t.Errorf("received: %v, expected: %v", err, errBadRollingWindow)
+	}
+	c.StatisticSettings.RollingWindow = 14
+
+	c.StatisticSettings.MonteCarlo = &MonteCarloSettings{Iterations: -1}
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, errBadMonteCarloIterations) {
+		t.Errorf("received: %v, expected: %v", err, errBadMonteCarloIterations)
+	}
+	c.StatisticSettings.MonteCarlo.Iterations = 0
+	c.StatisticSettings.MonteCarlo.ConfidenceLevel = decimal.NewFromInt(1)
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, errBadMonteCarloConfidenceLevel) {
+		t.Errorf("received: %v, expected: %v", err, errBadMonteCarloConfidenceLevel)
+	}
+	c.StatisticSettings.MonteCarlo.ConfidenceLevel = decimal.NewFromFloat(-0.5)
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, errBadMonteCarloConfidenceLevel) {
+		t.Errorf("received: %v, expected: %v", err, errBadMonteCarloConfidenceLevel)
+	}
+	c.StatisticSettings.MonteCarlo.ConfidenceLevel = decimal.NewFromFloat(0.9)
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+
+	c.StatisticSettings.Benchmark = &BenchmarkSettings{Type: "index-fund"}
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, errUnknownBenchmarkType) {
+		t.Errorf("received: %v, expected: %v", err, errUnknownBenchmarkType)
+	}
+
+	btc := BenchmarkComponent{
+		ExchangeName: testExchange,
+		Asset:        asset.Spot.String(),
+		Base:         currency.BTC.String(),
+		Quote:        currency.USDT.String(),
+	}
+	c.StatisticSettings.Benchmark = &BenchmarkSettings{Type: BuyAndHoldBenchmark}
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, errBadBenchmarkComponents) {
+		t.Errorf("received: %v, expected: %v", err, errBadBenchmarkComponents)
+	}
+	c.StatisticSettings.Benchmark.Components = []BenchmarkComponent{btc}
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	c.StatisticSettings.Benchmark.Components[0].Base = currency.LTC.String()
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, errBenchmarkComponentNotLoaded) {
+		t.Errorf("received: %v, expected: %v", err, errBenchmarkComponentNotLoaded)
+	}
+
+	eth := btc
+	eth.Base = currency.ETH.String()
+	c.StatisticSettings.Benchmark = &BenchmarkSettings{
+		Type:       BasketBenchmark,
+		Components: []BenchmarkComponent{btc, eth},
+	}
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, errBadBenchmarkWeight) {
+		t.Errorf("received: %v, expected: %v", err, errBadBenchmarkWeight)
+	}
+	c.StatisticSettings.Benchmark.Components[0].Weight = decimal.NewFromFloat(0.5)
+	c.StatisticSettings.Benchmark.Components[1].Weight = decimal.NewFromFloat(0.5)
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	c.StatisticSettings.Benchmark.Components[1] = c.StatisticSettings.Benchmark.Components[0]
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, errBadBenchmarkComponents) {
+		t.Errorf("received: %v, expected: %v", err, errBadBenchmarkComponents)
+	}
+
+	c.StatisticSettings.Benchmark = &BenchmarkSettings{
+		Type:       CSVIndexBenchmark,
+		Components: []BenchmarkComponent{btc},
+	}
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, errBadBenchmarkComponents) {
+		t.Errorf("received: %v, expected: %v", err, errBadBenchmarkComponents)
+	}
+	c.StatisticSettings.Benchmark.Components = nil
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, errBenchmarkPathUnset) {
+		t.Errorf("received: %v, expected: %v", err, errBenchmarkPathUnset)
+	}
+	c.StatisticSettings.Benchmark.CSVFilePath = "index.csv"
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+}
+
 func TestValidateCurrencySettings(t *testing.T) {
 	c := Config{}
 	err := c.validateCurrencySettings()
@@ -1054,6 +2163,46 @@ func TestValidateCurrencySettings(t *testing.T) {
 	if !errors.Is(err, errBadSlippageRates) {
 		t.Errorf("received: %v, expected: %v", err, errBadSlippageRates)
 	}
+
+	c.CurrencySettings[0].MinimumSlippagePercent = decimal.Zero
+	c.CurrencySettings[0].MaximumSlippagePercent = decimal.Zero
+	c.CurrencySettings[0].FuturesDetails = &FuturesDetails{}
+	err = c.validateCurrencySettings()
+	if !errors.Is(err, errFuturesAssetRequired) {
+		t.Errorf("received: %v, expected: %v", err, errFuturesAssetRequired)
+	}
+	c.CurrencySettings[0].Asset = asset.Spot.String()
+	err = c.validateCurrencySettings()
+	if !errors.Is(err, errFuturesAssetRequired) {
+		t.Errorf("received: %v, expected: %v", err, errFuturesAssetRequired)
+	}
+	c.CurrencySettings[0].Asset = asset.USDTMarginedFutures.String()
+	err = c.validateCurrencySettings()
+	if !errors.Is(err, errBadFuturesLeverage) {
+		t.Errorf("received: %v, expected: %v", err, errBadFuturesLeverage)
+	}
+	c.CurrencySettings[0].FuturesDetails.Leverage = decimal.NewFromInt(10)
+	err = c.validateCurrencySettings()
+	if !errors.Is(err, errLeverageNotAllowed) {
+		t.Errorf("received: %v, expected: %v", err, errLeverageNotAllowed)
+	}
+	c.CurrencySettings[0].Leverage.CanUseLeverage = true
+	c.CurrencySettings[0].FuturesDetails.MaintenanceMarginRate = decimal.NewFromFloat(0.1)
+	err = c.validateCurrencySettings()
+	if !errors.Is(err, errBadMaintenanceMarginRate) {
+		t.Errorf("received: %v, expected: %v", err, errBadMaintenanceMarginRate)
+	}
+	c.CurrencySettings[0].FuturesDetails.MaintenanceMarginRate = decimal.NewFromFloat(0.05)
+	c.CurrencySettings[0].FuturesDetails.FundingInterval = -time.Hour
+	err = c.validateCurrencySettings()
+	if !errors.Is(err, errBadFundingInterval) {
+		t.Errorf("received: %v, expected: %v", err, errBadFundingInterval)
+	}
+	c.CurrencySettings[0].FuturesDetails.FundingInterval = time.Hour * 8
+	err = c.validateCurrencySettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
 }
 
 func TestValidateMinMaxes(t *testing.T) {
@@ -1192,6 +2341,81 @@ func TestValidateStrategySettings(t *testing.T) {
 	}
 }
 
+func TestValidateSleeveSettings(t *testing.T) {
+	t.Parallel()
+	c := &Config{
+		StrategySettings: StrategySettings{
+			SleeveRebalancing: &SleeveRebalanceSettings{},
+		},
+	}
+	err := c.validateStrategySettings()
+	if !errors.Is(err, errSleeveRebalancingRequiresSleeves) {
+		t.Errorf("received %v expected %v", err, errSleeveRebalancingRequiresSleeves)
+	}
+	c.StrategySettings.Sleeves = []SleeveSettings{
+		{Name: "dca", StrategyName: dca, Weight: decimal.NewFromInt(1)},
+		{Name: "rsi", StrategyName: "rsi", Weight: decimal.NewFromInt(1)},
+	}
+	err = c.validateStrategySettings()
+	if !errors.Is(err, errBadSleeveRebalanceInterval) {
+		t.Errorf("received %v expected %v", err, errBadSleeveRebalanceInterval)
+	}
+	c.StrategySettings.SleeveRebalancing.Interval = kline.OneWeek.Duration()
+	c.StrategySettings.DisableUSDTracking = true
+	err = c.validateStrategySettings()
+	if !errors.Is(err, errSleeveRebalancingUSDTracking) {
+		t.Errorf("received %v expected %v", err, errSleeveRebalancingUSDTracking)
+	}
+	c.StrategySettings.DisableUSDTracking = false
+	err = c.validateStrategySettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	c.StrategySettings.Name = dca
+	err = c.validateStrategySettings()
+	if !errors.Is(err, errStrategySleeveAmbiguous) {
+		t.Errorf("received %v expected %v", err, errStrategySleeveAmbiguous)
+	}
+	c.StrategySettings.Name = ""
+	c.StrategySettings.Sleeves[1].Name = "DCA"
+	err = c.validateStrategySettings()
+	if !errors.Is(err, errBadStrategySleeve) {
+		t.Errorf("received %v expected %v", err, errBadStrategySleeve)
+	}
+	c.StrategySettings.Sleeves[1].Name = "rsi"
+	c.StrategySettings.Sleeves[1].Weight = decimal.Zero
+	err = c.validateStrategySettings()
+	if !errors.Is(err, errBadStrategySleeveWeight) {
+		t.Errorf("received %v expected %v", err, errBadStrategySleeveWeight)
+	}
+	c.StrategySettings.Sleeves[1].Weight = decimal.NewFromInt(1)
+	c.StrategySettings.Sleeves[1].StrategyName = "moon"
+	err = c.validateStrategySettings()
+	if !errors.Is(err, base.ErrStrategyNotFound) {
+		t.Errorf("received %v expected %v", err, base.ErrStrategyNotFound)
+	}
+	c.StrategySettings.Sleeves[1].StrategyName = "rsi"
+	c.StrategySettings.UseExchangeLevelFunding = true
+	err = c.validateStrategySettings()
+	if !errors.Is(err, errSimultaneousProcessingRequired) {
+		t.Errorf("received %v expected %v", err, errSimultaneousProcessingRequired)
+	}
+	c.StrategySettings.Sleeves[0].SimultaneousSignalProcessing = true
+	c.StrategySettings.Sleeves[1].SimultaneousSignalProcessing = true
+	err = c.validateStrategySettings()
+	if !errors.Is(err, errExchangeLevelFundingDataRequired) {
+		t.Errorf("received %v expected %v", err, errExchangeLevelFundingDataRequired)
+	}
+	c.StrategySettings.UseExchangeLevelFunding = false
+
+	c.OptimisationSettings = &OptimisationSettings{}
+	err = c.validateStrategySettings()
+	if !errors.Is(err, errStrategySleevesUnsupported) {
+		t.Errorf("received %v expected %v", err, errStrategySleevesUnsupported)
+	}
+}
+
 func TestValidate(t *testing.T) {
 	t.Parallel()
 	c := &Config{
@@ -1216,3 +2440,34 @@ func TestValidate(t *testing.T) {
 		t.Errorf("received %v expected %v", err, nil)
 	}
 }
+
+func TestValidateLiveData(t *testing.T) {
+	t.Parallel()
+	c := Config{}
+	err := c.validateLiveData()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	c.DataSettings.LiveData = &LiveData{}
+	err = c.validateLiveData()
+	if !errors.Is(err, errLiveDataIntervalUnset) {
+		t.Errorf("received: %v, expected: %v", err, errLiveDataIntervalUnset)
+	}
+	c.DataSettings.Interval = kline.OneHour.Duration()
+	c.DataSettings.LiveData.DataCheckTimer = -1
+	err = c.validateLiveData()
+	if !errors.Is(err, errBadLiveDataTimer) {
+		t.Errorf("received: %v, expected: %v", err, errBadLiveDataTimer)
+	}
+	c.DataSettings.LiveData.DataCheckTimer = time.Second
+	c.DataSettings.LiveData.NewEventTimeout = time.Minute
+	err = c.validateLiveData()
+	if !errors.Is(err, errLiveDataTimeoutTooShort) {
+		t.Errorf("received: %v, expected: %v", err, errLiveDataTimeoutTooShort)
+	}
+	c.DataSettings.LiveData.NewEventTimeout = kline.OneHour.Duration() * 2
+	err = c.validateLiveData()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+}
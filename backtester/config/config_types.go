@@ -24,6 +24,77 @@ var (
 	errSizeLessThanZero                 = errors.New("size less than zero")
 	errMaxSizeMinSizeMismatch           = errors.New("maximum size must be greater to minimum size")
 	errMinMaxEqual                      = errors.New("minimum and maximum limits cannot be equal")
+	errFuturesAssetRequired             = errors.New("futures details require a futures or margin asset, please check your config")
+	errBadFuturesLeverage               = errors.New("futures leverage must be at least 1, please check your config")
+	errLeverageNotAllowed               = errors.New("futures leverage greater than 1 requires leverage to be enabled, please check your config")
+	errBadMaintenanceMarginRate         = errors.New("maintenance margin rate must be positive and less than the initial margin rate, please check your config")
+	errBadFundingInterval               = errors.New("funding interval cannot be negative, please check your config")
+	errOrderbookDataAmbiguous           = errors.New("orderbook data cannot be combined with another data source, please check your config")
+	errOrderbookDataTypeMismatch        = errors.New("orderbook data requires the orderbook data type and vice versa, please check your config")
+	errOrderbookPathUnset               = errors.New("orderbook data full path unset, please check your config")
+	errUnknownOptimisationMethod        = errors.New("unknown optimisation method, please check your config")
+	errUnknownOptimisationMetric        = errors.New("unknown optimisation metric, please check your config")
+	errNoOptimisationParameters         = errors.New("no optimisation parameters set, please check your config")
+	errBadOptimisationParameter         = errors.New("invalid optimisation parameter, please check your config")
+	errBadOptimisationIterations        = errors.New("random optimisation requires a positive number of iterations, please check your config")
+	errBadWalkForwardWindow             = errors.New("walk forward windows must be a positive multiple of the data interval, please check your config")
+	errOptimisationLiveData             = errors.New("optimisation cannot be used with live data, please check your config")
+	errLiveDataIntervalUnset            = errors.New("live data requires a candle interval, please check your config")
+	errBadLiveDataTimer                 = errors.New("live data timers cannot be negative, please check your config")
+	errLiveDataTimeoutTooShort          = errors.New("live data new event timeout must be at least the candle interval, please check your config")
+	errUnsupportedExportFormat          = errors.New("unsupported report export format, please check your config")
+	errDuplicateExportFormat            = errors.New("duplicate report export format, please check your config")
+	errBadRollingWindow                 = errors.New("rolling window cannot be negative, please check your config")
+	errUnknownBenchmarkType             = errors.New("unknown benchmark type, please check your config")
+	errBadBenchmarkComponents           = errors.New("invalid benchmark components, please check your config")
+	errBenchmarkComponentNotLoaded      = errors.New("benchmark component must be in the currency settings, please check your config")
+	errBadBenchmarkWeight               = errors.New("benchmark basket weights must be positive, please check your config")
+	errBenchmarkPathUnset               = errors.New("benchmark csv index file path unset, please check your config")
+	errStrategySleeveAmbiguous          = errors.New("strategy name and custom settings cannot be set alongside strategy sleeves, please check your config")
+	errBadStrategySleeve                = errors.New("invalid strategy sleeve, please check your config")
+	errBadStrategySleeveWeight          = errors.New("strategy sleeve weights must be positive, please check your config")
+	errStrategySleevesUnsupported       = errors.New("strategy sleeves cannot be used with live data or optimisation, please check your config")
+	errSleeveRebalancingRequiresSleeves = errors.New("sleeve rebalancing requires at least two strategy sleeves, please check your config")
+	errBadSleeveRebalanceInterval       = errors.New("sleeve rebalance interval must be positive, please check your config")
+	errSleeveRebalancingUSDTracking     = errors.New("sleeve rebalancing requires USD tracking, please check your config")
+	errBadMonteCarloIterations          = errors.New("monte carlo iterations cannot be negative, please check your config")
+	errBadMonteCarloConfidenceLevel     = errors.New("monte carlo confidence level must be between 0 and 1, please check your config")
+	errTickDataSourceUnsupported        = errors.New("tick data can only be streamed from csv or database data, please check your config")
+	errTickDataUSDTracking              = errors.New("tick data requires `disable-usd-tracking` to be set to `true`, please check your config")
+	errTickDataUnsupportedStrategy      = errors.New("tick data cannot be used with simultaneous signal processing, strategy sleeves or optimisation, please check your config")
+	errBadTickChunkSize                 = errors.New("tick chunk size cannot be negative, please check your config")
+	errBadPositionSizing                = errors.New("invalid position sizing, please check your config")
+	errRiskParityUnsupported            = errors.New("risk-parity position sizing requires exchange level funding and simultaneous signal processing, please check your config")
+)
+
+// Optimisation methods
+const (
+	GridSearch   = "grid"
+	RandomSearch = "random"
+)
+
+// Optimisation metrics used to rank optimisation runs
+const (
+	SharpeRatioMetric      = "sharpe-ratio"
+	SortinoRatioMetric     = "sortino-ratio"
+	CalmarRatioMetric      = "calmar-ratio"
+	CAGRMetric             = "cagr"
+	MaxDrawdownMetric      = "max-drawdown"
+	StrategyMovementMetric = "strategy-movement"
+)
+
+// Report export formats
+const (
+	CSVExport       = "csv"
+	JSONLinesExport = "jsonl"
+	ColumnarExport  = "columnar"
+)
+
+// Benchmark types which strategy returns are measured against
+const (
+	BuyAndHoldBenchmark = "buy-and-hold"
+	BasketBenchmark     = "basket"
+	CSVIndexBenchmark   = "csv-index"
 )
 
 // Config defines what is in an individual strategy config
@@ -35,17 +106,27 @@ type Config struct {
 	DataSettings      DataSettings       `json:"data-settings"`
 	PortfolioSettings PortfolioSettings  `json:"portfolio-settings"`
 	StatisticSettings StatisticSettings  `json:"statistic-settings"`
+	// OptimisationSettings when set will run the strategy multiple
+	// times with differing custom settings instead of a single run
+	OptimisationSettings *OptimisationSettings `json:"optimisation-settings,omitempty"`
+	// ReportSettings when set chooses the machine readable results
+	// exported alongside the HTML report
+	ReportSettings *ReportSettings `json:"report,omitempty"`
 }
 
 // DataSettings is a container for each type of data retrieval setting.
 // Only ONE can be populated per config
 type DataSettings struct {
-	Interval     time.Duration `json:"interval"`
-	DataType     string        `json:"data-type"`
-	APIData      *APIData      `json:"api-data,omitempty"`
-	DatabaseData *DatabaseData `json:"database-data,omitempty"`
-	LiveData     *LiveData     `json:"live-data,omitempty"`
-	CSVData      *CSVData      `json:"csv-data,omitempty"`
+	Interval      time.Duration  `json:"interval"`
+	DataType      string         `json:"data-type"`
+	APIData       *APIData       `json:"api-data,omitempty"`
+	DatabaseData  *DatabaseData  `json:"database-data,omitempty"`
+	LiveData      *LiveData      `json:"live-data,omitempty"`
+	CSVData       *CSVData       `json:"csv-data,omitempty"`
+	OrderbookData *OrderbookData `json:"orderbook-data,omitempty"`
+	// TickChunkSize is the number of trades streamed at a time when using
+	// the tick data type
+	TickChunkSize int64 `json:"tick-chunk-size,omitempty"`
 }
 
 // StrategySettings contains what strategy to load, along with custom settings map
@@ -60,6 +141,30 @@ type StrategySettings struct {
 	// bool language is opposite to encourage use by default
 	DisableUSDTracking bool                   `json:"disable-usd-tracking"`
 	CustomSettings     map[string]interface{} `json:"custom-settings,omitempty"`
+	// Sleeves when set run multiple strategies over the same data, each
+	// with its own share of funding. The strategy name and custom settings
+	// are then set per sleeve
+	Sleeves []SleeveSettings `json:"sleeves,omitempty"`
+	// SleeveRebalancing when set periodically transfers funds between
+	// sleeves to restore their weights
+	SleeveRebalancing *SleeveRebalanceSettings `json:"sleeve-rebalancing,omitempty"`
+}
+
+// SleeveSettings defines a strategy run alongside other strategies. Weight is
+// the sleeve's share of all initial funds, relative to the weights of the other
+// sleeves
+type SleeveSettings struct {
+	Name                         string                 `json:"name"`
+	StrategyName                 string                 `json:"strategy-name"`
+	SimultaneousSignalProcessing bool                   `json:"use-simultaneous-signal-processing"`
+	Weight                       decimal.Decimal        `json:"weight"`
+	CustomSettings               map[string]interface{} `json:"custom-settings,omitempty"`
+}
+
+// SleeveRebalanceSettings defines how often funds are transferred between
+// sleeves to restore their weights
+type SleeveRebalanceSettings struct {
+	Interval time.Duration `json:"interval"`
 }
 
 // ExchangeLevelFunding allows the portfolio manager to access
@@ -81,6 +186,47 @@ type ExchangeLevelFunding struct {
 // proper data is currently lacking
 type StatisticSettings struct {
 	RiskFreeRate decimal.Decimal `json:"risk-free-rate"`
+	// RollingWindow is the number of candles used to calculate the
+	// rolling Sharpe ratio and volatility. Defaults to 30 when unset
+	RollingWindow int64 `json:"rolling-window,omitempty"`
+	// Benchmark when set is used instead of each pair's own market
+	// movement when comparing strategy returns
+	Benchmark *BenchmarkSettings `json:"benchmark,omitempty"`
+	// MonteCarlo when set resamples and shuffles each pair's trades after
+	// the run to show how much of the result came down to luck
+	MonteCarlo *MonteCarloSettings `json:"monte-carlo,omitempty"`
+}
+
+// MonteCarloSettings defines the Monte Carlo analysis run against each pair's
+// trades. Each simulated trade has its slippage randomised within the pair's
+// minimum and maximum slippage percent
+type MonteCarloSettings struct {
+	// Iterations is the number of paths simulated by each method. Defaults to 1000 when unset
+	Iterations int64 `json:"iterations,omitempty"`
+	// ConfidenceLevel sets the width of the confidence intervals, eg 0.95. Defaults to 0.95 when unset
+	ConfidenceLevel decimal.Decimal `json:"confidence-level"`
+	// Seed allows results to be reproduced. If unset, a time based seed is used
+	Seed int64 `json:"seed,omitempty"`
+}
+
+// BenchmarkSettings defines the series strategy returns are compared against.
+// A buy-and-hold benchmark has one component, a basket benchmark blends
+// multiple components by weight and a csv-index benchmark reads a
+// timestamp,value file
+type BenchmarkSettings struct {
+	Type        string               `json:"type"`
+	Components  []BenchmarkComponent `json:"components,omitempty"`
+	CSVFilePath string               `json:"csv-file-path,omitempty"`
+}
+
+// BenchmarkComponent is a pair bought and held as part of a benchmark.
+// The pair must be in the currency settings so its data is loaded
+type BenchmarkComponent struct {
+	ExchangeName string          `json:"exchange-name"`
+	Asset        string          `json:"asset"`
+	Base         string          `json:"base"`
+	Quote        string          `json:"quote"`
+	Weight       decimal.Decimal `json:"weight,omitempty"`
 }
 
 // PortfolioSettings act as a global protector for strategies
@@ -90,6 +236,29 @@ type PortfolioSettings struct {
 	Leverage Leverage `json:"leverage"`
 	BuySide  MinMax   `json:"buy-side"`
 	SellSide MinMax   `json:"sell-side"`
+	// PositionSizing when set chooses how much of the available funds
+	// each signal commits to opening a position
+	PositionSizing *PositionSizingSettings `json:"position-sizing,omitempty"`
+}
+
+// PositionSizingSettings defines the model used to size new positions.
+// Orders are still limited by the buy and sell side rules
+type PositionSizingSettings struct {
+	// Model is one of fixed-fractional, volatility-target, kelly or risk-parity
+	Model string `json:"model"`
+	// Fraction is the share of equity committed by fixed-fractional, the share
+	// of funds split between pairs by risk-parity and the share committed by
+	// kelly until enough trades have closed
+	Fraction decimal.Decimal `json:"fraction"`
+	// TargetVolatility is the share of equity one ATR move costs under volatility-target
+	TargetVolatility decimal.Decimal `json:"target-volatility"`
+	// ATRPeriod is the number of candles ATR is calculated over. Defaults to 14 when unset
+	ATRPeriod int64 `json:"atr-period,omitempty"`
+	// KellyMultiplier scales down the full Kelly fraction. Defaults to 0.5 when unset
+	KellyMultiplier decimal.Decimal `json:"kelly-multiplier"`
+	// KellyMinimumTrades is the number of closed trades required before
+	// the Kelly fraction is used. Defaults to 10 when unset
+	KellyMinimumTrades int64 `json:"kelly-minimum-trades,omitempty"`
 }
 
 // Leverage rules are used to allow or limit the use of leverage in orders
@@ -126,6 +295,8 @@ type CurrencySettings struct {
 	Leverage Leverage `json:"leverage"`
 	BuySide  MinMax   `json:"buy-side"`
 	SellSide MinMax   `json:"sell-side"`
+	// FuturesDetails simulates a leveraged position for futures and margin assets
+	FuturesDetails *FuturesDetails `json:"futures-details,omitempty"`
 
 	MinimumSlippagePercent decimal.Decimal `json:"min-slippage-percent"`
 	MaximumSlippagePercent decimal.Decimal `json:"max-slippage-percent"`
@@ -140,6 +311,17 @@ type CurrencySettings struct {
 	ShowExchangeOrderLimitWarning bool `json:"-"`
 }
 
+// FuturesDetails holds the contract rules used to simulate a
+// leveraged position. The quote currency funds are used as collateral
+type FuturesDetails struct {
+	Leverage              decimal.Decimal `json:"leverage"`
+	MaintenanceMarginRate decimal.Decimal `json:"maintenance-margin-rate"`
+	// FundingRate is paid by longs to shorts every FundingInterval
+	// a negative rate is paid by shorts to longs
+	FundingRate     decimal.Decimal `json:"funding-rate"`
+	FundingInterval time.Duration   `json:"funding-interval"`
+}
+
 // APIData defines all fields to configure API based data
 type APIData struct {
 	StartDate        time.Time `json:"start-date"`
@@ -152,6 +334,11 @@ type CSVData struct {
 	FullPath string `json:"full-path"`
 }
 
+// OrderbookData defines all fields to configure recorded orderbook based data
+type OrderbookData struct {
+	FullPath string `json:"full-path"`
+}
+
 // DatabaseData defines all fields to configure database based data
 type DatabaseData struct {
 	StartDate        time.Time       `json:"start-date"`
@@ -169,4 +356,56 @@ type LiveData struct {
 	API2FAOverride        string `json:"api-2fa-override"`
 	APISubAccountOverride string `json:"api-sub-account-override"`
 	RealOrders            bool   `json:"real-orders"`
+	// DataCheckTimer is how often new candles are requested from the exchange.
+	// If unset, it defaults to 15 seconds
+	DataCheckTimer time.Duration `json:"data-check-timer,omitempty"`
+	// NewEventTimeout stops the run when no new candles are processed
+	// within the duration. If unset, it defaults to twice the candle
+	// interval, or five minutes if greater
+	NewEventTimeout time.Duration `json:"new-event-timeout,omitempty"`
+	// SessionPath is the file every processed candle is saved to. If the
+	// file exists on startup, its candles are replayed to resume the session
+	SessionPath string `json:"session-path,omitempty"`
+}
+
+// ReportSettings define which formats the trade blotter, holdings curve and
+// funding snapshots are exported in. Each format writes one file per dataset
+// to the report output path
+type ReportSettings struct {
+	ExportFormats []string `json:"export-formats"`
+}
+
+// OptimisationSettings define which strategy custom settings are searched,
+// how they are searched and which statistic decides the best combination
+type OptimisationSettings struct {
+	Method string `json:"method"`
+	Metric string `json:"metric"`
+	// Iterations is the number of combinations sampled when using random search
+	Iterations int64 `json:"iterations,omitempty"`
+	// Seed allows random search results to be reproduced. If unset, a time based seed is used
+	Seed int64 `json:"seed,omitempty"`
+	// MaximumParallelRuns limits concurrent runs. If unset, it defaults to the number of CPUs.
+	// Runs using database or API data are always sequential
+	MaximumParallelRuns int64                   `json:"maximum-parallel-runs,omitempty"`
+	Parameters          []OptimisationParameter `json:"parameters"`
+	WalkForward         *WalkForward            `json:"walk-forward,omitempty"`
+}
+
+// OptimisationParameter is a strategy custom setting which is assessed
+// from the minimum to the maximum value in increments of step
+type OptimisationParameter struct {
+	Name    string          `json:"name"`
+	Minimum decimal.Decimal `json:"minimum"`
+	Maximum decimal.Decimal `json:"maximum"`
+	Step    decimal.Decimal `json:"step"`
+}
+
+// WalkForward splits the data into sequential in-sample windows which are
+// optimised, with the best settings then assessed against the out-of-sample
+// window which follows
+type WalkForward struct {
+	InSampleWindow    time.Duration `json:"in-sample-window"`
+	OutOfSampleWindow time.Duration `json:"out-of-sample-window"`
+	// Anchored keeps every in-sample window starting at the beginning of the data
+	Anchored bool `json:"anchored"`
 }
@@ -91,6 +91,18 @@ func (b *Base) Next() (dh common.DataEventHandler) {
 	return ret
 }
 
+// TrimHistory releases processed data events, keeping only the latest keep
+// events in the history. The offset is adjusted to continue from the same
+// event, allowing data to be streamed without holding it all in memory
+func (b *Base) TrimHistory(keep int) {
+	if keep < 0 || b.offset <= keep {
+		return
+	}
+	drop := b.offset - keep
+	b.stream = append([]common.DataEventHandler(nil), b.stream[drop:]...)
+	b.offset = keep
+}
+
 // History will return all previous data events that have happened
 func (b *Base) History() []common.DataEventHandler {
 	return b.stream[:b.offset]
@@ -109,6 +109,37 @@ func TestStream(t *testing.T) {
 	}
 }
 
+func TestTrimHistory(t *testing.T) {
+	t.Parallel()
+	var d Base
+	for i := 1; i <= 5; i++ {
+		d.AppendStream(fakeDataHandler{time: i})
+	}
+	d.Next()
+	d.Next()
+	d.TrimHistory(2)
+	if len(d.GetStream()) != 5 {
+		t.Errorf("received '%v' expected '%v'", len(d.GetStream()), 5)
+	}
+	d.Next()
+	d.Next()
+	d.TrimHistory(1)
+	if len(d.GetStream()) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(d.GetStream()), 2)
+	}
+	if d.Offset() != 1 {
+		t.Errorf("received '%v' expected '%v'", d.Offset(), 1)
+	}
+	f, ok := d.History()[0].(fakeDataHandler)
+	if !ok || f.time != 4 {
+		t.Errorf("received '%v' expected '%v'", f.time, 4)
+	}
+	f, ok = d.Next().(fakeDataHandler)
+	if !ok || f.time != 5 {
+		t.Errorf("received '%v' expected '%v'", f.time, 5)
+	}
+}
+
 func TestSetDataForCurrency(t *testing.T) {
 	t.Parallel()
 	d := HandlerPerCurrency{}
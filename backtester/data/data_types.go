@@ -5,8 +5,10 @@ import (
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/trade"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
 // HandlerPerCurrency stores an event handler per exchange asset pair
@@ -60,3 +62,23 @@ type Streamer interface {
 
 	HasDataAtTime(time.Time) bool
 }
+
+// OrderbookStreamer is implemented by data handlers which replay
+// recorded orderbook depth alongside their candles
+type OrderbookStreamer interface {
+	OrderbookAtTime(time.Time) (*orderbook.Base, error)
+}
+
+// WindowSetter is implemented by data handlers which can limit their
+// loaded data to a time window, allowing a subset of the data to be run
+type WindowSetter interface {
+	SetWindow(start, end time.Time) error
+}
+
+// TradeStreamer is implemented by data handlers which stream individual
+// trades, allowing events to be processed in time order across handlers and
+// orders to be matched against the trades which follow them
+type TradeStreamer interface {
+	PeekNext() common.DataEventHandler
+	UpcomingTrades(until time.Time) []*trade.Trade
+}
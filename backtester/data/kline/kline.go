@@ -1,6 +1,7 @@
 package kline
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -53,7 +54,27 @@ func (d *DataFromKline) Load() error {
 	return nil
 }
 
+// SetWindow limits the candle data to candles from start up until end and
+// reloads the stream so event offsets begin from the start of the window
+func (d *DataFromKline) SetWindow(start, end time.Time) error {
+	var candles []gctkline.Candle
+	for i := range d.Item.Candles {
+		if d.Item.Candles[i].Time.Before(start) || !d.Item.Candles[i].Time.Before(end) {
+			continue
+		}
+		candles = append(candles, d.Item.Candles[i])
+	}
+	if len(candles) == 0 {
+		return fmt.Errorf("%w between %v and %v", errNoCandleData, start, end)
+	}
+	d.Item.Candles = candles
+	d.Base.Reset()
+	return d.Load()
+}
+
 // AppendResults adds a candle item to the data stream and sorts it to ensure it is all in order
+// Appended candles are added to the item and the range holder is recalculated,
+// with empty candles flagged as missing data
 func (d *DataFromKline) AppendResults(ki *gctkline.Item) {
 	if d.addedTimes == nil {
 		d.addedTimes = make(map[time.Time]bool)
@@ -66,12 +87,15 @@ func (d *DataFromKline) AppendResults(ki *gctkline.Item) {
 			d.addedTimes[ki.Candles[i].Time] = true
 		}
 	}
+	if len(gctCandles) == 0 {
+		return
+	}
 	var candleTimes []time.Time
-
+	offset := len(d.GetStream())
 	for i := range gctCandles {
 		klineData = append(klineData, &kline.Kline{
 			Base: event.Base{
-				Offset:       int64(i + 1),
+				Offset:       int64(offset + i + 1),
 				Exchange:     ki.Exchange,
 				Time:         gctCandles[i].Time,
 				Interval:     ki.Interval,
@@ -87,16 +111,50 @@ func (d *DataFromKline) AppendResults(ki *gctkline.Item) {
 		})
 		candleTimes = append(candleTimes, gctCandles[i].Time)
 	}
-	for i := range d.RangeHolder.Ranges {
-		for j := range d.RangeHolder.Ranges[i].Intervals {
-			d.RangeHolder.Ranges[i].Intervals[j].HasData = true
-		}
+	d.Item.Candles = append(d.Item.Candles, gctCandles...)
+	d.Item.SortCandlesByTimestamp(false)
+	err := d.updateRangeHolder(ki.Interval)
+	if err != nil {
+		log.Error(log.BackTester, err)
 	}
 	log.Debugf(log.BackTester, "appending %v candle intervals: %v", len(gctCandles), candleTimes)
 	d.AppendStream(klineData...)
 	d.SortStream()
 }
 
+// updateRangeHolder recalculates the range holder to cover all candles
+// Empty candles, used to fill intervals without data, are flagged as missing data
+func (d *DataFromKline) updateRangeHolder(interval gctkline.Interval) error {
+	if len(d.Item.Candles) == 0 {
+		return errNoCandleData
+	}
+	rh, err := gctkline.CalculateCandleDateRanges(
+		d.Item.Candles[0].Time,
+		d.Item.Candles[len(d.Item.Candles)-1].Time.Add(interval.Duration()),
+		interval,
+		0)
+	if err != nil {
+		return err
+	}
+	hasData := make(map[int64]bool, len(d.Item.Candles))
+	for i := range d.Item.Candles {
+		if d.Item.Candles[i].Open == 0 &&
+			d.Item.Candles[i].High == 0 &&
+			d.Item.Candles[i].Low == 0 &&
+			d.Item.Candles[i].Close == 0 {
+			continue
+		}
+		hasData[d.Item.Candles[i].Time.Unix()] = true
+	}
+	for i := range rh.Ranges {
+		for j := range rh.Ranges[i].Intervals {
+			rh.Ranges[i].Intervals[j].HasData = hasData[rh.Ranges[i].Intervals[j].Start.Ticks]
+		}
+	}
+	d.RangeHolder = rh
+	return nil
+}
+
 // StreamOpen returns all Open prices from the beginning until the current iteration
 func (d *DataFromKline) StreamOpen() []decimal.Decimal {
 	s := d.GetStream()
@@ -51,6 +51,50 @@ func TestLoad(t *testing.T) {
 	}
 }
 
+func TestSetWindow(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	d := DataFromKline{
+		Item: gctkline.Item{
+			Exchange: testExchange,
+			Pair:     currency.NewPair(currency.BTC, currency.USDT),
+			Asset:    asset.Spot,
+			Interval: gctkline.OneDay,
+		},
+	}
+	for i := 0; i < 5; i++ {
+		d.Item.Candles = append(d.Item.Candles, gctkline.Candle{
+			Time:  start.AddDate(0, 0, i),
+			Close: float64(i + 1),
+		})
+	}
+	err := d.Load()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	d.Next()
+
+	err = d.SetWindow(start.AddDate(1, 0, 0), start.AddDate(2, 0, 0))
+	if !errors.Is(err, errNoCandleData) {
+		t.Errorf("received: %v, expected: %v", err, errNoCandleData)
+	}
+
+	err = d.SetWindow(start.AddDate(0, 0, 1), start.AddDate(0, 0, 3))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if len(d.GetStream()) != 2 {
+		t.Fatalf("received: %v, expected: %v", len(d.GetStream()), 2)
+	}
+	if d.Offset() != 0 {
+		t.Errorf("received: %v, expected: %v", d.Offset(), 0)
+	}
+	ev := d.Next()
+	if ev.GetOffset() != 1 || !ev.GetTime().Equal(start.AddDate(0, 0, 1)) {
+		t.Errorf("received: %v %v, expected: %v %v", ev.GetOffset(), ev.GetTime(), 1, start.AddDate(0, 0, 1))
+	}
+}
+
 func TestHasDataAtTime(t *testing.T) {
 	t.Parallel()
 	dStart := time.Date(2020, 1, 0, 0, 0, 0, 0, time.UTC)
@@ -129,6 +173,49 @@ func TestAppend(t *testing.T) {
 	d.AppendResults(&item)
 }
 
+func TestAppendContinuesOffsets(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	d := DataFromKline{}
+	item := gctkline.Item{
+		Exchange: testExchange,
+		Pair:     currency.NewPair(currency.BTC, currency.USDT),
+		Asset:    asset.Spot,
+		Interval: gctkline.OneDay,
+		Candles: []gctkline.Candle{
+			{Time: tt, Open: 1337, High: 1337, Low: 1337, Close: 1337, Volume: 1337},
+		},
+	}
+	d.AppendResults(&item)
+	item.Candles = []gctkline.Candle{
+		{Time: tt},
+		{Time: tt.AddDate(0, 0, 1)},
+		{Time: tt.AddDate(0, 0, 2), Open: 1337, High: 1337, Low: 1337, Close: 1337, Volume: 1337},
+	}
+	d.AppendResults(&item)
+	stream := d.GetStream()
+	if len(stream) != 3 {
+		t.Fatalf("received: %v, expected: %v", len(stream), 3)
+	}
+	for i := range stream {
+		if stream[i].GetOffset() != int64(i+1) {
+			t.Errorf("received: %v, expected: %v", stream[i].GetOffset(), i+1)
+		}
+	}
+	if len(d.Item.Candles) != 3 {
+		t.Errorf("received: %v, expected: %v", len(d.Item.Candles), 3)
+	}
+	if !d.HasDataAtTime(tt) {
+		t.Error("expected data")
+	}
+	if d.HasDataAtTime(tt.AddDate(0, 0, 1)) {
+		t.Error("expected empty candle to be flagged as missing data")
+	}
+	if !d.HasDataAtTime(tt.AddDate(0, 0, 2)) {
+		t.Error("expected data")
+	}
+}
+
 func TestStreamOpen(t *testing.T) {
 	t.Parallel()
 	exch := testExchange
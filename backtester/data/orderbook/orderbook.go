@@ -0,0 +1,348 @@
+package orderbook
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream/buffer"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// maxRecordSize allows for deep orderbook snapshots on a single line
+const maxRecordSize = 64 * 1024 * 1024
+
+// LoadData reads a JSON lines orderbook capture for the exchange, asset and
+// pair provided and converts the replayed mid price into candles
+func LoadData(filepath, exchangeName string, interval time.Duration, fPair currency.Pair, a asset.Item) (*DataFromOrderbook, error) {
+	f, err := os.Open(filepath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = f.Close()
+		if err != nil {
+			log.Errorln(log.BackTester, err)
+		}
+	}()
+
+	records, err := LoadRecords(f, exchangeName, fPair, a)
+	if err != nil {
+		return nil, fmt.Errorf("could not read orderbook data for %v %v %v, %w", exchangeName, a, fPair, err)
+	}
+	book, err := NewBook(records)
+	if err != nil {
+		return nil, fmt.Errorf("could not replay orderbook data for %v %v %v, %w", exchangeName, a, fPair, err)
+	}
+	candles, err := book.ConvertToCandles(gctkline.Interval(interval))
+	if err != nil {
+		return nil, fmt.Errorf("could not convert orderbook data for %v %v %v, %w", exchangeName, a, fPair, err)
+	}
+
+	resp := &DataFromOrderbook{Book: book}
+	resp.Item = gctkline.Item{
+		Exchange: strings.ToLower(exchangeName),
+		Pair:     fPair,
+		Asset:    a,
+		Interval: gctkline.Interval(interval),
+		Candles:  candles,
+	}
+	resp.RangeHolder, err = gctkline.CalculateCandleDateRanges(
+		candles[0].Time,
+		candles[len(candles)-1].Time.Add(interval),
+		gctkline.Interval(interval),
+		0)
+	if err != nil {
+		return nil, err
+	}
+	resp.RangeHolder.SetHasDataFromCandles(candles)
+	return resp, nil
+}
+
+// LoadRecords reads JSON lines orderbook records, returning those relevant to
+// the exchange, asset and pair sorted by time
+func LoadRecords(r io.Reader, exchangeName string, fPair currency.Pair, a asset.Item) ([]Record, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxRecordSize)
+	var records []Record
+	var line int
+	for scanner.Scan() {
+		line++
+		if len(strings.TrimSpace(scanner.Text())) == 0 {
+			continue
+		}
+		var rec Record
+		err := json.Unmarshal(scanner.Bytes(), &rec)
+		if err != nil {
+			return nil, fmt.Errorf("line %v: %w", line, err)
+		}
+		if err = rec.validate(); err != nil {
+			return nil, fmt.Errorf("line %v: %w", line, err)
+		}
+		switch {
+		case rec.Snapshot != nil:
+			if !strings.EqualFold(rec.Snapshot.Exchange, exchangeName) ||
+				rec.Snapshot.Asset != a ||
+				!rec.Snapshot.Pair.Equal(fPair) {
+				continue
+			}
+		case rec.Update != nil:
+			if rec.Update.Asset != a || !rec.Update.Pair.Equal(fPair) {
+				continue
+			}
+		}
+		records = append(records, rec)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errNoRecords
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].GetTime().Before(records[j].GetTime())
+	})
+	return records, nil
+}
+
+// GetTime returns the time the record was received
+func (r *Record) GetTime() time.Time {
+	if r.Snapshot != nil {
+		return r.Snapshot.LastUpdated
+	}
+	if r.Update != nil {
+		return r.Update.UpdateTime
+	}
+	return time.Time{}
+}
+
+func (r *Record) validate() error {
+	if (r.Snapshot == nil) == (r.Update == nil) {
+		return errInvalidRecord
+	}
+	if r.GetTime().IsZero() {
+		return errRecordMissingTime
+	}
+	return nil
+}
+
+// NewBook returns an orderbook replay for the time sorted records provided
+func NewBook(records []Record) (*Book, error) {
+	if len(records) == 0 {
+		return nil, errNoRecords
+	}
+	for i := range records {
+		if err := records[i].validate(); err != nil {
+			return nil, err
+		}
+	}
+	if records[0].Snapshot == nil {
+		return nil, errNoSnapshot
+	}
+	return &Book{records: records}, nil
+}
+
+// Reset rewinds the replay to before the first record
+func (b *Book) Reset() {
+	b.next = 0
+	b.bids = nil
+	b.asks = nil
+	b.lastUpdated = time.Time{}
+}
+
+// AdvanceTo applies all records received before the provided time.
+// Requesting a time prior to the last applied record replays from the start
+func (b *Book) AdvanceTo(t time.Time) {
+	if b.next > 0 && !b.records[b.next-1].GetTime().Before(t) {
+		b.Reset()
+	}
+	for b.next < len(b.records) && b.records[b.next].GetTime().Before(t) {
+		b.apply(&b.records[b.next])
+		b.next++
+	}
+}
+
+// Orderbook returns a copy of the current state of the replayed orderbook
+func (b *Book) Orderbook() *gctorderbook.Base {
+	resp := &gctorderbook.Base{
+		Bids:        make(gctorderbook.Items, len(b.bids)),
+		Asks:        make(gctorderbook.Items, len(b.asks)),
+		LastUpdated: b.lastUpdated,
+	}
+	copy(resp.Bids, b.bids)
+	copy(resp.Asks, b.asks)
+	return resp
+}
+
+// MidPrice returns the price between the best bid and best ask
+func (b *Book) MidPrice() (float64, bool) {
+	if len(b.bids) == 0 || len(b.asks) == 0 {
+		return 0, false
+	}
+	return (b.bids[0].Price + b.asks[0].Price) / 2, true
+}
+
+// ConvertToCandles replays the entire orderbook and produces a candle for
+// every interval from the first available mid price. Intervals without
+// orderbook activity carry the previous mid price
+func (b *Book) ConvertToCandles(interval gctkline.Interval) ([]gctkline.Candle, error) {
+	if interval <= 0 {
+		return nil, gctkline.ErrUnsetInterval
+	}
+	b.Reset()
+	defer b.Reset()
+	var candles []gctkline.Candle
+	for b.next < len(b.records) {
+		rec := &b.records[b.next]
+		b.apply(rec)
+		b.next++
+		mid, ok := b.MidPrice()
+		if !ok {
+			continue
+		}
+		bucket := rec.GetTime().Truncate(interval.Duration())
+		if len(candles) > 0 {
+			last := &candles[len(candles)-1]
+			if last.Time.Equal(bucket) {
+				if mid > last.High {
+					last.High = mid
+				}
+				if mid < last.Low {
+					last.Low = mid
+				}
+				last.Close = mid
+				continue
+			}
+			lastClose := last.Close
+			for next := last.Time.Add(interval.Duration()); next.Before(bucket); next = next.Add(interval.Duration()) {
+				candles = append(candles, gctkline.Candle{
+					Time:  next,
+					Open:  lastClose,
+					High:  lastClose,
+					Low:   lastClose,
+					Close: lastClose,
+				})
+			}
+		}
+		candles = append(candles, gctkline.Candle{
+			Time:  bucket,
+			Open:  mid,
+			High:  mid,
+			Low:   mid,
+			Close: mid,
+		})
+	}
+	if len(candles) == 0 {
+		return nil, errNoMidPrice
+	}
+	return candles, nil
+}
+
+func (b *Book) apply(r *Record) {
+	switch {
+	case r.Snapshot != nil:
+		b.bids = append(gctorderbook.Items(nil), r.Snapshot.Bids...)
+		b.asks = append(gctorderbook.Items(nil), r.Snapshot.Asks...)
+		sort.SliceStable(b.bids, func(i, j int) bool { return b.bids[i].Price > b.bids[j].Price })
+		sort.SliceStable(b.asks, func(i, j int) bool { return b.asks[i].Price < b.asks[j].Price })
+	case r.Update != nil:
+		for i := range r.Update.Bids {
+			b.bids = applyLevel(b.bids, r.Update.Bids[i], r.Update.Action, true)
+		}
+		for i := range r.Update.Asks {
+			b.asks = applyLevel(b.asks, r.Update.Asks[i], r.Update.Action, false)
+		}
+		if r.Update.MaxDepth > 0 {
+			if len(b.bids) > r.Update.MaxDepth {
+				b.bids = b.bids[:r.Update.MaxDepth]
+			}
+			if len(b.asks) > r.Update.MaxDepth {
+				b.asks = b.asks[:r.Update.MaxDepth]
+			}
+		}
+	}
+	b.lastUpdated = r.GetTime()
+}
+
+// applyLevel applies a single level update. Levels are matched by ID when
+// provided, otherwise by price. A zero amount removes the level
+func applyLevel(levels gctorderbook.Items, item gctorderbook.Item, action buffer.Action, descending bool) gctorderbook.Items {
+	idx := -1
+	if item.ID != 0 {
+		for i := range levels {
+			if levels[i].ID == item.ID {
+				idx = i
+				break
+			}
+		}
+	}
+	if idx == -1 && item.Price > 0 {
+		i := priceIndex(levels, item.Price, descending)
+		if i < len(levels) && levels[i].Price == item.Price {
+			idx = i
+		}
+	}
+
+	if action == buffer.Delete || item.Amount <= 0 {
+		if idx != -1 {
+			levels = append(levels[:idx], levels[idx+1:]...)
+		}
+		return levels
+	}
+	if idx != -1 {
+		if item.Price == 0 || levels[idx].Price == item.Price {
+			levels[idx].Amount = item.Amount
+			return levels
+		}
+		// an ID based amendment has moved price level
+		levels = append(levels[:idx], levels[idx+1:]...)
+	}
+	if item.Price <= 0 {
+		return levels
+	}
+	i := priceIndex(levels, item.Price, descending)
+	levels = append(levels, gctorderbook.Item{})
+	copy(levels[i+1:], levels[i:])
+	levels[i] = item
+	return levels
+}
+
+func priceIndex(levels gctorderbook.Items, price float64, descending bool) int {
+	return sort.Search(len(levels), func(i int) bool {
+		if descending {
+			return levels[i].Price <= price
+		}
+		return levels[i].Price >= price
+	})
+}
+
+// OrderbookAtTime returns the replayed orderbook with all records
+// received before the provided time applied
+func (d *DataFromOrderbook) OrderbookAtTime(t time.Time) (*gctorderbook.Base, error) {
+	if d.Book == nil {
+		return nil, errNilBook
+	}
+	d.Book.AdvanceTo(t)
+	ob := d.Book.Orderbook()
+	ob.Exchange = d.Item.Exchange
+	ob.Pair = d.Item.Pair
+	ob.Asset = d.Item.Asset
+	return ob, nil
+}
+
+// Reset rewinds both the candle stream and the orderbook replay
+func (d *DataFromOrderbook) Reset() {
+	d.DataFromKline.Reset()
+	if d.Book != nil {
+		d.Book.Reset()
+	}
+}
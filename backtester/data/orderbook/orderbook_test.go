@@ -0,0 +1,299 @@
+package orderbook
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream/buffer"
+)
+
+const testExchange = "binance"
+
+var (
+	testPair  = currency.NewPair(currency.BTC, currency.USDT)
+	testStart = time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func testRecords() []Record {
+	return []Record{
+		{
+			Snapshot: &gctorderbook.Base{
+				Bids: gctorderbook.Items{
+					{Price: 98, Amount: 2},
+					{Price: 99, Amount: 1},
+				},
+				Asks: gctorderbook.Items{
+					{Price: 102, Amount: 2},
+					{Price: 101, Amount: 1},
+				},
+				Exchange:    testExchange,
+				Pair:        testPair,
+				Asset:       asset.Spot,
+				LastUpdated: testStart,
+			},
+		},
+		{
+			Update: &buffer.Update{
+				UpdateTime: testStart.Add(time.Minute),
+				Asset:      asset.Spot,
+				Pair:       testPair,
+				Action:     buffer.UpdateInsert,
+				Bids: gctorderbook.Items{
+					{Price: 99, Amount: 0},
+					{Price: 100, Amount: 3},
+				},
+				Asks: gctorderbook.Items{
+					{Price: 101, Amount: 5},
+				},
+			},
+		},
+		{
+			Update: &buffer.Update{
+				UpdateTime: testStart.Add(time.Hour * 2),
+				Asset:      asset.Spot,
+				Pair:       testPair,
+				Action:     buffer.Delete,
+				Asks: gctorderbook.Items{
+					{Price: 101},
+				},
+			},
+		},
+	}
+}
+
+func TestLoadRecords(t *testing.T) {
+	t.Parallel()
+	_, err := LoadRecords(strings.NewReader(""), testExchange, testPair, asset.Spot)
+	if !errors.Is(err, errNoRecords) {
+		t.Errorf("received '%v' expected '%v'", err, errNoRecords)
+	}
+
+	_, err = LoadRecords(strings.NewReader("{}"), testExchange, testPair, asset.Spot)
+	if !errors.Is(err, errInvalidRecord) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidRecord)
+	}
+
+	_, err = LoadRecords(strings.NewReader(`{"update":{"Asset":"spot","Pair":"BTC-USDT"}}`), testExchange, testPair, asset.Spot)
+	if !errors.Is(err, errRecordMissingTime) {
+		t.Errorf("received '%v' expected '%v'", err, errRecordMissingTime)
+	}
+
+	capture := `{"update":{"UpdateTime":"2021-11-01T00:01:00Z","Asset":"spot","Pair":"BTC-USDT","Bids":[{"Price":100,"Amount":1}]}}
+
+{"update":{"UpdateTime":"2021-11-01T00:01:00Z","Asset":"spot","Pair":"ETH-USDT","Bids":[{"Price":100,"Amount":1}]}}
+{"snapshot":{"Exchange":"Binance","Asset":"spot","Pair":"BTC-USDT","LastUpdated":"2021-11-01T00:00:00Z","Bids":[{"Price":99,"Amount":1}]}}
+{"snapshot":{"Exchange":"Bitstamp","Asset":"spot","Pair":"BTC-USDT","LastUpdated":"2021-11-01T00:00:00Z","Bids":[{"Price":99,"Amount":1}]}}`
+	records, err := LoadRecords(strings.NewReader(capture), testExchange, testPair, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(records) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(records), 2)
+	}
+	if records[0].Snapshot == nil {
+		t.Error("expected records to be sorted with the snapshot first")
+	}
+}
+
+func TestNewBook(t *testing.T) {
+	t.Parallel()
+	_, err := NewBook(nil)
+	if !errors.Is(err, errNoRecords) {
+		t.Errorf("received '%v' expected '%v'", err, errNoRecords)
+	}
+	records := testRecords()
+	_, err = NewBook(records[1:])
+	if !errors.Is(err, errNoSnapshot) {
+		t.Errorf("received '%v' expected '%v'", err, errNoSnapshot)
+	}
+	_, err = NewBook([]Record{{}})
+	if !errors.Is(err, errInvalidRecord) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidRecord)
+	}
+	_, err = NewBook(records)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+}
+
+func TestAdvanceTo(t *testing.T) {
+	t.Parallel()
+	b, err := NewBook(testRecords())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	b.AdvanceTo(testStart)
+	if len(b.Orderbook().Bids) != 0 {
+		t.Error("expected records at the time provided to be excluded")
+	}
+
+	b.AdvanceTo(testStart.Add(time.Second))
+	ob := b.Orderbook()
+	if len(ob.Bids) != 2 || ob.Bids[0].Price != 99 || ob.Asks[0].Price != 101 {
+		t.Errorf("expected sorted snapshot, received bids %+v asks %+v", ob.Bids, ob.Asks)
+	}
+
+	b.AdvanceTo(testStart.Add(time.Hour))
+	ob = b.Orderbook()
+	if len(ob.Bids) != 2 || ob.Bids[0].Price != 100 || ob.Bids[0].Amount != 3 || ob.Bids[1].Price != 98 {
+		t.Errorf("expected bid update to be applied, received %+v", ob.Bids)
+	}
+	if ob.Asks[0].Price != 101 || ob.Asks[0].Amount != 5 {
+		t.Errorf("expected ask amendment to be applied, received %+v", ob.Asks)
+	}
+	mid, ok := b.MidPrice()
+	if !ok || mid != 100.5 {
+		t.Errorf("received '%v' expected '%v'", mid, 100.5)
+	}
+
+	b.AdvanceTo(testStart.Add(time.Hour * 3))
+	ob = b.Orderbook()
+	if len(ob.Asks) != 1 || ob.Asks[0].Price != 102 {
+		t.Errorf("expected ask deletion to be applied, received %+v", ob.Asks)
+	}
+
+	// requesting an earlier time replays from the start
+	b.AdvanceTo(testStart.Add(time.Second))
+	ob = b.Orderbook()
+	if len(ob.Bids) != 2 || ob.Bids[0].Price != 99 {
+		t.Errorf("expected replay to rewind, received %+v", ob.Bids)
+	}
+}
+
+func TestApplyLevel(t *testing.T) {
+	t.Parallel()
+	levels := gctorderbook.Items{
+		{Price: 100, Amount: 1, ID: 1},
+		{Price: 99, Amount: 1, ID: 2},
+	}
+	levels = applyLevel(levels, gctorderbook.Item{ID: 2, Amount: 4}, buffer.Amend, true)
+	if levels[1].Amount != 4 {
+		t.Errorf("received '%v' expected '%v'", levels[1].Amount, 4)
+	}
+	levels = applyLevel(levels, gctorderbook.Item{ID: 2, Price: 101, Amount: 4}, buffer.Amend, true)
+	if levels[0].ID != 2 || levels[0].Price != 101 || len(levels) != 2 {
+		t.Errorf("expected amended level to move price, received %+v", levels)
+	}
+	levels = applyLevel(levels, gctorderbook.Item{ID: 1}, buffer.Delete, true)
+	if len(levels) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(levels), 1)
+	}
+	levels = applyLevel(levels, gctorderbook.Item{Price: 50, Amount: 1}, buffer.Insert, true)
+	if len(levels) != 2 || levels[1].Price != 50 {
+		t.Errorf("expected insert at the back of bids, received %+v", levels)
+	}
+	levels = applyLevel(levels, gctorderbook.Item{ID: 1337, Amount: 1}, buffer.UpdateInsert, true)
+	if len(levels) != 2 {
+		t.Error("expected a level without a price to be ignored")
+	}
+}
+
+func TestConvertToCandles(t *testing.T) {
+	t.Parallel()
+	b, err := NewBook(testRecords())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = b.ConvertToCandles(0)
+	if !errors.Is(err, gctkline.ErrUnsetInterval) {
+		t.Errorf("received '%v' expected '%v'", err, gctkline.ErrUnsetInterval)
+	}
+	candles, err := b.ConvertToCandles(gctkline.OneHour)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(candles) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(candles), 3)
+	}
+	if candles[0].Open != 100 || candles[0].Close != 100.5 || candles[0].High != 100.5 || candles[0].Low != 100 {
+		t.Errorf("unexpected first candle %+v", candles[0])
+	}
+	if !candles[1].Time.Equal(testStart.Add(time.Hour)) || candles[1].Close != 100.5 {
+		t.Errorf("expected quiet interval to carry previous mid price, received %+v", candles[1])
+	}
+	if candles[2].Close != 101 {
+		t.Errorf("received '%v' expected '%v'", candles[2].Close, 101)
+	}
+	if b.next != 0 {
+		t.Error("expected replay to be reset after conversion")
+	}
+
+	b, err = NewBook([]Record{{Snapshot: &gctorderbook.Base{LastUpdated: testStart}}})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = b.ConvertToCandles(gctkline.OneHour)
+	if !errors.Is(err, errNoMidPrice) {
+		t.Errorf("received '%v' expected '%v'", err, errNoMidPrice)
+	}
+}
+
+func TestLoadData(t *testing.T) {
+	t.Parallel()
+	_, err := LoadData("", testExchange, gctkline.FifteenMin.Duration(), testPair, asset.Spot)
+	if err == nil {
+		t.Error("expected error for missing file")
+	}
+	fp := filepath.Join("..", "..", "..", "testdata", "binance_BTCUSDT_orderbook_2021_11_01.jsonl")
+	d, err := LoadData(fp, testExchange, gctkline.FifteenMin.Duration(), testPair, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(d.Item.Candles) != 25 {
+		t.Errorf("received '%v' expected '%v'", len(d.Item.Candles), 25)
+	}
+	if !d.HasDataAtTime(testStart) {
+		t.Error("expected data at start")
+	}
+	err = d.Load()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	_, err = LoadData(fp, testExchange, gctkline.FifteenMin.Duration(), currency.NewPair(currency.ETH, currency.USDT), asset.Spot)
+	if !errors.Is(err, errNoRecords) {
+		t.Errorf("received '%v' expected '%v'", err, errNoRecords)
+	}
+}
+
+func TestOrderbookAtTime(t *testing.T) {
+	t.Parallel()
+	d := DataFromOrderbook{}
+	_, err := d.OrderbookAtTime(testStart)
+	if !errors.Is(err, errNilBook) {
+		t.Errorf("received '%v' expected '%v'", err, errNilBook)
+	}
+	d.Book, err = NewBook(testRecords())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	d.Item.Exchange = testExchange
+	d.Item.Pair = testPair
+	d.Item.Asset = asset.Spot
+	ob, err := d.OrderbookAtTime(testStart.Add(time.Hour))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if ob.Exchange != testExchange || !ob.Pair.Equal(testPair) || ob.Asset != asset.Spot {
+		t.Errorf("unexpected orderbook details %v %v %v", ob.Exchange, ob.Pair, ob.Asset)
+	}
+	if len(ob.Bids) == 0 || len(ob.Asks) == 0 {
+		t.Error("expected orderbook depth")
+	}
+	// the returned orderbook is a copy
+	ob.Bids[0].Amount = 1337
+	if d.Book.Orderbook().Bids[0].Amount == 1337 {
+		t.Error("expected orderbook copy")
+	}
+	d.Reset()
+	if d.Book.next != 0 {
+		t.Error("expected replay to be reset")
+	}
+}
@@ -0,0 +1,46 @@
+package orderbook
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream/buffer"
+)
+
+var (
+	errNoRecords         = errors.New("no orderbook records found")
+	errNoSnapshot        = errors.New("orderbook replay must begin with a snapshot")
+	errInvalidRecord     = errors.New("orderbook record must contain either a snapshot or an update")
+	errRecordMissingTime = errors.New("orderbook record has no timestamp")
+	errNoMidPrice        = errors.New("orderbook records never contain both bids and asks")
+	errNilBook           = errors.New("orderbook replay not loaded")
+)
+
+// Record is a single line of a recorded orderbook capture. It holds either a
+// full snapshot or an incremental update, in the same format as processed by
+// the websocket orderbook buffer
+type Record struct {
+	Snapshot *gctorderbook.Base `json:"snapshot,omitempty"`
+	Update   *buffer.Update     `json:"update,omitempty"`
+}
+
+// Book replays recorded orderbook records in time order, maintaining
+// a local copy of the price levels
+type Book struct {
+	records     []Record
+	next        int
+	bids        gctorderbook.Items
+	asks        gctorderbook.Items
+	lastUpdated time.Time
+}
+
+// DataFromOrderbook is a struct which implements the data.Handler interface
+// candles are derived from the mid price of the replayed orderbook so that
+// strategies can operate as usual, while the orderbook itself is available
+// to the exchange for matching orders
+type DataFromOrderbook struct {
+	kline.DataFromKline
+	Book *Book
+}
@@ -0,0 +1,113 @@
+package csv
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/trade"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	gcttrade "github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+// Source reads trades from a CSV file a chunk at a time. Each row is
+// expected to be in the format of unix timestamp, price, amount, side
+type Source struct {
+	exchangeName string
+	pair         currency.Pair
+	asset        asset.Item
+	file         io.ReadCloser
+	reader       *csv.Reader
+	row          int64
+}
+
+// LoadData opens the CSV file to stream its trades in chunks of chunkSize
+func LoadData(filepath, exchangeName string, interval time.Duration, fPair currency.Pair, a asset.Item, chunkSize int64) (*trade.DataFromTrades, error) {
+	csvFile, err := os.Open(filepath)
+	if err != nil {
+		return nil, err
+	}
+	exchangeName = strings.ToLower(exchangeName)
+	return &trade.DataFromTrades{
+		Exchange:  exchangeName,
+		Asset:     a,
+		Pair:      fPair,
+		Interval:  gctkline.Interval(interval),
+		ChunkSize: chunkSize,
+		Source:    NewSource(csvFile, exchangeName, fPair, a),
+	}, nil
+}
+
+// NewSource returns a source which reads CSV trades for the exchange, pair
+// and asset provided
+func NewSource(r io.ReadCloser, exchangeName string, fPair currency.Pair, a asset.Item) *Source {
+	return &Source{
+		exchangeName: exchangeName,
+		pair:         fPair,
+		asset:        a,
+		file:         r,
+		reader:       csv.NewReader(r),
+	}
+}
+
+// Next reads up to limit trades from the CSV file
+func (s *Source) Next(limit int64) ([]gcttrade.Data, error) {
+	var resp []gcttrade.Data
+	for int64(len(resp)) < limit {
+		row, err := s.reader.Read()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		s.row++
+		t, err := s.parseRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("row %v %w", s.row, err)
+		}
+		resp = append(resp, t)
+	}
+	return resp, nil
+}
+
+func (s *Source) parseRow(row []string) (gcttrade.Data, error) {
+	t := gcttrade.Data{
+		Exchange:     s.exchangeName,
+		CurrencyPair: s.pair,
+		AssetType:    s.asset,
+	}
+	if len(row) < 4 {
+		return t, fmt.Errorf("expected 4 columns, received %v", len(row))
+	}
+	v, err := strconv.ParseInt(row[0], 10, 64)
+	if err != nil {
+		return t, fmt.Errorf("could not process trade timestamp %v, %w", row[0], err)
+	}
+	t.Timestamp = time.Unix(v, 0).UTC()
+	t.Price, err = strconv.ParseFloat(row[1], 64)
+	if err != nil {
+		return t, fmt.Errorf("could not process trade price %v, %w", row[1], err)
+	}
+	t.Amount, err = strconv.ParseFloat(row[2], 64)
+	if err != nil {
+		return t, fmt.Errorf("could not process trade amount %v, %w", row[2], err)
+	}
+	t.Side, err = order.StringToOrderSide(row[3])
+	if err != nil {
+		return t, fmt.Errorf("could not process trade side %v, %w", row[3], err)
+	}
+	return t, nil
+}
+
+// Close closes the CSV file
+func (s *Source) Close() error {
+	return s.file.Close()
+}
@@ -0,0 +1,101 @@
+package csv
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+const testExchange = "binance"
+
+func TestLoadData(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	_, err := LoadData("", testExchange, gctkline.OneMin.Duration(), p, asset.Spot, 100)
+	if err == nil {
+		t.Error("expected error for missing file")
+	}
+
+	d, err := LoadData(
+		filepath.Join("..", "..", "..", "..", "testdata", "binance_BTCUSDT_24h-trades_2020_11_16.csv"),
+		testExchange,
+		gctkline.OneMin.Duration(),
+		p,
+		asset.Spot,
+		100)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = d.Load()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	var trades int
+	for ev := d.Next(); ev != nil; ev = d.Next() {
+		trades++
+	}
+	if trades != 1000 {
+		t.Errorf("received '%v' expected '%v'", trades, 1000)
+	}
+	if len(d.GetStream()) > 200 {
+		t.Errorf("received '%v' trades in memory expected no more than 200", len(d.GetStream()))
+	}
+}
+
+func TestNext(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	s := NewSource(ioutil.NopCloser(strings.NewReader("1605499846,15993.5,0.026472,BUY\n1605499847,15994.96,0.227,SELL\n")), testExchange, p, asset.Spot)
+	trades, err := s.Next(1)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(trades) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(trades), 1)
+	}
+	if trades[0].Price != 15993.5 || trades[0].Amount != 0.026472 || trades[0].Side != gctorder.Buy {
+		t.Errorf("received '%v' expected price 15993.5, amount 0.026472 and side BUY", trades[0])
+	}
+	if !trades[0].CurrencyPair.Equal(p) || trades[0].Exchange != testExchange || trades[0].AssetType != asset.Spot {
+		t.Error("expected trade to be populated with exchange, asset and pair")
+	}
+	trades, err = s.Next(5)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(trades) != 1 || trades[0].Side != gctorder.Sell {
+		t.Errorf("received '%v' expected a single sell", trades)
+	}
+	trades, err = s.Next(5)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(trades) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(trades), 0)
+	}
+	err = s.Close()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+
+	for _, row := range []string{
+		"1605499846,15993.5,0.026472",
+		"nope,15993.5,0.026472,BUY",
+		"1605499846,nope,0.026472,BUY",
+		"1605499846,15993.5,nope,BUY",
+		"1605499846,15993.5,0.026472,nope",
+	} {
+		s = NewSource(ioutil.NopCloser(strings.NewReader(row)), testExchange, p, asset.Spot)
+		_, err = s.Next(1)
+		if err == nil {
+			t.Errorf("expected error for row '%v'", row)
+		}
+	}
+}
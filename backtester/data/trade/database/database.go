@@ -0,0 +1,68 @@
+package database
+
+import (
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/trade"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gcttrade "github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+// Source reads trades in a date range from GoCryptoTrader's database a chunk
+// at a time, so the range is never loaded into memory at once
+type Source struct {
+	exchangeName string
+	pair         currency.Pair
+	asset        asset.Item
+	startDate    time.Time
+	endDate      time.Time
+	offset       int
+}
+
+// LoadData streams trades from an existing database using GoCryptoTrader's
+// database handling implementation in chunks of chunkSize. The database must
+// remain connected while the data is being streamed
+func LoadData(startDate, endDate time.Time, interval time.Duration, exchangeName string, fPair currency.Pair, a asset.Item, chunkSize int64) *trade.DataFromTrades {
+	exchangeName = strings.ToLower(exchangeName)
+	return &trade.DataFromTrades{
+		Exchange:  exchangeName,
+		Asset:     a,
+		Pair:      fPair,
+		Interval:  gctkline.Interval(interval),
+		ChunkSize: chunkSize,
+		Source: &Source{
+			exchangeName: exchangeName,
+			pair:         fPair,
+			asset:        a,
+			startDate:    startDate,
+			endDate:      endDate,
+		},
+	}
+}
+
+// Next reads up to limit trades following those already read
+func (s *Source) Next(limit int64) ([]gcttrade.Data, error) {
+	trades, err := gcttrade.GetTradesInRangeChunk(
+		s.exchangeName,
+		s.asset.String(),
+		s.pair.Base.String(),
+		s.pair.Quote.String(),
+		s.startDate,
+		s.endDate,
+		s.offset,
+		int(limit))
+	if err != nil {
+		return nil, err
+	}
+	s.offset += len(trades)
+	return trades, nil
+}
+
+// Close satisfies the trade.Source interface. The database connection is
+// owned by the caller
+func (s *Source) Close() error {
+	return nil
+}
@@ -0,0 +1,147 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	exchangeDB "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+const (
+	verbose      = false
+	testExchange = "binance"
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		fmt.Printf("failed to create temp file: %v", err)
+		os.Exit(1)
+	}
+
+	t := m.Run()
+
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestLoadData(t *testing.T) {
+	exch := testExchange
+	a := asset.Spot
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	var err error
+	bot := &engine.Engine{}
+	dbConfg := database.Config{
+		Enabled: true,
+		Verbose: false,
+		Driver:  "sqlite",
+		ConnectionDetails: drivers.ConnectionDetails{
+			Host:     "localhost",
+			Database: "test",
+		},
+	}
+	bot.Config = &config.Config{
+		Database: dbConfg,
+	}
+
+	err = bot.Config.CheckConfig()
+	if err != nil && verbose {
+		// this loads the database config to the global database
+		// the errors are unrelated and likely prone to change for reasons that
+		// this test does not need to care about
+
+		// so we only log the error if verbose
+		t.Log(err)
+	}
+	database.MigrationDir = filepath.Join("..", "..", "..", "..", "database", "migrations")
+	testhelpers.MigrationDir = filepath.Join("..", "..", "..", "..", "database", "migrations")
+	_, err = testhelpers.ConnectToDatabase(&dbConfg)
+	if err != nil {
+		t.Error(err)
+	}
+
+	bot.DatabaseManager, err = engine.SetupDatabaseConnectionManager(&bot.Config.Database)
+	if err != nil {
+		t.Error(err)
+	}
+	err = bot.DatabaseManager.Start(&bot.ServicesWG)
+	if err != nil {
+		t.Error(err)
+	}
+
+	err = exchangeDB.InsertMany([]exchangeDB.Details{{Name: testExchange}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dStart := time.Date(2020, 1, 0, 0, 0, 0, 0, time.UTC)
+	dInsert := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	dEnd := time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+	var trades []trade.Data
+	for i := 0; i < 5; i++ {
+		trades = append(trades, trade.Data{
+			ID:        fmt.Sprintf("%v", i),
+			TID:       fmt.Sprintf("%v", i),
+			Exchange:  exch,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+			AssetType: a.String(),
+			Price:     float64(1337 + i),
+			Amount:    1,
+			Side:      gctorder.Buy.String(),
+			Timestamp: dInsert.Add(time.Duration(i) * time.Minute),
+		})
+	}
+	err = trade.Insert(trades...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := LoadData(dStart, dEnd, gctkline.FifteenMin.Duration(), exch, p, a, 2)
+	err = d.Load()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	var i int64
+	for ev := d.Next(); ev != nil; ev = d.Next() {
+		if !ev.GetTime().Equal(dInsert.Add(time.Duration(i) * time.Minute)) {
+			t.Errorf("received '%v' expected '%v'", ev.GetTime(), dInsert.Add(time.Duration(i)*time.Minute))
+		}
+		i++
+	}
+	if i != 5 {
+		t.Errorf("received '%v' expected '%v'", i, 5)
+	}
+
+	err = bot.DatabaseManager.Stop()
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,203 @@
+package trade
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/trade"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// Load reads the first chunk of trades from the source
+func (d *DataFromTrades) Load() error {
+	if d.Source == nil {
+		return common.ErrNilArguments
+	}
+	if d.ChunkSize <= 0 {
+		d.ChunkSize = DefaultChunkSize
+	}
+	d.Base.Reset()
+	d.loaded = 0
+	d.lastTime = time.Time{}
+	d.exhausted = false
+	err := d.loadChunk()
+	if err != nil {
+		return err
+	}
+	if len(d.GetStream()) == 0 {
+		return fmt.Errorf("%v %v %v %w", d.Exchange, d.Asset, d.Pair, errNoTradeData)
+	}
+	return nil
+}
+
+// loadChunk reads the next chunk of trades from the source, releasing
+// processed trades beyond the chunk size. The source is closed once all
+// trades have been read
+func (d *DataFromTrades) loadChunk() error {
+	if d.exhausted {
+		return nil
+	}
+	trades, err := d.Source.Next(d.ChunkSize)
+	if err != nil {
+		return fmt.Errorf("could not read trades for %v %v %v, %w", d.Exchange, d.Asset, d.Pair, err)
+	}
+	if int64(len(trades)) < d.ChunkSize {
+		d.exhausted = true
+		err = d.Source.Close()
+		if err != nil {
+			log.Errorln(log.BackTester, err)
+		}
+	}
+	d.TrimHistory(int(d.ChunkSize))
+	events := make([]common.DataEventHandler, len(trades))
+	for i := range trades {
+		if trades[i].Timestamp.Before(d.lastTime) {
+			return fmt.Errorf("%v %v %v %w, %v is before %v", d.Exchange, d.Asset, d.Pair, errTradesOutOfOrder, trades[i].Timestamp, d.lastTime)
+		}
+		d.lastTime = trades[i].Timestamp
+		d.loaded++
+		events[i] = &trade.Trade{
+			Base: event.Base{
+				Offset:       d.loaded,
+				Exchange:     d.Exchange,
+				Time:         trades[i].Timestamp,
+				Interval:     d.Interval,
+				CurrencyPair: d.Pair,
+				AssetType:    d.Asset,
+			},
+			Price:  decimal.NewFromFloat(trades[i].Price),
+			Amount: decimal.NewFromFloat(trades[i].Amount),
+			Side:   trades[i].Side,
+			TID:    trades[i].TID,
+		}
+	}
+	d.AppendStream(events...)
+	return nil
+}
+
+// ensureLoaded reads chunks until there is an unprocessed trade or the
+// source has no further trades
+func (d *DataFromTrades) ensureLoaded() {
+	for len(d.List()) == 0 && !d.exhausted {
+		err := d.loadChunk()
+		if err != nil {
+			log.Errorln(log.BackTester, err)
+			d.exhausted = true
+		}
+	}
+}
+
+// Next returns the next trade, reading the next chunk when required
+func (d *DataFromTrades) Next() common.DataEventHandler {
+	d.ensureLoaded()
+	return d.Base.Next()
+}
+
+// PeekNext returns the next trade without processing it, allowing streams
+// to be processed in time order
+func (d *DataFromTrades) PeekNext() common.DataEventHandler {
+	d.ensureLoaded()
+	upcoming := d.List()
+	if len(upcoming) == 0 {
+		return nil
+	}
+	return upcoming[0]
+}
+
+// UpcomingTrades returns the unprocessed trades which occur before until,
+// reading further chunks when required
+func (d *DataFromTrades) UpcomingTrades(until time.Time) []*trade.Trade {
+	var resp []*trade.Trade
+	for i := 0; ; i++ {
+		upcoming := d.List()
+		if i >= len(upcoming) {
+			if d.exhausted {
+				return resp
+			}
+			err := d.loadChunk()
+			if err != nil {
+				log.Errorln(log.BackTester, err)
+				d.exhausted = true
+				return resp
+			}
+			upcoming = d.List()
+			if i >= len(upcoming) {
+				return resp
+			}
+		}
+		if !upcoming[i].GetTime().Before(until) {
+			return resp
+		}
+		if t, ok := upcoming[i].(*trade.Trade); ok {
+			resp = append(resp, t)
+		}
+	}
+}
+
+// HasDataAtTime returns whether the latest trade occurred at the time provided
+func (d *DataFromTrades) HasDataAtTime(t time.Time) bool {
+	latest := d.Latest()
+	return latest != nil && latest.GetTime().Equal(t)
+}
+
+// Reset closes the source and returns the stream to a blank state
+func (d *DataFromTrades) Reset() {
+	if d.Source != nil && !d.exhausted {
+		err := d.Source.Close()
+		if err != nil {
+			log.Errorln(log.BackTester, err)
+		}
+	}
+	d.exhausted = true
+	d.Base.Reset()
+}
+
+// StreamOpen returns the price of all retained trades until the current iteration
+func (d *DataFromTrades) StreamOpen() []decimal.Decimal {
+	return d.streamPrices()
+}
+
+// StreamHigh returns the price of all retained trades until the current iteration
+func (d *DataFromTrades) StreamHigh() []decimal.Decimal {
+	return d.streamPrices()
+}
+
+// StreamLow returns the price of all retained trades until the current iteration
+func (d *DataFromTrades) StreamLow() []decimal.Decimal {
+	return d.streamPrices()
+}
+
+// StreamClose returns the price of all retained trades until the current iteration
+func (d *DataFromTrades) StreamClose() []decimal.Decimal {
+	return d.streamPrices()
+}
+
+// StreamVol returns the amount of all retained trades until the current iteration
+func (d *DataFromTrades) StreamVol() []decimal.Decimal {
+	history := d.History()
+	ret := make([]decimal.Decimal, len(history))
+	for i := range history {
+		if val, ok := history[i].(*trade.Trade); ok {
+			ret[i] = val.Amount
+		} else {
+			log.Errorf(log.BackTester, "incorrect data loaded into stream")
+		}
+	}
+	return ret
+}
+
+func (d *DataFromTrades) streamPrices() []decimal.Decimal {
+	history := d.History()
+	ret := make([]decimal.Decimal, len(history))
+	for i := range history {
+		if val, ok := history[i].(*trade.Trade); ok {
+			ret[i] = val.Price
+		} else {
+			log.Errorf(log.BackTester, "incorrect data loaded into stream")
+		}
+	}
+	return ret
+}
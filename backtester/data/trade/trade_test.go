@@ -0,0 +1,235 @@
+package trade
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	gcttrade "github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+const testExchange = "binance"
+
+var (
+	errTest   = errors.New("test")
+	startTime = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+type fakeSource struct {
+	trades []gcttrade.Data
+	reads  int
+	closed bool
+	err    error
+}
+
+func (f *fakeSource) Next(limit int64) ([]gcttrade.Data, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.reads++
+	if int64(len(f.trades)) < limit {
+		limit = int64(len(f.trades))
+	}
+	resp := f.trades[:limit]
+	f.trades = f.trades[limit:]
+	return resp, nil
+}
+
+func (f *fakeSource) Close() error {
+	f.closed = true
+	return nil
+}
+
+func testData(trades int, chunkSize int64) (*DataFromTrades, *fakeSource) {
+	src := &fakeSource{}
+	for i := 0; i < trades; i++ {
+		src.trades = append(src.trades, gcttrade.Data{
+			Timestamp: startTime.Add(time.Duration(i) * time.Second),
+			Price:     float64(i + 1),
+			Amount:    float64(i + 10),
+			Side:      gctorder.Buy,
+		})
+	}
+	return &DataFromTrades{
+		Exchange:  testExchange,
+		Asset:     asset.Spot,
+		Pair:      currency.NewPair(currency.BTC, currency.USDT),
+		Interval:  gctkline.OneMin,
+		ChunkSize: chunkSize,
+		Source:    src,
+	}, src
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+	d := &DataFromTrades{}
+	err := d.Load()
+	if !errors.Is(err, common.ErrNilArguments) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilArguments)
+	}
+
+	d, _ = testData(0, 2)
+	err = d.Load()
+	if !errors.Is(err, errNoTradeData) {
+		t.Errorf("received '%v' expected '%v'", err, errNoTradeData)
+	}
+
+	d, src := testData(1, 0)
+	src.err = errTest
+	err = d.Load()
+	if !errors.Is(err, errTest) {
+		t.Errorf("received '%v' expected '%v'", err, errTest)
+	}
+	if d.ChunkSize != DefaultChunkSize {
+		t.Errorf("received '%v' expected '%v'", d.ChunkSize, DefaultChunkSize)
+	}
+
+	d, src = testData(5, 2)
+	err = d.Load()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(d.GetStream()) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(d.GetStream()), 2)
+	}
+	if src.closed {
+		t.Error("expected source to remain open")
+	}
+
+	d, src = testData(5, 2)
+	src.trades[1].Timestamp = startTime.Add(-time.Second)
+	err = d.Load()
+	if !errors.Is(err, errTradesOutOfOrder) {
+		t.Errorf("received '%v' expected '%v'", err, errTradesOutOfOrder)
+	}
+}
+
+func TestNext(t *testing.T) {
+	t.Parallel()
+	d, src := testData(5, 2)
+	err := d.Load()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	for i := 0; i < 5; i++ {
+		ev := d.Next()
+		if ev == nil {
+			t.Fatalf("expected trade %v", i)
+		}
+		if ev.GetOffset() != int64(i+1) {
+			t.Errorf("received '%v' expected '%v'", ev.GetOffset(), i+1)
+		}
+		if !ev.GetClosePrice().Equal(decimal.NewFromInt(int64(i + 1))) {
+			t.Errorf("received '%v' expected '%v'", ev.GetClosePrice(), i+1)
+		}
+		if len(d.GetStream()) > 4 {
+			t.Errorf("received '%v' trades in memory expected no more than 4", len(d.GetStream()))
+		}
+	}
+	if d.Next() != nil {
+		t.Error("expected nil")
+	}
+	if !src.closed {
+		t.Error("expected source to be closed")
+	}
+	if src.reads != 3 {
+		t.Errorf("received '%v' expected '%v'", src.reads, 3)
+	}
+	if !d.HasDataAtTime(startTime.Add(4 * time.Second)) {
+		t.Error("expected data at latest trade time")
+	}
+	if d.HasDataAtTime(startTime) {
+		t.Error("expected no data at earlier trade time")
+	}
+}
+
+func TestPeekNext(t *testing.T) {
+	t.Parallel()
+	d, _ := testData(3, 2)
+	err := d.Load()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	d.Next()
+	d.Next()
+	peek := d.PeekNext()
+	if peek == nil || peek.GetOffset() != 3 {
+		t.Fatal("expected third trade")
+	}
+	if d.Next() != peek {
+		t.Error("expected peeked trade to be next")
+	}
+	if d.PeekNext() != nil {
+		t.Error("expected nil")
+	}
+}
+
+func TestUpcomingTrades(t *testing.T) {
+	t.Parallel()
+	d, _ := testData(6, 2)
+	err := d.Load()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	d.Next()
+	upcoming := d.UpcomingTrades(startTime.Add(4 * time.Second))
+	if len(upcoming) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(upcoming), 3)
+	}
+	if upcoming[0].GetOffset() != 2 || upcoming[2].GetOffset() != 4 {
+		t.Error("expected upcoming trades in order")
+	}
+	if d.Offset() != 1 {
+		t.Errorf("received '%v' expected '%v'", d.Offset(), 1)
+	}
+	upcoming = d.UpcomingTrades(startTime.Add(time.Hour))
+	if len(upcoming) != 5 {
+		t.Errorf("received '%v' expected '%v'", len(upcoming), 5)
+	}
+	upcoming = d.UpcomingTrades(startTime)
+	if len(upcoming) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(upcoming), 0)
+	}
+}
+
+func TestStreams(t *testing.T) {
+	t.Parallel()
+	d, _ := testData(3, 10)
+	err := d.Load()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	d.Next()
+	d.Next()
+	for _, s := range [][]decimal.Decimal{d.StreamOpen(), d.StreamHigh(), d.StreamLow(), d.StreamClose()} {
+		if len(s) != 2 || !s[1].Equal(decimal.NewFromInt(2)) {
+			t.Errorf("received '%v' expected '%v'", s, []int{1, 2})
+		}
+	}
+	vol := d.StreamVol()
+	if len(vol) != 2 || !vol[1].Equal(decimal.NewFromInt(11)) {
+		t.Errorf("received '%v' expected '%v'", vol, []int{10, 11})
+	}
+}
+
+func TestReset(t *testing.T) {
+	t.Parallel()
+	d, src := testData(3, 2)
+	err := d.Load()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	d.Reset()
+	if !src.closed {
+		t.Error("expected source to be closed")
+	}
+	if d.Next() != nil {
+		t.Error("expected nil")
+	}
+}
@@ -0,0 +1,45 @@
+package trade
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gcttrade "github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+// DefaultChunkSize is the number of trades loaded at a time when no chunk
+// size is configured
+const DefaultChunkSize = 10000
+
+var (
+	errNoTradeData      = errors.New("no trade data provided")
+	errTradesOutOfOrder = errors.New("trades must be provided in time order")
+)
+
+// Source supplies trades in time order, a chunk at a time
+type Source interface {
+	// Next returns up to limit of the following trades, returning no trades
+	// once all trades have been read
+	Next(limit int64) ([]gcttrade.Data, error)
+	Close() error
+}
+
+// DataFromTrades is a struct which implements the data.Handler interface
+// Trades are streamed from its source in chunks and emitted as individual
+// data events, with only the latest chunk of processed trades kept in memory
+type DataFromTrades struct {
+	data.Base
+	Exchange  string
+	Asset     asset.Item
+	Pair      currency.Pair
+	Interval  gctkline.Interval
+	ChunkSize int64
+	Source    Source
+	loaded    int64
+	lastTime  time.Time
+	exhausted bool
+}
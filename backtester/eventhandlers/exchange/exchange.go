@@ -12,6 +12,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/trade"
 	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/engine"
@@ -60,9 +61,25 @@ func (e *Exchange) ExecuteOrder(o order.Event, data data.Handler, orderManager *
 
 	volStr := data.StreamVol()
 	volume := volStr[len(volStr)-1]
-	var adjustedPrice, amount decimal.Decimal
+	var adjustedPrice, amount, restingAmount decimal.Decimal
+	feeRate := cs.ExchangeFee
 
-	if cs.UseRealOrders {
+	var ob *orderbook.Base
+	var isReplay bool
+	var trades []*trade.Trade
+	var isTick bool
+	if !cs.UseRealOrders {
+		// orders are matched against the orderbook as it is at the close of the candle
+		ob, isReplay, err = replayedOrderbook(data, o.GetTime().Add(o.GetInterval().Duration()))
+		if err != nil {
+			return f, err
+		}
+		// orders are matched against the trades which follow within the interval
+		trades, isTick = upcomingTrades(data, o.GetTime().Add(o.GetInterval().Duration()))
+	}
+
+	switch {
+	case cs.UseRealOrders:
 		// get current orderbook
 		var ob *orderbook.Base
 		ob, err = orderbook.Get(f.Exchange, f.CurrencyPair, f.AssetType)
@@ -72,8 +89,31 @@ func (e *Exchange) ExecuteOrder(o order.Event, data data.Handler, orderManager *
 		// calculate an estimated slippage rate
 		adjustedPrice, amount = slippage.CalculateSlippageByOrderbook(ob, o.GetDirection(), eventFunds, f.ExchangeFee)
 		f.Slippage = adjustedPrice.Sub(f.ClosePrice).Div(f.ClosePrice).Mul(decimal.NewFromInt(100))
-	} else {
-		adjustedPrice, amount, err = e.sizeOfflineOrder(high, low, volume, &cs, f)
+	case isReplay:
+		feeRate = cs.TakerFee
+		adjustedPrice, amount, restingAmount, err = sizeOrderbookOrder(ob, o, f)
+		if err != nil {
+			fundErr := funds.Release(eventFunds, eventFunds, f.GetDirection())
+			if fundErr != nil {
+				f.AppendReason(fundErr.Error())
+			}
+			if f.GetDirection() == gctorder.Buy {
+				f.SetDirection(common.CouldNotBuy)
+			} else {
+				f.SetDirection(common.CouldNotSell)
+			}
+			f.AppendReason(err.Error())
+			return f, err
+		}
+		if amount.IsZero() {
+			return e.placeRestingOrder(o, ob, restingAmount, eventFunds, &cs, f, funds)
+		}
+	default:
+		if isTick {
+			adjustedPrice, amount, err = sizeTickOrder(trades, &cs, f)
+		} else {
+			adjustedPrice, amount, err = e.sizeOfflineOrder(high, low, volume, &cs, f)
+		}
 		if err != nil {
 			switch f.GetDirection() {
 			case gctorder.Buy:
@@ -88,9 +128,23 @@ func (e *Exchange) ExecuteOrder(o order.Event, data data.Handler, orderManager *
 		}
 	}
 
-	portfolioLimitedAmount := reduceAmountToFitPortfolioLimit(adjustedPrice, amount, eventFunds, f.GetDirection())
+	pos := funds.GetPosition()
+	var portfolioLimitedAmount decimal.Decimal
+	if pos != nil {
+		portfolioLimitedAmount = reduceAmountToFitPositionLimit(adjustedPrice, amount, eventFunds, f.GetDirection(), pos)
+	} else {
+		portfolioLimitedAmount = reduceAmountToFitPortfolioLimit(adjustedPrice, amount, eventFunds, f.GetDirection())
+	}
 	if !portfolioLimitedAmount.Equal(amount) {
 		f.AppendReason(fmt.Sprintf("Order size shrunk from %v to %v to remain within portfolio limits", amount, portfolioLimitedAmount))
+		if isReplay {
+			// a smaller order walks less of the orderbook
+			var limitPrice decimal.Decimal
+			if o.GetOrderType() == gctorder.Limit {
+				limitPrice = o.GetPrice()
+			}
+			adjustedPrice, _ = matchOrderbook(ob, f.GetDirection(), portfolioLimitedAmount, limitPrice)
+		}
 	}
 
 	limitReducedAmount := portfolioLimitedAmount
@@ -108,7 +162,7 @@ func (e *Exchange) ExecuteOrder(o order.Event, data data.Handler, orderManager *
 	if err != nil {
 		return f, err
 	}
-	f.ExchangeFee = calculateExchangeFee(adjustedPrice, limitReducedAmount, cs.ExchangeFee)
+	f.ExchangeFee = calculateExchangeFee(adjustedPrice, limitReducedAmount, feeRate)
 
 	orderID, err := e.placeOrder(context.TODO(), adjustedPrice, limitReducedAmount, cs.UseRealOrders, cs.CanUseExchangeLimits, f, orderManager)
 	if err != nil {
@@ -123,15 +177,33 @@ func (e *Exchange) ExecuteOrder(o order.Event, data data.Handler, orderManager *
 		}
 		return f, err
 	}
-	switch f.GetDirection() {
-	case gctorder.Buy:
-		err = funds.Release(eventFunds, eventFunds.Sub(limitReducedAmount.Mul(adjustedPrice)), f.GetDirection())
+	// funds for the resting remainder of a limit order stay reserved
+	var restingFunds decimal.Decimal
+	if restingAmount.IsPositive() {
+		restingFunds = restingReserve(o, pos, restingAmount, eventFunds)
+	}
+	usedFunds := eventFunds.Sub(restingFunds)
+	switch {
+	case pos != nil:
+		// margin is taken from the collateral by the position itself
+		if usedFunds.IsPositive() {
+			err = funds.Release(usedFunds, usedFunds, f.GetDirection())
+			if err != nil {
+				return f, err
+			}
+		}
+		err = pos.Fill(f.GetDirection(), limitReducedAmount, adjustedPrice, f.ExchangeFee, o.GetTime())
+		if err != nil {
+			return f, err
+		}
+	case f.GetDirection() == gctorder.Buy:
+		err = funds.Release(usedFunds, usedFunds.Sub(limitReducedAmount.Mul(adjustedPrice)), f.GetDirection())
 		if err != nil {
 			return f, err
 		}
 		funds.IncreaseAvailable(limitReducedAmount, f.GetDirection())
-	case gctorder.Sell:
-		err = funds.Release(eventFunds, eventFunds.Sub(limitReducedAmount), f.GetDirection())
+	case f.GetDirection() == gctorder.Sell:
+		err = funds.Release(usedFunds, usedFunds.Sub(limitReducedAmount), f.GetDirection())
 		if err != nil {
 			return f, err
 		}
@@ -146,6 +218,12 @@ func (e *Exchange) ExecuteOrder(o order.Event, data data.Handler, orderManager *
 		ords[i].Date = o.GetTime()
 		ords[i].LastUpdated = o.GetTime()
 		ords[i].CloseTime = o.GetTime()
+		if pos != nil {
+			ords[i].Leverage, _ = pos.Leverage().Float64()
+		}
+		if o.GetOrderType() == gctorder.Limit {
+			ords[i].Type = gctorder.Limit
+		}
 		f.Order = &ords[i]
 		f.PurchasePrice = decimal.NewFromFloat(ords[i].Price)
 		f.Total = f.PurchasePrice.Mul(limitReducedAmount).Add(f.ExchangeFee)
@@ -154,6 +232,14 @@ func (e *Exchange) ExecuteOrder(o order.Event, data data.Handler, orderManager *
 	if f.Order == nil {
 		return nil, fmt.Errorf("placed order %v not found in order manager", orderID)
 	}
+	if isReplay {
+		// only the matched amount has been filled
+		f.Amount = limitReducedAmount
+	}
+	if restingAmount.IsPositive() {
+		e.restingOrders = append(e.restingOrders, newRestingOrder(o, ob, restingAmount, restingFunds))
+		f.AppendReason(fmt.Sprintf("Remaining %v resting at limit price %v", restingAmount, o.GetPrice()))
+	}
 
 	return f, nil
 }
@@ -220,6 +306,19 @@ func reduceAmountToFitPortfolioLimit(adjustedPrice, amount, sizedPortfolioTotal
 	return amount
 }
 
+// reduceAmountToFitPositionLimit limits a leveraged order to the amount which
+// reduces the position plus the amount the reserved margin can open
+func reduceAmountToFitPositionLimit(adjustedPrice, amount, reservedMargin decimal.Decimal, side gctorder.Side, pos *funding.Position) decimal.Decimal {
+	if !adjustedPrice.IsPositive() {
+		return amount
+	}
+	limit := pos.ReducibleAmount(side).Add(reservedMargin.Mul(pos.Leverage()).Div(adjustedPrice))
+	if amount.GreaterThan(limit) {
+		amount = limit
+	}
+	return amount
+}
+
 func (e *Exchange) placeOrder(ctx context.Context, price, amount decimal.Decimal, useRealOrders, useExchangeLimits bool, f *fill.Fill, orderManager *engine.OrderManager) (string, error) {
 	if f == nil {
 		return "", common.ErrNilEvent
@@ -13,6 +13,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -29,6 +30,9 @@ func (f *fakeFund) IncreaseAvailable(decimal.Decimal, gctorder.Side) {}
 func (f *fakeFund) Release(decimal.Decimal, decimal.Decimal, gctorder.Side) error {
 	return nil
 }
+func (f *fakeFund) GetPosition() *funding.Position {
+	return nil
+}
 
 func TestReset(t *testing.T) {
 	t.Parallel()
@@ -156,7 +160,7 @@ func TestPlaceOrder(t *testing.T) {
 	}
 	em.Add(exch)
 	bot.ExchangeManager = em
-	bot.OrderManager, err = engine.SetupOrderManager(em, &engine.CommunicationManager{}, &bot.ServicesWG, false)
+	bot.OrderManager, err = engine.SetupOrderManager(em, &engine.CommunicationManager{}, nil, &bot.ServicesWG, false)
 	if err != nil {
 		t.Error(err)
 	}
@@ -214,7 +218,7 @@ func TestExecuteOrder(t *testing.T) {
 	}
 	em.Add(exch)
 	bot.ExchangeManager = em
-	bot.OrderManager, err = engine.SetupOrderManager(em, &engine.CommunicationManager{}, &bot.ServicesWG, false)
+	bot.OrderManager, err = engine.SetupOrderManager(em, &engine.CommunicationManager{}, nil, &bot.ServicesWG, false)
 	if err != nil {
 		t.Error(err)
 	}
@@ -319,7 +323,7 @@ func TestExecuteOrderBuySellSizeLimit(t *testing.T) {
 
 	em.Add(exch)
 	bot.ExchangeManager = em
-	bot.OrderManager, err = engine.SetupOrderManager(em, &engine.CommunicationManager{}, &bot.ServicesWG, false)
+	bot.OrderManager, err = engine.SetupOrderManager(em, &engine.CommunicationManager{}, nil, &bot.ServicesWG, false)
 	if err != nil {
 		t.Error(err)
 	}
@@ -506,6 +510,54 @@ func TestReduceAmountToFitPortfolioLimit(t *testing.T) {
 	}
 }
 
+func TestReduceAmountToFitPositionLimit(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	f := funding.SetupFundingManager(false, true)
+	b, err := funding.CreateItem(testExchange, asset.PerpetualSwap, currency.BTC, decimal.Zero, decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := funding.CreateItem(testExchange, asset.PerpetualSwap, currency.USDT, decimal.NewFromInt(1000), decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := funding.CreatePair(b, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = f.AddPair(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pos, err := funding.CreatePosition(testExchange, asset.PerpetualSwap, cp, decimal.NewFromInt(10), decimal.Zero, decimal.Zero, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = f.AddPosition(pos)
+	if err != nil {
+		t.Fatal(err)
+	}
+	price := decimal.NewFromInt(100)
+	finalAmount := reduceAmountToFitPositionLimit(price, decimal.NewFromInt(5), decimal.NewFromInt(20), gctorder.Buy, pos)
+	if !finalAmount.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received %v expected %v", finalAmount, 2)
+	}
+	finalAmount = reduceAmountToFitPositionLimit(decimal.Zero, decimal.NewFromInt(5), decimal.NewFromInt(20), gctorder.Buy, pos)
+	if !finalAmount.Equal(decimal.NewFromInt(5)) {
+		t.Errorf("received %v expected %v", finalAmount, 5)
+	}
+	err = pos.Fill(gctorder.Buy, decimal.NewFromInt(1), price, decimal.Zero, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// closing the long requires no margin
+	finalAmount = reduceAmountToFitPositionLimit(price, decimal.NewFromInt(5), decimal.Zero, gctorder.Sell, pos)
+	if !finalAmount.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("received %v expected %v", finalAmount, 1)
+	}
+}
+
 func TestVerifyOrderWithinLimits(t *testing.T) {
 	t.Parallel()
 	err := verifyOrderWithinLimits(nil, decimal.Zero, nil)
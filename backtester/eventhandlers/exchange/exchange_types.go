@@ -4,7 +4,9 @@ import (
 	"errors"
 
 	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
 	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
@@ -19,6 +21,8 @@ var (
 	errExceededPortfolioLimit = errors.New("exceeded portfolio limit")
 	errNilCurrencySettings    = errors.New("received nil currency settings")
 	errInvalidDirection       = errors.New("received invalid order direction")
+	errNoOrderbookLiquidity   = errors.New("no orderbook liquidity to match order")
+	errNoUpcomingTrades       = errors.New("no upcoming trades to match order")
 )
 
 // ExecutionHandler interface dictates what functions are required to submit an order
@@ -26,12 +30,15 @@ type ExecutionHandler interface {
 	SetExchangeAssetCurrencySettings(string, asset.Item, currency.Pair, *Settings)
 	GetCurrencySettings(string, asset.Item, currency.Pair) (Settings, error)
 	ExecuteOrder(order.Event, data.Handler, *engine.OrderManager, funding.IPairReleaser) (*fill.Fill, error)
+	ProcessRestingOrders(common.DataEventHandler, data.Handler, *engine.OrderManager, funding.IPairReleaser) ([]*fill.Fill, error)
 	Reset()
 }
 
 // Exchange contains all the currency settings
+// along with limit orders resting on replayed orderbooks
 type Exchange struct {
 	CurrencySettings []Settings
+	restingOrders    []*restingOrder
 }
 
 // Settings allow the eventhandler to size an order within the limitations set by the config file
@@ -73,3 +80,21 @@ type Leverage struct {
 	MaximumOrdersWithLeverageRatio decimal.Decimal
 	MaximumLeverageRate            decimal.Decimal
 }
+
+// restingOrder is the unfilled remainder of a limit order resting on a
+// replayed orderbook. Its reserved funds are released as it is filled
+type restingOrder struct {
+	event.Base
+	side      gctorder.Side
+	price     decimal.Decimal
+	amount    decimal.Decimal
+	remaining decimal.Decimal
+	reserved  decimal.Decimal
+	// queueAhead is the amount at the order's price level which
+	// must trade before the order can be filled
+	queueAhead  decimal.Decimal
+	levelAmount decimal.Decimal
+	// crossed is the opposing liquidity at or better than the order's
+	// price that has already been matched or observed
+	crossed decimal.Decimal
+}
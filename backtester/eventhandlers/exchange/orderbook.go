@@ -0,0 +1,356 @@
+package exchange
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// ProcessRestingOrders assesses any resting limit orders for the data event's
+// exchange, asset and pair against the replayed orderbook, returning a fill
+// event for every order which has been fully or partially filled
+func (e *Exchange) ProcessRestingOrders(ev common.DataEventHandler, d data.Handler, orderManager *engine.OrderManager, funds funding.IPairReleaser) ([]*fill.Fill, error) {
+	if ev == nil || d == nil || orderManager == nil || funds == nil {
+		return nil, common.ErrNilArguments
+	}
+	if len(e.restingOrders) == 0 {
+		return nil, nil
+	}
+	ob, isReplay, err := replayedOrderbook(d, ev.GetTime().Add(ev.GetInterval().Duration()))
+	if err != nil || !isReplay {
+		return nil, err
+	}
+	cs, err := e.GetCurrencySettings(ev.GetExchange(), ev.GetAssetType(), ev.Pair())
+	if err != nil {
+		return nil, err
+	}
+	var resp []*fill.Fill
+	for i := 0; i < len(e.restingOrders); i++ {
+		ro := e.restingOrders[i]
+		if ro.Exchange != ev.GetExchange() ||
+			ro.AssetType != ev.GetAssetType() ||
+			!ro.CurrencyPair.Equal(ev.Pair()) {
+			continue
+		}
+		filled := ro.match(ob)
+		if !filled.IsPositive() {
+			continue
+		}
+		var f *fill.Fill
+		f, err = e.fillRestingOrder(ev, ro, filled, &cs, orderManager, funds)
+		if err != nil {
+			return resp, err
+		}
+		resp = append(resp, f)
+		if !ro.remaining.IsPositive() {
+			e.restingOrders = append(e.restingOrders[:i], e.restingOrders[i+1:]...)
+			i--
+		}
+	}
+	return resp, nil
+}
+
+// replayedOrderbook returns the orderbook at the time provided when the data
+// handler replays recorded orderbook data
+func replayedOrderbook(d data.Handler, t time.Time) (*orderbook.Base, bool, error) {
+	obs, ok := d.(data.OrderbookStreamer)
+	if !ok {
+		return nil, false, nil
+	}
+	ob, err := obs.OrderbookAtTime(t)
+	if err != nil {
+		return nil, true, err
+	}
+	return ob, true, nil
+}
+
+// sizeOrderbookOrder matches the order against the replayed orderbook.
+// Market orders which exhaust the orderbook are partially filled, while the
+// unmatched remainder of a limit order is returned so it can rest on the orderbook
+func sizeOrderbookOrder(ob *orderbook.Base, o order.Event, f *fill.Fill) (adjustedPrice, amount, restingAmount decimal.Decimal, err error) {
+	if ob == nil || o == nil || f == nil {
+		return decimal.Zero, decimal.Zero, decimal.Zero, common.ErrNilArguments
+	}
+	var limitPrice decimal.Decimal
+	if o.GetOrderType() == gctorder.Limit {
+		limitPrice = o.GetPrice()
+	}
+	adjustedPrice, amount = matchOrderbook(ob, f.GetDirection(), f.Amount, limitPrice)
+	f.VolumeAdjustedPrice = f.ClosePrice
+	if adjustedPrice.IsPositive() && f.ClosePrice.IsPositive() {
+		f.Slippage = adjustedPrice.Sub(f.ClosePrice).Div(f.ClosePrice).Mul(decimal.NewFromInt(100))
+	}
+	if limitPrice.IsPositive() {
+		restingAmount = f.Amount.Sub(amount)
+		return adjustedPrice, amount, restingAmount, nil
+	}
+	if amount.IsZero() {
+		return decimal.Zero, decimal.Zero, decimal.Zero, errNoOrderbookLiquidity
+	}
+	if amount.LessThan(f.Amount) {
+		f.AppendReason(fmt.Sprintf("Order partially filled %v of %v, orderbook liquidity exhausted and remainder cancelled", amount, f.Amount))
+	}
+	return adjustedPrice, amount, decimal.Zero, nil
+}
+
+// matchOrderbook walks the opposing side of the orderbook until the amount
+// is filled or the limit price is reached, returning the volume weighted
+// average price and the amount filled. A zero limit price is unbounded
+func matchOrderbook(ob *orderbook.Base, side gctorder.Side, amount, limitPrice decimal.Decimal) (averagePrice, filled decimal.Decimal) {
+	levels := ob.Asks
+	if side == gctorder.Sell {
+		levels = ob.Bids
+	}
+	var cost decimal.Decimal
+	for i := range levels {
+		remaining := amount.Sub(filled)
+		if !remaining.IsPositive() {
+			break
+		}
+		price := decimal.NewFromFloat(levels[i].Price)
+		if limitPrice.IsPositive() && !priceCrosses(side, price, limitPrice) {
+			break
+		}
+		available := decimal.NewFromFloat(levels[i].Amount)
+		if available.GreaterThan(remaining) {
+			available = remaining
+		}
+		cost = cost.Add(available.Mul(price))
+		filled = filled.Add(available)
+	}
+	if filled.IsPositive() {
+		averagePrice = cost.Div(filled)
+	}
+	return averagePrice, filled
+}
+
+// priceCrosses returns whether an opposing orderbook price can fill an order at the limit price
+func priceCrosses(side gctorder.Side, price, limitPrice decimal.Decimal) bool {
+	if side == gctorder.Buy {
+		return price.LessThanOrEqual(limitPrice)
+	}
+	return price.GreaterThanOrEqual(limitPrice)
+}
+
+// crossingAmount returns the opposing liquidity at or better than the limit price
+func crossingAmount(ob *orderbook.Base, side gctorder.Side, limitPrice decimal.Decimal) decimal.Decimal {
+	levels := ob.Asks
+	if side == gctorder.Sell {
+		levels = ob.Bids
+	}
+	var resp decimal.Decimal
+	for i := range levels {
+		if !priceCrosses(side, decimal.NewFromFloat(levels[i].Price), limitPrice) {
+			break
+		}
+		resp = resp.Add(decimal.NewFromFloat(levels[i].Amount))
+	}
+	return resp
+}
+
+// levelAmount returns the amount resting at a price on the order's own side of the orderbook
+func levelAmount(ob *orderbook.Base, side gctorder.Side, price decimal.Decimal) decimal.Decimal {
+	levels := ob.Bids
+	if side == gctorder.Sell {
+		levels = ob.Asks
+	}
+	for i := range levels {
+		if decimal.NewFromFloat(levels[i].Price).Equal(price) {
+			return decimal.NewFromFloat(levels[i].Amount)
+		}
+	}
+	return decimal.Zero
+}
+
+// placeRestingOrder rests a limit order which could not be matched on
+// placement. No fill occurs until the orderbook reaches its price
+func (e *Exchange) placeRestingOrder(o order.Event, ob *orderbook.Base, amount, reserved decimal.Decimal, cs *Settings, f *fill.Fill, funds funding.IPairReleaser) (*fill.Fill, error) {
+	err := verifyOrderWithinLimits(f, amount, cs)
+	if err != nil {
+		if reserved.IsPositive() {
+			fundErr := funds.Release(reserved, reserved, o.GetDirection())
+			if fundErr != nil {
+				f.AppendReason(fundErr.Error())
+			}
+		}
+		return f, err
+	}
+	e.restingOrders = append(e.restingOrders, newRestingOrder(o, ob, amount, reserved))
+	f.AppendReason(fmt.Sprintf("Limit order for %v resting at %v", amount, o.GetPrice()))
+	f.SetDirection(common.DoNothing)
+	// nothing has been filled yet
+	f.Amount = decimal.Zero
+	return f, nil
+}
+
+// restingReserve returns the portion of an order's reserved funds
+// which remains reserved for its resting amount
+func restingReserve(o order.Event, pos *funding.Position, amount, reserved decimal.Decimal) decimal.Decimal {
+	var resp decimal.Decimal
+	switch {
+	case pos != nil:
+		if o.GetAmount().IsPositive() {
+			resp = reserved.Mul(amount).Div(o.GetAmount())
+		}
+	case o.GetDirection() == gctorder.Buy:
+		resp = amount.Mul(o.GetPrice())
+	default:
+		resp = amount
+	}
+	if resp.GreaterThan(reserved) {
+		resp = reserved
+	}
+	return resp
+}
+
+// newRestingOrder places the remainder of a limit order at the back of the
+// queue of its price level. Any opposing liquidity which crossed the limit
+// price at placement has already been consumed by the order
+func newRestingOrder(o order.Event, ob *orderbook.Base, amount, reserved decimal.Decimal) *restingOrder {
+	queue := levelAmount(ob, o.GetDirection(), o.GetPrice())
+	return &restingOrder{
+		Base: event.Base{
+			Exchange:     o.GetExchange(),
+			AssetType:    o.GetAssetType(),
+			CurrencyPair: o.Pair(),
+			Interval:     o.GetInterval(),
+		},
+		side:        o.GetDirection(),
+		price:       o.GetPrice(),
+		amount:      o.GetAmount(),
+		remaining:   amount,
+		reserved:    reserved,
+		queueAhead:  queue,
+		levelAmount: queue,
+		crossed:     crossingAmount(ob, o.GetDirection(), o.GetPrice()),
+	}
+}
+
+// match returns the amount of the resting order filled by the latest
+// orderbook. The order fills when the market trades through its price,
+// or when the amount at its price level falls by more than the amount
+// queued ahead of it. Reductions are assumed to be trades as recorded
+// orderbooks cannot distinguish trades from cancellations
+func (r *restingOrder) match(ob *orderbook.Base) decimal.Decimal {
+	var filled decimal.Decimal
+	crossed := crossingAmount(ob, r.side, r.price)
+	if crossed.GreaterThan(r.crossed) {
+		// only liquidity which has not previously been seen is matched
+		// to prevent the same replayed orders filling repeatedly
+		filled = crossed.Sub(r.crossed)
+		r.queueAhead = decimal.Zero
+	}
+	r.crossed = crossed
+
+	current := levelAmount(ob, r.side, r.price)
+	if current.LessThan(r.levelAmount) {
+		traded := r.levelAmount.Sub(current)
+		if traded.GreaterThan(r.queueAhead) {
+			filled = filled.Add(traded.Sub(r.queueAhead))
+			r.queueAhead = decimal.Zero
+		} else {
+			r.queueAhead = r.queueAhead.Sub(traded)
+		}
+	}
+	r.levelAmount = current
+	if filled.GreaterThan(r.remaining) {
+		filled = r.remaining
+	}
+	return filled
+}
+
+// fillRestingOrder places a maker order for the filled amount of a resting
+// limit order and settles the proportion of its reserved funds
+func (e *Exchange) fillRestingOrder(ev common.DataEventHandler, r *restingOrder, amount decimal.Decimal, cs *Settings, orderManager *engine.OrderManager, funds funding.IPairReleaser) (*fill.Fill, error) {
+	f := &fill.Fill{
+		Base: event.Base{
+			Offset:       ev.GetOffset(),
+			Exchange:     r.Exchange,
+			Time:         ev.GetTime(),
+			CurrencyPair: r.CurrencyPair,
+			AssetType:    r.AssetType,
+			Interval:     r.Interval,
+		},
+		Direction:           r.side,
+		Amount:              amount,
+		ClosePrice:          ev.GetClosePrice(),
+		VolumeAdjustedPrice: ev.GetClosePrice(),
+	}
+	f.AppendReason(fmt.Sprintf("Resting limit order filled %v of %v at %v", amount, r.amount, r.price))
+	if f.ClosePrice.IsPositive() {
+		f.Slippage = r.price.Sub(f.ClosePrice).Div(f.ClosePrice).Mul(decimal.NewFromInt(100))
+	}
+	f.ExchangeFee = calculateExchangeFee(r.price, amount, cs.MakerFee)
+
+	// exchange limits were verified when the order was placed
+	// partial fills are not expected to conform to them
+	orderID, err := e.placeOrder(context.TODO(), r.price, amount, false, false, f, orderManager)
+	if err != nil {
+		return f, err
+	}
+
+	release := r.reserved
+	if amount.LessThan(r.remaining) {
+		release = r.reserved.Mul(amount).Div(r.remaining)
+	}
+	r.remaining = r.remaining.Sub(amount)
+	r.reserved = r.reserved.Sub(release)
+	pos := funds.GetPosition()
+	switch {
+	case pos != nil:
+		if release.IsPositive() {
+			err = funds.Release(release, release, r.side)
+			if err != nil {
+				return f, err
+			}
+		}
+		err = pos.Fill(r.side, amount, r.price, f.ExchangeFee, ev.GetTime())
+		if err != nil {
+			return f, err
+		}
+	case r.side == gctorder.Buy:
+		err = funds.Release(release, decimal.Max(release.Sub(amount.Mul(r.price)), decimal.Zero), r.side)
+		if err != nil {
+			return f, err
+		}
+		funds.IncreaseAvailable(amount, r.side)
+	case r.side == gctorder.Sell:
+		err = funds.Release(release, decimal.Max(release.Sub(amount), decimal.Zero), r.side)
+		if err != nil {
+			return f, err
+		}
+		funds.IncreaseAvailable(amount.Mul(r.price), r.side)
+	}
+
+	ords := orderManager.GetOrdersSnapshot("")
+	for i := range ords {
+		if ords[i].ID != orderID {
+			continue
+		}
+		ords[i].Date = ev.GetTime()
+		ords[i].LastUpdated = ev.GetTime()
+		ords[i].CloseTime = ev.GetTime()
+		ords[i].Type = gctorder.Limit
+		if pos != nil {
+			ords[i].Leverage, _ = pos.Leverage().Float64()
+		}
+		f.Order = &ords[i]
+		f.PurchasePrice = decimal.NewFromFloat(ords[i].Price)
+		f.Total = f.PurchasePrice.Mul(amount).Add(f.ExchangeFee)
+	}
+	if f.Order == nil {
+		return nil, fmt.Errorf("placed order %v not found in order manager", orderID)
+	}
+	return f, nil
+}
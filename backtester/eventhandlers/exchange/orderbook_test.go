@@ -0,0 +1,359 @@
+package exchange
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	bookdata "github.com/thrasher-corp/gocryptotrader/backtester/data/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream/buffer"
+)
+
+var obStart = time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+
+func testOrderbook() *orderbook.Base {
+	return &orderbook.Base{
+		Bids: orderbook.Items{
+			{Price: 99, Amount: 3},
+			{Price: 98, Amount: 2},
+		},
+		Asks: orderbook.Items{
+			{Price: 101, Amount: 1},
+			{Price: 102, Amount: 2},
+			{Price: 103, Amount: 5},
+		},
+	}
+}
+
+// setupOfflineOrderManager returns an order manager able to store
+// simulated orders without exchange connectivity
+func setupOfflineOrderManager(t *testing.T) *engine.OrderManager {
+	t.Helper()
+	bot := &engine.Engine{}
+	em := engine.SetupExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	em.Add(exch)
+	om, err := engine.SetupOrderManager(em, &engine.CommunicationManager{}, nil, &bot.ServicesWG, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = om.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return om
+}
+
+// setupOrderbookData returns orderbook data with a candle per minute
+// alongside the orderbook updates provided
+func setupOrderbookData(t *testing.T, updates ...*buffer.Update) *bookdata.DataFromOrderbook {
+	t.Helper()
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	ob := testOrderbook()
+	ob.Exchange = testExchange
+	ob.Pair = p
+	ob.Asset = asset.Spot
+	ob.LastUpdated = obStart
+	records := []bookdata.Record{{Snapshot: ob}}
+	for i := range updates {
+		records = append(records, bookdata.Record{Update: updates[i]})
+	}
+	book, err := bookdata.NewBook(records)
+	if err != nil {
+		t.Fatal(err)
+	}
+	candles, err := book.ConvertToCandles(gctkline.OneMin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &bookdata.DataFromOrderbook{
+		DataFromKline: kline.DataFromKline{
+			Item: gctkline.Item{
+				Exchange: testExchange,
+				Pair:     p,
+				Asset:    asset.Spot,
+				Interval: gctkline.OneMin,
+				Candles:  candles,
+			},
+		},
+		Book: book,
+	}
+	err = d.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func setupOrderbookFunds(t *testing.T) *funding.Pair {
+	t.Helper()
+	b, err := funding.CreateItem(testExchange, asset.Spot, currency.BTC, decimal.NewFromInt(10), decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := funding.CreateItem(testExchange, asset.Spot, currency.USDT, decimal.NewFromInt(1000), decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pair, err := funding.CreatePair(b, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pair
+}
+
+func TestMatchOrderbook(t *testing.T) {
+	t.Parallel()
+	ob := testOrderbook()
+	price, amount := matchOrderbook(ob, gctorder.Buy, decimal.NewFromInt(2), decimal.Zero)
+	if !price.Equal(decimal.NewFromFloat(101.5)) || !amount.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", price, amount, 101.5, 2)
+	}
+	_, amount = matchOrderbook(ob, gctorder.Buy, decimal.NewFromInt(10), decimal.Zero)
+	if !amount.Equal(decimal.NewFromInt(8)) {
+		t.Errorf("received '%v' expected '%v'", amount, 8)
+	}
+	price, amount = matchOrderbook(ob, gctorder.Buy, decimal.NewFromInt(5), decimal.NewFromInt(102))
+	if !price.Equal(decimal.NewFromInt(305).Div(decimal.NewFromInt(3))) || !amount.Equal(decimal.NewFromInt(3)) {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", price, amount, "101.67", 3)
+	}
+	price, amount = matchOrderbook(ob, gctorder.Sell, decimal.NewFromInt(4), decimal.Zero)
+	if !price.Equal(decimal.NewFromFloat(98.75)) || !amount.Equal(decimal.NewFromInt(4)) {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", price, amount, 98.75, 4)
+	}
+	price, amount = matchOrderbook(ob, gctorder.Sell, decimal.NewFromInt(4), decimal.NewFromInt(100))
+	if !price.IsZero() || !amount.IsZero() {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", price, amount, 0, 0)
+	}
+}
+
+func TestSizeOrderbookOrder(t *testing.T) {
+	t.Parallel()
+	_, _, _, err := sizeOrderbookOrder(nil, nil, nil)
+	if !errors.Is(err, common.ErrNilArguments) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilArguments)
+	}
+	o := &order.Order{
+		Direction: gctorder.Buy,
+		Amount:    decimal.NewFromInt(10),
+		OrderType: gctorder.Market,
+	}
+	f := &fill.Fill{
+		Direction:  gctorder.Buy,
+		Amount:     decimal.NewFromInt(10),
+		ClosePrice: decimal.NewFromInt(100),
+	}
+	price, amount, resting, err := sizeOrderbookOrder(testOrderbook(), o, f)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !amount.Equal(decimal.NewFromInt(8)) || !resting.IsZero() {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", amount, resting, 8, 0)
+	}
+	if !price.GreaterThan(f.ClosePrice) || !f.Slippage.IsPositive() {
+		t.Errorf("expected buy slippage, received price %v slippage %v", price, f.Slippage)
+	}
+	if f.Reason == "" {
+		t.Error("expected partial fill reason")
+	}
+
+	_, _, _, err = sizeOrderbookOrder(&orderbook.Base{}, o, f)
+	if !errors.Is(err, errNoOrderbookLiquidity) {
+		t.Errorf("received '%v' expected '%v'", err, errNoOrderbookLiquidity)
+	}
+
+	o.OrderType = gctorder.Limit
+	o.Price = decimal.NewFromInt(101)
+	_, amount, resting, err = sizeOrderbookOrder(testOrderbook(), o, f)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !amount.Equal(decimal.NewFromInt(1)) || !resting.Equal(decimal.NewFromInt(9)) {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", amount, resting, 1, 9)
+	}
+}
+
+func TestRestingOrderMatch(t *testing.T) {
+	t.Parallel()
+	o := &order.Order{
+		Direction: gctorder.Buy,
+		Amount:    decimal.NewFromInt(4),
+		OrderType: gctorder.Limit,
+		Price:     decimal.NewFromInt(99),
+	}
+	r := newRestingOrder(o, testOrderbook(), decimal.NewFromInt(4), decimal.NewFromInt(396))
+	if !r.queueAhead.Equal(decimal.NewFromInt(3)) {
+		t.Fatalf("received '%v' expected '%v'", r.queueAhead, 3)
+	}
+
+	ob := testOrderbook()
+	ob.Bids[0].Amount = 1
+	if filled := r.match(ob); !filled.IsZero() {
+		t.Errorf("received '%v' expected '%v'", filled, 0)
+	}
+	if !r.queueAhead.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("received '%v' expected '%v'", r.queueAhead, 1)
+	}
+
+	// orders joining the level queue behind the resting order
+	ob.Bids[0].Amount = 6
+	if filled := r.match(ob); !filled.IsZero() {
+		t.Errorf("received '%v' expected '%v'", filled, 0)
+	}
+
+	ob.Bids[0].Amount = 3
+	if filled := r.match(ob); !filled.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' expected '%v'", filled, 2)
+	}
+	if !r.queueAhead.IsZero() {
+		t.Errorf("received '%v' expected '%v'", r.queueAhead, 0)
+	}
+
+	// the market trading through the order's price
+	ob.Asks[0].Price = 99
+	ob.Asks[0].Amount = 1
+	if filled := r.match(ob); !filled.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("received '%v' expected '%v'", filled, 1)
+	}
+	// the same replayed liquidity cannot fill the order twice
+	if filled := r.match(ob); !filled.IsZero() {
+		t.Errorf("received '%v' expected '%v'", filled, 0)
+	}
+	ob.Asks[0].Amount = 10
+	if filled := r.match(ob); !filled.Equal(r.remaining) {
+		t.Errorf("expected fill to be capped by remaining amount, received '%v'", filled)
+	}
+}
+
+func TestExecuteOrderOrderbook(t *testing.T) {
+	t.Parallel()
+	om := setupOfflineOrderManager(t)
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	d := setupOrderbookData(t,
+		&buffer.Update{
+			UpdateTime: obStart.Add(time.Minute + time.Second),
+			Asset:      asset.Spot,
+			Pair:       p,
+			Action:     buffer.UpdateInsert,
+			Asks:       orderbook.Items{{Price: 100, Amount: 5}},
+		})
+	d.Next()
+
+	cs := Settings{
+		Exchange:    testExchange,
+		Pair:        p,
+		Asset:       asset.Spot,
+		ExchangeFee: decimal.NewFromFloat(0.01),
+		MakerFee:    decimal.NewFromFloat(0.001),
+		TakerFee:    decimal.NewFromFloat(0.01),
+	}
+	e := Exchange{
+		CurrencySettings: []Settings{cs},
+	}
+	funds := setupOrderbookFunds(t)
+
+	o := &order.Order{
+		Base: event.Base{
+			Offset:       1,
+			Exchange:     testExchange,
+			Time:         obStart,
+			Interval:     gctkline.OneMin,
+			CurrencyPair: p,
+			AssetType:    asset.Spot,
+		},
+		Direction:      gctorder.Buy,
+		Amount:         decimal.NewFromInt(2),
+		Price:          decimal.NewFromInt(101),
+		OrderType:      gctorder.Market,
+		AllocatedFunds: decimal.NewFromInt(202),
+	}
+	err := funds.Reserve(o.AllocatedFunds, gctorder.Buy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := e.ExecuteOrder(o, d, om, funds)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	// 1 at 101 and 1 at 102 exceeds the allocated funds, so the order is reduced
+	if !f.Amount.LessThan(decimal.NewFromInt(2)) || !f.Amount.GreaterThan(decimal.NewFromInt(1)) {
+		t.Errorf("received '%v' expected amount reduced to fit allocated funds", f.Amount)
+	}
+	expectedFee := f.PurchasePrice.Mul(f.Amount).Mul(cs.TakerFee)
+	if !f.ExchangeFee.Round(8).Equal(expectedFee.Round(8)) {
+		t.Errorf("received '%v' expected taker fee '%v'", f.ExchangeFee, expectedFee)
+	}
+	if !funds.BaseAvailable().Equal(decimal.NewFromInt(10).Add(f.Amount)) {
+		t.Errorf("received '%v' expected '%v'", funds.BaseAvailable(), decimal.NewFromInt(10).Add(f.Amount))
+	}
+
+	// a limit order below the best ask rests on the orderbook
+	o.OrderType = gctorder.Limit
+	o.Price = decimal.NewFromInt(100)
+	o.AllocatedFunds = decimal.NewFromInt(200)
+	err = funds.Reserve(o.AllocatedFunds, gctorder.Buy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	quoteAvailable := funds.QuoteAvailable()
+	f, err = e.ExecuteOrder(o, d, om, funds)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if f.GetDirection() != common.DoNothing || f.Order != nil {
+		t.Errorf("received '%v' expected resting order", f.GetDirection())
+	}
+	if len(e.restingOrders) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(e.restingOrders), 1)
+	}
+	if !funds.QuoteAvailable().Equal(quoteAvailable) {
+		t.Error("expected resting order funds to remain reserved")
+	}
+
+	// the next minute an ask arrives at the limit price
+	ev := d.Next()
+	fills, err := e.ProcessRestingOrders(ev, d, om, funds)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(fills) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(fills), 1)
+	}
+	if !fills[0].Amount.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' expected '%v'", fills[0].Amount, 2)
+	}
+	if fills[0].Order == nil || fills[0].Order.Type != gctorder.Limit {
+		t.Error("expected limit order to be placed")
+	}
+	if !fills[0].ExchangeFee.Equal(decimal.NewFromInt(200).Mul(cs.MakerFee)) {
+		t.Errorf("received '%v' expected maker fee '%v'", fills[0].ExchangeFee, decimal.NewFromInt(200).Mul(cs.MakerFee))
+	}
+	if len(e.restingOrders) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(e.restingOrders), 0)
+	}
+	if !funds.QuoteAvailable().Equal(quoteAvailable) {
+		t.Errorf("received '%v' expected '%v'", funds.QuoteAvailable(), quoteAvailable)
+	}
+
+	_, err = e.ProcessRestingOrders(nil, d, om, funds)
+	if !errors.Is(err, common.ErrNilArguments) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilArguments)
+	}
+}
@@ -0,0 +1,70 @@
+package exchange
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange/slippage"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/trade"
+)
+
+// upcomingTrades returns the trades which follow the latest data event up
+// until the time provided, when the data handler streams individual trades
+func upcomingTrades(d data.Handler, until time.Time) ([]*trade.Trade, bool) {
+	ts, ok := d.(data.TradeStreamer)
+	if !ok {
+		return nil, false
+	}
+	return ts.UpcomingTrades(until), true
+}
+
+// sizeTickOrder matches the order against the trades which follow it, filling
+// at the volume weighted price of the trades required to fill the order.
+// When volume fitting is skipped, the order is filled in full at the price of
+// the next trade. Slippage is then applied as it is for candles
+func sizeTickOrder(trades []*trade.Trade, cs *Settings, f *fill.Fill) (adjustedPrice, adjustedAmount decimal.Decimal, err error) {
+	if cs == nil || f == nil {
+		return decimal.Zero, decimal.Zero, common.ErrNilArguments
+	}
+	if len(trades) == 0 {
+		return decimal.Zero, decimal.Zero, errNoUpcomingTrades
+	}
+	slippageRate := slippage.EstimateSlippagePercentage(cs.MinimumSlippageRate, cs.MaximumSlippageRate)
+	f.VolumeAdjustedPrice = trades[0].Price
+	if cs.SkipCandleVolumeFitting {
+		adjustedAmount = f.Amount
+	} else {
+		var value decimal.Decimal
+		for i := range trades {
+			remaining := f.Amount.Sub(adjustedAmount)
+			if !remaining.IsPositive() {
+				break
+			}
+			filled := trades[i].Amount
+			if filled.GreaterThan(remaining) {
+				filled = remaining
+			}
+			value = value.Add(filled.Mul(trades[i].Price))
+			adjustedAmount = adjustedAmount.Add(filled)
+		}
+		if adjustedAmount.IsPositive() {
+			f.VolumeAdjustedPrice = value.Div(adjustedAmount)
+		}
+		if !adjustedAmount.Equal(f.Amount) {
+			f.AppendReason(fmt.Sprintf("Order size shrunk from %v to %v to fit upcoming trades", f.Amount, adjustedAmount))
+		}
+	}
+
+	if adjustedAmount.LessThanOrEqual(decimal.Zero) && f.Amount.GreaterThan(decimal.Zero) {
+		return decimal.Zero, decimal.Zero, fmt.Errorf("amount set to 0, %w", errDataMayBeIncorrect)
+	}
+	adjustedPrice = applySlippageToPrice(f.GetDirection(), f.GetVolumeAdjustedPrice(), slippageRate)
+
+	f.Slippage = slippageRate.Mul(decimal.NewFromInt(100)).Sub(decimal.NewFromInt(100))
+	f.ExchangeFee = calculateExchangeFee(adjustedPrice, adjustedAmount, cs.TakerFee)
+	return adjustedPrice, adjustedAmount, nil
+}
@@ -0,0 +1,183 @@
+package exchange
+
+import (
+	"errors"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	tradedata "github.com/thrasher-corp/gocryptotrader/backtester/data/trade"
+	tradecsv "github.com/thrasher-corp/gocryptotrader/backtester/data/trade/csv"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/trade"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// setupTradeData returns trade data streaming a trade every 20 seconds
+func setupTradeData(t *testing.T) *tradedata.DataFromTrades {
+	t.Helper()
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	trades := "1635724800,100,1,BUY\n" +
+		"1635724820,101,1,BUY\n" +
+		"1635724840,103,1,SELL\n" +
+		"1635724860,110,5,BUY\n"
+	d := &tradedata.DataFromTrades{
+		Exchange:  testExchange,
+		Asset:     asset.Spot,
+		Pair:      p,
+		Interval:  gctkline.OneMin,
+		ChunkSize: 2,
+		Source:    tradecsv.NewSource(ioutil.NopCloser(strings.NewReader(trades)), testExchange, p, asset.Spot),
+	}
+	err := d.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestUpcomingTrades(t *testing.T) {
+	t.Parallel()
+	_, isTick := upcomingTrades(&kline.DataFromKline{}, obStart)
+	if isTick {
+		t.Error("expected candle data to not stream trades")
+	}
+	d := setupTradeData(t)
+	d.Next()
+	trades, isTick := upcomingTrades(d, obStart.Add(time.Minute))
+	if !isTick {
+		t.Error("expected trade data to stream trades")
+	}
+	if len(trades) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(trades), 2)
+	}
+}
+
+func TestSizeTickOrder(t *testing.T) {
+	t.Parallel()
+	_, _, err := sizeTickOrder(nil, nil, nil)
+	if !errors.Is(err, common.ErrNilArguments) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilArguments)
+	}
+	cs := &Settings{TakerFee: decimal.NewFromFloat(0.01)}
+	f := &fill.Fill{
+		Direction: gctorder.Buy,
+		Amount:    decimal.NewFromFloat(1.5),
+	}
+	_, _, err = sizeTickOrder(nil, cs, f)
+	if !errors.Is(err, errNoUpcomingTrades) {
+		t.Errorf("received '%v' expected '%v'", err, errNoUpcomingTrades)
+	}
+
+	trades := []*trade.Trade{
+		{Price: decimal.NewFromInt(101), Amount: decimal.NewFromInt(1)},
+		{Price: decimal.NewFromInt(103), Amount: decimal.NewFromInt(1)},
+	}
+	price, amount, err := sizeTickOrder(trades, cs, f)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	// 1 at 101 and 0.5 at 103
+	expectedPrice := decimal.NewFromFloat(152.5).Div(decimal.NewFromFloat(1.5))
+	if !price.Equal(expectedPrice) {
+		t.Errorf("received '%v' expected '%v'", price, expectedPrice)
+	}
+	if !amount.Equal(f.Amount) {
+		t.Errorf("received '%v' expected '%v'", amount, f.Amount)
+	}
+
+	f.Amount = decimal.NewFromInt(5)
+	_, amount, err = sizeTickOrder(trades, cs, f)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !amount.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' expected '%v'", amount, 2)
+	}
+
+	cs.SkipCandleVolumeFitting = true
+	price, amount, err = sizeTickOrder(trades, cs, f)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !price.Equal(decimal.NewFromInt(101)) {
+		t.Errorf("received '%v' expected '%v'", price, 101)
+	}
+	if !amount.Equal(decimal.NewFromInt(5)) {
+		t.Errorf("received '%v' expected '%v'", amount, 5)
+	}
+
+	cs.SkipCandleVolumeFitting = false
+	trades[0].Amount = decimal.Zero
+	trades[1].Amount = decimal.Zero
+	_, _, err = sizeTickOrder(trades, cs, f)
+	if !errors.Is(err, errDataMayBeIncorrect) {
+		t.Errorf("received '%v' expected '%v'", err, errDataMayBeIncorrect)
+	}
+}
+
+func TestExecuteOrderTicks(t *testing.T) {
+	t.Parallel()
+	om := setupOfflineOrderManager(t)
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	d := setupTradeData(t)
+	d.Next()
+
+	cs := Settings{
+		Exchange:    testExchange,
+		Pair:        p,
+		Asset:       asset.Spot,
+		ExchangeFee: decimal.NewFromFloat(0.01),
+		MakerFee:    decimal.NewFromFloat(0.001),
+		TakerFee:    decimal.NewFromFloat(0.01),
+	}
+	e := Exchange{
+		CurrencySettings: []Settings{cs},
+	}
+	funds := setupOrderbookFunds(t)
+	o := &order.Order{
+		Base: event.Base{
+			Offset:       1,
+			Exchange:     testExchange,
+			Time:         obStart,
+			Interval:     gctkline.OneMin,
+			CurrencyPair: p,
+			AssetType:    asset.Spot,
+		},
+		Direction:      gctorder.Buy,
+		Amount:         decimal.NewFromInt(2),
+		OrderType:      gctorder.Market,
+		AllocatedFunds: decimal.NewFromInt(500),
+	}
+	err := funds.Reserve(o.AllocatedFunds, gctorder.Buy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := e.ExecuteOrder(o, d, om, funds)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	// filled against the following trades at 101 and 103, not the latest at 100
+	if !f.Amount.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' expected '%v'", f.Amount, 2)
+	}
+	if !f.VolumeAdjustedPrice.Equal(decimal.NewFromInt(102)) {
+		t.Errorf("received '%v' expected '%v'", f.VolumeAdjustedPrice, 102)
+	}
+	if !f.ClosePrice.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("received '%v' expected '%v'", f.ClosePrice, 100)
+	}
+	// the trades are still to be processed by the strategy
+	if d.Offset() != 1 {
+		t.Errorf("received '%v' expected '%v'", d.Offset(), 1)
+	}
+}
@@ -17,7 +17,7 @@ func Create(ev ClosePriceReader, funding funding.IPairReader) (Holding, error) {
 		return Holding{}, ErrInitialFundsZero
 	}
 
-	return Holding{
+	h := Holding{
 		Offset:            ev.GetOffset(),
 		Pair:              ev.Pair(),
 		Asset:             ev.GetAssetType(),
@@ -28,7 +28,9 @@ func Create(ev ClosePriceReader, funding funding.IPairReader) (Holding, error) {
 		BaseInitialFunds:  funding.BaseInitialFunds(),
 		BaseSize:          funding.BaseInitialFunds(),
 		TotalInitialValue: funding.QuoteInitialFunds().Add(funding.BaseInitialFunds().Mul(ev.GetClosePrice())),
-	}, nil
+	}
+	h.UpdatePosition(funding)
+	return h, nil
 }
 
 // Update calculates holding statistics for the events time
@@ -46,8 +48,50 @@ func (h *Holding) UpdateValue(d common.DataEventHandler) {
 	h.updateValue(latest)
 }
 
+// Transfer syncs the holding with funding moved outside of orders, such as
+// when sleeves are rebalanced. The funds moved are valued at the price and
+// added to the holding's total value so they are not treated as a return
+func (h *Holding) Transfer(price decimal.Decimal, f funding.IPairReader) {
+	baseSize, quoteSize := h.BaseSize, h.QuoteSize
+	if !h.IsLeveraged() {
+		h.BaseSize = f.BaseAvailable()
+	}
+	h.QuoteSize = f.QuoteAvailable()
+	h.TotalValue = h.TotalValue.Add(h.BaseSize.Sub(baseSize).Mul(price)).Add(h.QuoteSize.Sub(quoteSize))
+}
+
+// UpdatePosition syncs the holding with the leveraged position
+// and its collateral. It does nothing for spot holdings
+func (h *Holding) UpdatePosition(f funding.IPairReader) {
+	pos := f.GetPosition()
+	if pos == nil {
+		return
+	}
+	snap := pos.Snapshot()
+	h.Leverage = pos.Leverage()
+	h.BaseSize = snap.Size
+	h.QuoteSize = f.QuoteAvailable()
+	h.EntryPrice = snap.EntryPrice
+	h.Margin = snap.Margin
+	h.LiquidationPrice = snap.LiquidationPrice
+	h.UnrealisedPNL = snap.UnrealisedPNL
+	h.RealisedPNL = snap.RealisedPNL
+	h.Funding = snap.Funding
+	h.Liquidations = snap.Liquidations
+	h.LiquidationLosses = snap.LiquidationLosses
+}
+
+// IsLeveraged returns whether the holding tracks a leveraged position
+func (h *Holding) IsLeveraged() bool {
+	return !h.Leverage.IsZero()
+}
+
 // HasInvestments determines whether there are any holdings in the base funds
+// or an open leveraged position
 func (h *Holding) HasInvestments() bool {
+	if h.IsLeveraged() {
+		return !h.BaseSize.IsZero()
+	}
 	return h.BaseSize.GreaterThan(decimal.Zero)
 }
 
@@ -64,6 +108,7 @@ func (h *Holding) update(e fill.Event, f funding.IPairReader) {
 		price := decimal.NewFromFloat(o.Price)
 		h.BaseSize = f.BaseAvailable()
 		h.QuoteSize = f.QuoteAvailable()
+		h.UpdatePosition(f)
 		h.BaseValue = h.BaseSize.Mul(price)
 		h.TotalFees = h.TotalFees.Add(fee)
 		switch direction {
@@ -89,7 +134,13 @@ func (h *Holding) updateValue(latestPrice decimal.Decimal) {
 	h.BaseValue = h.BaseSize.Mul(latestPrice)
 	h.BoughtValue = h.BoughtAmount.Mul(latestPrice)
 	h.SoldValue = h.SoldAmount.Mul(latestPrice)
-	h.TotalValue = h.BaseValue.Add(h.QuoteSize)
+	if h.IsLeveraged() {
+		// the position is not owned outright, only its margin and pnl
+		h.UnrealisedPNL = h.BaseSize.Mul(latestPrice.Sub(h.EntryPrice))
+		h.TotalValue = h.QuoteSize.Add(h.Margin).Add(h.UnrealisedPNL)
+	} else {
+		h.TotalValue = h.BaseValue.Add(h.QuoteSize)
+	}
 
 	h.TotalValueDifference = h.TotalValue.Sub(origTotalValue)
 	h.BoughtValueDifference = h.BoughtValue.Sub(origBoughtValue)
@@ -87,6 +87,96 @@ func TestUpdateValue(t *testing.T) {
 	}
 }
 
+func TestTransfer(t *testing.T) {
+	t.Parallel()
+	p := pair(t)
+	h, err := Create(&fill.Fill{}, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	price := decimal.NewFromInt(100)
+	h.UpdateValue(&kline.Kline{Close: price})
+	p.IncreaseAvailable(decimal.NewFromInt(1), order.Buy)
+	h.Transfer(price, p)
+	if !h.BaseSize.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("received '%v' expected '%v'", h.BaseSize, 1)
+	}
+	if !h.TotalValue.Equal(decimal.NewFromInt(1437)) {
+		t.Errorf("received '%v' expected '%v'", h.TotalValue, 1437)
+	}
+	h.UpdateValue(&kline.Kline{Close: price})
+	if !h.ChangeInTotalValuePercent.IsZero() {
+		t.Errorf("received '%v' expected '%v'", h.ChangeInTotalValuePercent, 0)
+	}
+}
+
+func TestUpdatePosition(t *testing.T) {
+	t.Parallel()
+	h, err := Create(&fill.Fill{}, pair(t))
+	if err != nil {
+		t.Error(err)
+	}
+	h.UpdatePosition(pair(t))
+	if h.IsLeveraged() {
+		t.Error("expected spot holding to not be leveraged")
+	}
+
+	f := funding.SetupFundingManager(false, true)
+	b, err := funding.CreateItem(testExchange, asset.PerpetualSwap, currency.BTC, decimal.Zero, decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := funding.CreateItem(testExchange, asset.PerpetualSwap, currency.USDT, decimal.NewFromInt(1000), decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := funding.CreatePair(b, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = f.AddPair(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	pos, err := funding.CreatePosition(testExchange, asset.PerpetualSwap, cp, decimal.NewFromInt(10), decimal.NewFromFloat(0.05), decimal.Zero, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = f.AddPosition(pos)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = pos.Fill(order.Sell, decimal.NewFromInt(1), decimal.NewFromInt(100), decimal.Zero, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	fp, err := f.GetFundingForEAP(testExchange, asset.PerpetualSwap, cp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h, err = Create(&fill.Fill{}, fp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !h.IsLeveraged() || !h.HasInvestments() {
+		t.Error("expected leveraged holding with an open position")
+	}
+	if !h.BaseSize.Equal(decimal.NewFromInt(-1)) {
+		t.Errorf("expected '%v' received '%v'", decimal.NewFromInt(-1), h.BaseSize)
+	}
+	h.UpdateValue(&kline.Kline{
+		Close: decimal.NewFromInt(90),
+	})
+	// 990 collateral + 10 margin + 10 profit on the short
+	if !h.TotalValue.Equal(decimal.NewFromInt(1010)) {
+		t.Errorf("expected '%v' received '%v'", decimal.NewFromInt(1010), h.TotalValue)
+	}
+	if !h.UnrealisedPNL.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("expected '%v' received '%v'", decimal.NewFromInt(10), h.UnrealisedPNL)
+	}
+}
+
 func TestUpdateBuyStats(t *testing.T) {
 	t.Parallel()
 	b, err := funding.CreateItem(testExchange, asset.Spot, currency.BTC, decimal.NewFromInt(1), decimal.Zero)
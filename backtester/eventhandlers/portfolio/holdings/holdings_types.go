@@ -44,6 +44,19 @@ type Holding struct {
 	TotalValueLostToVolumeSizing decimal.Decimal `json:"total-value-lost-to-volume-sizing"`
 	TotalValueLostToSlippage     decimal.Decimal `json:"total-value-lost-to-slippage"`
 	TotalValueLost               decimal.Decimal `json:"total-value-lost"`
+
+	// Leveraged position details. Only set when the holding
+	// tracks a futures or margin position, where BaseSize
+	// is the signed position size
+	Leverage          decimal.Decimal `json:"leverage,omitempty"`
+	EntryPrice        decimal.Decimal `json:"entry-price,omitempty"`
+	Margin            decimal.Decimal `json:"margin,omitempty"`
+	LiquidationPrice  decimal.Decimal `json:"liquidation-price,omitempty"`
+	UnrealisedPNL     decimal.Decimal `json:"unrealised-pnl,omitempty"`
+	RealisedPNL       decimal.Decimal `json:"realised-pnl,omitempty"`
+	Funding           decimal.Decimal `json:"funding,omitempty"`
+	Liquidations      int64           `json:"liquidations,omitempty"`
+	LiquidationLosses decimal.Decimal `json:"liquidation-losses,omitempty"`
 }
 
 // ClosePriceReader is used for holdings calculations
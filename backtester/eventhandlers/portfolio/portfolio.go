@@ -41,9 +41,18 @@ func Setup(sh SizeHandler, r risk.Handler, riskFreeRate decimal.Decimal) (*Portf
 	return p, nil
 }
 
+// SetPositionSizer sets the model used to allocate funds to new positions.
+// When unset, all available funds are sized against the size rules
+func (p *Portfolio) SetPositionSizer(ps PositionSizer) {
+	p.positionSizer = ps
+}
+
 // Reset returns the portfolio manager to its default state
 func (p *Portfolio) Reset() {
 	p.exchangeAssetPairSettings = nil
+	if p.positionSizer != nil {
+		p.positionSizer.Reset()
+	}
 }
 
 // GetLatestOrderSnapshotForEvent gets orders related to the event
@@ -135,14 +144,53 @@ func (p *Portfolio) OnSignal(ev signal.Event, cs *exchange.Settings, funds fundi
 
 	o.Price = ev.GetPrice()
 	o.OrderType = gctorder.Market
+	if ev.GetOrderType() == gctorder.Limit {
+		if !ev.GetLimitPrice().IsPositive() {
+			o.AppendReason("limit order requires a limit price")
+			if ev.GetDirection() == gctorder.Sell {
+				o.SetDirection(common.CouldNotSell)
+			} else {
+				o.SetDirection(common.CouldNotBuy)
+			}
+			ev.SetDirection(o.Direction)
+			return o, nil
+		}
+		// limit orders are sized and reserved at the limit price
+		o.OrderType = gctorder.Limit
+		o.Price = ev.GetLimitPrice()
+	}
 	o.BuyLimit = ev.GetBuyLimit()
 	o.SellLimit = ev.GetSellLimit()
 	var sizingFunds decimal.Decimal
-	if ev.GetDirection() == gctorder.Sell {
+	pos := funds.GetPosition()
+	switch {
+	case pos != nil:
+		// leveraged positions can be sized beyond held funds
+		// buy sizing expects quote funds, sell sizing expects base funds
+		o.SetLeverage(pos.Leverage())
+		sizingFunds = pos.AvailableToOrder(ev.GetDirection(), o.Price)
+		if ev.GetDirection() == gctorder.Buy {
+			sizingFunds = sizingFunds.Mul(o.Price)
+		}
+	case ev.GetDirection() == gctorder.Sell:
 		sizingFunds = funds.BaseAvailable()
-	} else {
+	default:
 		sizingFunds = funds.QuoteAvailable()
 	}
+	if p.positionSizer != nil {
+		var err error
+		sizingFunds, err = p.allocatePosition(ev, o, sizingFunds, funds)
+		if err != nil {
+			o.AppendReason(err.Error())
+			if ev.GetDirection() == gctorder.Sell {
+				o.SetDirection(common.CouldNotSell)
+			} else {
+				o.SetDirection(common.CouldNotBuy)
+			}
+			ev.SetDirection(o.Direction)
+			return o, nil
+		}
+	}
 	sizedOrder := p.sizeOrder(ev, cs, o, sizingFunds, funds)
 
 	return p.evaluateOrder(ev, o, sizedOrder)
@@ -174,6 +222,48 @@ func (p *Portfolio) evaluateOrder(d common.Directioner, originalOrderSignal, siz
 	return evaluatedOrder, nil
 }
 
+// allocatePosition uses the position sizer to limit the funds committed to
+// opening a position. Funds which reduce an existing position or sell
+// spot holdings are left as is
+func (p *Portfolio) allocatePosition(ev signal.Event, o *order.Order, sizingFunds decimal.Decimal, funds funding.IPairReserver) (decimal.Decimal, error) {
+	price := o.Price
+	var reducible, opening, equity decimal.Decimal
+	pos := funds.GetPosition()
+	switch {
+	case pos != nil:
+		// sizing funds are held in the quote currency for buys and base for sells
+		reducible = pos.ReducibleAmount(ev.GetDirection())
+		opening = sizingFunds
+		if ev.GetDirection() == gctorder.Buy {
+			opening = opening.Div(price)
+		}
+		opening = opening.Sub(reducible).Mul(price)
+		equity = pos.Equity()
+		if pos.Leverage().IsPositive() {
+			equity = equity.Add(opening.Div(pos.Leverage()))
+		}
+	case ev.GetDirection() == gctorder.Sell:
+		return sizingFunds, nil
+	default:
+		opening = sizingFunds
+		equity = funds.QuoteAvailable().Add(funds.BaseAvailable().Mul(price))
+	}
+	allocated, err := p.positionSizer.Allocate(ev, opening, equity)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("%v position sizing %w", p.positionSizer.Name(), err)
+	}
+	o.AppendReason(fmt.Sprintf("%v position sizing allocated %v of %v", p.positionSizer.Name(), allocated.Round(8), opening.Round(8)))
+	if pos == nil {
+		return allocated, nil
+	}
+	// convert back to the quote or base funds expected by sizing
+	resp := reducible.Add(allocated.Div(price))
+	if ev.GetDirection() == gctorder.Buy {
+		resp = resp.Mul(price)
+	}
+	return resp, nil
+}
+
 func (p *Portfolio) sizeOrder(d common.Directioner, cs *exchange.Settings, originalOrderSignal *order.Order, sizingFunds decimal.Decimal, funds funding.IPairReserver) *order.Order {
 	sizedOrder, err := p.sizeManager.SizeOrder(originalOrderSignal, sizingFunds, cs)
 	if err != nil {
@@ -202,7 +292,14 @@ func (p *Portfolio) sizeOrder(d common.Directioner, cs *exchange.Settings, origi
 		d.SetDirection(originalOrderSignal.Direction)
 		originalOrderSignal.AppendReason("sized order to 0")
 	}
-	if d.GetDirection() == gctorder.Sell {
+	if pos := funds.GetPosition(); pos != nil {
+		// only the margin for the portion opening a position is reserved
+		// reducing a position requires no funds
+		sizedOrder.AllocatedFunds = pos.RequiredMargin(d.GetDirection(), sizedOrder.Amount, sizedOrder.Price)
+		if sizedOrder.AllocatedFunds.IsPositive() {
+			err = funds.Reserve(sizedOrder.AllocatedFunds, d.GetDirection())
+		}
+	} else if d.GetDirection() == gctorder.Sell {
 		err = funds.Reserve(sizedOrder.Amount, gctorder.Sell)
 		sizedOrder.AllocatedFunds = sizedOrder.Amount
 	} else {
@@ -255,6 +352,13 @@ func (p *Portfolio) OnFill(ev fill.Event, funding funding.IPairReader) (*fill.Fi
 		log.Error(log.BackTester, err)
 	}
 
+	if p.positionSizer != nil {
+		err = p.positionSizer.OnFill(ev)
+		if err != nil {
+			log.Error(log.BackTester, err)
+		}
+	}
+
 	direction := ev.GetDirection()
 	if direction == common.DoNothing ||
 		direction == common.CouldNotBuy ||
@@ -354,7 +458,14 @@ func (p *Portfolio) UpdateHoldings(ev common.DataEventHandler, funds funding.IPa
 			return err
 		}
 	}
+	h.UpdatePosition(funds)
 	h.UpdateValue(ev)
+	if p.positionSizer != nil {
+		err := p.positionSizer.Update(ev)
+		if err != nil {
+			return err
+		}
+	}
 	err := p.setHoldingsForOffset(&h, true)
 	if errors.Is(err, errNoHoldings) {
 		err = p.setHoldingsForOffset(&h, false)
@@ -362,6 +473,32 @@ func (p *Portfolio) UpdateHoldings(ev common.DataEventHandler, funds funding.IPa
 	return err
 }
 
+// TransferHoldings syncs the latest holdings for the data event's exchange,
+// asset and pair with funding moved outside of orders, such as when sleeves
+// are rebalanced. It does nothing when no holdings have been created
+func (p *Portfolio) TransferHoldings(ev common.DataEventHandler, funds funding.IPairReader) error {
+	if ev == nil {
+		return common.ErrNilEvent
+	}
+	if funds == nil {
+		return funding.ErrFundsNotFound
+	}
+	lookup, ok := p.exchangeAssetPairSettings[ev.GetExchange()][ev.GetAssetType()][ev.Pair()]
+	if !ok {
+		return fmt.Errorf("%w for %v %v %v",
+			errNoPortfolioSettings,
+			ev.GetExchange(),
+			ev.GetAssetType(),
+			ev.Pair())
+	}
+	h := lookup.GetLatestHoldings()
+	if h.Timestamp.IsZero() {
+		return nil
+	}
+	h.Transfer(ev.GetClosePrice(), funds)
+	return p.setHoldingsForOffset(&h, true)
+}
+
 // GetLatestHoldingsForAllCurrencies will return the current holdings for all loaded currencies
 // this is useful to assess the position of your entire portfolio in order to help with risk decisions
 func (p *Portfolio) GetLatestHoldingsForAllCurrencies() []holdings.Holding {
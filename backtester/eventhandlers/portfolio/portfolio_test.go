@@ -12,6 +12,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/risk"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/size"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/sizing"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
@@ -300,6 +301,67 @@ func TestUpdate(t *testing.T) {
 	}
 }
 
+func TestTransferHoldings(t *testing.T) {
+	t.Parallel()
+	p := Portfolio{}
+	err := p.TransferHoldings(nil, nil)
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilEvent)
+	}
+	err = p.TransferHoldings(&kline.Kline{}, nil)
+	if !errors.Is(err, funding.ErrFundsNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, funding.ErrFundsNotFound)
+	}
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	b, err := funding.CreateItem(testExchange, asset.Spot, cp.Base, decimal.Zero, decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := funding.CreateItem(testExchange, asset.Spot, cp.Quote, decimal.NewFromInt(100), decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pair, err := funding.CreatePair(b, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ev := &kline.Kline{
+		Base: event.Base{
+			Offset:       1,
+			Time:         time.Now(),
+			Exchange:     testExchange,
+			CurrencyPair: cp,
+			AssetType:    asset.Spot,
+		},
+		Close: decimal.NewFromInt(10),
+	}
+	err = p.TransferHoldings(ev, pair)
+	if !errors.Is(err, errNoPortfolioSettings) {
+		t.Errorf("received '%v' expected '%v'", err, errNoPortfolioSettings)
+	}
+	_, err = p.SetupCurrencySettingsMap(&exchange.Settings{Exchange: testExchange, Asset: asset.Spot, Pair: cp})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = p.TransferHoldings(ev, pair)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	err = p.UpdateHoldings(ev, pair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pair.IncreaseAvailable(decimal.NewFromInt(50), gctorder.Sell)
+	err = p.TransferHoldings(ev, pair)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	h := p.exchangeAssetPairSettings[testExchange][asset.Spot][cp].GetLatestHoldings()
+	if !h.TotalValue.Equal(decimal.NewFromInt(150)) {
+		t.Errorf("received '%v' expected '%v'", h.TotalValue, 150)
+	}
+}
+
 func TestGetFee(t *testing.T) {
 	t.Parallel()
 	p := Portfolio{}
@@ -548,6 +610,279 @@ func TestOnSignal(t *testing.T) {
 	}
 }
 
+func TestOnSignalLeveraged(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	f := funding.SetupFundingManager(false, true)
+	b, err := funding.CreateItem(testExchange, asset.PerpetualSwap, currency.BTC, decimal.Zero, decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := funding.CreateItem(testExchange, asset.PerpetualSwap, currency.USDT, decimal.NewFromInt(1000), decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pair, err := funding.CreatePair(b, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = f.AddPair(pair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pos, err := funding.CreatePosition(testExchange, asset.PerpetualSwap, cp, decimal.NewFromInt(10), decimal.NewFromFloat(0.05), decimal.Zero, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = f.AddPosition(pos)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := &risk.Risk{
+		CurrencySettings: map[string]map[asset.Item]map[currency.Pair]*risk.CurrencySettings{
+			testExchange: {
+				asset.PerpetualSwap: {
+					cp: &risk.CurrencySettings{},
+				},
+			},
+		},
+	}
+	p := Portfolio{
+		sizeManager: &size.Size{},
+		riskManager: r,
+	}
+	_, err = p.SetupCurrencySettingsMap(&exchange.Settings{Exchange: testExchange, Asset: asset.PerpetualSwap, Pair: cp})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = p.setHoldingsForOffset(&holdings.Holding{
+		Exchange:  testExchange,
+		Asset:     asset.PerpetualSwap,
+		Pair:      cp,
+		Timestamp: time.Now(),
+		QuoteSize: decimal.NewFromInt(1000)}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &signal.Signal{
+		Base: event.Base{
+			Exchange:     testExchange,
+			CurrencyPair: cp,
+			AssetType:    asset.PerpetualSwap,
+		},
+		ClosePrice: decimal.NewFromInt(100),
+		Direction:  gctorder.Sell,
+	}
+	fp, err := f.GetFundingForEAP(testExchange, asset.PerpetualSwap, cp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := p.OnSignal(s, &exchange.Settings{}, fp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Direction != common.CouldNotSell {
+		t.Errorf("expected leverage to be rejected when disabled, received %v", resp.Direction)
+	}
+	err = fp.Release(resp.AllocatedFunds, resp.AllocatedFunds, gctorder.Sell)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r.CanUseLeverage = true
+	s.Direction = gctorder.Sell
+	resp, err = p.OnSignal(s, &exchange.Settings{}, fp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Direction != gctorder.Sell {
+		t.Fatalf("expected a short to be sized without base funds, received %v %v", resp.Direction, resp.Reason)
+	}
+	// the full collateral at 10x leverage can short 100 BTC at 100
+	if !resp.Amount.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("received: %v, expected: %v", resp.Amount, 100)
+	}
+	if !resp.Leverage.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("received: %v, expected: %v", resp.Leverage, 10)
+	}
+	if !resp.AllocatedFunds.Equal(decimal.NewFromInt(1000)) {
+		t.Errorf("received: %v, expected: %v", resp.AllocatedFunds, 1000)
+	}
+	if !fp.QuoteAvailable().IsZero() {
+		t.Errorf("received: %v, expected: %v", fp.QuoteAvailable(), 0)
+	}
+}
+
+func TestOnSignalLimitOrder(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	b, err := funding.CreateItem(testExchange, asset.Spot, currency.BTC, decimal.Zero, decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := funding.CreateItem(testExchange, asset.Spot, currency.USDT, decimal.NewFromInt(1000), decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pair, err := funding.CreatePair(b, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := Portfolio{
+		sizeManager: &size.Size{},
+		riskManager: &risk.Risk{
+			CurrencySettings: map[string]map[asset.Item]map[currency.Pair]*risk.CurrencySettings{
+				testExchange: {
+					asset.Spot: {
+						cp: &risk.CurrencySettings{},
+					},
+				},
+			},
+		},
+	}
+	_, err = p.SetupCurrencySettingsMap(&exchange.Settings{Exchange: testExchange, Asset: asset.Spot, Pair: cp})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = p.setHoldingsForOffset(&holdings.Holding{
+		Exchange:  testExchange,
+		Asset:     asset.Spot,
+		Pair:      cp,
+		Timestamp: time.Now(),
+		QuoteSize: decimal.NewFromInt(1000)}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &signal.Signal{
+		Base: event.Base{
+			Exchange:     testExchange,
+			CurrencyPair: cp,
+			AssetType:    asset.Spot,
+		},
+		ClosePrice: decimal.NewFromInt(100),
+		Direction:  gctorder.Buy,
+	}
+	s.SetLimitOrder(decimal.Zero)
+	resp, err := p.OnSignal(s, &exchange.Settings{}, pair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Direction != common.CouldNotBuy {
+		t.Errorf("received: %v, expected: %v", resp.Direction, common.CouldNotBuy)
+	}
+
+	s.Direction = gctorder.Buy
+	s.SetLimitOrder(decimal.NewFromInt(50))
+	resp, err = p.OnSignal(s, &exchange.Settings{}, pair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Direction != gctorder.Buy {
+		t.Fatalf("received: %v, expected: %v %v", resp.Direction, gctorder.Buy, resp.Reason)
+	}
+	if resp.OrderType != gctorder.Limit {
+		t.Errorf("received: %v, expected: %v", resp.OrderType, gctorder.Limit)
+	}
+	if !resp.Price.Equal(decimal.NewFromInt(50)) {
+		t.Errorf("received: %v, expected: %v", resp.Price, 50)
+	}
+	// funds are reserved at the limit price rather than the close price
+	if !resp.AllocatedFunds.Equal(resp.Amount.Mul(decimal.NewFromInt(50))) {
+		t.Errorf("received: %v, expected: %v", resp.AllocatedFunds, resp.Amount.Mul(decimal.NewFromInt(50)))
+	}
+}
+
+func TestOnSignalPositionSizer(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	b, err := funding.CreateItem(testExchange, asset.Spot, currency.BTC, decimal.Zero, decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := funding.CreateItem(testExchange, asset.Spot, currency.USDT, decimal.NewFromInt(1000), decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pair, err := funding.CreatePair(b, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := Portfolio{
+		sizeManager: &size.Size{},
+		riskManager: &risk.Risk{
+			CurrencySettings: map[string]map[asset.Item]map[currency.Pair]*risk.CurrencySettings{
+				testExchange: {
+					asset.Spot: {
+						cp: &risk.CurrencySettings{},
+					},
+				},
+			},
+		},
+	}
+	sizer, err := sizing.Setup(sizing.Settings{Model: sizing.VolatilityTarget, TargetVolatility: decimal.NewFromFloat(0.02)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.SetPositionSizer(sizer)
+	_, err = p.SetupCurrencySettingsMap(&exchange.Settings{Exchange: testExchange, Asset: asset.Spot, Pair: cp})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = p.setHoldingsForOffset(&holdings.Holding{
+		Exchange:  testExchange,
+		Asset:     asset.Spot,
+		Pair:      cp,
+		Timestamp: time.Now(),
+		QuoteSize: decimal.NewFromInt(1000)}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &signal.Signal{
+		Base: event.Base{
+			Exchange:     testExchange,
+			CurrencyPair: cp,
+			AssetType:    asset.Spot,
+		},
+		ClosePrice: decimal.NewFromInt(100),
+		Direction:  gctorder.Buy,
+	}
+	// without enough prices to calculate ATR, the order cannot be sized
+	resp, err := p.OnSignal(s, &exchange.Settings{}, pair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Direction != common.CouldNotBuy {
+		t.Errorf("received: %v, expected: %v", resp.Direction, common.CouldNotBuy)
+	}
+
+	sizer, err = sizing.Setup(sizing.Settings{Model: sizing.FixedFractional, Fraction: decimal.NewFromFloat(0.1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.SetPositionSizer(sizer)
+	s.Direction = gctorder.Buy
+	resp, err = p.OnSignal(s, &exchange.Settings{}, pair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Direction != gctorder.Buy {
+		t.Fatalf("received: %v, expected: %v %v", resp.Direction, gctorder.Buy, resp.Reason)
+	}
+	// a tenth of the 1000 USDT equity is committed
+	if !resp.Amount.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("received: %v, expected: %v", resp.Amount, 1)
+	}
+	if !resp.AllocatedFunds.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("received: %v, expected: %v", resp.AllocatedFunds, 100)
+	}
+
+	p.Reset()
+	if p.positionSizer == nil {
+		t.Error("expected position sizer to remain set")
+	}
+}
+
 func TestGetLatestHoldings(t *testing.T) {
 	t.Parallel()
 	cs := Settings{}
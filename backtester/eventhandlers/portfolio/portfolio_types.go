@@ -36,6 +36,7 @@ var (
 type Portfolio struct {
 	riskFreeRate              decimal.Decimal
 	sizeManager               SizeHandler
+	positionSizer             PositionSizer
 	riskManager               risk.Handler
 	exchangeAssetPairSettings map[string]map[asset.Item]map[currency.Pair]*Settings
 }
@@ -51,6 +52,7 @@ type Handler interface {
 	ViewHoldingAtTimePeriod(common.EventHandler) (*holdings.Holding, error)
 	setHoldingsForOffset(*holdings.Holding, bool) error
 	UpdateHoldings(common.DataEventHandler, funding.IPairReader) error
+	TransferHoldings(common.DataEventHandler, funding.IPairReader) error
 
 	GetComplianceManager(string, asset.Item, currency.Pair) (*compliance.Manager, error)
 
@@ -65,6 +67,16 @@ type SizeHandler interface {
 	SizeOrder(order.Event, decimal.Decimal, *exchange.Settings) (*order.Order, error)
 }
 
+// PositionSizer decides how much of the funds available to open a position
+// each signal commits, before the order is sized against the size rules
+type PositionSizer interface {
+	Name() string
+	Update(common.DataEventHandler) error
+	OnFill(fill.Event) error
+	Allocate(signal.Event, decimal.Decimal, decimal.Decimal) (decimal.Decimal, error)
+	Reset()
+}
+
 // Settings holds all important information for the portfolio manager
 // to assess purchasing decisions
 type Settings struct {
@@ -0,0 +1,312 @@
+package sizing
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gct-ta/indicators"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// Setup validates the settings and creates a sizer for the model
+func Setup(s Settings) (*Sizer, error) {
+	switch s.Model {
+	case FixedFractional, Kelly, RiskParity:
+		if !s.Fraction.IsPositive() || s.Fraction.GreaterThan(decimal.NewFromInt(1)) {
+			return nil, fmt.Errorf("%v %w", s.Model, errInvalidFraction)
+		}
+	case VolatilityTarget:
+		if !s.TargetVolatility.IsPositive() || s.TargetVolatility.GreaterThan(decimal.NewFromInt(1)) {
+			return nil, errInvalidTargetVolatility
+		}
+	default:
+		return nil, fmt.Errorf("%w '%v'", ErrUnknownModel, s.Model)
+	}
+	if s.ATRPeriod < 0 {
+		return nil, errInvalidATRPeriod
+	}
+	if s.ATRPeriod == 0 {
+		s.ATRPeriod = DefaultATRPeriod
+	}
+	if s.KellyMultiplier.IsNegative() || s.KellyMultiplier.GreaterThan(decimal.NewFromInt(1)) {
+		return nil, errInvalidKellyMultiplier
+	}
+	if s.KellyMultiplier.IsZero() {
+		s.KellyMultiplier = decimal.NewFromFloat(DefaultKellyMultiplier)
+	}
+	if s.KellyMinimumTrades < 0 {
+		return nil, errInvalidMinimumTrades
+	}
+	if s.KellyMinimumTrades == 0 {
+		s.KellyMinimumTrades = DefaultKellyMinimumTrades
+	}
+	return &Sizer{
+		model:              s.Model,
+		fraction:           s.Fraction,
+		targetVolatility:   s.TargetVolatility,
+		atrPeriod:          int(s.ATRPeriod),
+		kellyMultiplier:    s.KellyMultiplier,
+		kellyMinimumTrades: s.KellyMinimumTrades,
+		pairs:              make(map[string]map[asset.Item]map[currency.Pair]*pairStats),
+		pools:              make(map[string]map[asset.Item]map[string]*fundPool),
+	}, nil
+}
+
+// Name returns the sizing model
+func (s *Sizer) Name() string {
+	return s.model
+}
+
+// Reset clears all tracked prices and trades
+func (s *Sizer) Reset() {
+	s.pairs = make(map[string]map[asset.Item]map[currency.Pair]*pairStats)
+	s.pools = make(map[string]map[asset.Item]map[string]*fundPool)
+}
+
+// Update records the latest prices of the data event's pair. Only the most
+// recent ATR periods are kept
+func (s *Sizer) Update(ev common.DataEventHandler) error {
+	if ev == nil {
+		return common.ErrNilEvent
+	}
+	ps := s.getPairStats(ev.GetExchange(), ev.GetAssetType(), ev.Pair())
+	if !ps.lastTime.IsZero() && !ev.GetTime().After(ps.lastTime) {
+		return nil
+	}
+	ps.lastTime = ev.GetTime()
+	ps.highs = append(ps.highs, ev.GetHighPrice().InexactFloat64())
+	ps.lows = append(ps.lows, ev.GetLowPrice().InexactFloat64())
+	ps.closes = append(ps.closes, ev.GetClosePrice().InexactFloat64())
+	if window := s.atrPeriod * atrWindowMultiplier; len(ps.closes) > window {
+		trim := len(ps.closes) - window
+		ps.highs = append(ps.highs[:0], ps.highs[trim:]...)
+		ps.lows = append(ps.lows[:0], ps.lows[trim:]...)
+		ps.closes = append(ps.closes[:0], ps.closes[trim:]...)
+	}
+	return nil
+}
+
+// OnFill records the fill against the pair's net position. Fills which
+// reduce the position close a trade, counting as a win or loss
+func (s *Sizer) OnFill(ev fill.Event) error {
+	if ev == nil {
+		return common.ErrNilEvent
+	}
+	amount := ev.GetAmount()
+	if !amount.IsPositive() {
+		return nil
+	}
+	switch ev.GetDirection() {
+	case gctorder.Buy:
+	case gctorder.Sell:
+		amount = amount.Neg()
+	default:
+		return nil
+	}
+	price := ev.GetPurchasePrice()
+	if !price.IsPositive() {
+		price = ev.GetClosePrice()
+	}
+	if !price.IsPositive() {
+		return nil
+	}
+	s.getPairStats(ev.GetExchange(), ev.GetAssetType(), ev.Pair()).fill(amount, price)
+	return nil
+}
+
+// Allocate returns the funds, in the quote currency, the signal commits to
+// opening a position. Available is the most which can be committed
+// and equity is the value of the pair's funds and holdings
+func (s *Sizer) Allocate(ev signal.Event, available, equity decimal.Decimal) (decimal.Decimal, error) {
+	if ev == nil {
+		return decimal.Zero, common.ErrNilEvent
+	}
+	if !available.IsPositive() {
+		return decimal.Zero, nil
+	}
+	price := ev.GetPrice()
+	if !price.IsPositive() {
+		return decimal.Zero, errNoPrice
+	}
+	ps := s.getPairStats(ev.GetExchange(), ev.GetAssetType(), ev.Pair())
+	var funds decimal.Decimal
+	switch s.model {
+	case FixedFractional:
+		funds = equity.Mul(s.fraction)
+	case VolatilityTarget:
+		atr, err := ps.atr(s.atrPeriod)
+		if err != nil {
+			return decimal.Zero, err
+		}
+		funds = equity.Mul(s.targetVolatility).Div(atr).Mul(price)
+	case Kelly:
+		if ps.wins+ps.losses < s.kellyMinimumTrades {
+			funds = equity.Mul(s.fraction)
+		} else {
+			funds = equity.Mul(ps.kellyFraction()).Mul(s.kellyMultiplier)
+		}
+	case RiskParity:
+		weight, err := s.riskParityWeight(ev)
+		if err != nil {
+			return decimal.Zero, err
+		}
+		funds = s.getPool(ev, available).Mul(s.fraction).Mul(weight)
+	default:
+		return decimal.Zero, fmt.Errorf("%w '%v'", ErrUnknownModel, s.model)
+	}
+	if funds.IsNegative() {
+		return decimal.Zero, nil
+	}
+	if funds.GreaterThan(available) {
+		return available, nil
+	}
+	return funds, nil
+}
+
+// riskParityWeight returns the pair's share of the funds, weighting each
+// pair sharing the exchange, asset and quote currency by its inverse volatility
+func (s *Sizer) riskParityWeight(ev signal.Event) (decimal.Decimal, error) {
+	pairVolatility, err := s.getPairStats(ev.GetExchange(), ev.GetAssetType(), ev.Pair()).volatility(s.atrPeriod)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	weight := decimal.NewFromInt(1).Div(pairVolatility)
+	var total decimal.Decimal
+	for cp, ps := range s.pairs[ev.GetExchange()][ev.GetAssetType()] {
+		if !cp.Quote.Match(ev.Pair().Quote) {
+			continue
+		}
+		v, err := ps.volatility(s.atrPeriod)
+		if err != nil {
+			// pairs without enough data are excluded from the split
+			continue
+		}
+		total = total.Add(decimal.NewFromInt(1).Div(v))
+	}
+	return weight.Div(total), nil
+}
+
+// getPool returns the funds available for the quote currency at the start
+// of the event's time, so each pair's share is taken from the same amount
+func (s *Sizer) getPool(ev signal.Event, available decimal.Decimal) decimal.Decimal {
+	exch, a, quote := ev.GetExchange(), ev.GetAssetType(), ev.Pair().Quote.Upper().String()
+	if s.pools[exch] == nil {
+		s.pools[exch] = make(map[asset.Item]map[string]*fundPool)
+	}
+	if s.pools[exch][a] == nil {
+		s.pools[exch][a] = make(map[string]*fundPool)
+	}
+	pool, ok := s.pools[exch][a][quote]
+	if !ok {
+		pool = &fundPool{}
+		s.pools[exch][a][quote] = pool
+	}
+	if !pool.time.Equal(ev.GetTime()) {
+		pool.time = ev.GetTime()
+		pool.amount = available
+	}
+	return pool.amount
+}
+
+func (s *Sizer) getPairStats(exch string, a asset.Item, cp currency.Pair) *pairStats {
+	if s.pairs[exch] == nil {
+		s.pairs[exch] = make(map[asset.Item]map[currency.Pair]*pairStats)
+	}
+	if s.pairs[exch][a] == nil {
+		s.pairs[exch][a] = make(map[currency.Pair]*pairStats)
+	}
+	ps, ok := s.pairs[exch][a][cp]
+	if !ok {
+		ps = &pairStats{}
+		s.pairs[exch][a][cp] = ps
+	}
+	return ps
+}
+
+// atr returns the latest average true range of the pair's prices
+func (p *pairStats) atr(period int) (decimal.Decimal, error) {
+	if len(p.closes) <= period {
+		return decimal.Zero, fmt.Errorf("%w, have %v prices, need %v", errInsufficientData, len(p.closes), period+1)
+	}
+	atr := indicators.ATR(p.highs, p.lows, p.closes, period)
+	latest := decimal.NewFromFloat(atr[len(atr)-1])
+	if !latest.IsPositive() {
+		return decimal.Zero, fmt.Errorf("%w, ATR is %v", errInsufficientData, latest)
+	}
+	return latest, nil
+}
+
+// volatility returns the pair's ATR as a proportion of its latest close
+func (p *pairStats) volatility(period int) (decimal.Decimal, error) {
+	atr, err := p.atr(period)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	latestClose := decimal.NewFromFloat(p.closes[len(p.closes)-1])
+	if !latestClose.IsPositive() {
+		return decimal.Zero, errNoPrice
+	}
+	return atr.Div(latestClose), nil
+}
+
+// fill applies a signed amount at the price to the net position. Any amount
+// reducing the position records the trade's return as a win or loss
+func (p *pairStats) fill(amount, price decimal.Decimal) {
+	if p.size.IsZero() || p.size.IsPositive() == amount.IsPositive() {
+		// opening or increasing the position averages the entry price
+		total := p.size.Add(amount)
+		p.entryPrice = p.entryPrice.Mul(p.size).Add(price.Mul(amount)).Div(total)
+		p.size = total
+		return
+	}
+	tradeReturn := price.Sub(p.entryPrice).Div(p.entryPrice)
+	if p.size.IsNegative() {
+		tradeReturn = tradeReturn.Neg()
+	}
+	switch {
+	case tradeReturn.IsPositive():
+		p.wins++
+		p.totalWin = p.totalWin.Add(tradeReturn)
+	case tradeReturn.IsNegative():
+		p.losses++
+		p.totalLoss = p.totalLoss.Add(tradeReturn.Abs())
+	}
+	remaining := p.size.Add(amount)
+	switch {
+	case remaining.IsZero():
+		p.entryPrice = decimal.Zero
+	case remaining.IsPositive() != p.size.IsPositive():
+		// the position has flipped sides, opening at the fill price
+		p.entryPrice = price
+	}
+	p.size = remaining
+}
+
+// kellyFraction returns the full Kelly fraction from the pair's closed
+// trades, W - (1 - W) / R, where W is the win rate and R is the
+// average win divided by the average loss
+func (p *pairStats) kellyFraction() decimal.Decimal {
+	if p.wins == 0 {
+		return decimal.Zero
+	}
+	if p.losses == 0 {
+		return decimal.NewFromInt(1)
+	}
+	one := decimal.NewFromInt(1)
+	winRate := decimal.NewFromInt(p.wins).Div(decimal.NewFromInt(p.wins + p.losses))
+	averageWin := p.totalWin.Div(decimal.NewFromInt(p.wins))
+	averageLoss := p.totalLoss.Div(decimal.NewFromInt(p.losses))
+	fraction := winRate.Sub(one.Sub(winRate).Div(averageWin.Div(averageLoss)))
+	if fraction.IsNegative() {
+		return decimal.Zero
+	}
+	if fraction.GreaterThan(one) {
+		return one
+	}
+	return fraction
+}
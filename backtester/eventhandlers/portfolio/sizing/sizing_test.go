@@ -0,0 +1,315 @@
+package sizing
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+const testExchange = "binance"
+
+var (
+	btcUSDT = currency.NewPair(currency.BTC, currency.USDT)
+	ethUSDT = currency.NewPair(currency.ETH, currency.USDT)
+)
+
+func TestSetup(t *testing.T) {
+	t.Parallel()
+	_, err := Setup(Settings{Model: "bad"})
+	if !errors.Is(err, ErrUnknownModel) {
+		t.Errorf("received '%v' expected '%v'", err, ErrUnknownModel)
+	}
+	_, err = Setup(Settings{Model: FixedFractional})
+	if !errors.Is(err, errInvalidFraction) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidFraction)
+	}
+	_, err = Setup(Settings{Model: RiskParity, Fraction: decimal.NewFromInt(2)})
+	if !errors.Is(err, errInvalidFraction) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidFraction)
+	}
+	_, err = Setup(Settings{Model: VolatilityTarget})
+	if !errors.Is(err, errInvalidTargetVolatility) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidTargetVolatility)
+	}
+	_, err = Setup(Settings{Model: VolatilityTarget, TargetVolatility: decimal.NewFromFloat(0.02), ATRPeriod: -1})
+	if !errors.Is(err, errInvalidATRPeriod) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidATRPeriod)
+	}
+	_, err = Setup(Settings{Model: Kelly, Fraction: decimal.NewFromFloat(0.1), KellyMultiplier: decimal.NewFromInt(-1)})
+	if !errors.Is(err, errInvalidKellyMultiplier) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidKellyMultiplier)
+	}
+	_, err = Setup(Settings{Model: Kelly, Fraction: decimal.NewFromFloat(0.1), KellyMinimumTrades: -1})
+	if !errors.Is(err, errInvalidMinimumTrades) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidMinimumTrades)
+	}
+	s, err := Setup(Settings{Model: Kelly, Fraction: decimal.NewFromFloat(0.1)})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if s.Name() != Kelly {
+		t.Errorf("received '%v' expected '%v'", s.Name(), Kelly)
+	}
+	if s.atrPeriod != DefaultATRPeriod {
+		t.Errorf("received '%v' expected '%v'", s.atrPeriod, DefaultATRPeriod)
+	}
+	if !s.kellyMultiplier.Equal(decimal.NewFromFloat(DefaultKellyMultiplier)) {
+		t.Errorf("received '%v' expected '%v'", s.kellyMultiplier, DefaultKellyMultiplier)
+	}
+	if s.kellyMinimumTrades != DefaultKellyMinimumTrades {
+		t.Errorf("received '%v' expected '%v'", s.kellyMinimumTrades, DefaultKellyMinimumTrades)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	t.Parallel()
+	s, err := Setup(Settings{Model: VolatilityTarget, TargetVolatility: decimal.NewFromFloat(0.02), ATRPeriod: 2})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = s.Update(nil)
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilEvent)
+	}
+	tt := time.Now().Truncate(time.Hour)
+	updateCandles(t, s, btcUSDT, tt, 25, 10, 2)
+	ps := s.pairs[testExchange][asset.Spot][btcUSDT]
+	if len(ps.closes) != 2*atrWindowMultiplier {
+		t.Errorf("received '%v' expected '%v'", len(ps.closes), 2*atrWindowMultiplier)
+	}
+	// an event which is not after the latest is ignored
+	err = s.Update(candle(btcUSDT, tt, 10, 2))
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(ps.closes) != 2*atrWindowMultiplier {
+		t.Errorf("received '%v' expected '%v'", len(ps.closes), 2*atrWindowMultiplier)
+	}
+	s.Reset()
+	if len(s.pairs) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(s.pairs), 0)
+	}
+}
+
+func TestOnFill(t *testing.T) {
+	t.Parallel()
+	s, err := Setup(Settings{Model: Kelly, Fraction: decimal.NewFromFloat(0.1)})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = s.OnFill(nil)
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilEvent)
+	}
+	fillTrade(t, s, gctorder.Buy, 2, 10)
+	fillTrade(t, s, common.CouldNotSell, 2, 10)
+	fillTrade(t, s, gctorder.Sell, 1, 12)
+	fillTrade(t, s, gctorder.Sell, 3, 9)
+	ps := s.pairs[testExchange][asset.Spot][btcUSDT]
+	if ps.wins != 1 || ps.losses != 1 {
+		t.Errorf("received '%v' wins '%v' losses, expected 1 and 1", ps.wins, ps.losses)
+	}
+	// the position flipped short at the last fill price
+	if !ps.size.Equal(decimal.NewFromInt(-2)) || !ps.entryPrice.Equal(decimal.NewFromInt(9)) {
+		t.Errorf("received '%v' at '%v' expected '%v' at '%v'", ps.size, ps.entryPrice, -2, 9)
+	}
+	fillTrade(t, s, gctorder.Buy, 2, 8)
+	if ps.wins != 2 || !ps.size.IsZero() {
+		t.Errorf("received '%v' wins and size '%v', expected 2 and 0", ps.wins, ps.size)
+	}
+}
+
+func TestAllocate(t *testing.T) {
+	t.Parallel()
+	tt := time.Now().Truncate(time.Hour)
+	equity := decimal.NewFromInt(1000)
+	available := decimal.NewFromInt(900)
+
+	s, err := Setup(Settings{Model: FixedFractional, Fraction: decimal.NewFromFloat(0.1)})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = s.Allocate(nil, available, equity)
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilEvent)
+	}
+	_, err = s.Allocate(signalAt(btcUSDT, tt, 0), available, equity)
+	if !errors.Is(err, errNoPrice) {
+		t.Errorf("received '%v' expected '%v'", err, errNoPrice)
+	}
+	funds, err := s.Allocate(signalAt(btcUSDT, tt, 10), available, equity)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !funds.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("received '%v' expected '%v'", funds, 100)
+	}
+	// allocations are capped to what is available
+	funds, err = s.Allocate(signalAt(btcUSDT, tt, 10), decimal.NewFromInt(50), equity)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !funds.Equal(decimal.NewFromInt(50)) {
+		t.Errorf("received '%v' expected '%v'", funds, 50)
+	}
+
+	s, err = Setup(Settings{Model: VolatilityTarget, TargetVolatility: decimal.NewFromFloat(0.02), ATRPeriod: 2})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = s.Allocate(signalAt(btcUSDT, tt, 10), available, equity)
+	if !errors.Is(err, errInsufficientData) {
+		t.Errorf("received '%v' expected '%v'", err, errInsufficientData)
+	}
+	updateCandles(t, s, btcUSDT, tt, 5, 10, 2)
+	// ATR of 2 risks 20 of equity per 10 units
+	funds, err = s.Allocate(signalAt(btcUSDT, tt, 10), available, equity)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !funds.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("received '%v' expected '%v'", funds, 100)
+	}
+
+	s, err = Setup(Settings{Model: Kelly, Fraction: decimal.NewFromFloat(0.1), KellyMinimumTrades: 3})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	fillTrade(t, s, gctorder.Buy, 1, 10)
+	fillTrade(t, s, gctorder.Sell, 1, 12)
+	funds, err = s.Allocate(signalAt(btcUSDT, tt, 10), available, equity)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !funds.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("received '%v' expected '%v'", funds, 100)
+	}
+	fillTrade(t, s, gctorder.Buy, 1, 10)
+	fillTrade(t, s, gctorder.Sell, 1, 9)
+	fillTrade(t, s, gctorder.Buy, 1, 10)
+	fillTrade(t, s, gctorder.Sell, 1, 12)
+	// a full Kelly fraction of 0.5 is halved by the default multiplier
+	funds, err = s.Allocate(signalAt(btcUSDT, tt, 10), available, equity)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !funds.Round(8).Equal(decimal.NewFromInt(250)) {
+		t.Errorf("received '%v' expected '%v'", funds, 250)
+	}
+}
+
+func TestAllocateRiskParity(t *testing.T) {
+	t.Parallel()
+	tt := time.Now().Truncate(time.Hour)
+	s, err := Setup(Settings{Model: RiskParity, Fraction: decimal.NewFromInt(1), ATRPeriod: 2})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	updateCandles(t, s, btcUSDT, tt, 5, 10, 2)
+	_, err = s.Allocate(signalAt(ethUSDT, tt, 20), decimal.NewFromInt(900), decimal.NewFromInt(900))
+	if !errors.Is(err, errInsufficientData) {
+		t.Errorf("received '%v' expected '%v'", err, errInsufficientData)
+	}
+	updateCandles(t, s, ethUSDT, tt, 5, 20, 2)
+	// BTC is twice as volatile as ETH, so receives half the weight
+	signalTime := tt.Add(4 * time.Hour)
+	funds, err := s.Allocate(signalAt(btcUSDT, signalTime, 10), decimal.NewFromInt(900), decimal.NewFromInt(900))
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !funds.Round(8).Equal(decimal.NewFromInt(300)) {
+		t.Errorf("received '%v' expected '%v'", funds, 300)
+	}
+	// the split is taken from the funds available at the start of the time
+	funds, err = s.Allocate(signalAt(ethUSDT, signalTime, 20), decimal.NewFromInt(600), decimal.NewFromInt(600))
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !funds.Round(8).Equal(decimal.NewFromInt(600)) {
+		t.Errorf("received '%v' expected '%v'", funds, 600)
+	}
+}
+
+func TestKellyFraction(t *testing.T) {
+	t.Parallel()
+	p := &pairStats{}
+	if !p.kellyFraction().IsZero() {
+		t.Errorf("received '%v' expected '%v'", p.kellyFraction(), 0)
+	}
+	p.wins = 1
+	p.totalWin = decimal.NewFromFloat(0.1)
+	if !p.kellyFraction().Equal(decimal.NewFromInt(1)) {
+		t.Errorf("received '%v' expected '%v'", p.kellyFraction(), 1)
+	}
+	// a losing edge commits nothing
+	p.losses = 3
+	p.totalLoss = decimal.NewFromFloat(0.3)
+	if !p.kellyFraction().IsZero() {
+		t.Errorf("received '%v' expected '%v'", p.kellyFraction(), 0)
+	}
+}
+
+func updateCandles(t *testing.T, s *Sizer, cp currency.Pair, start time.Time, count int, price, trueRange float64) {
+	t.Helper()
+	for i := 0; i < count; i++ {
+		err := s.Update(candle(cp, start.Add(time.Duration(i)*time.Hour), price, trueRange))
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v' expected '%v'", err, nil)
+		}
+	}
+}
+
+func candle(cp currency.Pair, tt time.Time, price, trueRange float64) *kline.Kline {
+	return &kline.Kline{
+		Base: event.Base{
+			Exchange:     testExchange,
+			Time:         tt,
+			CurrencyPair: cp,
+			AssetType:    asset.Spot,
+		},
+		Open:  decimal.NewFromFloat(price),
+		Close: decimal.NewFromFloat(price),
+		High:  decimal.NewFromFloat(price + trueRange/2),
+		Low:   decimal.NewFromFloat(price - trueRange/2),
+	}
+}
+
+func signalAt(cp currency.Pair, tt time.Time, price int64) *signal.Signal {
+	return &signal.Signal{
+		Base: event.Base{
+			Exchange:     testExchange,
+			Time:         tt,
+			CurrencyPair: cp,
+			AssetType:    asset.Spot,
+		},
+		ClosePrice: decimal.NewFromInt(price),
+		Direction:  gctorder.Buy,
+	}
+}
+
+func fillTrade(t *testing.T, s *Sizer, side gctorder.Side, amount, price int64) {
+	t.Helper()
+	err := s.OnFill(&fill.Fill{
+		Base: event.Base{
+			Exchange:     testExchange,
+			CurrencyPair: btcUSDT,
+			AssetType:    asset.Spot,
+		},
+		Direction:     side,
+		Amount:        decimal.NewFromInt(amount),
+		PurchasePrice: decimal.NewFromInt(price),
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+}
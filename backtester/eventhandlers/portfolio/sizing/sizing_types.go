@@ -0,0 +1,101 @@
+package sizing
+
+import (
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+const (
+	// FixedFractional commits a fixed fraction of the pair's equity to each order
+	FixedFractional = "fixed-fractional"
+	// VolatilityTarget sizes orders so that one ATR move costs a fixed
+	// fraction of the pair's equity
+	VolatilityTarget = "volatility-target"
+	// Kelly commits the Kelly fraction of the pair's equity, calculated from
+	// the pair's running win rate and win/loss ratio
+	Kelly = "kelly"
+	// RiskParity splits funds between pairs sharing a currency so each
+	// pair carries the same ATR based volatility
+	RiskParity = "risk-parity"
+
+	// DefaultATRPeriod is used when no ATR period is set
+	DefaultATRPeriod = 14
+	// DefaultKellyMultiplier is used when no Kelly multiplier is set,
+	// committing half of the full Kelly fraction
+	DefaultKellyMultiplier = 0.5
+	// DefaultKellyMinimumTrades is used when no minimum number of trades is set
+	DefaultKellyMinimumTrades = 10
+
+	// atrWindowMultiplier limits the price history kept per pair to this
+	// many ATR periods
+	atrWindowMultiplier = 10
+)
+
+var (
+	// ErrUnknownModel is returned when the sizing model is not supported
+	ErrUnknownModel = errors.New("unknown position sizing model")
+
+	errInvalidFraction         = errors.New("fraction must be greater than zero and at most one")
+	errInvalidTargetVolatility = errors.New("target volatility must be greater than zero and at most one")
+	errInvalidATRPeriod        = errors.New("ATR period must not be negative")
+	errInvalidKellyMultiplier  = errors.New("kelly multiplier must be greater than zero and at most one")
+	errInvalidMinimumTrades    = errors.New("kelly minimum trades must not be negative")
+	errInsufficientData        = errors.New("not enough data to calculate ATR")
+	errNoPrice                 = errors.New("signal has no price")
+)
+
+// Settings defines the sizing model and its parameters
+type Settings struct {
+	Model string
+	// Fraction is the share of equity committed by fixed-fractional,
+	// the share of funds split between pairs by risk-parity and
+	// the fraction used by kelly until enough trades have closed
+	Fraction decimal.Decimal
+	// TargetVolatility is the share of equity one ATR move costs under volatility-target
+	TargetVolatility decimal.Decimal
+	ATRPeriod        int64
+	// KellyMultiplier scales the full Kelly fraction down to reduce risk
+	KellyMultiplier    decimal.Decimal
+	KellyMinimumTrades int64
+}
+
+// Sizer tracks the prices and closed trades of each pair
+// in order to allocate funds to new positions
+type Sizer struct {
+	model              string
+	fraction           decimal.Decimal
+	targetVolatility   decimal.Decimal
+	atrPeriod          int
+	kellyMultiplier    decimal.Decimal
+	kellyMinimumTrades int64
+	pairs              map[string]map[asset.Item]map[currency.Pair]*pairStats
+	pools              map[string]map[asset.Item]map[string]*fundPool
+}
+
+// pairStats holds the price history and trade results of a pair
+type pairStats struct {
+	lastTime time.Time
+	highs    []float64
+	lows     []float64
+	closes   []float64
+
+	// the signed amount and average price of the net position
+	// opened by fills, used to determine trade results
+	size       decimal.Decimal
+	entryPrice decimal.Decimal
+
+	wins      int64
+	losses    int64
+	totalWin  decimal.Decimal
+	totalLoss decimal.Decimal
+}
+
+// fundPool holds the funds available to be split between pairs at a time
+type fundPool struct {
+	time   time.Time
+	amount decimal.Decimal
+}
@@ -0,0 +1,287 @@
+package statistics
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	gctmath "github.com/thrasher-corp/gocryptotrader/common/math"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+const defaultRollingWindow = 30
+
+// LoadBenchmarkIndex reads a benchmark index from a CSV file where each row is
+// a unix timestamp in seconds followed by the index value
+func LoadBenchmarkIndex(path string) ([]ValueAtTime, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = f.Close()
+		if err != nil {
+			log.Errorln(log.BackTester, err)
+		}
+	}()
+	r := csv.NewReader(f)
+	var resp []ValueAtTime
+	for {
+		row, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not read benchmark index %v, %w", path, err)
+		}
+		if len(row) < 2 {
+			return nil, fmt.Errorf("%w on row %v", errBadBenchmarkIndexRow, row)
+		}
+		ts, err := strconv.ParseInt(strings.TrimSpace(row[0]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w invalid timestamp %v", errBadBenchmarkIndexRow, row[0])
+		}
+		value, err := decimal.NewFromString(strings.TrimSpace(row[1]))
+		if err != nil {
+			return nil, fmt.Errorf("%w invalid value %v", errBadBenchmarkIndexRow, row[1])
+		}
+		resp = append(resp, ValueAtTime{
+			Time:  time.Unix(ts, 0).UTC(),
+			Value: value,
+		})
+	}
+	if len(resp) == 0 {
+		return nil, fmt.Errorf("%w %v", errReceivedNoData, path)
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].Time.Before(resp[j].Time)
+	})
+	return resp, nil
+}
+
+// setup creates the benchmark's values over time, either from its index or by
+// buying and holding its components from their first candle
+func (b *Benchmark) setup(stats map[string]map[asset.Item]map[currency.Pair]*CurrencyPairStatistic) error {
+	if len(b.Components) == 0 {
+		if len(b.Index) == 0 {
+			return fmt.Errorf("%v %w", b.Name, errBenchmarkUnset)
+		}
+		b.values = b.Index
+		return nil
+	}
+	totalWeight := decimal.Zero
+	for i := range b.Components {
+		totalWeight = totalWeight.Add(b.Components[i].Weight)
+	}
+	prices := make([][]ValueAtTime, len(b.Components))
+	weights := make([]decimal.Decimal, len(b.Components))
+	var times []time.Time
+	seen := make(map[int64]bool)
+	for i := range b.Components {
+		c := b.Components[i]
+		lookup := lookupStatistics(stats, c.Exchange, c.Asset, c.Pair)
+		if lookup == nil {
+			return fmt.Errorf("%w %v %v %v", errBenchmarkComponentNotFound, c.Exchange, c.Asset, c.Pair)
+		}
+		if totalWeight.IsZero() {
+			// spread evenly when no weights are set, such as buy and hold of one pair
+			weights[i] = decimal.NewFromInt(1).Div(decimal.NewFromInt(int64(len(b.Components))))
+		} else {
+			weights[i] = c.Weight.Div(totalWeight)
+		}
+		for j := range lookup.Events {
+			if lookup.Events[j].DataEvent == nil {
+				continue
+			}
+			t := lookup.Events[j].DataEvent.GetTime()
+			price := lookup.Events[j].DataEvent.GetClosePrice()
+			if price.IsZero() {
+				continue
+			}
+			prices[i] = append(prices[i], ValueAtTime{Time: t, Value: price})
+			if !seen[t.UnixNano()] {
+				seen[t.UnixNano()] = true
+				times = append(times, t)
+			}
+		}
+		if len(prices[i]) == 0 {
+			return fmt.Errorf("%w %v %v %v", errReceivedNoData, c.Exchange, c.Asset, c.Pair)
+		}
+		sort.Slice(prices[i], func(x, y int) bool {
+			return prices[i][x].Time.Before(prices[i][y].Time)
+		})
+	}
+	sort.Slice(times, func(i, j int) bool {
+		return times[i].Before(times[j])
+	})
+	b.values = make([]ValueAtTime, len(times))
+	for i := range times {
+		value := decimal.Zero
+		for j := range prices {
+			// each component is valued relative to its first price
+			// so the basket starts at 1
+			value = value.Add(valueAt(prices[j], times[i]).Div(prices[j][0].Value).Mul(weights[j]))
+		}
+		b.values[i] = ValueAtTime{Time: times[i], Value: value}
+	}
+	return nil
+}
+
+// lookupStatistics finds a pair's statistics regardless of exchange name case
+// or pair formatting
+func lookupStatistics(stats map[string]map[asset.Item]map[currency.Pair]*CurrencyPairStatistic, exch string, a asset.Item, p currency.Pair) *CurrencyPairStatistic {
+	for exchName, exchMap := range stats {
+		if !strings.EqualFold(exchName, exch) {
+			continue
+		}
+		for pair, stat := range exchMap[a] {
+			if pair.Equal(p) {
+				return stat
+			}
+		}
+	}
+	return nil
+}
+
+// valueAt returns the latest value at or before the time. The first value is
+// returned for times before the series begins
+func valueAt(values []ValueAtTime, t time.Time) decimal.Decimal {
+	if len(values) == 0 {
+		return decimal.Zero
+	}
+	i := sort.Search(len(values), func(i int) bool {
+		return values[i].Time.After(t)
+	})
+	if i == 0 {
+		return values[0].Value
+	}
+	return values[i-1].Value
+}
+
+// rates returns the benchmark's value at each time and the rate of change
+// between each time. The first rate is zero as no movement has been made
+func (b *Benchmark) rates(times []time.Time) (values, rates []decimal.Decimal, missingData bool) {
+	values = make([]decimal.Decimal, len(times))
+	rates = make([]decimal.Decimal, len(times))
+	for i := range times {
+		values[i] = valueAt(b.values, times[i])
+		if i == 0 {
+			continue
+		}
+		if values[i].IsZero() || values[i-1].IsZero() {
+			// use the previous rate to allow some consistency
+			missingData = true
+			rates[i] = rates[i-1]
+			continue
+		}
+		rates[i] = values[i].Sub(values[i-1]).Div(values[i-1])
+	}
+	return values, rates, missingData
+}
+
+// CalculateBenchmarkStatistics compares the returns per candle against the
+// benchmark's returns per candle. Alpha and tracking error are annualised
+func CalculateBenchmarkStatistics(name string, benchmarkRates, returnsPerCandle []decimal.Decimal, riskFreeRatePerCandle decimal.Decimal, intervalsPerYear float64) (*BenchmarkStatistics, error) {
+	averageReturns, err := gctmath.DecimalArithmeticMean(returnsPerCandle)
+	if err != nil {
+		return nil, err
+	}
+	averageBenchmark, err := gctmath.DecimalArithmeticMean(benchmarkRates)
+	if err != nil {
+		return nil, err
+	}
+	beta, err := gctmath.DecimalBeta(returnsPerCandle, benchmarkRates)
+	if err != nil {
+		return nil, err
+	}
+	trackingError, err := gctmath.DecimalTrackingError(returnsPerCandle, benchmarkRates)
+	if err != nil && !errors.Is(err, gctmath.ErrInexactConversion) {
+		return nil, err
+	}
+	upCapture, downCapture, err := gctmath.DecimalCaptureRatios(returnsPerCandle, benchmarkRates)
+	if err != nil {
+		return nil, err
+	}
+	periods := decimal.NewFromFloat(intervalsPerYear)
+	return &BenchmarkStatistics{
+		Benchmark:        name,
+		Alpha:            gctmath.DecimalAlpha(averageReturns, averageBenchmark, beta, riskFreeRatePerCandle).Mul(periods),
+		Beta:             beta,
+		TrackingError:    trackingError.Mul(gctmath.DecimalPow(periods, decimal.NewFromFloat(0.5))),
+		UpCaptureRatio:   upCapture,
+		DownCaptureRatio: downCapture,
+	}, nil
+}
+
+// CalculateRollingStatistics calculates the Sharpe ratio and annualised
+// volatility of each window of returns, timestamped at the window's end
+func CalculateRollingStatistics(times []time.Time, returnsPerCandle []decimal.Decimal, window int64, riskFreeRatePerCandle decimal.Decimal, intervalsPerYear float64) ([]RollingStatistic, error) {
+	if len(times) != len(returnsPerCandle) {
+		return nil, errRollingTimesMismatch
+	}
+	if window <= 0 {
+		window = defaultRollingWindow
+	}
+	if int64(len(returnsPerCandle)) < window {
+		return nil, nil
+	}
+	annualise := gctmath.DecimalPow(decimal.NewFromFloat(intervalsPerYear), decimal.NewFromFloat(0.5))
+	resp := make([]RollingStatistic, 0, int64(len(returnsPerCandle))-window+1)
+	for i := window; i <= int64(len(returnsPerCandle)); i++ {
+		returns := returnsPerCandle[i-window : i]
+		average, err := gctmath.DecimalArithmeticMean(returns)
+		if err != nil {
+			return nil, err
+		}
+		sharpe, err := gctmath.DecimalSharpeRatio(returns, riskFreeRatePerCandle, average)
+		if err != nil {
+			return nil, err
+		}
+		stdDev, err := gctmath.DecimalPopulationStandardDeviation(returns)
+		if err != nil && !errors.Is(err, gctmath.ErrInexactConversion) {
+			return nil, err
+		}
+		resp = append(resp, RollingStatistic{
+			Time:        times[i-1],
+			SharpeRatio: sharpe,
+			Volatility:  stdDev.Mul(annualise),
+		})
+	}
+	return resp, nil
+}
+
+// CalculateMonthlyReturns compounds the strategy and benchmark returns per
+// candle into a return for each calendar month as a percentage
+func CalculateMonthlyReturns(times []time.Time, returnsPerCandle, benchmarkRates []decimal.Decimal) ([]MonthlyReturn, error) {
+	if len(times) != len(returnsPerCandle) || len(times) != len(benchmarkRates) {
+		return nil, errRollingTimesMismatch
+	}
+	one := decimal.NewFromInt(1)
+	oneHundred := decimal.NewFromInt(100)
+	var resp []MonthlyReturn
+	strategyGrowth, benchmarkGrowth := one, one
+	for i := range times {
+		t := times[i].UTC()
+		month := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+		if len(resp) == 0 || !resp[len(resp)-1].Month.Equal(month) {
+			resp = append(resp, MonthlyReturn{Month: month})
+			strategyGrowth, benchmarkGrowth = one, one
+		}
+		strategyGrowth = strategyGrowth.Mul(one.Add(returnsPerCandle[i]))
+		benchmarkGrowth = benchmarkGrowth.Mul(one.Add(benchmarkRates[i]))
+		last := &resp[len(resp)-1]
+		last.StrategyReturn = strategyGrowth.Sub(one).Mul(oneHundred).Round(8)
+		last.BenchmarkReturn = benchmarkGrowth.Sub(one).Mul(oneHundred).Round(8)
+		last.ExcessReturn = last.StrategyReturn.Sub(last.BenchmarkReturn)
+	}
+	return resp, nil
+}
@@ -0,0 +1,324 @@
+package statistics
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+var benchmarkTime = time.Date(2021, 1, 30, 0, 0, 0, 0, time.UTC)
+
+// benchmarkStatistics returns pair statistics with an event for each close
+// price, one day apart
+func benchmarkStatistics(exch string, a asset.Item, p currency.Pair, closes ...int64) *CurrencyPairStatistic {
+	resp := &CurrencyPairStatistic{}
+	for i := range closes {
+		resp.Events = append(resp.Events, EventStore{
+			DataEvent: &kline.Kline{
+				Base: event.Base{
+					Exchange:     exch,
+					Time:         benchmarkTime.Add(gctkline.OneDay.Duration() * time.Duration(i)),
+					Interval:     gctkline.OneDay,
+					CurrencyPair: p,
+					AssetType:    a,
+				},
+				Close: decimal.NewFromInt(closes[i]),
+			},
+		})
+	}
+	return resp
+}
+
+func TestLoadBenchmarkIndex(t *testing.T) {
+	t.Parallel()
+	_, err := LoadBenchmarkIndex(filepath.Join(t.TempDir(), "missing.csv"))
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("received '%v' expected '%v'", err, os.ErrNotExist)
+	}
+
+	dir := t.TempDir()
+	bad := filepath.Join(dir, "bad.csv")
+	err = os.WriteFile(bad, []byte("1609459200,abc\n"), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = LoadBenchmarkIndex(bad)
+	if !errors.Is(err, errBadBenchmarkIndexRow) {
+		t.Errorf("received '%v' expected '%v'", err, errBadBenchmarkIndexRow)
+	}
+
+	empty := filepath.Join(dir, "empty.csv")
+	err = os.WriteFile(empty, nil, 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = LoadBenchmarkIndex(empty)
+	if !errors.Is(err, errReceivedNoData) {
+		t.Errorf("received '%v' expected '%v'", err, errReceivedNoData)
+	}
+
+	good := filepath.Join(dir, "good.csv")
+	err = os.WriteFile(good, []byte("1609545600,101.5\n1609459200,100\n"), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	index, err := LoadBenchmarkIndex(good)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(index) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(index), 2)
+	}
+	if !index[0].Time.Equal(time.Unix(1609459200, 0)) {
+		t.Errorf("received '%v' expected '%v'", index[0].Time, time.Unix(1609459200, 0))
+	}
+	if !index[1].Value.Equal(decimal.NewFromFloat(101.5)) {
+		t.Errorf("received '%v' expected '%v'", index[1].Value, 101.5)
+	}
+}
+
+func TestBenchmarkSetup(t *testing.T) {
+	t.Parallel()
+	b := &Benchmark{}
+	err := b.setup(nil)
+	if !errors.Is(err, errBenchmarkUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errBenchmarkUnset)
+	}
+
+	btc := currency.NewPair(currency.BTC, currency.USDT)
+	eth := currency.NewPair(currency.ETH, currency.USDT)
+	stats := map[string]map[asset.Item]map[currency.Pair]*CurrencyPairStatistic{
+		testExchange: {
+			asset.Spot: {
+				btc: benchmarkStatistics(testExchange, asset.Spot, btc, 100, 110, 120),
+				eth: benchmarkStatistics(testExchange, asset.Spot, eth, 10, 10, 5),
+			},
+		},
+	}
+	b.Components = []BenchmarkComponent{
+		{Exchange: testExchange, Asset: asset.Spot, Pair: currency.NewPair(currency.LTC, currency.USDT)},
+	}
+	err = b.setup(stats)
+	if !errors.Is(err, errBenchmarkComponentNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errBenchmarkComponentNotFound)
+	}
+
+	// buy and hold does not require a weight
+	b.Components = []BenchmarkComponent{
+		{Exchange: "BiNaNcE", Asset: asset.Spot, Pair: currency.NewPairWithDelimiter("BTC", "USDT", "-")},
+	}
+	err = b.setup(stats)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !b.values[2].Value.Equal(decimal.NewFromFloat(1.2)) {
+		t.Errorf("received '%v' expected '%v'", b.values[2].Value, 1.2)
+	}
+
+	b.Components = []BenchmarkComponent{
+		{Exchange: testExchange, Asset: asset.Spot, Pair: btc, Weight: decimal.NewFromInt(3)},
+		{Exchange: testExchange, Asset: asset.Spot, Pair: eth, Weight: decimal.NewFromInt(1)},
+	}
+	err = b.setup(stats)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(b.values) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(b.values), 3)
+	}
+	if !b.values[0].Value.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("received '%v' expected '%v'", b.values[0].Value, 1)
+	}
+	// 0.75 * 120/100 + 0.25 * 5/10
+	if !b.values[2].Value.Equal(decimal.NewFromFloat(1.025)) {
+		t.Errorf("received '%v' expected '%v'", b.values[2].Value, 1.025)
+	}
+}
+
+func TestValueAt(t *testing.T) {
+	t.Parallel()
+	if v := valueAt(nil, benchmarkTime); !v.IsZero() {
+		t.Errorf("received '%v' expected '%v'", v, 0)
+	}
+	values := []ValueAtTime{
+		{Time: benchmarkTime, Value: decimal.NewFromInt(1)},
+		{Time: benchmarkTime.Add(time.Hour * 2), Value: decimal.NewFromInt(2)},
+	}
+	if v := valueAt(values, benchmarkTime.Add(-time.Hour)); !v.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("received '%v' expected '%v'", v, 1)
+	}
+	if v := valueAt(values, benchmarkTime.Add(time.Hour)); !v.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("received '%v' expected '%v'", v, 1)
+	}
+	if v := valueAt(values, benchmarkTime.Add(time.Hour*2)); !v.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' expected '%v'", v, 2)
+	}
+}
+
+func TestBenchmarkRates(t *testing.T) {
+	t.Parallel()
+	b := &Benchmark{
+		values: []ValueAtTime{
+			{Time: benchmarkTime, Value: decimal.NewFromInt(100)},
+			{Time: benchmarkTime.Add(time.Hour), Value: decimal.Zero},
+			{Time: benchmarkTime.Add(time.Hour * 2), Value: decimal.NewFromInt(50)},
+			{Time: benchmarkTime.Add(time.Hour * 3), Value: decimal.NewFromInt(100)},
+		},
+	}
+	times := []time.Time{
+		benchmarkTime,
+		benchmarkTime.Add(time.Hour * 3),
+	}
+	values, rates, missingData := b.rates(times)
+	if missingData {
+		t.Error("expected no missing data")
+	}
+	if !values[1].Equal(decimal.NewFromInt(100)) {
+		t.Errorf("received '%v' expected '%v'", values[1], 100)
+	}
+	if !rates[1].IsZero() {
+		t.Errorf("received '%v' expected '%v'", rates[1], 0)
+	}
+
+	times = append(times[:1], benchmarkTime.Add(time.Hour), benchmarkTime.Add(time.Hour*3))
+	_, rates, missingData = b.rates(times)
+	if !missingData {
+		t.Error("expected missing data")
+	}
+	if !rates[2].IsZero() {
+		t.Errorf("received '%v' expected '%v'", rates[2], 0)
+	}
+}
+
+func TestCalculateBenchmarkStatistics(t *testing.T) {
+	t.Parallel()
+	_, err := CalculateBenchmarkStatistics("", nil, nil, decimal.Zero, 365)
+	if err == nil {
+		t.Error("expected error for no returns")
+	}
+	benchmarkRates := []decimal.Decimal{
+		decimal.NewFromFloat(0.01),
+		decimal.NewFromFloat(-0.02),
+		decimal.NewFromFloat(0.03),
+		decimal.NewFromFloat(-0.01),
+	}
+	returnsPerCandle := make([]decimal.Decimal, len(benchmarkRates))
+	for i := range benchmarkRates {
+		returnsPerCandle[i] = benchmarkRates[i].Div(decimal.NewFromInt(2))
+	}
+	resp, err := CalculateBenchmarkStatistics("test", benchmarkRates, returnsPerCandle, decimal.Zero, 365)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.Benchmark != "test" {
+		t.Errorf("received '%v' expected '%v'", resp.Benchmark, "test")
+	}
+	if !resp.Beta.Equal(decimal.NewFromFloat(0.5)) {
+		t.Errorf("received '%v' expected '%v'", resp.Beta, 0.5)
+	}
+	if !resp.Alpha.IsZero() {
+		t.Errorf("received '%v' expected '%v'", resp.Alpha, 0)
+	}
+	if !resp.UpCaptureRatio.Equal(decimal.NewFromFloat(0.5)) {
+		t.Errorf("received '%v' expected '%v'", resp.UpCaptureRatio, 0.5)
+	}
+	if !resp.DownCaptureRatio.Equal(decimal.NewFromFloat(0.5)) {
+		t.Errorf("received '%v' expected '%v'", resp.DownCaptureRatio, 0.5)
+	}
+	if !resp.TrackingError.IsPositive() {
+		t.Errorf("received '%v' expected a positive tracking error", resp.TrackingError)
+	}
+}
+
+func TestCalculateRollingStatistics(t *testing.T) {
+	t.Parallel()
+	_, err := CalculateRollingStatistics(nil, []decimal.Decimal{decimal.Zero}, 0, decimal.Zero, 365)
+	if !errors.Is(err, errRollingTimesMismatch) {
+		t.Errorf("received '%v' expected '%v'", err, errRollingTimesMismatch)
+	}
+	times := make([]time.Time, 5)
+	returnsPerCandle := make([]decimal.Decimal, 5)
+	for i := range times {
+		times[i] = benchmarkTime.Add(gctkline.OneDay.Duration() * time.Duration(i))
+		returnsPerCandle[i] = decimal.NewFromFloat(0.01).Mul(decimal.NewFromInt(int64(i%2*2 - 1)))
+	}
+	resp, err := CalculateRollingStatistics(times, returnsPerCandle, 0, decimal.Zero, 365)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(resp), 0)
+	}
+
+	resp, err = CalculateRollingStatistics(times, returnsPerCandle, 2, decimal.Zero, 365)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp) != 4 {
+		t.Fatalf("received '%v' expected '%v'", len(resp), 4)
+	}
+	if !resp[0].Time.Equal(times[1]) {
+		t.Errorf("received '%v' expected '%v'", resp[0].Time, times[1])
+	}
+	if !resp[0].SharpeRatio.IsZero() {
+		t.Errorf("received '%v' expected '%v'", resp[0].SharpeRatio, 0)
+	}
+	if !resp[0].Volatility.IsPositive() {
+		t.Errorf("received '%v' expected a positive volatility", resp[0].Volatility)
+	}
+}
+
+func TestCalculateMonthlyReturns(t *testing.T) {
+	t.Parallel()
+	_, err := CalculateMonthlyReturns(nil, []decimal.Decimal{decimal.Zero}, nil)
+	if !errors.Is(err, errRollingTimesMismatch) {
+		t.Errorf("received '%v' expected '%v'", err, errRollingTimesMismatch)
+	}
+	times := []time.Time{
+		benchmarkTime,
+		benchmarkTime.Add(gctkline.OneDay.Duration()),
+		benchmarkTime.Add(gctkline.OneDay.Duration() * 2),
+	}
+	returnsPerCandle := []decimal.Decimal{
+		decimal.NewFromFloat(0.1),
+		decimal.NewFromFloat(0.1),
+		decimal.NewFromFloat(-0.5),
+	}
+	benchmarkRates := []decimal.Decimal{
+		decimal.NewFromFloat(0.05),
+		decimal.Zero,
+		decimal.NewFromFloat(0.1),
+	}
+	resp, err := CalculateMonthlyReturns(times, returnsPerCandle, benchmarkRates)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(resp), 2)
+	}
+	if resp[0].Month.Month() != time.January || resp[1].Month.Month() != time.February {
+		t.Errorf("received '%v' '%v' expected January February", resp[0].Month.Month(), resp[1].Month.Month())
+	}
+	// 1.1 * 1.1 - 1
+	if !resp[0].StrategyReturn.Equal(decimal.NewFromInt(21)) {
+		t.Errorf("received '%v' expected '%v'", resp[0].StrategyReturn, 21)
+	}
+	if !resp[0].BenchmarkReturn.Equal(decimal.NewFromInt(5)) {
+		t.Errorf("received '%v' expected '%v'", resp[0].BenchmarkReturn, 5)
+	}
+	if !resp[0].ExcessReturn.Equal(decimal.NewFromInt(16)) {
+		t.Errorf("received '%v' expected '%v'", resp[0].ExcessReturn, 16)
+	}
+	if !resp[1].StrategyReturn.Equal(decimal.NewFromInt(-50)) {
+		t.Errorf("received '%v' expected '%v'", resp[1].StrategyReturn, -50)
+	}
+}
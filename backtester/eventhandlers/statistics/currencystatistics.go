@@ -17,8 +17,10 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
-// CalculateResults calculates all statistics for the exchange, asset, currency pair
-func (c *CurrencyPairStatistic) CalculateResults(riskFreeRate decimal.Decimal) error {
+// CalculateResults calculates all statistics for the exchange, asset, currency pair.
+// Returns are compared against the benchmark when set, otherwise against the
+// pair's own market movement
+func (c *CurrencyPairStatistic) CalculateResults(riskFreeRate decimal.Decimal, benchmark *Benchmark, rollingWindow int64) error {
 	var errs gctcommon.Errors
 	var err error
 	first := c.Events[0]
@@ -55,9 +57,12 @@ func (c *CurrencyPairStatistic) CalculateResults(riskFreeRate decimal.Decimal) e
 	returnsPerCandle := make([]decimal.Decimal, len(c.Events))
 	benchmarkRates := make([]decimal.Decimal, len(c.Events))
 
+	times := make([]time.Time, len(c.Events))
+
 	var allDataEvents []common.DataEventHandler
 	for i := range c.Events {
 		returnsPerCandle[i] = c.Events[i].Holdings.ChangeInTotalValuePercent
+		times[i] = c.Events[i].DataEvent.GetTime()
 		allDataEvents = append(allDataEvents, c.Events[i].DataEvent)
 		if i == 0 {
 			continue
@@ -77,10 +82,27 @@ func (c *CurrencyPairStatistic) CalculateResults(riskFreeRate decimal.Decimal) e
 			c.Events[i-1].DataEvent.GetClosePrice())
 	}
 
+	benchmarkName := fmt.Sprintf("%v %v %v buy and hold", first.DataEvent.GetExchange(), first.DataEvent.GetAssetType(), first.DataEvent.Pair())
+	benchmarkMovement := c.MarketMovement
+	if benchmark != nil {
+		var values []decimal.Decimal
+		var missingData bool
+		values, benchmarkRates, missingData = benchmark.rates(times)
+		if missingData {
+			c.ShowMissingDataWarning = true
+		}
+		benchmarkName = benchmark.Name
+		benchmarkMovement = decimal.Zero
+		if !values[0].IsZero() {
+			benchmarkMovement = values[len(values)-1].Sub(values[0]).Div(values[0]).Mul(oneHundred)
+		}
+	}
+
 	// remove the first entry as its zero and impacts
 	// ratio calculations as no movement has been made
 	benchmarkRates = benchmarkRates[1:]
 	returnsPerCandle = returnsPerCandle[1:]
+	times = times[1:]
 	c.MaxDrawdown, err = CalculateBiggestEventDrawdown(allDataEvents)
 	if err != nil {
 		errs = append(errs, err)
@@ -94,6 +116,21 @@ func (c *CurrencyPairStatistic) CalculateResults(riskFreeRate decimal.Decimal) e
 		return err
 	}
 
+	c.BenchmarkStatistics, err = CalculateBenchmarkStatistics(benchmarkName, benchmarkRates, returnsPerCandle, riskFreeRatePerCandle, intervalsPerYear)
+	if err != nil {
+		errs = append(errs, err)
+	} else {
+		c.BenchmarkStatistics.BenchmarkMovement = benchmarkMovement
+	}
+	c.RollingStatistics, err = CalculateRollingStatistics(times, returnsPerCandle, rollingWindow, riskFreeRatePerCandle, intervalsPerYear)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	c.MonthlyReturns, err = CalculateMonthlyReturns(times, returnsPerCandle, benchmarkRates)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
 	if last.Holdings.QuoteInitialFunds.GreaterThan(decimal.Zero) {
 		cagr, err := gctmath.DecimalCompoundAnnualGrowthRate(
 			last.Holdings.QuoteInitialFunds,
@@ -116,6 +153,9 @@ func (c *CurrencyPairStatistic) CalculateResults(riskFreeRate decimal.Decimal) e
 	c.TotalValueLost = last.Holdings.TotalValueLost.Round(2)
 	c.TotalValueLostToSlippage = last.Holdings.TotalValueLostToSlippage.Round(2)
 	c.TotalAssetValue = last.Holdings.BaseValue.Round(8)
+	if last.Holdings.IsLeveraged() {
+		c.calculateFuturesStatistics()
+	}
 	if len(errs) > 0 {
 		return errs
 	}
@@ -175,6 +215,46 @@ func (c *CurrencyPairStatistic) PrintResults(e string, a asset.Item, p currency.
 		log.Infof(log.BackTester, "%s Sortino ratio: %v", sep, c.GeometricRatios.SortinoRatio.Round(4))
 		log.Infof(log.BackTester, "%s Information ratio: %v", sep, c.GeometricRatios.InformationRatio.Round(4))
 		log.Infof(log.BackTester, "%s Calmar ratio: %v\n\n", sep, c.GeometricRatios.CalmarRatio.Round(4))
+
+		if c.BenchmarkStatistics != nil {
+			log.Info(log.BackTester, "------------------Benchmark---------------------------------------------")
+			log.Infof(log.BackTester, "%s Benchmark: %v", sep, c.BenchmarkStatistics.Benchmark)
+			log.Infof(log.BackTester, "%s Benchmark movement: %s%%", sep, convert.DecimalToHumanFriendlyString(c.BenchmarkStatistics.BenchmarkMovement, 2, ".", ","))
+			log.Infof(log.BackTester, "%s Alpha: %v", sep, c.BenchmarkStatistics.Alpha.Round(4))
+			log.Infof(log.BackTester, "%s Beta: %v", sep, c.BenchmarkStatistics.Beta.Round(4))
+			log.Infof(log.BackTester, "%s Tracking error: %v", sep, c.BenchmarkStatistics.TrackingError.Round(4))
+			log.Infof(log.BackTester, "%s Up capture ratio: %v", sep, c.BenchmarkStatistics.UpCaptureRatio.Round(4))
+			log.Infof(log.BackTester, "%s Down capture ratio: %v\n\n", sep, c.BenchmarkStatistics.DownCaptureRatio.Round(4))
+		}
+	}
+
+	if c.FuturesStatistics != nil {
+		log.Info(log.BackTester, "------------------Futures------------------------------------")
+		log.Infof(log.BackTester, "%s Leverage: %s", sep, convert.DecimalToHumanFriendlyString(c.FuturesStatistics.Leverage, 2, ".", ","))
+		log.Infof(log.BackTester, "%s Final position size: %s", sep, convert.DecimalToHumanFriendlyString(c.FuturesStatistics.FinalPositionSize, 8, ".", ","))
+		log.Infof(log.BackTester, "%s Final entry price: %s", sep, convert.DecimalToHumanFriendlyString(c.FuturesStatistics.FinalEntryPrice, 8, ".", ","))
+		log.Infof(log.BackTester, "%s Realised PNL: %s", sep, convert.DecimalToHumanFriendlyString(c.FuturesStatistics.RealisedPNL, 8, ".", ","))
+		log.Infof(log.BackTester, "%s Unrealised PNL: %s", sep, convert.DecimalToHumanFriendlyString(c.FuturesStatistics.UnrealisedPNL, 8, ".", ","))
+		log.Infof(log.BackTester, "%s Total PNL: %s", sep, convert.DecimalToHumanFriendlyString(c.FuturesStatistics.TotalPNL, 8, ".", ","))
+		log.Infof(log.BackTester, "%s Funding: %s", sep, convert.DecimalToHumanFriendlyString(c.FuturesStatistics.Funding, 8, ".", ","))
+		log.Infof(log.BackTester, "%s Highest margin: %s at %v", sep, convert.DecimalToHumanFriendlyString(c.FuturesStatistics.HighestMargin.Value, 8, ".", ","), c.FuturesStatistics.HighestMargin.Time)
+		log.Infof(log.BackTester, "%s Liquidations: %s", sep, convert.IntToHumanFriendlyString(c.FuturesStatistics.Liquidations, ","))
+		log.Infof(log.BackTester, "%s Value lost to liquidations: %s\n\n", sep, convert.DecimalToHumanFriendlyString(c.FuturesStatistics.LiquidationLosses, 8, ".", ","))
+	}
+
+	if c.MonteCarlo != nil {
+		log.Info(log.BackTester, "------------------Monte Carlo--------------------------------")
+		log.Infof(log.BackTester, "%s Trades: %s Iterations: %s Seed: %v", sep, convert.IntToHumanFriendlyString(c.MonteCarlo.Trades, ","), convert.IntToHumanFriendlyString(c.MonteCarlo.Iterations, ","), c.MonteCarlo.Seed)
+		log.Infof(log.BackTester, "%s Actual final equity: %s max drawdown: %s%% Sharpe ratio: %v", sep, convert.DecimalToHumanFriendlyString(c.MonteCarlo.Actual.FinalEquity, 8, ".", ","), convert.DecimalToHumanFriendlyString(c.MonteCarlo.Actual.MaxDrawdown, 2, ".", ","), c.MonteCarlo.Actual.SharpeRatio.Round(4))
+		confidence := c.MonteCarlo.ConfidenceLevel.Mul(decimal.NewFromInt(100))
+		for _, sim := range []*MonteCarloSimulation{c.MonteCarlo.Bootstrap, c.MonteCarlo.Shuffle} {
+			if sim == nil {
+				continue
+			}
+			log.Infof(log.BackTester, "%s %v %v%% final equity: %s to %s", sep, sim.Method, confidence, convert.DecimalToHumanFriendlyString(sim.FinalEquity.Lower, 8, ".", ","), convert.DecimalToHumanFriendlyString(sim.FinalEquity.Upper, 8, ".", ","))
+			log.Infof(log.BackTester, "%s %v %v%% max drawdown: %s%% to %s%%", sep, sim.Method, confidence, convert.DecimalToHumanFriendlyString(sim.MaxDrawdown.Lower, 2, ".", ","), convert.DecimalToHumanFriendlyString(sim.MaxDrawdown.Upper, 2, ".", ","))
+			log.Infof(log.BackTester, "%s %v %v%% Sharpe ratio: %v to %v", sep, sim.Method, confidence, sim.SharpeRatio.Lower.Round(4), sim.SharpeRatio.Upper.Round(4))
+		}
 	}
 
 	log.Info(log.BackTester, "------------------Results------------------------------------")
@@ -308,13 +388,39 @@ func CalculateBiggestEventDrawdown(closePrices []common.DataEventHandler) (Swing
 
 func (c *CurrencyPairStatistic) calculateHighestCommittedFunds() {
 	for i := range c.Events {
-		if c.Events[i].Holdings.BaseSize.Mul(c.Events[i].DataEvent.GetClosePrice()).GreaterThan(c.HighestCommittedFunds.Value) {
-			c.HighestCommittedFunds.Value = c.Events[i].Holdings.BaseSize.Mul(c.Events[i].DataEvent.GetClosePrice())
+		// short positions commit funds too
+		committed := c.Events[i].Holdings.BaseSize.Abs().Mul(c.Events[i].DataEvent.GetClosePrice())
+		if committed.GreaterThan(c.HighestCommittedFunds.Value) {
+			c.HighestCommittedFunds.Value = committed
 			c.HighestCommittedFunds.Time = c.Events[i].Holdings.Timestamp
 		}
 	}
 }
 
+// calculateFuturesStatistics summarises the leveraged position
+// from the final holdings
+func (c *CurrencyPairStatistic) calculateFuturesStatistics() {
+	last := c.Events[len(c.Events)-1].Holdings
+	resp := &FuturesStatistics{
+		Leverage:          last.Leverage,
+		FinalPositionSize: last.BaseSize,
+		FinalEntryPrice:   last.EntryPrice,
+		RealisedPNL:       last.RealisedPNL,
+		UnrealisedPNL:     last.UnrealisedPNL,
+		TotalPNL:          last.RealisedPNL.Add(last.UnrealisedPNL),
+		Funding:           last.Funding,
+		Liquidations:      last.Liquidations,
+		LiquidationLosses: last.LiquidationLosses,
+	}
+	for i := range c.Events {
+		if c.Events[i].Holdings.Margin.GreaterThan(resp.HighestMargin.Value) {
+			resp.HighestMargin.Value = c.Events[i].Holdings.Margin
+			resp.HighestMargin.Time = c.Events[i].Holdings.Timestamp
+		}
+	}
+	c.FuturesStatistics = resp
+}
+
 // CalculateBiggestValueAtTimeDrawdown calculates the biggest drawdown using a slice of ValueAtTimes
 func CalculateBiggestValueAtTimeDrawdown(closePrices []ValueAtTime, interval gctkline.Interval) (Swing, error) {
 	if len(closePrices) == 0 {
@@ -111,13 +111,44 @@ func TestCalculateResults(t *testing.T) {
 	}
 
 	cs.Events = append(cs.Events, ev, ev2)
-	err := cs.CalculateResults(decimal.NewFromFloat(0.03))
+	err := cs.CalculateResults(decimal.NewFromFloat(0.03), nil, 0)
 	if err != nil {
 		t.Error(err)
 	}
 	if !cs.MarketMovement.Equal(decimal.NewFromFloat(-33.15)) {
 		t.Error("expected -33.15")
 	}
+	if cs.BenchmarkStatistics == nil {
+		t.Fatal("expected benchmark statistics")
+	}
+	if !cs.BenchmarkStatistics.BenchmarkMovement.Equal(cs.MarketMovement) {
+		t.Errorf("received '%v' expected '%v'", cs.BenchmarkStatistics.BenchmarkMovement, cs.MarketMovement)
+	}
+	if len(cs.MonthlyReturns) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(cs.MonthlyReturns), 1)
+	}
+
+	benchmark := &Benchmark{
+		Name: "index",
+		Index: []ValueAtTime{
+			{Time: tt1, Value: decimal.NewFromInt(100)},
+			{Time: tt2, Value: decimal.NewFromInt(110)},
+		},
+	}
+	err = benchmark.setup(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = cs.CalculateResults(decimal.NewFromFloat(0.03), benchmark, 0)
+	if err != nil {
+		t.Error(err)
+	}
+	if cs.BenchmarkStatistics.Benchmark != benchmark.Name {
+		t.Errorf("received '%v' expected '%v'", cs.BenchmarkStatistics.Benchmark, benchmark.Name)
+	}
+	if !cs.BenchmarkStatistics.BenchmarkMovement.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("received '%v' expected '%v'", cs.BenchmarkStatistics.BenchmarkMovement, 10)
+	}
 	ev3 := ev2
 	ev3.DataEvent = &kline.Kline{
 		Base:   even2,
@@ -136,7 +167,7 @@ func TestCalculateResults(t *testing.T) {
 		High:   decimal.Zero,
 		Volume: decimal.Zero,
 	}
-	err = cs.CalculateResults(decimal.NewFromFloat(0.03))
+	err = cs.CalculateResults(decimal.NewFromFloat(0.03), nil, 0)
 	if err != nil {
 		t.Error(err)
 	}
@@ -149,7 +180,7 @@ func TestCalculateResults(t *testing.T) {
 		High:   decimal.Zero,
 		Volume: decimal.Zero,
 	}
-	err = cs.CalculateResults(decimal.NewFromFloat(0.03))
+	err = cs.CalculateResults(decimal.NewFromFloat(0.03), nil, 0)
 	if err != nil {
 		t.Error(err)
 	}
@@ -270,3 +301,53 @@ func TestCalculateHighestCommittedFunds(t *testing.T) {
 		t.Errorf("expected %v, received %v", tt2, c.HighestCommittedFunds.Time)
 	}
 }
+
+func TestCalculateFuturesStatistics(t *testing.T) {
+	t.Parallel()
+	tt1 := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	tt2 := time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)
+	c := CurrencyPairStatistic{
+		Events: []EventStore{
+			{
+				DataEvent: &kline.Kline{Close: decimal.NewFromInt(100)},
+				Holdings: holdings.Holding{
+					Timestamp: tt1,
+					Leverage:  decimal.NewFromInt(10),
+					BaseSize:  decimal.NewFromInt(-2),
+					Margin:    decimal.NewFromInt(20),
+				},
+			},
+			{
+				DataEvent: &kline.Kline{Close: decimal.NewFromInt(90)},
+				Holdings: holdings.Holding{
+					Timestamp:     tt2,
+					Leverage:      decimal.NewFromInt(10),
+					BaseSize:      decimal.NewFromInt(-1),
+					EntryPrice:    decimal.NewFromInt(100),
+					Margin:        decimal.NewFromInt(10),
+					RealisedPNL:   decimal.NewFromInt(10),
+					UnrealisedPNL: decimal.NewFromInt(10),
+					Funding:       decimal.NewFromInt(1),
+					Liquidations:  1,
+				},
+			},
+		},
+	}
+	c.calculateFuturesStatistics()
+	if c.FuturesStatistics == nil {
+		t.Fatal("expected futures statistics")
+	}
+	if !c.FuturesStatistics.TotalPNL.Equal(decimal.NewFromInt(20)) {
+		t.Errorf("expected %v, received %v", 20, c.FuturesStatistics.TotalPNL)
+	}
+	if !c.FuturesStatistics.HighestMargin.Time.Equal(tt1) {
+		t.Errorf("expected %v, received %v", tt1, c.FuturesStatistics.HighestMargin.Time)
+	}
+	if c.FuturesStatistics.Liquidations != 1 {
+		t.Errorf("expected %v, received %v", 1, c.FuturesStatistics.Liquidations)
+	}
+	c.calculateHighestCommittedFunds()
+	if !c.HighestCommittedFunds.Value.Equal(decimal.NewFromInt(200)) {
+		t.Errorf("expected short positions to commit funds, received %v", c.HighestCommittedFunds.Value)
+	}
+}
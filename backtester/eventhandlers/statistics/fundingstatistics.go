@@ -256,6 +256,22 @@ func (f *FundingStatistics) PrintResults(wasAnyDataMissing bool) error {
 		}
 		log.Info(log.BackTester, "")
 	}
+	if len(f.Report.Positions) > 0 {
+		log.Info(log.BackTester, "------------------Leveraged Positions------------------------")
+		for i := range f.Report.Positions {
+			sep := fmt.Sprintf("%v %v %v |\t", f.Report.Positions[i].Exchange, f.Report.Positions[i].Asset, f.Report.Positions[i].Pair)
+			log.Infof(log.BackTester, "%s Collateral: %v", sep, f.Report.Positions[i].Collateral)
+			log.Infof(log.BackTester, "%s Leverage: %s", sep, convert.DecimalToHumanFriendlyString(f.Report.Positions[i].Leverage, 2, ".", ","))
+			log.Infof(log.BackTester, "%s Final size: %s", sep, convert.DecimalToHumanFriendlyString(f.Report.Positions[i].Final.Size, 8, ".", ","))
+			log.Infof(log.BackTester, "%s Final margin: %s", sep, convert.DecimalToHumanFriendlyString(f.Report.Positions[i].Final.Margin, 8, ".", ","))
+			log.Infof(log.BackTester, "%s Realised PNL: %s", sep, convert.DecimalToHumanFriendlyString(f.Report.Positions[i].Final.RealisedPNL, 8, ".", ","))
+			log.Infof(log.BackTester, "%s Unrealised PNL: %s", sep, convert.DecimalToHumanFriendlyString(f.Report.Positions[i].Final.UnrealisedPNL, 8, ".", ","))
+			log.Infof(log.BackTester, "%s Fees: %s", sep, convert.DecimalToHumanFriendlyString(f.Report.Positions[i].Final.Fees, 8, ".", ","))
+			log.Infof(log.BackTester, "%s Funding: %s", sep, convert.DecimalToHumanFriendlyString(f.Report.Positions[i].Final.Funding, 8, ".", ","))
+			log.Infof(log.BackTester, "%s Liquidations: %s", sep, convert.IntToHumanFriendlyString(f.Report.Positions[i].Final.Liquidations, ","))
+			log.Info(log.BackTester, "")
+		}
+	}
 	if f.Report.DisableUSDTracking {
 		return nil
 	}
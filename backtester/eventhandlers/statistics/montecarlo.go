@@ -0,0 +1,297 @@
+package statistics
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+	gctmath "github.com/thrasher-corp/gocryptotrader/common/math"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// Monte Carlo simulation methods
+const (
+	// BootstrapMethod resamples the trades with replacement
+	BootstrapMethod = "bootstrap"
+	// ShuffleMethod reorders the trades
+	ShuffleMethod = "shuffle"
+)
+
+const (
+	defaultMonteCarloIterations = 1000
+	monteCarloHistogramBins     = 20
+)
+
+var defaultConfidenceLevel = decimal.NewFromFloat(0.95)
+
+// monteCarloTrade is a trade as recorded by compliance, along with the price
+// it would have had without slippage
+type monteCarloTrade struct {
+	isBuy        bool
+	price        decimal.Decimal
+	slippedPrice decimal.Decimal
+	amount       decimal.Decimal
+	fee          decimal.Decimal
+}
+
+// setDefaults applies the default iterations and confidence level when unset
+// and picks a time based seed so that every pair is simulated with the same one
+func (m *MonteCarloSettings) setDefaults() {
+	if m.Iterations <= 0 {
+		m.Iterations = defaultMonteCarloIterations
+	}
+	if !m.ConfidenceLevel.IsPositive() {
+		m.ConfidenceLevel = defaultConfidenceLevel
+	}
+	if m.Seed == 0 {
+		m.Seed = time.Now().UnixNano()
+	}
+}
+
+// slippageRange returns the configured slippage for the pair
+func (m *MonteCarloSettings) slippageRange(exch string, a asset.Item, p currency.Pair) (minimum, maximum decimal.Decimal) {
+	for i := range m.Slippage {
+		if m.Slippage[i].Exchange == exch &&
+			m.Slippage[i].Asset == a &&
+			m.Slippage[i].Pair.Equal(p) {
+			return m.Slippage[i].MinimumSlippageRate, m.Slippage[i].MaximumSlippageRate
+		}
+	}
+	return decimal.Zero, decimal.Zero
+}
+
+// CalculateMonteCarlo resamples and shuffles the pair's trades, randomising
+// the slippage of each within the pair's configured range. Each path starts
+// at the pair's initial total value and adds each trade's profit, valued at
+// the final close price, so the results show how much of the outcome depended
+// on the order and selection of trades
+func (m *MonteCarloSettings) CalculateMonteCarlo(exch string, a asset.Item, p currency.Pair, c *CurrencyPairStatistic) (*MonteCarloStatistics, error) {
+	if c == nil || len(c.Events) == 0 {
+		return nil, fmt.Errorf("%v %v %v %w", exch, a, p, errReceivedNoData)
+	}
+	initialEquity := c.Events[0].Holdings.TotalValue
+	if !initialEquity.IsPositive() {
+		return nil, fmt.Errorf("%v %v %v %w", exch, a, p, errNoInitialEquity)
+	}
+	trades := monteCarloTrades(c.Events)
+	if len(trades) == 0 {
+		return nil, fmt.Errorf("%v %v %v %w", exch, a, p, errNoTrades)
+	}
+	m.setDefaults()
+	finalPrice := c.Events[len(c.Events)-1].DataEvent.GetClosePrice()
+	minimumSlippage, maximumSlippage := m.slippageRange(exch, a, p)
+
+	profits := make([]decimal.Decimal, len(trades))
+	for i := range trades {
+		profits[i] = trades[i].profit(trades[i].slippedPrice, finalPrice)
+	}
+	resp := &MonteCarloStatistics{
+		Iterations:      m.Iterations,
+		ConfidenceLevel: m.ConfidenceLevel,
+		Seed:            m.Seed,
+		Trades:          int64(len(trades)),
+		InitialEquity:   initialEquity,
+		Actual:          simulatePath(initialEquity, profits),
+	}
+
+	r := rand.New(rand.NewSource(m.Seed)) // nolint:gosec // reproducible sampling is required, not security
+	bootstrap := make([]MonteCarloIteration, m.Iterations)
+	shuffle := make([]MonteCarloIteration, m.Iterations)
+	for i := int64(0); i < m.Iterations; i++ {
+		for j := range profits {
+			t := trades[r.Intn(len(trades))]
+			profits[j] = t.profit(t.randomSlippage(r, minimumSlippage, maximumSlippage), finalPrice)
+		}
+		bootstrap[i] = simulatePath(initialEquity, profits)
+
+		order := r.Perm(len(trades))
+		for j := range order {
+			t := trades[order[j]]
+			profits[j] = t.profit(t.randomSlippage(r, minimumSlippage, maximumSlippage), finalPrice)
+		}
+		shuffle[i] = simulatePath(initialEquity, profits)
+	}
+	resp.Bootstrap = summariseSimulation(BootstrapMethod, bootstrap, m.ConfidenceLevel)
+	resp.Shuffle = summariseSimulation(ShuffleMethod, shuffle, m.ConfidenceLevel)
+	return resp, nil
+}
+
+// monteCarloTrades returns each order once, in the order they were first
+// recorded in the compliance snapshots
+func monteCarloTrades(events []EventStore) []monteCarloTrade {
+	var resp []monteCarloTrade
+	seen := make(map[string]bool)
+	for i := range events {
+		orders := events[i].Transactions.Orders
+		for j := range orders {
+			o := orders[j]
+			if o.Detail == nil || seen[o.ID] {
+				continue
+			}
+			seen[o.ID] = true
+			t := monteCarloTrade{
+				slippedPrice: decimal.NewFromFloat(o.Price),
+				amount:       decimal.NewFromFloat(o.Amount),
+				fee:          decimal.NewFromFloat(o.Fee),
+				price:        o.VolumeAdjustedPrice,
+			}
+			switch o.Side {
+			case gctorder.Buy, gctorder.Bid, gctorder.Long:
+				t.isBuy = true
+			case gctorder.Sell, gctorder.Ask, gctorder.Short:
+			default:
+				continue
+			}
+			if !t.price.IsPositive() {
+				t.price = t.slippedPrice
+			}
+			resp = append(resp, t)
+		}
+	}
+	return resp
+}
+
+// profit values the trade at the final price, less its fee
+func (t *monteCarloTrade) profit(price, finalPrice decimal.Decimal) decimal.Decimal {
+	if t.isBuy {
+		return t.amount.Mul(finalPrice.Sub(price)).Sub(t.fee)
+	}
+	return t.amount.Mul(price.Sub(finalPrice)).Sub(t.fee)
+}
+
+// randomSlippage applies a slippage rate drawn from the range to the trade's
+// price the same way the exchange does. Ranges the exchange would not apply
+// leave the price unchanged
+func (t *monteCarloTrade) randomSlippage(r *rand.Rand, minimum, maximum decimal.Decimal) decimal.Decimal {
+	one := decimal.NewFromInt(1)
+	hundred := decimal.NewFromInt(100)
+	if minimum.LessThan(one) || maximum.GreaterThan(hundred) || !maximum.GreaterThan(minimum) {
+		return t.price
+	}
+	rate := minimum.Add(maximum.Sub(minimum).Mul(decimal.NewFromFloat(r.Float64()))).Div(hundred)
+	if t.isBuy {
+		return t.price.Add(t.price.Mul(one.Sub(rate)))
+	}
+	return t.price.Mul(rate)
+}
+
+// simulatePath adds each profit to the initial equity in turn, returning the
+// final equity, the largest percentage fall from a peak and the Sharpe ratio
+// of the return of each trade
+func simulatePath(initialEquity decimal.Decimal, profits []decimal.Decimal) MonteCarloIteration {
+	equity := initialEquity
+	peak := initialEquity
+	var maxDrawdown decimal.Decimal
+	returns := make([]decimal.Decimal, len(profits))
+	for i := range profits {
+		if equity.IsPositive() {
+			returns[i] = profits[i].Div(equity)
+		}
+		equity = equity.Add(profits[i])
+		if equity.GreaterThan(peak) {
+			peak = equity
+		}
+		drawdown := peak.Sub(equity).Div(peak).Mul(decimal.NewFromInt(100))
+		if drawdown.GreaterThan(maxDrawdown) {
+			maxDrawdown = drawdown
+		}
+	}
+	resp := MonteCarloIteration{
+		FinalEquity: equity,
+		MaxDrawdown: maxDrawdown,
+	}
+	mean, err := gctmath.DecimalArithmeticMean(returns)
+	if err != nil {
+		return resp
+	}
+	resp.SharpeRatio, err = gctmath.DecimalSharpeRatio(returns, decimal.Zero, mean)
+	if err != nil {
+		resp.SharpeRatio = decimal.Zero
+	}
+	return resp
+}
+
+// summariseSimulation creates the distributions of each iteration's results
+func summariseSimulation(method string, iterations []MonteCarloIteration, confidenceLevel decimal.Decimal) *MonteCarloSimulation {
+	finalEquity := make([]decimal.Decimal, len(iterations))
+	maxDrawdown := make([]decimal.Decimal, len(iterations))
+	sharpeRatio := make([]decimal.Decimal, len(iterations))
+	for i := range iterations {
+		finalEquity[i] = iterations[i].FinalEquity
+		maxDrawdown[i] = iterations[i].MaxDrawdown
+		sharpeRatio[i] = iterations[i].SharpeRatio
+	}
+	return &MonteCarloSimulation{
+		Method:      method,
+		FinalEquity: calculateDistribution(finalEquity, confidenceLevel),
+		MaxDrawdown: calculateDistribution(maxDrawdown, confidenceLevel),
+		SharpeRatio: calculateDistribution(sharpeRatio, confidenceLevel),
+	}
+}
+
+// calculateDistribution returns the mean, median, confidence interval and
+// histogram of the values
+func calculateDistribution(values []decimal.Decimal, confidenceLevel decimal.Decimal) Distribution {
+	if len(values) == 0 {
+		return Distribution{}
+	}
+	sorted := make([]decimal.Decimal, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LessThan(sorted[j])
+	})
+	tail := decimal.NewFromInt(1).Sub(confidenceLevel).Div(decimal.NewFromInt(2))
+	resp := Distribution{
+		Median:    percentile(sorted, decimal.NewFromFloat(0.5)),
+		Lower:     percentile(sorted, tail),
+		Upper:     percentile(sorted, decimal.NewFromInt(1).Sub(tail)),
+		Histogram: histogram(sorted, monteCarloHistogramBins),
+	}
+	resp.Mean, _ = gctmath.DecimalArithmeticMean(sorted)
+	return resp
+}
+
+// percentile returns the nearest ranked value of sorted values
+func percentile(sorted []decimal.Decimal, p decimal.Decimal) decimal.Decimal {
+	i := p.Mul(decimal.NewFromInt(int64(len(sorted) - 1))).Round(0).IntPart()
+	if i < 0 {
+		i = 0
+	}
+	if i >= int64(len(sorted)) {
+		i = int64(len(sorted) - 1)
+	}
+	return sorted[i]
+}
+
+// histogram counts sorted values into bins of equal width between the lowest
+// and highest value
+func histogram(sorted []decimal.Decimal, bins int) []HistogramBin {
+	lowest := sorted[0]
+	highest := sorted[len(sorted)-1]
+	if !highest.GreaterThan(lowest) {
+		return []HistogramBin{{
+			Lower: lowest,
+			Upper: highest,
+			Count: int64(len(sorted)),
+		}}
+	}
+	width := highest.Sub(lowest).Div(decimal.NewFromInt(int64(bins)))
+	resp := make([]HistogramBin, bins)
+	for i := range resp {
+		resp[i].Lower = lowest.Add(width.Mul(decimal.NewFromInt(int64(i))))
+		resp[i].Upper = resp[i].Lower.Add(width)
+	}
+	resp[bins-1].Upper = highest
+	for i := range sorted {
+		bin := int(sorted[i].Sub(lowest).Div(width).IntPart())
+		if bin >= bins {
+			bin = bins - 1
+		}
+		resp[bin].Count++
+	}
+	return resp
+}
@@ -0,0 +1,234 @@
+package statistics
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/compliance"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// monteCarloStatistics returns statistics starting at 100 which buy 1 at 10
+// and sell 1 at 12, closing at 15. The buy makes 5 and the sell loses 3
+func monteCarloStatistics() *CurrencyPairStatistic {
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	stats := benchmarkStatistics(testExchange, asset.Spot, p, 10, 12, 15)
+	buy := compliance.SnapshotOrder{
+		VolumeAdjustedPrice: decimal.NewFromInt(10),
+		Detail:              &gctorder.Detail{ID: "1", Side: gctorder.Buy, Price: 10, Amount: 1},
+	}
+	sell := compliance.SnapshotOrder{
+		VolumeAdjustedPrice: decimal.NewFromInt(12),
+		Detail:              &gctorder.Detail{ID: "2", Side: gctorder.Sell, Price: 12, Amount: 1},
+	}
+	stats.Events[0].Holdings = holdings.Holding{TotalValue: decimal.NewFromInt(100)}
+	stats.Events[0].Transactions = compliance.Snapshot{Orders: []compliance.SnapshotOrder{buy}}
+	stats.Events[1].Transactions = compliance.Snapshot{Orders: []compliance.SnapshotOrder{buy, sell}}
+	stats.Events[2].Transactions = compliance.Snapshot{Orders: []compliance.SnapshotOrder{buy, sell}}
+	return stats
+}
+
+func TestCalculateMonteCarlo(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	m := &MonteCarloSettings{Seed: 1337}
+	_, err := m.CalculateMonteCarlo(testExchange, asset.Spot, p, nil)
+	if !errors.Is(err, errReceivedNoData) {
+		t.Errorf("received '%v' expected '%v'", err, errReceivedNoData)
+	}
+	_, err = m.CalculateMonteCarlo(testExchange, asset.Spot, p, benchmarkStatistics(testExchange, asset.Spot, p, 10))
+	if !errors.Is(err, errNoInitialEquity) {
+		t.Errorf("received '%v' expected '%v'", err, errNoInitialEquity)
+	}
+	stats := benchmarkStatistics(testExchange, asset.Spot, p, 10)
+	stats.Events[0].Holdings.TotalValue = decimal.NewFromInt(100)
+	_, err = m.CalculateMonteCarlo(testExchange, asset.Spot, p, stats)
+	if !errors.Is(err, errNoTrades) {
+		t.Errorf("received '%v' expected '%v'", err, errNoTrades)
+	}
+
+	resp, err := m.CalculateMonteCarlo(testExchange, asset.Spot, p, monteCarloStatistics())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.Iterations != defaultMonteCarloIterations || !resp.ConfidenceLevel.Equal(defaultConfidenceLevel) || resp.Seed != 1337 {
+		t.Errorf("received '%v' '%v' '%v' expected defaults", resp.Iterations, resp.ConfidenceLevel, resp.Seed)
+	}
+	if resp.Trades != 2 {
+		t.Errorf("received '%v' expected '%v'", resp.Trades, 2)
+	}
+	if !resp.Actual.FinalEquity.Equal(decimal.NewFromInt(102)) {
+		t.Errorf("received '%v' expected '%v'", resp.Actual.FinalEquity, 102)
+	}
+	expectedDrawdown := decimal.NewFromInt(3).Div(decimal.NewFromInt(105)).Mul(decimal.NewFromInt(100))
+	if !resp.Actual.MaxDrawdown.Equal(expectedDrawdown) {
+		t.Errorf("received '%v' expected '%v'", resp.Actual.MaxDrawdown, expectedDrawdown)
+	}
+	// without slippage, shuffling only changes the path to the same result
+	if !resp.Shuffle.FinalEquity.Lower.Equal(decimal.NewFromInt(102)) || !resp.Shuffle.FinalEquity.Upper.Equal(decimal.NewFromInt(102)) {
+		t.Errorf("received '%v' to '%v' expected '%v'", resp.Shuffle.FinalEquity.Lower, resp.Shuffle.FinalEquity.Upper, 102)
+	}
+	if !resp.Shuffle.MaxDrawdown.Lower.Equal(expectedDrawdown) || !resp.Shuffle.MaxDrawdown.Upper.Equal(decimal.NewFromInt(3)) {
+		t.Errorf("received '%v' to '%v' expected '%v' to '%v'", resp.Shuffle.MaxDrawdown.Lower, resp.Shuffle.MaxDrawdown.Upper, expectedDrawdown, 3)
+	}
+	if !resp.Bootstrap.FinalEquity.Lower.Equal(decimal.NewFromInt(94)) || !resp.Bootstrap.FinalEquity.Upper.Equal(decimal.NewFromInt(110)) {
+		t.Errorf("received '%v' to '%v' expected '%v' to '%v'", resp.Bootstrap.FinalEquity.Lower, resp.Bootstrap.FinalEquity.Upper, 94, 110)
+	}
+	if resp.Bootstrap.Method != BootstrapMethod || resp.Shuffle.Method != ShuffleMethod {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", resp.Bootstrap.Method, resp.Shuffle.Method, BootstrapMethod, ShuffleMethod)
+	}
+
+	again, err := m.CalculateMonteCarlo(testExchange, asset.Spot, p, monteCarloStatistics())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !again.Bootstrap.FinalEquity.Mean.Equal(resp.Bootstrap.FinalEquity.Mean) {
+		t.Errorf("received '%v' expected '%v'", again.Bootstrap.FinalEquity.Mean, resp.Bootstrap.FinalEquity.Mean)
+	}
+
+	m = &MonteCarloSettings{
+		Iterations: 100,
+		Seed:       1337,
+		Slippage: []SlippageRange{
+			{
+				Exchange:            testExchange,
+				Asset:               asset.Spot,
+				Pair:                p,
+				MinimumSlippageRate: decimal.NewFromInt(90),
+				MaximumSlippageRate: decimal.NewFromInt(100),
+			},
+		},
+	}
+	resp, err = m.CalculateMonteCarlo(testExchange, asset.Spot, p, monteCarloStatistics())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !resp.Shuffle.FinalEquity.Upper.GreaterThan(resp.Shuffle.FinalEquity.Lower) {
+		t.Errorf("expected randomised slippage to vary final equity, received '%v' to '%v'", resp.Shuffle.FinalEquity.Lower, resp.Shuffle.FinalEquity.Upper)
+	}
+	if resp.Shuffle.FinalEquity.Upper.GreaterThan(decimal.NewFromInt(102)) {
+		t.Errorf("received '%v' expected at most '%v'", resp.Shuffle.FinalEquity.Upper, 102)
+	}
+}
+
+func TestMonteCarloTrades(t *testing.T) {
+	t.Parallel()
+	stats := monteCarloStatistics()
+	stats.Events[2].Transactions.Orders = append(stats.Events[2].Transactions.Orders,
+		compliance.SnapshotOrder{Detail: &gctorder.Detail{ID: "3", Side: gctorder.UnknownSide}},
+		compliance.SnapshotOrder{Detail: &gctorder.Detail{ID: "4", Side: gctorder.Short, Price: 14, Amount: 2}},
+		compliance.SnapshotOrder{})
+	trades := monteCarloTrades(stats.Events)
+	if len(trades) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(trades), 3)
+	}
+	if !trades[0].isBuy || trades[1].isBuy || trades[2].isBuy {
+		t.Error("expected only the first trade to be a buy")
+	}
+	// without a volume adjusted price, the traded price is used
+	if !trades[2].price.Equal(decimal.NewFromInt(14)) {
+		t.Errorf("received '%v' expected '%v'", trades[2].price, 14)
+	}
+	if !trades[2].profit(trades[2].price, decimal.NewFromInt(15)).Equal(decimal.NewFromInt(-2)) {
+		t.Errorf("received '%v' expected '%v'", trades[2].profit(trades[2].price, decimal.NewFromInt(15)), -2)
+	}
+}
+
+func TestRandomSlippage(t *testing.T) {
+	t.Parallel()
+	r := rand.New(rand.NewSource(1337)) // nolint:gosec // reproducible sampling is required, not security
+	buy := monteCarloTrade{isBuy: true, price: decimal.NewFromInt(100)}
+	sell := monteCarloTrade{price: decimal.NewFromInt(100)}
+	minimum := decimal.NewFromInt(90)
+	maximum := decimal.NewFromInt(100)
+	for i := 0; i < 10; i++ {
+		price := buy.randomSlippage(r, minimum, maximum)
+		if price.LessThan(decimal.NewFromInt(100)) || price.GreaterThan(decimal.NewFromInt(110)) {
+			t.Errorf("received '%v' expected between '%v' and '%v'", price, 100, 110)
+		}
+		price = sell.randomSlippage(r, minimum, maximum)
+		if price.LessThan(decimal.NewFromInt(90)) || price.GreaterThan(decimal.NewFromInt(100)) {
+			t.Errorf("received '%v' expected between '%v' and '%v'", price, 90, 100)
+		}
+	}
+	price := buy.randomSlippage(r, decimal.Zero, decimal.Zero)
+	if !price.Equal(buy.price) {
+		t.Errorf("received '%v' expected '%v'", price, buy.price)
+	}
+	price = sell.randomSlippage(r, maximum, minimum)
+	if !price.Equal(sell.price) {
+		t.Errorf("received '%v' expected '%v'", price, sell.price)
+	}
+}
+
+func TestSimulatePath(t *testing.T) {
+	t.Parallel()
+	resp := simulatePath(decimal.NewFromInt(100), []decimal.Decimal{
+		decimal.NewFromInt(100),
+		decimal.NewFromInt(-50),
+		decimal.NewFromInt(25),
+	})
+	if !resp.FinalEquity.Equal(decimal.NewFromInt(175)) {
+		t.Errorf("received '%v' expected '%v'", resp.FinalEquity, 175)
+	}
+	if !resp.MaxDrawdown.Equal(decimal.NewFromInt(25)) {
+		t.Errorf("received '%v' expected '%v'", resp.MaxDrawdown, 25)
+	}
+	if !resp.SharpeRatio.IsPositive() {
+		t.Errorf("received '%v' expected a positive Sharpe ratio", resp.SharpeRatio)
+	}
+
+	resp = simulatePath(decimal.NewFromInt(100), nil)
+	if !resp.FinalEquity.Equal(decimal.NewFromInt(100)) || !resp.MaxDrawdown.IsZero() || !resp.SharpeRatio.IsZero() {
+		t.Errorf("received '%v' expected an unchanged path", resp)
+	}
+}
+
+func TestCalculateDistribution(t *testing.T) {
+	t.Parallel()
+	resp := calculateDistribution(nil, defaultConfidenceLevel)
+	if resp.Histogram != nil {
+		t.Errorf("received '%v' expected '%v'", resp.Histogram, nil)
+	}
+
+	values := make([]decimal.Decimal, 10)
+	for i := range values {
+		values[len(values)-1-i] = decimal.NewFromInt(int64(i + 1))
+	}
+	resp = calculateDistribution(values, decimal.NewFromFloat(0.8))
+	if !resp.Mean.Equal(decimal.NewFromFloat(5.5)) {
+		t.Errorf("received '%v' expected '%v'", resp.Mean, 5.5)
+	}
+	if !resp.Median.Equal(decimal.NewFromInt(6)) {
+		t.Errorf("received '%v' expected '%v'", resp.Median, 6)
+	}
+	if !resp.Lower.Equal(decimal.NewFromInt(2)) || !resp.Upper.Equal(decimal.NewFromInt(9)) {
+		t.Errorf("received '%v' to '%v' expected '%v' to '%v'", resp.Lower, resp.Upper, 2, 9)
+	}
+	if len(resp.Histogram) != monteCarloHistogramBins {
+		t.Fatalf("received '%v' expected '%v'", len(resp.Histogram), monteCarloHistogramBins)
+	}
+	var count int64
+	for i := range resp.Histogram {
+		count += resp.Histogram[i].Count
+	}
+	if count != 10 {
+		t.Errorf("received '%v' expected '%v'", count, 10)
+	}
+	if resp.Histogram[monteCarloHistogramBins-1].Count != 1 || !resp.Histogram[monteCarloHistogramBins-1].Upper.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("received '%v' expected the highest value in the last bin", resp.Histogram[monteCarloHistogramBins-1])
+	}
+	if !values[0].Equal(decimal.NewFromInt(10)) {
+		t.Error("expected values to be unsorted")
+	}
+
+	resp = calculateDistribution([]decimal.Decimal{decimal.NewFromInt(1), decimal.NewFromInt(1)}, defaultConfidenceLevel)
+	if len(resp.Histogram) != 1 || resp.Histogram[0].Count != 2 {
+		t.Errorf("received '%v' expected a single bin", resp.Histogram)
+	}
+}
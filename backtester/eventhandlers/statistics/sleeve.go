@@ -0,0 +1,295 @@
+package statistics
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	"github.com/thrasher-corp/gocryptotrader/common/convert"
+	gctmath "github.com/thrasher-corp/gocryptotrader/common/math"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// calculateSleeveResults calculates the results of each sleeve, then combines
+// the currency pair statistics of all sleeves so the combined results can be
+// calculated as if they were one strategy
+func (s *Statistic) calculateSleeveResults() error {
+	var transfers []funding.SleeveTransfer
+	if s.FundManager != nil {
+		transfers = s.FundManager.GenerateReport().SleeveTransfers
+	}
+	var total decimal.Decimal
+	for i := range s.Sleeves {
+		if s.Sleeves[i].Statistic == nil {
+			return fmt.Errorf("%v %w", s.Sleeves[i].Name, errSleeveStatisticsUnset)
+		}
+		log.Infof(log.BackTester, "------------------Sleeve %v-------------------------------", s.Sleeves[i].Name)
+		err := s.Sleeves[i].Statistic.CalculateAllResults()
+		if err != nil {
+			return fmt.Errorf("sleeve %v %w", s.Sleeves[i].Name, err)
+		}
+		err = s.Sleeves[i].calculate(transfers)
+		if err != nil {
+			return fmt.Errorf("sleeve %v %w", s.Sleeves[i].Name, err)
+		}
+		total = total.Add(s.Sleeves[i].FinalValue)
+	}
+	if !total.IsZero() {
+		for i := range s.Sleeves {
+			s.Sleeves[i].ShareOfFinalValue = s.Sleeves[i].FinalValue.Div(total).Mul(decimal.NewFromInt(100))
+		}
+	}
+	s.ExchangeAssetPairStatistics = combineSleeveStatistics(s.Sleeves)
+	s.PrintSleeveResults()
+	return nil
+}
+
+// calculate determines the sleeve's value over time along with its returns,
+// excluding any funds moved between sleeves when rebalancing
+func (s *SleeveStatistics) calculate(transfers []funding.SleeveTransfer) error {
+	s.ValuesOverTime = sleeveValues(s.Statistic)
+	if len(s.ValuesOverTime) == 0 {
+		return errReceivedNoData
+	}
+	flows := make(map[int64]decimal.Decimal)
+	s.NetTransfers = decimal.Zero
+	for i := range transfers {
+		t := transfers[i].Time.UnixNano()
+		switch s.Name {
+		case transfers[i].From:
+			flows[t] = flows[t].Sub(transfers[i].USDValue)
+			s.NetTransfers = s.NetTransfers.Sub(transfers[i].USDValue)
+		case transfers[i].To:
+			flows[t] = flows[t].Add(transfers[i].USDValue)
+			s.NetTransfers = s.NetTransfers.Add(transfers[i].USDValue)
+		}
+	}
+	s.InitialValue = s.ValuesOverTime[0].Value
+	s.FinalValue = s.ValuesOverTime[len(s.ValuesOverTime)-1].Value
+	s.ProfitLoss = s.FinalValue.Sub(s.InitialValue).Sub(s.NetTransfers)
+
+	one := decimal.NewFromInt(1)
+	oneHundred := decimal.NewFromInt(100)
+	growth, peak := one, one
+	s.MaxDrawdown = decimal.Zero
+	returns := make([]decimal.Decimal, 0, len(s.ValuesOverTime)-1)
+	for i := 1; i < len(s.ValuesOverTime); i++ {
+		previous := s.ValuesOverTime[i-1].Value
+		if previous.IsZero() {
+			returns = append(returns, decimal.Zero)
+			continue
+		}
+		current := s.ValuesOverTime[i].Value.Sub(flows[s.ValuesOverTime[i].Time.UnixNano()])
+		r := current.Sub(previous).Div(previous)
+		returns = append(returns, r)
+		growth = growth.Mul(one.Add(r))
+		if growth.GreaterThan(peak) {
+			peak = growth
+		}
+		drawdown := peak.Sub(growth).Div(peak).Mul(oneHundred)
+		if drawdown.GreaterThan(s.MaxDrawdown) {
+			s.MaxDrawdown = drawdown
+		}
+	}
+	s.TimeWeightedReturn = growth.Sub(one).Mul(oneHundred)
+	if len(returns) == 0 {
+		return nil
+	}
+	var riskFreeRatePerCandle decimal.Decimal
+	if s.Statistic.CandleInterval > 0 {
+		riskFreeRatePerCandle = s.Statistic.RiskFreeRate.Div(decimal.NewFromFloat(s.Statistic.CandleInterval.IntervalsPerYear()))
+	}
+	average, err := gctmath.DecimalArithmeticMean(returns)
+	if err != nil {
+		return err
+	}
+	s.SharpeRatio, err = gctmath.DecimalSharpeRatio(returns, riskFreeRatePerCandle, average)
+	return err
+}
+
+// sleeveValues returns the USD value of the sleeve's funding over time, or the
+// total value of its holdings over time when USD tracking is disabled
+func sleeveValues(s *Statistic) []ValueAtTime {
+	if s.FundingStatistics != nil && s.FundingStatistics.TotalUSDStatistics != nil {
+		return s.FundingStatistics.TotalUSDStatistics.HoldingValues
+	}
+	totals := make(map[int64]*ValueAtTime)
+	for _, exchMap := range s.ExchangeAssetPairStatistics {
+		for _, assetMap := range exchMap {
+			for _, stats := range assetMap {
+				for i := range stats.Events {
+					if stats.Events[i].DataEvent == nil {
+						continue
+					}
+					t := stats.Events[i].DataEvent.GetTime()
+					lookup, ok := totals[t.UnixNano()]
+					if !ok {
+						lookup = &ValueAtTime{Time: t}
+						totals[t.UnixNano()] = lookup
+					}
+					lookup.Value = lookup.Value.Add(stats.Events[i].Holdings.TotalValue)
+				}
+			}
+		}
+	}
+	resp := make([]ValueAtTime, 0, len(totals))
+	for _, v := range totals {
+		resp = append(resp, *v)
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].Time.Before(resp[j].Time)
+	})
+	return resp
+}
+
+// combineSleeveStatistics merges the events of each currency pair across all
+// sleeves, summing their holdings and orders
+func combineSleeveStatistics(sleeves []*SleeveStatistics) map[string]map[asset.Item]map[currency.Pair]*CurrencyPairStatistic {
+	resp := make(map[string]map[asset.Item]map[currency.Pair]*CurrencyPairStatistic)
+	for i := range sleeves {
+		for exch, exchMap := range sleeves[i].Statistic.ExchangeAssetPairStatistics {
+			if resp[exch] == nil {
+				resp[exch] = make(map[asset.Item]map[currency.Pair]*CurrencyPairStatistic)
+			}
+			for a, assetMap := range exchMap {
+				if resp[exch][a] == nil {
+					resp[exch][a] = make(map[currency.Pair]*CurrencyPairStatistic)
+				}
+				for p, stats := range assetMap {
+					combined, ok := resp[exch][a][p]
+					if !ok {
+						combined = &CurrencyPairStatistic{
+							Events: make([]EventStore, len(stats.Events)),
+						}
+						for j := range stats.Events {
+							combined.Events[j] = stats.Events[j]
+							combined.Events[j].Transactions.Orders = append(combined.Events[j].Transactions.Orders[:0:0], stats.Events[j].Transactions.Orders...)
+						}
+						resp[exch][a][p] = combined
+						continue
+					}
+					for j := range stats.Events {
+						k := combinedEventIndex(combined.Events, j, stats.Events[j].DataEvent.GetTime())
+						if k < 0 {
+							continue
+						}
+						combineEvent(&combined.Events[k], &stats.Events[j])
+					}
+				}
+			}
+		}
+	}
+	for _, exchMap := range resp {
+		for _, assetMap := range exchMap {
+			for _, stats := range assetMap {
+				for i := 1; i < len(stats.Events); i++ {
+					previous := stats.Events[i-1].Holdings.TotalValue
+					if previous.IsZero() {
+						continue
+					}
+					stats.Events[i].Holdings.ChangeInTotalValuePercent = stats.Events[i].Holdings.TotalValue.Sub(previous).Div(previous)
+				}
+			}
+		}
+	}
+	return resp
+}
+
+// combinedEventIndex returns the index of the combined event at the time,
+// checking the expected index first as sleeves share the same data events
+func combinedEventIndex(events []EventStore, expected int, t time.Time) int {
+	if expected < len(events) && events[expected].DataEvent.GetTime().Equal(t) {
+		return expected
+	}
+	for i := range events {
+		if events[i].DataEvent.GetTime().Equal(t) {
+			return i
+		}
+	}
+	return -1
+}
+
+// combineEvent adds a sleeve's holdings and orders to the combined event. The
+// first sleeve's signal, order and fill events are kept
+func combineEvent(combined, ev *EventStore) {
+	combined.Holdings = addHoldings(combined.Holdings, ev.Holdings)
+	combined.Transactions.Orders = append(combined.Transactions.Orders, ev.Transactions.Orders...)
+	if combined.SignalEvent == nil {
+		combined.SignalEvent = ev.SignalEvent
+	}
+	if combined.OrderEvent == nil {
+		combined.OrderEvent = ev.OrderEvent
+	}
+	if combined.FillEvent == nil {
+		combined.FillEvent = ev.FillEvent
+	}
+}
+
+// addHoldings sums the holdings of the same currency pair held by different
+// sleeves. Leveraged positions use the size weighted entry price
+func addHoldings(h, o holdings.Holding) holdings.Holding {
+	if h.Timestamp.IsZero() {
+		return o
+	}
+	if h.Leverage.IsZero() {
+		h.Leverage = o.Leverage
+		h.EntryPrice = o.EntryPrice
+		h.LiquidationPrice = o.LiquidationPrice
+	} else if !o.Leverage.IsZero() {
+		size := h.BaseSize.Abs().Add(o.BaseSize.Abs())
+		if !size.IsZero() {
+			h.EntryPrice = h.EntryPrice.Mul(h.BaseSize.Abs()).Add(o.EntryPrice.Mul(o.BaseSize.Abs())).Div(size)
+		}
+	}
+	h.BaseInitialFunds = h.BaseInitialFunds.Add(o.BaseInitialFunds)
+	h.BaseSize = h.BaseSize.Add(o.BaseSize)
+	h.BaseValue = h.BaseValue.Add(o.BaseValue)
+	h.QuoteInitialFunds = h.QuoteInitialFunds.Add(o.QuoteInitialFunds)
+	h.TotalInitialValue = h.TotalInitialValue.Add(o.TotalInitialValue)
+	h.QuoteSize = h.QuoteSize.Add(o.QuoteSize)
+	h.SoldAmount = h.SoldAmount.Add(o.SoldAmount)
+	h.SoldValue = h.SoldValue.Add(o.SoldValue)
+	h.BoughtAmount = h.BoughtAmount.Add(o.BoughtAmount)
+	h.BoughtValue = h.BoughtValue.Add(o.BoughtValue)
+	h.TotalValueDifference = h.TotalValueDifference.Add(o.TotalValueDifference)
+	h.BoughtValueDifference = h.BoughtValueDifference.Add(o.BoughtValueDifference)
+	h.SoldValueDifference = h.SoldValueDifference.Add(o.SoldValueDifference)
+	h.PositionsValueDifference = h.PositionsValueDifference.Add(o.PositionsValueDifference)
+	h.TotalValue = h.TotalValue.Add(o.TotalValue)
+	h.TotalFees = h.TotalFees.Add(o.TotalFees)
+	h.TotalValueLostToVolumeSizing = h.TotalValueLostToVolumeSizing.Add(o.TotalValueLostToVolumeSizing)
+	h.TotalValueLostToSlippage = h.TotalValueLostToSlippage.Add(o.TotalValueLostToSlippage)
+	h.TotalValueLost = h.TotalValueLost.Add(o.TotalValueLost)
+	h.Margin = h.Margin.Add(o.Margin)
+	h.UnrealisedPNL = h.UnrealisedPNL.Add(o.UnrealisedPNL)
+	h.RealisedPNL = h.RealisedPNL.Add(o.RealisedPNL)
+	h.Funding = h.Funding.Add(o.Funding)
+	h.Liquidations += o.Liquidations
+	h.LiquidationLosses = h.LiquidationLosses.Add(o.LiquidationLosses)
+	return h
+}
+
+// PrintSleeveResults outputs the results of each sleeve to the command line
+func (s *Statistic) PrintSleeveResults() {
+	log.Info(log.BackTester, "------------------Sleeves------------------------------------")
+	for i := range s.Sleeves {
+		sep := fmt.Sprintf("%v |\t", s.Sleeves[i].Name)
+		if s.Sleeves[i].Statistic != nil {
+			log.Infof(log.BackTester, "%s Strategy: %v", sep, s.Sleeves[i].Statistic.StrategyName)
+		}
+		log.Infof(log.BackTester, "%s Weight: %v", sep, s.Sleeves[i].Weight)
+		log.Infof(log.BackTester, "%s Initial value: %s", sep, convert.DecimalToHumanFriendlyString(s.Sleeves[i].InitialValue, 8, ".", ","))
+		log.Infof(log.BackTester, "%s Final value: %s", sep, convert.DecimalToHumanFriendlyString(s.Sleeves[i].FinalValue, 8, ".", ","))
+		log.Infof(log.BackTester, "%s Net transfers: %s", sep, convert.DecimalToHumanFriendlyString(s.Sleeves[i].NetTransfers, 8, ".", ","))
+		log.Infof(log.BackTester, "%s Profit and loss: %s", sep, convert.DecimalToHumanFriendlyString(s.Sleeves[i].ProfitLoss, 8, ".", ","))
+		log.Infof(log.BackTester, "%s Time weighted return: %s%%", sep, convert.DecimalToHumanFriendlyString(s.Sleeves[i].TimeWeightedReturn, 2, ".", ","))
+		log.Infof(log.BackTester, "%s Max drawdown: %s%%", sep, convert.DecimalToHumanFriendlyString(s.Sleeves[i].MaxDrawdown, 2, ".", ","))
+		log.Infof(log.BackTester, "%s Sharpe ratio: %s", sep, s.Sleeves[i].SharpeRatio.Round(4))
+		log.Infof(log.BackTester, "%s Share of final value: %s%%\n\n", sep, convert.DecimalToHumanFriendlyString(s.Sleeves[i].ShareOfFinalValue, 2, ".", ","))
+	}
+}
@@ -0,0 +1,181 @@
+package statistics
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/compliance"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// sleeveStatistic returns a sleeve with BTC-USDT holdings of each total value
+func sleeveStatistic(name string, totalValues ...int64) *SleeveStatistics {
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	closes := make([]int64, len(totalValues))
+	for i := range closes {
+		closes[i] = 10
+	}
+	stats := benchmarkStatistics(testExchange, asset.Spot, p, closes...)
+	for i := range stats.Events {
+		stats.Events[i].Holdings = holdings.Holding{
+			Timestamp:  stats.Events[i].DataEvent.GetTime(),
+			Exchange:   testExchange,
+			Asset:      asset.Spot,
+			Pair:       p,
+			QuoteSize:  decimal.NewFromInt(totalValues[i]),
+			TotalValue: decimal.NewFromInt(totalValues[i]),
+		}
+		stats.Events[i].Transactions = compliance.Snapshot{
+			Orders: []compliance.SnapshotOrder{
+				{Detail: &gctorder.Detail{ID: name, Side: gctorder.Buy}},
+			},
+		}
+	}
+	return &SleeveStatistics{
+		Name:   name,
+		Weight: decimal.NewFromFloat(0.5),
+		Statistic: &Statistic{
+			StrategyName:   name,
+			CandleInterval: gctkline.OneDay,
+			ExchangeAssetPairStatistics: map[string]map[asset.Item]map[currency.Pair]*CurrencyPairStatistic{
+				testExchange: {
+					asset.Spot: {
+						p: stats,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestCalculateSleeveResults(t *testing.T) {
+	t.Parallel()
+	s := Statistic{
+		Sleeves: []*SleeveStatistics{{Name: "dca"}},
+	}
+	err := s.CalculateAllResults()
+	if !errors.Is(err, errSleeveStatisticsUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errSleeveStatisticsUnset)
+	}
+}
+
+func TestSleeveStatisticsCalculate(t *testing.T) {
+	t.Parallel()
+	s := sleeveStatistic("dca", 100, 150, 165)
+	// the sleeve receives 50 when rebalanced at the second candle
+	transfers := []funding.SleeveTransfer{
+		{
+			Time:     s.Statistic.ExchangeAssetPairStatistics[testExchange][asset.Spot][currency.NewPair(currency.BTC, currency.USDT)].Events[1].DataEvent.GetTime(),
+			From:     "rsi",
+			To:       "dca",
+			USDValue: decimal.NewFromInt(50),
+		},
+	}
+	err := s.calculate(transfers)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !s.NetTransfers.Equal(decimal.NewFromInt(50)) {
+		t.Errorf("received '%v' expected '%v'", s.NetTransfers, 50)
+	}
+	if !s.ProfitLoss.Equal(decimal.NewFromInt(15)) {
+		t.Errorf("received '%v' expected '%v'", s.ProfitLoss, 15)
+	}
+	if !s.TimeWeightedReturn.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("received '%v' expected '%v'", s.TimeWeightedReturn, 10)
+	}
+	if !s.MaxDrawdown.IsZero() {
+		t.Errorf("received '%v' expected '%v'", s.MaxDrawdown, 0)
+	}
+
+	s = sleeveStatistic("rsi", 100, 50, 60)
+	err = s.calculate(transfers)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !s.NetTransfers.Equal(decimal.NewFromInt(-50)) {
+		t.Errorf("received '%v' expected '%v'", s.NetTransfers, -50)
+	}
+	if !s.TimeWeightedReturn.Equal(decimal.NewFromInt(20)) {
+		t.Errorf("received '%v' expected '%v'", s.TimeWeightedReturn, 20)
+	}
+
+	s = sleeveStatistic("rsi", 100, 80, 100)
+	err = s.calculate(nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !s.MaxDrawdown.Equal(decimal.NewFromInt(20)) {
+		t.Errorf("received '%v' expected '%v'", s.MaxDrawdown, 20)
+	}
+
+	s = &SleeveStatistics{Statistic: &Statistic{}}
+	err = s.calculate(nil)
+	if !errors.Is(err, errReceivedNoData) {
+		t.Errorf("received '%v' expected '%v'", err, errReceivedNoData)
+	}
+}
+
+func TestCombineSleeveStatistics(t *testing.T) {
+	t.Parallel()
+	dca := sleeveStatistic("dca", 100, 110)
+	rsi := sleeveStatistic("rsi", 100, 90)
+	resp := combineSleeveStatistics([]*SleeveStatistics{dca, rsi})
+	combined := resp[testExchange][asset.Spot][currency.NewPair(currency.BTC, currency.USDT)]
+	if combined == nil {
+		t.Fatal("expected combined statistics")
+	}
+	if len(combined.Events) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(combined.Events), 2)
+	}
+	if !combined.Events[1].Holdings.TotalValue.Equal(decimal.NewFromInt(200)) {
+		t.Errorf("received '%v' expected '%v'", combined.Events[1].Holdings.TotalValue, 200)
+	}
+	if !combined.Events[1].Holdings.ChangeInTotalValuePercent.IsZero() {
+		t.Errorf("received '%v' expected '%v'", combined.Events[1].Holdings.ChangeInTotalValuePercent, 0)
+	}
+	if len(combined.Events[1].Transactions.Orders) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(combined.Events[1].Transactions.Orders), 2)
+	}
+	if len(dca.Statistic.ExchangeAssetPairStatistics[testExchange][asset.Spot][currency.NewPair(currency.BTC, currency.USDT)].Events[1].Transactions.Orders) != 1 {
+		t.Error("expected sleeve orders to be unchanged")
+	}
+}
+
+func TestAddHoldings(t *testing.T) {
+	t.Parallel()
+	h := holdings.Holding{
+		Timestamp:  benchmarkTime,
+		Leverage:   decimal.NewFromInt(2),
+		BaseSize:   decimal.NewFromInt(1),
+		EntryPrice: decimal.NewFromInt(100),
+		TotalValue: decimal.NewFromInt(50),
+	}
+	o := holdings.Holding{
+		Timestamp:  benchmarkTime,
+		Leverage:   decimal.NewFromInt(2),
+		BaseSize:   decimal.NewFromInt(3),
+		EntryPrice: decimal.NewFromInt(200),
+		TotalValue: decimal.NewFromInt(150),
+	}
+	resp := addHoldings(h, o)
+	if !resp.EntryPrice.Equal(decimal.NewFromInt(175)) {
+		t.Errorf("received '%v' expected '%v'", resp.EntryPrice, 175)
+	}
+	if !resp.BaseSize.Equal(decimal.NewFromInt(4)) {
+		t.Errorf("received '%v' expected '%v'", resp.BaseSize, 4)
+	}
+	if !resp.TotalValue.Equal(decimal.NewFromInt(200)) {
+		t.Errorf("received '%v' expected '%v'", resp.TotalValue, 200)
+	}
+	resp = addHoldings(holdings.Holding{}, o)
+	if !resp.EntryPrice.Equal(o.EntryPrice) {
+		t.Errorf("received '%v' expected '%v'", resp.EntryPrice, o.EntryPrice)
+	}
+}
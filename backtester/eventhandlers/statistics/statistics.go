@@ -40,7 +40,11 @@ func (s *Statistic) SetupEventForTime(ev common.DataEventHandler) error {
 	if lookup == nil {
 		lookup = &CurrencyPairStatistic{}
 	}
-	for i := range lookup.Events {
+	// events are stored in time order, so only the latest need checking
+	for i := len(lookup.Events) - 1; i >= 0; i-- {
+		if lookup.Events[i].DataEvent.GetTime().Before(ev.GetTime()) {
+			break
+		}
 		if lookup.Events[i].DataEvent.GetTime().Equal(ev.GetTime()) &&
 			lookup.Events[i].DataEvent.GetExchange() == ev.GetExchange() &&
 			lookup.Events[i].DataEvent.GetAssetType() == ev.GetAssetType() &&
@@ -156,22 +160,45 @@ func (s *Statistic) AddComplianceSnapshotForTime(c compliance.Snapshot, e fill.E
 }
 
 // CalculateAllResults calculates the statistics of all exchange asset pair holdings,
-// orders, ratios and drawdowns
+// orders, ratios and drawdowns. When strategies are run as sleeves, each
+// sleeve's results are calculated before their combined results
 func (s *Statistic) CalculateAllResults() error {
+	if len(s.Sleeves) > 0 {
+		err := s.calculateSleeveResults()
+		if err != nil {
+			return err
+		}
+	}
 	log.Info(log.BackTester, "calculating backtesting results")
 	s.PrintAllEventsChronologically()
 	currCount := 0
 	var finalResults []FinalResultsHolder
 	var err error
+	if s.RollingWindow <= 0 {
+		s.RollingWindow = defaultRollingWindow
+	}
+	if s.Benchmark != nil {
+		err = s.Benchmark.setup(s.ExchangeAssetPairStatistics)
+		if err != nil {
+			return err
+		}
+	}
+	s.CurrencyPairStatistics = nil
 	for exchangeName, exchangeMap := range s.ExchangeAssetPairStatistics {
 		for assetItem, assetMap := range exchangeMap {
 			for pair, stats := range assetMap {
 				currCount++
 				last := stats.Events[len(stats.Events)-1]
-				err = stats.CalculateResults(s.RiskFreeRate)
+				err = stats.CalculateResults(s.RiskFreeRate, s.Benchmark, s.RollingWindow)
 				if err != nil {
 					log.Error(log.BackTester, err)
 				}
+				if s.MonteCarlo != nil {
+					stats.MonteCarlo, err = s.MonteCarlo.CalculateMonteCarlo(exchangeName, assetItem, pair, stats)
+					if err != nil {
+						log.Error(log.BackTester, err)
+					}
+				}
 				stats.PrintResults(exchangeName, assetItem, pair, s.FundManager.IsUsingExchangeLevelFunding())
 				stats.FinalHoldings = last.Holdings
 				stats.InitialHoldings = stats.Events[0].Holdings
@@ -192,9 +219,23 @@ func (s *Statistic) CalculateAllResults() error {
 				if stats.ShowMissingDataWarning {
 					s.WasAnyDataMissing = true
 				}
+				stats.Exchange = exchangeName
+				stats.Asset = assetItem
+				stats.Pair = pair
+				s.CurrencyPairStatistics = append(s.CurrencyPairStatistics, *stats)
 			}
 		}
 	}
+	sort.Slice(s.CurrencyPairStatistics, func(i, j int) bool {
+		a, b := &s.CurrencyPairStatistics[i], &s.CurrencyPairStatistics[j]
+		if a.Exchange != b.Exchange {
+			return a.Exchange < b.Exchange
+		}
+		if a.Asset != b.Asset {
+			return a.Asset < b.Asset
+		}
+		return a.Pair.String() < b.Pair.String()
+	})
 	s.FundingStatistics, err = CalculateFundingStatistics(s.FundManager, s.ExchangeAssetPairStatistics, s.RiskFreeRate, s.CandleInterval)
 	if err != nil {
 		return err
@@ -1,6 +1,7 @@
 package statistics
 
 import (
+	"encoding/json"
 	"errors"
 	"testing"
 	"time"
@@ -795,6 +796,61 @@ func TestCalculateTheResults(t *testing.T) {
 	if !errors.Is(err, nil) {
 		t.Errorf("received '%v' expected '%v'", err, nil)
 	}
+	serialised, err := s.Serialise()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	var results struct {
+		CurrencyPairStatistics []struct {
+			Exchange         string          `json:"exchange"`
+			Asset            string          `json:"asset"`
+			Pair             string          `json:"pair"`
+			BuyOrders        int64           `json:"buy-orders"`
+			ArithmeticRatios *Ratios         `json:"arithmetic-ratios"`
+			MaxDrawdown      Swing           `json:"max-drawdown"`
+			FinalHoldings    json.RawMessage `json:"final-holdings"`
+		} `json:"currency-pair-statistics"`
+	}
+	err = json.Unmarshal([]byte(serialised), &results)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(results.CurrencyPairStatistics) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(results.CurrencyPairStatistics), 2)
+	}
+	for i := range results.CurrencyPairStatistics {
+		r := results.CurrencyPairStatistics[i]
+		if r.Exchange != exch || r.Asset != a.String() || r.Pair == "" {
+			t.Errorf("received '%+v' expected per pair identifiers", r)
+		}
+		if r.ArithmeticRatios == nil || len(r.FinalHoldings) == 0 {
+			t.Errorf("received '%+v' expected per pair results", r)
+		}
+	}
+
+	s.Benchmark = &Benchmark{
+		Name: "ltc",
+		Components: []BenchmarkComponent{
+			{Exchange: exch, Asset: a, Pair: currency.NewPair(currency.LTC, currency.USDT)},
+		},
+	}
+	err = s.CalculateAllResults()
+	if !errors.Is(err, errBenchmarkComponentNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errBenchmarkComponentNotFound)
+	}
+	s.Benchmark = &Benchmark{
+		Name: "btc",
+		Components: []BenchmarkComponent{
+			{Exchange: exch, Asset: a, Pair: p},
+		},
+	}
+	err = s.CalculateAllResults()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if s.ExchangeAssetPairStatistics[exch][a][p2].BenchmarkStatistics.Benchmark != s.Benchmark.Name {
+		t.Errorf("received '%v' expected '%v'", s.ExchangeAssetPairStatistics[exch][a][p2].BenchmarkStatistics.Benchmark, s.Benchmark.Name)
+	}
 }
 
 func TestCalculateMaxDrawdown(t *testing.T) {
@@ -26,6 +26,13 @@ var (
 	errMissingSnapshots            = errors.New("funding report item missing USD snapshots")
 	errNoRelevantStatsFound        = errors.New("no relevant currency pair statistics found")
 	errReceivedNoData              = errors.New("received no data")
+	errBenchmarkUnset              = errors.New("benchmark has no components or index")
+	errBenchmarkComponentNotFound  = errors.New("benchmark component has no statistics")
+	errBadBenchmarkIndexRow        = errors.New("invalid benchmark index row")
+	errRollingTimesMismatch        = errors.New("times length does not match returns")
+	errSleeveStatisticsUnset       = errors.New("sleeve statistics unset")
+	errNoTrades                    = errors.New("no trades to simulate")
+	errNoInitialEquity             = errors.New("initial total value must be positive")
 )
 
 // Statistic holds all statistical information for a backtester run, from drawdowns to ratios.
@@ -39,19 +46,43 @@ type Statistic struct {
 	EndDate                     time.Time                                                          `json:"end-date"`
 	CandleInterval              gctkline.Interval                                                  `json:"candle-interval"`
 	RiskFreeRate                decimal.Decimal                                                    `json:"risk-free-rate"`
-	ExchangeAssetPairStatistics map[string]map[asset.Item]map[currency.Pair]*CurrencyPairStatistic `json:"exchange-asset-pair-statistics"`
+	ExchangeAssetPairStatistics map[string]map[asset.Item]map[currency.Pair]*CurrencyPairStatistic `json:"-"`
 	TotalBuyOrders              int64                                                              `json:"total-buy-orders"`
 	TotalSellOrders             int64                                                              `json:"total-sell-orders"`
 	TotalOrders                 int64                                                              `json:"total-orders"`
 	BiggestDrawdown             *FinalResultsHolder                                                `json:"biggest-drawdown,omitempty"`
 	BestStrategyResults         *FinalResultsHolder                                                `json:"best-start-results,omitempty"`
 	BestMarketMovement          *FinalResultsHolder                                                `json:"best-market-movement,omitempty"`
-	CurrencyPairStatistics      []CurrencyPairStatistic                                            `json:"currency-pair-statistics"` // as ExchangeAssetPairStatistics cannot be rendered via json.Marshall, we append all result to this slice instead
+	CurrencyPairStatistics      []CurrencyPairStatistic                                            `json:"currency-pair-statistics"` // as ExchangeAssetPairStatistics cannot be rendered via json.Marshall, CalculateAllResults appends all results to this slice instead
 	WasAnyDataMissing           bool                                                               `json:"was-any-data-missing"`
 	FundingStatistics           *FundingStatistics                                                 `json:"funding-statistics"`
+	Benchmark                   *Benchmark                                                         `json:"benchmark,omitempty"`
+	RollingWindow               int64                                                              `json:"rolling-window"`
+	Sleeves                     []*SleeveStatistics                                                `json:"sleeves,omitempty"`
+	MonteCarlo                  *MonteCarloSettings                                                `json:"monte-carlo,omitempty"`
 	FundManager                 funding.IFundingManager                                            `json:"-"`
 }
 
+// SleeveStatistics holds the results of a strategy run alongside other
+// strategies with its own share of funding. Values are in USD unless USD
+// tracking is disabled, where they are the total value of the sleeve's
+// holdings. The time weighted return and max drawdown exclude funds moved
+// between sleeves when rebalancing
+type SleeveStatistics struct {
+	Name               string          `json:"name"`
+	Weight             decimal.Decimal `json:"weight"`
+	Statistic          *Statistic      `json:"statistic"`
+	InitialValue       decimal.Decimal `json:"initial-value"`
+	FinalValue         decimal.Decimal `json:"final-value"`
+	NetTransfers       decimal.Decimal `json:"net-transfers"`
+	ProfitLoss         decimal.Decimal `json:"profit-loss"`
+	TimeWeightedReturn decimal.Decimal `json:"time-weighted-return"`
+	MaxDrawdown        decimal.Decimal `json:"max-drawdown"`
+	SharpeRatio        decimal.Decimal `json:"sharpe-ratio"`
+	ShareOfFinalValue  decimal.Decimal `json:"share-of-final-value"`
+	ValuesOverTime     []ValueAtTime   `json:"values-over-time"`
+}
+
 // FinalResultsHolder holds important stats about a currency's performance
 type FinalResultsHolder struct {
 	Exchange         string          `json:"exchange"`
@@ -126,6 +157,10 @@ type EventStore struct {
 
 // CurrencyPairStatistic Holds all events and statistics relevant to an exchange, asset type and currency pair
 type CurrencyPairStatistic struct {
+	Exchange string        `json:"exchange"`
+	Asset    asset.Item    `json:"asset"`
+	Pair     currency.Pair `json:"pair"`
+
 	ShowMissingDataWarning       bool `json:"-"`
 	IsStrategyProfitable         bool `json:"is-strategy-profitable"`
 	DoesPerformanceBeatTheMarket bool `json:"does-performance-beat-the-market"`
@@ -149,13 +184,82 @@ type CurrencyPairStatistic struct {
 
 	Events []EventStore `json:"-"`
 
-	MaxDrawdown           Swing               `json:"max-drawdown,omitempty"`
-	HighestCommittedFunds ValueAtTime         `json:"highest-committed-funds"`
-	GeometricRatios       *Ratios             `json:"geometric-ratios"`
-	ArithmeticRatios      *Ratios             `json:"arithmetic-ratios"`
-	InitialHoldings       holdings.Holding    `json:"initial-holdings-holdings"`
-	FinalHoldings         holdings.Holding    `json:"final-holdings"`
-	FinalOrders           compliance.Snapshot `json:"final-orders"`
+	MaxDrawdown           Swing                 `json:"max-drawdown,omitempty"`
+	HighestCommittedFunds ValueAtTime           `json:"highest-committed-funds"`
+	GeometricRatios       *Ratios               `json:"geometric-ratios"`
+	ArithmeticRatios      *Ratios               `json:"arithmetic-ratios"`
+	InitialHoldings       holdings.Holding      `json:"initial-holdings-holdings"`
+	FinalHoldings         holdings.Holding      `json:"final-holdings"`
+	FinalOrders           compliance.Snapshot   `json:"final-orders"`
+	FuturesStatistics     *FuturesStatistics    `json:"futures-statistics,omitempty"`
+	BenchmarkStatistics   *BenchmarkStatistics  `json:"benchmark-statistics,omitempty"`
+	RollingStatistics     []RollingStatistic    `json:"rolling-statistics,omitempty"`
+	MonthlyReturns        []MonthlyReturn       `json:"monthly-returns,omitempty"`
+	MonteCarlo            *MonteCarloStatistics `json:"monte-carlo,omitempty"`
+}
+
+// Benchmark is the series strategy returns are compared against. It is either
+// a weighted basket of loaded pairs bought and held from their first candle
+// or an external index. When unset, each pair is compared against its own
+// market movement
+type Benchmark struct {
+	Name       string               `json:"name"`
+	Components []BenchmarkComponent `json:"components,omitempty"`
+	Index      []ValueAtTime        `json:"-"`
+	values     []ValueAtTime
+}
+
+// BenchmarkComponent is a loaded pair held as part of a benchmark
+type BenchmarkComponent struct {
+	Exchange string          `json:"exchange"`
+	Asset    asset.Item      `json:"asset"`
+	Pair     currency.Pair   `json:"pair"`
+	Weight   decimal.Decimal `json:"weight"`
+}
+
+// BenchmarkStatistics compares strategy returns against a benchmark.
+// Alpha and tracking error are annualised
+type BenchmarkStatistics struct {
+	Benchmark         string          `json:"benchmark"`
+	BenchmarkMovement decimal.Decimal `json:"benchmark-movement"`
+	Alpha             decimal.Decimal `json:"alpha"`
+	Beta              decimal.Decimal `json:"beta"`
+	TrackingError     decimal.Decimal `json:"tracking-error"`
+	UpCaptureRatio    decimal.Decimal `json:"up-capture-ratio"`
+	DownCaptureRatio  decimal.Decimal `json:"down-capture-ratio"`
+}
+
+// RollingStatistic holds the Sharpe ratio and annualised volatility of the
+// rolling window ending at the time
+type RollingStatistic struct {
+	Time        time.Time       `json:"time"`
+	SharpeRatio decimal.Decimal `json:"sharpe-ratio"`
+	Volatility  decimal.Decimal `json:"volatility"`
+}
+
+// MonthlyReturn holds the compounded strategy and benchmark
+// percentage returns for a calendar month
+type MonthlyReturn struct {
+	Month           time.Time       `json:"month"`
+	StrategyReturn  decimal.Decimal `json:"strategy-return"`
+	BenchmarkReturn decimal.Decimal `json:"benchmark-return"`
+	ExcessReturn    decimal.Decimal `json:"excess-return"`
+}
+
+// FuturesStatistics holds the results of a leveraged
+// futures or margin position
+type FuturesStatistics struct {
+	Leverage          decimal.Decimal `json:"leverage"`
+	FinalPositionSize decimal.Decimal `json:"final-position-size"`
+	FinalEntryPrice   decimal.Decimal `json:"final-entry-price"`
+	RealisedPNL       decimal.Decimal `json:"realised-pnl"`
+	UnrealisedPNL     decimal.Decimal `json:"unrealised-pnl"`
+	// TotalPNL excludes funding payments and fees
+	TotalPNL          decimal.Decimal `json:"total-pnl"`
+	Funding           decimal.Decimal `json:"funding"`
+	Liquidations      int64           `json:"liquidations"`
+	LiquidationLosses decimal.Decimal `json:"liquidation-losses"`
+	HighestMargin     ValueAtTime     `json:"highest-margin"`
 }
 
 // Ratios stores all the ratios used for statistics
@@ -233,3 +337,71 @@ type TotalFundingStatistics struct {
 	DidStrategyMakeProfit    bool
 	HoldingValueDifference   decimal.Decimal
 }
+
+// MonteCarloSettings defines the post-run analysis which resamples and
+// reorders each pair's trades to show how much of a result came down to luck
+type MonteCarloSettings struct {
+	Iterations      int64           `json:"iterations"`
+	ConfidenceLevel decimal.Decimal `json:"confidence-level"`
+	Seed            int64           `json:"seed"`
+	Slippage        []SlippageRange `json:"-"`
+}
+
+// SlippageRange is the configured slippage of a pair, used to randomise the
+// price of each simulated trade. Rates follow the exchange's convention where
+// 95 means a trade keeps 95% of its price
+type SlippageRange struct {
+	Exchange            string
+	Asset               asset.Item
+	Pair                currency.Pair
+	MinimumSlippageRate decimal.Decimal
+	MaximumSlippageRate decimal.Decimal
+}
+
+// MonteCarloStatistics holds the distributions of each simulation method
+// alongside the result of the trades as they happened. Equity only includes
+// the trades, each valued at the final close price
+type MonteCarloStatistics struct {
+	Iterations      int64                 `json:"iterations"`
+	ConfidenceLevel decimal.Decimal       `json:"confidence-level"`
+	Seed            int64                 `json:"seed"`
+	Trades          int64                 `json:"trades"`
+	InitialEquity   decimal.Decimal       `json:"initial-equity"`
+	Actual          MonteCarloIteration   `json:"actual"`
+	Bootstrap       *MonteCarloSimulation `json:"bootstrap"`
+	Shuffle         *MonteCarloSimulation `json:"shuffle"`
+}
+
+// MonteCarloIteration is the result of a single path of trades. Max drawdown
+// is a percentage and the Sharpe ratio is per trade
+type MonteCarloIteration struct {
+	FinalEquity decimal.Decimal `json:"final-equity"`
+	MaxDrawdown decimal.Decimal `json:"max-drawdown"`
+	SharpeRatio decimal.Decimal `json:"sharpe-ratio"`
+}
+
+// MonteCarloSimulation holds the distributions of every iteration of a
+// simulation method
+type MonteCarloSimulation struct {
+	Method      string       `json:"method"`
+	FinalEquity Distribution `json:"final-equity"`
+	MaxDrawdown Distribution `json:"max-drawdown"`
+	SharpeRatio Distribution `json:"sharpe-ratio"`
+}
+
+// Distribution summarises simulated values with a confidence interval and a
+// histogram for charting
+type Distribution struct {
+	Mean      decimal.Decimal `json:"mean"`
+	Median    decimal.Decimal `json:"median"`
+	Lower     decimal.Decimal `json:"lower"`
+	Upper     decimal.Decimal `json:"upper"`
+	Histogram []HistogramBin  `json:"histogram"`
+}
+
+// HistogramBin counts the values from its lower bound up to its upper bound
+type HistogramBin struct {
+	Lower decimal.Decimal `json:"lower"`
+	Upper decimal.Decimal `json:"upper"`
+	Count int64           `json:"count"`
+}
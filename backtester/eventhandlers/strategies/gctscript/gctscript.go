@@ -0,0 +1,477 @@
+package gctscript
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/d5/tengo/v2"
+	"github.com/d5/tengo/v2/stdlib"
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	eventkline "github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/ta"
+)
+
+const (
+	// Name is the strategy name
+	Name              = "gctscript"
+	scriptPathKey     = "script-path"
+	scriptTimeoutKey  = "script-timeout"
+	scriptLookbackKey = "script-lookback"
+	defaultTimeout    = time.Second * 5
+	// defaultLookback is the number of candles passed to the script when
+	// neither a lookback nor any indicator period settings are set
+	defaultLookback = 100
+	// periodSuffix identifies the custom settings holding indicator periods
+	periodSuffix = "-period"
+	description  = `Runs a user defined gctscript against each data event. The script receives the candle history, funding levels and custom settings of the currency being processed and can import any ta indicator module to decide whether to buy, sell or do nothing`
+
+	// script input variable names
+	exchangeVar     = "exchange"
+	assetVar        = "asset"
+	pairVar         = "pair"
+	timeVar         = "timestamp"
+	offsetVar       = "offset"
+	ohlcvVar        = "ohlcv"
+	fundsVar        = "funds"
+	settingsVar     = "settings"
+	simultaneousVar = "simultaneous"
+	currenciesVar   = "currencies"
+	// script output variable names
+	signalVar  = "signal"
+	signalsVar = "signals"
+
+	// signal map keys
+	directionKey  = "direction"
+	reasonKey     = "reason"
+	limitPriceKey = "limit-price"
+
+	buyDirection       = "buy"
+	sellDirection      = "sell"
+	holdDirection      = "hold"
+	doNothingDirection = "donothing"
+)
+
+var (
+	errNoScriptPath      = errors.New("no script path set")
+	errScriptNotLoaded   = errors.New("script not loaded")
+	errNoSignalReturned  = errors.New("script did not set a signal")
+	errInvalidSignal     = errors.New("invalid signal returned from script")
+	errSignalCountDiffer = errors.New("number of signals returned does not match number of currencies")
+)
+
+// Strategy is an implementation of the Handler interface
+// which defers its decisions to a gctscript
+type Strategy struct {
+	base.Strategy
+	scriptPath string
+	timeout    time.Duration
+	lookback   int
+	settings   map[string]interface{}
+	compiled   *tengo.Compiled
+}
+
+// Name returns the name of the strategy
+func (s *Strategy) Name() string {
+	return Name
+}
+
+// Description provides a nice overview of the strategy
+// be it definition of terms or to highlight its purpose
+func (s *Strategy) Description() string {
+	return description
+}
+
+// OnSignal handles a data event and returns what action the strategy believes should occur
+// For gctscript, the loaded script is run with the candle history and funding
+// of the data event and its signal variable is converted to a signal event
+func (s *Strategy) OnSignal(d data.Handler, f funding.IFundTransferer, _ portfolio.Handler) (signal.Event, error) {
+	if d == nil {
+		return nil, common.ErrNilEvent
+	}
+	if s.compiled == nil {
+		return nil, errScriptNotLoaded
+	}
+	es, err := s.GetBaseData(d)
+	if err != nil {
+		return nil, err
+	}
+	es.SetPrice(d.Latest().GetClosePrice())
+	if !d.HasDataAtTime(d.Latest().GetTime()) {
+		es.SetDirection(common.MissingData)
+		es.AppendReason(fmt.Sprintf("missing data at %v, cannot perform any actions", d.Latest().GetTime()))
+		return &es, nil
+	}
+
+	inputs, err := currencyInputs(d, f, s.lookback)
+	if err != nil {
+		return nil, err
+	}
+	inputs[settingsVar] = s.settings
+	inputs[simultaneousVar] = false
+	inputs[currenciesVar] = nil
+	inputs[signalVar] = nil
+	inputs[signalsVar] = nil
+	err = s.run(inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = applyScriptSignal(&es, s.compiled.Get(signalVar).Value())
+	if err != nil {
+		return nil, err
+	}
+	return &es, nil
+}
+
+// SupportsSimultaneousProcessing highlights whether the strategy can handle multiple currency calculation
+func (s *Strategy) SupportsSimultaneousProcessing() bool {
+	return true
+}
+
+// OnSimultaneousSignals runs the loaded script once with every currency's data
+// available via the currencies variable. The script sets the signals variable
+// to a signal per currency, in the same order as currencies
+func (s *Strategy) OnSimultaneousSignals(d []data.Handler, f funding.IFundTransferer, _ portfolio.Handler) ([]signal.Event, error) {
+	if s.compiled == nil {
+		return nil, errScriptNotLoaded
+	}
+	var errs gctcommon.Errors
+	events := make([]*signal.Signal, 0, len(d))
+	currencies := make([]interface{}, 0, len(d))
+	for i := range d {
+		if d[i] == nil {
+			return nil, common.ErrNilEvent
+		}
+		es, err := s.GetBaseData(d[i])
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		es.SetPrice(d[i].Latest().GetClosePrice())
+		inputs, err := currencyInputs(d[i], f, s.lookback)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%v %v %v %w", d[i].Latest().GetExchange(), d[i].Latest().GetAssetType(), d[i].Latest().Pair(), err))
+			continue
+		}
+		inputs["has-data"] = d[i].HasDataAtTime(d[i].Latest().GetTime())
+		events = append(events, &es)
+		currencies = append(currencies, inputs)
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	err := s.run(map[string]interface{}{
+		exchangeVar:     "",
+		assetVar:        "",
+		pairVar:         "",
+		timeVar:         nil,
+		offsetVar:       0,
+		ohlcvVar:        nil,
+		fundsVar:        nil,
+		settingsVar:     s.settings,
+		simultaneousVar: true,
+		currenciesVar:   currencies,
+		signalVar:       nil,
+		signalsVar:      nil,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output := s.compiled.Get(signalsVar)
+	if output.IsUndefined() {
+		return nil, errNoSignalReturned
+	}
+	scriptSignals := output.Array()
+	if len(scriptSignals) != len(events) {
+		return nil, fmt.Errorf("%w received %v signals for %v currencies", errSignalCountDiffer, len(scriptSignals), len(events))
+	}
+	resp := make([]signal.Event, len(events))
+	for i := range events {
+		if !d[i].HasDataAtTime(d[i].Latest().GetTime()) {
+			events[i].SetDirection(common.MissingData)
+			events[i].AppendReason(fmt.Sprintf("missing data at %v, cannot perform any actions", d[i].Latest().GetTime()))
+		} else if err = applyScriptSignal(events[i], scriptSignals[i]); err != nil {
+			errs = append(errs, fmt.Errorf("%v %v %v %w", d[i].Latest().GetExchange(), d[i].Latest().GetAssetType(), d[i].Latest().Pair(), err))
+			continue
+		}
+		resp[i] = events[i]
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return resp, nil
+}
+
+// SetCustomSettings loads and compiles the script at script-path. All other
+// custom settings are passed to the script via the settings variable. When
+// script-lookback is not set, the number of candles passed to the script is
+// the longest indicator period setting plus the current candle
+func (s *Strategy) SetCustomSettings(customSettings map[string]interface{}) error {
+	settings := make(map[string]interface{})
+	var lookback, longestPeriod int
+	for k, v := range customSettings {
+		switch k {
+		case scriptPathKey:
+			scriptPath, ok := v.(string)
+			if !ok || scriptPath == "" {
+				return fmt.Errorf("%w provided script-path value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			s.scriptPath = scriptPath
+		case scriptTimeoutKey:
+			timeoutStr, ok := v.(string)
+			if !ok {
+				return fmt.Errorf("%w provided script-timeout value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			timeout, err := time.ParseDuration(timeoutStr)
+			if err != nil || timeout <= 0 {
+				return fmt.Errorf("%w provided script-timeout value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			s.timeout = timeout
+		case scriptLookbackKey:
+			candles, ok := v.(float64)
+			if !ok || candles < 1 {
+				return fmt.Errorf("%w provided script-lookback value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			lookback = int(candles)
+		default:
+			if period, ok := v.(float64); ok && strings.HasSuffix(k, periodSuffix) && int(period) > longestPeriod {
+				longestPeriod = int(period)
+			}
+			settings[k] = v
+		}
+	}
+	if s.scriptPath == "" {
+		return fmt.Errorf("%w %v", base.ErrInvalidCustomSettings, errNoScriptPath)
+	}
+	switch {
+	case lookback > 0:
+		s.lookback = lookback
+	case longestPeriod > 0:
+		s.lookback = longestPeriod + 1
+	default:
+		s.lookback = defaultLookback
+	}
+	s.settings = settings
+	return s.load()
+}
+
+// SetDefaults sets the custom settings to their default values
+func (s *Strategy) SetDefaults() {
+	s.timeout = defaultTimeout
+	s.lookback = defaultLookback
+	s.settings = make(map[string]interface{})
+}
+
+// load reads and compiles the script, declaring every variable
+// the strategy sets before each run
+func (s *Strategy) load() error {
+	file := s.scriptPath
+	if filepath.Ext(file) != gctcommon.GctExt {
+		file += gctcommon.GctExt
+	}
+	code, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	script := tengo.NewScript(code)
+	script.SetImports(moduleMap())
+	for _, name := range []string{
+		exchangeVar,
+		assetVar,
+		pairVar,
+		timeVar,
+		offsetVar,
+		ohlcvVar,
+		fundsVar,
+		settingsVar,
+		simultaneousVar,
+		currenciesVar,
+		signalVar,
+		signalsVar,
+	} {
+		err = script.Add(name, nil)
+		if err != nil {
+			return err
+		}
+	}
+	s.compiled, err = script.Compile()
+	if err != nil {
+		return fmt.Errorf("could not compile script %v %w", file, err)
+	}
+	return nil
+}
+
+// run sets the script's input variables and runs it to completion
+func (s *Strategy) run(inputs map[string]interface{}) error {
+	for k, v := range inputs {
+		err := s.compiled.Set(k, v)
+		if err != nil {
+			return err
+		}
+	}
+	timeout := s.timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	err := s.compiled.RunContext(ctx)
+	if err != nil {
+		return fmt.Errorf("script %v %w", s.scriptPath, err)
+	}
+	return nil
+}
+
+// moduleMap returns the ta indicator and standard library modules.
+// The gct exchange modules are excluded as they act upon live exchanges
+func moduleMap() *tengo.ModuleMap {
+	modules := tengo.NewModuleMap()
+	taModuleList := ta.AllModuleNames()
+	for _, name := range taModuleList {
+		if mod := ta.Modules[name]; mod != nil {
+			modules.AddBuiltinModule(name, mod)
+		}
+	}
+	stdLib := stdlib.AllModuleNames()
+	for _, name := range stdLib {
+		if mod := stdlib.BuiltinModules[name]; mod != nil {
+			modules.AddBuiltinModule(name, mod)
+		}
+		if mod := stdlib.SourceModules[name]; mod != "" {
+			modules.AddSourceModule(name, []byte(mod))
+		}
+	}
+	return modules
+}
+
+// currencyInputs returns the script variables describing a single currency.
+// Only the last lookback candles are converted so each run does not grow
+// with the length of the backtest
+func currencyInputs(d data.Handler, f funding.IFundTransferer, lookback int) (map[string]interface{}, error) {
+	latest := d.Latest()
+	funds := make(map[string]interface{})
+	if f != nil {
+		pair, err := f.GetFundingForEAP(latest.GetExchange(), latest.GetAssetType(), latest.Pair())
+		if err != nil {
+			return nil, err
+		}
+		funds["base-initial"] = pair.BaseInitialFunds().InexactFloat64()
+		funds["quote-initial"] = pair.QuoteInitialFunds().InexactFloat64()
+		funds["base-available"] = pair.BaseAvailable().InexactFloat64()
+		funds["quote-available"] = pair.QuoteAvailable().InexactFloat64()
+		if pos := pair.GetPosition(); pos != nil {
+			snapshot := pos.Snapshot()
+			funds["position"] = map[string]interface{}{
+				"size":              snapshot.Size.InexactFloat64(),
+				"entry-price":       snapshot.EntryPrice.InexactFloat64(),
+				"margin":            snapshot.Margin.InexactFloat64(),
+				"liquidation-price": snapshot.LiquidationPrice.InexactFloat64(),
+				"unrealised-pnl":    snapshot.UnrealisedPNL.InexactFloat64(),
+				"leverage":          pos.Leverage().InexactFloat64(),
+			}
+		}
+	}
+
+	history := d.History()
+	if lookback > 0 && len(history) > lookback {
+		history = history[len(history)-lookback:]
+	}
+	ohlcv := make([]interface{}, len(history))
+	for i := range history {
+		var volume float64
+		if k, ok := history[i].(*eventkline.Kline); ok {
+			volume = k.Volume.InexactFloat64()
+		}
+		ohlcv[i] = []interface{}{
+			history[i].GetTime().Unix(),
+			history[i].GetOpenPrice().InexactFloat64(),
+			history[i].GetHighPrice().InexactFloat64(),
+			history[i].GetLowPrice().InexactFloat64(),
+			history[i].GetClosePrice().InexactFloat64(),
+			volume,
+		}
+	}
+
+	return map[string]interface{}{
+		exchangeVar: latest.GetExchange(),
+		assetVar:    latest.GetAssetType().String(),
+		pairVar:     latest.Pair().String(),
+		timeVar:     latest.GetTime(),
+		offsetVar:   d.Offset(),
+		ohlcvVar:    ohlcv,
+		fundsVar:    funds,
+	}, nil
+}
+
+// applyScriptSignal converts a script signal into the signal event's
+// direction. A signal is either a direction string or a map containing
+// a direction and an optional reason and limit price
+func applyScriptSignal(es *signal.Signal, scriptSignal interface{}) error {
+	var direction, reason string
+	var limitPrice float64
+	switch sig := scriptSignal.(type) {
+	case nil:
+		return errNoSignalReturned
+	case string:
+		direction = sig
+	case map[string]interface{}:
+		var ok bool
+		direction, ok = sig[directionKey].(string)
+		if !ok {
+			return fmt.Errorf("%w %v must be a string", errInvalidSignal, directionKey)
+		}
+		if r, ok := sig[reasonKey]; ok {
+			reason, ok = r.(string)
+			if !ok {
+				return fmt.Errorf("%w %v must be a string", errInvalidSignal, reasonKey)
+			}
+		}
+		switch p := sig[limitPriceKey].(type) {
+		case nil:
+		case float64:
+			limitPrice = p
+		case int64:
+			limitPrice = float64(p)
+		default:
+			return fmt.Errorf("%w %v must be a number", errInvalidSignal, limitPriceKey)
+		}
+		if limitPrice < 0 {
+			return fmt.Errorf("%w %v must be positive", errInvalidSignal, limitPriceKey)
+		}
+	default:
+		return fmt.Errorf("%w unsupported type %T", errInvalidSignal, scriptSignal)
+	}
+
+	switch strings.ToLower(direction) {
+	case buyDirection:
+		es.SetDirection(order.Buy)
+	case sellDirection:
+		es.SetDirection(order.Sell)
+	case holdDirection, doNothingDirection:
+		es.SetDirection(common.DoNothing)
+	default:
+		return fmt.Errorf("%w unrecognised direction '%v'", errInvalidSignal, direction)
+	}
+	if limitPrice > 0 {
+		es.SetLimitOrder(decimal.NewFromFloat(limitPrice))
+	}
+	if reason != "" {
+		es.AppendReason(reason)
+	}
+	return nil
+}
@@ -0,0 +1,456 @@
+package gctscript
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+const (
+	testExchange = "binance"
+	exampleRSI   = "../../../config/examples/scripts/rsi.gct"
+)
+
+var testPair = currency.NewPair(currency.BTC, currency.USDT)
+
+// writeScript saves the script contents to a temporary file and
+// returns its path
+func writeScript(t *testing.T, contents string) string {
+	t.Helper()
+	p := filepath.Join(t.TempDir(), "strategy.gct")
+	err := ioutil.WriteFile(p, []byte(contents), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+// loadStrategy returns a strategy with the script loaded
+func loadStrategy(t *testing.T, contents string) *Strategy {
+	t.Helper()
+	s := &Strategy{}
+	s.SetDefaults()
+	err := s.SetCustomSettings(map[string]interface{}{
+		scriptPathKey: writeScript(t, contents),
+		"threshold":   1337.0,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// loadData returns kline data for each close price provided, with the
+// stream advanced to the final candle
+func loadData(t *testing.T, p currency.Pair, closes ...float64) *kline.DataFromKline {
+	t.Helper()
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	d := &kline.DataFromKline{
+		Item: gctkline.Item{
+			Exchange: testExchange,
+			Pair:     p,
+			Asset:    asset.Spot,
+			Interval: gctkline.OneDay,
+		},
+	}
+	for i := range closes {
+		d.Item.Candles = append(d.Item.Candles, gctkline.Candle{
+			Time:   start.Add(gctkline.OneDay.Duration() * time.Duration(i)),
+			Open:   closes[i],
+			High:   closes[i],
+			Low:    closes[i],
+			Close:  closes[i],
+			Volume: 1,
+		})
+	}
+	err := d.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.RangeHolder, err = gctkline.CalculateCandleDateRanges(start, start.Add(gctkline.OneDay.Duration()*time.Duration(len(closes))), gctkline.OneDay, 100000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.RangeHolder.SetHasDataFromCandles(d.Item.Candles)
+	for d.Next() != nil {
+	}
+	return d
+}
+
+func TestName(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	if n := s.Name(); n != Name {
+		t.Errorf("expected %v", Name)
+	}
+}
+
+func TestSupportsSimultaneousProcessing(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	if !s.SupportsSimultaneousProcessing() {
+		t.Error("expected true")
+	}
+}
+
+func TestSetCustomSettings(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	err := s.SetCustomSettings(nil)
+	if !errors.Is(err, base.ErrInvalidCustomSettings) {
+		t.Errorf("received '%v' expected '%v'", err, base.ErrInvalidCustomSettings)
+	}
+
+	err = s.SetCustomSettings(map[string]interface{}{scriptPathKey: 1337.0})
+	if !errors.Is(err, base.ErrInvalidCustomSettings) {
+		t.Errorf("received '%v' expected '%v'", err, base.ErrInvalidCustomSettings)
+	}
+
+	err = s.SetCustomSettings(map[string]interface{}{scriptPathKey: exampleRSI, scriptTimeoutKey: "lol"})
+	if !errors.Is(err, base.ErrInvalidCustomSettings) {
+		t.Errorf("received '%v' expected '%v'", err, base.ErrInvalidCustomSettings)
+	}
+
+	err = s.SetCustomSettings(map[string]interface{}{scriptPathKey: exampleRSI, scriptLookbackKey: 0.0})
+	if !errors.Is(err, base.ErrInvalidCustomSettings) {
+		t.Errorf("received '%v' expected '%v'", err, base.ErrInvalidCustomSettings)
+	}
+
+	err = s.SetCustomSettings(map[string]interface{}{scriptPathKey: filepath.Join(t.TempDir(), "missing")})
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("received '%v' expected '%v'", err, os.ErrNotExist)
+	}
+
+	err = s.SetCustomSettings(map[string]interface{}{scriptPathKey: writeScript(t, "signal = ")})
+	if err == nil {
+		t.Error("expected compilation error")
+	}
+
+	err = s.SetCustomSettings(map[string]interface{}{scriptPathKey: writeScript(t, `x := import("exchange")`)})
+	if err == nil {
+		t.Error("expected live exchange module to be unavailable")
+	}
+
+	err = s.SetCustomSettings(map[string]interface{}{
+		scriptPathKey:    strings.TrimSuffix(exampleRSI, ".gct"),
+		scriptTimeoutKey: "1s",
+		"rsi-period":     14.0,
+	})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if s.timeout != time.Second {
+		t.Errorf("received '%v' expected '%v'", s.timeout, time.Second)
+	}
+	if _, ok := s.settings["rsi-period"]; !ok {
+		t.Error("expected rsi-period to be passed to the script")
+	}
+	if _, ok := s.settings[scriptPathKey]; ok {
+		t.Error("expected script-path to be consumed by the strategy")
+	}
+	// the lookback defaults to the longest period plus the current candle
+	if s.lookback != 15 {
+		t.Errorf("received '%v' expected '%v'", s.lookback, 15)
+	}
+
+	err = s.SetCustomSettings(map[string]interface{}{
+		scriptPathKey:     exampleRSI,
+		scriptLookbackKey: 50.0,
+		"rsi-period":      14.0,
+	})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if s.lookback != 50 {
+		t.Errorf("received '%v' expected '%v'", s.lookback, 50)
+	}
+	if _, ok := s.settings[scriptLookbackKey]; ok {
+		t.Error("expected script-lookback to be consumed by the strategy")
+	}
+
+	err = s.SetCustomSettings(map[string]interface{}{scriptPathKey: exampleRSI})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if s.lookback != defaultLookback {
+		t.Errorf("received '%v' expected '%v'", s.lookback, defaultLookback)
+	}
+}
+
+func TestSetDefaults(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	s.SetDefaults()
+	if s.timeout != defaultTimeout {
+		t.Errorf("received '%v' expected '%v'", s.timeout, defaultTimeout)
+	}
+	if s.lookback != defaultLookback {
+		t.Errorf("received '%v' expected '%v'", s.lookback, defaultLookback)
+	}
+	if s.settings == nil {
+		t.Error("expected settings to be initialised")
+	}
+}
+
+func TestOnSignal(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	_, err := s.OnSignal(nil, nil, nil)
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilEvent)
+	}
+	d := loadData(t, testPair, 5, 4, 3, 2, 1)
+	_, err = s.OnSignal(d, nil, nil)
+	if !errors.Is(err, errScriptNotLoaded) {
+		t.Errorf("received '%v' expected '%v'", err, errScriptNotLoaded)
+	}
+
+	s.SetDefaults()
+	err = s.SetCustomSettings(map[string]interface{}{scriptPathKey: exampleRSI, "rsi-period": 2.0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := s.OnSignal(d, nil, nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.GetDirection() != order.Buy {
+		t.Errorf("received '%v' expected '%v'", resp.GetDirection(), order.Buy)
+	}
+	if !strings.Contains(resp.GetReason(), "RSI at") {
+		t.Errorf("expected RSI reason, received '%v'", resp.GetReason())
+	}
+
+	d = loadData(t, testPair, 1, 2, 3, 4, 5)
+	resp, err = s.OnSignal(d, nil, nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.GetDirection() != order.Sell {
+		t.Errorf("received '%v' expected '%v'", resp.GetDirection(), order.Sell)
+	}
+
+	d = loadData(t, testPair, 1)
+	resp, err = s.OnSignal(d, nil, nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.GetDirection() != common.DoNothing {
+		t.Errorf("received '%v' expected '%v'", resp.GetDirection(), common.DoNothing)
+	}
+
+	d.RangeHolder.Ranges[0].Intervals[0].HasData = false
+	resp, err = s.OnSignal(d, nil, nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.GetDirection() != common.MissingData {
+		t.Errorf("received '%v' expected '%v'", resp.GetDirection(), common.MissingData)
+	}
+}
+
+func TestOnSignalScriptInputs(t *testing.T) {
+	t.Parallel()
+	s := loadStrategy(t, `
+if exchange != "binance" || asset != "spot" || pair != "BTCUSDT" {
+	signal = "unexpected currency"
+} else if offset != 3 || len(ohlcv) != 3 || ohlcv[2][4] != 3.0 || ohlcv[2][5] != 1.0 {
+	signal = "unexpected candles"
+} else if settings.threshold != 1337.0 || funds["quote-available"] != 1000.0 || funds["base-initial"] != 0.0 {
+	signal = "unexpected settings"
+} else {
+	signal = {direction: "buy", reason: "inputs as expected", "limit-price": 2.5}
+}`)
+	f := funding.SetupFundingManager(false, true)
+	b, err := funding.CreateItem(testExchange, asset.Spot, currency.BTC, decimal.Zero, decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := funding.CreateItem(testExchange, asset.Spot, currency.USDT, decimal.NewFromInt(1000), decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := funding.CreatePair(b, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = f.AddPair(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := s.OnSignal(loadData(t, testPair, 1, 2, 3), f, nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.GetDirection() != order.Buy {
+		t.Errorf("received '%v' expected '%v'", resp.GetDirection(), order.Buy)
+	}
+	if resp.GetReason() != "inputs as expected" {
+		t.Errorf("received '%v' expected '%v'", resp.GetReason(), "inputs as expected")
+	}
+	if resp.GetOrderType() != order.Limit {
+		t.Errorf("received '%v' expected '%v'", resp.GetOrderType(), order.Limit)
+	}
+	if !resp.GetLimitPrice().Equal(decimal.NewFromFloat(2.5)) {
+		t.Errorf("received '%v' expected '%v'", resp.GetLimitPrice(), 2.5)
+	}
+
+	_, err = s.OnSignal(loadData(t, currency.NewPair(currency.ETH, currency.USDT), 1), f, nil)
+	if !errors.Is(err, funding.ErrFundsNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, funding.ErrFundsNotFound)
+	}
+}
+
+func TestOnSignalLookback(t *testing.T) {
+	t.Parallel()
+	s := &Strategy{}
+	s.SetDefaults()
+	err := s.SetCustomSettings(map[string]interface{}{
+		scriptPathKey: writeScript(t, `
+if offset != 5 || len(ohlcv) != 2 || ohlcv[0][4] != 4.0 || ohlcv[1][4] != 5.0 {
+	signal = "unexpected candles"
+} else {
+	signal = "buy"
+}`),
+		scriptLookbackKey: 2.0,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := s.OnSignal(loadData(t, testPair, 1, 2, 3, 4, 5), nil, nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.GetDirection() != order.Buy {
+		t.Errorf("received '%v' expected '%v'", resp.GetDirection(), order.Buy)
+	}
+}
+
+func TestOnSignalScriptErrors(t *testing.T) {
+	t.Parallel()
+	d := loadData(t, testPair, 1)
+	s := loadStrategy(t, `x := 1`)
+	_, err := s.OnSignal(d, nil, nil)
+	if !errors.Is(err, errNoSignalReturned) {
+		t.Errorf("received '%v' expected '%v'", err, errNoSignalReturned)
+	}
+
+	s = loadStrategy(t, `signal = "moon"`)
+	_, err = s.OnSignal(d, nil, nil)
+	if !errors.Is(err, errInvalidSignal) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidSignal)
+	}
+
+	s = loadStrategy(t, `signal = {direction: "buy", "limit-price": "cheap"}`)
+	_, err = s.OnSignal(d, nil, nil)
+	if !errors.Is(err, errInvalidSignal) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidSignal)
+	}
+
+	s = loadStrategy(t, `signal = {direction: "buy", "limit-price": -1}`)
+	_, err = s.OnSignal(d, nil, nil)
+	if !errors.Is(err, errInvalidSignal) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidSignal)
+	}
+
+	s = loadStrategy(t, `signal = [1]`)
+	_, err = s.OnSignal(d, nil, nil)
+	if !errors.Is(err, errInvalidSignal) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidSignal)
+	}
+
+	s = loadStrategy(t, `signal = 1 / 0`)
+	_, err = s.OnSignal(d, nil, nil)
+	if err == nil {
+		t.Error("expected runtime error")
+	}
+
+	s = loadStrategy(t, `for {}`)
+	s.timeout = time.Millisecond
+	_, err = s.OnSignal(d, nil, nil)
+	if err == nil {
+		t.Error("expected timeout error")
+	}
+
+	s = loadStrategy(t, `signal = "HOLD"`)
+	resp, err := s.OnSignal(d, nil, nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.GetDirection() != common.DoNothing {
+		t.Errorf("received '%v' expected '%v'", resp.GetDirection(), common.DoNothing)
+	}
+}
+
+func TestOnSimultaneousSignals(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	_, err := s.OnSimultaneousSignals(nil, nil, nil)
+	if !errors.Is(err, errScriptNotLoaded) {
+		t.Errorf("received '%v' expected '%v'", err, errScriptNotLoaded)
+	}
+
+	s.SetDefaults()
+	err = s.SetCustomSettings(map[string]interface{}{scriptPathKey: exampleRSI, "rsi-period": 2.0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.OnSimultaneousSignals([]data.Handler{nil}, nil, nil)
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilEvent)
+	}
+
+	ethPair := currency.NewPair(currency.ETH, currency.USDT)
+	d := []data.Handler{
+		loadData(t, testPair, 5, 4, 3, 2, 1),
+		loadData(t, ethPair, 1, 2, 3, 4, 5),
+	}
+	resp, err := s.OnSimultaneousSignals(d, nil, nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(resp), 2)
+	}
+	if resp[0].GetDirection() != order.Buy || !resp[0].Pair().Equal(testPair) {
+		t.Errorf("received '%v %v' expected '%v %v'", resp[0].GetDirection(), resp[0].Pair(), order.Buy, testPair)
+	}
+	if resp[1].GetDirection() != order.Sell || !resp[1].Pair().Equal(ethPair) {
+		t.Errorf("received '%v %v' expected '%v %v'", resp[1].GetDirection(), resp[1].Pair(), order.Sell, ethPair)
+	}
+
+	s = *loadStrategy(t, `signals = ["buy"]`)
+	_, err = s.OnSimultaneousSignals(d, nil, nil)
+	if !errors.Is(err, errSignalCountDiffer) {
+		t.Errorf("received '%v' expected '%v'", err, errSignalCountDiffer)
+	}
+
+	s = *loadStrategy(t, `signal = "buy"`)
+	_, err = s.OnSimultaneousSignals(d, nil, nil)
+	if !errors.Is(err, errNoSignalReturned) {
+		t.Errorf("received '%v' expected '%v'", err, errNoSignalReturned)
+	}
+
+	s = *loadStrategy(t, `signals = ["buy", "moon"]`)
+	_, err = s.OnSimultaneousSignals(d, nil, nil)
+	if !strings.Contains(err.Error(), errInvalidSignal.Error()) {
+		// common.Errs type doesn't keep type
+		t.Errorf("received '%v' expected '%v'", err, errInvalidSignal)
+	}
+}
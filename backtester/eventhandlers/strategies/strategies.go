@@ -6,6 +6,7 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/dollarcostaverage"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/gctscript"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/rsi"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/top2bottom2"
 )
@@ -36,6 +37,7 @@ func LoadStrategyByName(name string, useSimultaneousProcessing bool) (Handler, e
 func GetStrategies() []Handler {
 	return []Handler{
 		new(dollarcostaverage.Strategy),
+		new(gctscript.Strategy),
 		new(rsi.Strategy),
 		new(top2bottom2.Strategy),
 	}
@@ -81,3 +81,14 @@ func (o *Order) SetLeverage(l decimal.Decimal) {
 func (o *Order) GetAllocatedFunds() decimal.Decimal {
 	return o.AllocatedFunds
 }
+
+// GetPrice returns the price the order was sized at
+// for limit orders this is the limit price
+func (o *Order) GetPrice() decimal.Decimal {
+	return o.Price
+}
+
+// GetOrderType returns the order type
+func (o *Order) GetOrderType() order.Type {
+	return o.OrderType
+}
@@ -84,3 +84,17 @@ func TestGetFunds(t *testing.T) {
 		t.Error("expected decimal.NewFromInt(1337)")
 	}
 }
+
+func TestGetPriceAndOrderType(t *testing.T) {
+	t.Parallel()
+	o := Order{
+		Price:     decimal.NewFromInt(1337),
+		OrderType: gctorder.Limit,
+	}
+	if !o.GetPrice().Equal(decimal.NewFromInt(1337)) {
+		t.Error("expected decimal.NewFromInt(1337)")
+	}
+	if o.GetOrderType() != gctorder.Limit {
+		t.Errorf("received '%v' expected '%v'", o.GetOrderType(), gctorder.Limit)
+	}
+}
@@ -36,4 +36,6 @@ type Event interface {
 	GetID() string
 	IsLeveraged() bool
 	GetAllocatedFunds() decimal.Decimal
+	GetPrice() decimal.Decimal
+	GetOrderType() order.Type
 }
@@ -55,3 +55,22 @@ func (s *Signal) GetPrice() decimal.Decimal {
 func (s *Signal) SetPrice(f decimal.Decimal) {
 	s.ClosePrice = f
 }
+
+// SetLimitOrder sets the signal to be placed as a limit order at the price provided
+func (s *Signal) SetLimitOrder(price decimal.Decimal) {
+	s.OrderType = order.Limit
+	s.LimitPrice = price
+}
+
+// GetOrderType returns the order type, defaulting to a market order
+func (s *Signal) GetOrderType() order.Type {
+	if s.OrderType == "" {
+		return order.Market
+	}
+	return s.OrderType
+}
+
+// GetLimitPrice returns the limit price
+func (s *Signal) GetLimitPrice() decimal.Decimal {
+	return s.LimitPrice
+}
@@ -56,3 +56,18 @@ func TestSetSellLimit(t *testing.T) {
 		t.Errorf("expected 20, received %v", s.GetSellLimit())
 	}
 }
+
+func TestSetLimitOrder(t *testing.T) {
+	t.Parallel()
+	s := Signal{}
+	if s.GetOrderType() != gctorder.Market {
+		t.Errorf("received '%v' expected '%v'", s.GetOrderType(), gctorder.Market)
+	}
+	s.SetLimitOrder(decimal.NewFromInt(1337))
+	if s.GetOrderType() != gctorder.Limit {
+		t.Errorf("received '%v' expected '%v'", s.GetOrderType(), gctorder.Limit)
+	}
+	if !s.GetLimitPrice().Equal(decimal.NewFromInt(1337)) {
+		t.Error("expected decimal.NewFromInt(1337)")
+	}
+}
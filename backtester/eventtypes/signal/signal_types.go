@@ -17,6 +17,8 @@ type Event interface {
 	IsSignal() bool
 	GetSellLimit() decimal.Decimal
 	GetBuyLimit() decimal.Decimal
+	GetOrderType() order.Type
+	GetLimitPrice() decimal.Decimal
 }
 
 // Signal contains everything needed for a strategy to raise a signal event
@@ -30,4 +32,8 @@ type Signal struct {
 	BuyLimit   decimal.Decimal
 	SellLimit  decimal.Decimal
 	Direction  order.Side
+	// OrderType defaults to a market order when unset
+	OrderType order.Type
+	// LimitPrice is the price a limit order will rest at
+	LimitPrice decimal.Decimal
 }
@@ -0,0 +1,23 @@
+package trade
+
+import "github.com/shopspring/decimal"
+
+// GetClosePrice returns the price of the trade
+func (t *Trade) GetClosePrice() decimal.Decimal {
+	return t.Price
+}
+
+// GetHighPrice returns the price of the trade
+func (t *Trade) GetHighPrice() decimal.Decimal {
+	return t.Price
+}
+
+// GetLowPrice returns the price of the trade
+func (t *Trade) GetLowPrice() decimal.Decimal {
+	return t.Price
+}
+
+// GetOpenPrice returns the price of the trade
+func (t *Trade) GetOpenPrice() decimal.Decimal {
+	return t.Price
+}
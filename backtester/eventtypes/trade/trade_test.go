@@ -0,0 +1,26 @@
+package trade
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestPrices(t *testing.T) {
+	t.Parallel()
+	tr := Trade{
+		Price: decimal.NewFromInt(1337),
+	}
+	if !tr.GetClosePrice().Equal(decimal.NewFromInt(1337)) {
+		t.Error("expected decimal.NewFromInt(1337)")
+	}
+	if !tr.GetHighPrice().Equal(decimal.NewFromInt(1337)) {
+		t.Error("expected decimal.NewFromInt(1337)")
+	}
+	if !tr.GetLowPrice().Equal(decimal.NewFromInt(1337)) {
+		t.Error("expected decimal.NewFromInt(1337)")
+	}
+	if !tr.GetOpenPrice().Equal(decimal.NewFromInt(1337)) {
+		t.Error("expected decimal.NewFromInt(1337)")
+	}
+}
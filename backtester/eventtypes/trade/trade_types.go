@@ -0,0 +1,17 @@
+package trade
+
+import (
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// Trade holds an individual trade print and an event to be processed as
+// a common.DataEventHandler type
+type Trade struct {
+	event.Base
+	Price  decimal.Decimal
+	Amount decimal.Decimal
+	Side   order.Side
+	TID    string
+}
@@ -71,27 +71,23 @@ func (f *FundManager) CreateSnapshot(t time.Time) {
 			f.items[i].snapshot = make(map[time.Time]ItemSnapshot)
 		}
 		iss := ItemSnapshot{
-			Available: f.items[i].available,
+			Available: f.itemEquity(i),
 			Time:      t,
 		}
 		if !f.disableUSDTracking {
-			var usdClosePrice decimal.Decimal
 			if f.items[i].usdTrackingCandles == nil {
 				continue
 			}
-			usdCandles := f.items[i].usdTrackingCandles.GetStream()
-			for j := range usdCandles {
-				if usdCandles[j].GetTime().Equal(t) {
-					usdClosePrice = usdCandles[j].GetClosePrice()
-					break
-				}
-			}
+			usdClosePrice := f.items[i].usdClosePrice(t)
 			iss.USDClosePrice = usdClosePrice
-			iss.USDValue = usdClosePrice.Mul(f.items[i].available)
+			iss.USDValue = usdClosePrice.Mul(iss.Available)
 		}
 
 		f.items[i].snapshot[t] = iss
 	}
+	for i := range f.positions {
+		f.positions[i].createSnapshot(t)
+	}
 }
 
 // AddUSDTrackingData adds USD tracking data to a funding item
@@ -207,13 +203,13 @@ func (f *FundManager) GenerateReport() *Report {
 			Currency:     f.items[i].currency,
 			InitialFunds: f.items[i].initialFunds,
 			TransferFee:  f.items[i].transferFee,
-			FinalFunds:   f.items[i].available,
+			FinalFunds:   f.itemEquity(i),
 		}
 		if !f.disableUSDTracking &&
 			f.items[i].usdTrackingCandles != nil {
 			usdStream := f.items[i].usdTrackingCandles.GetStream()
 			item.USDInitialFunds = f.items[i].initialFunds.Mul(usdStream[0].GetClosePrice())
-			item.USDFinalFunds = f.items[i].available.Mul(usdStream[len(usdStream)-1].GetClosePrice())
+			item.USDFinalFunds = item.FinalFunds.Mul(usdStream[len(usdStream)-1].GetClosePrice())
 			item.USDInitialCostForOne = usdStream[0].GetClosePrice()
 			item.USDFinalCostForOne = usdStream[len(usdStream)-1].GetClosePrice()
 			item.USDPairCandle = f.items[i].usdTrackingCandles
@@ -237,7 +233,7 @@ func (f *FundManager) GenerateReport() *Report {
 		if f.items[i].initialFunds.IsZero() {
 			item.ShowInfinite = true
 		} else {
-			item.Difference = f.items[i].available.Sub(f.items[i].initialFunds).Div(f.items[i].initialFunds).Mul(decimal.NewFromInt(100))
+			item.Difference = item.FinalFunds.Sub(f.items[i].initialFunds).Div(f.items[i].initialFunds).Mul(decimal.NewFromInt(100))
 		}
 		if f.items[i].pairedWith != nil {
 			item.PairedWith = f.items[i].pairedWith.currency
@@ -246,6 +242,15 @@ func (f *FundManager) GenerateReport() *Report {
 		items = append(items, item)
 	}
 	report.Items = items
+	for i := range f.positions {
+		report.Positions = append(report.Positions, f.positions[i].report())
+	}
+	for i := range f.sleeves {
+		for j := range f.sleeves[i].funds.positions {
+			report.Positions = append(report.Positions, f.sleeves[i].funds.positions[j].report())
+		}
+	}
+	report.SleeveTransfers = f.sleeveTransfers
 	return &report
 }
 
@@ -293,6 +298,9 @@ func (f *FundManager) Transfer(amount decimal.Decimal, sender, receiver *Item, i
 
 // AddItem appends a new funding item. Will reject if exists by exchange asset currency
 func (f *FundManager) AddItem(item *Item) error {
+	if len(f.sleeves) > 0 {
+		return errSleevesCreated
+	}
 	if f.Exists(item) {
 		return fmt.Errorf("cannot add item %v %v %v %w", item.exchange, item.asset, item.currency, ErrAlreadyExists)
 	}
@@ -313,6 +321,9 @@ func (f *FundManager) Exists(item *Item) bool {
 
 // AddPair adds a pair to the fund manager if it does not exist
 func (f *FundManager) AddPair(p *Pair) error {
+	if len(f.sleeves) > 0 {
+		return errSleevesCreated
+	}
 	if f.Exists(p.Base) {
 		return fmt.Errorf("%w %v", ErrAlreadyExists, p.Base)
 	}
@@ -361,6 +372,7 @@ func (f *FundManager) GetFundingForEAP(exch string, a asset.Item, p currency.Pai
 	if resp.Quote == nil {
 		return nil, fmt.Errorf("quote %w", ErrFundsNotFound)
 	}
+	resp.position = f.getPosition(exch, a, p)
 	return &resp, nil
 }
 
@@ -390,8 +402,12 @@ func (p *Pair) QuoteAvailable() decimal.Decimal {
 
 // Reserve allocates an amount of funds to be used at a later time
 // it prevents multiple events from claiming the same resource
-// changes which currency to affect based on the order side
+// changes which currency to affect based on the order side.
+// Leveraged positions reserve margin from the quote collateral on either side
 func (p *Pair) Reserve(amount decimal.Decimal, side order.Side) error {
+	if p.position != nil && (side == order.Buy || side == order.Sell) {
+		return p.Quote.Reserve(amount)
+	}
 	switch side {
 	case order.Buy:
 		return p.Quote.Reserve(amount)
@@ -409,8 +425,12 @@ func (p *Pair) Reserve(amount decimal.Decimal, side order.Side) error {
 
 // Release reduces the amount of funding reserved and adds any difference
 // back to the available amount
-// changes which currency to affect based on the order side
+// changes which currency to affect based on the order side.
+// Leveraged positions release margin to the quote collateral on either side
 func (p *Pair) Release(amount, diff decimal.Decimal, side order.Side) error {
+	if p.position != nil && (side == order.Buy || side == order.Sell) {
+		return p.Quote.Release(amount, diff)
+	}
 	switch side {
 	case order.Buy:
 		return p.Quote.Release(amount, diff)
@@ -427,8 +447,12 @@ func (p *Pair) Release(amount, diff decimal.Decimal, side order.Side) error {
 }
 
 // IncreaseAvailable adds funding to the available amount
-// changes which currency to affect based on the order side
+// changes which currency to affect based on the order side.
+// Leveraged position fills are applied to the position instead
 func (p *Pair) IncreaseAvailable(amount decimal.Decimal, side order.Side) {
+	if p.position != nil {
+		return
+	}
 	switch side {
 	case order.Buy:
 		p.Base.IncreaseAvailable(amount)
@@ -439,8 +463,13 @@ func (p *Pair) IncreaseAvailable(amount decimal.Decimal, side order.Side) {
 
 // CanPlaceOrder does a > 0 check to see if there are any funds
 // to place an order with
-// changes which currency to affect based on the order side
+// changes which currency to affect based on the order side.
+// Leveraged positions can place orders on either side with collateral
+// available, or when the order would reduce the position
 func (p *Pair) CanPlaceOrder(side order.Side) bool {
+	if p.position != nil && (side == order.Buy || side == order.Sell) {
+		return p.Quote.CanPlaceOrder() || p.position.ReducibleAmount(side).IsPositive()
+	}
 	switch side {
 	case order.Buy:
 		return p.Quote.CanPlaceOrder()
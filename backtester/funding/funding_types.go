@@ -17,6 +17,9 @@ type FundManager struct {
 	usingExchangeLevelFunding bool
 	disableUSDTracking        bool
 	items                     []*Item
+	positions                 []*Position
+	sleeves                   []*sleeve
+	sleeveTransfers           []SleeveTransfer
 }
 
 // IFundingManager limits funding usage for portfolio event handling
@@ -31,6 +34,8 @@ type IFundingManager interface {
 	AddUSDTrackingData(*kline.DataFromKline) error
 	CreateSnapshot(time.Time)
 	USDTrackingDisabled() bool
+	UpdatePosition(common.DataEventHandler) error
+	RebalanceSleeves(time.Time) error
 }
 
 // IFundTransferer allows for funding amounts to be transferred
@@ -50,6 +55,7 @@ type IPairReader interface {
 	QuoteInitialFunds() decimal.Decimal
 	BaseAvailable() decimal.Decimal
 	QuoteAvailable() decimal.Decimal
+	GetPosition() *Position
 }
 
 // IPairReserver limits funding usage for portfolio event handling
@@ -63,6 +69,7 @@ type IPairReserver interface {
 type IPairReleaser interface {
 	IncreaseAvailable(decimal.Decimal, order.Side)
 	Release(decimal.Decimal, decimal.Decimal, order.Side) error
+	GetPosition() *Position
 }
 
 // Item holds funding data per currency item
@@ -79,10 +86,84 @@ type Item struct {
 	snapshot           map[time.Time]ItemSnapshot
 }
 
+// sleeve holds the funding allocated to a strategy which is run alongside
+// other strategies
+type sleeve struct {
+	name   string
+	weight decimal.Decimal
+	funds  *FundManager
+}
+
+// SleeveTransfer records funds moved between sleeves when rebalancing
+type SleeveTransfer struct {
+	Time     time.Time
+	From     string
+	To       string
+	Exchange string
+	Asset    asset.Item
+	Currency currency.Code
+	Amount   decimal.Decimal
+	USDValue decimal.Decimal
+}
+
 // Pair holds two currencies that are associated with each other
+// position is only set for leveraged assets, where the quote item
+// is used as collateral
 type Pair struct {
-	Base  *Item
-	Quote *Item
+	Base     *Item
+	Quote    *Item
+	position *Position
+}
+
+// Position simulates an isolated margin futures, perpetual or margin position
+// for an exchange, asset and pair. Margin is taken from the collateral item
+// when the position is opened or increased and is returned along with any
+// realised profit or loss when the position is reduced. Size is signed,
+// positive for long positions and negative for short positions
+type Position struct {
+	exchange              string
+	asset                 asset.Item
+	pair                  currency.Pair
+	collateral            *Item
+	leverage              decimal.Decimal
+	maintenanceMarginRate decimal.Decimal
+	fundingRate           decimal.Decimal
+	fundingInterval       time.Duration
+	nextFunding           time.Time
+	size                  decimal.Decimal
+	entryPrice            decimal.Decimal
+	markPrice             decimal.Decimal
+	margin                decimal.Decimal
+	realisedPNL           decimal.Decimal
+	unrealisedPNL         decimal.Decimal
+	fees                  decimal.Decimal
+	funding               decimal.Decimal
+	liquidations          int64
+	liquidationLosses     decimal.Decimal
+	lastUpdated           time.Time
+	snapshot              map[time.Time]PositionSnapshot
+}
+
+// PositionSnapshot holds the state of a position at a point in time.
+// Profit and loss, fees and funding are denominated in the collateral currency
+type PositionSnapshot struct {
+	Time             time.Time
+	Size             decimal.Decimal
+	EntryPrice       decimal.Decimal
+	MarkPrice        decimal.Decimal
+	Margin           decimal.Decimal
+	LiquidationPrice decimal.Decimal
+	// RealisedPNL excludes fees, funding and liquidation losses
+	RealisedPNL   decimal.Decimal
+	UnrealisedPNL decimal.Decimal
+	Fees          decimal.Decimal
+	// Funding is positive when funding has been received and negative
+	// when paid
+	Funding decimal.Decimal
+	// Liquidations is the number of times the position has been liquidated
+	// and LiquidationLosses is the remaining margin forfeited when it was
+	Liquidations      int64
+	LiquidationLosses decimal.Decimal
 }
 
 // Report holds all funding data for result reporting
@@ -90,7 +171,24 @@ type Report struct {
 	DisableUSDTracking        bool
 	UsingExchangeLevelFunding bool
 	Items                     []ReportItem
+	Positions                 []PositionReport
 	USDTotalsOverTime         map[time.Time]ItemSnapshot
+	SleeveTransfers           []SleeveTransfer
+}
+
+// PositionReport holds the settings, final state and snapshots of a
+// leveraged position for result reporting
+type PositionReport struct {
+	Exchange              string
+	Asset                 asset.Item
+	Pair                  currency.Pair
+	Collateral            currency.Code
+	Leverage              decimal.Decimal
+	MaintenanceMarginRate decimal.Decimal
+	FundingRate           decimal.Decimal
+	FundingInterval       time.Duration
+	Final                 PositionSnapshot
+	Snapshots             []PositionSnapshot
 }
 
 // ReportItem holds reporting fields
@@ -114,7 +212,8 @@ type ReportItem struct {
 }
 
 // ItemSnapshot holds USD values to allow for tracking
-// across backtesting results
+// across backtesting results. Available includes the equity of any
+// open positions collateralised by the item
 type ItemSnapshot struct {
 	Time          time.Time
 	Available     decimal.Decimal
@@ -0,0 +1,393 @@
+package funding
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var (
+	// ErrNotLeveragedAsset used when attempting to simulate a position for an
+	// asset which is not a futures or margin asset
+	ErrNotLeveragedAsset        = errors.New("asset does not support leveraged positions")
+	errInvalidLeverage          = errors.New("leverage must be greater than or equal to 1")
+	errInvalidMaintenanceMargin = errors.New("maintenance margin rate must be positive and less than the initial margin rate")
+	errInvalidFundingInterval   = errors.New("funding interval must not be negative")
+	errCollateralUnset          = errors.New("position collateral unset")
+	errInvalidPositionSide      = errors.New("invalid position side")
+)
+
+// CreatePosition creates a position to simulate leveraged trading for an
+// exchange, asset and pair. The initial margin rate is the inverse of the
+// leverage and the position is liquidated once its equity falls to the
+// maintenance margin. When the funding interval is set, a funding payment of
+// the position's notional value multiplied by the funding rate is paid by long
+// positions to short positions every interval, negative rates are paid by
+// short positions
+func CreatePosition(exch string, a asset.Item, p currency.Pair, leverage, maintenanceMarginRate, fundingRate decimal.Decimal, fundingInterval time.Duration) (*Position, error) {
+	if !a.IsFutures() && a != asset.Margin {
+		return nil, fmt.Errorf("%v %v %v %w", exch, a, p, ErrNotLeveragedAsset)
+	}
+	if leverage.LessThan(decimal.NewFromInt(1)) {
+		return nil, fmt.Errorf("%v %v %v %w received: %v", exch, a, p, errInvalidLeverage, leverage)
+	}
+	if maintenanceMarginRate.IsNegative() ||
+		maintenanceMarginRate.GreaterThanOrEqual(decimal.NewFromInt(1).Div(leverage)) {
+		return nil, fmt.Errorf("%v %v %v %w received: %v", exch, a, p, errInvalidMaintenanceMargin, maintenanceMarginRate)
+	}
+	if fundingInterval < 0 {
+		return nil, fmt.Errorf("%v %v %v %w received: %v", exch, a, p, errInvalidFundingInterval, fundingInterval)
+	}
+	return &Position{
+		exchange:              exch,
+		asset:                 a,
+		pair:                  p,
+		leverage:              leverage,
+		maintenanceMarginRate: maintenanceMarginRate,
+		fundingRate:           fundingRate,
+		fundingInterval:       fundingInterval,
+		snapshot:              make(map[time.Time]PositionSnapshot),
+	}, nil
+}
+
+// AddPosition adds a position to the fund manager, using the quote currency
+// funding of the position's pair as its collateral
+func (f *FundManager) AddPosition(pos *Position) error {
+	if pos == nil {
+		return common.ErrNilArguments
+	}
+	if len(f.sleeves) > 0 {
+		return errSleevesCreated
+	}
+	if f.getPosition(pos.exchange, pos.asset, pos.pair) != nil {
+		return fmt.Errorf("position %v %v %v %w", pos.exchange, pos.asset, pos.pair, ErrAlreadyExists)
+	}
+	pair, err := f.GetFundingForEAP(pos.exchange, pos.asset, pos.pair)
+	if err != nil {
+		return err
+	}
+	pos.collateral = pair.Quote
+	f.positions = append(f.positions, pos)
+	return nil
+}
+
+// UpdatePosition marks the position for the data event's exchange, asset and
+// pair to the latest price, liquidating it if its maintenance margin has been
+// breached and settling any funding payments due. It does nothing when there
+// is no position for the data event
+func (f *FundManager) UpdatePosition(ev common.DataEventHandler) error {
+	if ev == nil {
+		return common.ErrNilEvent
+	}
+	pos := f.getPosition(ev.GetExchange(), ev.GetAssetType(), ev.Pair())
+	if pos == nil {
+		return nil
+	}
+	return pos.Update(ev.GetTime(), ev.GetHighPrice(), ev.GetLowPrice(), ev.GetClosePrice())
+}
+
+// getPosition returns the position for an exchange, asset and pair, nil
+// if there is none
+func (f *FundManager) getPosition(exch string, a asset.Item, p currency.Pair) *Position {
+	for i := range f.positions {
+		if f.positions[i].exchange == exch &&
+			f.positions[i].asset == a &&
+			f.positions[i].pair.Equal(p) {
+			return f.positions[i]
+		}
+	}
+	return nil
+}
+
+// positionEquity returns the equity of all positions using the item as
+// collateral
+func (f *FundManager) positionEquity(item *Item) decimal.Decimal {
+	var equity decimal.Decimal
+	for i := range f.positions {
+		if f.positions[i].collateral == item {
+			equity = equity.Add(f.positions[i].Equity())
+		}
+	}
+	return equity
+}
+
+// GetPosition returns the leveraged position for the pair,
+// nil for non-leveraged assets
+func (p *Pair) GetPosition() *Position {
+	return p.position
+}
+
+// IsOpen returns whether the position has a size
+func (p *Position) IsOpen() bool {
+	return !p.size.IsZero()
+}
+
+// Leverage returns the leverage the position is opened with
+func (p *Position) Leverage() decimal.Decimal {
+	return p.leverage
+}
+
+// Equity returns the position's margin plus its unrealised profit or loss
+func (p *Position) Equity() decimal.Decimal {
+	return p.margin.Add(p.unrealisedPNL)
+}
+
+// ReducibleAmount returns the amount of an order on the side which would
+// reduce, rather than open or increase, the position
+func (p *Position) ReducibleAmount(side order.Side) decimal.Decimal {
+	if (side == order.Buy && p.size.IsNegative()) ||
+		(side == order.Sell && p.size.IsPositive()) {
+		return p.size.Abs()
+	}
+	return decimal.Zero
+}
+
+// AvailableToOrder returns the maximum amount which can be ordered on the side
+// at the price, closing the position and opening a new one with the remaining
+// collateral available
+func (p *Position) AvailableToOrder(side order.Side, price decimal.Decimal) decimal.Decimal {
+	amount := p.ReducibleAmount(side)
+	if price.IsPositive() && p.collateral != nil {
+		amount = amount.Add(p.collateral.available.Mul(p.leverage).Div(price))
+	}
+	return amount
+}
+
+// RequiredMargin returns the initial margin required to fill the amount on
+// the side at the price. Any amount which reduces the position requires no
+// margin
+func (p *Position) RequiredMargin(side order.Side, amount, price decimal.Decimal) decimal.Decimal {
+	opening := amount.Sub(p.ReducibleAmount(side))
+	if !opening.IsPositive() {
+		return decimal.Zero
+	}
+	return opening.Mul(price).Div(p.leverage)
+}
+
+// LiquidationPrice returns the price at which the position's equity falls
+// to its maintenance margin, zero when the position cannot be liquidated
+func (p *Position) LiquidationPrice() decimal.Decimal {
+	if p.size.IsZero() {
+		return decimal.Zero
+	}
+	one := decimal.NewFromInt(1)
+	rate := one.Sub(p.maintenanceMarginRate)
+	if p.size.IsNegative() {
+		rate = one.Add(p.maintenanceMarginRate)
+	}
+	// margin + size * (price - entry) = |size| * price * maintenance rate
+	price := p.size.Mul(p.entryPrice).Sub(p.margin).Div(p.size.Mul(rate))
+	if !price.IsPositive() {
+		return decimal.Zero
+	}
+	return price
+}
+
+// Fill applies an order fill to the position. Any amount reducing the
+// position realises profit or loss and returns its share of margin to the
+// collateral, any remaining amount opens or increases the position taking
+// margin from the collateral. The fee is paid from the collateral
+func (p *Position) Fill(side order.Side, amount, price, fee decimal.Decimal, t time.Time) error {
+	if p.collateral == nil {
+		return errCollateralUnset
+	}
+	if side != order.Buy && side != order.Sell {
+		return fmt.Errorf("%w %v", errInvalidPositionSide, side)
+	}
+	if !amount.IsPositive() || !price.IsPositive() {
+		return errZeroAmountReceived
+	}
+	requiredMargin := p.RequiredMargin(side, amount, price)
+	if requiredMargin.GreaterThan(p.collateral.available) {
+		return fmt.Errorf("%w for %v %v %v margin. Requested %v Available: %v",
+			errNotEnoughFunds,
+			p.exchange,
+			p.asset,
+			p.pair,
+			requiredMargin,
+			p.collateral.available)
+	}
+	reduce := decimal.Min(amount, p.ReducibleAmount(side))
+	if reduce.IsPositive() {
+		direction := decimal.NewFromInt(int64(p.size.Sign()))
+		pnl := reduce.Mul(price.Sub(p.entryPrice)).Mul(direction)
+		releasedMargin := p.margin.Mul(reduce).Div(p.size.Abs())
+		p.margin = p.margin.Sub(releasedMargin)
+		p.size = p.size.Sub(reduce.Mul(direction))
+		p.realisedPNL = p.realisedPNL.Add(pnl)
+		p.settle(releasedMargin.Add(pnl))
+		if p.size.IsZero() {
+			p.settle(p.margin)
+			p.margin = decimal.Zero
+			p.entryPrice = decimal.Zero
+		}
+	}
+	if opening := amount.Sub(reduce); opening.IsPositive() {
+		p.collateral.available = p.collateral.available.Sub(requiredMargin)
+		p.margin = p.margin.Add(requiredMargin)
+		p.entryPrice = p.size.Abs().Mul(p.entryPrice).Add(opening.Mul(price)).Div(p.size.Abs().Add(opening))
+		if side == order.Buy {
+			p.size = p.size.Add(opening)
+		} else {
+			p.size = p.size.Sub(opening)
+		}
+	}
+	if fee.IsPositive() {
+		p.fees = p.fees.Add(fee)
+		p.settle(fee.Neg())
+	}
+	p.mark(price, t)
+	return nil
+}
+
+// Update marks the position to the close price after checking whether the
+// high or low price breached the liquidation price, then settles any funding
+// payments due at the time
+func (p *Position) Update(t time.Time, high, low, closePrice decimal.Decimal) error {
+	if p.collateral == nil {
+		return errCollateralUnset
+	}
+	if liquidationPrice := p.LiquidationPrice(); liquidationPrice.IsPositive() {
+		if (p.size.IsPositive() && low.IsPositive() && low.LessThanOrEqual(liquidationPrice)) ||
+			(p.size.IsNegative() && high.GreaterThanOrEqual(liquidationPrice)) {
+			p.liquidate(liquidationPrice, t)
+		}
+	}
+	if closePrice.IsPositive() {
+		p.mark(closePrice, t)
+	}
+	if p.fundingInterval <= 0 || p.fundingRate.IsZero() {
+		return nil
+	}
+	if p.nextFunding.IsZero() {
+		p.nextFunding = t.Add(p.fundingInterval)
+		return nil
+	}
+	for !t.Before(p.nextFunding) {
+		if p.IsOpen() && p.markPrice.IsPositive() {
+			payment := p.size.Mul(p.markPrice).Mul(p.fundingRate).Neg()
+			p.funding = p.funding.Add(payment)
+			p.settle(payment)
+		}
+		p.nextFunding = p.nextFunding.Add(p.fundingInterval)
+	}
+	// funding payments taken from margin can breach the maintenance margin
+	if p.IsOpen() && p.Equity().LessThanOrEqual(p.maintenanceMargin()) {
+		p.liquidate(p.markPrice, t)
+	}
+	return nil
+}
+
+// Snapshot returns the current state of the position
+func (p *Position) Snapshot() PositionSnapshot {
+	return PositionSnapshot{
+		Time:              p.lastUpdated,
+		Size:              p.size,
+		EntryPrice:        p.entryPrice,
+		MarkPrice:         p.markPrice,
+		Margin:            p.margin,
+		LiquidationPrice:  p.LiquidationPrice(),
+		RealisedPNL:       p.realisedPNL,
+		UnrealisedPNL:     p.unrealisedPNL,
+		Fees:              p.fees,
+		Funding:           p.funding,
+		Liquidations:      p.liquidations,
+		LiquidationLosses: p.liquidationLosses,
+	}
+}
+
+// createSnapshot stores the position's state at the time
+func (p *Position) createSnapshot(t time.Time) {
+	if p.snapshot == nil {
+		p.snapshot = make(map[time.Time]PositionSnapshot)
+	}
+	snap := p.Snapshot()
+	snap.Time = t
+	p.snapshot[t] = snap
+}
+
+// report builds the position's report data
+func (p *Position) report() PositionReport {
+	resp := PositionReport{
+		Exchange:              p.exchange,
+		Asset:                 p.asset,
+		Pair:                  p.pair,
+		Leverage:              p.leverage,
+		MaintenanceMarginRate: p.maintenanceMarginRate,
+		FundingRate:           p.fundingRate,
+		FundingInterval:       p.fundingInterval,
+		Final:                 p.Snapshot(),
+	}
+	if p.collateral != nil {
+		resp.Collateral = p.collateral.currency
+	}
+	for _, v := range p.snapshot {
+		resp.Snapshots = append(resp.Snapshots, v)
+	}
+	sort.Slice(resp.Snapshots, func(i, j int) bool {
+		return resp.Snapshots[i].Time.Before(resp.Snapshots[j].Time)
+	})
+	return resp
+}
+
+// maintenanceMargin returns the equity required to keep the position open at
+// the mark price
+func (p *Position) maintenanceMargin() decimal.Decimal {
+	return p.size.Abs().Mul(p.markPrice).Mul(p.maintenanceMarginRate)
+}
+
+// liquidate closes the position at the price, forfeiting any remaining margin
+func (p *Position) liquidate(price decimal.Decimal, t time.Time) {
+	direction := decimal.NewFromInt(int64(p.size.Sign()))
+	pnl := p.size.Abs().Mul(price.Sub(p.entryPrice)).Mul(direction)
+	p.realisedPNL = p.realisedPNL.Add(pnl)
+	remaining := p.margin.Add(pnl)
+	if remaining.IsPositive() {
+		p.liquidationLosses = p.liquidationLosses.Add(remaining)
+	} else {
+		// the price moved beyond the point of bankruptcy, the shortfall is
+		// taken from the collateral
+		p.settle(remaining)
+	}
+	p.liquidations++
+	p.size = decimal.Zero
+	p.margin = decimal.Zero
+	p.entryPrice = decimal.Zero
+	p.unrealisedPNL = decimal.Zero
+	p.markPrice = price
+	p.lastUpdated = t
+}
+
+// mark updates the unrealised profit or loss of the position at the price
+func (p *Position) mark(price decimal.Decimal, t time.Time) {
+	p.markPrice = price
+	p.lastUpdated = t
+	if p.size.IsZero() {
+		p.unrealisedPNL = decimal.Zero
+		return
+	}
+	p.unrealisedPNL = p.size.Mul(price.Sub(p.entryPrice))
+}
+
+// settle pays a positive amount into the collateral, or takes a negative
+// amount from it. When the collateral cannot cover the amount, the shortfall
+// is taken from the position's margin
+func (p *Position) settle(amount decimal.Decimal) {
+	if amount.IsZero() {
+		return
+	}
+	p.collateral.available = p.collateral.available.Add(amount)
+	if !p.collateral.available.IsNegative() {
+		return
+	}
+	shortfall := p.collateral.available.Neg()
+	p.collateral.available = decimal.Zero
+	p.margin = p.margin.Sub(decimal.Min(shortfall, p.margin))
+}
@@ -0,0 +1,294 @@
+package funding
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var (
+	futuresAsset = asset.PerpetualSwap
+	futuresPair  = currency.NewPair(currency.BTC, currency.USDT)
+)
+
+// positionSetup creates a fund manager with collateral and a leveraged
+// position for the futures pair
+func positionSetup(t *testing.T, collateral, leverage, maintenanceMarginRate, fundingRate decimal.Decimal, fundingInterval time.Duration) (*FundManager, *Position) {
+	t.Helper()
+	f := SetupFundingManager(false, true)
+	baseItem, err := CreateItem(exch, futuresAsset, futuresPair.Base, decimal.Zero, decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	quoteItem, err := CreateItem(exch, futuresAsset, futuresPair.Quote, collateral, decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := CreatePair(baseItem, quoteItem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = f.AddPair(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pos, err := CreatePosition(exch, futuresAsset, futuresPair, leverage, maintenanceMarginRate, fundingRate, fundingInterval)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = f.AddPosition(pos)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f, pos
+}
+
+func TestCreatePosition(t *testing.T) {
+	t.Parallel()
+	ten := decimal.NewFromInt(10)
+	_, err := CreatePosition(exch, asset.Spot, futuresPair, ten, decimal.Zero, decimal.Zero, 0)
+	if !errors.Is(err, ErrNotLeveragedAsset) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNotLeveragedAsset)
+	}
+	_, err = CreatePosition(exch, futuresAsset, futuresPair, decimal.NewFromFloat(0.5), decimal.Zero, decimal.Zero, 0)
+	if !errors.Is(err, errInvalidLeverage) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidLeverage)
+	}
+	_, err = CreatePosition(exch, futuresAsset, futuresPair, ten, decimal.NewFromFloat(0.1), decimal.Zero, 0)
+	if !errors.Is(err, errInvalidMaintenanceMargin) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidMaintenanceMargin)
+	}
+	_, err = CreatePosition(exch, futuresAsset, futuresPair, ten, neg, decimal.Zero, 0)
+	if !errors.Is(err, errInvalidMaintenanceMargin) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidMaintenanceMargin)
+	}
+	_, err = CreatePosition(exch, futuresAsset, futuresPair, ten, decimal.Zero, decimal.Zero, -time.Hour)
+	if !errors.Is(err, errInvalidFundingInterval) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidFundingInterval)
+	}
+	_, err = CreatePosition(exch, asset.Margin, futuresPair, ten, decimal.NewFromFloat(0.05), decimal.Zero, 0)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+}
+
+func TestAddPosition(t *testing.T) {
+	t.Parallel()
+	f, pos := positionSetup(t, decimal.NewFromInt(1000), decimal.NewFromInt(10), decimal.NewFromFloat(0.05), decimal.Zero, 0)
+	err := f.AddPosition(nil)
+	if !errors.Is(err, common.ErrNilArguments) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilArguments)
+	}
+	err = f.AddPosition(pos)
+	if !errors.Is(err, ErrAlreadyExists) {
+		t.Errorf("received '%v' expected '%v'", err, ErrAlreadyExists)
+	}
+	unfunded, err := CreatePosition(exch, futuresAsset, currency.NewPair(currency.ETH, currency.USDT), decimal.NewFromInt(2), decimal.Zero, decimal.Zero, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = f.AddPosition(unfunded)
+	if !errors.Is(err, ErrFundsNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, ErrFundsNotFound)
+	}
+
+	p, err := f.GetFundingForEAP(exch, futuresAsset, futuresPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.GetPosition() != pos {
+		t.Fatal("expected position to be attached to funding pair")
+	}
+	if pos.collateral != p.Quote {
+		t.Error("expected quote item to be used as collateral")
+	}
+	if !p.CanPlaceOrder(gctorder.Sell) {
+		t.Error("expected leveraged pair to be able to sell without base funds")
+	}
+	err = p.Reserve(decimal.NewFromInt(100), gctorder.Sell)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.Quote.reserved.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("received '%v' expected '%v'", p.Quote.reserved, 100)
+	}
+	err = p.Release(decimal.NewFromInt(100), decimal.NewFromInt(100), gctorder.Sell)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.QuoteAvailable().Equal(decimal.NewFromInt(1000)) {
+		t.Errorf("received '%v' expected '%v'", p.QuoteAvailable(), 1000)
+	}
+	p.IncreaseAvailable(decimal.NewFromInt(1), gctorder.Buy)
+	if !p.BaseAvailable().IsZero() {
+		t.Error("expected leveraged pair base to remain unfunded")
+	}
+}
+
+func TestPositionFill(t *testing.T) {
+	t.Parallel()
+	_, pos := positionSetup(t, decimal.NewFromInt(1000), decimal.NewFromInt(10), decimal.NewFromFloat(0.05), decimal.Zero, 0)
+	tt := time.Now()
+	err := pos.Fill(gctorder.AnySide, one, decimal.NewFromInt(100), decimal.Zero, tt)
+	if !errors.Is(err, errInvalidPositionSide) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidPositionSide)
+	}
+	err = pos.Fill(gctorder.Buy, decimal.NewFromInt(1000), decimal.NewFromInt(100), decimal.Zero, tt)
+	if !errors.Is(err, errNotEnoughFunds) {
+		t.Errorf("received '%v' expected '%v'", err, errNotEnoughFunds)
+	}
+
+	// open a long of 1 at 100 with 10 margin
+	err = pos.Fill(gctorder.Buy, one, decimal.NewFromInt(100), decimal.Zero, tt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pos.margin.Equal(decimal.NewFromInt(10)) || !pos.collateral.available.Equal(decimal.NewFromInt(990)) {
+		t.Errorf("received margin '%v' available '%v' expected '%v' '%v'", pos.margin, pos.collateral.available, 10, 990)
+	}
+	if req := pos.RequiredMargin(gctorder.Sell, decimal.NewFromInt(3), decimal.NewFromInt(100)); !req.Equal(decimal.NewFromInt(20)) {
+		t.Errorf("received '%v' expected '%v'", req, 20)
+	}
+	if avail := pos.AvailableToOrder(gctorder.Sell, decimal.NewFromInt(100)); !avail.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("received '%v' expected '%v'", avail, 100)
+	}
+
+	err = pos.Update(tt, decimal.NewFromInt(110), decimal.NewFromInt(100), decimal.NewFromInt(110))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pos.unrealisedPNL.Equal(decimal.NewFromInt(10)) || !pos.Equity().Equal(decimal.NewFromInt(20)) {
+		t.Errorf("received unrealised '%v' equity '%v' expected '%v' '%v'", pos.unrealisedPNL, pos.Equity(), 10, 20)
+	}
+
+	// reduce by half, realising 5 and releasing 5 margin with a 1 fee
+	err = pos.Fill(gctorder.Sell, decimal.NewFromFloat(0.5), decimal.NewFromInt(110), one, tt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pos.realisedPNL.Equal(decimal.NewFromInt(5)) || !pos.collateral.available.Equal(decimal.NewFromInt(999)) {
+		t.Errorf("received realised '%v' available '%v' expected '%v' '%v'", pos.realisedPNL, pos.collateral.available, 5, 999)
+	}
+
+	// flip to a short of 0.5 at 120
+	err = pos.Fill(gctorder.Sell, one, decimal.NewFromInt(120), decimal.Zero, tt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pos.size.Equal(decimal.NewFromFloat(-0.5)) || !pos.entryPrice.Equal(decimal.NewFromInt(120)) {
+		t.Errorf("received size '%v' entry '%v' expected '%v' '%v'", pos.size, pos.entryPrice, -0.5, 120)
+	}
+	if !pos.realisedPNL.Equal(decimal.NewFromInt(15)) || !pos.margin.Equal(decimal.NewFromInt(6)) {
+		t.Errorf("received realised '%v' margin '%v' expected '%v' '%v'", pos.realisedPNL, pos.margin, 15, 6)
+	}
+	if !pos.collateral.available.Equal(decimal.NewFromInt(1008)) {
+		t.Errorf("received '%v' expected '%v'", pos.collateral.available, 1008)
+	}
+	snap := pos.Snapshot()
+	if !snap.Fees.Equal(one) || !snap.UnrealisedPNL.IsZero() {
+		t.Errorf("received fees '%v' unrealised '%v' expected '%v' '%v'", snap.Fees, snap.UnrealisedPNL, 1, 0)
+	}
+}
+
+func TestPositionLiquidation(t *testing.T) {
+	t.Parallel()
+	_, pos := positionSetup(t, decimal.NewFromInt(1000), decimal.NewFromInt(10), decimal.NewFromFloat(0.05), decimal.Zero, 0)
+	tt := time.Now()
+	hundred := decimal.NewFromInt(100)
+	err := pos.Fill(gctorder.Buy, one, hundred, decimal.Zero, tt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// (100 - 10) / 0.95
+	expectedPrice := decimal.NewFromInt(90).Div(decimal.NewFromFloat(0.95))
+	if !pos.LiquidationPrice().Equal(expectedPrice) {
+		t.Errorf("received '%v' expected '%v'", pos.LiquidationPrice(), expectedPrice)
+	}
+	err = pos.Update(tt, hundred, decimal.NewFromInt(95), decimal.NewFromInt(96))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pos.IsOpen() {
+		t.Fatal("expected position to remain open above liquidation price")
+	}
+	err = pos.Update(tt, hundred, decimal.NewFromInt(94), decimal.NewFromInt(96))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pos.IsOpen() || pos.liquidations != 1 {
+		t.Fatalf("received open '%v' liquidations '%v' expected '%v' '%v'", pos.IsOpen(), pos.liquidations, false, 1)
+	}
+	if !pos.collateral.available.Equal(decimal.NewFromInt(990)) {
+		t.Errorf("received '%v' expected '%v'", pos.collateral.available, 990)
+	}
+	if !pos.liquidationLosses.Add(pos.realisedPNL.Neg()).Round(8).Equal(decimal.NewFromInt(10)) {
+		t.Errorf("expected the full margin of 10 to be lost, received liquidation losses '%v' realised '%v'", pos.liquidationLosses, pos.realisedPNL)
+	}
+
+	// short of 1 at 100 is liquidated above (100 + 10) / 1.05
+	err = pos.Fill(gctorder.Sell, one, hundred, decimal.Zero, tt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedPrice = decimal.NewFromInt(110).Div(decimal.NewFromFloat(1.05))
+	if !pos.LiquidationPrice().Equal(expectedPrice) {
+		t.Errorf("received '%v' expected '%v'", pos.LiquidationPrice(), expectedPrice)
+	}
+	err = pos.Update(tt, decimal.NewFromInt(105), hundred, hundred)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pos.IsOpen() || pos.liquidations != 2 {
+		t.Errorf("received open '%v' liquidations '%v' expected '%v' '%v'", pos.IsOpen(), pos.liquidations, false, 2)
+	}
+}
+
+func TestPositionFunding(t *testing.T) {
+	t.Parallel()
+	f, pos := positionSetup(t, decimal.NewFromInt(1000), decimal.NewFromInt(2), decimal.NewFromFloat(0.1), decimal.NewFromFloat(0.01), time.Hour*8)
+	tt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	hundred := decimal.NewFromInt(100)
+	err := pos.Fill(gctorder.Buy, one, hundred, decimal.Zero, tt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = pos.Update(tt, hundred, hundred, hundred)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pos.funding.IsZero() {
+		t.Errorf("received '%v' expected '%v'", pos.funding, 0)
+	}
+	// two funding intervals have elapsed, the long pays 1 each interval
+	err = pos.Update(tt.Add(time.Hour*16), hundred, hundred, hundred)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pos.funding.Equal(decimal.NewFromInt(-2)) {
+		t.Errorf("received '%v' expected '%v'", pos.funding, -2)
+	}
+	if !pos.collateral.available.Equal(decimal.NewFromInt(948)) {
+		t.Errorf("received '%v' expected '%v'", pos.collateral.available, 948)
+	}
+
+	f.CreateSnapshot(tt)
+	report := f.GenerateReport()
+	if len(report.Positions) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(report.Positions), 1)
+	}
+	if !report.Positions[0].Final.Funding.Equal(decimal.NewFromInt(-2)) {
+		t.Errorf("received '%v' expected '%v'", report.Positions[0].Final.Funding, -2)
+	}
+	// final funds include the margin held by the open position
+	for i := range report.Items {
+		if report.Items[i].Currency == futuresPair.Quote && !report.Items[i].FinalFunds.Equal(decimal.NewFromInt(998)) {
+			t.Errorf("received '%v' expected '%v'", report.Items[i].FinalFunds, 998)
+		}
+	}
+}
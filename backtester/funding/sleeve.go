@@ -0,0 +1,181 @@
+package funding
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	errInvalidSleeveWeight = errors.New("sleeve weight must be greater than zero and no more than one")
+	errSleevesCreated      = errors.New("funding cannot be added once sleeves have been created")
+)
+
+// CreateSleeve allocates the weight of every funding item to a new fund
+// manager, along with a copy of every position, for a strategy which is run
+// alongside other strategies. Sleeves must be created after all funding,
+// positions and USD tracking data have been added. Once sleeves are created,
+// snapshots and reports of the fund manager combine the funding of all sleeves
+func (f *FundManager) CreateSleeve(name string, weight decimal.Decimal) (*FundManager, error) {
+	if weight.LessThanOrEqual(decimal.Zero) || weight.GreaterThan(decimal.NewFromInt(1)) {
+		return nil, fmt.Errorf("%v %w received: %v", name, errInvalidSleeveWeight, weight)
+	}
+	for i := range f.sleeves {
+		if strings.EqualFold(f.sleeves[i].name, name) {
+			return nil, fmt.Errorf("sleeve %v %w", name, ErrAlreadyExists)
+		}
+	}
+	resp := &FundManager{
+		usingExchangeLevelFunding: f.usingExchangeLevelFunding,
+		disableUSDTracking:        f.disableUSDTracking,
+		items:                     make([]*Item, len(f.items)),
+	}
+	// items keep the same order across sleeves so they can be matched by index
+	lookup := make(map[*Item]*Item, len(f.items))
+	for i := range f.items {
+		funds := f.items[i].initialFunds.Mul(weight)
+		resp.items[i] = &Item{
+			exchange:           f.items[i].exchange,
+			asset:              f.items[i].asset,
+			currency:           f.items[i].currency,
+			initialFunds:       funds,
+			available:          funds,
+			transferFee:        f.items[i].transferFee,
+			usdTrackingCandles: f.items[i].usdTrackingCandles,
+			snapshot:           make(map[time.Time]ItemSnapshot),
+		}
+		lookup[f.items[i]] = resp.items[i]
+	}
+	for i := range f.items {
+		if f.items[i].pairedWith != nil {
+			resp.items[i].pairedWith = lookup[f.items[i].pairedWith]
+		}
+	}
+	for i := range f.positions {
+		pos, err := CreatePosition(f.positions[i].exchange,
+			f.positions[i].asset,
+			f.positions[i].pair,
+			f.positions[i].leverage,
+			f.positions[i].maintenanceMarginRate,
+			f.positions[i].fundingRate,
+			f.positions[i].fundingInterval)
+		if err != nil {
+			return nil, err
+		}
+		pos.collateral = lookup[f.positions[i].collateral]
+		resp.positions = append(resp.positions, pos)
+	}
+	f.sleeves = append(f.sleeves, &sleeve{
+		name:   name,
+		weight: weight,
+		funds:  resp,
+	})
+	return resp, nil
+}
+
+// RebalanceSleeves transfers available funds between sleeves so that each
+// sleeve's share of the combined USD value matches its weight. Funds reserved
+// for orders or used as margin are not transferred, nor are currencies without
+// a USD price at the time
+func (f *FundManager) RebalanceSleeves(t time.Time) error {
+	if len(f.sleeves) < 2 {
+		return nil
+	}
+	if f.disableUSDTracking {
+		return ErrUSDTrackingDisabled
+	}
+	prices := make([]decimal.Decimal, len(f.items))
+	for i := range f.items {
+		prices[i] = f.items[i].usdClosePrice(t)
+	}
+	values := make([]decimal.Decimal, len(f.sleeves))
+	var total, totalWeight decimal.Decimal
+	for i := range f.sleeves {
+		for j := range f.items {
+			values[i] = values[i].Add(f.sleeves[i].funds.itemEquity(j).Mul(prices[j]))
+		}
+		total = total.Add(values[i])
+		totalWeight = totalWeight.Add(f.sleeves[i].weight)
+	}
+	if total.IsZero() {
+		return nil
+	}
+	// differences are positive for sleeves above their target value
+	// and negative for sleeves below it
+	differences := make([]decimal.Decimal, len(f.sleeves))
+	for i := range f.sleeves {
+		differences[i] = values[i].Sub(total.Mul(f.sleeves[i].weight).Div(totalWeight))
+	}
+	for i := range f.sleeves {
+		for j := range f.items {
+			if !differences[i].IsPositive() {
+				break
+			}
+			if prices[j].IsZero() {
+				continue
+			}
+			sender := f.sleeves[i].funds.items[j]
+			for k := range f.sleeves {
+				if !differences[i].IsPositive() || !sender.available.IsPositive() {
+					break
+				}
+				if !differences[k].IsNegative() {
+					continue
+				}
+				usdValue := decimal.Min(differences[i], differences[k].Neg(), sender.available.Mul(prices[j]))
+				amount := decimal.Min(usdValue.Div(prices[j]), sender.available)
+				if !amount.IsPositive() {
+					continue
+				}
+				receiver := f.sleeves[k].funds.items[j]
+				sender.available = sender.available.Sub(amount)
+				receiver.available = receiver.available.Add(amount)
+				differences[i] = differences[i].Sub(usdValue)
+				differences[k] = differences[k].Add(usdValue)
+				f.sleeveTransfers = append(f.sleeveTransfers, SleeveTransfer{
+					Time:     t,
+					From:     f.sleeves[i].name,
+					To:       f.sleeves[k].name,
+					Exchange: sender.exchange,
+					Asset:    sender.asset,
+					Currency: sender.currency,
+					Amount:   amount,
+					USDValue: usdValue,
+				})
+			}
+		}
+	}
+	return nil
+}
+
+// itemEquity returns the item's available funds along with the equity of any
+// positions it collateralises. Once sleeves are created, it is the combined
+// equity of the item across all sleeves
+func (f *FundManager) itemEquity(i int) decimal.Decimal {
+	if len(f.sleeves) == 0 {
+		return f.items[i].available.Add(f.positionEquity(f.items[i]))
+	}
+	var resp decimal.Decimal
+	for j := range f.sleeves {
+		resp = resp.Add(f.sleeves[j].funds.itemEquity(i))
+	}
+	return resp
+}
+
+// usdClosePrice returns the item's USD tracking close price at the time, zero
+// when there is none
+func (i *Item) usdClosePrice(t time.Time) decimal.Decimal {
+	if i.usdTrackingCandles == nil {
+		return decimal.Zero
+	}
+	usdCandles := i.usdTrackingCandles.GetStream()
+	for j := range usdCandles {
+		if usdCandles[j].GetTime().Equal(t) {
+			return usdCandles[j].GetClosePrice()
+		}
+	}
+	return decimal.Zero
+}
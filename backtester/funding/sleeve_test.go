@@ -0,0 +1,171 @@
+package funding
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+var sleeveTime = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// usdCandles creates USD tracking data with a single candle at the close price
+func usdCandles(t *testing.T, c currency.Code, closePrice float64) *kline.DataFromKline {
+	t.Helper()
+	dfk := &kline.DataFromKline{
+		Item: gctkline.Item{
+			Exchange: exch,
+			Pair:     currency.NewPair(c, currency.USD),
+			Asset:    asset.Spot,
+			Interval: gctkline.OneDay,
+			Candles: []gctkline.Candle{
+				{
+					Time:  sleeveTime,
+					Close: closePrice,
+				},
+			},
+		},
+	}
+	err := dfk.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dfk
+}
+
+// sleeveSetup creates a fund manager with 1000 USDT and no BTC, with BTC
+// tracked at 100 USD
+func sleeveSetup(t *testing.T) *FundManager {
+	t.Helper()
+	f := SetupFundingManager(false, false)
+	baseItem, err := CreateItem(exch, asset.Spot, currency.BTC, decimal.Zero, decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	quoteItem, err := CreateItem(exch, asset.Spot, currency.USDT, decimal.NewFromInt(1000), decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := CreatePair(baseItem, quoteItem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = f.AddPair(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Base.usdTrackingCandles = usdCandles(t, currency.BTC, 100)
+	p.Quote.usdTrackingCandles = usdCandles(t, currency.USDT, 1)
+	return f
+}
+
+func TestCreateSleeve(t *testing.T) {
+	t.Parallel()
+	f := sleeveSetup(t)
+	_, err := f.CreateSleeve("dca", decimal.Zero)
+	if !errors.Is(err, errInvalidSleeveWeight) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidSleeveWeight)
+	}
+	_, err = f.CreateSleeve("dca", decimal.NewFromInt(2))
+	if !errors.Is(err, errInvalidSleeveWeight) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidSleeveWeight)
+	}
+	s, err := f.CreateSleeve("dca", decimal.NewFromFloat(0.25))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = f.CreateSleeve("DCA", decimal.NewFromFloat(0.75))
+	if !errors.Is(err, ErrAlreadyExists) {
+		t.Errorf("received '%v' expected '%v'", err, ErrAlreadyExists)
+	}
+	p, err := s.GetFundingForEAP(exch, asset.Spot, currency.NewPair(currency.BTC, currency.USDT))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !p.QuoteInitialFunds().Equal(decimal.NewFromInt(250)) {
+		t.Errorf("received '%v' expected '%v'", p.QuoteInitialFunds(), 250)
+	}
+	if p.Quote.pairedWith != p.Base {
+		t.Error("expected sleeve quote to be paired with sleeve base")
+	}
+	if p.Quote == f.items[1] {
+		t.Error("expected sleeve funding to be copied")
+	}
+
+	item, err := CreateItem(exch, asset.Spot, currency.LTC, decimal.Zero, decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = f.AddItem(item)
+	if !errors.Is(err, errSleevesCreated) {
+		t.Errorf("received '%v' expected '%v'", err, errSleevesCreated)
+	}
+}
+
+func TestRebalanceSleeves(t *testing.T) {
+	t.Parallel()
+	f := sleeveSetup(t)
+	err := f.RebalanceSleeves(sleeveTime)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	half := decimal.NewFromFloat(0.5)
+	dca, err := f.CreateSleeve("dca", half)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsi, err := f.CreateSleeve("rsi", half)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the dca sleeve gains one BTC, taking it 50 USD over its target value
+	dca.items[0].available = decimal.NewFromInt(1)
+
+	f.disableUSDTracking = true
+	err = f.RebalanceSleeves(sleeveTime)
+	if !errors.Is(err, ErrUSDTrackingDisabled) {
+		t.Errorf("received '%v' expected '%v'", err, ErrUSDTrackingDisabled)
+	}
+	f.disableUSDTracking = false
+	err = f.RebalanceSleeves(sleeveTime)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !dca.items[0].available.Equal(half) {
+		t.Errorf("received '%v' expected '%v'", dca.items[0].available, half)
+	}
+	if !rsi.items[0].available.Equal(half) {
+		t.Errorf("received '%v' expected '%v'", rsi.items[0].available, half)
+	}
+	if !rsi.items[1].available.Equal(decimal.NewFromInt(500)) {
+		t.Errorf("received '%v' expected '%v'", rsi.items[1].available, 500)
+	}
+
+	f.CreateSnapshot(sleeveTime)
+	snap := f.items[0].snapshot[sleeveTime]
+	if !snap.Available.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("received '%v' expected '%v'", snap.Available, 1)
+	}
+	if !snap.USDValue.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("received '%v' expected '%v'", snap.USDValue, 100)
+	}
+	report := f.GenerateReport()
+	if len(report.SleeveTransfers) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(report.SleeveTransfers), 1)
+	}
+	transfer := report.SleeveTransfers[0]
+	if transfer.From != "dca" || transfer.To != "rsi" {
+		t.Errorf("received '%v' to '%v' expected '%v' to '%v'", transfer.From, transfer.To, "dca", "rsi")
+	}
+	if !transfer.USDValue.Equal(decimal.NewFromInt(50)) {
+		t.Errorf("received '%v' expected '%v'", transfer.USDValue, 50)
+	}
+	if !report.Items[1].FinalFunds.Equal(decimal.NewFromInt(1000)) {
+		t.Errorf("received '%v' expected '%v'", report.Items[1].FinalFunds, 1000)
+	}
+}
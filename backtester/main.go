@@ -5,10 +5,14 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"runtime"
 
 	"github.com/thrasher-corp/gocryptotrader/backtester/backtest"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/optimisation"
+	"github.com/thrasher-corp/gocryptotrader/backtester/rpcserver"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/signaler"
 )
@@ -16,6 +20,9 @@ import (
 func main() {
 	var configPath, templatePath, reportOutput string
 	var printLogo, generateReport, darkReport bool
+	var runServer bool
+	var maxJobs int
+	var serverCfg rpcserver.Config
 	wd, err := os.Getwd()
 	if err != nil {
 		fmt.Printf("Could not get working directory. Error: %v.\n", err)
@@ -60,6 +67,49 @@ func main() {
 		"darkreport",
 		false,
 		"sets the output report to use a dark theme by default")
+	flag.BoolVar(
+		&runServer,
+		"rpcserver",
+		false,
+		"runs a gRPC server which accepts strategy configs as jobs instead of running the config at configpath")
+	flag.StringVar(
+		&serverCfg.ListenAddress,
+		"rpclisten",
+		"localhost:9054",
+		"the address the gRPC server listens on")
+	flag.StringVar(
+		&serverCfg.Username,
+		"rpcuser",
+		"",
+		"the gRPC username")
+	flag.StringVar(
+		&serverCfg.Password,
+		"rpcpassword",
+		"",
+		"the gRPC password")
+	flag.BoolVar(
+		&serverCfg.EnableProxy,
+		"rpcproxy",
+		false,
+		"enables the gRPC JSON proxy")
+	flag.StringVar(
+		&serverCfg.ProxyListenAddress,
+		"rpcproxylisten",
+		"localhost:9055",
+		"the address the gRPC JSON proxy listens on")
+	flag.StringVar(
+		&serverCfg.TLSDir,
+		"tlsdir",
+		filepath.Join(
+			gctcommon.GetDefaultDataDir(runtime.GOOS),
+			"backtester",
+			"tls"),
+		"the directory containing the gRPC server's cert.pem and key.pem, which are generated if missing")
+	flag.IntVar(
+		&maxJobs,
+		"maxjobs",
+		rpcserver.DefaultMaxConcurrentJobs,
+		"the number of gRPC server jobs run at once")
 	flag.Parse()
 
 	var bt *backtest.BackTest
@@ -71,6 +121,18 @@ func main() {
 		os.Exit(1)
 	}
 
+	if runServer {
+		if printLogo {
+			fmt.Print(common.ASCIILogo)
+		}
+		err = runRPCServer(&serverCfg, templatePath, reportOutput, maxJobs)
+		if err != nil {
+			fmt.Printf("Could not run gRPC server. Error: %v.\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	cfg, err = config.ReadConfigFromFile(configPath)
 	if err != nil {
 		fmt.Printf("Could not read config. Error: %v.\n", err)
@@ -85,6 +147,28 @@ func main() {
 		fmt.Printf("Could not read config. Error: %v.\n", err)
 		os.Exit(1)
 	}
+	if cfg.OptimisationSettings != nil {
+		var o *optimisation.Optimiser
+		o, err = optimisation.New(cfg, templatePath, reportOutput)
+		if err != nil {
+			fmt.Printf("Could not setup optimisation from config. Error: %v.\n", err)
+			os.Exit(1)
+		}
+		var results *optimisation.Report
+		results, err = o.Run()
+		if err != nil {
+			fmt.Printf("Could not complete optimisation. Error: %v.\n", err)
+			os.Exit(1)
+		}
+		results.PrintResults()
+		if generateReport {
+			_, err = results.Write(reportOutput)
+			if err != nil {
+				log.Error(log.BackTester, err)
+			}
+		}
+		return
+	}
 	bt, err = backtest.NewFromConfig(cfg, templatePath, reportOutput)
 	if err != nil {
 		fmt.Printf("Could not setup backtester from config. Error: %v.\n", err)
@@ -92,15 +176,15 @@ func main() {
 	}
 	if cfg.DataSettings.LiveData != nil {
 		go func() {
-			err = bt.RunLive()
-			if err != nil {
-				fmt.Printf("Could not complete live run. Error: %v.\n", err)
-				os.Exit(-1)
-			}
+			interrupt := signaler.WaitForInterrupt()
+			log.Infof(log.Global, "Captured %v, shutdown requested.\n", interrupt)
+			bt.Stop()
 		}()
-		interrupt := signaler.WaitForInterrupt()
-		log.Infof(log.Global, "Captured %v, shutdown requested.\n", interrupt)
-		bt.Stop()
+		err = bt.RunLive()
+		if err != nil {
+			fmt.Printf("Could not complete live run. Error: %v.\n", err)
+			os.Exit(1)
+		}
 	} else {
 		err = bt.Run()
 		if err != nil {
@@ -121,5 +205,29 @@ func main() {
 		if err != nil {
 			log.Error(log.BackTester, err)
 		}
+		err = bt.Reports.ExportResults()
+		if err != nil {
+			log.Error(log.BackTester, err)
+		}
+	}
+}
+
+// runRPCServer runs the gRPC server until interrupted, then stops all jobs
+func runRPCServer(cfg *rpcserver.Config, templatePath, reportOutput string, maxJobs int) error {
+	jobs, err := rpcserver.NewJobManager(templatePath, reportOutput, maxJobs)
+	if err != nil {
+		return err
+	}
+	server, err := rpcserver.New(cfg, jobs)
+	if err != nil {
+		return err
+	}
+	err = server.Start()
+	if err != nil {
+		return err
 	}
+	interrupt := signaler.WaitForInterrupt()
+	log.Infof(log.Global, "Captured %v, shutdown requested.\n", interrupt)
+	server.Stop()
+	return nil
 }
@@ -0,0 +1,499 @@
+package optimisation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/backtest"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// New returns an optimiser for a config containing optimisation settings
+func New(cfg *config.Config, templatePath, outputPath string) (*Optimiser, error) {
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if cfg.OptimisationSettings == nil {
+		return nil, errNoOptimisationSettings
+	}
+	o := &Optimiser{
+		config:       cfg,
+		templatePath: templatePath,
+		outputPath:   outputPath,
+	}
+	o.run = o.runBackTest
+	o.dataRange = o.loadDataRange
+	return o, nil
+}
+
+// Run searches the configured custom settings ranges. Without walk forward
+// settings, every combination is run against all data. With walk forward
+// settings, every combination is run against each in-sample window and the
+// best combination is then run against the following out-of-sample window
+func (o *Optimiser) Run() (*Report, error) {
+	settings := o.config.OptimisationSettings
+	resp := &Report{
+		Nickname:      o.config.Nickname,
+		StrategyName:  o.config.StrategySettings.Name,
+		Method:        settings.Method,
+		Metric:        settings.Metric,
+		WalkForward:   settings.WalkForward != nil,
+		GeneratedTime: time.Now(),
+	}
+	var combinations []map[string]interface{}
+	switch settings.Method {
+	case config.GridSearch:
+		combinations = gridCombinations(settings.Parameters)
+	case config.RandomSearch:
+		resp.Seed = settings.Seed
+		if resp.Seed == 0 {
+			resp.Seed = time.Now().UnixNano()
+		}
+		combinations = randomCombinations(settings.Parameters, settings.Iterations, resp.Seed)
+	default:
+		return nil, fmt.Errorf("unknown optimisation method '%v'", settings.Method)
+	}
+	log.Infof(log.BackTester, "optimising %v custom setting combinations using %v search", len(combinations), settings.Method)
+
+	if settings.WalkForward == nil {
+		runs := o.runAll(combinations, time.Time{}, time.Time{})
+		if len(runs) == 0 || runs[0].Error != "" {
+			return nil, errNoSuccessfulRuns
+		}
+		resp.Windows = []Window{{InSample: runs}}
+		return resp, nil
+	}
+
+	cfg, err := cloneConfig(o.config, nil)
+	if err != nil {
+		return nil, err
+	}
+	start, end, err := o.dataRange(cfg)
+	if err != nil {
+		return nil, err
+	}
+	resp.Windows = walkForwardWindows(start, end, settings.WalkForward)
+	if len(resp.Windows) == 0 {
+		return nil, fmt.Errorf("%w from %v to %v", errNoWalkForwardWindows, start, end)
+	}
+	for i := range resp.Windows {
+		w := &resp.Windows[i]
+		log.Infof(log.BackTester, "walk forward window %v of %v, in-sample %v to %v, out-of-sample %v to %v",
+			i+1, len(resp.Windows), w.InSampleStart, w.InSampleEnd, w.OutOfSampleStart, w.OutOfSampleEnd)
+		w.InSample = o.runAll(combinations, w.InSampleStart, w.InSampleEnd)
+		if len(w.InSample) == 0 || w.InSample[0].Error != "" {
+			log.Warnf(log.BackTester, "walk forward window %v has no successful in-sample runs, skipping out-of-sample run", i+1)
+			continue
+		}
+		outOfSample := o.runOnce(w.InSample[0].CustomSettings, w.OutOfSampleStart, w.OutOfSampleEnd)
+		w.OutOfSample = &outOfSample
+	}
+	return resp, nil
+}
+
+// runAll runs every combination in parallel and returns the ranked results
+func (o *Optimiser) runAll(combinations []map[string]interface{}, start, end time.Time) []Run {
+	runs := make([]Run, len(combinations))
+	sem := make(chan struct{}, o.maximumParallelRuns())
+	var wg sync.WaitGroup
+	for i := range combinations {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			runs[i] = o.runOnce(combinations[i], start, end)
+		}(i)
+	}
+	wg.Wait()
+	rankRuns(runs)
+	return runs
+}
+
+// maximumParallelRuns returns how many combinations can run at once. Database
+// data is loaded through the global database connection which each run starts
+// and stops, and API data is fetched from rate limited exchanges, so runs
+// using either are sequential
+func (o *Optimiser) maximumParallelRuns() int64 {
+	limit := o.config.OptimisationSettings.MaximumParallelRuns
+	if o.config.DataSettings.DatabaseData != nil || o.config.DataSettings.APIData != nil {
+		if limit > 1 {
+			log.Warnf(log.BackTester, "database and API data cannot be loaded in parallel, running combinations sequentially")
+		}
+		return 1
+	}
+	if limit <= 0 {
+		limit = int64(runtime.NumCPU())
+	}
+	return limit
+}
+
+// runOnce runs a single combination of custom settings, recording any error
+// against the run rather than halting the optimisation
+func (o *Optimiser) runOnce(customSettings map[string]interface{}, start, end time.Time) Run {
+	resp := Run{
+		CustomSettings: customSettings,
+	}
+	cfg, err := cloneConfig(o.config, customSettings)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp
+	}
+	stats, err := o.run(cfg, start, end)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp
+	}
+	resp.Metric, err = metricFromStatistics(stats, o.config.OptimisationSettings.Metric)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp
+	}
+	resp.TotalOrders = stats.TotalOrders
+	if stats.FundingStatistics != nil && stats.FundingStatistics.TotalUSDStatistics != nil {
+		resp.StrategyMovement = stats.FundingStatistics.TotalUSDStatistics.StrategyMovement
+	} else {
+		resp.StrategyMovement, _ = metricFromStatistics(stats, config.StrategyMovementMetric)
+	}
+	return resp
+}
+
+// runBackTest sets up a fresh backtest from the config, runs it over the
+// window provided and resets it once its statistics have been calculated
+func (o *Optimiser) runBackTest(cfg *config.Config, start, end time.Time) (*statistics.Statistic, error) {
+	bt, err := backtest.NewFromConfig(cfg, o.templatePath, o.outputPath)
+	if err != nil {
+		return nil, err
+	}
+	defer bt.Reset()
+	if !start.IsZero() || !end.IsZero() {
+		err = bt.SetDataWindow(start, end)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = bt.Run()
+	if err != nil {
+		return nil, err
+	}
+	err = bt.Statistic.CalculateAllResults()
+	if err != nil {
+		return nil, err
+	}
+	stats, ok := bt.Statistic.(*statistics.Statistic)
+	if !ok {
+		return nil, fmt.Errorf("%w %T", errUnsupportedStatisticType, bt.Statistic)
+	}
+	// resetting the backtest clears its statistics, so keep a copy
+	resp := *stats
+	return &resp, nil
+}
+
+// loadDataRange loads the config's data to determine the range available
+// for walk forward windows
+func (o *Optimiser) loadDataRange(cfg *config.Config) (start, end time.Time, err error) {
+	bt, err := backtest.NewFromConfig(cfg, o.templatePath, o.outputPath)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	defer bt.Reset()
+	return bt.DataRange()
+}
+
+// cloneConfig deep copies the config, as setting up a backtest modifies it,
+// and applies the custom settings over the strategy's existing custom settings
+func cloneConfig(cfg *config.Config, customSettings map[string]interface{}) (*config.Config, error) {
+	b, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+	resp := &config.Config{}
+	err = json.Unmarshal(b, resp)
+	if err != nil {
+		return nil, err
+	}
+	resp.OptimisationSettings = nil
+	if len(customSettings) > 0 && resp.StrategySettings.CustomSettings == nil {
+		resp.StrategySettings.CustomSettings = make(map[string]interface{})
+	}
+	for k, v := range customSettings {
+		resp.StrategySettings.CustomSettings[k] = v
+	}
+	return resp, nil
+}
+
+// parameterValues returns every value from the parameter's minimum up to and
+// including its maximum in increments of its step
+func parameterValues(p *config.OptimisationParameter) []interface{} {
+	var resp []interface{}
+	if !p.Step.IsPositive() {
+		return resp
+	}
+	for v := p.Minimum; v.LessThanOrEqual(p.Maximum); v = v.Add(p.Step) {
+		f, _ := v.Float64()
+		resp = append(resp, f)
+	}
+	return resp
+}
+
+// gridCombinations returns every combination of parameter values
+func gridCombinations(params []config.OptimisationParameter) []map[string]interface{} {
+	resp := []map[string]interface{}{{}}
+	for i := range params {
+		values := parameterValues(&params[i])
+		next := make([]map[string]interface{}, 0, len(resp)*len(values))
+		for j := range resp {
+			for k := range values {
+				combination := make(map[string]interface{}, len(resp[j])+1)
+				for key, val := range resp[j] {
+					combination[key] = val
+				}
+				combination[params[i].Name] = values[k]
+				next = append(next, combination)
+			}
+		}
+		resp = next
+	}
+	return resp
+}
+
+// randomCombinations returns up to the number of iterations of unique
+// randomly selected parameter value combinations. If the search space is not
+// larger than the iterations requested, every combination is returned
+func randomCombinations(params []config.OptimisationParameter, iterations, seed int64) []map[string]interface{} {
+	values := make([][]interface{}, len(params))
+	total := int64(1)
+	for i := range params {
+		values[i] = parameterValues(&params[i])
+		if len(values[i]) == 0 {
+			return nil
+		}
+		if total <= iterations {
+			total *= int64(len(values[i]))
+		}
+	}
+	if total <= iterations {
+		return gridCombinations(params)
+	}
+	r := rand.New(rand.NewSource(seed)) // nolint:gosec // reproducible sampling is required, not security
+	seen := make(map[string]bool)
+	resp := make([]map[string]interface{}, 0, iterations)
+	for int64(len(resp)) < iterations {
+		combination := make(map[string]interface{}, len(params))
+		key := make([]string, len(params))
+		for i := range params {
+			idx := r.Intn(len(values[i]))
+			combination[params[i].Name] = values[i][idx]
+			key[i] = fmt.Sprint(idx)
+		}
+		k := strings.Join(key, ",")
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		resp = append(resp, combination)
+	}
+	return resp
+}
+
+// walkForwardWindows splits the range into in-sample windows, each followed by
+// an out-of-sample window. Windows advance by the out-of-sample duration so
+// every out-of-sample period is assessed once
+func walkForwardWindows(start, end time.Time, wf *config.WalkForward) []Window {
+	var resp []Window
+	if wf == nil || wf.InSampleWindow <= 0 || wf.OutOfSampleWindow <= 0 {
+		return resp
+	}
+	for offset := time.Duration(0); ; offset += wf.OutOfSampleWindow {
+		inSampleStart := start.Add(offset)
+		if wf.Anchored {
+			inSampleStart = start
+		}
+		inSampleEnd := start.Add(offset + wf.InSampleWindow)
+		outOfSampleEnd := inSampleEnd.Add(wf.OutOfSampleWindow)
+		if outOfSampleEnd.After(end) {
+			break
+		}
+		resp = append(resp, Window{
+			InSampleStart:    inSampleStart,
+			InSampleEnd:      inSampleEnd,
+			OutOfSampleStart: inSampleEnd,
+			OutOfSampleEnd:   outOfSampleEnd,
+		})
+	}
+	return resp
+}
+
+// rankRuns sorts successful runs by their metric, best first, followed by
+// any runs which errored
+func rankRuns(runs []Run) {
+	sort.SliceStable(runs, func(i, j int) bool {
+		if (runs[i].Error == "") != (runs[j].Error == "") {
+			return runs[i].Error == ""
+		}
+		return runs[i].Metric.GreaterThan(runs[j].Metric)
+	})
+	for i := range runs {
+		if runs[i].Error != "" {
+			continue
+		}
+		runs[i].Rank = i + 1
+	}
+}
+
+// metricFromStatistics returns the metric from a completed run's statistics.
+// USD totals are used when available, otherwise the metric is averaged across
+// all currency pairs. All metrics are expressed so that higher is better,
+// drawdowns are negative percentages
+func metricFromStatistics(s *statistics.Statistic, metric string) (decimal.Decimal, error) {
+	if s == nil {
+		return decimal.Zero, errNilStatistics
+	}
+	if s.FundingStatistics != nil && s.FundingStatistics.TotalUSDStatistics != nil {
+		usd := s.FundingStatistics.TotalUSDStatistics
+		switch metric {
+		case config.CAGRMetric:
+			return usd.CompoundAnnualGrowthRate, nil
+		case config.MaxDrawdownMetric:
+			return usd.MaxDrawdown.DrawdownPercent, nil
+		case config.StrategyMovementMetric:
+			return usd.StrategyMovement, nil
+		default:
+			return ratioMetric(usd.ArithmeticRatios, metric)
+		}
+	}
+	var total decimal.Decimal
+	var count int64
+	for _, exchangeMap := range s.ExchangeAssetPairStatistics {
+		for _, assetMap := range exchangeMap {
+			for _, stats := range assetMap {
+				var v decimal.Decimal
+				var err error
+				switch metric {
+				case config.CAGRMetric:
+					v = stats.CompoundAnnualGrowthRate
+				case config.MaxDrawdownMetric:
+					v = holdingsDrawdown(stats.Events)
+				case config.StrategyMovementMetric:
+					v = stats.StrategyMovement
+				default:
+					v, err = ratioMetric(stats.ArithmeticRatios, metric)
+					if err != nil {
+						return decimal.Zero, err
+					}
+				}
+				total = total.Add(v)
+				count++
+			}
+		}
+	}
+	if count == 0 {
+		return decimal.Zero, fmt.Errorf("%w '%v', no currency statistics", errMetricUnavailable, metric)
+	}
+	return total.Div(decimal.NewFromInt(count)), nil
+}
+
+func ratioMetric(r *statistics.Ratios, metric string) (decimal.Decimal, error) {
+	if r == nil {
+		return decimal.Zero, fmt.Errorf("%w '%v', ratios were not calculated", errMetricUnavailable, metric)
+	}
+	switch metric {
+	case config.SharpeRatioMetric:
+		return r.SharpeRatio, nil
+	case config.SortinoRatioMetric:
+		return r.SortinoRatio, nil
+	case config.CalmarRatioMetric:
+		return r.CalmarRatio, nil
+	}
+	return decimal.Zero, fmt.Errorf("%w '%v'", errMetricUnavailable, metric)
+}
+
+// holdingsDrawdown returns the largest percentage fall in holdings value from
+// a previous peak. Currency statistics only track the market's drawdown, so
+// the strategy's drawdown is calculated from its holdings
+func holdingsDrawdown(events []statistics.EventStore) decimal.Decimal {
+	var peak, resp decimal.Decimal
+	oneHundred := decimal.NewFromInt(100)
+	for i := range events {
+		v := events[i].Holdings.TotalValue
+		if v.GreaterThan(peak) {
+			peak = v
+		}
+		if peak.IsZero() {
+			continue
+		}
+		drawdown := v.Sub(peak).Div(peak).Mul(oneHundred)
+		if drawdown.LessThan(resp) {
+			resp = drawdown
+		}
+	}
+	return resp
+}
+
+// Write saves the report as JSON to the output path and returns the file path
+func (r *Report) Write(outputPath string) (string, error) {
+	b, err := json.MarshalIndent(r, "", " ")
+	if err != nil {
+		return "", err
+	}
+	var nickName string
+	if r.Nickname != "" {
+		nickName = r.Nickname + "-"
+	}
+	fileName := filepath.Join(outputPath, fmt.Sprintf(
+		"%v%v-optimisation-%v.json",
+		nickName,
+		r.StrategyName,
+		r.GeneratedTime.Format("2006-01-02-15-04-05")))
+	err = file.Write(fileName, b)
+	if err != nil {
+		return "", err
+	}
+	log.Infof(log.BackTester, "successfully saved optimisation report to %v", fileName)
+	return fileName, nil
+}
+
+// PrintResults outputs the best runs of each window to the log
+func (r *Report) PrintResults() {
+	log.Info(log.BackTester, "------------------Optimisation Results-----------------------")
+	log.Infof(log.BackTester, "Method: %v Metric: %v", r.Method, r.Metric)
+	if r.Seed != 0 {
+		log.Infof(log.BackTester, "Seed: %v", r.Seed)
+	}
+	for i := range r.Windows {
+		w := &r.Windows[i]
+		if r.WalkForward {
+			log.Infof(log.BackTester, "Window %v in-sample %v to %v", i+1, w.InSampleStart, w.InSampleEnd)
+		}
+		for j := range w.InSample {
+			if j >= 5 {
+				break
+			}
+			if w.InSample[j].Error != "" {
+				log.Infof(log.BackTester, "Failed %v: %v", w.InSample[j].CustomSettings, w.InSample[j].Error)
+				continue
+			}
+			log.Infof(log.BackTester, "Rank %v %v: %v", w.InSample[j].Rank, w.InSample[j].CustomSettings, w.InSample[j].Metric.Round(4))
+		}
+		if w.OutOfSample != nil {
+			if w.OutOfSample.Error != "" {
+				log.Infof(log.BackTester, "Out-of-sample %v to %v failed: %v", w.OutOfSampleStart, w.OutOfSampleEnd, w.OutOfSample.Error)
+				continue
+			}
+			log.Infof(log.BackTester, "Out-of-sample %v to %v %v: %v", w.OutOfSampleStart, w.OutOfSampleEnd, w.OutOfSample.CustomSettings, w.OutOfSample.Metric.Round(4))
+		}
+	}
+}
@@ -0,0 +1,404 @@
+package optimisation
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var testStart = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func testConfig() *config.Config {
+	return &config.Config{
+		Nickname: "test",
+		StrategySettings: config.StrategySettings{
+			Name: "rsi",
+			CustomSettings: map[string]interface{}{
+				"rsi-period": 14.0,
+			},
+		},
+		OptimisationSettings: &config.OptimisationSettings{
+			Method:              config.GridSearch,
+			Metric:              config.SharpeRatioMetric,
+			MaximumParallelRuns: 2,
+			Parameters: []config.OptimisationParameter{
+				{
+					Name:    "rsi-low",
+					Minimum: decimal.NewFromInt(20),
+					Maximum: decimal.NewFromInt(40),
+					Step:    decimal.NewFromInt(10),
+				},
+				{
+					Name:    "rsi-high",
+					Minimum: decimal.NewFromInt(60),
+					Maximum: decimal.NewFromInt(80),
+					Step:    decimal.NewFromInt(10),
+				},
+			},
+		},
+	}
+}
+
+// usdStatistic returns statistics with a USD total result for each metric
+func usdStatistic(v decimal.Decimal) *statistics.Statistic {
+	return &statistics.Statistic{
+		TotalOrders: 2,
+		FundingStatistics: &statistics.FundingStatistics{
+			TotalUSDStatistics: &statistics.TotalFundingStatistics{
+				StrategyMovement:         v,
+				CompoundAnnualGrowthRate: v,
+				MaxDrawdown:              statistics.Swing{DrawdownPercent: v.Neg()},
+				ArithmeticRatios: &statistics.Ratios{
+					SharpeRatio:  v,
+					SortinoRatio: v,
+					CalmarRatio:  v,
+				},
+			},
+		},
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	_, err := New(nil, "", "")
+	if !errors.Is(err, errNilConfig) {
+		t.Errorf("received '%v' expected '%v'", err, errNilConfig)
+	}
+	_, err = New(&config.Config{}, "", "")
+	if !errors.Is(err, errNoOptimisationSettings) {
+		t.Errorf("received '%v' expected '%v'", err, errNoOptimisationSettings)
+	}
+	o, err := New(testConfig(), "", "")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if o.run == nil || o.dataRange == nil {
+		t.Error("expected default runners to be set")
+	}
+}
+
+func TestRunGridSearch(t *testing.T) {
+	t.Parallel()
+	o, err := New(testConfig(), "", "")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	var runs int64
+	o.run = func(cfg *config.Config, start, end time.Time) (*statistics.Statistic, error) {
+		atomic.AddInt64(&runs, 1)
+		if cfg.OptimisationSettings != nil {
+			t.Error("expected optimisation settings to be removed from run config")
+		}
+		if cfg.StrategySettings.CustomSettings["rsi-period"] != 14.0 {
+			t.Error("expected existing custom settings to be kept")
+		}
+		if !start.IsZero() || !end.IsZero() {
+			t.Error("expected all data to be used")
+		}
+		low := cfg.StrategySettings.CustomSettings["rsi-low"].(float64)
+		high := cfg.StrategySettings.CustomSettings["rsi-high"].(float64)
+		if low == 40 && high == 60 {
+			return nil, errors.New("test error")
+		}
+		return usdStatistic(decimal.NewFromFloat(high - low)), nil
+	}
+	r, err := o.Run()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if runs != 9 {
+		t.Errorf("received '%v' expected '%v'", runs, 9)
+	}
+	if len(r.Windows) != 1 || len(r.Windows[0].InSample) != 9 {
+		t.Fatalf("expected a single window with 9 runs")
+	}
+	best := r.Windows[0].InSample[0]
+	if best.Rank != 1 || !best.Metric.Equal(decimal.NewFromInt(60)) {
+		t.Errorf("received rank %v metric '%v' expected rank 1 metric 60", best.Rank, best.Metric)
+	}
+	if best.CustomSettings["rsi-low"] != 20.0 || best.CustomSettings["rsi-high"] != 80.0 {
+		t.Errorf("received '%v' expected rsi-low 20 rsi-high 80", best.CustomSettings)
+	}
+	worst := r.Windows[0].InSample[8]
+	if worst.Error == "" || worst.Rank != 0 {
+		t.Errorf("expected failed run to be ranked last, received '%+v'", worst)
+	}
+
+	o.run = func(*config.Config, time.Time, time.Time) (*statistics.Statistic, error) {
+		return nil, errors.New("test error")
+	}
+	_, err = o.Run()
+	if !errors.Is(err, errNoSuccessfulRuns) {
+		t.Errorf("received '%v' expected '%v'", err, errNoSuccessfulRuns)
+	}
+}
+
+func TestRunAllParallelRuns(t *testing.T) {
+	t.Parallel()
+	o, err := New(testConfig(), "", "")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	o.config.OptimisationSettings.MaximumParallelRuns = 3
+	var running, maxRunning int64
+	o.run = func(*config.Config, time.Time, time.Time) (*statistics.Statistic, error) {
+		n := atomic.AddInt64(&running, 1)
+		for {
+			m := atomic.LoadInt64(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt64(&maxRunning, m, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond * 20)
+		atomic.AddInt64(&running, -1)
+		return usdStatistic(decimal.NewFromInt(1)), nil
+	}
+	combinations := gridCombinations(o.config.OptimisationSettings.Parameters)
+	runs := o.runAll(combinations, time.Time{}, time.Time{})
+	if len(runs) != 9 {
+		t.Fatalf("received '%v' expected '%v'", len(runs), 9)
+	}
+	if maxRunning < 2 || maxRunning > 3 {
+		t.Errorf("received '%v' expected parallel runs limited to 3", maxRunning)
+	}
+
+	// database and API data are loaded sequentially
+	for _, ds := range []config.DataSettings{
+		{DatabaseData: &config.DatabaseData{}},
+		{APIData: &config.APIData{}},
+	} {
+		o.config.DataSettings = ds
+		atomic.StoreInt64(&maxRunning, 0)
+		o.runAll(combinations, time.Time{}, time.Time{})
+		if maxRunning != 1 {
+			t.Errorf("received '%v' expected '%v'", maxRunning, 1)
+		}
+	}
+}
+
+func TestRunWalkForward(t *testing.T) {
+	t.Parallel()
+	cfg := testConfig()
+	cfg.OptimisationSettings.Method = config.RandomSearch
+	cfg.OptimisationSettings.Iterations = 4
+	cfg.OptimisationSettings.Seed = 1337
+	cfg.OptimisationSettings.WalkForward = &config.WalkForward{
+		InSampleWindow:    time.Hour * 24 * 10,
+		OutOfSampleWindow: time.Hour * 24 * 5,
+	}
+	o, err := New(cfg, "", "")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	o.dataRange = func(*config.Config) (time.Time, time.Time, error) {
+		return testStart, testStart.AddDate(0, 0, 20), nil
+	}
+	o.run = func(cfg *config.Config, start, end time.Time) (*statistics.Statistic, error) {
+		if start.IsZero() || !start.Before(end) {
+			t.Errorf("received invalid window %v %v", start, end)
+		}
+		low := cfg.StrategySettings.CustomSettings["rsi-low"].(float64)
+		return usdStatistic(decimal.NewFromFloat(low)), nil
+	}
+	r, err := o.Run()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if r.Seed != 1337 || !r.WalkForward {
+		t.Errorf("received seed %v walk forward %v", r.Seed, r.WalkForward)
+	}
+	if len(r.Windows) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(r.Windows), 2)
+	}
+	for i := range r.Windows {
+		if len(r.Windows[i].InSample) != 4 {
+			t.Errorf("received '%v' expected '%v'", len(r.Windows[i].InSample), 4)
+		}
+		if r.Windows[i].OutOfSample == nil {
+			t.Fatal("expected out-of-sample run")
+		}
+		if r.Windows[i].OutOfSample.CustomSettings["rsi-low"] != r.Windows[i].InSample[0].CustomSettings["rsi-low"] {
+			t.Error("expected out-of-sample run to use the best in-sample settings")
+		}
+	}
+
+	o.dataRange = func(*config.Config) (time.Time, time.Time, error) {
+		return testStart, testStart.AddDate(0, 0, 5), nil
+	}
+	_, err = o.Run()
+	if !errors.Is(err, errNoWalkForwardWindows) {
+		t.Errorf("received '%v' expected '%v'", err, errNoWalkForwardWindows)
+	}
+}
+
+func TestGridCombinations(t *testing.T) {
+	t.Parallel()
+	c := gridCombinations(testConfig().OptimisationSettings.Parameters)
+	if len(c) != 9 {
+		t.Fatalf("received '%v' expected '%v'", len(c), 9)
+	}
+	seen := make(map[[2]float64]bool)
+	for i := range c {
+		seen[[2]float64{c[i]["rsi-low"].(float64), c[i]["rsi-high"].(float64)}] = true
+	}
+	if len(seen) != 9 {
+		t.Errorf("received '%v' unique combinations expected '%v'", len(seen), 9)
+	}
+	c = gridCombinations([]config.OptimisationParameter{{
+		Name:    "rsi-period",
+		Minimum: decimal.NewFromFloat(0.1),
+		Maximum: decimal.NewFromFloat(0.3),
+		Step:    decimal.NewFromFloat(0.1),
+	}})
+	if len(c) != 3 || c[2]["rsi-period"] != 0.3 {
+		t.Errorf("received '%v' expected inclusive decimal steps", c)
+	}
+}
+
+func TestRandomCombinations(t *testing.T) {
+	t.Parallel()
+	params := testConfig().OptimisationSettings.Parameters
+	c := randomCombinations(params, 4, 1337)
+	if len(c) != 4 {
+		t.Fatalf("received '%v' expected '%v'", len(c), 4)
+	}
+	seen := make(map[[2]float64]bool)
+	for i := range c {
+		seen[[2]float64{c[i]["rsi-low"].(float64), c[i]["rsi-high"].(float64)}] = true
+	}
+	if len(seen) != 4 {
+		t.Errorf("received '%v' unique combinations expected '%v'", len(seen), 4)
+	}
+	again := randomCombinations(params, 4, 1337)
+	for i := range c {
+		if c[i]["rsi-low"] != again[i]["rsi-low"] || c[i]["rsi-high"] != again[i]["rsi-high"] {
+			t.Error("expected the same seed to produce the same combinations")
+		}
+	}
+	c = randomCombinations(params, 100, 1337)
+	if len(c) != 9 {
+		t.Errorf("received '%v' expected '%v'", len(c), 9)
+	}
+}
+
+func TestWalkForwardWindows(t *testing.T) {
+	t.Parallel()
+	wf := &config.WalkForward{
+		InSampleWindow:    time.Hour * 24 * 10,
+		OutOfSampleWindow: time.Hour * 24 * 5,
+	}
+	w := walkForwardWindows(testStart, testStart.AddDate(0, 0, 22), wf)
+	if len(w) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(w), 2)
+	}
+	if !w[1].InSampleStart.Equal(testStart.AddDate(0, 0, 5)) ||
+		!w[1].InSampleEnd.Equal(testStart.AddDate(0, 0, 15)) ||
+		!w[1].OutOfSampleStart.Equal(w[1].InSampleEnd) ||
+		!w[1].OutOfSampleEnd.Equal(testStart.AddDate(0, 0, 20)) {
+		t.Errorf("received unexpected window '%+v'", w[1])
+	}
+	wf.Anchored = true
+	w = walkForwardWindows(testStart, testStart.AddDate(0, 0, 22), wf)
+	if len(w) != 2 || !w[1].InSampleStart.Equal(testStart) || !w[1].InSampleEnd.Equal(testStart.AddDate(0, 0, 15)) {
+		t.Errorf("received unexpected anchored window '%+v'", w)
+	}
+	if w = walkForwardWindows(testStart, testStart.AddDate(0, 0, 22), nil); len(w) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(w), 0)
+	}
+}
+
+func TestMetricFromStatistics(t *testing.T) {
+	t.Parallel()
+	_, err := metricFromStatistics(nil, config.SharpeRatioMetric)
+	if !errors.Is(err, errNilStatistics) {
+		t.Errorf("received '%v' expected '%v'", err, errNilStatistics)
+	}
+	s := usdStatistic(decimal.NewFromInt(2))
+	for _, m := range []string{
+		config.SharpeRatioMetric,
+		config.SortinoRatioMetric,
+		config.CalmarRatioMetric,
+		config.CAGRMetric,
+		config.StrategyMovementMetric,
+	} {
+		v, err := metricFromStatistics(s, m)
+		if !errors.Is(err, nil) || !v.Equal(decimal.NewFromInt(2)) {
+			t.Errorf("%v received '%v' '%v' expected '%v'", m, v, err, 2)
+		}
+	}
+	v, err := metricFromStatistics(s, config.MaxDrawdownMetric)
+	if !errors.Is(err, nil) || !v.Equal(decimal.NewFromInt(-2)) {
+		t.Errorf("received '%v' '%v' expected '%v'", v, err, -2)
+	}
+
+	s = &statistics.Statistic{}
+	_, err = metricFromStatistics(s, config.SharpeRatioMetric)
+	if !errors.Is(err, errMetricUnavailable) {
+		t.Errorf("received '%v' expected '%v'", err, errMetricUnavailable)
+	}
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	s.ExchangeAssetPairStatistics = map[string]map[asset.Item]map[currency.Pair]*statistics.CurrencyPairStatistic{
+		"binance": {
+			asset.Spot: {
+				p: {
+					StrategyMovement: decimal.NewFromInt(10),
+					Events: []statistics.EventStore{
+						{Holdings: holdings.Holding{TotalValue: decimal.NewFromInt(100)}},
+						{Holdings: holdings.Holding{TotalValue: decimal.NewFromInt(200)}},
+						{Holdings: holdings.Holding{TotalValue: decimal.NewFromInt(150)}},
+						{Holdings: holdings.Holding{TotalValue: decimal.NewFromInt(250)}},
+					},
+				},
+				p.Swap(): {
+					StrategyMovement: decimal.NewFromInt(20),
+				},
+			},
+		},
+	}
+	v, err = metricFromStatistics(s, config.StrategyMovementMetric)
+	if !errors.Is(err, nil) || !v.Equal(decimal.NewFromInt(15)) {
+		t.Errorf("received '%v' '%v' expected '%v'", v, err, 15)
+	}
+	v, err = metricFromStatistics(s, config.MaxDrawdownMetric)
+	if !errors.Is(err, nil) || !v.Equal(decimal.NewFromFloat(-12.5)) {
+		t.Errorf("received '%v' '%v' expected '%v'", v, err, -12.5)
+	}
+	_, err = metricFromStatistics(s, config.SharpeRatioMetric)
+	if !errors.Is(err, errMetricUnavailable) {
+		t.Errorf("received '%v' expected '%v'", err, errMetricUnavailable)
+	}
+}
+
+func TestReportWrite(t *testing.T) {
+	t.Parallel()
+	r := &Report{
+		Nickname:      "test",
+		StrategyName:  "rsi",
+		GeneratedTime: testStart,
+		Windows: []Window{{
+			InSample: []Run{{Rank: 1, Metric: decimal.NewFromInt(1)}},
+		}},
+	}
+	dir := t.TempDir()
+	fileName, err := r.Write(dir)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if filepath.Base(fileName) != "test-rsi-optimisation-2021-01-01-00-00-00.json" {
+		t.Errorf("received '%v'", fileName)
+	}
+	if _, err = os.Stat(fileName); err != nil {
+		t.Error(err)
+	}
+	r.PrintResults()
+}
@@ -0,0 +1,66 @@
+package optimisation
+
+import (
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+)
+
+var (
+	errNilConfig                = errors.New("unable to optimise with nil config")
+	errNoOptimisationSettings   = errors.New("config has no optimisation settings")
+	errNoSuccessfulRuns         = errors.New("no optimisation runs completed successfully")
+	errNoWalkForwardWindows     = errors.New("data range is too short for a single walk forward window")
+	errMetricUnavailable        = errors.New("metric unavailable")
+	errNilStatistics            = errors.New("nil statistics received")
+	errUnsupportedStatisticType = errors.New("unsupported statistic type")
+)
+
+// Optimiser runs a strategy config repeatedly with differing custom settings
+// and ranks each run by the configured metric
+type Optimiser struct {
+	config       *config.Config
+	templatePath string
+	outputPath   string
+	// run executes a single backtest for the config over the window provided.
+	// A zero start and end time runs all available data
+	run func(cfg *config.Config, start, end time.Time) (*statistics.Statistic, error)
+	// dataRange returns the time range of the data the config will load
+	dataRange func(cfg *config.Config) (start, end time.Time, err error)
+}
+
+// Report holds the combined results of every optimisation run
+type Report struct {
+	Nickname      string    `json:"nickname"`
+	StrategyName  string    `json:"strategy-name"`
+	Method        string    `json:"method"`
+	Metric        string    `json:"metric"`
+	Seed          int64     `json:"seed,omitempty"`
+	WalkForward   bool      `json:"walk-forward"`
+	GeneratedTime time.Time `json:"generated-time"`
+	Windows       []Window  `json:"windows"`
+}
+
+// Window holds ranked in-sample runs and, when walking forward, the result of
+// the best in-sample settings over the following out-of-sample period
+type Window struct {
+	InSampleStart    time.Time `json:"in-sample-start,omitempty"`
+	InSampleEnd      time.Time `json:"in-sample-end,omitempty"`
+	OutOfSampleStart time.Time `json:"out-of-sample-start,omitempty"`
+	OutOfSampleEnd   time.Time `json:"out-of-sample-end,omitempty"`
+	InSample         []Run     `json:"in-sample"`
+	OutOfSample      *Run      `json:"out-of-sample,omitempty"`
+}
+
+// Run holds the results of a single backtest run
+type Run struct {
+	Rank             int                    `json:"rank,omitempty"`
+	CustomSettings   map[string]interface{} `json:"custom-settings"`
+	Metric           decimal.Decimal        `json:"metric"`
+	StrategyMovement decimal.Decimal        `json:"strategy-movement"`
+	TotalOrders      int64                  `json:"total-orders"`
+	Error            string                 `json:"error,omitempty"`
+}
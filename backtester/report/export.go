@@ -0,0 +1,372 @@
+package report
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// ExportResults writes the trade blotter, holdings curve and funding snapshots
+// in each format set in the config's report settings. Statistics must be
+// calculated beforehand
+func (d *Data) ExportResults() error {
+	if d.Config == nil || d.Config.ReportSettings == nil || len(d.Config.ReportSettings.ExportFormats) == 0 {
+		return nil
+	}
+	if d.Statistics == nil {
+		return errStatisticsUnset
+	}
+	tables := []*table{
+		d.tradeBlotter(),
+		d.holdingsCurve(),
+		d.fundingSnapshots(),
+	}
+	prefix := d.fileNamePrefix()
+	for _, format := range d.Config.ReportSettings.ExportFormats {
+		for _, t := range tables {
+			var fileName string
+			var err error
+			switch format {
+			case config.CSVExport:
+				fileName = prefix + "-" + t.Name + ".csv"
+				err = writeFile(filepath.Join(d.OutputPath, fileName), t.writeCSV)
+			case config.JSONLinesExport:
+				fileName = prefix + "-" + t.Name + ".jsonl"
+				err = writeFile(filepath.Join(d.OutputPath, fileName), t.writeJSONLines)
+			case config.ColumnarExport:
+				fileName = prefix + "-" + t.Name + ".columnar.json"
+				err = writeFile(filepath.Join(d.OutputPath, fileName), t.writeColumnar)
+			default:
+				return fmt.Errorf("%w %v", errUnsupportedExportFormat, format)
+			}
+			if err != nil {
+				return err
+			}
+			log.Infof(log.BackTester, "successfully exported %v %v to %v", t.Name, format, filepath.Join(d.OutputPath, fileName))
+		}
+	}
+	return nil
+}
+
+// tradeBlotter creates a row for every order from the compliance snapshots,
+// at the event it was first recorded
+func (d *Data) tradeBlotter() *table {
+	t := &table{
+		Name: tradesTable,
+		Columns: []column{
+			{Name: "time", Type: timestampColumn},
+			{Name: "offset", Type: intColumn},
+			{Name: "exchange", Type: stringColumn},
+			{Name: "asset", Type: stringColumn},
+			{Name: "pair", Type: stringColumn},
+			{Name: "order-id", Type: stringColumn},
+			{Name: "side", Type: stringColumn},
+			{Name: "order-type", Type: stringColumn},
+			{Name: "price", Type: decimalColumn},
+			{Name: "amount", Type: decimalColumn},
+			{Name: "fee", Type: decimalColumn},
+			{Name: "close-price", Type: decimalColumn},
+			{Name: "volume-adjusted-price", Type: decimalColumn},
+			{Name: "slippage-rate", Type: decimalColumn},
+			{Name: "cost-basis", Type: decimalColumn},
+		},
+	}
+	d.forEachPair(func(exch string, a asset.Item, p currency.Pair, stats *statistics.CurrencyPairStatistic) {
+		seen := make(map[string]bool)
+		for i := range stats.Events {
+			snapshot := stats.Events[i].Transactions
+			for j := range snapshot.Orders {
+				o := snapshot.Orders[j]
+				if o.Detail == nil || seen[o.ID] {
+					continue
+				}
+				seen[o.ID] = true
+				t.Rows = append(t.Rows, []interface{}{
+					o.Date,
+					snapshot.Offset,
+					exch,
+					a.String(),
+					p.String(),
+					o.ID,
+					o.Side.String(),
+					o.Type.String(),
+					decimal.NewFromFloat(o.Price),
+					decimal.NewFromFloat(o.Amount),
+					decimal.NewFromFloat(o.Fee),
+					o.ClosePrice,
+					o.VolumeAdjustedPrice,
+					o.SlippageRate,
+					o.CostBasis,
+				})
+			}
+		}
+	})
+	return t
+}
+
+// holdingsCurve creates a row of holdings and equity for every data event
+func (d *Data) holdingsCurve() *table {
+	t := &table{
+		Name: holdingsTable,
+		Columns: []column{
+			{Name: "time", Type: timestampColumn},
+			{Name: "offset", Type: intColumn},
+			{Name: "exchange", Type: stringColumn},
+			{Name: "asset", Type: stringColumn},
+			{Name: "pair", Type: stringColumn},
+			{Name: "close-price", Type: decimalColumn},
+			{Name: "base-size", Type: decimalColumn},
+			{Name: "base-value", Type: decimalColumn},
+			{Name: "quote-size", Type: decimalColumn},
+			{Name: "total-value", Type: decimalColumn},
+			{Name: "change-in-total-value-percent", Type: decimalColumn},
+			{Name: "bought-amount", Type: decimalColumn},
+			{Name: "sold-amount", Type: decimalColumn},
+			{Name: "total-fees", Type: decimalColumn},
+			{Name: "unrealised-pnl", Type: decimalColumn},
+			{Name: "realised-pnl", Type: decimalColumn},
+		},
+	}
+	d.forEachPair(func(exch string, a asset.Item, p currency.Pair, stats *statistics.CurrencyPairStatistic) {
+		for i := range stats.Events {
+			h := stats.Events[i].Holdings
+			ts, offset, closePrice := h.Timestamp, h.Offset, decimal.Zero
+			if ev := stats.Events[i].DataEvent; ev != nil {
+				ts, offset, closePrice = ev.GetTime(), ev.GetOffset(), ev.GetClosePrice()
+			}
+			t.Rows = append(t.Rows, []interface{}{
+				ts,
+				offset,
+				exch,
+				a.String(),
+				p.String(),
+				closePrice,
+				h.BaseSize,
+				h.BaseValue,
+				h.QuoteSize,
+				h.TotalValue,
+				h.ChangeInTotalValuePercent,
+				h.BoughtAmount,
+				h.SoldAmount,
+				h.TotalFees,
+				h.UnrealisedPNL,
+				h.RealisedPNL,
+			})
+		}
+	})
+	return t
+}
+
+// fundingSnapshots creates a row for every funding item snapshot
+func (d *Data) fundingSnapshots() *table {
+	t := &table{
+		Name: fundingTable,
+		Columns: []column{
+			{Name: "time", Type: timestampColumn},
+			{Name: "exchange", Type: stringColumn},
+			{Name: "asset", Type: stringColumn},
+			{Name: "currency", Type: stringColumn},
+			{Name: "available", Type: decimalColumn},
+			{Name: "usd-close-price", Type: decimalColumn},
+			{Name: "usd-value", Type: decimalColumn},
+		},
+	}
+	if d.Statistics.FundingStatistics == nil {
+		return t
+	}
+	for i := range d.Statistics.FundingStatistics.Items {
+		item := d.Statistics.FundingStatistics.Items[i].ReportItem
+		if item == nil {
+			continue
+		}
+		for j := range item.Snapshots {
+			t.Rows = append(t.Rows, []interface{}{
+				item.Snapshots[j].Time,
+				item.Exchange,
+				item.Asset.String(),
+				item.Currency.String(),
+				item.Snapshots[j].Available,
+				item.Snapshots[j].USDClosePrice,
+				item.Snapshots[j].USDValue,
+			})
+		}
+	}
+	return t
+}
+
+// forEachPair runs f against each currency pair's statistics, sorted by
+// exchange, asset and pair so exports are consistently ordered
+func (d *Data) forEachPair(f func(string, asset.Item, currency.Pair, *statistics.CurrencyPairStatistic)) {
+	exchanges := make([]string, 0, len(d.Statistics.ExchangeAssetPairStatistics))
+	for exch := range d.Statistics.ExchangeAssetPairStatistics {
+		exchanges = append(exchanges, exch)
+	}
+	sort.Strings(exchanges)
+	for _, exch := range exchanges {
+		assets := make([]asset.Item, 0, len(d.Statistics.ExchangeAssetPairStatistics[exch]))
+		for a := range d.Statistics.ExchangeAssetPairStatistics[exch] {
+			assets = append(assets, a)
+		}
+		sort.Slice(assets, func(i, j int) bool {
+			return assets[i] < assets[j]
+		})
+		for _, a := range assets {
+			pairs := make(currency.Pairs, 0, len(d.Statistics.ExchangeAssetPairStatistics[exch][a]))
+			for p := range d.Statistics.ExchangeAssetPairStatistics[exch][a] {
+				pairs = append(pairs, p)
+			}
+			sort.Slice(pairs, func(i, j int) bool {
+				return pairs[i].String() < pairs[j].String()
+			})
+			for _, p := range pairs {
+				f(exch, a, p, d.Statistics.ExchangeAssetPairStatistics[exch][a][p])
+			}
+		}
+	}
+}
+
+// writeFile creates the file and buffers the write function's output to it
+func writeFile(path string, write func(*bufio.Writer) error) (err error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+	w := bufio.NewWriter(f)
+	err = write(w)
+	if err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// writeCSV writes a header row followed by each row
+func (t *table) writeCSV(w *bufio.Writer) error {
+	c := csv.NewWriter(w)
+	header := make([]string, len(t.Columns))
+	for i := range t.Columns {
+		header[i] = t.Columns[i].Name
+	}
+	err := c.Write(header)
+	if err != nil {
+		return err
+	}
+	record := make([]string, len(t.Columns))
+	for i := range t.Rows {
+		for j := range t.Rows[i] {
+			record[j] = formatCSVValue(t.Rows[i][j])
+		}
+		err = c.Write(record)
+		if err != nil {
+			return err
+		}
+	}
+	c.Flush()
+	return c.Error()
+}
+
+// writeJSONLines writes each row as a JSON object keyed by column name, with
+// keys kept in column order
+func (t *table) writeJSONLines(w *bufio.Writer) error {
+	for i := range t.Rows {
+		err := w.WriteByte('{')
+		if err != nil {
+			return err
+		}
+		for j := range t.Rows[i] {
+			if j > 0 {
+				err = w.WriteByte(',')
+				if err != nil {
+					return err
+				}
+			}
+			var key, value []byte
+			key, err = json.Marshal(t.Columns[j].Name)
+			if err != nil {
+				return err
+			}
+			value, err = json.Marshal(formatJSONValue(t.Rows[i][j]))
+			if err != nil {
+				return err
+			}
+			_, err = w.Write(key)
+			if err != nil {
+				return err
+			}
+			err = w.WriteByte(':')
+			if err != nil {
+				return err
+			}
+			_, err = w.Write(value)
+			if err != nil {
+				return err
+			}
+		}
+		_, err = w.WriteString("}\n")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeColumnar writes the table with the values of each column grouped
+// together, mirroring how columnar formats such as Parquet lay out data
+func (t *table) writeColumnar(w *bufio.Writer) error {
+	resp := columnarTable{
+		Name:    t.Name,
+		Rows:    len(t.Rows),
+		Columns: make([]columnarColumn, len(t.Columns)),
+	}
+	for i := range t.Columns {
+		resp.Columns[i] = columnarColumn{
+			Name:   t.Columns[i].Name,
+			Type:   t.Columns[i].Type,
+			Values: make([]interface{}, len(t.Rows)),
+		}
+		for j := range t.Rows {
+			resp.Columns[i].Values[j] = formatJSONValue(t.Rows[j][i])
+		}
+	}
+	return json.NewEncoder(w).Encode(resp)
+}
+
+func formatCSVValue(v interface{}) string {
+	switch val := v.(type) {
+	case time.Time:
+		return val.UTC().Format(time.RFC3339Nano)
+	case decimal.Decimal:
+		return val.String()
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+// formatJSONValue outputs decimals as JSON numbers without losing precision
+// and times as RFC3339 strings
+func formatJSONValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case time.Time:
+		return val.UTC().Format(time.RFC3339Nano)
+	case decimal.Decimal:
+		return json.Number(val.String())
+	default:
+		return val
+	}
+}
@@ -0,0 +1,283 @@
+package report
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/compliance"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var exportTime = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// exportData returns two events for a pair, with an order placed on the
+// second event, along with funding snapshots
+func exportData(t *testing.T) *Data {
+	t.Helper()
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	ord := compliance.SnapshotOrder{
+		ClosePrice:          decimal.NewFromInt(1337),
+		VolumeAdjustedPrice: decimal.NewFromInt(1337),
+		SlippageRate:        decimal.NewFromFloat(0.99),
+		CostBasis:           decimal.NewFromInt(1323),
+		Detail: &gctorder.Detail{
+			ID:     "1",
+			Side:   gctorder.Buy,
+			Type:   gctorder.Market,
+			Price:  1323.63,
+			Amount: 1,
+			Fee:    1.5,
+			Date:   exportTime.Add(time.Hour),
+		},
+	}
+	events := make([]statistics.EventStore, 2)
+	for i := range events {
+		events[i] = statistics.EventStore{
+			DataEvent: &kline.Kline{
+				Base: event.Base{
+					Offset:       int64(i + 1),
+					Exchange:     testExchange,
+					Time:         exportTime.Add(time.Hour * time.Duration(i)),
+					CurrencyPair: p,
+					AssetType:    asset.Spot,
+				},
+				Close: decimal.NewFromInt(1337),
+			},
+			Holdings: holdings.Holding{
+				QuoteSize:  decimal.NewFromInt(1000),
+				TotalValue: decimal.NewFromInt(1000),
+			},
+		}
+	}
+	events[1].Transactions = compliance.Snapshot{
+		Orders:    []compliance.SnapshotOrder{ord},
+		Timestamp: exportTime.Add(time.Hour),
+		Offset:    2,
+	}
+	events[1].Holdings.BaseSize = decimal.NewFromInt(1)
+	events[1].Holdings.BoughtAmount = decimal.NewFromInt(1)
+	return &Data{
+		Config: &config.Config{
+			Nickname: "export",
+			ReportSettings: &config.ReportSettings{
+				ExportFormats: []string{config.CSVExport, config.JSONLinesExport, config.ColumnarExport},
+			},
+		},
+		OutputPath: t.TempDir(),
+		Statistics: &statistics.Statistic{
+			StrategyName: "dca",
+			ExchangeAssetPairStatistics: map[string]map[asset.Item]map[currency.Pair]*statistics.CurrencyPairStatistic{
+				testExchange: {
+					asset.Spot: {
+						p: {Events: events},
+					},
+				},
+			},
+			FundingStatistics: &statistics.FundingStatistics{
+				Items: []statistics.FundingItemStatistics{
+					{
+						ReportItem: &funding.ReportItem{
+							Exchange: testExchange,
+							Asset:    asset.Spot,
+							Currency: currency.USDT,
+							Snapshots: []funding.ItemSnapshot{
+								{Time: exportTime, Available: decimal.NewFromInt(1000)},
+								{Time: exportTime.Add(time.Hour), Available: decimal.NewFromFloat(-323.63)},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// exportedFile returns the single exported file with the suffix
+func exportedFile(t *testing.T, dir, suffix string) string {
+	t.Helper()
+	matches, err := filepath.Glob(filepath.Join(dir, "export-dca-*"+suffix))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matches) != 1 {
+		t.Fatalf("received '%v' files expected '%v' for %v", len(matches), 1, suffix)
+	}
+	return matches[0]
+}
+
+func TestExportResults(t *testing.T) {
+	t.Parallel()
+	d := &Data{}
+	err := d.ExportResults()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	d.Config = &config.Config{ReportSettings: &config.ReportSettings{ExportFormats: []string{config.CSVExport}}}
+	err = d.ExportResults()
+	if !errors.Is(err, errStatisticsUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errStatisticsUnset)
+	}
+	d = exportData(t)
+	d.Config.ReportSettings.ExportFormats = []string{"parquet"}
+	err = d.ExportResults()
+	if !errors.Is(err, errUnsupportedExportFormat) {
+		t.Errorf("received '%v' expected '%v'", err, errUnsupportedExportFormat)
+	}
+
+	d = exportData(t)
+	err = d.ExportResults()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	files, err := os.ReadDir(d.OutputPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 9 {
+		t.Errorf("received '%v' expected '%v'", len(files), 9)
+	}
+}
+
+func TestExportCSV(t *testing.T) {
+	t.Parallel()
+	d := exportData(t)
+	d.Config.ReportSettings.ExportFormats = []string{config.CSVExport}
+	err := d.ExportResults()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	f, err := os.Open(exportedFile(t, d.OutputPath, "-trades.csv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(records), 2)
+	}
+	expected := []string{"2021-01-01T01:00:00Z", "2", testExchange, "spot", "BTCUSDT", "1", "BUY", "MARKET", "1323.63", "1", "1.5", "1337", "1337", "0.99", "1323"}
+	if strings.Join(records[1], ",") != strings.Join(expected, ",") {
+		t.Errorf("received '%v' expected '%v'", records[1], expected)
+	}
+
+	f2, err := os.Open(exportedFile(t, d.OutputPath, "-holdings.csv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f2.Close()
+	records, err = csv.NewReader(f2).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 3 {
+		t.Errorf("received '%v' expected '%v'", len(records), 3)
+	}
+}
+
+func TestExportJSONLines(t *testing.T) {
+	t.Parallel()
+	d := exportData(t)
+	d.Config.ReportSettings.ExportFormats = []string{config.JSONLinesExport}
+	err := d.ExportResults()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	f, err := os.Open(exportedFile(t, d.OutputPath, "-funding.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var lines []map[string]interface{}
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		var line map[string]interface{}
+		err = json.Unmarshal(s.Bytes(), &line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(lines), 2)
+	}
+	if lines[1]["available"] != -323.63 {
+		t.Errorf("received '%v' expected '%v'", lines[1]["available"], -323.63)
+	}
+	if lines[1]["currency"] != "USDT" {
+		t.Errorf("received '%v' expected '%v'", lines[1]["currency"], "USDT")
+	}
+	if lines[0]["time"] != "2021-01-01T00:00:00Z" {
+		t.Errorf("received '%v' expected '%v'", lines[0]["time"], "2021-01-01T00:00:00Z")
+	}
+}
+
+func TestExportColumnar(t *testing.T) {
+	t.Parallel()
+	d := exportData(t)
+	d.Config.ReportSettings.ExportFormats = []string{config.ColumnarExport}
+	err := d.ExportResults()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	data, err := os.ReadFile(exportedFile(t, d.OutputPath, "-holdings.columnar.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resp columnarTable
+	err = json.Unmarshal(data, &resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Name != holdingsTable {
+		t.Errorf("received '%v' expected '%v'", resp.Name, holdingsTable)
+	}
+	if resp.Rows != 2 {
+		t.Errorf("received '%v' expected '%v'", resp.Rows, 2)
+	}
+	for i := range resp.Columns {
+		if len(resp.Columns[i].Values) != resp.Rows {
+			t.Errorf("received '%v' expected '%v' for %v", len(resp.Columns[i].Values), resp.Rows, resp.Columns[i].Name)
+		}
+		if resp.Columns[i].Name != "base-size" {
+			continue
+		}
+		if resp.Columns[i].Type != decimalColumn {
+			t.Errorf("received '%v' expected '%v'", resp.Columns[i].Type, decimalColumn)
+		}
+		if resp.Columns[i].Values[1] != 1.0 {
+			t.Errorf("received '%v' expected '%v'", resp.Columns[i].Values[1], 1)
+		}
+	}
+}
+
+func TestTradeBlotterDeduplicatesOrders(t *testing.T) {
+	t.Parallel()
+	d := exportData(t)
+	for _, m := range d.Statistics.ExchangeAssetPairStatistics[testExchange][asset.Spot] {
+		// compliance snapshots are cumulative, so later events repeat orders
+		m.Events = append(m.Events, m.Events[1])
+	}
+	blotter := d.tradeBlotter()
+	if len(blotter.Rows) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(blotter.Rows), 1)
+	}
+}
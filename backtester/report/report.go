@@ -50,15 +50,7 @@ func (d *Data) GenerateReport() error {
 			filepath.Join(d.TemplatePath),
 		),
 	)
-	var nickName string
-	if d.Config.Nickname != "" {
-		nickName = d.Config.Nickname + "-"
-	}
-	fileName := fmt.Sprintf(
-		"%v%v-%v.html",
-		nickName,
-		d.Statistics.StrategyName,
-		time.Now().Format("2006-01-02-15-04-05"))
+	fileName := d.fileNamePrefix() + ".html"
 	var f *os.File
 	f, err = os.Create(
 		filepath.Join(d.OutputPath,
@@ -83,6 +75,20 @@ func (d *Data) GenerateReport() error {
 	return nil
 }
 
+// fileNamePrefix names result files after the config nickname, strategy and
+// the current time
+func (d *Data) fileNamePrefix() string {
+	var nickName string
+	if d.Config.Nickname != "" {
+		nickName = d.Config.Nickname + "-"
+	}
+	return fmt.Sprintf(
+		"%v%v-%v",
+		nickName,
+		d.Statistics.StrategyName,
+		time.Now().Format("2006-01-02-15-04-05"))
+}
+
 // CreateUSDTotalsChart used for creating a chart in the HTML report
 // to show how much the overall assets are worth over time
 func (d *Data) CreateUSDTotalsChart() []TotalsChart {
@@ -150,13 +156,22 @@ func (d *Data) AddKlineItem(k *kline.Item) {
 }
 
 // UpdateItem updates an existing kline item for LIVE data usage
+// matching on exchange, asset and pair. Unmatched items are added
 func (d *Data) UpdateItem(k *kline.Item) {
-	if len(d.OriginalCandles) == 0 {
-		d.OriginalCandles = append(d.OriginalCandles, k)
-	} else {
-		d.OriginalCandles[0].Candles = append(d.OriginalCandles[0].Candles, k.Candles...)
-		d.OriginalCandles[0].RemoveDuplicates()
+	for i := range d.OriginalCandles {
+		if d.OriginalCandles[i] == k {
+			return
+		}
+		if d.OriginalCandles[i].Exchange == k.Exchange &&
+			d.OriginalCandles[i].Asset == k.Asset &&
+			d.OriginalCandles[i].Pair.Equal(k.Pair) {
+			d.OriginalCandles[i].Candles = append(d.OriginalCandles[i].Candles, k.Candles...)
+			d.OriginalCandles[i].RemoveDuplicates()
+			d.OriginalCandles[i].SortCandlesByTimestamp(false)
+			return
+		}
 	}
+	d.OriginalCandles = append(d.OriginalCandles, k)
 }
 
 // enhanceCandles will enhance candle data with order information allowing
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -22,6 +23,21 @@ import (
 
 const testExchange = "binance"
 
+// monteCarloDistribution returns a distribution with one iteration at the lower
+// and upper values
+func monteCarloDistribution(lower, upper int64) statistics.Distribution {
+	return statistics.Distribution{
+		Mean:   decimal.NewFromInt(lower + upper).Div(decimal.NewFromInt(2)),
+		Median: decimal.NewFromInt(lower),
+		Lower:  decimal.NewFromInt(lower),
+		Upper:  decimal.NewFromInt(upper),
+		Histogram: []statistics.HistogramBin{
+			{Lower: decimal.NewFromInt(lower), Upper: decimal.NewFromInt(lower), Count: 1},
+			{Lower: decimal.NewFromInt(upper), Upper: decimal.NewFromInt(upper), Count: 1},
+		},
+	}
+}
+
 func TestGenerateReport(t *testing.T) {
 	t.Parallel()
 	e := testExchange
@@ -233,6 +249,10 @@ func TestGenerateReport(t *testing.T) {
 		Statistics: &statistics.Statistic{
 			StrategyName: "testStrat",
 			RiskFreeRate: decimal.NewFromFloat(0.03),
+			MonteCarlo: &statistics.MonteCarloSettings{
+				Iterations:      1000,
+				ConfidenceLevel: decimal.NewFromFloat(0.95),
+			},
 			ExchangeAssetPairStatistics: map[string]map[asset.Item]map[currency.Pair]*statistics.CurrencyPairStatistic{
 				e: {
 					a: {
@@ -249,6 +269,59 @@ func TestGenerateReport(t *testing.T) {
 							FinalOrders:              compliance.Snapshot{},
 							ArithmeticRatios:         &statistics.Ratios{},
 							GeometricRatios:          &statistics.Ratios{},
+							FuturesStatistics: &statistics.FuturesStatistics{
+								Leverage:     decimal.NewFromInt(5),
+								RealisedPNL:  decimal.NewFromInt(1337),
+								Liquidations: 1,
+							},
+							BenchmarkStatistics: &statistics.BenchmarkStatistics{
+								Benchmark:         "basket index",
+								BenchmarkMovement: decimal.NewFromInt(10),
+								Alpha:             decimal.NewFromFloat(0.1),
+								Beta:              decimal.NewFromFloat(0.5),
+								TrackingError:     decimal.NewFromFloat(0.2),
+								UpCaptureRatio:    decimal.NewFromFloat(0.4),
+								DownCaptureRatio:  decimal.NewFromFloat(0.6),
+							},
+							RollingStatistics: []statistics.RollingStatistic{
+								{
+									Time:        time.Now(),
+									SharpeRatio: decimal.NewFromFloat(1.5),
+									Volatility:  decimal.NewFromFloat(0.3),
+								},
+							},
+							MonthlyReturns: []statistics.MonthlyReturn{
+								{
+									Month:           time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+									StrategyReturn:  decimal.NewFromInt(5),
+									BenchmarkReturn: decimal.NewFromInt(3),
+									ExcessReturn:    decimal.NewFromInt(2),
+								},
+							},
+							MonteCarlo: &statistics.MonteCarloStatistics{
+								Iterations:      1000,
+								ConfidenceLevel: decimal.NewFromFloat(0.95),
+								Seed:            1337,
+								Trades:          2,
+								InitialEquity:   decimal.NewFromInt(100),
+								Actual: statistics.MonteCarloIteration{
+									FinalEquity: decimal.NewFromInt(102),
+									MaxDrawdown: decimal.NewFromInt(3),
+									SharpeRatio: decimal.NewFromFloat(0.1),
+								},
+								Bootstrap: &statistics.MonteCarloSimulation{
+									Method:      statistics.BootstrapMethod,
+									FinalEquity: monteCarloDistribution(94, 110),
+									MaxDrawdown: monteCarloDistribution(0, 6),
+									SharpeRatio: monteCarloDistribution(-1, 1),
+								},
+								Shuffle: &statistics.MonteCarloSimulation{
+									Method:      statistics.ShuffleMethod,
+									FinalEquity: monteCarloDistribution(102, 102),
+									MaxDrawdown: monteCarloDistribution(2, 3),
+									SharpeRatio: monteCarloDistribution(0, 1),
+								},
+							},
 						},
 					},
 				},
@@ -317,15 +390,72 @@ func TestGenerateReport(t *testing.T) {
 	}
 	d.OutputPath = tempDir
 	d.Config.StrategySettings.DisableUSDTracking = true
+	d.Config.StrategySettings.Sleeves = []config.SleeveSettings{
+		{
+			Name:         "dca-sleeve",
+			StrategyName: "dollarcostaverage",
+			Weight:       decimal.NewFromInt(1),
+		},
+	}
+	d.Statistics.Sleeves = []*statistics.SleeveStatistics{
+		{
+			Name:   "dca-sleeve",
+			Weight: decimal.NewFromInt(1),
+			Statistic: &statistics.Statistic{
+				StrategyName:                "dollarcostaverage",
+				ExchangeAssetPairStatistics: d.Statistics.ExchangeAssetPairStatistics,
+			},
+			ValuesOverTime: []statistics.ValueAtTime{
+				{Time: time.Now(), Value: decimal.NewFromInt(1337)},
+			},
+		},
+	}
 	d.Statistics.FundingStatistics = &statistics.FundingStatistics{
 		Report: &funding.Report{
 			DisableUSDTracking: true,
+			Positions: []funding.PositionReport{
+				{
+					Exchange:   e,
+					Asset:      a,
+					Pair:       p,
+					Collateral: p.Quote,
+					Leverage:   decimal.NewFromInt(5),
+				},
+			},
+			SleeveTransfers: []funding.SleeveTransfer{
+				{
+					Time:     time.Now(),
+					From:     "dca-sleeve",
+					To:       "rsi-sleeve",
+					Exchange: e,
+					Asset:    a,
+					Currency: p.Quote,
+					Amount:   decimal.NewFromInt(1337),
+					USDValue: decimal.NewFromInt(1337),
+				},
+			},
 		},
 	}
 	err = d.GenerateReport()
 	if err != nil {
 		t.Error(err)
 	}
+	matches, err := filepath.Glob(filepath.Join(tempDir, "*.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matches) != 1 {
+		t.Fatalf("received '%v' reports expected '%v'", len(matches), 1)
+	}
+	report, err := os.ReadFile(matches[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{"basket index", "January 2021", "Rolling Sharpe Ratio", "Sleeve Statistics", "Rebalance Transfers", "rsi-sleeve"} {
+		if !strings.Contains(string(report), expected) {
+			t.Errorf("expected report to contain '%v'", expected)
+		}
+	}
 }
 
 func TestEnhanceCandles(t *testing.T) {
@@ -464,3 +594,42 @@ func TestEnhanceCandles(t *testing.T) {
 		t.Error("expected enhanced candles")
 	}
 }
+
+func TestUpdateItem(t *testing.T) {
+	t.Parallel()
+	tt := time.Now()
+	d := Data{}
+	btc := &gctkline.Item{
+		Exchange: testExchange,
+		Asset:    asset.Spot,
+		Pair:     currency.NewPair(currency.BTC, currency.USDT),
+		Candles:  []gctkline.Candle{{Time: tt}},
+	}
+	d.UpdateItem(btc)
+	d.UpdateItem(btc)
+	if len(d.OriginalCandles) != 1 {
+		t.Fatalf("received: %v, expected: %v", len(d.OriginalCandles), 1)
+	}
+
+	d.UpdateItem(&gctkline.Item{
+		Exchange: testExchange,
+		Asset:    asset.Spot,
+		Pair:     currency.NewPair(currency.BTC, currency.USDT),
+		Candles:  []gctkline.Candle{{Time: tt}, {Time: tt.Add(time.Minute)}},
+	})
+	if len(d.OriginalCandles) != 1 {
+		t.Fatalf("received: %v, expected: %v", len(d.OriginalCandles), 1)
+	}
+	if len(d.OriginalCandles[0].Candles) != 2 {
+		t.Errorf("received: %v, expected: %v", len(d.OriginalCandles[0].Candles), 2)
+	}
+
+	d.UpdateItem(&gctkline.Item{
+		Exchange: testExchange,
+		Asset:    asset.Spot,
+		Pair:     currency.NewPair(currency.ETH, currency.USDT),
+	})
+	if len(d.OriginalCandles) != 2 {
+		t.Errorf("received: %v, expected: %v", len(d.OriginalCandles), 2)
+	}
+}
@@ -17,13 +17,30 @@ import (
 const maxChartLimit = 1100
 
 var (
-	errNoCandles       = errors.New("no candles to enhance")
-	errStatisticsUnset = errors.New("unable to proceed with unset Statistics property")
+	errNoCandles               = errors.New("no candles to enhance")
+	errStatisticsUnset         = errors.New("unable to proceed with unset Statistics property")
+	errUnsupportedExportFormat = errors.New("unsupported export format")
+)
+
+// Exported result tables
+const (
+	tradesTable   = "trades"
+	holdingsTable = "holdings"
+	fundingTable  = "funding"
+)
+
+// Column types of exported result tables
+const (
+	stringColumn    = "string"
+	intColumn       = "int"
+	decimalColumn   = "decimal"
+	timestampColumn = "timestamp"
 )
 
 // Handler contains all functions required to generate statistical reporting for backtesting results
 type Handler interface {
 	GenerateReport() error
+	ExportResults() error
 	AddKlineItem(*kline.Item)
 	UpdateItem(*kline.Item)
 	UseDarkMode(bool)
@@ -44,6 +61,35 @@ type Data struct {
 	Prettify              PrettyNumbers
 }
 
+// table holds rows of results to be exported. Row values are strings, int64s,
+// decimals or times in column order
+type table struct {
+	Name    string
+	Columns []column
+	Rows    [][]interface{}
+}
+
+// column describes an exported column
+type column struct {
+	Name string
+	Type string
+}
+
+// columnarTable is the columnar export layout, holding every value of a
+// column together
+type columnarTable struct {
+	Name    string           `json:"name"`
+	Rows    int              `json:"rows"`
+	Columns []columnarColumn `json:"columns"`
+}
+
+// columnarColumn holds every value of a column in row order
+type columnarColumn struct {
+	Name   string        `json:"name"`
+	Type   string        `json:"type"`
+	Values []interface{} `json:"values"`
+}
+
 // TotalsChart holds chart plot data
 // to render charts in the report
 type TotalsChart struct {
@@ -0,0 +1,369 @@
+package rpcserver
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/backtester/backtest"
+	"github.com/thrasher-corp/gocryptotrader/backtester/btrpc"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// NewJobManager sets up a job manager which runs up to maxConcurrentJobs at
+// once, writing reports within the output path
+func NewJobManager(templatePath, outputPath string, maxConcurrentJobs int) (*JobManager, error) {
+	if maxConcurrentJobs < 0 {
+		return nil, errMaxConcurrentJobsNegative
+	}
+	if maxConcurrentJobs == 0 {
+		maxConcurrentJobs = DefaultMaxConcurrentJobs
+	}
+	return &JobManager{
+		slots:        make(chan struct{}, maxConcurrentJobs),
+		dataSlot:     make(chan struct{}, 1),
+		templatePath: templatePath,
+		outputPath:   outputPath,
+		newRunner:    newBackTestRunner,
+	}, nil
+}
+
+// backTestRunner runs a job with a backtester
+type backTestRunner struct {
+	*backtest.BackTest
+}
+
+func newBackTestRunner(cfg *config.Config, templatePath, outputPath string) (runner, error) {
+	bt, err := backtest.NewFromConfig(cfg, templatePath, outputPath)
+	if err != nil {
+		return nil, err
+	}
+	return &backTestRunner{bt}, nil
+}
+
+// Results calculates statistics, generates the report and any configured
+// exports and returns the statistics as JSON
+func (b *backTestRunner) Results() (string, error) {
+	err := b.Statistic.CalculateAllResults()
+	if err != nil {
+		return "", err
+	}
+	err = b.Reports.GenerateReport()
+	if err != nil {
+		return "", err
+	}
+	err = b.Reports.ExportResults()
+	if err != nil {
+		return "", err
+	}
+	return b.Statistic.Serialise()
+}
+
+// Submit validates a strategy config and queues it to be run as a job
+func (m *JobManager) Submit(cfg *config.Config, source string) (*btrpc.Job, error) {
+	if m == nil {
+		return nil, fmt.Errorf("job manager %w", common.ErrNilPointer)
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if cfg.OptimisationSettings != nil {
+		return nil, errOptimisationUnsupported
+	}
+	err := cfg.Validate()
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	j := &job{
+		id:           id.String(),
+		nickname:     cfg.Nickname,
+		strategyName: cfg.StrategySettings.Name,
+		source:       source,
+		live:         cfg.DataSettings.LiveData != nil,
+		config:       cfg,
+		status:       StatusQueued,
+		created:      time.Now(),
+		cancel:       make(chan struct{}),
+		outputPath:   filepath.Join(m.outputPath, id.String()),
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	if m.shutdown {
+		return nil, errJobManagerShutdown
+	}
+	m.jobs = append(m.jobs, j)
+	m.wg.Add(1)
+	go m.execute(j)
+	return j.toRPC(), nil
+}
+
+// execute waits for a free slot then runs the job, resetting its backtester
+// once finished. Stopping a live job completes it, whereas a stopped
+// backtest is cancelled without results
+func (m *JobManager) execute(j *job) {
+	defer m.wg.Done()
+	if usesSharedData(j.config) {
+		select {
+		case m.dataSlot <- struct{}{}:
+		case <-j.cancel:
+			m.finish(j, StatusCancelled, nil)
+			return
+		}
+		defer func() {
+			<-m.dataSlot
+		}()
+	}
+	select {
+	case m.slots <- struct{}{}:
+	case <-j.cancel:
+		m.finish(j, StatusCancelled, nil)
+		return
+	}
+	defer func() {
+		<-m.slots
+	}()
+
+	m.m.Lock()
+	if j.cancelled {
+		m.m.Unlock()
+		m.finish(j, StatusCancelled, nil)
+		return
+	}
+	j.status = StatusRunning
+	j.started = time.Now()
+	m.m.Unlock()
+	log.Infof(log.BackTester, "running job %v %v", j.id, j.nickname)
+
+	err := os.MkdirAll(j.outputPath, 0o770)
+	if err != nil {
+		m.finish(j, StatusFailed, err)
+		return
+	}
+	r, err := m.newRunner(j.config, m.templatePath, j.outputPath)
+	if err != nil {
+		m.finish(j, StatusFailed, err)
+		return
+	}
+	defer r.Reset()
+	m.m.Lock()
+	j.runner = r
+	if j.cancelled {
+		r.Stop()
+	}
+	m.m.Unlock()
+
+	if j.live {
+		err = r.RunLive()
+	} else {
+		err = r.Run()
+	}
+	if err != nil {
+		m.finish(j, StatusFailed, err)
+		return
+	}
+	m.m.Lock()
+	cancelled := j.cancelled
+	m.m.Unlock()
+	if cancelled && !j.live {
+		m.finish(j, StatusCancelled, nil)
+		return
+	}
+
+	stats, err := r.Results()
+	if err != nil {
+		m.finish(j, StatusFailed, err)
+		return
+	}
+	reports, err := filepath.Glob(filepath.Join(j.outputPath, "*.html"))
+	if err != nil {
+		m.finish(j, StatusFailed, err)
+		return
+	}
+	m.m.Lock()
+	j.statistics = stats
+	if len(reports) > 0 {
+		j.reportPath = reports[len(reports)-1]
+	}
+	m.m.Unlock()
+	m.finish(j, StatusComplete, nil)
+}
+
+// usesSharedData returns whether a job loads database or API data, which
+// cannot be loaded by jobs running at the same time
+func usesSharedData(cfg *config.Config) bool {
+	return cfg.DataSettings.DatabaseData != nil || cfg.DataSettings.APIData != nil
+}
+
+// finish records the final status of a job
+func (m *JobManager) finish(j *job, status string, err error) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	j.status = status
+	j.err = err
+	j.finished = time.Now()
+	if err != nil {
+		log.Errorf(log.BackTester, "job %v %v failed, %v", j.id, j.nickname, err)
+		return
+	}
+	log.Infof(log.BackTester, "job %v %v %v", j.id, j.nickname, status)
+}
+
+// List returns every job in the order they were submitted
+func (m *JobManager) List() []*btrpc.Job {
+	m.m.Lock()
+	defer m.m.Unlock()
+	resp := make([]*btrpc.Job, len(m.jobs))
+	for i := range m.jobs {
+		resp[i] = m.jobs[i].toRPC()
+	}
+	return resp
+}
+
+// Get returns a job by its ID
+func (m *JobManager) Get(id string) (*btrpc.Job, error) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	j, err := m.getJob(id)
+	if err != nil {
+		return nil, err
+	}
+	return j.toRPC(), nil
+}
+
+// Cancel stops a queued or running job
+func (m *JobManager) Cancel(id string) (*btrpc.Job, error) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	j, err := m.getJob(id)
+	if err != nil {
+		return nil, err
+	}
+	if j.isFinished() {
+		return nil, fmt.Errorf("%w, job %v is %v", errJobFinished, id, j.status)
+	}
+	j.cancelRun()
+	return j.toRPC(), nil
+}
+
+// Result returns the statistics JSON and HTML report of a completed job
+func (m *JobManager) Result(id string) (*btrpc.GetJobResultResponse, error) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	j, err := m.getJob(id)
+	if err != nil {
+		return nil, err
+	}
+	if j.status != StatusComplete {
+		return nil, fmt.Errorf("%w, job %v is %v", errJobNotComplete, id, j.status)
+	}
+	resp := &btrpc.GetJobResultResponse{
+		Job:        j.toRPC(),
+		Statistics: j.statistics,
+	}
+	if j.reportPath != "" {
+		report, err := os.ReadFile(j.reportPath)
+		if err != nil {
+			return nil, err
+		}
+		resp.Report = string(report)
+	}
+	return resp, nil
+}
+
+// Remove removes a finished job. Its report is left in the output path
+func (m *JobManager) Remove(id string) (*btrpc.Job, error) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	for i := range m.jobs {
+		if m.jobs[i].id != id {
+			continue
+		}
+		if !m.jobs[i].isFinished() {
+			return nil, fmt.Errorf("%w, job %v is %v", errJobNotFinished, id, m.jobs[i].status)
+		}
+		resp := m.jobs[i].toRPC()
+		m.jobs = append(m.jobs[:i], m.jobs[i+1:]...)
+		return resp, nil
+	}
+	return nil, fmt.Errorf("%w %v", errJobNotFound, id)
+}
+
+// Shutdown stops every queued and running job, prevents new jobs from
+// being submitted and waits for stopped jobs to finish
+func (m *JobManager) Shutdown() {
+	m.m.Lock()
+	m.shutdown = true
+	for i := range m.jobs {
+		if !m.jobs[i].isFinished() {
+			m.jobs[i].cancelRun()
+		}
+	}
+	m.m.Unlock()
+	m.wg.Wait()
+}
+
+func (m *JobManager) getJob(id string) (*job, error) {
+	for i := range m.jobs {
+		if m.jobs[i].id == id {
+			return m.jobs[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w %v", errJobNotFound, id)
+}
+
+// cancelRun flags the job as cancelled and stops it. The job manager's mutex
+// must be held
+func (j *job) cancelRun() {
+	if j.cancelled {
+		return
+	}
+	j.cancelled = true
+	close(j.cancel)
+	if j.runner != nil {
+		j.runner.Stop()
+	}
+}
+
+func (j *job) isFinished() bool {
+	return j.status == StatusComplete ||
+		j.status == StatusCancelled ||
+		j.status == StatusFailed
+}
+
+// toRPC converts the job for gRPC responses. The job manager's mutex must be
+// held
+func (j *job) toRPC() *btrpc.Job {
+	resp := &btrpc.Job{
+		Id:           j.id,
+		Nickname:     j.nickname,
+		StrategyName: j.strategyName,
+		Source:       j.source,
+		Status:       j.status,
+		Live:         j.live,
+		Created:      j.created.UTC().Format(common.SimpleTimeFormatWithTimezone),
+	}
+	if j.err != nil {
+		resp.Error = j.err.Error()
+	}
+	if !j.started.IsZero() {
+		resp.Started = j.started.UTC().Format(common.SimpleTimeFormatWithTimezone)
+	}
+	if !j.finished.IsZero() {
+		resp.Finished = j.finished.UTC().Format(common.SimpleTimeFormatWithTimezone)
+	}
+	switch {
+	case j.status == StatusComplete:
+		resp.Progress = 100
+	case j.runner != nil:
+		resp.Progress = j.runner.Progress().InexactFloat64()
+	}
+	return resp
+}
@@ -0,0 +1,427 @@
+package rpcserver
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+)
+
+const (
+	testStrategyFile     = "dca-api-candles.strat"
+	testLiveStrategyFile = "dca-candles-live.strat"
+	testCSVStrategyFile  = "dca-csv-candles.strat"
+)
+
+var errTestRun = errors.New("test run error")
+
+// fakeRunner runs until stopped or released, avoiding exchange API calls
+type fakeRunner struct {
+	outputPath string
+	runErr     error
+	release    chan struct{}
+	stop       chan struct{}
+	stopOnce   sync.Once
+	resets     int32
+}
+
+func (f *fakeRunner) Run() error {
+	select {
+	case <-f.release:
+	case <-f.stop:
+	}
+	return f.runErr
+}
+
+func (f *fakeRunner) RunLive() error {
+	<-f.stop
+	return f.runErr
+}
+
+func (f *fakeRunner) Stop() {
+	f.stopOnce.Do(func() {
+		close(f.stop)
+	})
+}
+
+func (f *fakeRunner) Reset() {
+	atomic.AddInt32(&f.resets, 1)
+}
+
+func (f *fakeRunner) Progress() decimal.Decimal {
+	return decimal.NewFromInt(50)
+}
+
+func (f *fakeRunner) Results() (string, error) {
+	err := os.WriteFile(filepath.Join(f.outputPath, "report.html"), []byte("<html></html>"), 0o600)
+	if err != nil {
+		return "", err
+	}
+	return `{"total-orders":1}`, nil
+}
+
+func testJobManager(t *testing.T, maxJobs int, runErr error) (m *JobManager, release chan struct{}) {
+	t.Helper()
+	m, err := NewJobManager("", t.TempDir(), maxJobs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// shutdown waits for running jobs to finish before the output path is removed
+	t.Cleanup(m.Shutdown)
+	release = make(chan struct{})
+	m.newRunner = func(_ *config.Config, _, outputPath string) (runner, error) {
+		return &fakeRunner{
+			outputPath: outputPath,
+			runErr:     runErr,
+			release:    release,
+			stop:       make(chan struct{}),
+		}, nil
+	}
+	return m, release
+}
+
+func testConfig(t *testing.T) *config.Config {
+	t.Helper()
+	return testConfigFromFile(t, testStrategyFile)
+}
+
+func testConfigFromFile(t *testing.T, name string) *config.Config {
+	t.Helper()
+	cfg, err := config.ReadConfigFromFile(filepath.Join("..", "config", "examples", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cfg
+}
+
+// waitForStatus polls until the job has the expected status
+func waitForStatus(t *testing.T, m *JobManager, id, status string) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		j, err := m.Get(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if j.Status == status {
+			return
+		}
+		time.Sleep(time.Millisecond * 5)
+	}
+	t.Fatalf("job %v did not reach status %v", id, status)
+}
+
+func TestNewJobManager(t *testing.T) {
+	t.Parallel()
+	_, err := NewJobManager("", "", -1)
+	if !errors.Is(err, errMaxConcurrentJobsNegative) {
+		t.Errorf("received '%v' expected '%v'", err, errMaxConcurrentJobsNegative)
+	}
+	m, err := NewJobManager("", "", 0)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if cap(m.slots) != DefaultMaxConcurrentJobs {
+		t.Errorf("received '%v' expected '%v'", cap(m.slots), DefaultMaxConcurrentJobs)
+	}
+}
+
+func TestSubmit(t *testing.T) {
+	t.Parallel()
+	var m *JobManager
+	_, err := m.Submit(nil, "")
+	if err == nil {
+		t.Error("expected error")
+	}
+	m, release := testJobManager(t, 1, nil)
+	_, err = m.Submit(nil, "")
+	if !errors.Is(err, errNilConfig) {
+		t.Errorf("received '%v' expected '%v'", err, errNilConfig)
+	}
+	cfg := testConfig(t)
+	cfg.OptimisationSettings = &config.OptimisationSettings{}
+	_, err = m.Submit(cfg, "")
+	if !errors.Is(err, errOptimisationUnsupported) {
+		t.Errorf("received '%v' expected '%v'", err, errOptimisationUnsupported)
+	}
+	cfg = testConfig(t)
+	cfg.StrategySettings.Name = ""
+	_, err = m.Submit(cfg, "")
+	if !errors.Is(err, base.ErrStrategyNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, base.ErrStrategyNotFound)
+	}
+
+	j, err := m.Submit(testConfig(t), testStrategyFile)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if j.Status != StatusQueued {
+		t.Errorf("received '%v' expected '%v'", j.Status, StatusQueued)
+	}
+	if j.Source != testStrategyFile {
+		t.Errorf("received '%v' expected '%v'", j.Source, testStrategyFile)
+	}
+	waitForStatus(t, m, j.Id, StatusRunning)
+	close(release)
+	waitForStatus(t, m, j.Id, StatusComplete)
+
+	m.Shutdown()
+	_, err = m.Submit(testConfig(t), "")
+	if !errors.Is(err, errJobManagerShutdown) {
+		t.Errorf("received '%v' expected '%v'", err, errJobManagerShutdown)
+	}
+}
+
+func TestConcurrentJobs(t *testing.T) {
+	t.Parallel()
+	m, release := testJobManager(t, 1, nil)
+	first, err := m.Submit(testConfig(t), "")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	waitForStatus(t, m, first.Id, StatusRunning)
+	second, err := m.Submit(testConfig(t), "")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	time.Sleep(time.Millisecond * 50)
+	j, err := m.Get(second.Id)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if j.Status != StatusQueued {
+		t.Errorf("received '%v' expected '%v'", j.Status, StatusQueued)
+	}
+	j, err = m.Get(first.Id)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if j.Progress != 50 {
+		t.Errorf("received '%v' expected '%v'", j.Progress, 50)
+	}
+	close(release)
+	waitForStatus(t, m, first.Id, StatusComplete)
+	waitForStatus(t, m, second.Id, StatusComplete)
+	if jobs := m.List(); len(jobs) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(jobs), 2)
+	}
+}
+
+func TestSharedDataJobs(t *testing.T) {
+	t.Parallel()
+	m, release := testJobManager(t, 2, nil)
+	first, err := m.Submit(testConfig(t), "")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	waitForStatus(t, m, first.Id, StatusRunning)
+	// API data jobs wait for each other despite a free slot
+	second, err := m.Submit(testConfig(t), "")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	csv, err := m.Submit(testConfigFromFile(t, testCSVStrategyFile), "")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	waitForStatus(t, m, csv.Id, StatusRunning)
+	j, err := m.Get(second.Id)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if j.Status != StatusQueued {
+		t.Errorf("received '%v' expected '%v'", j.Status, StatusQueued)
+	}
+	close(release)
+	waitForStatus(t, m, first.Id, StatusComplete)
+	waitForStatus(t, m, second.Id, StatusComplete)
+	waitForStatus(t, m, csv.Id, StatusComplete)
+}
+
+func TestJobReset(t *testing.T) {
+	t.Parallel()
+	for _, runErr := range []error{nil, errTestRun} {
+		m, release := testJobManager(t, 1, runErr)
+		close(release)
+		runners := make(chan *fakeRunner, 1)
+		newRunner := m.newRunner
+		m.newRunner = func(cfg *config.Config, templatePath, outputPath string) (runner, error) {
+			r, err := newRunner(cfg, templatePath, outputPath)
+			if err != nil {
+				return nil, err
+			}
+			fr, ok := r.(*fakeRunner)
+			if !ok {
+				return nil, errTestRun
+			}
+			runners <- fr
+			return r, nil
+		}
+		j, err := m.Submit(testConfig(t), "")
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v' expected '%v'", err, nil)
+		}
+		r := <-runners
+		m.Shutdown()
+		if j, err = m.Get(j.Id); err != nil {
+			t.Fatal(err)
+		}
+		if resets := atomic.LoadInt32(&r.resets); resets != 1 {
+			t.Errorf("%v job received '%v' resets expected '%v'", j.Status, resets, 1)
+		}
+	}
+}
+
+func TestCancel(t *testing.T) {
+	t.Parallel()
+	m, release := testJobManager(t, 1, nil)
+	defer close(release)
+	_, err := m.Cancel("")
+	if !errors.Is(err, errJobNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errJobNotFound)
+	}
+	running, err := m.Submit(testConfig(t), "")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	waitForStatus(t, m, running.Id, StatusRunning)
+	queued, err := m.Submit(testConfig(t), "")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	_, err = m.Cancel(queued.Id)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	waitForStatus(t, m, queued.Id, StatusCancelled)
+	_, err = m.Cancel(running.Id)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	waitForStatus(t, m, running.Id, StatusCancelled)
+	_, err = m.Cancel(running.Id)
+	if !errors.Is(err, errJobFinished) {
+		t.Errorf("received '%v' expected '%v'", err, errJobFinished)
+	}
+	_, err = m.Result(running.Id)
+	if !errors.Is(err, errJobNotComplete) {
+		t.Errorf("received '%v' expected '%v'", err, errJobNotComplete)
+	}
+}
+
+func TestCancelLive(t *testing.T) {
+	t.Parallel()
+	m, _ := testJobManager(t, 1, nil)
+	j, err := m.Submit(testConfigFromFile(t, testLiveStrategyFile), "")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !j.Live {
+		t.Error("expected live job")
+	}
+	waitForStatus(t, m, j.Id, StatusRunning)
+	_, err = m.Cancel(j.Id)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	waitForStatus(t, m, j.Id, StatusComplete)
+}
+
+func TestFailedJob(t *testing.T) {
+	t.Parallel()
+	m, release := testJobManager(t, 1, errTestRun)
+	close(release)
+	j, err := m.Submit(testConfig(t), "")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	waitForStatus(t, m, j.Id, StatusFailed)
+	j, err = m.Get(j.Id)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if j.Error != errTestRun.Error() {
+		t.Errorf("received '%v' expected '%v'", j.Error, errTestRun)
+	}
+}
+
+func TestResult(t *testing.T) {
+	t.Parallel()
+	m, release := testJobManager(t, 1, nil)
+	_, err := m.Result("")
+	if !errors.Is(err, errJobNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errJobNotFound)
+	}
+	j, err := m.Submit(testConfig(t), "")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	waitForStatus(t, m, j.Id, StatusRunning)
+	_, err = m.Result(j.Id)
+	if !errors.Is(err, errJobNotComplete) {
+		t.Errorf("received '%v' expected '%v'", err, errJobNotComplete)
+	}
+	close(release)
+	waitForStatus(t, m, j.Id, StatusComplete)
+	resp, err := m.Result(j.Id)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.Statistics != `{"total-orders":1}` {
+		t.Errorf("received '%v' expected '%v'", resp.Statistics, `{"total-orders":1}`)
+	}
+	if resp.Report != "<html></html>" {
+		t.Errorf("received '%v' expected '%v'", resp.Report, "<html></html>")
+	}
+	if resp.Job.Progress != 100 {
+		t.Errorf("received '%v' expected '%v'", resp.Job.Progress, 100)
+	}
+}
+
+func TestRemove(t *testing.T) {
+	t.Parallel()
+	m, release := testJobManager(t, 1, nil)
+	_, err := m.Remove("")
+	if !errors.Is(err, errJobNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errJobNotFound)
+	}
+	j, err := m.Submit(testConfig(t), "")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	waitForStatus(t, m, j.Id, StatusRunning)
+	_, err = m.Remove(j.Id)
+	if !errors.Is(err, errJobNotFinished) {
+		t.Errorf("received '%v' expected '%v'", err, errJobNotFinished)
+	}
+	close(release)
+	waitForStatus(t, m, j.Id, StatusComplete)
+	_, err = m.Remove(j.Id)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if jobs := m.List(); len(jobs) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(jobs), 0)
+	}
+}
+
+func TestShutdown(t *testing.T) {
+	t.Parallel()
+	m, release := testJobManager(t, 1, nil)
+	defer close(release)
+	j, err := m.Submit(testConfig(t), "")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	waitForStatus(t, m, j.Id, StatusRunning)
+	m.Shutdown()
+	waitForStatus(t, m, j.Id, StatusCancelled)
+}
@@ -0,0 +1,228 @@
+package rpcserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	grpcauth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/thrasher-corp/gocryptotrader/backtester/btrpc"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+)
+
+// New returns a backtester gRPC server which runs jobs with the job manager
+func New(cfg *Config, jobs *JobManager) (*RPCServer, error) {
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if jobs == nil {
+		return nil, fmt.Errorf("job manager %w", common.ErrNilPointer)
+	}
+	if cfg.ListenAddress == "" {
+		return nil, errListenAddressUnset
+	}
+	if cfg.Username == "" || cfg.Password == "" {
+		return nil, errCredentialsUnset
+	}
+	return &RPCServer{
+		config: *cfg,
+		jobs:   jobs,
+	}, nil
+}
+
+// Start starts the gRPC server with TLS and basic authentication, along with
+// the gRPC JSON proxy when enabled
+func (s *RPCServer) Start() error {
+	err := engine.CheckCerts(s.config.TLSDir)
+	if err != nil {
+		return err
+	}
+	creds, err := credentials.NewServerTLSFromFile(filepath.Join(s.config.TLSDir, "cert.pem"), filepath.Join(s.config.TLSDir, "key.pem"))
+	if err != nil {
+		return fmt.Errorf("gRPC server could not load TLS keys: %w", err)
+	}
+	lis, err := net.Listen("tcp", s.config.ListenAddress)
+	if err != nil {
+		return fmt.Errorf("gRPC server failed to bind to %v: %w", s.config.ListenAddress, err)
+	}
+	s.server = grpc.NewServer(
+		grpc.Creds(creds),
+		grpc.UnaryInterceptor(grpcauth.UnaryServerInterceptor(s.authenticateClient)),
+	)
+	btrpc.RegisterBacktesterServiceServer(s.server, s)
+	go func() {
+		if err := s.server.Serve(lis); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			log.Errorf(log.GRPCSys, "backtester gRPC server failed to serve: %s\n", err)
+		}
+	}()
+	log.Infof(log.GRPCSys, "backtester gRPC server started on https://%v\n", s.config.ListenAddress)
+
+	if s.config.EnableProxy {
+		return s.startRESTProxy()
+	}
+	return nil
+}
+
+// Stop stops the gRPC server and every queued and running job
+func (s *RPCServer) Stop() {
+	s.jobs.Shutdown()
+	if s.server != nil {
+		s.server.Stop()
+	}
+}
+
+// startRESTProxy starts the gRPC JSON proxy
+func (s *RPCServer) startRESTProxy() error {
+	creds, err := credentials.NewClientTLSFromFile(filepath.Join(s.config.TLSDir, "cert.pem"), "")
+	if err != nil {
+		return fmt.Errorf("unable to start gRPC proxy: %w", err)
+	}
+	mux := runtime.NewServeMux()
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds),
+		grpc.WithPerRPCCredentials(auth.BasicAuth{
+			Username: s.config.Username,
+			Password: s.config.Password,
+		}),
+	}
+	err = btrpc.RegisterBacktesterServiceHandlerFromEndpoint(context.Background(), mux, s.config.ListenAddress, opts)
+	if err != nil {
+		return fmt.Errorf("failed to register gRPC proxy: %w", err)
+	}
+	go func() {
+		if err := http.ListenAndServe(s.config.ProxyListenAddress, mux); err != nil {
+			log.Errorf(log.GRPCSys, "backtester gRPC proxy failed to serve: %s\n", err)
+		}
+	}()
+	log.Infof(log.GRPCSys, "backtester gRPC proxy started on http://%v\n", s.config.ProxyListenAddress)
+	return nil
+}
+
+func (s *RPCServer) authenticateClient(ctx context.Context) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx, errUnableToExtractMetadata
+	}
+	authStr, ok := md["authorization"]
+	if !ok || len(authStr) == 0 {
+		return ctx, errMissingAuthorisation
+	}
+	basic := strings.SplitN(authStr[0], " ", 2)
+	if len(basic) != 2 || basic[0] != "Basic" {
+		return ctx, errInvalidAuthorisation
+	}
+	decoded, err := crypto.Base64Decode(basic[1])
+	if err != nil {
+		return ctx, errInvalidAuthorisation
+	}
+	credentials := strings.SplitN(string(decoded), ":", 2)
+	if len(credentials) != 2 {
+		return ctx, errInvalidAuthorisation
+	}
+	if subtle.ConstantTimeCompare([]byte(credentials[0]), []byte(s.config.Username)) != 1 ||
+		subtle.ConstantTimeCompare([]byte(credentials[1]), []byte(s.config.Password)) != 1 {
+		return ctx, errAuthorisationMismatch
+	}
+	return ctx, nil
+}
+
+// ExecuteStrategyFromFile reads a strategy config file and runs it as a job
+func (s *RPCServer) ExecuteStrategyFromFile(_ context.Context, r *btrpc.ExecuteStrategyFromFileRequest) (*btrpc.ExecuteStrategyResponse, error) {
+	if r == nil {
+		return nil, errNilRequest
+	}
+	if r.StrategyFilePath == "" {
+		return nil, errStrategyFilePathUnset
+	}
+	cfg, err := config.ReadConfigFromFile(r.StrategyFilePath)
+	if err != nil {
+		return nil, err
+	}
+	j, err := s.jobs.Submit(cfg, r.StrategyFilePath)
+	if err != nil {
+		return nil, err
+	}
+	return &btrpc.ExecuteStrategyResponse{Job: j}, nil
+}
+
+// ExecuteStrategyFromConfig runs a JSON strategy config as a job
+func (s *RPCServer) ExecuteStrategyFromConfig(_ context.Context, r *btrpc.ExecuteStrategyFromConfigRequest) (*btrpc.ExecuteStrategyResponse, error) {
+	if r == nil {
+		return nil, errNilRequest
+	}
+	if r.Config == "" {
+		return nil, errStrategyConfigUnset
+	}
+	cfg, err := config.LoadConfig([]byte(r.Config))
+	if err != nil {
+		return nil, err
+	}
+	j, err := s.jobs.Submit(cfg, configSourceRequest)
+	if err != nil {
+		return nil, err
+	}
+	return &btrpc.ExecuteStrategyResponse{Job: j}, nil
+}
+
+// ListJobs returns every job
+func (s *RPCServer) ListJobs(_ context.Context, _ *btrpc.ListJobsRequest) (*btrpc.ListJobsResponse, error) {
+	return &btrpc.ListJobsResponse{Jobs: s.jobs.List()}, nil
+}
+
+// GetJob returns a job's status and progress
+func (s *RPCServer) GetJob(_ context.Context, r *btrpc.GetJobRequest) (*btrpc.GetJobResponse, error) {
+	if r == nil {
+		return nil, errNilRequest
+	}
+	j, err := s.jobs.Get(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &btrpc.GetJobResponse{Job: j}, nil
+}
+
+// CancelJob stops a queued or running job. Live jobs are completed with
+// results up to when they were stopped
+func (s *RPCServer) CancelJob(_ context.Context, r *btrpc.CancelJobRequest) (*btrpc.CancelJobResponse, error) {
+	if r == nil {
+		return nil, errNilRequest
+	}
+	j, err := s.jobs.Cancel(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &btrpc.CancelJobResponse{Job: j}, nil
+}
+
+// GetJobResult returns the statistics JSON and HTML report of a completed job
+func (s *RPCServer) GetJobResult(_ context.Context, r *btrpc.GetJobResultRequest) (*btrpc.GetJobResultResponse, error) {
+	if r == nil {
+		return nil, errNilRequest
+	}
+	return s.jobs.Result(r.Id)
+}
+
+// RemoveJob removes a finished job from the job list
+func (s *RPCServer) RemoveJob(_ context.Context, r *btrpc.RemoveJobRequest) (*btrpc.RemoveJobResponse, error) {
+	if r == nil {
+		return nil, errNilRequest
+	}
+	j, err := s.jobs.Remove(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &btrpc.RemoveJobResponse{Job: j}, nil
+}
@@ -0,0 +1,232 @@
+package rpcserver
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/btrpc"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+	"google.golang.org/grpc/metadata"
+)
+
+func testServer(t *testing.T) (s *RPCServer, release chan struct{}) {
+	t.Helper()
+	m, release := testJobManager(t, 1, nil)
+	s, err := New(&Config{
+		ListenAddress: "localhost:0",
+		Username:      "user",
+		Password:      "pass",
+		TLSDir:        t.TempDir(),
+	}, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, release
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	_, err := New(nil, nil)
+	if !errors.Is(err, errNilConfig) {
+		t.Errorf("received '%v' expected '%v'", err, errNilConfig)
+	}
+	_, err = New(&Config{}, nil)
+	if !errors.Is(err, common.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilPointer)
+	}
+	m, err := NewJobManager("", "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = New(&Config{}, m)
+	if !errors.Is(err, errListenAddressUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errListenAddressUnset)
+	}
+	_, err = New(&Config{ListenAddress: "localhost:9054"}, m)
+	if !errors.Is(err, errCredentialsUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errCredentialsUnset)
+	}
+	_, err = New(&Config{ListenAddress: "localhost:9054", Username: "user", Password: "pass"}, m)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+}
+
+func TestStartStop(t *testing.T) {
+	t.Parallel()
+	s, release := testServer(t)
+	defer close(release)
+	err := s.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if _, err = os.Stat(filepath.Join(s.config.TLSDir, "cert.pem")); err != nil {
+		t.Error(err)
+	}
+	s.Stop()
+	_, err = s.jobs.Submit(testConfig(t), "")
+	if !errors.Is(err, errJobManagerShutdown) {
+		t.Errorf("received '%v' expected '%v'", err, errJobManagerShutdown)
+	}
+}
+
+func TestAuthenticateClient(t *testing.T) {
+	t.Parallel()
+	s, release := testServer(t)
+	defer close(release)
+	_, err := s.authenticateClient(context.Background())
+	if !errors.Is(err, errUnableToExtractMetadata) {
+		t.Errorf("received '%v' expected '%v'", err, errUnableToExtractMetadata)
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{})
+	_, err = s.authenticateClient(ctx)
+	if !errors.Is(err, errMissingAuthorisation) {
+		t.Errorf("received '%v' expected '%v'", err, errMissingAuthorisation)
+	}
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token"))
+	_, err = s.authenticateClient(ctx)
+	if !errors.Is(err, errInvalidAuthorisation) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidAuthorisation)
+	}
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Basic "+crypto.Base64Encode([]byte("user:wrong"))))
+	_, err = s.authenticateClient(ctx)
+	if !errors.Is(err, errAuthorisationMismatch) {
+		t.Errorf("received '%v' expected '%v'", err, errAuthorisationMismatch)
+	}
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Basic "+crypto.Base64Encode([]byte("user:pass"))))
+	_, err = s.authenticateClient(ctx)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+}
+
+func TestExecuteStrategyFromFile(t *testing.T) {
+	t.Parallel()
+	s, release := testServer(t)
+	defer close(release)
+	_, err := s.ExecuteStrategyFromFile(context.Background(), nil)
+	if !errors.Is(err, errNilRequest) {
+		t.Errorf("received '%v' expected '%v'", err, errNilRequest)
+	}
+	_, err = s.ExecuteStrategyFromFile(context.Background(), &btrpc.ExecuteStrategyFromFileRequest{})
+	if !errors.Is(err, errStrategyFilePathUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errStrategyFilePathUnset)
+	}
+	_, err = s.ExecuteStrategyFromFile(context.Background(), &btrpc.ExecuteStrategyFromFileRequest{
+		StrategyFilePath: filepath.Join(t.TempDir(), "missing.strat"),
+	})
+	if err == nil {
+		t.Error("expected error")
+	}
+	path := filepath.Join("..", "config", "examples", testStrategyFile)
+	resp, err := s.ExecuteStrategyFromFile(context.Background(), &btrpc.ExecuteStrategyFromFileRequest{
+		StrategyFilePath: path,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.Job.Source != path {
+		t.Errorf("received '%v' expected '%v'", resp.Job.Source, path)
+	}
+}
+
+func TestExecuteStrategyFromConfig(t *testing.T) {
+	t.Parallel()
+	s, release := testServer(t)
+	defer close(release)
+	_, err := s.ExecuteStrategyFromConfig(context.Background(), nil)
+	if !errors.Is(err, errNilRequest) {
+		t.Errorf("received '%v' expected '%v'", err, errNilRequest)
+	}
+	_, err = s.ExecuteStrategyFromConfig(context.Background(), &btrpc.ExecuteStrategyFromConfigRequest{})
+	if !errors.Is(err, errStrategyConfigUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errStrategyConfigUnset)
+	}
+	_, err = s.ExecuteStrategyFromConfig(context.Background(), &btrpc.ExecuteStrategyFromConfigRequest{Config: "{"})
+	if err == nil {
+		t.Error("expected error")
+	}
+	resp, err := s.ExecuteStrategyFromConfig(context.Background(), &btrpc.ExecuteStrategyFromConfigRequest{Config: mustReadExample(t)})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.Job.Source != configSourceRequest {
+		t.Errorf("received '%v' expected '%v'", resp.Job.Source, configSourceRequest)
+	}
+}
+
+func TestJobRPCs(t *testing.T) {
+	t.Parallel()
+	s, release := testServer(t)
+	ctx := context.Background()
+	resp, err := s.ExecuteStrategyFromConfig(ctx, &btrpc.ExecuteStrategyFromConfigRequest{Config: mustReadExample(t)})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	id := resp.Job.Id
+
+	list, err := s.ListJobs(ctx, &btrpc.ListJobsRequest{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(list.Jobs) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(list.Jobs), 1)
+	}
+
+	_, err = s.GetJob(ctx, nil)
+	if !errors.Is(err, errNilRequest) {
+		t.Errorf("received '%v' expected '%v'", err, errNilRequest)
+	}
+	_, err = s.GetJob(ctx, &btrpc.GetJobRequest{})
+	if !errors.Is(err, errJobNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errJobNotFound)
+	}
+	waitForStatus(t, s.jobs, id, StatusRunning)
+
+	_, err = s.RemoveJob(ctx, nil)
+	if !errors.Is(err, errNilRequest) {
+		t.Errorf("received '%v' expected '%v'", err, errNilRequest)
+	}
+	_, err = s.RemoveJob(ctx, &btrpc.RemoveJobRequest{Id: id})
+	if !errors.Is(err, errJobNotFinished) {
+		t.Errorf("received '%v' expected '%v'", err, errJobNotFinished)
+	}
+	_, err = s.GetJobResult(ctx, nil)
+	if !errors.Is(err, errNilRequest) {
+		t.Errorf("received '%v' expected '%v'", err, errNilRequest)
+	}
+	close(release)
+	waitForStatus(t, s.jobs, id, StatusComplete)
+	result, err := s.GetJobResult(ctx, &btrpc.GetJobResultRequest{Id: id})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if result.Report == "" {
+		t.Error("expected report")
+	}
+	_, err = s.CancelJob(ctx, nil)
+	if !errors.Is(err, errNilRequest) {
+		t.Errorf("received '%v' expected '%v'", err, errNilRequest)
+	}
+	_, err = s.CancelJob(ctx, &btrpc.CancelJobRequest{Id: id})
+	if !errors.Is(err, errJobFinished) {
+		t.Errorf("received '%v' expected '%v'", err, errJobFinished)
+	}
+	_, err = s.RemoveJob(ctx, &btrpc.RemoveJobRequest{Id: id})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+}
+
+func mustReadExample(t *testing.T) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("..", "config", "examples", testStrategyFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
@@ -0,0 +1,124 @@
+package rpcserver
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/btrpc"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"google.golang.org/grpc"
+)
+
+// Job statuses
+const (
+	StatusQueued    = "queued"
+	StatusRunning   = "running"
+	StatusComplete  = "complete"
+	StatusCancelled = "cancelled"
+	StatusFailed    = "failed"
+)
+
+const (
+	// DefaultMaxConcurrentJobs is the number of jobs run at once when unset
+	DefaultMaxConcurrentJobs = 2
+	configSourceRequest      = "request"
+)
+
+var (
+	errNilConfig                 = errors.New("nil config received")
+	errNilRequest                = errors.New("nil request received")
+	errOptimisationUnsupported   = errors.New("optimisation configs cannot be run as jobs")
+	errJobNotFound               = errors.New("job not found")
+	errJobFinished               = errors.New("job has already finished")
+	errJobNotFinished            = errors.New("job has not finished")
+	errJobNotComplete            = errors.New("job has not completed successfully")
+	errJobManagerShutdown        = errors.New("job manager has been shut down")
+	errStrategyFilePathUnset     = errors.New("strategy file path unset")
+	errStrategyConfigUnset       = errors.New("strategy config unset")
+	errListenAddressUnset        = errors.New("listen address unset")
+	errCredentialsUnset          = errors.New("username and password must be set")
+	errMissingAuthorisation      = errors.New("authorization header missing")
+	errInvalidAuthorisation      = errors.New("invalid authorization header")
+	errAuthorisationMismatch     = errors.New("username/password mismatch")
+	errUnableToExtractMetadata   = errors.New("unable to extract metadata")
+	errMaxConcurrentJobsNegative = errors.New("max concurrent jobs cannot be negative")
+)
+
+// Config holds the settings for the backtester gRPC server
+type Config struct {
+	// ListenAddress is where the gRPC server listens, eg localhost:9054
+	ListenAddress string
+	// EnableProxy enables the gRPC JSON proxy at ProxyListenAddress
+	EnableProxy        bool
+	ProxyListenAddress string
+	Username           string
+	Password           string
+	// TLSDir holds the cert.pem and key.pem files used by the server, which
+	// are generated if missing
+	TLSDir string
+}
+
+// RPCServer implements the backtester gRPC service, running strategy configs
+// as jobs
+type RPCServer struct {
+	btrpc.UnimplementedBacktesterServiceServer
+	config Config
+	jobs   *JobManager
+	server *grpc.Server
+}
+
+// JobManager runs strategy configs as jobs, limiting how many run at once.
+// Each job writes its report to its own directory within the output path
+type JobManager struct {
+	m            sync.Mutex
+	wg           sync.WaitGroup
+	jobs         []*job
+	slots        chan struct{}
+	shutdown     bool
+	templatePath string
+	outputPath   string
+	// dataSlot runs database and API data jobs one at a time, as each starts
+	// and stops the global database connection and API data is fetched from
+	// rate limited exchanges
+	dataSlot chan struct{}
+	// newRunner sets up a job's backtester, allowing tests to avoid
+	// exchange API calls
+	newRunner func(cfg *config.Config, templatePath, outputPath string) (runner, error)
+}
+
+// runner is the part of a backtester used to run a job
+type runner interface {
+	Run() error
+	RunLive() error
+	Stop()
+	// Reset stops the engine subsystems started for the job
+	Reset()
+	Progress() decimal.Decimal
+	// Results calculates statistics, generates the report and returns the
+	// statistics as JSON
+	Results() (string, error)
+}
+
+// job is a strategy config run by the job manager. All fields are guarded
+// by the job manager's mutex
+type job struct {
+	id           string
+	nickname     string
+	strategyName string
+	source       string
+	live         bool
+	config       *config.Config
+	status       string
+	err          error
+	created      time.Time
+	started      time.Time
+	finished     time.Time
+	runner       runner
+	cancelled    bool
+	cancel       chan struct{}
+	outputPath   string
+	statistics   string
+	reportPath   string
+}
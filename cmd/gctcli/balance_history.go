@@ -0,0 +1,201 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var errBalanceHistoryCurrencyUnset = errors.New("currency must be set")
+
+var balanceHistorySharedFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:    "exchange",
+		Aliases: []string{"e"},
+		Usage:   "the exchange to limit balances to, all exchanges when unset",
+	},
+	&cli.StringFlag{
+		Name:  "fiat",
+		Usage: "the fiat currency to value balances in, the recorded fiat currency when unset",
+	},
+	&cli.StringFlag{
+		Name:        "start",
+		Usage:       "<start>",
+		Value:       time.Now().AddDate(0, -1, 0).Format(common.SimpleTimeFormat),
+		Destination: &startTime,
+	},
+	&cli.StringFlag{
+		Name:        "end",
+		Usage:       "<end>",
+		Value:       time.Now().Format(common.SimpleTimeFormat),
+		Destination: &endTime,
+	},
+}
+
+var balanceHistoryCommand = &cli.Command{
+	Name:      "balancehistory",
+	Usage:     "execute balance history commands for balances recorded by the balance history manager",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "equitycurve",
+			Usage:     "returns the total value of all balances over time",
+			ArgsUsage: "<exchange> <fiat> <start> <end>",
+			Action:    getEquityCurve,
+			Flags:     balanceHistorySharedFlags,
+		},
+		{
+			Name:      "currency",
+			Usage:     "returns the combined balance and value of a currency over time",
+			ArgsUsage: "<currency> <exchange> <fiat> <start> <end>",
+			Action:    getBalanceHistory,
+			Flags: append([]cli.Flag{
+				&cli.StringFlag{
+					Name:    "currency",
+					Aliases: []string{"c"},
+					Usage:   "the currency to return the balance history of e.g. btc",
+				},
+			}, balanceHistorySharedFlags...),
+		},
+		{
+			Name:      "dailypnl",
+			Usage:     "returns the profit and loss of all balances for every UTC day",
+			ArgsUsage: "<exchange> <fiat> <start> <end>",
+			Action:    getDailyPNL,
+			Flags:     balanceHistorySharedFlags,
+		},
+	},
+}
+
+// parseBalanceHistoryArgs returns the exchange, fiat currency, start and end
+// of a balance history command, positional arguments starting at offset
+func parseBalanceHistoryArgs(c *cli.Context, offset int) (exchangeName, fiat, start, end string, err error) {
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().Get(offset)
+	}
+	if c.IsSet("fiat") {
+		fiat = c.String("fiat")
+	} else {
+		fiat = c.Args().Get(offset + 1)
+	}
+	if !c.IsSet("start") && c.Args().Get(offset+2) != "" {
+		startTime = c.Args().Get(offset + 2)
+	}
+	if !c.IsSet("end") && c.Args().Get(offset+3) != "" {
+		endTime = c.Args().Get(offset + 3)
+	}
+	s, err := time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return "", "", "", "", fmt.Errorf("invalid time format for start: %v", err)
+	}
+	e, err := time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return "", "", "", "", fmt.Errorf("invalid time format for end: %v", err)
+	}
+	if e.Before(s) {
+		return "", "", "", "", errors.New("start cannot be after end")
+	}
+	return exchangeName, fiat, negateLocalOffset(s), negateLocalOffset(e), nil
+}
+
+func getEquityCurve(c *cli.Context) error {
+	exchangeName, fiat, start, end, err := parseBalanceHistoryArgs(c, 0)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetEquityCurve(c.Context,
+		&gctrpc.GetEquityCurveRequest{
+			Exchange:     exchangeName,
+			FiatCurrency: fiat,
+			Start:        start,
+			End:          end,
+		})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func getBalanceHistory(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var code string
+	if c.IsSet("currency") {
+		code = c.String("currency")
+	} else {
+		code = c.Args().First()
+	}
+	if code == "" {
+		return errBalanceHistoryCurrencyUnset
+	}
+
+	exchangeName, fiat, start, end, err := parseBalanceHistoryArgs(c, 1)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetBalanceHistory(c.Context,
+		&gctrpc.GetBalanceHistoryRequest{
+			Exchange:     exchangeName,
+			Currency:     code,
+			FiatCurrency: fiat,
+			Start:        start,
+			End:          end,
+		})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func getDailyPNL(c *cli.Context) error {
+	exchangeName, fiat, start, end, err := parseBalanceHistoryArgs(c, 0)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetDailyPNL(c.Context,
+		&gctrpc.GetDailyPNLRequest{
+			Exchange:     exchangeName,
+			FiatCurrency: fiat,
+			Start:        start,
+			End:          end,
+		})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -2381,6 +2382,14 @@ var addEventCommand = &cli.Command{
 			Name:  "action",
 			Usage: "the action for the event to perform upon trigger",
 		},
+		&cli.StringFlag{
+			Name:  "conditions",
+			Usage: "a JSON condition tree replacing item and condition e.g. '{\"operator\":\"AND\",\"children\":[{\"condition\":{\"item\":\"PERCENT_CHANGE\",\"condition_params\":{\"condition\":\"<\"},\"value\":-5,\"window\":3600000000000}}]}', window and interval are in nanoseconds",
+		},
+		&cli.StringFlag{
+			Name:  "actions",
+			Usage: "a JSON array of actions to perform upon trigger e.g. '[{\"type\":\"SUBMIT_ORDER\",\"order\":{\"side\":\"BUY\",\"type\":\"MARKET\",\"amount\":0.1}},{\"type\":\"NOTIFY\",\"relayer\":\"telegram\"}]'",
+		},
 	},
 }
 
@@ -2399,6 +2408,8 @@ func addEvent(c *cli.Context) error {
 	var currencyPair string
 	var assetType string
 	var action string
+	var conditions *gctrpc.EventConditionNode
+	var actions []*gctrpc.EventAction
 
 	if c.IsSet("exchange") {
 		exchangeName = c.String("exchange")
@@ -2406,15 +2417,22 @@ func addEvent(c *cli.Context) error {
 		return fmt.Errorf("exchange name is required")
 	}
 
+	if c.IsSet("conditions") {
+		conditions = &gctrpc.EventConditionNode{}
+		if err := json.Unmarshal([]byte(c.String("conditions")), conditions); err != nil {
+			return fmt.Errorf("unable to parse conditions: %w", err)
+		}
+	}
+
 	if c.IsSet("item") {
 		item = c.String("item")
-	} else {
+	} else if conditions == nil {
 		return fmt.Errorf("item is required")
 	}
 
 	if c.IsSet("condition") {
 		condition = c.String("condition")
-	} else {
+	} else if conditions == nil {
 		return fmt.Errorf("condition is required")
 	}
 
@@ -2453,9 +2471,15 @@ func addEvent(c *cli.Context) error {
 		return errInvalidAsset
 	}
 
+	if c.IsSet("actions") {
+		if err := json.Unmarshal([]byte(c.String("actions")), &actions); err != nil {
+			return fmt.Errorf("unable to parse actions: %w", err)
+		}
+	}
+
 	if c.IsSet("action") {
 		action = c.String("action")
-	} else {
+	} else if len(actions) == 0 {
 		return fmt.Errorf("action is required")
 	}
 
@@ -2486,8 +2510,10 @@ func addEvent(c *cli.Context) error {
 			Base:      p.Base.String(),
 			Quote:     p.Quote.String(),
 		},
-		AssetType: assetType,
-		Action:    action,
+		AssetType:  assetType,
+		Action:     action,
+		Conditions: conditions,
+		Actions:    actions,
 	})
 	if err != nil {
 		return err
@@ -0,0 +1,289 @@
+package main
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var conditionalOrderCommand = &cli.Command{
+	Name:      "conditionalorder",
+	Usage:     "execute engine held stop-loss, take-profit and trailing stop order commands",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "add",
+			Usage:     "adds a conditional order which is submitted once its trigger is hit",
+			ArgsUsage: "<exchange> <pair> <asset> <side> <type> <amount>",
+			Action:    addConditionalOrder,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "exchange",
+					Usage: "the exchange to submit the order to once triggered",
+				},
+				&cli.StringFlag{
+					Name:  "pair",
+					Usage: "the currency pair e.g. btc-usd",
+				},
+				&cli.StringFlag{
+					Name:  "asset",
+					Usage: "the asset type",
+				},
+				&cli.StringFlag{
+					Name:  "side",
+					Usage: "the side of the order submitted once triggered, buy or sell",
+				},
+				&cli.StringFlag{
+					Name:  "type",
+					Usage: "the conditional order type: STOP, STOP LIMIT, TAKE PROFIT or TRAILING_STOP",
+				},
+				&cli.Float64Flag{
+					Name:  "amount",
+					Usage: "the amount of the order submitted once triggered",
+				},
+				&cli.Float64Flag{
+					Name:  "triggerprice",
+					Usage: "the trigger price for STOP, STOP LIMIT and TAKE PROFIT orders",
+				},
+				&cli.Float64Flag{
+					Name:  "limitprice",
+					Usage: "the price of the limit order submitted when a STOP LIMIT order triggers",
+				},
+				&cli.Float64Flag{
+					Name:  "trailingamount",
+					Usage: "the absolute distance a TRAILING_STOP follows the market by",
+				},
+				&cli.Float64Flag{
+					Name:  "trailingpercent",
+					Usage: "the percentage distance a TRAILING_STOP follows the market by",
+				},
+			},
+		},
+		{
+			Name:      "cancel",
+			Usage:     "cancels a pending conditional order",
+			ArgsUsage: "<id>",
+			Action:    cancelConditionalOrder,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "id",
+					Usage: "the conditional order id",
+				},
+			},
+		},
+		{
+			Name:      "getorders",
+			Usage:     "returns conditional orders held by the engine",
+			ArgsUsage: "<exchange>",
+			Action:    getConditionalOrders,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "exchange",
+					Usage: "optional exchange to filter by",
+				},
+				&cli.BoolFlag{
+					Name:  "includeinactive",
+					Usage: "include triggered, failed and cancelled conditional orders",
+				},
+			},
+		},
+		{
+			Name:      "history",
+			Usage:     "returns the trigger history of conditional orders",
+			ArgsUsage: "<exchange>",
+			Action:    getConditionalOrderHistory,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "exchange",
+					Usage: "optional exchange to filter by",
+				},
+			},
+		},
+	},
+}
+
+func addConditionalOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var side string
+	if c.IsSet("side") {
+		side = c.String("side")
+	} else {
+		side = c.Args().Get(3)
+	}
+
+	var orderType string
+	if c.IsSet("type") {
+		orderType = c.String("type")
+	} else {
+		orderType = c.Args().Get(4)
+	}
+
+	var amount float64
+	var err error
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else if c.Args().Get(5) != "" {
+		amount, err = strconv.ParseFloat(c.Args().Get(5), 64)
+		if err != nil {
+			return err
+		}
+	}
+	if amount == 0 {
+		return errors.New("amount must be set")
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.AddConditionalOrder(c.Context,
+		&gctrpc.AddConditionalOrderRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			Asset:           assetType,
+			Side:            side,
+			OrderType:       orderType,
+			Amount:          amount,
+			TriggerPrice:    c.Float64("triggerprice"),
+			LimitPrice:      c.Float64("limitprice"),
+			TrailingAmount:  c.Float64("trailingamount"),
+			TrailingPercent: c.Float64("trailingpercent"),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func cancelConditionalOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.CancelConditionalOrder(c.Context,
+		&gctrpc.CancelConditionalOrderRequest{Id: id},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getConditionalOrders(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetConditionalOrders(c.Context,
+		&gctrpc.GetConditionalOrdersRequest{
+			Exchange:        exchangeName,
+			IncludeInactive: c.Bool("includeinactive"),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getConditionalOrderHistory(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetConditionalOrderHistory(c.Context,
+		&gctrpc.GetConditionalOrderHistoryRequest{Exchange: exchangeName},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
@@ -0,0 +1,260 @@
+package main
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var errConsolidatedSideUnset = errors.New("side must be set")
+
+var consolidatedOrderbookCommand = &cli.Command{
+	Name:      "consolidatedorderbook",
+	Usage:     "execute consolidated orderbook commands for orderbooks merged across exchanges by the consolidated orderbook manager",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "get",
+			Usage:     "returns the fee adjusted orderbook of a pair merged across exchanges",
+			ArgsUsage: "<pair> <asset>",
+			Action:    getConsolidatedOrderbook,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    "pair",
+					Aliases: []string{"p"},
+					Usage:   "the currency pair of the consolidated orderbook",
+				},
+				&cli.StringFlag{
+					Name:    "asset",
+					Aliases: []string{"a"},
+					Usage:   "the asset type of the consolidated orderbook, spot when unset",
+				},
+			},
+		},
+		{
+			Name:      "simulate",
+			Usage:     "simulates an order across all exchanges of a consolidated orderbook, buy amounts are in the quote currency and sell amounts in the base currency",
+			ArgsUsage: "<pair> <side> <amount> <asset>",
+			Action:    simulateConsolidatedOrder,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    "pair",
+					Aliases: []string{"p"},
+					Usage:   "the currency pair of the consolidated orderbook",
+				},
+				&cli.StringFlag{
+					Name:  "side",
+					Usage: "the order side to use (BUY OR SELL)",
+				},
+				&cli.Float64Flag{
+					Name:  "amount",
+					Usage: "the amount for the order",
+				},
+				&cli.StringFlag{
+					Name:    "asset",
+					Aliases: []string{"a"},
+					Usage:   "the asset type of the consolidated orderbook, spot when unset",
+				},
+			},
+		},
+		{
+			Name:      "whalebomb",
+			Usage:     "finds the amount required across all exchanges of a consolidated orderbook to reach a price target",
+			ArgsUsage: "<pair> <side> <price> <asset>",
+			Action:    consolidatedWhaleBomb,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    "pair",
+					Aliases: []string{"p"},
+					Usage:   "the currency pair of the consolidated orderbook",
+				},
+				&cli.StringFlag{
+					Name:  "side",
+					Usage: "the order side to use (BUY OR SELL)",
+				},
+				&cli.Float64Flag{
+					Name:  "price",
+					Usage: "the fee adjusted price target",
+				},
+				&cli.StringFlag{
+					Name:    "asset",
+					Aliases: []string{"a"},
+					Usage:   "the asset type of the consolidated orderbook, spot when unset",
+				},
+			},
+		},
+	},
+}
+
+// parseConsolidatedPair returns the pair of a consolidated orderbook command
+// from its flag or first positional argument
+func parseConsolidatedPair(c *cli.Context) (*gctrpc.CurrencyPair, error) {
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().First()
+	}
+	if !validPair(currencyPair) {
+		return nil, errInvalidPair
+	}
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.CurrencyPair{
+		Delimiter: p.Delimiter,
+		Base:      p.Base.String(),
+		Quote:     p.Quote.String(),
+	}, nil
+}
+
+// parseConsolidatedOrder returns the side and amount, or price target, of a
+// consolidated orderbook simulation from their flags or positional arguments
+func parseConsolidatedOrder(c *cli.Context, valueFlag string) (side string, value float64, err error) {
+	if c.IsSet("side") {
+		side = c.String("side")
+	} else {
+		side = c.Args().Get(1)
+	}
+	if side == "" {
+		return "", 0, errConsolidatedSideUnset
+	}
+	if c.IsSet(valueFlag) {
+		value = c.Float64(valueFlag)
+	} else if c.Args().Get(2) != "" {
+		value, err = strconv.ParseFloat(c.Args().Get(2), 64)
+		if err != nil {
+			return "", 0, err
+		}
+	}
+	if value == 0 {
+		return "", 0, errors.New(valueFlag + " must be set")
+	}
+	return side, value, nil
+}
+
+func getConsolidatedOrderbook(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	p, err := parseConsolidatedPair(c)
+	if err != nil {
+		return err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(1)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetConsolidatedOrderbook(c.Context,
+		&gctrpc.GetConsolidatedOrderbookRequest{
+			Pair:  p,
+			Asset: assetType,
+		})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func simulateConsolidatedOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	p, err := parseConsolidatedPair(c)
+	if err != nil {
+		return err
+	}
+
+	side, amount, err := parseConsolidatedOrder(c, "amount")
+	if err != nil {
+		return err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(3)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.SimulateConsolidatedOrder(c.Context,
+		&gctrpc.SimulateConsolidatedOrderRequest{
+			Pair:   p,
+			Asset:  assetType,
+			Amount: amount,
+			Side:   side,
+		})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+func consolidatedWhaleBomb(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	p, err := parseConsolidatedPair(c)
+	if err != nil {
+		return err
+	}
+
+	side, price, err := parseConsolidatedOrder(c, "price")
+	if err != nil {
+		return err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(3)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.ConsolidatedWhaleBomb(c.Context,
+		&gctrpc.ConsolidatedWhaleBombRequest{
+			Pair:        p,
+			Asset:       assetType,
+			PriceTarget: price,
+			Side:        side,
+		})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
@@ -147,6 +147,12 @@ var dataHistoryJobCommands = &cli.Command{
 			Flags:  append(baseJobSubCommands, secondaryValidationJobSubCommands...),
 			Action: upsertDataHistoryJob,
 		},
+		{
+			Name:   "saveorderbooksnapshots",
+			Usage:  "will save the top levels of an exchange's orderbook to the database every interval until the end date",
+			Flags:  append(baseJobSubCommands, orderbookSnapshotJobSubCommands...),
+			Action: upsertDataHistoryJob,
+		},
 	},
 }
 
@@ -292,6 +298,14 @@ var (
 		comparisonDecimalPlacesFlag,
 		intolerancePercentageFlag,
 	}
+	orderbookSnapshotJobSubCommands = []cli.Flag{
+		&cli.Uint64Flag{
+			Name:        "request_size_limit",
+			Usage:       "the number of price levels per side to save in each orderbook snapshot",
+			Destination: &requestSizeLimit,
+			Value:       20,
+		},
+	}
 )
 
 func getDataHistoryJob(c *cli.Context) error {
@@ -461,6 +475,8 @@ func upsertDataHistoryJob(c *cli.Context) error {
 		dataType = 4
 	case "secondaryvalidatecandles":
 		dataType = 5
+	case "saveorderbooksnapshots":
+		dataType = 6
 	default:
 		return errors.New("unrecognised command, cannot set data type")
 	}
@@ -0,0 +1,302 @@
+package main
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var executionOrderCommand = &cli.Command{
+	Name:      "executionorder",
+	Usage:     "execute TWAP and VWAP scheduled parent order commands",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "submit",
+			Usage:     "submits a parent order which is split into child orders over time",
+			ArgsUsage: "<exchange> <pair> <asset> --side <side> --strategy <strategy> --amount <amount> --end <end> --slices <slices>",
+			Action:    submitExecutionOrder,
+			Flags: append(append([]cli.Flag{}, orderGroupSharedFlags...),
+				&cli.StringFlag{
+					Name:  "side",
+					Usage: "the side of the parent order, buy or sell",
+				},
+				&cli.StringFlag{
+					Name:  "strategy",
+					Usage: "the execution strategy, TWAP or VWAP",
+					Value: "TWAP",
+				},
+				&cli.Float64Flag{
+					Name:  "amount",
+					Usage: "the total amount of the parent order",
+				},
+				&cli.Float64Flag{
+					Name:  "limitprice",
+					Usage: "optional price to submit child orders as limit orders, otherwise market orders are used",
+				},
+				&cli.StringFlag{
+					Name:  "start",
+					Usage: "optional start time of the execution window, defaults to now",
+				},
+				&cli.StringFlag{
+					Name:  "end",
+					Usage: "the end time of the execution window",
+					Value: time.Now().Add(time.Hour).Format(common.SimpleTimeFormat),
+				},
+				&cli.Int64Flag{
+					Name:  "slices",
+					Usage: "the number of child orders to split the parent order into",
+					Value: 12,
+				},
+				&cli.Int64Flag{
+					Name:  "volumeinterval",
+					Usage: "the candle interval in seconds used to build the VWAP volume profile",
+					Value: int64(time.Hour / time.Second),
+				},
+				&cli.Int64Flag{
+					Name:  "volumelookback",
+					Usage: "the number of hours of historic volume used to build the VWAP volume profile",
+					Value: 24 * 7,
+				},
+			),
+		},
+		{
+			Name:      "pause",
+			Usage:     "pauses a parent order from submitting further child orders",
+			ArgsUsage: "<id>",
+			Action:    pauseExecutionOrder,
+			Flags:     executionOrderIDFlags,
+		},
+		{
+			Name:      "resume",
+			Usage:     "resumes a paused parent order",
+			ArgsUsage: "<id>",
+			Action:    resumeExecutionOrder,
+			Flags:     executionOrderIDFlags,
+		},
+		{
+			Name:      "cancel",
+			Usage:     "cancels a parent order and its open child orders",
+			ArgsUsage: "<id>",
+			Action:    cancelExecutionOrder,
+			Flags:     executionOrderIDFlags,
+		},
+		{
+			Name:      "get",
+			Usage:     "returns a parent order, its schedule and fill progress against the benchmark",
+			ArgsUsage: "<id>",
+			Action:    getExecutionOrder,
+			Flags:     executionOrderIDFlags,
+		},
+		{
+			Name:      "getall",
+			Usage:     "returns parent orders managed by the execution manager",
+			ArgsUsage: "<exchange>",
+			Action:    getExecutionOrders,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "exchange",
+					Usage: "optional exchange to filter by",
+				},
+				&cli.BoolFlag{
+					Name:  "includeinactive",
+					Usage: "include completed, cancelled and failed parent orders",
+				},
+			},
+		},
+	},
+}
+
+var executionOrderIDFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "id",
+		Usage: "the parent order id",
+	},
+}
+
+func submitExecutionOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	exchangeName, pair, assetType, err := parseOrderGroupShared(c)
+	if err != nil {
+		return err
+	}
+
+	if c.Float64("amount") <= 0 {
+		return errors.New("amount must be set")
+	}
+
+	start := c.String("start")
+	if start != "" {
+		if _, err = time.Parse(common.SimpleTimeFormat, start); err != nil {
+			return err
+		}
+	}
+	end := c.String("end")
+	if _, err = time.Parse(common.SimpleTimeFormat, end); err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.SubmitExecutionOrder(c.Context,
+		&gctrpc.SubmitExecutionOrderRequest{
+			Exchange:       exchangeName,
+			Pair:           pair,
+			Asset:          assetType,
+			Side:           c.String("side"),
+			Strategy:       c.String("strategy"),
+			Amount:         c.Float64("amount"),
+			LimitPrice:     c.Float64("limitprice"),
+			Start:          start,
+			End:            end,
+			Slices:         c.Int64("slices"),
+			VolumeInterval: int64(time.Duration(c.Int64("volumeinterval")) * time.Second),
+			VolumeLookback: int64(time.Duration(c.Int64("volumelookback")) * time.Hour),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+// executionOrderID returns the parent order id from the flag or first
+// argument
+func executionOrderID(c *cli.Context) string {
+	if c.IsSet("id") {
+		return c.String("id")
+	}
+	return c.Args().First()
+}
+
+func pauseExecutionOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.PauseExecutionOrder(c.Context,
+		&gctrpc.ExecutionOrderRequest{Id: executionOrderID(c)},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func resumeExecutionOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.ResumeExecutionOrder(c.Context,
+		&gctrpc.ExecutionOrderRequest{Id: executionOrderID(c)},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func cancelExecutionOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.CancelExecutionOrder(c.Context,
+		&gctrpc.ExecutionOrderRequest{Id: executionOrderID(c)},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getExecutionOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetExecutionOrder(c.Context,
+		&gctrpc.ExecutionOrderRequest{Id: executionOrderID(c)},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getExecutionOrders(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetExecutionOrders(c.Context,
+		&gctrpc.GetExecutionOrdersRequest{
+			Exchange:        exchangeName,
+			IncludeInactive: c.Bool("includeinactive"),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var futuresPositionsCommand = &cli.Command{
+	Name:      "futures",
+	Usage:     "execute futures position tracking commands",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "positions",
+			Usage:     "returns futures positions tracked from order fills, optionally filtered by exchange, pair and asset",
+			ArgsUsage: "<exchange> <pair> <asset>",
+			Action:    getFuturesPositions,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "exchange",
+					Usage: "the exchange to filter positions by",
+				},
+				&cli.StringFlag{
+					Name:  "pair",
+					Usage: "the currency pair to filter positions by e.g. btc-usdt",
+				},
+				&cli.StringFlag{
+					Name:  "asset",
+					Usage: "the futures asset type to filter positions by",
+				},
+			},
+		},
+		{
+			Name:      "addfunding",
+			Usage:     "records a funding payment for a position, either as an amount or calculated from a funding rate",
+			ArgsUsage: "<exchange> <pair> <asset> --payment <payment> | --rate <rate>",
+			Action:    addFuturesFundingPayment,
+			Flags: append(append([]cli.Flag{}, orderGroupSharedFlags...),
+				&cli.Float64Flag{
+					Name:  "payment",
+					Usage: "the funding received, when positive, or paid, when negative, in the settlement currency",
+				},
+				&cli.Float64Flag{
+					Name:  "rate",
+					Usage: "the funding rate, when set the payment is calculated from the position size and mark price",
+				},
+			),
+		},
+		{
+			Name:      "setcontracttype",
+			Usage:     "sets whether a futures pair is a linear or inverse contract, must be set before the first fill",
+			ArgsUsage: "<exchange> <pair> <asset> <contracttype>",
+			Action:    setFuturesContractType,
+			Flags: append(append([]cli.Flag{}, orderGroupSharedFlags...),
+				&cli.StringFlag{
+					Name:  "contracttype",
+					Usage: "linear or inverse",
+				},
+			),
+		},
+	},
+}
+
+func getFuturesPositions(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	var pair *gctrpc.CurrencyPair
+	if currencyPair != "" {
+		if !validPair(currencyPair) {
+			return errInvalidPair
+		}
+		p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+		if err != nil {
+			return err
+		}
+		pair = &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		}
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+	assetType = strings.ToLower(assetType)
+	if assetType != "" && !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetFuturesPositions(c.Context,
+		&gctrpc.GetFuturesPositionsRequest{
+			Exchange: exchangeName,
+			Asset:    assetType,
+			Pair:     pair,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func addFuturesFundingPayment(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	exchangeName, pair, assetType, err := parseOrderGroupShared(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.AddFuturesFundingPayment(c.Context,
+		&gctrpc.AddFuturesFundingPaymentRequest{
+			Exchange: exchangeName,
+			Asset:    assetType,
+			Pair:     pair,
+			Payment:  c.Float64("payment"),
+			Rate:     c.Float64("rate"),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func setFuturesContractType(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	exchangeName, pair, assetType, err := parseOrderGroupShared(c)
+	if err != nil {
+		return err
+	}
+
+	var contractType string
+	if c.IsSet("contracttype") {
+		contractType = c.String("contracttype")
+	} else {
+		contractType = c.Args().Get(3)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.SetFuturesContractType(c.Context,
+		&gctrpc.SetFuturesContractTypeRequest{
+			Exchange:     exchangeName,
+			Asset:        assetType,
+			Pair:         pair,
+			ContractType: contractType,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
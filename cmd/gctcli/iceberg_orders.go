@@ -0,0 +1,219 @@
+package main
+
+import (
+	"errors"
+
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var icebergOrderCommand = &cli.Command{
+	Name:      "icebergorder",
+	Usage:     "execute engine emulated iceberg order commands",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "submit",
+			Usage:     "submits a limit order which only shows a visible clip on the orderbook at a time",
+			ArgsUsage: "<exchange> <pair> <asset> --side <side> --amount <amount> --price <price> --visibleamount <visibleamount>",
+			Action:    submitIcebergOrder,
+			Flags: append(append([]cli.Flag{}, orderGroupSharedFlags...),
+				&cli.StringFlag{
+					Name:  "side",
+					Usage: "the side of the order, buy or sell",
+				},
+				&cli.Float64Flag{
+					Name:  "amount",
+					Usage: "the total amount of the order",
+				},
+				&cli.Float64Flag{
+					Name:  "price",
+					Usage: "the limit price of the order",
+				},
+				&cli.Float64Flag{
+					Name:  "visibleamount",
+					Usage: "the amount of each clip placed on the orderbook",
+				},
+				&cli.Float64Flag{
+					Name:  "amountvariance",
+					Usage: "optional fraction of the visible amount each clip amount is randomised by e.g. 0.2",
+				},
+				&cli.Float64Flag{
+					Name:  "pricevariance",
+					Usage: "optional absolute amount each clip price is randomised by",
+				},
+				&cli.StringFlag{
+					Name:  "client_id",
+					Usage: "optional client id attached to each clip",
+				},
+			),
+		},
+		{
+			Name:      "cancel",
+			Usage:     "cancels an iceberg order and its visible clip",
+			ArgsUsage: "<id>",
+			Action:    cancelIcebergOrder,
+			Flags:     icebergOrderIDFlags,
+		},
+		{
+			Name:      "get",
+			Usage:     "returns an iceberg order, its clips and aggregate progress",
+			ArgsUsage: "<id>",
+			Action:    getIcebergOrder,
+			Flags:     icebergOrderIDFlags,
+		},
+		{
+			Name:      "getall",
+			Usage:     "returns iceberg orders managed by the order manager",
+			ArgsUsage: "<exchange>",
+			Action:    getIcebergOrders,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "exchange",
+					Usage: "optional exchange to filter by",
+				},
+				&cli.BoolFlag{
+					Name:  "includeinactive",
+					Usage: "include completed, cancelled and failed iceberg orders",
+				},
+			},
+		},
+	},
+}
+
+var icebergOrderIDFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "id",
+		Usage: "the iceberg order id",
+	},
+}
+
+func submitIcebergOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	exchangeName, pair, assetType, err := parseOrderGroupShared(c)
+	if err != nil {
+		return err
+	}
+
+	if c.Float64("amount") <= 0 {
+		return errors.New("amount must be set")
+	}
+	if c.Float64("price") <= 0 {
+		return errors.New("price must be set")
+	}
+	if c.Float64("visibleamount") <= 0 {
+		return errors.New("visible amount must be set")
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.SubmitIcebergOrder(c.Context,
+		&gctrpc.SubmitIcebergOrderRequest{
+			Exchange:       exchangeName,
+			Pair:           pair,
+			Asset:          assetType,
+			Side:           c.String("side"),
+			Amount:         c.Float64("amount"),
+			Price:          c.Float64("price"),
+			VisibleAmount:  c.Float64("visibleamount"),
+			AmountVariance: c.Float64("amountvariance"),
+			PriceVariance:  c.Float64("pricevariance"),
+			ClientId:       c.String("client_id"),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+// icebergOrderID returns the iceberg order id from the flag or first argument
+func icebergOrderID(c *cli.Context) string {
+	if c.IsSet("id") {
+		return c.String("id")
+	}
+	return c.Args().First()
+}
+
+func cancelIcebergOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.CancelIcebergOrder(c.Context,
+		&gctrpc.IcebergOrderRequest{Id: icebergOrderID(c)},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getIcebergOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetIcebergOrder(c.Context,
+		&gctrpc.IcebergOrderRequest{Id: icebergOrderID(c)},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getIcebergOrders(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetIcebergOrders(c.Context,
+		&gctrpc.GetIcebergOrdersRequest{
+			Exchange:        exchangeName,
+			IncludeInactive: c.Bool("includeinactive"),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
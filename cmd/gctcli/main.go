@@ -163,6 +163,14 @@ func main() {
 		tradeCommand,
 		dataHistoryCommands,
 		currencyStateManagementCommand,
+		conditionalOrderCommand,
+		orderGroupCommand,
+		executionOrderCommand,
+		icebergOrderCommand,
+		riskManagerCommand,
+		futuresPositionsCommand,
+		balanceHistoryCommand,
+		consolidatedOrderbookCommand,
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -0,0 +1,353 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var errInvalidOrderGroupLeg = errors.New("order group legs must be in the format side,type,amount[,price[,triggerprice]]")
+
+const orderGroupLegUsage = "in the format side,type,amount[,price[,triggerprice]] e.g. sell,limit,1,20000 or sell,stop,1,0,15000"
+
+var orderGroupSharedFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "exchange",
+		Usage: "the exchange to submit the order group to",
+	},
+	&cli.StringFlag{
+		Name:  "pair",
+		Usage: "the currency pair e.g. btc-usd",
+	},
+	&cli.StringFlag{
+		Name:  "asset",
+		Usage: "the asset type",
+	},
+}
+
+var orderGroupCommand = &cli.Command{
+	Name:      "ordergroup",
+	Usage:     "execute one-cancels-other and bracket order group commands",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "submitoco",
+			Usage:     "submits two or more orders where a fill on any leg cancels the others",
+			ArgsUsage: "<exchange> <pair> <asset> --leg <leg> --leg <leg>",
+			Action:    submitOCOOrder,
+			Flags: append(append([]cli.Flag{}, orderGroupSharedFlags...),
+				&cli.StringSliceFlag{
+					Name:  "leg",
+					Usage: "an order leg " + orderGroupLegUsage,
+				},
+			),
+		},
+		{
+			Name:      "submitbracket",
+			Usage:     "submits an entry order which, once filled, submits a take-profit and stop-loss pair",
+			ArgsUsage: "<exchange> <pair> <asset> --entry <leg> --takeprofit <leg> --stoploss <leg>",
+			Action:    submitBracketOrder,
+			Flags: append(append([]cli.Flag{}, orderGroupSharedFlags...),
+				&cli.StringFlag{
+					Name:  "entry",
+					Usage: "the entry order " + orderGroupLegUsage,
+				},
+				&cli.StringFlag{
+					Name:  "takeprofit",
+					Usage: "the take-profit order " + orderGroupLegUsage,
+				},
+				&cli.StringFlag{
+					Name:  "stoploss",
+					Usage: "the stop-loss order " + orderGroupLegUsage,
+				},
+			),
+		},
+		{
+			Name:      "cancel",
+			Usage:     "cancels all outstanding legs of an order group",
+			ArgsUsage: "<id>",
+			Action:    cancelOrderGroup,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "id",
+					Usage: "the order group id",
+				},
+			},
+		},
+		{
+			Name:      "get",
+			Usage:     "returns an order group and the state of its legs",
+			ArgsUsage: "<id>",
+			Action:    getOrderGroup,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "id",
+					Usage: "the order group id",
+				},
+			},
+		},
+		{
+			Name:      "getall",
+			Usage:     "returns order groups managed by the order manager",
+			ArgsUsage: "<exchange>",
+			Action:    getOrderGroups,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "exchange",
+					Usage: "optional exchange to filter by",
+				},
+				&cli.BoolFlag{
+					Name:  "includeinactive",
+					Usage: "include completed, cancelled and failed order groups",
+				},
+			},
+		},
+	},
+}
+
+// parseOrderGroupShared parses the exchange, pair and asset shared by all
+// legs of an order group
+func parseOrderGroupShared(c *cli.Context) (string, *gctrpc.CurrencyPair, string, error) {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return "", nil, "", errInvalidPair
+	}
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return "", nil, "", err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return "", nil, "", errInvalidAsset
+	}
+
+	return exchangeName, &gctrpc.CurrencyPair{
+		Delimiter: p.Delimiter,
+		Base:      p.Base.String(),
+		Quote:     p.Quote.String(),
+	}, assetType, nil
+}
+
+// parseOrderGroupLeg parses a leg in the format
+// side,type,amount[,price[,triggerprice]]
+func parseOrderGroupLeg(leg string) (*gctrpc.OrderGroupLegRequest, error) {
+	fields := strings.Split(leg, ",")
+	if len(fields) < 3 || len(fields) > 5 {
+		return nil, fmt.Errorf("%w received '%s'", errInvalidOrderGroupLeg, leg)
+	}
+	values := make([]float64, 3)
+	for i := 2; i < len(fields); i++ {
+		v, err := strconv.ParseFloat(strings.TrimSpace(fields[i]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w received '%s': %v", errInvalidOrderGroupLeg, leg, err)
+		}
+		values[i-2] = v
+	}
+	return &gctrpc.OrderGroupLegRequest{
+		Side:         strings.TrimSpace(fields[0]),
+		OrderType:    strings.TrimSpace(fields[1]),
+		Amount:       values[0],
+		Price:        values[1],
+		TriggerPrice: values[2],
+	}, nil
+}
+
+func submitOCOOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	exchangeName, pair, assetType, err := parseOrderGroupShared(c)
+	if err != nil {
+		return err
+	}
+
+	legFlags := c.StringSlice("leg")
+	legs := make([]*gctrpc.OrderGroupLegRequest, len(legFlags))
+	for i := range legFlags {
+		legs[i], err = parseOrderGroupLeg(legFlags[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.SubmitOCOOrder(c.Context,
+		&gctrpc.SubmitOCOOrderRequest{
+			Exchange: exchangeName,
+			Pair:     pair,
+			Asset:    assetType,
+			Legs:     legs,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func submitBracketOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	exchangeName, pair, assetType, err := parseOrderGroupShared(c)
+	if err != nil {
+		return err
+	}
+
+	entry, err := parseOrderGroupLeg(c.String("entry"))
+	if err != nil {
+		return err
+	}
+	takeProfit, err := parseOrderGroupLeg(c.String("takeprofit"))
+	if err != nil {
+		return err
+	}
+	stopLoss, err := parseOrderGroupLeg(c.String("stoploss"))
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.SubmitBracketOrder(c.Context,
+		&gctrpc.SubmitBracketOrderRequest{
+			Exchange:   exchangeName,
+			Pair:       pair,
+			Asset:      assetType,
+			Entry:      entry,
+			TakeProfit: takeProfit,
+			StopLoss:   stopLoss,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func cancelOrderGroup(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.CancelOrderGroup(c.Context,
+		&gctrpc.CancelOrderGroupRequest{Id: id},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getOrderGroup(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetOrderGroup(c.Context,
+		&gctrpc.GetOrderGroupRequest{Id: id},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getOrderGroups(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetOrderGroups(c.Context,
+		&gctrpc.GetOrderGroupsRequest{
+			Exchange:        exchangeName,
+			IncludeInactive: c.Bool("includeinactive"),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"errors"
+
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var errInvalidKillSwitchState = errors.New("kill switch state must be true or false")
+
+var riskManagerCommand = &cli.Command{
+	Name:      "riskmanager",
+	Usage:     "execute pre-trade risk manager commands",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:   "status",
+			Usage:  "returns the risk limits, kill switch state, daily pnl and order rate per exchange",
+			Action: getRiskManagerStatus,
+		},
+		{
+			Name:      "killswitch",
+			Usage:     "activates the kill switch, cancelling all orders and blocking new ones, or deactivates it",
+			ArgsUsage: "<active> <reason>",
+			Action:    setKillSwitch,
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:  "active",
+					Usage: "true to cancel all orders and block new orders, false to allow new orders",
+				},
+				&cli.StringFlag{
+					Name:  "reason",
+					Usage: "optional reason recorded when activating the kill switch",
+				},
+			},
+		},
+	},
+}
+
+func getRiskManagerStatus(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetRiskManagerStatus(c.Context,
+		&gctrpc.GetRiskManagerStatusRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func setKillSwitch(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var active bool
+	if c.IsSet("active") {
+		active = c.Bool("active")
+	} else {
+		switch c.Args().First() {
+		case "true", "on", "1":
+			active = true
+		case "false", "off", "0":
+		default:
+			return errInvalidKillSwitchState
+		}
+	}
+
+	var reason string
+	if c.IsSet("reason") {
+		reason = c.String("reason")
+	} else {
+		reason = c.Args().Get(1)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.SetKillSwitch(c.Context,
+		&gctrpc.SetKillSwitchRequest{
+			Active: active,
+			Reason: reason,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
@@ -20,6 +20,7 @@ var (
 	errCAGRNoIntervals         = errors.New("cannot calculate CAGR with no intervals")
 	errCAGRZeroOpenValue       = errors.New("cannot calculate CAGR with an open value of 0")
 	errInformationBadLength    = errors.New("benchmark rates length does not match returns rates")
+	errCovarianceBadLength     = errors.New("cannot calculate covariance of values with differing lengths")
 )
 
 // CalculateAmountWithFee returns a calculated fee included amount on fee
@@ -475,3 +476,108 @@ func DecimalSharpeRatio(movementPerCandle []decimal.Decimal, riskFreeRatePerInte
 
 	return average.Sub(riskFreeRatePerInterval).Div(standardDeviation), nil
 }
+
+// DecimalPopulationCovariance measures how two sets of values move together
+// using population based calculation
+func DecimalPopulationCovariance(values, comparison []decimal.Decimal) (decimal.Decimal, error) {
+	if len(values) != len(comparison) {
+		return decimal.Zero, errCovarianceBadLength
+	}
+	valuesAvg, err := DecimalArithmeticMean(values)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	comparisonAvg, err := DecimalArithmeticMean(comparison)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	products := make([]decimal.Decimal, len(values))
+	for x := range values {
+		products[x] = values[x].Sub(valuesAvg).Mul(comparison[x].Sub(comparisonAvg))
+	}
+	return DecimalArithmeticMean(products)
+}
+
+// DecimalBeta measures the sensitivity of returns to the movement of a
+// benchmark. A beta of 1 moves with the benchmark, a beta of 0 is unrelated
+func DecimalBeta(returnsRates, benchmarkRates []decimal.Decimal) (decimal.Decimal, error) {
+	if len(benchmarkRates) != len(returnsRates) {
+		return decimal.Zero, errInformationBadLength
+	}
+	covariance, err := DecimalPopulationCovariance(returnsRates, benchmarkRates)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	variance, err := DecimalPopulationCovariance(benchmarkRates, benchmarkRates)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if variance.IsZero() {
+		return decimal.Zero, nil
+	}
+	return covariance.Div(variance), nil
+}
+
+// DecimalAlpha is Jensen's alpha, the average return earned in excess of
+// what the benchmark's return and the beta of the returns would predict
+func DecimalAlpha(averageReturns, averageBenchmark, beta, riskFreeRatePerInterval decimal.Decimal) decimal.Decimal {
+	expected := riskFreeRatePerInterval.Add(beta.Mul(averageBenchmark.Sub(riskFreeRatePerInterval)))
+	return averageReturns.Sub(expected)
+}
+
+// DecimalTrackingError is the standard deviation of the difference between
+// returns and benchmark returns
+func DecimalTrackingError(returnsRates, benchmarkRates []decimal.Decimal) (decimal.Decimal, error) {
+	if len(benchmarkRates) != len(returnsRates) {
+		return decimal.Zero, errInformationBadLength
+	}
+	diffs := make([]decimal.Decimal, len(returnsRates))
+	for i := range returnsRates {
+		diffs[i] = returnsRates[i].Sub(benchmarkRates[i])
+	}
+	return DecimalPopulationStandardDeviation(diffs)
+}
+
+// DecimalCaptureRatios compares the average return to the average benchmark
+// return over intervals where the benchmark rose (up capture) and fell
+// (down capture). A ratio is zero when the benchmark never moved that way
+func DecimalCaptureRatios(returnsRates, benchmarkRates []decimal.Decimal) (upCapture, downCapture decimal.Decimal, err error) {
+	if len(benchmarkRates) != len(returnsRates) {
+		return decimal.Zero, decimal.Zero, errInformationBadLength
+	}
+	var upReturns, upBenchmark, downReturns, downBenchmark []decimal.Decimal
+	for i := range benchmarkRates {
+		switch {
+		case benchmarkRates[i].IsPositive():
+			upReturns = append(upReturns, returnsRates[i])
+			upBenchmark = append(upBenchmark, benchmarkRates[i])
+		case benchmarkRates[i].IsNegative():
+			downReturns = append(downReturns, returnsRates[i])
+			downBenchmark = append(downBenchmark, benchmarkRates[i])
+		}
+	}
+	upCapture, err = decimalCaptureRatio(upReturns, upBenchmark)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+	downCapture, err = decimalCaptureRatio(downReturns, downBenchmark)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+	return upCapture, downCapture, nil
+}
+
+func decimalCaptureRatio(returnsRates, benchmarkRates []decimal.Decimal) (decimal.Decimal, error) {
+	if len(benchmarkRates) == 0 {
+		return decimal.Zero, nil
+	}
+	returnsAvg, err := DecimalArithmeticMean(returnsRates)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	benchmarkAvg, err := DecimalArithmeticMean(benchmarkRates)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return returnsAvg.Div(benchmarkAvg), nil
+}
@@ -870,3 +870,137 @@ func TestDecimalArithmeticAverage(t *testing.T) {
 		t.Error("expected 4.5")
 	}
 }
+
+func TestDecimalPopulationCovariance(t *testing.T) {
+	t.Parallel()
+	_, err := DecimalPopulationCovariance([]decimal.Decimal{decimal.NewFromInt(1)}, nil)
+	if !errors.Is(err, errCovarianceBadLength) {
+		t.Errorf("received '%v' expected '%v'", err, errCovarianceBadLength)
+	}
+	_, err = DecimalPopulationCovariance(nil, nil)
+	if !errors.Is(err, errZeroValue) {
+		t.Errorf("received '%v' expected '%v'", err, errZeroValue)
+	}
+	values := []decimal.Decimal{
+		decimal.NewFromInt(1),
+		decimal.NewFromInt(2),
+		decimal.NewFromInt(3),
+	}
+	comparison := []decimal.Decimal{
+		decimal.NewFromInt(3),
+		decimal.NewFromInt(2),
+		decimal.NewFromInt(1),
+	}
+	covariance, err := DecimalPopulationCovariance(values, comparison)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	expected := decimal.NewFromInt(-2).Div(decimal.NewFromInt(3))
+	if !covariance.Equal(expected) {
+		t.Errorf("received '%v' expected '%v'", covariance, expected)
+	}
+}
+
+// benchmarkFigures returns benchmark rates and returns which move at twice
+// the rate of the benchmark
+func benchmarkFigures() (returnsRates, benchmarkRates []decimal.Decimal) {
+	benchmarkRates = []decimal.Decimal{
+		decimal.NewFromFloat(0.01),
+		decimal.NewFromFloat(-0.02),
+		decimal.NewFromFloat(0.03),
+		decimal.NewFromFloat(-0.01),
+	}
+	returnsRates = make([]decimal.Decimal, len(benchmarkRates))
+	for i := range benchmarkRates {
+		returnsRates[i] = benchmarkRates[i].Mul(decimal.NewFromInt(2))
+	}
+	return returnsRates, benchmarkRates
+}
+
+func TestDecimalBeta(t *testing.T) {
+	t.Parallel()
+	_, err := DecimalBeta([]decimal.Decimal{decimal.NewFromInt(1)}, nil)
+	if !errors.Is(err, errInformationBadLength) {
+		t.Errorf("received '%v' expected '%v'", err, errInformationBadLength)
+	}
+	returnsRates, benchmarkRates := benchmarkFigures()
+	beta, err := DecimalBeta(returnsRates, benchmarkRates)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !beta.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' expected '%v'", beta, 2)
+	}
+
+	flat := []decimal.Decimal{decimal.NewFromInt(1), decimal.NewFromInt(1)}
+	beta, err = DecimalBeta(returnsRates[:2], flat)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !beta.IsZero() {
+		t.Errorf("received '%v' expected '%v'", beta, 0)
+	}
+}
+
+func TestDecimalAlpha(t *testing.T) {
+	t.Parallel()
+	alpha := DecimalAlpha(decimal.NewFromFloat(0.005), decimal.NewFromFloat(0.0025), decimal.NewFromInt(2), decimal.Zero)
+	if !alpha.IsZero() {
+		t.Errorf("received '%v' expected '%v'", alpha, 0)
+	}
+	alpha = DecimalAlpha(decimal.NewFromFloat(0.005), decimal.NewFromFloat(0.0025), decimal.NewFromInt(2), decimal.NewFromFloat(0.001))
+	if !alpha.Equal(decimal.NewFromFloat(0.001)) {
+		t.Errorf("received '%v' expected '%v'", alpha, 0.001)
+	}
+}
+
+func TestDecimalTrackingError(t *testing.T) {
+	t.Parallel()
+	_, err := DecimalTrackingError([]decimal.Decimal{decimal.NewFromInt(1)}, nil)
+	if !errors.Is(err, errInformationBadLength) {
+		t.Errorf("received '%v' expected '%v'", err, errInformationBadLength)
+	}
+	returnsRates, benchmarkRates := benchmarkFigures()
+	trackingError, err := DecimalTrackingError(returnsRates, benchmarkRates)
+	if err != nil && !errors.Is(err, ErrInexactConversion) {
+		t.Errorf("received '%v' expected '%v'", err, ErrInexactConversion)
+	}
+	// the difference between the returns and benchmark is the benchmark itself
+	expected, err := DecimalPopulationStandardDeviation(benchmarkRates)
+	if err != nil && !errors.Is(err, ErrInexactConversion) {
+		t.Errorf("received '%v' expected '%v'", err, ErrInexactConversion)
+	}
+	if !trackingError.Equal(expected) {
+		t.Errorf("received '%v' expected '%v'", trackingError, expected)
+	}
+}
+
+func TestDecimalCaptureRatios(t *testing.T) {
+	t.Parallel()
+	_, _, err := DecimalCaptureRatios([]decimal.Decimal{decimal.NewFromInt(1)}, nil)
+	if !errors.Is(err, errInformationBadLength) {
+		t.Errorf("received '%v' expected '%v'", err, errInformationBadLength)
+	}
+	returnsRates, benchmarkRates := benchmarkFigures()
+	up, down, err := DecimalCaptureRatios(returnsRates, benchmarkRates)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !up.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' expected '%v'", up, 2)
+	}
+	if !down.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' expected '%v'", down, 2)
+	}
+
+	up, down, err = DecimalCaptureRatios(returnsRates[:1], benchmarkRates[:1])
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !up.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' expected '%v'", up, 2)
+	}
+	if !down.IsZero() {
+		t.Errorf("received '%v' expected '%v'", down, 0)
+	}
+}
@@ -2,11 +2,21 @@ package base
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
+var (
+	// ErrRelayerNotFound returns when a communication relayer does not exist
+	ErrRelayerNotFound = errors.New("communication relayer not found")
+	// ErrRelayerNotConnected returns when a communication relayer is not
+	// enabled or connected
+	ErrRelayerNotConnected = errors.New("communication relayer is not enabled or connected")
+)
+
 // IComm is the main interface array across the communication packages
 type IComm []ICommunicate
 
@@ -50,6 +60,21 @@ func (c IComm) PushEvent(event Event) {
 	}
 }
 
+// PushEventTo pushes an event to a single enabled and connected communication
+// link, matched by name
+func (c IComm) PushEventTo(name string, event Event) error {
+	for i := range c {
+		if !strings.EqualFold(c[i].GetName(), name) {
+			continue
+		}
+		if !c[i].IsEnabled() || !c[i].IsConnected() {
+			return fmt.Errorf("%s %w", name, ErrRelayerNotConnected)
+		}
+		return c[i].PushEvent(event)
+	}
+	return fmt.Errorf("%s %w", name, ErrRelayerNotFound)
+}
+
 // GetStatus returns the status of the comms relayers
 func (c IComm) GetStatus() map[string]CommsStatus {
 	result := make(map[string]CommsStatus)
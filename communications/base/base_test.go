@@ -1,6 +1,7 @@
 package base
 
 import (
+	"errors"
 	"testing"
 	"time"
 )
@@ -145,3 +146,25 @@ func TestPushEvent(t *testing.T) {
 		}
 	}
 }
+
+func TestPushEventTo(t *testing.T) {
+	t.Parallel()
+	ic := IComm{&CommunicationProvider{isEnabled: true, isConnected: false}}
+	err := ic.PushEventTo("someTestProvider", Event{})
+	if !errors.Is(err, ErrRelayerNotConnected) {
+		t.Errorf("error '%v', expected '%v'", err, ErrRelayerNotConnected)
+	}
+	err = ic.PushEventTo("missing", Event{})
+	if !errors.Is(err, ErrRelayerNotFound) {
+		t.Errorf("error '%v', expected '%v'", err, ErrRelayerNotFound)
+	}
+	p := &CommunicationProvider{isEnabled: true, isConnected: true}
+	ic = IComm{p}
+	err = ic.PushEventTo("SOMETESTPROVIDER", Event{})
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	if !p.PushEventCalled {
+		t.Error("expected event to be pushed")
+	}
+}
@@ -1433,6 +1433,60 @@ func (c *Config) CheckDataHistoryMonitorConfig() {
 	}
 }
 
+// CheckBalanceHistoryManagerConfig ensures the balance history manager config
+// is valid, or sets default values
+func (c *Config) CheckBalanceHistoryManagerConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.BalanceHistoryManager.Delay <= 0 {
+		c.BalanceHistoryManager.Delay = defaultBalanceHistoryDelay
+	}
+	if c.BalanceHistoryManager.FiatCurrency.IsEmpty() {
+		c.BalanceHistoryManager.FiatCurrency = c.Currency.FiatDisplayCurrency
+	}
+}
+
+// CheckConsolidatedOrderbookManagerConfig ensures the consolidated orderbook
+// manager config is valid, removing invalid orderbooks
+func (c *Config) CheckConsolidatedOrderbookManagerConfig() {
+	m.Lock()
+	defer m.Unlock()
+	books := c.ConsolidatedOrderbookManager.Books[:0]
+	for i := range c.ConsolidatedOrderbookManager.Books {
+		b := c.ConsolidatedOrderbookManager.Books[i]
+		if b.Pair.IsEmpty() {
+			log.Warnf(log.ConfigMgr, "Consolidated orderbook %d has no pair set, removing\n", i)
+			continue
+		}
+		if b.Asset == "" {
+			b.Asset = asset.Spot.String()
+		}
+		a, err := asset.New(b.Asset)
+		if err != nil {
+			log.Warnf(log.ConfigMgr, "Consolidated orderbook %s asset %v, removing\n", b.Pair, err)
+			continue
+		}
+		b.Asset = a.String()
+		quotes := b.EquivalentQuotes[:0]
+		for j := range b.EquivalentQuotes {
+			if b.EquivalentQuotes[j].Currency.IsEmpty() || b.EquivalentQuotes[j].Rate < 0 {
+				log.Warnf(log.ConfigMgr, "Consolidated orderbook %s equivalent quote %d is invalid, removing\n", b.Pair, j)
+				continue
+			}
+			quotes = append(quotes, b.EquivalentQuotes[j])
+		}
+		b.EquivalentQuotes = quotes
+		books = append(books, b)
+	}
+	c.ConsolidatedOrderbookManager.Books = books
+	for k, v := range c.ConsolidatedOrderbookManager.Fees {
+		if v < 0 || v >= 1 {
+			log.Warnf(log.ConfigMgr, "Consolidated orderbook fee for %s must be between 0 and 1, removing\n", k)
+			delete(c.ConsolidatedOrderbookManager.Fees, k)
+		}
+	}
+}
+
 // CheckCurrencyStateManager ensures the currency state config is valid, or sets
 // default values
 func (c *Config) CheckCurrencyStateManager() {
@@ -1446,6 +1500,66 @@ func (c *Config) CheckCurrencyStateManager() {
 	}
 }
 
+// CheckRiskManagerConfig ensures the risk manager config is valid, negative
+// limits are disabled
+func (c *Config) CheckRiskManagerConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.RiskManager.MaxOrderNotional < 0 {
+		log.Warnf(log.ConfigMgr, "Risk manager max order notional cannot be negative, disabling\n")
+		c.RiskManager.MaxOrderNotional = 0
+	}
+	if c.RiskManager.MaxPositionPerPair < 0 {
+		log.Warnf(log.ConfigMgr, "Risk manager max position per pair cannot be negative, disabling\n")
+		c.RiskManager.MaxPositionPerPair = 0
+	}
+	if c.RiskManager.MaxDailyLoss < 0 {
+		log.Warnf(log.ConfigMgr, "Risk manager max daily loss cannot be negative, disabling\n")
+		c.RiskManager.MaxDailyLoss = 0
+	}
+	if c.RiskManager.MaxPriceDeviation < 0 {
+		log.Warnf(log.ConfigMgr, "Risk manager max price deviation cannot be negative, disabling\n")
+		c.RiskManager.MaxPriceDeviation = 0
+	}
+	if c.RiskManager.MaxOrdersPerInterval < 0 {
+		log.Warnf(log.ConfigMgr, "Risk manager max orders per interval cannot be negative, disabling\n")
+		c.RiskManager.MaxOrdersPerInterval = 0
+	}
+	if c.RiskManager.OrderRateInterval <= 0 {
+		c.RiskManager.OrderRateInterval = defaultRiskManagerOrderRateInterval
+	}
+}
+
+// CheckMarketDataConfig ensures the market data recorder and replayer configs
+// are valid. Recording is disabled while replaying so replayed events are not
+// recorded again
+func (c *Config) CheckMarketDataConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.MarketDataRecorder.Directory == "" {
+		c.MarketDataRecorder.Directory = c.GetDataPath("marketdata")
+	}
+	if c.MarketDataRecorder.FlushInterval <= 0 {
+		c.MarketDataRecorder.FlushInterval = defaultMarketDataFlushInterval
+	}
+	if c.MarketDataReplayer.Directory == "" {
+		c.MarketDataReplayer.Directory = c.GetDataPath("marketdata")
+	}
+	if c.MarketDataReplayer.Speed < 0 {
+		log.Warnf(log.ConfigMgr, "Market data replayer speed cannot be negative, replaying in real time\n")
+		c.MarketDataReplayer.Speed = 1
+	}
+	if !c.MarketDataReplayer.EndTime.IsZero() &&
+		c.MarketDataReplayer.EndTime.Before(c.MarketDataReplayer.StartTime) {
+		log.Warnf(log.ConfigMgr, "Market data replayer end time cannot be before start time, replaying all events after start time\n")
+		c.MarketDataReplayer.EndTime = time.Time{}
+	}
+	if c.MarketDataReplayer.Enabled && c.MarketDataRecorder.Enabled {
+		log.Warnf(log.ConfigMgr, "Market data recorder cannot be enabled while replaying, disabling recorder\n")
+		c.MarketDataRecorder.Enabled = false
+	}
+}
+
 // CheckConnectionMonitorConfig checks and if zero value assigns default values
 func (c *Config) CheckConnectionMonitorConfig() {
 	m.Lock()
@@ -1794,6 +1908,8 @@ func (c *Config) CheckConfig() error {
 	c.CheckConnectionMonitorConfig()
 	c.CheckDataHistoryMonitorConfig()
 	c.CheckCurrencyStateManager()
+	c.CheckRiskManagerConfig()
+	c.CheckMarketDataConfig()
 	c.CheckCommunicationsConfig()
 	c.CheckClientBankAccounts()
 	c.CheckBankAccountConfig()
@@ -1803,6 +1919,8 @@ func (c *Config) CheckConfig() error {
 	if err != nil {
 		return err
 	}
+	c.CheckBalanceHistoryManagerConfig()
+	c.CheckConsolidatedOrderbookManagerConfig()
 
 	if c.GlobalHTTPTimeout <= 0 {
 		log.Warnf(log.ConfigMgr,
@@ -1828,6 +1828,99 @@ func TestCheckConnectionMonitorConfig(t *testing.T) {
 	}
 }
 
+func TestCheckRiskManagerConfig(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.RiskManager.MaxOrderNotional = -1
+	c.RiskManager.MaxPositionPerPair = 2
+	c.RiskManager.MaxDailyLoss = -1
+	c.RiskManager.MaxPriceDeviation = -0.1
+	c.RiskManager.MaxOrdersPerInterval = -5
+	c.CheckRiskManagerConfig()
+
+	if c.RiskManager.MaxOrderNotional != 0 ||
+		c.RiskManager.MaxPositionPerPair != 2 ||
+		c.RiskManager.MaxDailyLoss != 0 ||
+		c.RiskManager.MaxPriceDeviation != 0 ||
+		c.RiskManager.MaxOrdersPerInterval != 0 ||
+		c.RiskManager.OrderRateInterval != defaultRiskManagerOrderRateInterval {
+		t.Errorf("unexpected values %+v", c.RiskManager)
+	}
+}
+
+func TestCheckMarketDataConfig(t *testing.T) {
+	t.Parallel()
+
+	c := Config{DataDirectory: "test"}
+	c.MarketDataRecorder.Enabled = true
+	c.MarketDataReplayer.Enabled = true
+	c.MarketDataReplayer.Speed = -1
+	c.MarketDataReplayer.StartTime = time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)
+	c.MarketDataReplayer.EndTime = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	c.CheckMarketDataConfig()
+
+	expectedDir := filepath.Join("test", "marketdata")
+	if c.MarketDataRecorder.Enabled ||
+		c.MarketDataRecorder.Directory != expectedDir ||
+		c.MarketDataRecorder.FlushInterval != defaultMarketDataFlushInterval {
+		t.Errorf("unexpected values %+v", c.MarketDataRecorder)
+	}
+	if c.MarketDataReplayer.Directory != expectedDir ||
+		c.MarketDataReplayer.Speed != 1 ||
+		!c.MarketDataReplayer.EndTime.IsZero() {
+		t.Errorf("unexpected values %+v", c.MarketDataReplayer)
+	}
+}
+
+func TestCheckBalanceHistoryManagerConfig(t *testing.T) {
+	t.Parallel()
+
+	c := Config{}
+	c.Currency.FiatDisplayCurrency = currency.EUR
+	c.CheckBalanceHistoryManagerConfig()
+	if c.BalanceHistoryManager.Delay != defaultBalanceHistoryDelay ||
+		c.BalanceHistoryManager.FiatCurrency != currency.EUR {
+		t.Errorf("unexpected values %+v", c.BalanceHistoryManager)
+	}
+
+	c.BalanceHistoryManager.Delay = time.Hour
+	c.BalanceHistoryManager.FiatCurrency = currency.AUD
+	c.CheckBalanceHistoryManagerConfig()
+	if c.BalanceHistoryManager.Delay != time.Hour ||
+		c.BalanceHistoryManager.FiatCurrency != currency.AUD {
+		t.Errorf("unexpected values %+v", c.BalanceHistoryManager)
+	}
+}
+
+func TestCheckConsolidatedOrderbookManagerConfig(t *testing.T) {
+	t.Parallel()
+
+	c := Config{}
+	c.ConsolidatedOrderbookManager.Books = []ConsolidatedOrderbook{
+		{},
+		{Pair: currency.NewPair(currency.BTC, currency.USD), Asset: "meow"},
+		{
+			Pair: currency.NewPair(currency.BTC, currency.USD),
+			EquivalentQuotes: []QuoteConversion{
+				{Currency: currency.USDT, Rate: 1},
+				{Currency: currency.USDC, Rate: -1},
+				{},
+			},
+		},
+	}
+	c.ConsolidatedOrderbookManager.Fees = map[string]float64{"a": 0.001, "b": -1, "c": 1}
+	c.CheckConsolidatedOrderbookManagerConfig()
+	if len(c.ConsolidatedOrderbookManager.Books) != 1 ||
+		c.ConsolidatedOrderbookManager.Books[0].Asset != asset.Spot.String() ||
+		len(c.ConsolidatedOrderbookManager.Books[0].EquivalentQuotes) != 1 {
+		t.Errorf("unexpected values %+v", c.ConsolidatedOrderbookManager.Books)
+	}
+	if len(c.ConsolidatedOrderbookManager.Fees) != 1 || c.ConsolidatedOrderbookManager.Fees["a"] != 0.001 {
+		t.Errorf("unexpected values %+v", c.ConsolidatedOrderbookManager.Fees)
+	}
+}
+
 func TestDefaultFilePath(t *testing.T) {
 	// This is tricky to test because we're dealing with a config file stored
 	// in a persons default directory and to properly test it, it would
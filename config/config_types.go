@@ -38,6 +38,9 @@ const (
 	DefaultAPIClientID                   = "ClientID"
 	defaultDataHistoryMonitorCheckTimer  = time.Minute
 	defaultCurrencyStateManagerDelay     = time.Minute
+	defaultRiskManagerOrderRateInterval  = time.Second
+	defaultMarketDataFlushInterval       = time.Second * 5
+	defaultBalanceHistoryDelay           = time.Minute * 5
 	defaultMaxJobsPerCycle               = 5
 	DefaultOrderbookPublishPeriod        = time.Second * 10
 )
@@ -74,24 +77,29 @@ var (
 // prestart management of Portfolio, Communications, Webserver and Enabled
 // Exchanges
 type Config struct {
-	Name                 string                    `json:"name"`
-	DataDirectory        string                    `json:"dataDirectory"`
-	EncryptConfig        int                       `json:"encryptConfig"`
-	GlobalHTTPTimeout    time.Duration             `json:"globalHTTPTimeout"`
-	Database             database.Config           `json:"database"`
-	Logging              log.Config                `json:"logging"`
-	ConnectionMonitor    ConnectionMonitorConfig   `json:"connectionMonitor"`
-	DataHistoryManager   DataHistoryManager        `json:"dataHistoryManager"`
-	CurrencyStateManager CurrencyStateManager      `json:"currencyStateManager"`
-	Profiler             Profiler                  `json:"profiler"`
-	NTPClient            NTPClientConfig           `json:"ntpclient"`
-	GCTScript            gctscript.Config          `json:"gctscript"`
-	Currency             CurrencyConfig            `json:"currencyConfig"`
-	Communications       base.CommunicationsConfig `json:"communications"`
-	RemoteControl        RemoteControlConfig       `json:"remoteControl"`
-	Portfolio            portfolio.Base            `json:"portfolioAddresses"`
-	Exchanges            []Exchange                `json:"exchanges"`
-	BankAccounts         []banking.Account         `json:"bankAccounts"`
+	Name                         string                       `json:"name"`
+	DataDirectory                string                       `json:"dataDirectory"`
+	EncryptConfig                int                          `json:"encryptConfig"`
+	GlobalHTTPTimeout            time.Duration                `json:"globalHTTPTimeout"`
+	Database                     database.Config              `json:"database"`
+	Logging                      log.Config                   `json:"logging"`
+	ConnectionMonitor            ConnectionMonitorConfig      `json:"connectionMonitor"`
+	DataHistoryManager           DataHistoryManager           `json:"dataHistoryManager"`
+	BalanceHistoryManager        BalanceHistoryManager        `json:"balanceHistoryManager"`
+	CurrencyStateManager         CurrencyStateManager         `json:"currencyStateManager"`
+	RiskManager                  RiskManager                  `json:"riskManager"`
+	MarketDataRecorder           MarketDataRecorder           `json:"marketDataRecorder"`
+	MarketDataReplayer           MarketDataReplayer           `json:"marketDataReplayer"`
+	ConsolidatedOrderbookManager ConsolidatedOrderbookManager `json:"consolidatedOrderbookManager"`
+	Profiler                     Profiler                     `json:"profiler"`
+	NTPClient                    NTPClientConfig              `json:"ntpclient"`
+	GCTScript                    gctscript.Config             `json:"gctscript"`
+	Currency                     CurrencyConfig               `json:"currencyConfig"`
+	Communications               base.CommunicationsConfig    `json:"communications"`
+	RemoteControl                RemoteControlConfig          `json:"remoteControl"`
+	Portfolio                    portfolio.Base               `json:"portfolioAddresses"`
+	Exchanges                    []Exchange                   `json:"exchanges"`
+	BankAccounts                 []banking.Account            `json:"bankAccounts"`
 
 	// Deprecated config settings, will be removed at a future date
 	Webserver           *WebserverConfig          `json:"webserver,omitempty"`
@@ -113,6 +121,18 @@ type DataHistoryManager struct {
 	Verbose             bool          `json:"verbose"`
 }
 
+// BalanceHistoryManager defines the recording of exchange account balances to
+// the database
+type BalanceHistoryManager struct {
+	Enabled bool `json:"enabled"`
+	// Delay is the time between balance snapshots of all exchanges
+	Delay time.Duration `json:"delay"`
+	// FiatCurrency values balances when recorded, defaulting to the fiat
+	// display currency
+	FiatCurrency currency.Code `json:"fiatCurrency"`
+	Verbose      bool          `json:"verbose"`
+}
+
 // CurrencyStateManager defines a set of configuration options for the currency
 // state manager
 type CurrencyStateManager struct {
@@ -120,6 +140,90 @@ type CurrencyStateManager struct {
 	Delay   time.Duration `json:"delay"`
 }
 
+// RiskManager defines the pre-trade risk checks applied to every order before
+// it leaves the engine. A zero value disables the associated check
+type RiskManager struct {
+	Enabled bool `json:"enabled"`
+	// MaxOrderNotional is the maximum amount multiplied by price of a single
+	// order, in the quote currency of the pair
+	MaxOrderNotional float64 `json:"maxOrderNotional"`
+	// MaxPositionPerPair is the maximum net position in the base currency an
+	// exchange pair can reach including open orders
+	MaxPositionPerPair float64 `json:"maxPositionPerPair"`
+	// MaxDailyLoss is the maximum loss across all pairs since the start of the
+	// UTC day before new orders are blocked
+	MaxDailyLoss float64 `json:"maxDailyLoss"`
+	// MaxPriceDeviation is the maximum fraction an order price can deviate
+	// from the last ticker price e.g. 0.05 for 5%
+	MaxPriceDeviation float64 `json:"maxPriceDeviation"`
+	// MaxOrdersPerInterval is the maximum number of orders submitted to an
+	// exchange within OrderRateInterval
+	MaxOrdersPerInterval int64         `json:"maxOrdersPerInterval"`
+	OrderRateInterval    time.Duration `json:"orderRateInterval"`
+	Verbose              bool          `json:"verbose"`
+}
+
+// MarketDataRecorder defines the recording of normalised websocket events to
+// compressed files per exchange and day
+type MarketDataRecorder struct {
+	Enabled bool `json:"enabled"`
+	// Directory defaults to the marketdata folder in the data directory
+	Directory string `json:"directory"`
+	// Exchanges limits recording to the named exchanges, all exchanges are
+	// recorded when empty
+	Exchanges []string `json:"exchanges"`
+	// FlushInterval is how often buffered events are written to disk
+	FlushInterval time.Duration `json:"flushInterval"`
+}
+
+// MarketDataReplayer defines the replay of events recorded by the market data
+// recorder
+type MarketDataReplayer struct {
+	Enabled bool `json:"enabled"`
+	// Directory defaults to the marketdata folder in the data directory
+	Directory string `json:"directory"`
+	// Exchanges limits replay to the named exchanges, all recorded exchanges
+	// are replayed when empty
+	Exchanges []string  `json:"exchanges"`
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+	// Speed multiplies the recorded pace of events e.g. 1 for real time and
+	// 10 for ten times faster. Zero replays events as fast as possible
+	Speed float64 `json:"speed"`
+}
+
+// ConsolidatedOrderbookManager defines the merging of exchange orderbooks for
+// the same pair into consolidated cross exchange orderbooks
+type ConsolidatedOrderbookManager struct {
+	Enabled bool                    `json:"enabled"`
+	Books   []ConsolidatedOrderbook `json:"books"`
+	// Fees overrides the taker fee rate of an exchange e.g. 0.001 for 0.1%.
+	// Exchanges without an override use their offline trade fee
+	Fees    map[string]float64 `json:"fees"`
+	Verbose bool               `json:"verbose"`
+}
+
+// ConsolidatedOrderbook defines a pair to merge across exchanges
+type ConsolidatedOrderbook struct {
+	Pair  currency.Pair `json:"pair"`
+	Asset string        `json:"asset"`
+	// Exchanges limits the orderbook to the named exchanges, all enabled
+	// exchanges are merged when empty
+	Exchanges []string `json:"exchanges"`
+	// EquivalentQuotes are quote currencies merged into the orderbook of the
+	// pair e.g. USDT and USDC for a USD pair
+	EquivalentQuotes []QuoteConversion `json:"equivalentQuotes"`
+}
+
+// QuoteConversion defines how prices in an equivalent quote currency are
+// converted into the quote currency of a consolidated orderbook
+type QuoteConversion struct {
+	Currency currency.Code `json:"currency"`
+	// Rate multiplies prices into the quote currency of the orderbook. Zero
+	// uses the foreign exchange rate of the currency
+	Rate float64 `json:"rate"`
+}
+
 // ConnectionMonitorConfig defines the connection monitor variables to ensure
 // that there is internet connectivity
 type ConnectionMonitorConfig struct {
@@ -342,4 +446,7 @@ type Orderbook struct {
 	// PublishPeriod here is a pointer because we want to distinguish
 	// between zeroed out and missing.
 	PublishPeriod *time.Duration `json:"publishPeriod"`
+	// SaveWebsocketDeltas stores every applied websocket orderbook update to
+	// the database so books can be rebuilt from stored snapshots
+	SaveWebsocketDeltas bool `json:"saveWebsocketDeltas,omitempty"`
 }
@@ -0,0 +1,31 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS order_detail
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    order_id varchar NOT NULL,
+    client_order_id varchar,
+    account_id varchar,
+    asset varchar NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    side varchar NOT NULL,
+    type varchar NOT NULL,
+    status varchar NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    trigger_price DOUBLE PRECISION NOT NULL,
+    average_executed_price DOUBLE PRECISION NOT NULL,
+    executed_amount DOUBLE PRECISION NOT NULL,
+    remaining_amount DOUBLE PRECISION NOT NULL,
+    cost DOUBLE PRECISION NOT NULL,
+    fee DOUBLE PRECISION NOT NULL,
+    leverage DOUBLE PRECISION NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT uniqueexchangeorderid
+        unique(exchange_name_id, order_id, asset)
+);
+CREATE INDEX IF NOT EXISTS order_detail_status_idx ON order_detail(status);
+-- +goose Down
+DROP TABLE order_detail;
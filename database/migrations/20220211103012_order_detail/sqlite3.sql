@@ -0,0 +1,32 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS order_detail
+(
+    id text NOT NULL primary key,
+    exchange_name_id text NOT NULL,
+    order_id text NOT NULL,
+    client_order_id text,
+    account_id text,
+    asset text NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    side text NOT NULL,
+    type text NOT NULL,
+    status text NOT NULL,
+    price real NOT NULL,
+    amount real NOT NULL,
+    trigger_price real NOT NULL,
+    average_executed_price real NOT NULL,
+    executed_amount real NOT NULL,
+    remaining_amount real NOT NULL,
+    cost real NOT NULL,
+    fee real NOT NULL,
+    leverage real NOT NULL,
+    created_at timestamp NOT NULL,
+    updated_at timestamp NOT NULL,
+    FOREIGN KEY(exchange_name_id) REFERENCES exchange(id) ON DELETE RESTRICT,
+    UNIQUE(id) ON CONFLICT REPLACE,
+    UNIQUE(exchange_name_id, order_id, asset) ON CONFLICT REPLACE
+);
+CREATE INDEX IF NOT EXISTS order_detail_status_idx ON order_detail(status);
+-- +goose Down
+DROP TABLE order_detail;
@@ -0,0 +1,35 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS orderbook_snapshot
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    asset varchar NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    timestamp TIMESTAMPTZ NOT NULL,
+    update_id BIGINT NOT NULL,
+    depth INTEGER NOT NULL,
+    bids TEXT NOT NULL,
+    asks TEXT NOT NULL,
+    CONSTRAINT uniqueorderbooksnapshot
+        unique(exchange_name_id, asset, base, quote, timestamp)
+);
+CREATE TABLE IF NOT EXISTS orderbook_delta
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    asset varchar NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    timestamp TIMESTAMPTZ NOT NULL,
+    sequence BIGINT NOT NULL,
+    update_id BIGINT NOT NULL,
+    action varchar NOT NULL,
+    max_depth INTEGER NOT NULL,
+    bids TEXT NOT NULL,
+    asks TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS orderbook_delta_timestamp_idx ON orderbook_delta(exchange_name_id, asset, base, quote, timestamp);
+-- +goose Down
+DROP TABLE orderbook_delta;
+DROP TABLE orderbook_snapshot;
@@ -0,0 +1,36 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS orderbook_snapshot
+(
+    id text NOT NULL primary key,
+    exchange_name_id text NOT NULL,
+    asset text NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    timestamp timestamp NOT NULL,
+    update_id integer NOT NULL,
+    depth integer NOT NULL,
+    bids text NOT NULL,
+    asks text NOT NULL,
+    FOREIGN KEY(exchange_name_id) REFERENCES exchange(id) ON DELETE RESTRICT,
+    UNIQUE(exchange_name_id, asset, base, quote, timestamp) ON CONFLICT REPLACE
+);
+CREATE TABLE IF NOT EXISTS orderbook_delta
+(
+    id text NOT NULL primary key,
+    exchange_name_id text NOT NULL,
+    asset text NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    timestamp timestamp NOT NULL,
+    sequence integer NOT NULL,
+    update_id integer NOT NULL,
+    action text NOT NULL,
+    max_depth integer NOT NULL,
+    bids text NOT NULL,
+    asks text NOT NULL,
+    FOREIGN KEY(exchange_name_id) REFERENCES exchange(id) ON DELETE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS orderbook_delta_timestamp_idx ON orderbook_delta(exchange_name_id, asset, base, quote, timestamp);
+-- +goose Down
+DROP TABLE orderbook_delta;
+DROP TABLE orderbook_snapshot;
@@ -0,0 +1,33 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS balance_snapshot
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    account varchar NOT NULL,
+    asset varchar NOT NULL,
+    currency varchar(30) NOT NULL,
+    total DOUBLE PRECISION NOT NULL,
+    hold DOUBLE PRECISION NOT NULL,
+    fiat_currency varchar(30) NOT NULL,
+    fiat_rate DOUBLE PRECISION NOT NULL,
+    timestamp TIMESTAMPTZ NOT NULL,
+    CONSTRAINT uniquebalancesnapshot
+        unique(exchange_name_id, account, asset, currency, timestamp)
+);
+CREATE INDEX IF NOT EXISTS balance_snapshot_timestamp_idx ON balance_snapshot(timestamp);
+CREATE TABLE IF NOT EXISTS balance_change
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    account varchar NOT NULL,
+    asset varchar NOT NULL,
+    currency varchar(30) NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    fiat_currency varchar(30) NOT NULL,
+    fiat_rate DOUBLE PRECISION NOT NULL,
+    timestamp TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS balance_change_timestamp_idx ON balance_change(timestamp);
+-- +goose Down
+DROP TABLE balance_change;
+DROP TABLE balance_snapshot;
@@ -0,0 +1,34 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS balance_snapshot
+(
+    id text NOT NULL primary key,
+    exchange_name_id text NOT NULL,
+    account text NOT NULL,
+    asset text NOT NULL,
+    currency text NOT NULL,
+    total real NOT NULL,
+    hold real NOT NULL,
+    fiat_currency text NOT NULL,
+    fiat_rate real NOT NULL,
+    timestamp timestamp NOT NULL,
+    FOREIGN KEY(exchange_name_id) REFERENCES exchange(id) ON DELETE RESTRICT,
+    UNIQUE(exchange_name_id, account, asset, currency, timestamp) ON CONFLICT REPLACE
+);
+CREATE INDEX IF NOT EXISTS balance_snapshot_timestamp_idx ON balance_snapshot(timestamp);
+CREATE TABLE IF NOT EXISTS balance_change
+(
+    id text NOT NULL primary key,
+    exchange_name_id text NOT NULL,
+    account text NOT NULL,
+    asset text NOT NULL,
+    currency text NOT NULL,
+    amount real NOT NULL,
+    fiat_currency text NOT NULL,
+    fiat_rate real NOT NULL,
+    timestamp timestamp NOT NULL,
+    FOREIGN KEY(exchange_name_id) REFERENCES exchange(id) ON DELETE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS balance_change_timestamp_idx ON balance_change(timestamp);
+-- +goose Down
+DROP TABLE balance_change;
+DROP TABLE balance_snapshot;
@@ -0,0 +1,899 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+// BalanceChange is an object representing the database table.
+type BalanceChange struct {
+	ID             string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ExchangeNameID string    `boil:"exchange_name_id" json:"exchange_name_id" toml:"exchange_name_id" yaml:"exchange_name_id"`
+	Account        string    `boil:"account" json:"account" toml:"account" yaml:"account"`
+	Asset          string    `boil:"asset" json:"asset" toml:"asset" yaml:"asset"`
+	Currency       string    `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
+	Amount         float64   `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	FiatCurrency   string    `boil:"fiat_currency" json:"fiat_currency" toml:"fiat_currency" yaml:"fiat_currency"`
+	FiatRate       float64   `boil:"fiat_rate" json:"fiat_rate" toml:"fiat_rate" yaml:"fiat_rate"`
+	Timestamp      time.Time `boil:"timestamp" json:"timestamp" toml:"timestamp" yaml:"timestamp"`
+
+	R *balanceChangeR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L balanceChangeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var BalanceChangeColumns = struct {
+	ID             string
+	ExchangeNameID string
+	Account        string
+	Asset          string
+	Currency       string
+	Amount         string
+	FiatCurrency   string
+	FiatRate       string
+	Timestamp      string
+}{
+	ID:             "id",
+	ExchangeNameID: "exchange_name_id",
+	Account:        "account",
+	Asset:          "asset",
+	Currency:       "currency",
+	Amount:         "amount",
+	FiatCurrency:   "fiat_currency",
+	FiatRate:       "fiat_rate",
+	Timestamp:      "timestamp",
+}
+
+// Generated where
+
+var BalanceChangeWhere = struct {
+	ID             whereHelperstring
+	ExchangeNameID whereHelperstring
+	Account        whereHelperstring
+	Asset          whereHelperstring
+	Currency       whereHelperstring
+	Amount         whereHelperfloat64
+	FiatCurrency   whereHelperstring
+	FiatRate       whereHelperfloat64
+	Timestamp      whereHelpertime_Time
+}{
+	ID:             whereHelperstring{field: "\"balance_change\".\"id\""},
+	ExchangeNameID: whereHelperstring{field: "\"balance_change\".\"exchange_name_id\""},
+	Account:        whereHelperstring{field: "\"balance_change\".\"account\""},
+	Asset:          whereHelperstring{field: "\"balance_change\".\"asset\""},
+	Currency:       whereHelperstring{field: "\"balance_change\".\"currency\""},
+	Amount:         whereHelperfloat64{field: "\"balance_change\".\"amount\""},
+	FiatCurrency:   whereHelperstring{field: "\"balance_change\".\"fiat_currency\""},
+	FiatRate:       whereHelperfloat64{field: "\"balance_change\".\"fiat_rate\""},
+	Timestamp:      whereHelpertime_Time{field: "\"balance_change\".\"timestamp\""},
+}
+
+// BalanceChangeRels is where relationship names are stored.
+var BalanceChangeRels = struct {
+}{}
+
+// balanceChangeR is where relationships are stored.
+type balanceChangeR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*balanceChangeR) NewStruct() *balanceChangeR {
+	return &balanceChangeR{}
+}
+
+// balanceChangeL is where Load methods for each relationship are stored.
+type balanceChangeL struct{}
+
+var (
+	balanceChangeAllColumns            = []string{"id", "exchange_name_id", "account", "asset", "currency", "amount", "fiat_currency", "fiat_rate", "timestamp"}
+	balanceChangeColumnsWithoutDefault = []string{"exchange_name_id", "account", "asset", "currency", "amount", "fiat_currency", "fiat_rate", "timestamp"}
+	balanceChangeColumnsWithDefault    = []string{"id"}
+	balanceChangePrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// BalanceChangeSlice is an alias for a slice of pointers to BalanceChange.
+	// This should generally be used opposed to []BalanceChange.
+	BalanceChangeSlice []*BalanceChange
+	// BalanceChangeHook is the signature for custom BalanceChange hook methods
+	BalanceChangeHook func(context.Context, boil.ContextExecutor, *BalanceChange) error
+
+	balanceChangeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	balanceChangeType                 = reflect.TypeOf(&BalanceChange{})
+	balanceChangeMapping              = queries.MakeStructMapping(balanceChangeType)
+	balanceChangePrimaryKeyMapping, _ = queries.BindMapping(balanceChangeType, balanceChangeMapping, balanceChangePrimaryKeyColumns)
+	balanceChangeInsertCacheMut       sync.RWMutex
+	balanceChangeInsertCache          = make(map[string]insertCache)
+	balanceChangeUpdateCacheMut       sync.RWMutex
+	balanceChangeUpdateCache          = make(map[string]updateCache)
+	balanceChangeUpsertCacheMut       sync.RWMutex
+	balanceChangeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var balanceChangeBeforeInsertHooks []BalanceChangeHook
+var balanceChangeBeforeUpdateHooks []BalanceChangeHook
+var balanceChangeBeforeDeleteHooks []BalanceChangeHook
+var balanceChangeBeforeUpsertHooks []BalanceChangeHook
+
+var balanceChangeAfterInsertHooks []BalanceChangeHook
+var balanceChangeAfterSelectHooks []BalanceChangeHook
+var balanceChangeAfterUpdateHooks []BalanceChangeHook
+var balanceChangeAfterDeleteHooks []BalanceChangeHook
+var balanceChangeAfterUpsertHooks []BalanceChangeHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *BalanceChange) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range balanceChangeBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *BalanceChange) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range balanceChangeBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *BalanceChange) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range balanceChangeBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *BalanceChange) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range balanceChangeBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *BalanceChange) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range balanceChangeAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *BalanceChange) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range balanceChangeAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *BalanceChange) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range balanceChangeAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *BalanceChange) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range balanceChangeAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *BalanceChange) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range balanceChangeAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddBalanceChangeHook registers your hook function for all future operations.
+func AddBalanceChangeHook(hookPoint boil.HookPoint, balanceChangeHook BalanceChangeHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		balanceChangeBeforeInsertHooks = append(balanceChangeBeforeInsertHooks, balanceChangeHook)
+	case boil.BeforeUpdateHook:
+		balanceChangeBeforeUpdateHooks = append(balanceChangeBeforeUpdateHooks, balanceChangeHook)
+	case boil.BeforeDeleteHook:
+		balanceChangeBeforeDeleteHooks = append(balanceChangeBeforeDeleteHooks, balanceChangeHook)
+	case boil.BeforeUpsertHook:
+		balanceChangeBeforeUpsertHooks = append(balanceChangeBeforeUpsertHooks, balanceChangeHook)
+	case boil.AfterInsertHook:
+		balanceChangeAfterInsertHooks = append(balanceChangeAfterInsertHooks, balanceChangeHook)
+	case boil.AfterSelectHook:
+		balanceChangeAfterSelectHooks = append(balanceChangeAfterSelectHooks, balanceChangeHook)
+	case boil.AfterUpdateHook:
+		balanceChangeAfterUpdateHooks = append(balanceChangeAfterUpdateHooks, balanceChangeHook)
+	case boil.AfterDeleteHook:
+		balanceChangeAfterDeleteHooks = append(balanceChangeAfterDeleteHooks, balanceChangeHook)
+	case boil.AfterUpsertHook:
+		balanceChangeAfterUpsertHooks = append(balanceChangeAfterUpsertHooks, balanceChangeHook)
+	}
+}
+
+// One returns a single balance_change record from the query.
+func (q balanceChangeQuery) One(ctx context.Context, exec boil.ContextExecutor) (*BalanceChange, error) {
+	o := &BalanceChange{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: failed to execute a one query for balance_change")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all BalanceChange records from the query.
+func (q balanceChangeQuery) All(ctx context.Context, exec boil.ContextExecutor) (BalanceChangeSlice, error) {
+	var o []*BalanceChange
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "postgres: failed to assign all query results to BalanceChange slice")
+	}
+
+	if len(balanceChangeAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all BalanceChange records in the query.
+func (q balanceChangeQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to count balance_change rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q balanceChangeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: failed to check if balance_change exists")
+	}
+
+	return count > 0, nil
+}
+
+// BalanceChanges retrieves all the records using an executor.
+func BalanceChanges(mods ...qm.QueryMod) balanceChangeQuery {
+	mods = append(mods, qm.From("\"balance_change\""))
+	return balanceChangeQuery{NewQuery(mods...)}
+}
+
+// FindBalanceChange retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindBalanceChange(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*BalanceChange, error) {
+	balanceChangeObj := &BalanceChange{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"balance_change\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, balanceChangeObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: unable to select from balance_change")
+	}
+
+	return balanceChangeObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *BalanceChange) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no balance_change provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(balanceChangeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	balanceChangeInsertCacheMut.RLock()
+	cache, cached := balanceChangeInsertCache[key]
+	balanceChangeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			balanceChangeAllColumns,
+			balanceChangeColumnsWithDefault,
+			balanceChangeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(balanceChangeType, balanceChangeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(balanceChangeType, balanceChangeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"balance_change\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"balance_change\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to insert into balance_change")
+	}
+
+	if !cached {
+		balanceChangeInsertCacheMut.Lock()
+		balanceChangeInsertCache[key] = cache
+		balanceChangeInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the BalanceChange.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *BalanceChange) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	balanceChangeUpdateCacheMut.RLock()
+	cache, cached := balanceChangeUpdateCache[key]
+	balanceChangeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			balanceChangeAllColumns,
+			balanceChangePrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("postgres: unable to update balance_change, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"balance_change\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, balanceChangePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(balanceChangeType, balanceChangeMapping, append(wl, balanceChangePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update balance_change row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by update for balance_change")
+	}
+
+	if !cached {
+		balanceChangeUpdateCacheMut.Lock()
+		balanceChangeUpdateCache[key] = cache
+		balanceChangeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q balanceChangeQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all for balance_change")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected for balance_change")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o BalanceChangeSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("postgres: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), balanceChangePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"balance_change\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, balanceChangePrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all in balanceChange slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected all in update all balanceChange")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *BalanceChange) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no balance_change provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(balanceChangeColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	balanceChangeUpsertCacheMut.RLock()
+	cache, cached := balanceChangeUpsertCache[key]
+	balanceChangeUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			balanceChangeAllColumns,
+			balanceChangeColumnsWithDefault,
+			balanceChangeColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			balanceChangeAllColumns,
+			balanceChangePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("postgres: unable to upsert balance_change, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(balanceChangePrimaryKeyColumns))
+			copy(conflict, balanceChangePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"balance_change\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(balanceChangeType, balanceChangeMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(balanceChangeType, balanceChangeMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to upsert balanceChange")
+	}
+
+	if !cached {
+		balanceChangeUpsertCacheMut.Lock()
+		balanceChangeUpsertCache[key] = cache
+		balanceChangeUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single BalanceChange record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *BalanceChange) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("postgres: no BalanceChange provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), balanceChangePrimaryKeyMapping)
+	sql := "DELETE FROM \"balance_change\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete from balance_change")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by delete for balance_change")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q balanceChangeQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("postgres: no balanceChangeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from balance_change")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for balance_change")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o BalanceChangeSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(balanceChangeBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), balanceChangePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"balance_change\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, balanceChangePrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from balanceChange slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for balance_change")
+	}
+
+	if len(balanceChangeAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *BalanceChange) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindBalanceChange(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *BalanceChangeSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := BalanceChangeSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), balanceChangePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"balance_change\".* FROM \"balance_change\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, balanceChangePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to reload all in BalanceChangeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// BalanceChangeExists checks if the BalanceChange row exists.
+func BalanceChangeExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"balance_change\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: unable to check if balance_change exists")
+	}
+
+	return exists, nil
+}
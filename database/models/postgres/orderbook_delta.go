@@ -0,0 +1,914 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+// OrderbookDelta is an object representing the database table.
+type OrderbookDelta struct {
+	ID             string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ExchangeNameID string    `boil:"exchange_name_id" json:"exchange_name_id" toml:"exchange_name_id" yaml:"exchange_name_id"`
+	Asset          string    `boil:"asset" json:"asset" toml:"asset" yaml:"asset"`
+	Base           string    `boil:"base" json:"base" toml:"base" yaml:"base"`
+	Quote          string    `boil:"quote" json:"quote" toml:"quote" yaml:"quote"`
+	Timestamp      time.Time `boil:"timestamp" json:"timestamp" toml:"timestamp" yaml:"timestamp"`
+	Sequence       int64     `boil:"sequence" json:"sequence" toml:"sequence" yaml:"sequence"`
+	UpdateID       int64     `boil:"update_id" json:"update_id" toml:"update_id" yaml:"update_id"`
+	Action         string    `boil:"action" json:"action" toml:"action" yaml:"action"`
+	MaxDepth       int       `boil:"max_depth" json:"max_depth" toml:"max_depth" yaml:"max_depth"`
+	Bids           string    `boil:"bids" json:"bids" toml:"bids" yaml:"bids"`
+	Asks           string    `boil:"asks" json:"asks" toml:"asks" yaml:"asks"`
+
+	R *orderbookDeltaR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L orderbookDeltaL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OrderbookDeltaColumns = struct {
+	ID             string
+	ExchangeNameID string
+	Asset          string
+	Base           string
+	Quote          string
+	Timestamp      string
+	Sequence       string
+	UpdateID       string
+	Action         string
+	MaxDepth       string
+	Bids           string
+	Asks           string
+}{
+	ID:             "id",
+	ExchangeNameID: "exchange_name_id",
+	Asset:          "asset",
+	Base:           "base",
+	Quote:          "quote",
+	Timestamp:      "timestamp",
+	Sequence:       "sequence",
+	UpdateID:       "update_id",
+	Action:         "action",
+	MaxDepth:       "max_depth",
+	Bids:           "bids",
+	Asks:           "asks",
+}
+
+// Generated where
+
+var OrderbookDeltaWhere = struct {
+	ID             whereHelperstring
+	ExchangeNameID whereHelperstring
+	Asset          whereHelperstring
+	Base           whereHelperstring
+	Quote          whereHelperstring
+	Timestamp      whereHelpertime_Time
+	Sequence       whereHelperint64
+	UpdateID       whereHelperint64
+	Action         whereHelperstring
+	MaxDepth       whereHelperint
+	Bids           whereHelperstring
+	Asks           whereHelperstring
+}{
+	ID:             whereHelperstring{field: "\"orderbook_delta\".\"id\""},
+	ExchangeNameID: whereHelperstring{field: "\"orderbook_delta\".\"exchange_name_id\""},
+	Asset:          whereHelperstring{field: "\"orderbook_delta\".\"asset\""},
+	Base:           whereHelperstring{field: "\"orderbook_delta\".\"base\""},
+	Quote:          whereHelperstring{field: "\"orderbook_delta\".\"quote\""},
+	Timestamp:      whereHelpertime_Time{field: "\"orderbook_delta\".\"timestamp\""},
+	Sequence:       whereHelperint64{field: "\"orderbook_delta\".\"sequence\""},
+	UpdateID:       whereHelperint64{field: "\"orderbook_delta\".\"update_id\""},
+	Action:         whereHelperstring{field: "\"orderbook_delta\".\"action\""},
+	MaxDepth:       whereHelperint{field: "\"orderbook_delta\".\"max_depth\""},
+	Bids:           whereHelperstring{field: "\"orderbook_delta\".\"bids\""},
+	Asks:           whereHelperstring{field: "\"orderbook_delta\".\"asks\""},
+}
+
+// OrderbookDeltaRels is where relationship names are stored.
+var OrderbookDeltaRels = struct {
+}{}
+
+// orderbookDeltaR is where relationships are stored.
+type orderbookDeltaR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*orderbookDeltaR) NewStruct() *orderbookDeltaR {
+	return &orderbookDeltaR{}
+}
+
+// orderbookDeltaL is where Load methods for each relationship are stored.
+type orderbookDeltaL struct{}
+
+var (
+	orderbookDeltaAllColumns            = []string{"id", "exchange_name_id", "asset", "base", "quote", "timestamp", "sequence", "update_id", "action", "max_depth", "bids", "asks"}
+	orderbookDeltaColumnsWithoutDefault = []string{"exchange_name_id", "asset", "base", "quote", "timestamp", "sequence", "update_id", "action", "max_depth", "bids", "asks"}
+	orderbookDeltaColumnsWithDefault    = []string{"id"}
+	orderbookDeltaPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// OrderbookDeltaSlice is an alias for a slice of pointers to OrderbookDelta.
+	// This should generally be used opposed to []OrderbookDelta.
+	OrderbookDeltaSlice []*OrderbookDelta
+	// OrderbookDeltaHook is the signature for custom OrderbookDelta hook methods
+	OrderbookDeltaHook func(context.Context, boil.ContextExecutor, *OrderbookDelta) error
+
+	orderbookDeltaQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	orderbookDeltaType                 = reflect.TypeOf(&OrderbookDelta{})
+	orderbookDeltaMapping              = queries.MakeStructMapping(orderbookDeltaType)
+	orderbookDeltaPrimaryKeyMapping, _ = queries.BindMapping(orderbookDeltaType, orderbookDeltaMapping, orderbookDeltaPrimaryKeyColumns)
+	orderbookDeltaInsertCacheMut       sync.RWMutex
+	orderbookDeltaInsertCache          = make(map[string]insertCache)
+	orderbookDeltaUpdateCacheMut       sync.RWMutex
+	orderbookDeltaUpdateCache          = make(map[string]updateCache)
+	orderbookDeltaUpsertCacheMut       sync.RWMutex
+	orderbookDeltaUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var orderbookDeltaBeforeInsertHooks []OrderbookDeltaHook
+var orderbookDeltaBeforeUpdateHooks []OrderbookDeltaHook
+var orderbookDeltaBeforeDeleteHooks []OrderbookDeltaHook
+var orderbookDeltaBeforeUpsertHooks []OrderbookDeltaHook
+
+var orderbookDeltaAfterInsertHooks []OrderbookDeltaHook
+var orderbookDeltaAfterSelectHooks []OrderbookDeltaHook
+var orderbookDeltaAfterUpdateHooks []OrderbookDeltaHook
+var orderbookDeltaAfterDeleteHooks []OrderbookDeltaHook
+var orderbookDeltaAfterUpsertHooks []OrderbookDeltaHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *OrderbookDelta) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderbookDeltaBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *OrderbookDelta) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderbookDeltaBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *OrderbookDelta) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderbookDeltaBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *OrderbookDelta) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderbookDeltaBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *OrderbookDelta) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderbookDeltaAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *OrderbookDelta) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderbookDeltaAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *OrderbookDelta) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderbookDeltaAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *OrderbookDelta) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderbookDeltaAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *OrderbookDelta) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderbookDeltaAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddOrderbookDeltaHook registers your hook function for all future operations.
+func AddOrderbookDeltaHook(hookPoint boil.HookPoint, orderbookDeltaHook OrderbookDeltaHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		orderbookDeltaBeforeInsertHooks = append(orderbookDeltaBeforeInsertHooks, orderbookDeltaHook)
+	case boil.BeforeUpdateHook:
+		orderbookDeltaBeforeUpdateHooks = append(orderbookDeltaBeforeUpdateHooks, orderbookDeltaHook)
+	case boil.BeforeDeleteHook:
+		orderbookDeltaBeforeDeleteHooks = append(orderbookDeltaBeforeDeleteHooks, orderbookDeltaHook)
+	case boil.BeforeUpsertHook:
+		orderbookDeltaBeforeUpsertHooks = append(orderbookDeltaBeforeUpsertHooks, orderbookDeltaHook)
+	case boil.AfterInsertHook:
+		orderbookDeltaAfterInsertHooks = append(orderbookDeltaAfterInsertHooks, orderbookDeltaHook)
+	case boil.AfterSelectHook:
+		orderbookDeltaAfterSelectHooks = append(orderbookDeltaAfterSelectHooks, orderbookDeltaHook)
+	case boil.AfterUpdateHook:
+		orderbookDeltaAfterUpdateHooks = append(orderbookDeltaAfterUpdateHooks, orderbookDeltaHook)
+	case boil.AfterDeleteHook:
+		orderbookDeltaAfterDeleteHooks = append(orderbookDeltaAfterDeleteHooks, orderbookDeltaHook)
+	case boil.AfterUpsertHook:
+		orderbookDeltaAfterUpsertHooks = append(orderbookDeltaAfterUpsertHooks, orderbookDeltaHook)
+	}
+}
+
+// One returns a single orderbook_delta record from the query.
+func (q orderbookDeltaQuery) One(ctx context.Context, exec boil.ContextExecutor) (*OrderbookDelta, error) {
+	o := &OrderbookDelta{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: failed to execute a one query for orderbook_delta")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all OrderbookDelta records from the query.
+func (q orderbookDeltaQuery) All(ctx context.Context, exec boil.ContextExecutor) (OrderbookDeltaSlice, error) {
+	var o []*OrderbookDelta
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "postgres: failed to assign all query results to OrderbookDelta slice")
+	}
+
+	if len(orderbookDeltaAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all OrderbookDelta records in the query.
+func (q orderbookDeltaQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to count orderbook_delta rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q orderbookDeltaQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: failed to check if orderbook_delta exists")
+	}
+
+	return count > 0, nil
+}
+
+// OrderbookDeltas retrieves all the records using an executor.
+func OrderbookDeltas(mods ...qm.QueryMod) orderbookDeltaQuery {
+	mods = append(mods, qm.From("\"orderbook_delta\""))
+	return orderbookDeltaQuery{NewQuery(mods...)}
+}
+
+// FindOrderbookDelta retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOrderbookDelta(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*OrderbookDelta, error) {
+	orderbookDeltaObj := &OrderbookDelta{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"orderbook_delta\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, orderbookDeltaObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: unable to select from orderbook_delta")
+	}
+
+	return orderbookDeltaObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *OrderbookDelta) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no orderbook_delta provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(orderbookDeltaColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	orderbookDeltaInsertCacheMut.RLock()
+	cache, cached := orderbookDeltaInsertCache[key]
+	orderbookDeltaInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			orderbookDeltaAllColumns,
+			orderbookDeltaColumnsWithDefault,
+			orderbookDeltaColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(orderbookDeltaType, orderbookDeltaMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(orderbookDeltaType, orderbookDeltaMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"orderbook_delta\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"orderbook_delta\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to insert into orderbook_delta")
+	}
+
+	if !cached {
+		orderbookDeltaInsertCacheMut.Lock()
+		orderbookDeltaInsertCache[key] = cache
+		orderbookDeltaInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the OrderbookDelta.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *OrderbookDelta) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	orderbookDeltaUpdateCacheMut.RLock()
+	cache, cached := orderbookDeltaUpdateCache[key]
+	orderbookDeltaUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			orderbookDeltaAllColumns,
+			orderbookDeltaPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("postgres: unable to update orderbook_delta, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"orderbook_delta\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, orderbookDeltaPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(orderbookDeltaType, orderbookDeltaMapping, append(wl, orderbookDeltaPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update orderbook_delta row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by update for orderbook_delta")
+	}
+
+	if !cached {
+		orderbookDeltaUpdateCacheMut.Lock()
+		orderbookDeltaUpdateCache[key] = cache
+		orderbookDeltaUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q orderbookDeltaQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all for orderbook_delta")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected for orderbook_delta")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OrderbookDeltaSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("postgres: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), orderbookDeltaPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"orderbook_delta\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, orderbookDeltaPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all in orderbookDelta slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected all in update all orderbookDelta")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *OrderbookDelta) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no orderbook_delta provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(orderbookDeltaColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	orderbookDeltaUpsertCacheMut.RLock()
+	cache, cached := orderbookDeltaUpsertCache[key]
+	orderbookDeltaUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			orderbookDeltaAllColumns,
+			orderbookDeltaColumnsWithDefault,
+			orderbookDeltaColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			orderbookDeltaAllColumns,
+			orderbookDeltaPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("postgres: unable to upsert orderbook_delta, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(orderbookDeltaPrimaryKeyColumns))
+			copy(conflict, orderbookDeltaPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"orderbook_delta\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(orderbookDeltaType, orderbookDeltaMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(orderbookDeltaType, orderbookDeltaMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to upsert orderbookDelta")
+	}
+
+	if !cached {
+		orderbookDeltaUpsertCacheMut.Lock()
+		orderbookDeltaUpsertCache[key] = cache
+		orderbookDeltaUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single OrderbookDelta record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *OrderbookDelta) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("postgres: no OrderbookDelta provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), orderbookDeltaPrimaryKeyMapping)
+	sql := "DELETE FROM \"orderbook_delta\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete from orderbook_delta")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by delete for orderbook_delta")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q orderbookDeltaQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("postgres: no orderbookDeltaQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from orderbook_delta")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for orderbook_delta")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OrderbookDeltaSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(orderbookDeltaBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), orderbookDeltaPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"orderbook_delta\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, orderbookDeltaPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from orderbookDelta slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for orderbook_delta")
+	}
+
+	if len(orderbookDeltaAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *OrderbookDelta) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindOrderbookDelta(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OrderbookDeltaSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OrderbookDeltaSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), orderbookDeltaPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"orderbook_delta\".* FROM \"orderbook_delta\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, orderbookDeltaPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to reload all in OrderbookDeltaSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OrderbookDeltaExists checks if the OrderbookDelta row exists.
+func OrderbookDeltaExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"orderbook_delta\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: unable to check if orderbook_delta exists")
+	}
+
+	return exists, nil
+}
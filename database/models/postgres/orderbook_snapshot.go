@@ -0,0 +1,904 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+// OrderbookSnapshot is an object representing the database table.
+type OrderbookSnapshot struct {
+	ID             string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ExchangeNameID string    `boil:"exchange_name_id" json:"exchange_name_id" toml:"exchange_name_id" yaml:"exchange_name_id"`
+	Asset          string    `boil:"asset" json:"asset" toml:"asset" yaml:"asset"`
+	Base           string    `boil:"base" json:"base" toml:"base" yaml:"base"`
+	Quote          string    `boil:"quote" json:"quote" toml:"quote" yaml:"quote"`
+	Timestamp      time.Time `boil:"timestamp" json:"timestamp" toml:"timestamp" yaml:"timestamp"`
+	UpdateID       int64     `boil:"update_id" json:"update_id" toml:"update_id" yaml:"update_id"`
+	Depth          int       `boil:"depth" json:"depth" toml:"depth" yaml:"depth"`
+	Bids           string    `boil:"bids" json:"bids" toml:"bids" yaml:"bids"`
+	Asks           string    `boil:"asks" json:"asks" toml:"asks" yaml:"asks"`
+
+	R *orderbookSnapshotR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L orderbookSnapshotL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OrderbookSnapshotColumns = struct {
+	ID             string
+	ExchangeNameID string
+	Asset          string
+	Base           string
+	Quote          string
+	Timestamp      string
+	UpdateID       string
+	Depth          string
+	Bids           string
+	Asks           string
+}{
+	ID:             "id",
+	ExchangeNameID: "exchange_name_id",
+	Asset:          "asset",
+	Base:           "base",
+	Quote:          "quote",
+	Timestamp:      "timestamp",
+	UpdateID:       "update_id",
+	Depth:          "depth",
+	Bids:           "bids",
+	Asks:           "asks",
+}
+
+// Generated where
+
+var OrderbookSnapshotWhere = struct {
+	ID             whereHelperstring
+	ExchangeNameID whereHelperstring
+	Asset          whereHelperstring
+	Base           whereHelperstring
+	Quote          whereHelperstring
+	Timestamp      whereHelpertime_Time
+	UpdateID       whereHelperint64
+	Depth          whereHelperint
+	Bids           whereHelperstring
+	Asks           whereHelperstring
+}{
+	ID:             whereHelperstring{field: "\"orderbook_snapshot\".\"id\""},
+	ExchangeNameID: whereHelperstring{field: "\"orderbook_snapshot\".\"exchange_name_id\""},
+	Asset:          whereHelperstring{field: "\"orderbook_snapshot\".\"asset\""},
+	Base:           whereHelperstring{field: "\"orderbook_snapshot\".\"base\""},
+	Quote:          whereHelperstring{field: "\"orderbook_snapshot\".\"quote\""},
+	Timestamp:      whereHelpertime_Time{field: "\"orderbook_snapshot\".\"timestamp\""},
+	UpdateID:       whereHelperint64{field: "\"orderbook_snapshot\".\"update_id\""},
+	Depth:          whereHelperint{field: "\"orderbook_snapshot\".\"depth\""},
+	Bids:           whereHelperstring{field: "\"orderbook_snapshot\".\"bids\""},
+	Asks:           whereHelperstring{field: "\"orderbook_snapshot\".\"asks\""},
+}
+
+// OrderbookSnapshotRels is where relationship names are stored.
+var OrderbookSnapshotRels = struct {
+}{}
+
+// orderbookSnapshotR is where relationships are stored.
+type orderbookSnapshotR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*orderbookSnapshotR) NewStruct() *orderbookSnapshotR {
+	return &orderbookSnapshotR{}
+}
+
+// orderbookSnapshotL is where Load methods for each relationship are stored.
+type orderbookSnapshotL struct{}
+
+var (
+	orderbookSnapshotAllColumns            = []string{"id", "exchange_name_id", "asset", "base", "quote", "timestamp", "update_id", "depth", "bids", "asks"}
+	orderbookSnapshotColumnsWithoutDefault = []string{"exchange_name_id", "asset", "base", "quote", "timestamp", "update_id", "depth", "bids", "asks"}
+	orderbookSnapshotColumnsWithDefault    = []string{"id"}
+	orderbookSnapshotPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// OrderbookSnapshotSlice is an alias for a slice of pointers to OrderbookSnapshot.
+	// This should generally be used opposed to []OrderbookSnapshot.
+	OrderbookSnapshotSlice []*OrderbookSnapshot
+	// OrderbookSnapshotHook is the signature for custom OrderbookSnapshot hook methods
+	OrderbookSnapshotHook func(context.Context, boil.ContextExecutor, *OrderbookSnapshot) error
+
+	orderbookSnapshotQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	orderbookSnapshotType                 = reflect.TypeOf(&OrderbookSnapshot{})
+	orderbookSnapshotMapping              = queries.MakeStructMapping(orderbookSnapshotType)
+	orderbookSnapshotPrimaryKeyMapping, _ = queries.BindMapping(orderbookSnapshotType, orderbookSnapshotMapping, orderbookSnapshotPrimaryKeyColumns)
+	orderbookSnapshotInsertCacheMut       sync.RWMutex
+	orderbookSnapshotInsertCache          = make(map[string]insertCache)
+	orderbookSnapshotUpdateCacheMut       sync.RWMutex
+	orderbookSnapshotUpdateCache          = make(map[string]updateCache)
+	orderbookSnapshotUpsertCacheMut       sync.RWMutex
+	orderbookSnapshotUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var orderbookSnapshotBeforeInsertHooks []OrderbookSnapshotHook
+var orderbookSnapshotBeforeUpdateHooks []OrderbookSnapshotHook
+var orderbookSnapshotBeforeDeleteHooks []OrderbookSnapshotHook
+var orderbookSnapshotBeforeUpsertHooks []OrderbookSnapshotHook
+
+var orderbookSnapshotAfterInsertHooks []OrderbookSnapshotHook
+var orderbookSnapshotAfterSelectHooks []OrderbookSnapshotHook
+var orderbookSnapshotAfterUpdateHooks []OrderbookSnapshotHook
+var orderbookSnapshotAfterDeleteHooks []OrderbookSnapshotHook
+var orderbookSnapshotAfterUpsertHooks []OrderbookSnapshotHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *OrderbookSnapshot) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderbookSnapshotBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *OrderbookSnapshot) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderbookSnapshotBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *OrderbookSnapshot) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderbookSnapshotBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *OrderbookSnapshot) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderbookSnapshotBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *OrderbookSnapshot) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderbookSnapshotAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *OrderbookSnapshot) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderbookSnapshotAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *OrderbookSnapshot) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderbookSnapshotAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *OrderbookSnapshot) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderbookSnapshotAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *OrderbookSnapshot) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderbookSnapshotAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddOrderbookSnapshotHook registers your hook function for all future operations.
+func AddOrderbookSnapshotHook(hookPoint boil.HookPoint, orderbookSnapshotHook OrderbookSnapshotHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		orderbookSnapshotBeforeInsertHooks = append(orderbookSnapshotBeforeInsertHooks, orderbookSnapshotHook)
+	case boil.BeforeUpdateHook:
+		orderbookSnapshotBeforeUpdateHooks = append(orderbookSnapshotBeforeUpdateHooks, orderbookSnapshotHook)
+	case boil.BeforeDeleteHook:
+		orderbookSnapshotBeforeDeleteHooks = append(orderbookSnapshotBeforeDeleteHooks, orderbookSnapshotHook)
+	case boil.BeforeUpsertHook:
+		orderbookSnapshotBeforeUpsertHooks = append(orderbookSnapshotBeforeUpsertHooks, orderbookSnapshotHook)
+	case boil.AfterInsertHook:
+		orderbookSnapshotAfterInsertHooks = append(orderbookSnapshotAfterInsertHooks, orderbookSnapshotHook)
+	case boil.AfterSelectHook:
+		orderbookSnapshotAfterSelectHooks = append(orderbookSnapshotAfterSelectHooks, orderbookSnapshotHook)
+	case boil.AfterUpdateHook:
+		orderbookSnapshotAfterUpdateHooks = append(orderbookSnapshotAfterUpdateHooks, orderbookSnapshotHook)
+	case boil.AfterDeleteHook:
+		orderbookSnapshotAfterDeleteHooks = append(orderbookSnapshotAfterDeleteHooks, orderbookSnapshotHook)
+	case boil.AfterUpsertHook:
+		orderbookSnapshotAfterUpsertHooks = append(orderbookSnapshotAfterUpsertHooks, orderbookSnapshotHook)
+	}
+}
+
+// One returns a single orderbook_snapshot record from the query.
+func (q orderbookSnapshotQuery) One(ctx context.Context, exec boil.ContextExecutor) (*OrderbookSnapshot, error) {
+	o := &OrderbookSnapshot{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: failed to execute a one query for orderbook_snapshot")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all OrderbookSnapshot records from the query.
+func (q orderbookSnapshotQuery) All(ctx context.Context, exec boil.ContextExecutor) (OrderbookSnapshotSlice, error) {
+	var o []*OrderbookSnapshot
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "postgres: failed to assign all query results to OrderbookSnapshot slice")
+	}
+
+	if len(orderbookSnapshotAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all OrderbookSnapshot records in the query.
+func (q orderbookSnapshotQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to count orderbook_snapshot rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q orderbookSnapshotQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: failed to check if orderbook_snapshot exists")
+	}
+
+	return count > 0, nil
+}
+
+// OrderbookSnapshots retrieves all the records using an executor.
+func OrderbookSnapshots(mods ...qm.QueryMod) orderbookSnapshotQuery {
+	mods = append(mods, qm.From("\"orderbook_snapshot\""))
+	return orderbookSnapshotQuery{NewQuery(mods...)}
+}
+
+// FindOrderbookSnapshot retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOrderbookSnapshot(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*OrderbookSnapshot, error) {
+	orderbookSnapshotObj := &OrderbookSnapshot{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"orderbook_snapshot\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, orderbookSnapshotObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: unable to select from orderbook_snapshot")
+	}
+
+	return orderbookSnapshotObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *OrderbookSnapshot) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no orderbook_snapshot provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(orderbookSnapshotColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	orderbookSnapshotInsertCacheMut.RLock()
+	cache, cached := orderbookSnapshotInsertCache[key]
+	orderbookSnapshotInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			orderbookSnapshotAllColumns,
+			orderbookSnapshotColumnsWithDefault,
+			orderbookSnapshotColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(orderbookSnapshotType, orderbookSnapshotMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(orderbookSnapshotType, orderbookSnapshotMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"orderbook_snapshot\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"orderbook_snapshot\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to insert into orderbook_snapshot")
+	}
+
+	if !cached {
+		orderbookSnapshotInsertCacheMut.Lock()
+		orderbookSnapshotInsertCache[key] = cache
+		orderbookSnapshotInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the OrderbookSnapshot.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *OrderbookSnapshot) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	orderbookSnapshotUpdateCacheMut.RLock()
+	cache, cached := orderbookSnapshotUpdateCache[key]
+	orderbookSnapshotUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			orderbookSnapshotAllColumns,
+			orderbookSnapshotPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("postgres: unable to update orderbook_snapshot, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"orderbook_snapshot\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, orderbookSnapshotPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(orderbookSnapshotType, orderbookSnapshotMapping, append(wl, orderbookSnapshotPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update orderbook_snapshot row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by update for orderbook_snapshot")
+	}
+
+	if !cached {
+		orderbookSnapshotUpdateCacheMut.Lock()
+		orderbookSnapshotUpdateCache[key] = cache
+		orderbookSnapshotUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q orderbookSnapshotQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all for orderbook_snapshot")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected for orderbook_snapshot")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OrderbookSnapshotSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("postgres: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), orderbookSnapshotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"orderbook_snapshot\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, orderbookSnapshotPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all in orderbookSnapshot slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected all in update all orderbookSnapshot")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *OrderbookSnapshot) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no orderbook_snapshot provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(orderbookSnapshotColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	orderbookSnapshotUpsertCacheMut.RLock()
+	cache, cached := orderbookSnapshotUpsertCache[key]
+	orderbookSnapshotUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			orderbookSnapshotAllColumns,
+			orderbookSnapshotColumnsWithDefault,
+			orderbookSnapshotColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			orderbookSnapshotAllColumns,
+			orderbookSnapshotPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("postgres: unable to upsert orderbook_snapshot, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(orderbookSnapshotPrimaryKeyColumns))
+			copy(conflict, orderbookSnapshotPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"orderbook_snapshot\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(orderbookSnapshotType, orderbookSnapshotMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(orderbookSnapshotType, orderbookSnapshotMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to upsert orderbookSnapshot")
+	}
+
+	if !cached {
+		orderbookSnapshotUpsertCacheMut.Lock()
+		orderbookSnapshotUpsertCache[key] = cache
+		orderbookSnapshotUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single OrderbookSnapshot record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *OrderbookSnapshot) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("postgres: no OrderbookSnapshot provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), orderbookSnapshotPrimaryKeyMapping)
+	sql := "DELETE FROM \"orderbook_snapshot\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete from orderbook_snapshot")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by delete for orderbook_snapshot")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q orderbookSnapshotQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("postgres: no orderbookSnapshotQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from orderbook_snapshot")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for orderbook_snapshot")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OrderbookSnapshotSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(orderbookSnapshotBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), orderbookSnapshotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"orderbook_snapshot\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, orderbookSnapshotPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from orderbookSnapshot slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for orderbook_snapshot")
+	}
+
+	if len(orderbookSnapshotAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *OrderbookSnapshot) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindOrderbookSnapshot(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OrderbookSnapshotSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OrderbookSnapshotSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), orderbookSnapshotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"orderbook_snapshot\".* FROM \"orderbook_snapshot\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, orderbookSnapshotPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to reload all in OrderbookSnapshotSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OrderbookSnapshotExists checks if the OrderbookSnapshot row exists.
+func OrderbookSnapshotExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"orderbook_snapshot\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: unable to check if orderbook_snapshot exists")
+	}
+
+	return exists, nil
+}
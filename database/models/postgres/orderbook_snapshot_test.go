@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testOrderbookSnapshots(t *testing.T) {
+	t.Parallel()
+
+	query := OrderbookSnapshots()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testOrderbookSnapshotsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderbookSnapshot{}
+	if err = randomize.Struct(seed, o, orderbookSnapshotDBTypes, true, orderbookSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OrderbookSnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOrderbookSnapshotsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderbookSnapshot{}
+	if err = randomize.Struct(seed, o, orderbookSnapshotDBTypes, true, orderbookSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := OrderbookSnapshots().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OrderbookSnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOrderbookSnapshotsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderbookSnapshot{}
+	if err = randomize.Struct(seed, o, orderbookSnapshotDBTypes, true, orderbookSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OrderbookSnapshotSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OrderbookSnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOrderbookSnapshotsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderbookSnapshot{}
+	if err = randomize.Struct(seed, o, orderbookSnapshotDBTypes, true, orderbookSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := OrderbookSnapshotExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if OrderbookSnapshot exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected OrderbookSnapshotExists to return true, but got false.")
+	}
+}
+
+func testOrderbookSnapshotsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderbookSnapshot{}
+	if err = randomize.Struct(seed, o, orderbookSnapshotDBTypes, true, orderbookSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	orderbookSnapshotFound, err := FindOrderbookSnapshot(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if orderbookSnapshotFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testOrderbookSnapshotsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderbookSnapshot{}
+	if err = randomize.Struct(seed, o, orderbookSnapshotDBTypes, true, orderbookSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = OrderbookSnapshots().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOrderbookSnapshotsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderbookSnapshot{}
+	if err = randomize.Struct(seed, o, orderbookSnapshotDBTypes, true, orderbookSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := OrderbookSnapshots().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testOrderbookSnapshotsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	orderbookSnapshotOne := &OrderbookSnapshot{}
+	orderbookSnapshotTwo := &OrderbookSnapshot{}
+	if err = randomize.Struct(seed, orderbookSnapshotOne, orderbookSnapshotDBTypes, false, orderbookSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookSnapshot struct: %s", err)
+	}
+	if err = randomize.Struct(seed, orderbookSnapshotTwo, orderbookSnapshotDBTypes, false, orderbookSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = orderbookSnapshotOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = orderbookSnapshotTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OrderbookSnapshots().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testOrderbookSnapshotsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	orderbookSnapshotOne := &OrderbookSnapshot{}
+	orderbookSnapshotTwo := &OrderbookSnapshot{}
+	if err = randomize.Struct(seed, orderbookSnapshotOne, orderbookSnapshotDBTypes, false, orderbookSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookSnapshot struct: %s", err)
+	}
+	if err = randomize.Struct(seed, orderbookSnapshotTwo, orderbookSnapshotDBTypes, false, orderbookSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = orderbookSnapshotOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = orderbookSnapshotTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrderbookSnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func orderbookSnapshotBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *OrderbookSnapshot) error {
+	*o = OrderbookSnapshot{}
+	return nil
+}
+
+func orderbookSnapshotAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *OrderbookSnapshot) error {
+	*o = OrderbookSnapshot{}
+	return nil
+}
+
+func orderbookSnapshotAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *OrderbookSnapshot) error {
+	*o = OrderbookSnapshot{}
+	return nil
+}
+
+func orderbookSnapshotBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *OrderbookSnapshot) error {
+	*o = OrderbookSnapshot{}
+	return nil
+}
+
+func orderbookSnapshotAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *OrderbookSnapshot) error {
+	*o = OrderbookSnapshot{}
+	return nil
+}
+
+func orderbookSnapshotBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *OrderbookSnapshot) error {
+	*o = OrderbookSnapshot{}
+	return nil
+}
+
+func orderbookSnapshotAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *OrderbookSnapshot) error {
+	*o = OrderbookSnapshot{}
+	return nil
+}
+
+func orderbookSnapshotBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *OrderbookSnapshot) error {
+	*o = OrderbookSnapshot{}
+	return nil
+}
+
+func orderbookSnapshotAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *OrderbookSnapshot) error {
+	*o = OrderbookSnapshot{}
+	return nil
+}
+
+func testOrderbookSnapshotsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &OrderbookSnapshot{}
+	o := &OrderbookSnapshot{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, orderbookSnapshotDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize OrderbookSnapshot object: %s", err)
+	}
+
+	AddOrderbookSnapshotHook(boil.BeforeInsertHook, orderbookSnapshotBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	orderbookSnapshotBeforeInsertHooks = []OrderbookSnapshotHook{}
+
+	AddOrderbookSnapshotHook(boil.AfterInsertHook, orderbookSnapshotAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	orderbookSnapshotAfterInsertHooks = []OrderbookSnapshotHook{}
+
+	AddOrderbookSnapshotHook(boil.AfterSelectHook, orderbookSnapshotAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	orderbookSnapshotAfterSelectHooks = []OrderbookSnapshotHook{}
+
+	AddOrderbookSnapshotHook(boil.BeforeUpdateHook, orderbookSnapshotBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	orderbookSnapshotBeforeUpdateHooks = []OrderbookSnapshotHook{}
+
+	AddOrderbookSnapshotHook(boil.AfterUpdateHook, orderbookSnapshotAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	orderbookSnapshotAfterUpdateHooks = []OrderbookSnapshotHook{}
+
+	AddOrderbookSnapshotHook(boil.BeforeDeleteHook, orderbookSnapshotBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	orderbookSnapshotBeforeDeleteHooks = []OrderbookSnapshotHook{}
+
+	AddOrderbookSnapshotHook(boil.AfterDeleteHook, orderbookSnapshotAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	orderbookSnapshotAfterDeleteHooks = []OrderbookSnapshotHook{}
+
+	AddOrderbookSnapshotHook(boil.BeforeUpsertHook, orderbookSnapshotBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	orderbookSnapshotBeforeUpsertHooks = []OrderbookSnapshotHook{}
+
+	AddOrderbookSnapshotHook(boil.AfterUpsertHook, orderbookSnapshotAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	orderbookSnapshotAfterUpsertHooks = []OrderbookSnapshotHook{}
+}
+
+func testOrderbookSnapshotsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderbookSnapshot{}
+	if err = randomize.Struct(seed, o, orderbookSnapshotDBTypes, true, orderbookSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrderbookSnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOrderbookSnapshotsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderbookSnapshot{}
+	if err = randomize.Struct(seed, o, orderbookSnapshotDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OrderbookSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(orderbookSnapshotColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrderbookSnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOrderbookSnapshotsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderbookSnapshot{}
+	if err = randomize.Struct(seed, o, orderbookSnapshotDBTypes, true, orderbookSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOrderbookSnapshotsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderbookSnapshot{}
+	if err = randomize.Struct(seed, o, orderbookSnapshotDBTypes, true, orderbookSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OrderbookSnapshotSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOrderbookSnapshotsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderbookSnapshot{}
+	if err = randomize.Struct(seed, o, orderbookSnapshotDBTypes, true, orderbookSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OrderbookSnapshots().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	orderbookSnapshotDBTypes = map[string]string{`ID`: `uuid`, `ExchangeNameID`: `uuid`, `Asset`: `character varying`, `Base`: `character varying`, `Quote`: `character varying`, `Timestamp`: `timestamp with time zone`, `UpdateID`: `bigint`, `Bids`: `text`, `Asks`: `text`}
+	_                        = bytes.MinRead
+)
+
+func testOrderbookSnapshotsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(orderbookSnapshotPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(orderbookSnapshotAllColumns) == len(orderbookSnapshotPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderbookSnapshot{}
+	if err = randomize.Struct(seed, o, orderbookSnapshotDBTypes, true, orderbookSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrderbookSnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, orderbookSnapshotDBTypes, true, orderbookSnapshotPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OrderbookSnapshot struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testOrderbookSnapshotsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(orderbookSnapshotAllColumns) == len(orderbookSnapshotPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderbookSnapshot{}
+	if err = randomize.Struct(seed, o, orderbookSnapshotDBTypes, true, orderbookSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrderbookSnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, orderbookSnapshotDBTypes, true, orderbookSnapshotPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OrderbookSnapshot struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(orderbookSnapshotAllColumns, orderbookSnapshotPrimaryKeyColumns) {
+		fields = orderbookSnapshotAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			orderbookSnapshotAllColumns,
+			orderbookSnapshotPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := OrderbookSnapshotSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testOrderbookSnapshotsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(orderbookSnapshotAllColumns) == len(orderbookSnapshotPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := OrderbookSnapshot{}
+	if err = randomize.Struct(seed, &o, orderbookSnapshotDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OrderbookSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OrderbookSnapshot: %s", err)
+	}
+
+	count, err := OrderbookSnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, orderbookSnapshotDBTypes, false, orderbookSnapshotPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OrderbookSnapshot struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OrderbookSnapshot: %s", err)
+	}
+
+	count, err = OrderbookSnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
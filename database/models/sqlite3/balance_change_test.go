@@ -0,0 +1,684 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testBalanceChanges(t *testing.T) {
+	t.Parallel()
+
+	query := BalanceChanges()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testBalanceChangesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BalanceChange{}
+	if err = randomize.Struct(seed, o, balanceChangeDBTypes, true, balanceChangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceChange struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := BalanceChanges().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testBalanceChangesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BalanceChange{}
+	if err = randomize.Struct(seed, o, balanceChangeDBTypes, true, balanceChangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceChange struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := BalanceChanges().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := BalanceChanges().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testBalanceChangesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BalanceChange{}
+	if err = randomize.Struct(seed, o, balanceChangeDBTypes, true, balanceChangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceChange struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := BalanceChangeSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := BalanceChanges().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testBalanceChangesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BalanceChange{}
+	if err = randomize.Struct(seed, o, balanceChangeDBTypes, true, balanceChangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceChange struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := BalanceChangeExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if BalanceChange exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected BalanceChangeExists to return true, but got false.")
+	}
+}
+
+func testBalanceChangesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BalanceChange{}
+	if err = randomize.Struct(seed, o, balanceChangeDBTypes, true, balanceChangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceChange struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	balanceChangeFound, err := FindBalanceChange(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if balanceChangeFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testBalanceChangesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BalanceChange{}
+	if err = randomize.Struct(seed, o, balanceChangeDBTypes, true, balanceChangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceChange struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = BalanceChanges().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testBalanceChangesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BalanceChange{}
+	if err = randomize.Struct(seed, o, balanceChangeDBTypes, true, balanceChangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceChange struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := BalanceChanges().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testBalanceChangesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	balanceChangeOne := &BalanceChange{}
+	balanceChangeTwo := &BalanceChange{}
+	if err = randomize.Struct(seed, balanceChangeOne, balanceChangeDBTypes, false, balanceChangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceChange struct: %s", err)
+	}
+	if err = randomize.Struct(seed, balanceChangeTwo, balanceChangeDBTypes, false, balanceChangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceChange struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = balanceChangeOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = balanceChangeTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := BalanceChanges().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testBalanceChangesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	balanceChangeOne := &BalanceChange{}
+	balanceChangeTwo := &BalanceChange{}
+	if err = randomize.Struct(seed, balanceChangeOne, balanceChangeDBTypes, false, balanceChangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceChange struct: %s", err)
+	}
+	if err = randomize.Struct(seed, balanceChangeTwo, balanceChangeDBTypes, false, balanceChangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceChange struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = balanceChangeOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = balanceChangeTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BalanceChanges().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func balanceChangeBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *BalanceChange) error {
+	*o = BalanceChange{}
+	return nil
+}
+
+func balanceChangeAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *BalanceChange) error {
+	*o = BalanceChange{}
+	return nil
+}
+
+func balanceChangeAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *BalanceChange) error {
+	*o = BalanceChange{}
+	return nil
+}
+
+func balanceChangeBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *BalanceChange) error {
+	*o = BalanceChange{}
+	return nil
+}
+
+func balanceChangeAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *BalanceChange) error {
+	*o = BalanceChange{}
+	return nil
+}
+
+func balanceChangeBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *BalanceChange) error {
+	*o = BalanceChange{}
+	return nil
+}
+
+func balanceChangeAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *BalanceChange) error {
+	*o = BalanceChange{}
+	return nil
+}
+
+func balanceChangeBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *BalanceChange) error {
+	*o = BalanceChange{}
+	return nil
+}
+
+func balanceChangeAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *BalanceChange) error {
+	*o = BalanceChange{}
+	return nil
+}
+
+func testBalanceChangesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &BalanceChange{}
+	o := &BalanceChange{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, balanceChangeDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize BalanceChange object: %s", err)
+	}
+
+	AddBalanceChangeHook(boil.BeforeInsertHook, balanceChangeBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	balanceChangeBeforeInsertHooks = []BalanceChangeHook{}
+
+	AddBalanceChangeHook(boil.AfterInsertHook, balanceChangeAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	balanceChangeAfterInsertHooks = []BalanceChangeHook{}
+
+	AddBalanceChangeHook(boil.AfterSelectHook, balanceChangeAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	balanceChangeAfterSelectHooks = []BalanceChangeHook{}
+
+	AddBalanceChangeHook(boil.BeforeUpdateHook, balanceChangeBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	balanceChangeBeforeUpdateHooks = []BalanceChangeHook{}
+
+	AddBalanceChangeHook(boil.AfterUpdateHook, balanceChangeAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	balanceChangeAfterUpdateHooks = []BalanceChangeHook{}
+
+	AddBalanceChangeHook(boil.BeforeDeleteHook, balanceChangeBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	balanceChangeBeforeDeleteHooks = []BalanceChangeHook{}
+
+	AddBalanceChangeHook(boil.AfterDeleteHook, balanceChangeAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	balanceChangeAfterDeleteHooks = []BalanceChangeHook{}
+
+	AddBalanceChangeHook(boil.BeforeUpsertHook, balanceChangeBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	balanceChangeBeforeUpsertHooks = []BalanceChangeHook{}
+
+	AddBalanceChangeHook(boil.AfterUpsertHook, balanceChangeAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	balanceChangeAfterUpsertHooks = []BalanceChangeHook{}
+}
+
+func testBalanceChangesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BalanceChange{}
+	if err = randomize.Struct(seed, o, balanceChangeDBTypes, true, balanceChangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceChange struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BalanceChanges().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testBalanceChangesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BalanceChange{}
+	if err = randomize.Struct(seed, o, balanceChangeDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize BalanceChange struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(balanceChangeColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BalanceChanges().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testBalanceChangesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BalanceChange{}
+	if err = randomize.Struct(seed, o, balanceChangeDBTypes, true, balanceChangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceChange struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testBalanceChangesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BalanceChange{}
+	if err = randomize.Struct(seed, o, balanceChangeDBTypes, true, balanceChangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceChange struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := BalanceChangeSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testBalanceChangesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BalanceChange{}
+	if err = randomize.Struct(seed, o, balanceChangeDBTypes, true, balanceChangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceChange struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := BalanceChanges().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	balanceChangeDBTypes = map[string]string{`ID`: `TEXT`, `ExchangeNameID`: `TEXT`, `Account`: `TEXT`, `Asset`: `TEXT`, `Currency`: `TEXT`, `Amount`: `REAL`, `FiatCurrency`: `TEXT`, `FiatRate`: `REAL`, `Timestamp`: `TIMESTAMP`}
+	_                    = bytes.MinRead
+)
+
+func testBalanceChangesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(balanceChangePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(balanceChangeAllColumns) == len(balanceChangePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BalanceChange{}
+	if err = randomize.Struct(seed, o, balanceChangeDBTypes, true, balanceChangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceChange struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BalanceChanges().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, balanceChangeDBTypes, true, balanceChangePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize BalanceChange struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testBalanceChangesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(balanceChangeAllColumns) == len(balanceChangePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BalanceChange{}
+	if err = randomize.Struct(seed, o, balanceChangeDBTypes, true, balanceChangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceChange struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BalanceChanges().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, balanceChangeDBTypes, true, balanceChangePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize BalanceChange struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(balanceChangeAllColumns, balanceChangePrimaryKeyColumns) {
+		fields = balanceChangeAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			balanceChangeAllColumns,
+			balanceChangePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := BalanceChangeSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
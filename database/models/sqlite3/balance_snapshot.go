@@ -0,0 +1,805 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+// BalanceSnapshot is an object representing the database table.
+type BalanceSnapshot struct {
+	ID             string  `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ExchangeNameID string  `boil:"exchange_name_id" json:"exchange_name_id" toml:"exchange_name_id" yaml:"exchange_name_id"`
+	Account        string  `boil:"account" json:"account" toml:"account" yaml:"account"`
+	Asset          string  `boil:"asset" json:"asset" toml:"asset" yaml:"asset"`
+	Currency       string  `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
+	Total          float64 `boil:"total" json:"total" toml:"total" yaml:"total"`
+	Hold           float64 `boil:"hold" json:"hold" toml:"hold" yaml:"hold"`
+	FiatCurrency   string  `boil:"fiat_currency" json:"fiat_currency" toml:"fiat_currency" yaml:"fiat_currency"`
+	FiatRate       float64 `boil:"fiat_rate" json:"fiat_rate" toml:"fiat_rate" yaml:"fiat_rate"`
+	Timestamp      string  `boil:"timestamp" json:"timestamp" toml:"timestamp" yaml:"timestamp"`
+
+	R *balanceSnapshotR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L balanceSnapshotL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var BalanceSnapshotColumns = struct {
+	ID             string
+	ExchangeNameID string
+	Account        string
+	Asset          string
+	Currency       string
+	Total          string
+	Hold           string
+	FiatCurrency   string
+	FiatRate       string
+	Timestamp      string
+}{
+	ID:             "id",
+	ExchangeNameID: "exchange_name_id",
+	Account:        "account",
+	Asset:          "asset",
+	Currency:       "currency",
+	Total:          "total",
+	Hold:           "hold",
+	FiatCurrency:   "fiat_currency",
+	FiatRate:       "fiat_rate",
+	Timestamp:      "timestamp",
+}
+
+// Generated where
+
+var BalanceSnapshotWhere = struct {
+	ID             whereHelperstring
+	ExchangeNameID whereHelperstring
+	Account        whereHelperstring
+	Asset          whereHelperstring
+	Currency       whereHelperstring
+	Total          whereHelperfloat64
+	Hold           whereHelperfloat64
+	FiatCurrency   whereHelperstring
+	FiatRate       whereHelperfloat64
+	Timestamp      whereHelperstring
+}{
+	ID:             whereHelperstring{field: "\"balance_snapshot\".\"id\""},
+	ExchangeNameID: whereHelperstring{field: "\"balance_snapshot\".\"exchange_name_id\""},
+	Account:        whereHelperstring{field: "\"balance_snapshot\".\"account\""},
+	Asset:          whereHelperstring{field: "\"balance_snapshot\".\"asset\""},
+	Currency:       whereHelperstring{field: "\"balance_snapshot\".\"currency\""},
+	Total:          whereHelperfloat64{field: "\"balance_snapshot\".\"total\""},
+	Hold:           whereHelperfloat64{field: "\"balance_snapshot\".\"hold\""},
+	FiatCurrency:   whereHelperstring{field: "\"balance_snapshot\".\"fiat_currency\""},
+	FiatRate:       whereHelperfloat64{field: "\"balance_snapshot\".\"fiat_rate\""},
+	Timestamp:      whereHelperstring{field: "\"balance_snapshot\".\"timestamp\""},
+}
+
+// BalanceSnapshotRels is where relationship names are stored.
+var BalanceSnapshotRels = struct {
+}{}
+
+// balanceSnapshotR is where relationships are stored.
+type balanceSnapshotR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*balanceSnapshotR) NewStruct() *balanceSnapshotR {
+	return &balanceSnapshotR{}
+}
+
+// balanceSnapshotL is where Load methods for each relationship are stored.
+type balanceSnapshotL struct{}
+
+var (
+	balanceSnapshotAllColumns            = []string{"id", "exchange_name_id", "account", "asset", "currency", "total", "hold", "fiat_currency", "fiat_rate", "timestamp"}
+	balanceSnapshotColumnsWithoutDefault = []string{"id", "exchange_name_id", "account", "asset", "currency", "total", "hold", "fiat_currency", "fiat_rate", "timestamp"}
+	balanceSnapshotColumnsWithDefault    = []string{}
+	balanceSnapshotPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// BalanceSnapshotSlice is an alias for a slice of pointers to BalanceSnapshot.
+	// This should generally be used opposed to []BalanceSnapshot.
+	BalanceSnapshotSlice []*BalanceSnapshot
+	// BalanceSnapshotHook is the signature for custom BalanceSnapshot hook methods
+	BalanceSnapshotHook func(context.Context, boil.ContextExecutor, *BalanceSnapshot) error
+
+	balanceSnapshotQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	balanceSnapshotType                 = reflect.TypeOf(&BalanceSnapshot{})
+	balanceSnapshotMapping              = queries.MakeStructMapping(balanceSnapshotType)
+	balanceSnapshotPrimaryKeyMapping, _ = queries.BindMapping(balanceSnapshotType, balanceSnapshotMapping, balanceSnapshotPrimaryKeyColumns)
+	balanceSnapshotInsertCacheMut       sync.RWMutex
+	balanceSnapshotInsertCache          = make(map[string]insertCache)
+	balanceSnapshotUpdateCacheMut       sync.RWMutex
+	balanceSnapshotUpdateCache          = make(map[string]updateCache)
+	balanceSnapshotUpsertCacheMut       sync.RWMutex
+	balanceSnapshotUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var balanceSnapshotBeforeInsertHooks []BalanceSnapshotHook
+var balanceSnapshotBeforeUpdateHooks []BalanceSnapshotHook
+var balanceSnapshotBeforeDeleteHooks []BalanceSnapshotHook
+var balanceSnapshotBeforeUpsertHooks []BalanceSnapshotHook
+
+var balanceSnapshotAfterInsertHooks []BalanceSnapshotHook
+var balanceSnapshotAfterSelectHooks []BalanceSnapshotHook
+var balanceSnapshotAfterUpdateHooks []BalanceSnapshotHook
+var balanceSnapshotAfterDeleteHooks []BalanceSnapshotHook
+var balanceSnapshotAfterUpsertHooks []BalanceSnapshotHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *BalanceSnapshot) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range balanceSnapshotBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *BalanceSnapshot) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range balanceSnapshotBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *BalanceSnapshot) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range balanceSnapshotBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *BalanceSnapshot) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range balanceSnapshotBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *BalanceSnapshot) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range balanceSnapshotAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *BalanceSnapshot) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range balanceSnapshotAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *BalanceSnapshot) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range balanceSnapshotAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *BalanceSnapshot) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range balanceSnapshotAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *BalanceSnapshot) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range balanceSnapshotAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddBalanceSnapshotHook registers your hook function for all future operations.
+func AddBalanceSnapshotHook(hookPoint boil.HookPoint, balanceSnapshotHook BalanceSnapshotHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		balanceSnapshotBeforeInsertHooks = append(balanceSnapshotBeforeInsertHooks, balanceSnapshotHook)
+	case boil.BeforeUpdateHook:
+		balanceSnapshotBeforeUpdateHooks = append(balanceSnapshotBeforeUpdateHooks, balanceSnapshotHook)
+	case boil.BeforeDeleteHook:
+		balanceSnapshotBeforeDeleteHooks = append(balanceSnapshotBeforeDeleteHooks, balanceSnapshotHook)
+	case boil.BeforeUpsertHook:
+		balanceSnapshotBeforeUpsertHooks = append(balanceSnapshotBeforeUpsertHooks, balanceSnapshotHook)
+	case boil.AfterInsertHook:
+		balanceSnapshotAfterInsertHooks = append(balanceSnapshotAfterInsertHooks, balanceSnapshotHook)
+	case boil.AfterSelectHook:
+		balanceSnapshotAfterSelectHooks = append(balanceSnapshotAfterSelectHooks, balanceSnapshotHook)
+	case boil.AfterUpdateHook:
+		balanceSnapshotAfterUpdateHooks = append(balanceSnapshotAfterUpdateHooks, balanceSnapshotHook)
+	case boil.AfterDeleteHook:
+		balanceSnapshotAfterDeleteHooks = append(balanceSnapshotAfterDeleteHooks, balanceSnapshotHook)
+	case boil.AfterUpsertHook:
+		balanceSnapshotAfterUpsertHooks = append(balanceSnapshotAfterUpsertHooks, balanceSnapshotHook)
+	}
+}
+
+// One returns a single balance_snapshot record from the query.
+func (q balanceSnapshotQuery) One(ctx context.Context, exec boil.ContextExecutor) (*BalanceSnapshot, error) {
+	o := &BalanceSnapshot{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: failed to execute a one query for balance_snapshot")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all BalanceSnapshot records from the query.
+func (q balanceSnapshotQuery) All(ctx context.Context, exec boil.ContextExecutor) (BalanceSnapshotSlice, error) {
+	var o []*BalanceSnapshot
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "sqlite3: failed to assign all query results to BalanceSnapshot slice")
+	}
+
+	if len(balanceSnapshotAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all BalanceSnapshot records in the query.
+func (q balanceSnapshotQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to count balance_snapshot rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q balanceSnapshotQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: failed to check if balance_snapshot exists")
+	}
+
+	return count > 0, nil
+}
+
+// BalanceSnapshots retrieves all the records using an executor.
+func BalanceSnapshots(mods ...qm.QueryMod) balanceSnapshotQuery {
+	mods = append(mods, qm.From("\"balance_snapshot\""))
+	return balanceSnapshotQuery{NewQuery(mods...)}
+}
+
+// FindBalanceSnapshot retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindBalanceSnapshot(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*BalanceSnapshot, error) {
+	balanceSnapshotObj := &BalanceSnapshot{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"balance_snapshot\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, balanceSnapshotObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: unable to select from balance_snapshot")
+	}
+
+	return balanceSnapshotObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *BalanceSnapshot) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlite3: no balance_snapshot provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(balanceSnapshotColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	balanceSnapshotInsertCacheMut.RLock()
+	cache, cached := balanceSnapshotInsertCache[key]
+	balanceSnapshotInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			balanceSnapshotAllColumns,
+			balanceSnapshotColumnsWithDefault,
+			balanceSnapshotColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(balanceSnapshotType, balanceSnapshotMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(balanceSnapshotType, balanceSnapshotMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"balance_snapshot\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"balance_snapshot\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"balance_snapshot\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, balanceSnapshotPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to insert into balance_snapshot")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to populate default values for balance_snapshot")
+	}
+
+CacheNoHooks:
+	if !cached {
+		balanceSnapshotInsertCacheMut.Lock()
+		balanceSnapshotInsertCache[key] = cache
+		balanceSnapshotInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the BalanceSnapshot.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *BalanceSnapshot) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	balanceSnapshotUpdateCacheMut.RLock()
+	cache, cached := balanceSnapshotUpdateCache[key]
+	balanceSnapshotUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			balanceSnapshotAllColumns,
+			balanceSnapshotPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("sqlite3: unable to update balance_snapshot, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"balance_snapshot\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, balanceSnapshotPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(balanceSnapshotType, balanceSnapshotMapping, append(wl, balanceSnapshotPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update balance_snapshot row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by update for balance_snapshot")
+	}
+
+	if !cached {
+		balanceSnapshotUpdateCacheMut.Lock()
+		balanceSnapshotUpdateCache[key] = cache
+		balanceSnapshotUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q balanceSnapshotQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all for balance_snapshot")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected for balance_snapshot")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o BalanceSnapshotSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("sqlite3: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), balanceSnapshotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"balance_snapshot\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, balanceSnapshotPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all in balanceSnapshot slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected all in update all balanceSnapshot")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single BalanceSnapshot record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *BalanceSnapshot) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("sqlite3: no BalanceSnapshot provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), balanceSnapshotPrimaryKeyMapping)
+	sql := "DELETE FROM \"balance_snapshot\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete from balance_snapshot")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by delete for balance_snapshot")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q balanceSnapshotQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("sqlite3: no balanceSnapshotQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from balance_snapshot")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for balance_snapshot")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o BalanceSnapshotSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(balanceSnapshotBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), balanceSnapshotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"balance_snapshot\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, balanceSnapshotPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from balanceSnapshot slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for balance_snapshot")
+	}
+
+	if len(balanceSnapshotAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *BalanceSnapshot) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindBalanceSnapshot(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *BalanceSnapshotSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := BalanceSnapshotSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), balanceSnapshotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"balance_snapshot\".* FROM \"balance_snapshot\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, balanceSnapshotPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to reload all in BalanceSnapshotSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// BalanceSnapshotExists checks if the BalanceSnapshot row exists.
+func BalanceSnapshotExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"balance_snapshot\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: unable to check if balance_snapshot exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,684 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testBalanceSnapshots(t *testing.T) {
+	t.Parallel()
+
+	query := BalanceSnapshots()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testBalanceSnapshotsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BalanceSnapshot{}
+	if err = randomize.Struct(seed, o, balanceSnapshotDBTypes, true, balanceSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := BalanceSnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testBalanceSnapshotsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BalanceSnapshot{}
+	if err = randomize.Struct(seed, o, balanceSnapshotDBTypes, true, balanceSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := BalanceSnapshots().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := BalanceSnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testBalanceSnapshotsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BalanceSnapshot{}
+	if err = randomize.Struct(seed, o, balanceSnapshotDBTypes, true, balanceSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := BalanceSnapshotSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := BalanceSnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testBalanceSnapshotsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BalanceSnapshot{}
+	if err = randomize.Struct(seed, o, balanceSnapshotDBTypes, true, balanceSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := BalanceSnapshotExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if BalanceSnapshot exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected BalanceSnapshotExists to return true, but got false.")
+	}
+}
+
+func testBalanceSnapshotsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BalanceSnapshot{}
+	if err = randomize.Struct(seed, o, balanceSnapshotDBTypes, true, balanceSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	balanceSnapshotFound, err := FindBalanceSnapshot(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if balanceSnapshotFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testBalanceSnapshotsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BalanceSnapshot{}
+	if err = randomize.Struct(seed, o, balanceSnapshotDBTypes, true, balanceSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = BalanceSnapshots().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testBalanceSnapshotsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BalanceSnapshot{}
+	if err = randomize.Struct(seed, o, balanceSnapshotDBTypes, true, balanceSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := BalanceSnapshots().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testBalanceSnapshotsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	balanceSnapshotOne := &BalanceSnapshot{}
+	balanceSnapshotTwo := &BalanceSnapshot{}
+	if err = randomize.Struct(seed, balanceSnapshotOne, balanceSnapshotDBTypes, false, balanceSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceSnapshot struct: %s", err)
+	}
+	if err = randomize.Struct(seed, balanceSnapshotTwo, balanceSnapshotDBTypes, false, balanceSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = balanceSnapshotOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = balanceSnapshotTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := BalanceSnapshots().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testBalanceSnapshotsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	balanceSnapshotOne := &BalanceSnapshot{}
+	balanceSnapshotTwo := &BalanceSnapshot{}
+	if err = randomize.Struct(seed, balanceSnapshotOne, balanceSnapshotDBTypes, false, balanceSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceSnapshot struct: %s", err)
+	}
+	if err = randomize.Struct(seed, balanceSnapshotTwo, balanceSnapshotDBTypes, false, balanceSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = balanceSnapshotOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = balanceSnapshotTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BalanceSnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func balanceSnapshotBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *BalanceSnapshot) error {
+	*o = BalanceSnapshot{}
+	return nil
+}
+
+func balanceSnapshotAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *BalanceSnapshot) error {
+	*o = BalanceSnapshot{}
+	return nil
+}
+
+func balanceSnapshotAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *BalanceSnapshot) error {
+	*o = BalanceSnapshot{}
+	return nil
+}
+
+func balanceSnapshotBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *BalanceSnapshot) error {
+	*o = BalanceSnapshot{}
+	return nil
+}
+
+func balanceSnapshotAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *BalanceSnapshot) error {
+	*o = BalanceSnapshot{}
+	return nil
+}
+
+func balanceSnapshotBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *BalanceSnapshot) error {
+	*o = BalanceSnapshot{}
+	return nil
+}
+
+func balanceSnapshotAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *BalanceSnapshot) error {
+	*o = BalanceSnapshot{}
+	return nil
+}
+
+func balanceSnapshotBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *BalanceSnapshot) error {
+	*o = BalanceSnapshot{}
+	return nil
+}
+
+func balanceSnapshotAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *BalanceSnapshot) error {
+	*o = BalanceSnapshot{}
+	return nil
+}
+
+func testBalanceSnapshotsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &BalanceSnapshot{}
+	o := &BalanceSnapshot{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, balanceSnapshotDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize BalanceSnapshot object: %s", err)
+	}
+
+	AddBalanceSnapshotHook(boil.BeforeInsertHook, balanceSnapshotBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	balanceSnapshotBeforeInsertHooks = []BalanceSnapshotHook{}
+
+	AddBalanceSnapshotHook(boil.AfterInsertHook, balanceSnapshotAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	balanceSnapshotAfterInsertHooks = []BalanceSnapshotHook{}
+
+	AddBalanceSnapshotHook(boil.AfterSelectHook, balanceSnapshotAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	balanceSnapshotAfterSelectHooks = []BalanceSnapshotHook{}
+
+	AddBalanceSnapshotHook(boil.BeforeUpdateHook, balanceSnapshotBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	balanceSnapshotBeforeUpdateHooks = []BalanceSnapshotHook{}
+
+	AddBalanceSnapshotHook(boil.AfterUpdateHook, balanceSnapshotAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	balanceSnapshotAfterUpdateHooks = []BalanceSnapshotHook{}
+
+	AddBalanceSnapshotHook(boil.BeforeDeleteHook, balanceSnapshotBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	balanceSnapshotBeforeDeleteHooks = []BalanceSnapshotHook{}
+
+	AddBalanceSnapshotHook(boil.AfterDeleteHook, balanceSnapshotAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	balanceSnapshotAfterDeleteHooks = []BalanceSnapshotHook{}
+
+	AddBalanceSnapshotHook(boil.BeforeUpsertHook, balanceSnapshotBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	balanceSnapshotBeforeUpsertHooks = []BalanceSnapshotHook{}
+
+	AddBalanceSnapshotHook(boil.AfterUpsertHook, balanceSnapshotAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	balanceSnapshotAfterUpsertHooks = []BalanceSnapshotHook{}
+}
+
+func testBalanceSnapshotsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BalanceSnapshot{}
+	if err = randomize.Struct(seed, o, balanceSnapshotDBTypes, true, balanceSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BalanceSnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testBalanceSnapshotsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BalanceSnapshot{}
+	if err = randomize.Struct(seed, o, balanceSnapshotDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize BalanceSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(balanceSnapshotColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BalanceSnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testBalanceSnapshotsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BalanceSnapshot{}
+	if err = randomize.Struct(seed, o, balanceSnapshotDBTypes, true, balanceSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testBalanceSnapshotsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BalanceSnapshot{}
+	if err = randomize.Struct(seed, o, balanceSnapshotDBTypes, true, balanceSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := BalanceSnapshotSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testBalanceSnapshotsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BalanceSnapshot{}
+	if err = randomize.Struct(seed, o, balanceSnapshotDBTypes, true, balanceSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := BalanceSnapshots().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	balanceSnapshotDBTypes = map[string]string{`ID`: `TEXT`, `ExchangeNameID`: `TEXT`, `Account`: `TEXT`, `Asset`: `TEXT`, `Currency`: `TEXT`, `Total`: `REAL`, `Hold`: `REAL`, `FiatCurrency`: `TEXT`, `FiatRate`: `REAL`, `Timestamp`: `TIMESTAMP`}
+	_                      = bytes.MinRead
+)
+
+func testBalanceSnapshotsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(balanceSnapshotPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(balanceSnapshotAllColumns) == len(balanceSnapshotPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BalanceSnapshot{}
+	if err = randomize.Struct(seed, o, balanceSnapshotDBTypes, true, balanceSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BalanceSnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, balanceSnapshotDBTypes, true, balanceSnapshotPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize BalanceSnapshot struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testBalanceSnapshotsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(balanceSnapshotAllColumns) == len(balanceSnapshotPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BalanceSnapshot{}
+	if err = randomize.Struct(seed, o, balanceSnapshotDBTypes, true, balanceSnapshotColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BalanceSnapshot struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BalanceSnapshots().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, balanceSnapshotDBTypes, true, balanceSnapshotPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize BalanceSnapshot struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(balanceSnapshotAllColumns, balanceSnapshotPrimaryKeyColumns) {
+		fields = balanceSnapshotAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			balanceSnapshotAllColumns,
+			balanceSnapshotPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := BalanceSnapshotSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
@@ -13,10 +13,15 @@ import "testing"
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
 	t.Run("AuditEvents", testAuditEvents)
+	t.Run("BalanceChanges", testBalanceChanges)
+	t.Run("BalanceSnapshots", testBalanceSnapshots)
 	t.Run("Candles", testCandles)
 	t.Run("Datahistoryjobs", testDatahistoryjobs)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresults)
 	t.Run("Exchanges", testExchanges)
+	t.Run("OrderDetails", testOrderDetails)
+	t.Run("OrderbookDeltas", testOrderbookDeltas)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshots)
 	t.Run("Scripts", testScripts)
 	t.Run("ScriptExecutions", testScriptExecutions)
 	t.Run("Trades", testTrades)
@@ -27,10 +32,15 @@ func TestParent(t *testing.T) {
 
 func TestDelete(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsDelete)
+	t.Run("BalanceChanges", testBalanceChangesDelete)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsDelete)
 	t.Run("Candles", testCandlesDelete)
 	t.Run("Datahistoryjobs", testDatahistoryjobsDelete)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsDelete)
 	t.Run("Exchanges", testExchangesDelete)
+	t.Run("OrderDetails", testOrderDetailsDelete)
+	t.Run("OrderbookDeltas", testOrderbookDeltasDelete)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsDelete)
 	t.Run("Scripts", testScriptsDelete)
 	t.Run("ScriptExecutions", testScriptExecutionsDelete)
 	t.Run("Trades", testTradesDelete)
@@ -41,10 +51,15 @@ func TestDelete(t *testing.T) {
 
 func TestQueryDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsQueryDeleteAll)
+	t.Run("BalanceChanges", testBalanceChangesQueryDeleteAll)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsQueryDeleteAll)
 	t.Run("Candles", testCandlesQueryDeleteAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsQueryDeleteAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsQueryDeleteAll)
 	t.Run("Exchanges", testExchangesQueryDeleteAll)
+	t.Run("OrderDetails", testOrderDetailsQueryDeleteAll)
+	t.Run("OrderbookDeltas", testOrderbookDeltasQueryDeleteAll)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsQueryDeleteAll)
 	t.Run("Scripts", testScriptsQueryDeleteAll)
 	t.Run("ScriptExecutions", testScriptExecutionsQueryDeleteAll)
 	t.Run("Trades", testTradesQueryDeleteAll)
@@ -55,10 +70,15 @@ func TestQueryDeleteAll(t *testing.T) {
 
 func TestSliceDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceDeleteAll)
+	t.Run("BalanceChanges", testBalanceChangesSliceDeleteAll)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsSliceDeleteAll)
 	t.Run("Candles", testCandlesSliceDeleteAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsSliceDeleteAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSliceDeleteAll)
 	t.Run("Exchanges", testExchangesSliceDeleteAll)
+	t.Run("OrderDetails", testOrderDetailsSliceDeleteAll)
+	t.Run("OrderbookDeltas", testOrderbookDeltasSliceDeleteAll)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsSliceDeleteAll)
 	t.Run("Scripts", testScriptsSliceDeleteAll)
 	t.Run("ScriptExecutions", testScriptExecutionsSliceDeleteAll)
 	t.Run("Trades", testTradesSliceDeleteAll)
@@ -69,10 +89,15 @@ func TestSliceDeleteAll(t *testing.T) {
 
 func TestExists(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsExists)
+	t.Run("BalanceChanges", testBalanceChangesExists)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsExists)
 	t.Run("Candles", testCandlesExists)
 	t.Run("Datahistoryjobs", testDatahistoryjobsExists)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsExists)
 	t.Run("Exchanges", testExchangesExists)
+	t.Run("OrderDetails", testOrderDetailsExists)
+	t.Run("OrderbookDeltas", testOrderbookDeltasExists)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsExists)
 	t.Run("Scripts", testScriptsExists)
 	t.Run("ScriptExecutions", testScriptExecutionsExists)
 	t.Run("Trades", testTradesExists)
@@ -83,10 +108,15 @@ func TestExists(t *testing.T) {
 
 func TestFind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsFind)
+	t.Run("BalanceChanges", testBalanceChangesFind)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsFind)
 	t.Run("Candles", testCandlesFind)
 	t.Run("Datahistoryjobs", testDatahistoryjobsFind)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsFind)
 	t.Run("Exchanges", testExchangesFind)
+	t.Run("OrderDetails", testOrderDetailsFind)
+	t.Run("OrderbookDeltas", testOrderbookDeltasFind)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsFind)
 	t.Run("Scripts", testScriptsFind)
 	t.Run("ScriptExecutions", testScriptExecutionsFind)
 	t.Run("Trades", testTradesFind)
@@ -97,10 +127,15 @@ func TestFind(t *testing.T) {
 
 func TestBind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsBind)
+	t.Run("BalanceChanges", testBalanceChangesBind)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsBind)
 	t.Run("Candles", testCandlesBind)
 	t.Run("Datahistoryjobs", testDatahistoryjobsBind)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsBind)
 	t.Run("Exchanges", testExchangesBind)
+	t.Run("OrderDetails", testOrderDetailsBind)
+	t.Run("OrderbookDeltas", testOrderbookDeltasBind)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsBind)
 	t.Run("Scripts", testScriptsBind)
 	t.Run("ScriptExecutions", testScriptExecutionsBind)
 	t.Run("Trades", testTradesBind)
@@ -111,10 +146,15 @@ func TestBind(t *testing.T) {
 
 func TestOne(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsOne)
+	t.Run("BalanceChanges", testBalanceChangesOne)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsOne)
 	t.Run("Candles", testCandlesOne)
 	t.Run("Datahistoryjobs", testDatahistoryjobsOne)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsOne)
 	t.Run("Exchanges", testExchangesOne)
+	t.Run("OrderDetails", testOrderDetailsOne)
+	t.Run("OrderbookDeltas", testOrderbookDeltasOne)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsOne)
 	t.Run("Scripts", testScriptsOne)
 	t.Run("ScriptExecutions", testScriptExecutionsOne)
 	t.Run("Trades", testTradesOne)
@@ -125,10 +165,15 @@ func TestOne(t *testing.T) {
 
 func TestAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsAll)
+	t.Run("BalanceChanges", testBalanceChangesAll)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsAll)
 	t.Run("Candles", testCandlesAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsAll)
 	t.Run("Exchanges", testExchangesAll)
+	t.Run("OrderDetails", testOrderDetailsAll)
+	t.Run("OrderbookDeltas", testOrderbookDeltasAll)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsAll)
 	t.Run("Scripts", testScriptsAll)
 	t.Run("ScriptExecutions", testScriptExecutionsAll)
 	t.Run("Trades", testTradesAll)
@@ -139,10 +184,15 @@ func TestAll(t *testing.T) {
 
 func TestCount(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsCount)
+	t.Run("BalanceChanges", testBalanceChangesCount)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsCount)
 	t.Run("Candles", testCandlesCount)
 	t.Run("Datahistoryjobs", testDatahistoryjobsCount)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsCount)
 	t.Run("Exchanges", testExchangesCount)
+	t.Run("OrderDetails", testOrderDetailsCount)
+	t.Run("OrderbookDeltas", testOrderbookDeltasCount)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsCount)
 	t.Run("Scripts", testScriptsCount)
 	t.Run("ScriptExecutions", testScriptExecutionsCount)
 	t.Run("Trades", testTradesCount)
@@ -153,10 +203,15 @@ func TestCount(t *testing.T) {
 
 func TestHooks(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsHooks)
+	t.Run("BalanceChanges", testBalanceChangesHooks)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsHooks)
 	t.Run("Candles", testCandlesHooks)
 	t.Run("Datahistoryjobs", testDatahistoryjobsHooks)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsHooks)
 	t.Run("Exchanges", testExchangesHooks)
+	t.Run("OrderDetails", testOrderDetailsHooks)
+	t.Run("OrderbookDeltas", testOrderbookDeltasHooks)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsHooks)
 	t.Run("Scripts", testScriptsHooks)
 	t.Run("ScriptExecutions", testScriptExecutionsHooks)
 	t.Run("Trades", testTradesHooks)
@@ -167,7 +222,11 @@ func TestHooks(t *testing.T) {
 
 func TestInsert(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsInsert)
+	t.Run("BalanceChanges", testBalanceChangesInsert)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsInsert)
 	t.Run("AuditEvents", testAuditEventsInsertWhitelist)
+	t.Run("BalanceChanges", testBalanceChangesInsertWhitelist)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsInsertWhitelist)
 	t.Run("Candles", testCandlesInsert)
 	t.Run("Candles", testCandlesInsertWhitelist)
 	t.Run("Datahistoryjobs", testDatahistoryjobsInsert)
@@ -176,6 +235,12 @@ func TestInsert(t *testing.T) {
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsInsertWhitelist)
 	t.Run("Exchanges", testExchangesInsert)
 	t.Run("Exchanges", testExchangesInsertWhitelist)
+	t.Run("OrderDetails", testOrderDetailsInsert)
+	t.Run("OrderbookDeltas", testOrderbookDeltasInsert)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsInsert)
+	t.Run("OrderDetails", testOrderDetailsInsertWhitelist)
+	t.Run("OrderbookDeltas", testOrderbookDeltasInsertWhitelist)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsInsertWhitelist)
 	t.Run("Scripts", testScriptsInsert)
 	t.Run("Scripts", testScriptsInsertWhitelist)
 	t.Run("ScriptExecutions", testScriptExecutionsInsert)
@@ -302,10 +367,15 @@ func TestToManyRemove(t *testing.T) {
 
 func TestReload(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReload)
+	t.Run("BalanceChanges", testBalanceChangesReload)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsReload)
 	t.Run("Candles", testCandlesReload)
 	t.Run("Datahistoryjobs", testDatahistoryjobsReload)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsReload)
 	t.Run("Exchanges", testExchangesReload)
+	t.Run("OrderDetails", testOrderDetailsReload)
+	t.Run("OrderbookDeltas", testOrderbookDeltasReload)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsReload)
 	t.Run("Scripts", testScriptsReload)
 	t.Run("ScriptExecutions", testScriptExecutionsReload)
 	t.Run("Trades", testTradesReload)
@@ -316,10 +386,15 @@ func TestReload(t *testing.T) {
 
 func TestReloadAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReloadAll)
+	t.Run("BalanceChanges", testBalanceChangesReloadAll)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsReloadAll)
 	t.Run("Candles", testCandlesReloadAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsReloadAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsReloadAll)
 	t.Run("Exchanges", testExchangesReloadAll)
+	t.Run("OrderDetails", testOrderDetailsReloadAll)
+	t.Run("OrderbookDeltas", testOrderbookDeltasReloadAll)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsReloadAll)
 	t.Run("Scripts", testScriptsReloadAll)
 	t.Run("ScriptExecutions", testScriptExecutionsReloadAll)
 	t.Run("Trades", testTradesReloadAll)
@@ -330,10 +405,15 @@ func TestReloadAll(t *testing.T) {
 
 func TestSelect(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSelect)
+	t.Run("BalanceChanges", testBalanceChangesSelect)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsSelect)
 	t.Run("Candles", testCandlesSelect)
 	t.Run("Datahistoryjobs", testDatahistoryjobsSelect)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSelect)
 	t.Run("Exchanges", testExchangesSelect)
+	t.Run("OrderDetails", testOrderDetailsSelect)
+	t.Run("OrderbookDeltas", testOrderbookDeltasSelect)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsSelect)
 	t.Run("Scripts", testScriptsSelect)
 	t.Run("ScriptExecutions", testScriptExecutionsSelect)
 	t.Run("Trades", testTradesSelect)
@@ -344,10 +424,15 @@ func TestSelect(t *testing.T) {
 
 func TestUpdate(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsUpdate)
+	t.Run("BalanceChanges", testBalanceChangesUpdate)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsUpdate)
 	t.Run("Candles", testCandlesUpdate)
 	t.Run("Datahistoryjobs", testDatahistoryjobsUpdate)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsUpdate)
 	t.Run("Exchanges", testExchangesUpdate)
+	t.Run("OrderDetails", testOrderDetailsUpdate)
+	t.Run("OrderbookDeltas", testOrderbookDeltasUpdate)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsUpdate)
 	t.Run("Scripts", testScriptsUpdate)
 	t.Run("ScriptExecutions", testScriptExecutionsUpdate)
 	t.Run("Trades", testTradesUpdate)
@@ -358,10 +443,15 @@ func TestUpdate(t *testing.T) {
 
 func TestSliceUpdateAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceUpdateAll)
+	t.Run("BalanceChanges", testBalanceChangesSliceUpdateAll)
+	t.Run("BalanceSnapshots", testBalanceSnapshotsSliceUpdateAll)
 	t.Run("Candles", testCandlesSliceUpdateAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsSliceUpdateAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSliceUpdateAll)
 	t.Run("Exchanges", testExchangesSliceUpdateAll)
+	t.Run("OrderDetails", testOrderDetailsSliceUpdateAll)
+	t.Run("OrderbookDeltas", testOrderbookDeltasSliceUpdateAll)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsSliceUpdateAll)
 	t.Run("Scripts", testScriptsSliceUpdateAll)
 	t.Run("ScriptExecutions", testScriptExecutionsSliceUpdateAll)
 	t.Run("Trades", testTradesSliceUpdateAll)
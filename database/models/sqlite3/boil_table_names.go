@@ -5,11 +5,16 @@ package sqlite3
 
 var TableNames = struct {
 	AuditEvent              string
+	BalanceChange           string
+	BalanceSnapshot         string
 	Candle                  string
 	Datahistoryjob          string
 	Datahistoryjobrelations string
 	Datahistoryjobresult    string
 	Exchange                string
+	OrderDetail             string
+	OrderbookDelta          string
+	OrderbookSnapshot       string
 	Script                  string
 	ScriptExecution         string
 	Trade                   string
@@ -18,11 +23,16 @@ var TableNames = struct {
 	WithdrawalHistory       string
 }{
 	AuditEvent:              "audit_event",
+	BalanceChange:           "balance_change",
+	BalanceSnapshot:         "balance_snapshot",
 	Candle:                  "candle",
 	Datahistoryjob:          "datahistoryjob",
 	Datahistoryjobrelations: "datahistoryjobrelations",
 	Datahistoryjobresult:    "datahistoryjobresult",
 	Exchange:                "exchange",
+	OrderDetail:             "order_detail",
+	OrderbookDelta:          "orderbook_delta",
+	OrderbookSnapshot:       "orderbook_snapshot",
 	Script:                  "script",
 	ScriptExecution:         "script_execution",
 	Trade:                   "trade",
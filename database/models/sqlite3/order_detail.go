@@ -0,0 +1,866 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+	"github.com/volatiletech/null"
+)
+
+// OrderDetail is an object representing the database table.
+type OrderDetail struct {
+	ID                   string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ExchangeNameID       string      `boil:"exchange_name_id" json:"exchange_name_id" toml:"exchange_name_id" yaml:"exchange_name_id"`
+	OrderID              string      `boil:"order_id" json:"order_id" toml:"order_id" yaml:"order_id"`
+	ClientOrderID        null.String `boil:"client_order_id" json:"client_order_id,omitempty" toml:"client_order_id" yaml:"client_order_id,omitempty"`
+	AccountID            null.String `boil:"account_id" json:"account_id,omitempty" toml:"account_id" yaml:"account_id,omitempty"`
+	Asset                string      `boil:"asset" json:"asset" toml:"asset" yaml:"asset"`
+	Base                 string      `boil:"base" json:"base" toml:"base" yaml:"base"`
+	Quote                string      `boil:"quote" json:"quote" toml:"quote" yaml:"quote"`
+	Side                 string      `boil:"side" json:"side" toml:"side" yaml:"side"`
+	Type                 string      `boil:"type" json:"type" toml:"type" yaml:"type"`
+	Status               string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Price                float64     `boil:"price" json:"price" toml:"price" yaml:"price"`
+	Amount               float64     `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	TriggerPrice         float64     `boil:"trigger_price" json:"trigger_price" toml:"trigger_price" yaml:"trigger_price"`
+	AverageExecutedPrice float64     `boil:"average_executed_price" json:"average_executed_price" toml:"average_executed_price" yaml:"average_executed_price"`
+	ExecutedAmount       float64     `boil:"executed_amount" json:"executed_amount" toml:"executed_amount" yaml:"executed_amount"`
+	RemainingAmount      float64     `boil:"remaining_amount" json:"remaining_amount" toml:"remaining_amount" yaml:"remaining_amount"`
+	Cost                 float64     `boil:"cost" json:"cost" toml:"cost" yaml:"cost"`
+	Fee                  float64     `boil:"fee" json:"fee" toml:"fee" yaml:"fee"`
+	Leverage             float64     `boil:"leverage" json:"leverage" toml:"leverage" yaml:"leverage"`
+	CreatedAt            string      `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt            string      `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *orderDetailR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L orderDetailL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OrderDetailColumns = struct {
+	ID                   string
+	ExchangeNameID       string
+	OrderID              string
+	ClientOrderID        string
+	AccountID            string
+	Asset                string
+	Base                 string
+	Quote                string
+	Side                 string
+	Type                 string
+	Status               string
+	Price                string
+	Amount               string
+	TriggerPrice         string
+	AverageExecutedPrice string
+	ExecutedAmount       string
+	RemainingAmount      string
+	Cost                 string
+	Fee                  string
+	Leverage             string
+	CreatedAt            string
+	UpdatedAt            string
+}{
+	ID:                   "id",
+	ExchangeNameID:       "exchange_name_id",
+	OrderID:              "order_id",
+	ClientOrderID:        "client_order_id",
+	AccountID:            "account_id",
+	Asset:                "asset",
+	Base:                 "base",
+	Quote:                "quote",
+	Side:                 "side",
+	Type:                 "type",
+	Status:               "status",
+	Price:                "price",
+	Amount:               "amount",
+	TriggerPrice:         "trigger_price",
+	AverageExecutedPrice: "average_executed_price",
+	ExecutedAmount:       "executed_amount",
+	RemainingAmount:      "remaining_amount",
+	Cost:                 "cost",
+	Fee:                  "fee",
+	Leverage:             "leverage",
+	CreatedAt:            "created_at",
+	UpdatedAt:            "updated_at",
+}
+
+// Generated where
+
+var OrderDetailWhere = struct {
+	ID                   whereHelperstring
+	ExchangeNameID       whereHelperstring
+	OrderID              whereHelperstring
+	ClientOrderID        whereHelpernull_String
+	AccountID            whereHelpernull_String
+	Asset                whereHelperstring
+	Base                 whereHelperstring
+	Quote                whereHelperstring
+	Side                 whereHelperstring
+	Type                 whereHelperstring
+	Status               whereHelperstring
+	Price                whereHelperfloat64
+	Amount               whereHelperfloat64
+	TriggerPrice         whereHelperfloat64
+	AverageExecutedPrice whereHelperfloat64
+	ExecutedAmount       whereHelperfloat64
+	RemainingAmount      whereHelperfloat64
+	Cost                 whereHelperfloat64
+	Fee                  whereHelperfloat64
+	Leverage             whereHelperfloat64
+	CreatedAt            whereHelperstring
+	UpdatedAt            whereHelperstring
+}{
+	ID:                   whereHelperstring{field: "\"order_detail\".\"id\""},
+	ExchangeNameID:       whereHelperstring{field: "\"order_detail\".\"exchange_name_id\""},
+	OrderID:              whereHelperstring{field: "\"order_detail\".\"order_id\""},
+	ClientOrderID:        whereHelpernull_String{field: "\"order_detail\".\"client_order_id\""},
+	AccountID:            whereHelpernull_String{field: "\"order_detail\".\"account_id\""},
+	Asset:                whereHelperstring{field: "\"order_detail\".\"asset\""},
+	Base:                 whereHelperstring{field: "\"order_detail\".\"base\""},
+	Quote:                whereHelperstring{field: "\"order_detail\".\"quote\""},
+	Side:                 whereHelperstring{field: "\"order_detail\".\"side\""},
+	Type:                 whereHelperstring{field: "\"order_detail\".\"type\""},
+	Status:               whereHelperstring{field: "\"order_detail\".\"status\""},
+	Price:                whereHelperfloat64{field: "\"order_detail\".\"price\""},
+	Amount:               whereHelperfloat64{field: "\"order_detail\".\"amount\""},
+	TriggerPrice:         whereHelperfloat64{field: "\"order_detail\".\"trigger_price\""},
+	AverageExecutedPrice: whereHelperfloat64{field: "\"order_detail\".\"average_executed_price\""},
+	ExecutedAmount:       whereHelperfloat64{field: "\"order_detail\".\"executed_amount\""},
+	RemainingAmount:      whereHelperfloat64{field: "\"order_detail\".\"remaining_amount\""},
+	Cost:                 whereHelperfloat64{field: "\"order_detail\".\"cost\""},
+	Fee:                  whereHelperfloat64{field: "\"order_detail\".\"fee\""},
+	Leverage:             whereHelperfloat64{field: "\"order_detail\".\"leverage\""},
+	CreatedAt:            whereHelperstring{field: "\"order_detail\".\"created_at\""},
+	UpdatedAt:            whereHelperstring{field: "\"order_detail\".\"updated_at\""},
+}
+
+// OrderDetailRels is where relationship names are stored.
+var OrderDetailRels = struct {
+}{}
+
+// orderDetailR is where relationships are stored.
+type orderDetailR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*orderDetailR) NewStruct() *orderDetailR {
+	return &orderDetailR{}
+}
+
+// orderDetailL is where Load methods for each relationship are stored.
+type orderDetailL struct{}
+
+var (
+	orderDetailAllColumns            = []string{"id", "exchange_name_id", "order_id", "client_order_id", "account_id", "asset", "base", "quote", "side", "type", "status", "price", "amount", "trigger_price", "average_executed_price", "executed_amount", "remaining_amount", "cost", "fee", "leverage", "created_at", "updated_at"}
+	orderDetailColumnsWithoutDefault = []string{"id", "exchange_name_id", "order_id", "client_order_id", "account_id", "asset", "base", "quote", "side", "type", "status", "price", "amount", "trigger_price", "average_executed_price", "executed_amount", "remaining_amount", "cost", "fee", "leverage", "created_at", "updated_at"}
+	orderDetailColumnsWithDefault    = []string{}
+	orderDetailPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// OrderDetailSlice is an alias for a slice of pointers to OrderDetail.
+	// This should generally be used opposed to []OrderDetail.
+	OrderDetailSlice []*OrderDetail
+	// OrderDetailHook is the signature for custom OrderDetail hook methods
+	OrderDetailHook func(context.Context, boil.ContextExecutor, *OrderDetail) error
+
+	orderDetailQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	orderDetailType                 = reflect.TypeOf(&OrderDetail{})
+	orderDetailMapping              = queries.MakeStructMapping(orderDetailType)
+	orderDetailPrimaryKeyMapping, _ = queries.BindMapping(orderDetailType, orderDetailMapping, orderDetailPrimaryKeyColumns)
+	orderDetailInsertCacheMut       sync.RWMutex
+	orderDetailInsertCache          = make(map[string]insertCache)
+	orderDetailUpdateCacheMut       sync.RWMutex
+	orderDetailUpdateCache          = make(map[string]updateCache)
+	orderDetailUpsertCacheMut       sync.RWMutex
+	orderDetailUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var orderDetailBeforeInsertHooks []OrderDetailHook
+var orderDetailBeforeUpdateHooks []OrderDetailHook
+var orderDetailBeforeDeleteHooks []OrderDetailHook
+var orderDetailBeforeUpsertHooks []OrderDetailHook
+
+var orderDetailAfterInsertHooks []OrderDetailHook
+var orderDetailAfterSelectHooks []OrderDetailHook
+var orderDetailAfterUpdateHooks []OrderDetailHook
+var orderDetailAfterDeleteHooks []OrderDetailHook
+var orderDetailAfterUpsertHooks []OrderDetailHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *OrderDetail) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderDetailBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *OrderDetail) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderDetailBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *OrderDetail) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderDetailBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *OrderDetail) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderDetailBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *OrderDetail) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderDetailAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *OrderDetail) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderDetailAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *OrderDetail) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderDetailAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *OrderDetail) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderDetailAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *OrderDetail) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range orderDetailAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddOrderDetailHook registers your hook function for all future operations.
+func AddOrderDetailHook(hookPoint boil.HookPoint, orderDetailHook OrderDetailHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		orderDetailBeforeInsertHooks = append(orderDetailBeforeInsertHooks, orderDetailHook)
+	case boil.BeforeUpdateHook:
+		orderDetailBeforeUpdateHooks = append(orderDetailBeforeUpdateHooks, orderDetailHook)
+	case boil.BeforeDeleteHook:
+		orderDetailBeforeDeleteHooks = append(orderDetailBeforeDeleteHooks, orderDetailHook)
+	case boil.BeforeUpsertHook:
+		orderDetailBeforeUpsertHooks = append(orderDetailBeforeUpsertHooks, orderDetailHook)
+	case boil.AfterInsertHook:
+		orderDetailAfterInsertHooks = append(orderDetailAfterInsertHooks, orderDetailHook)
+	case boil.AfterSelectHook:
+		orderDetailAfterSelectHooks = append(orderDetailAfterSelectHooks, orderDetailHook)
+	case boil.AfterUpdateHook:
+		orderDetailAfterUpdateHooks = append(orderDetailAfterUpdateHooks, orderDetailHook)
+	case boil.AfterDeleteHook:
+		orderDetailAfterDeleteHooks = append(orderDetailAfterDeleteHooks, orderDetailHook)
+	case boil.AfterUpsertHook:
+		orderDetailAfterUpsertHooks = append(orderDetailAfterUpsertHooks, orderDetailHook)
+	}
+}
+
+// One returns a single order_detail record from the query.
+func (q orderDetailQuery) One(ctx context.Context, exec boil.ContextExecutor) (*OrderDetail, error) {
+	o := &OrderDetail{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: failed to execute a one query for order_detail")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all OrderDetail records from the query.
+func (q orderDetailQuery) All(ctx context.Context, exec boil.ContextExecutor) (OrderDetailSlice, error) {
+	var o []*OrderDetail
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "sqlite3: failed to assign all query results to OrderDetail slice")
+	}
+
+	if len(orderDetailAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all OrderDetail records in the query.
+func (q orderDetailQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to count order_detail rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q orderDetailQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: failed to check if order_detail exists")
+	}
+
+	return count > 0, nil
+}
+
+// OrderDetails retrieves all the records using an executor.
+func OrderDetails(mods ...qm.QueryMod) orderDetailQuery {
+	mods = append(mods, qm.From("\"order_detail\""))
+	return orderDetailQuery{NewQuery(mods...)}
+}
+
+// FindOrderDetail retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOrderDetail(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*OrderDetail, error) {
+	orderDetailObj := &OrderDetail{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"order_detail\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, orderDetailObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: unable to select from order_detail")
+	}
+
+	return orderDetailObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *OrderDetail) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlite3: no order_detail provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(orderDetailColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	orderDetailInsertCacheMut.RLock()
+	cache, cached := orderDetailInsertCache[key]
+	orderDetailInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			orderDetailAllColumns,
+			orderDetailColumnsWithDefault,
+			orderDetailColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(orderDetailType, orderDetailMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(orderDetailType, orderDetailMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"order_detail\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"order_detail\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"order_detail\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, orderDetailPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to insert into order_detail")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to populate default values for order_detail")
+	}
+
+CacheNoHooks:
+	if !cached {
+		orderDetailInsertCacheMut.Lock()
+		orderDetailInsertCache[key] = cache
+		orderDetailInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the OrderDetail.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *OrderDetail) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	orderDetailUpdateCacheMut.RLock()
+	cache, cached := orderDetailUpdateCache[key]
+	orderDetailUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			orderDetailAllColumns,
+			orderDetailPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("sqlite3: unable to update order_detail, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"order_detail\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, orderDetailPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(orderDetailType, orderDetailMapping, append(wl, orderDetailPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update order_detail row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by update for order_detail")
+	}
+
+	if !cached {
+		orderDetailUpdateCacheMut.Lock()
+		orderDetailUpdateCache[key] = cache
+		orderDetailUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q orderDetailQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all for order_detail")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected for order_detail")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OrderDetailSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("sqlite3: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), orderDetailPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"order_detail\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, orderDetailPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all in orderDetail slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected all in update all orderDetail")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single OrderDetail record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *OrderDetail) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("sqlite3: no OrderDetail provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), orderDetailPrimaryKeyMapping)
+	sql := "DELETE FROM \"order_detail\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete from order_detail")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by delete for order_detail")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q orderDetailQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("sqlite3: no orderDetailQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from order_detail")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for order_detail")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OrderDetailSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(orderDetailBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), orderDetailPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"order_detail\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, orderDetailPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from orderDetail slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for order_detail")
+	}
+
+	if len(orderDetailAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *OrderDetail) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindOrderDetail(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OrderDetailSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OrderDetailSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), orderDetailPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"order_detail\".* FROM \"order_detail\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, orderDetailPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to reload all in OrderDetailSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OrderDetailExists checks if the OrderDetail row exists.
+func OrderDetailExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"order_detail\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: unable to check if order_detail exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,684 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testOrderDetails(t *testing.T) {
+	t.Parallel()
+
+	query := OrderDetails()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testOrderDetailsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderDetail{}
+	if err = randomize.Struct(seed, o, orderDetailDBTypes, true, orderDetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderDetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OrderDetails().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOrderDetailsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderDetail{}
+	if err = randomize.Struct(seed, o, orderDetailDBTypes, true, orderDetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderDetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := OrderDetails().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OrderDetails().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOrderDetailsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderDetail{}
+	if err = randomize.Struct(seed, o, orderDetailDBTypes, true, orderDetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderDetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OrderDetailSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OrderDetails().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOrderDetailsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderDetail{}
+	if err = randomize.Struct(seed, o, orderDetailDBTypes, true, orderDetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderDetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := OrderDetailExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if OrderDetail exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected OrderDetailExists to return true, but got false.")
+	}
+}
+
+func testOrderDetailsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderDetail{}
+	if err = randomize.Struct(seed, o, orderDetailDBTypes, true, orderDetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderDetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	orderDetailFound, err := FindOrderDetail(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if orderDetailFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testOrderDetailsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderDetail{}
+	if err = randomize.Struct(seed, o, orderDetailDBTypes, true, orderDetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderDetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = OrderDetails().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOrderDetailsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderDetail{}
+	if err = randomize.Struct(seed, o, orderDetailDBTypes, true, orderDetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderDetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := OrderDetails().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testOrderDetailsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	orderDetailOne := &OrderDetail{}
+	orderDetailTwo := &OrderDetail{}
+	if err = randomize.Struct(seed, orderDetailOne, orderDetailDBTypes, false, orderDetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderDetail struct: %s", err)
+	}
+	if err = randomize.Struct(seed, orderDetailTwo, orderDetailDBTypes, false, orderDetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderDetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = orderDetailOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = orderDetailTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OrderDetails().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testOrderDetailsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	orderDetailOne := &OrderDetail{}
+	orderDetailTwo := &OrderDetail{}
+	if err = randomize.Struct(seed, orderDetailOne, orderDetailDBTypes, false, orderDetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderDetail struct: %s", err)
+	}
+	if err = randomize.Struct(seed, orderDetailTwo, orderDetailDBTypes, false, orderDetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderDetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = orderDetailOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = orderDetailTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrderDetails().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func orderDetailBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *OrderDetail) error {
+	*o = OrderDetail{}
+	return nil
+}
+
+func orderDetailAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *OrderDetail) error {
+	*o = OrderDetail{}
+	return nil
+}
+
+func orderDetailAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *OrderDetail) error {
+	*o = OrderDetail{}
+	return nil
+}
+
+func orderDetailBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *OrderDetail) error {
+	*o = OrderDetail{}
+	return nil
+}
+
+func orderDetailAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *OrderDetail) error {
+	*o = OrderDetail{}
+	return nil
+}
+
+func orderDetailBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *OrderDetail) error {
+	*o = OrderDetail{}
+	return nil
+}
+
+func orderDetailAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *OrderDetail) error {
+	*o = OrderDetail{}
+	return nil
+}
+
+func orderDetailBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *OrderDetail) error {
+	*o = OrderDetail{}
+	return nil
+}
+
+func orderDetailAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *OrderDetail) error {
+	*o = OrderDetail{}
+	return nil
+}
+
+func testOrderDetailsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &OrderDetail{}
+	o := &OrderDetail{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, orderDetailDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize OrderDetail object: %s", err)
+	}
+
+	AddOrderDetailHook(boil.BeforeInsertHook, orderDetailBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	orderDetailBeforeInsertHooks = []OrderDetailHook{}
+
+	AddOrderDetailHook(boil.AfterInsertHook, orderDetailAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	orderDetailAfterInsertHooks = []OrderDetailHook{}
+
+	AddOrderDetailHook(boil.AfterSelectHook, orderDetailAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	orderDetailAfterSelectHooks = []OrderDetailHook{}
+
+	AddOrderDetailHook(boil.BeforeUpdateHook, orderDetailBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	orderDetailBeforeUpdateHooks = []OrderDetailHook{}
+
+	AddOrderDetailHook(boil.AfterUpdateHook, orderDetailAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	orderDetailAfterUpdateHooks = []OrderDetailHook{}
+
+	AddOrderDetailHook(boil.BeforeDeleteHook, orderDetailBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	orderDetailBeforeDeleteHooks = []OrderDetailHook{}
+
+	AddOrderDetailHook(boil.AfterDeleteHook, orderDetailAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	orderDetailAfterDeleteHooks = []OrderDetailHook{}
+
+	AddOrderDetailHook(boil.BeforeUpsertHook, orderDetailBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	orderDetailBeforeUpsertHooks = []OrderDetailHook{}
+
+	AddOrderDetailHook(boil.AfterUpsertHook, orderDetailAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	orderDetailAfterUpsertHooks = []OrderDetailHook{}
+}
+
+func testOrderDetailsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderDetail{}
+	if err = randomize.Struct(seed, o, orderDetailDBTypes, true, orderDetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderDetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrderDetails().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOrderDetailsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderDetail{}
+	if err = randomize.Struct(seed, o, orderDetailDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OrderDetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(orderDetailColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrderDetails().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOrderDetailsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderDetail{}
+	if err = randomize.Struct(seed, o, orderDetailDBTypes, true, orderDetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderDetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOrderDetailsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderDetail{}
+	if err = randomize.Struct(seed, o, orderDetailDBTypes, true, orderDetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderDetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OrderDetailSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOrderDetailsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderDetail{}
+	if err = randomize.Struct(seed, o, orderDetailDBTypes, true, orderDetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderDetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OrderDetails().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	orderDetailDBTypes = map[string]string{`ID`: `TEXT`, `ExchangeNameID`: `TEXT`, `OrderID`: `TEXT`, `ClientOrderID`: `TEXT`, `AccountID`: `TEXT`, `Asset`: `TEXT`, `Base`: `TEXT`, `Quote`: `TEXT`, `Side`: `TEXT`, `Type`: `TEXT`, `Status`: `TEXT`, `Price`: `REAL`, `Amount`: `REAL`, `TriggerPrice`: `REAL`, `AverageExecutedPrice`: `REAL`, `ExecutedAmount`: `REAL`, `RemainingAmount`: `REAL`, `Cost`: `REAL`, `Fee`: `REAL`, `Leverage`: `REAL`, `CreatedAt`: `TIMESTAMP`, `UpdatedAt`: `TIMESTAMP`}
+	_                  = bytes.MinRead
+)
+
+func testOrderDetailsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(orderDetailPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(orderDetailAllColumns) == len(orderDetailPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderDetail{}
+	if err = randomize.Struct(seed, o, orderDetailDBTypes, true, orderDetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderDetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrderDetails().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, orderDetailDBTypes, true, orderDetailPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OrderDetail struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testOrderDetailsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(orderDetailAllColumns) == len(orderDetailPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderDetail{}
+	if err = randomize.Struct(seed, o, orderDetailDBTypes, true, orderDetailColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderDetail struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrderDetails().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, orderDetailDBTypes, true, orderDetailPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OrderDetail struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(orderDetailAllColumns, orderDetailPrimaryKeyColumns) {
+		fields = orderDetailAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			orderDetailAllColumns,
+			orderDetailPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := OrderDetailSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
@@ -0,0 +1,684 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testOrderbookDeltas(t *testing.T) {
+	t.Parallel()
+
+	query := OrderbookDeltas()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testOrderbookDeltasDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderbookDelta{}
+	if err = randomize.Struct(seed, o, orderbookDeltaDBTypes, true, orderbookDeltaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookDelta struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OrderbookDeltas().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOrderbookDeltasQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderbookDelta{}
+	if err = randomize.Struct(seed, o, orderbookDeltaDBTypes, true, orderbookDeltaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookDelta struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := OrderbookDeltas().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OrderbookDeltas().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOrderbookDeltasSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderbookDelta{}
+	if err = randomize.Struct(seed, o, orderbookDeltaDBTypes, true, orderbookDeltaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookDelta struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OrderbookDeltaSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OrderbookDeltas().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOrderbookDeltasExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderbookDelta{}
+	if err = randomize.Struct(seed, o, orderbookDeltaDBTypes, true, orderbookDeltaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookDelta struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := OrderbookDeltaExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if OrderbookDelta exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected OrderbookDeltaExists to return true, but got false.")
+	}
+}
+
+func testOrderbookDeltasFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderbookDelta{}
+	if err = randomize.Struct(seed, o, orderbookDeltaDBTypes, true, orderbookDeltaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookDelta struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	orderbookDeltaFound, err := FindOrderbookDelta(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if orderbookDeltaFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testOrderbookDeltasBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderbookDelta{}
+	if err = randomize.Struct(seed, o, orderbookDeltaDBTypes, true, orderbookDeltaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookDelta struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = OrderbookDeltas().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOrderbookDeltasOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderbookDelta{}
+	if err = randomize.Struct(seed, o, orderbookDeltaDBTypes, true, orderbookDeltaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookDelta struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := OrderbookDeltas().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testOrderbookDeltasAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	orderbookDeltaOne := &OrderbookDelta{}
+	orderbookDeltaTwo := &OrderbookDelta{}
+	if err = randomize.Struct(seed, orderbookDeltaOne, orderbookDeltaDBTypes, false, orderbookDeltaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookDelta struct: %s", err)
+	}
+	if err = randomize.Struct(seed, orderbookDeltaTwo, orderbookDeltaDBTypes, false, orderbookDeltaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookDelta struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = orderbookDeltaOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = orderbookDeltaTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OrderbookDeltas().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testOrderbookDeltasCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	orderbookDeltaOne := &OrderbookDelta{}
+	orderbookDeltaTwo := &OrderbookDelta{}
+	if err = randomize.Struct(seed, orderbookDeltaOne, orderbookDeltaDBTypes, false, orderbookDeltaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookDelta struct: %s", err)
+	}
+	if err = randomize.Struct(seed, orderbookDeltaTwo, orderbookDeltaDBTypes, false, orderbookDeltaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookDelta struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = orderbookDeltaOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = orderbookDeltaTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrderbookDeltas().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func orderbookDeltaBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *OrderbookDelta) error {
+	*o = OrderbookDelta{}
+	return nil
+}
+
+func orderbookDeltaAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *OrderbookDelta) error {
+	*o = OrderbookDelta{}
+	return nil
+}
+
+func orderbookDeltaAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *OrderbookDelta) error {
+	*o = OrderbookDelta{}
+	return nil
+}
+
+func orderbookDeltaBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *OrderbookDelta) error {
+	*o = OrderbookDelta{}
+	return nil
+}
+
+func orderbookDeltaAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *OrderbookDelta) error {
+	*o = OrderbookDelta{}
+	return nil
+}
+
+func orderbookDeltaBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *OrderbookDelta) error {
+	*o = OrderbookDelta{}
+	return nil
+}
+
+func orderbookDeltaAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *OrderbookDelta) error {
+	*o = OrderbookDelta{}
+	return nil
+}
+
+func orderbookDeltaBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *OrderbookDelta) error {
+	*o = OrderbookDelta{}
+	return nil
+}
+
+func orderbookDeltaAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *OrderbookDelta) error {
+	*o = OrderbookDelta{}
+	return nil
+}
+
+func testOrderbookDeltasHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &OrderbookDelta{}
+	o := &OrderbookDelta{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, orderbookDeltaDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize OrderbookDelta object: %s", err)
+	}
+
+	AddOrderbookDeltaHook(boil.BeforeInsertHook, orderbookDeltaBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	orderbookDeltaBeforeInsertHooks = []OrderbookDeltaHook{}
+
+	AddOrderbookDeltaHook(boil.AfterInsertHook, orderbookDeltaAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	orderbookDeltaAfterInsertHooks = []OrderbookDeltaHook{}
+
+	AddOrderbookDeltaHook(boil.AfterSelectHook, orderbookDeltaAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	orderbookDeltaAfterSelectHooks = []OrderbookDeltaHook{}
+
+	AddOrderbookDeltaHook(boil.BeforeUpdateHook, orderbookDeltaBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	orderbookDeltaBeforeUpdateHooks = []OrderbookDeltaHook{}
+
+	AddOrderbookDeltaHook(boil.AfterUpdateHook, orderbookDeltaAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	orderbookDeltaAfterUpdateHooks = []OrderbookDeltaHook{}
+
+	AddOrderbookDeltaHook(boil.BeforeDeleteHook, orderbookDeltaBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	orderbookDeltaBeforeDeleteHooks = []OrderbookDeltaHook{}
+
+	AddOrderbookDeltaHook(boil.AfterDeleteHook, orderbookDeltaAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	orderbookDeltaAfterDeleteHooks = []OrderbookDeltaHook{}
+
+	AddOrderbookDeltaHook(boil.BeforeUpsertHook, orderbookDeltaBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	orderbookDeltaBeforeUpsertHooks = []OrderbookDeltaHook{}
+
+	AddOrderbookDeltaHook(boil.AfterUpsertHook, orderbookDeltaAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	orderbookDeltaAfterUpsertHooks = []OrderbookDeltaHook{}
+}
+
+func testOrderbookDeltasInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderbookDelta{}
+	if err = randomize.Struct(seed, o, orderbookDeltaDBTypes, true, orderbookDeltaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookDelta struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrderbookDeltas().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOrderbookDeltasInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderbookDelta{}
+	if err = randomize.Struct(seed, o, orderbookDeltaDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OrderbookDelta struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(orderbookDeltaColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrderbookDeltas().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOrderbookDeltasReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderbookDelta{}
+	if err = randomize.Struct(seed, o, orderbookDeltaDBTypes, true, orderbookDeltaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookDelta struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOrderbookDeltasReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderbookDelta{}
+	if err = randomize.Struct(seed, o, orderbookDeltaDBTypes, true, orderbookDeltaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookDelta struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OrderbookDeltaSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOrderbookDeltasSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderbookDelta{}
+	if err = randomize.Struct(seed, o, orderbookDeltaDBTypes, true, orderbookDeltaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookDelta struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OrderbookDeltas().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	orderbookDeltaDBTypes = map[string]string{`ID`: `TEXT`, `ExchangeNameID`: `TEXT`, `Asset`: `TEXT`, `Base`: `TEXT`, `Quote`: `TEXT`, `Timestamp`: `TIMESTAMP`, `Sequence`: `INTEGER`, `UpdateID`: `INTEGER`, `Action`: `TEXT`, `MaxDepth`: `INTEGER`, `Bids`: `TEXT`, `Asks`: `TEXT`}
+	_                     = bytes.MinRead
+)
+
+func testOrderbookDeltasUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(orderbookDeltaPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(orderbookDeltaAllColumns) == len(orderbookDeltaPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderbookDelta{}
+	if err = randomize.Struct(seed, o, orderbookDeltaDBTypes, true, orderbookDeltaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookDelta struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrderbookDeltas().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, orderbookDeltaDBTypes, true, orderbookDeltaPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OrderbookDelta struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testOrderbookDeltasSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(orderbookDeltaAllColumns) == len(orderbookDeltaPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OrderbookDelta{}
+	if err = randomize.Struct(seed, o, orderbookDeltaDBTypes, true, orderbookDeltaColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OrderbookDelta struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OrderbookDeltas().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, orderbookDeltaDBTypes, true, orderbookDeltaPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OrderbookDelta struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(orderbookDeltaAllColumns, orderbookDeltaPrimaryKeyColumns) {
+		fields = orderbookDeltaAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			orderbookDeltaAllColumns,
+			orderbookDeltaPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := OrderbookDeltaSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
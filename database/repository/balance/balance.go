@@ -0,0 +1,529 @@
+package balance
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// InsertSnapshots saves balance snapshots to the database. A snapshot for the
+// same account currency and timestamp replaces the existing one
+func InsertSnapshots(snapshots ...Snapshot) error {
+	for i := range snapshots {
+		if snapshots[i].Currency == "" {
+			return errCurrencyUnset
+		}
+		id, err := exchangeNameID(snapshots[i].Exchange, snapshots[i].ExchangeNameID)
+		if err != nil {
+			return err
+		}
+		snapshots[i].ExchangeNameID = id
+	}
+
+	ctx := context.Background()
+	ctx = boil.SkipTimestamps(ctx)
+
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "InsertSnapshots tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		err = insertSnapshotsSQLite(ctx, tx, snapshots...)
+	} else {
+		err = insertSnapshotsPostgres(ctx, tx, snapshots...)
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// InsertChanges saves balance changes to the database
+func InsertChanges(changes ...Change) error {
+	for i := range changes {
+		if changes[i].Currency == "" {
+			return errCurrencyUnset
+		}
+		id, err := exchangeNameID(changes[i].Exchange, changes[i].ExchangeNameID)
+		if err != nil {
+			return err
+		}
+		changes[i].ExchangeNameID = id
+	}
+
+	ctx := context.Background()
+	ctx = boil.SkipTimestamps(ctx)
+
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "InsertChanges tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		err = insertChangesSQLite(ctx, tx, changes...)
+	} else {
+		err = insertChangesPostgres(ctx, tx, changes...)
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetSnapshotsInRange returns all balance snapshots in a date range ordered by
+// timestamp. An empty exchange name returns snapshots for all exchanges
+func GetSnapshotsInRange(exchangeName string, startDate, endDate time.Time) ([]Snapshot, error) {
+	if endDate.Before(startDate) {
+		return nil, errInvalidDateRange
+	}
+	wheres, err := generateQuery(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	var snapshots []Snapshot
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		wheres = append(wheres,
+			qm.Where("timestamp BETWEEN ? AND ?", startDate.UTC().Format(sqliteTimeFormat), endDate.UTC().Format(sqliteTimeFormat)),
+			qm.OrderBy("timestamp"))
+		snapshots, err = getSnapshotsSQLite(wheres...)
+	} else {
+		wheres = append(wheres,
+			qm.Where("timestamp BETWEEN ? AND ?", startDate.UTC(), endDate.UTC()),
+			qm.OrderBy("timestamp"))
+		snapshots, err = getSnapshotsPostgres(wheres...)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("balance.GetSnapshotsInRange %w", err)
+	}
+	return snapshots, nil
+}
+
+// GetChangesInRange returns all balance changes in a date range ordered by
+// timestamp. An empty exchange name returns changes for all exchanges
+func GetChangesInRange(exchangeName string, startDate, endDate time.Time) ([]Change, error) {
+	if endDate.Before(startDate) {
+		return nil, errInvalidDateRange
+	}
+	wheres, err := generateQuery(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	var changes []Change
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		wheres = append(wheres,
+			qm.Where("timestamp BETWEEN ? AND ?", startDate.UTC().Format(sqliteTimeFormat), endDate.UTC().Format(sqliteTimeFormat)),
+			qm.OrderBy("timestamp"))
+		changes, err = getChangesSQLite(wheres...)
+	} else {
+		wheres = append(wheres,
+			qm.Where("timestamp BETWEEN ? AND ?", startDate.UTC(), endDate.UTC()),
+			qm.OrderBy("timestamp"))
+		changes, err = getChangesPostgres(wheres...)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("balance.GetChangesInRange %w", err)
+	}
+	return changes, nil
+}
+
+// EquityCurve returns the total fiat value of all balances at every snapshot
+// timestamp. Snapshots of an exchange sharing a timestamp replace its previous
+// balances, so exchanges recorded at different times are each valued at their
+// latest snapshot
+func EquityCurve(snapshots []Snapshot) []EquityPoint {
+	var curve []EquityPoint
+	walkCycles(snapshots, func(ts time.Time, latest map[string][]Snapshot) {
+		point := EquityPoint{Timestamp: ts}
+		for _, balances := range latest {
+			for i := range balances {
+				point.Value += balances[i].Total * balances[i].FiatRate
+			}
+		}
+		curve = append(curve, point)
+	})
+	return curve
+}
+
+// CurrencyHistory returns the combined balance of a currency across all
+// supplied snapshots at every snapshot timestamp
+func CurrencyHistory(snapshots []Snapshot, code string) []CurrencyPoint {
+	var history []CurrencyPoint
+	walkCycles(snapshots, func(ts time.Time, latest map[string][]Snapshot) {
+		point := CurrencyPoint{Timestamp: ts}
+		for _, balances := range latest {
+			for i := range balances {
+				if !strings.EqualFold(balances[i].Currency, code) {
+					continue
+				}
+				point.Total += balances[i].Total
+				point.Hold += balances[i].Hold
+				point.Value += balances[i].Total * balances[i].FiatRate
+			}
+		}
+		history = append(history, point)
+	})
+	return history
+}
+
+// CalculateDailyPNL returns the change in equity for every UTC day of an
+// equity curve ordered by timestamp
+func CalculateDailyPNL(curve []EquityPoint) []DailyPNL {
+	var days []DailyPNL
+	for i := range curve {
+		date := curve[i].Timestamp.UTC().Truncate(time.Hour * 24)
+		if len(days) == 0 || !days[len(days)-1].Date.Equal(date) {
+			open := curve[i].Value
+			if len(days) > 0 {
+				open = days[len(days)-1].Close
+			}
+			days = append(days, DailyPNL{Date: date, Open: open})
+		}
+		days[len(days)-1].Close = curve[i].Value
+	}
+	for i := range days {
+		days[i].PNL = days[i].Close - days[i].Open
+		if days[i].Open != 0 {
+			days[i].PNLPercentage = days[i].PNL / days[i].Open * 100
+		}
+	}
+	return days
+}
+
+// walkCycles calls fn for every distinct snapshot timestamp with the latest
+// balances recorded for each exchange at or before it. All snapshots of an
+// exchange sharing a timestamp replace its previous balances, so currencies
+// which are no longer held drop out
+func walkCycles(snapshots []Snapshot, fn func(time.Time, map[string][]Snapshot)) {
+	sorted := make([]Snapshot, len(snapshots))
+	copy(sorted, snapshots)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Timestamp.Before(sorted[j].Timestamp)
+	})
+	latest := make(map[string][]Snapshot)
+	for i := 0; i < len(sorted); {
+		ts := sorted[i].Timestamp
+		cycle := make(map[string][]Snapshot)
+		for ; i < len(sorted) && sorted[i].Timestamp.Equal(ts); i++ {
+			name := strings.ToLower(sorted[i].Exchange)
+			cycle[name] = append(cycle[name], sorted[i])
+		}
+		for name, balances := range cycle {
+			latest[name] = balances
+		}
+		fn(ts, latest)
+	}
+}
+
+func exchangeNameID(exchangeName, exchangeNameID string) (string, error) {
+	if exchangeNameID != "" {
+		return exchangeNameID, nil
+	}
+	if exchangeName == "" {
+		return "", errExchangeNameUnset
+	}
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return "", err
+	}
+	return exchangeUUID.String(), nil
+}
+
+// exchangeNames looks up and caches exchange names by their database IDs
+type exchangeNames map[string]string
+
+func (e exchangeNames) get(id string) (string, error) {
+	if name, ok := e[id]; ok {
+		return name, nil
+	}
+	exchangeUUID, err := uuid.FromString(id)
+	if err != nil {
+		return "", err
+	}
+	details, err := exchange.OneByUUID(exchangeUUID)
+	if err != nil {
+		return "", err
+	}
+	e[id] = strings.ToLower(details.Name)
+	return e[id], nil
+}
+
+func insertSnapshotsSQLite(ctx context.Context, tx *sql.Tx, snapshots ...Snapshot) error {
+	for i := range snapshots {
+		if snapshots[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			snapshots[i].ID = freshUUID.String()
+		}
+		var tempEvent = sqlite3.BalanceSnapshot{
+			ID:             snapshots[i].ID,
+			ExchangeNameID: snapshots[i].ExchangeNameID,
+			Account:        snapshots[i].Account,
+			Asset:          strings.ToLower(snapshots[i].Asset),
+			Currency:       strings.ToUpper(snapshots[i].Currency),
+			Total:          snapshots[i].Total,
+			Hold:           snapshots[i].Hold,
+			FiatCurrency:   strings.ToUpper(snapshots[i].FiatCurrency),
+			FiatRate:       snapshots[i].FiatRate,
+			Timestamp:      snapshots[i].Timestamp.UTC().Format(sqliteTimeFormat),
+		}
+		err := tempEvent.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertSnapshotsPostgres(ctx context.Context, tx *sql.Tx, snapshots ...Snapshot) error {
+	for i := range snapshots {
+		if snapshots[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			snapshots[i].ID = freshUUID.String()
+		}
+		var tempEvent = postgres.BalanceSnapshot{
+			ID:             snapshots[i].ID,
+			ExchangeNameID: snapshots[i].ExchangeNameID,
+			Account:        snapshots[i].Account,
+			Asset:          strings.ToLower(snapshots[i].Asset),
+			Currency:       strings.ToUpper(snapshots[i].Currency),
+			Total:          snapshots[i].Total,
+			Hold:           snapshots[i].Hold,
+			FiatCurrency:   strings.ToUpper(snapshots[i].FiatCurrency),
+			FiatRate:       snapshots[i].FiatRate,
+			Timestamp:      snapshots[i].Timestamp.UTC(),
+		}
+		err := tempEvent.Upsert(ctx, tx, true, []string{"exchange_name_id", "account", "asset", "currency", "timestamp"}, boil.Whitelist("total", "hold", "fiat_currency", "fiat_rate"), boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertChangesSQLite(ctx context.Context, tx *sql.Tx, changes ...Change) error {
+	for i := range changes {
+		if changes[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			changes[i].ID = freshUUID.String()
+		}
+		var tempEvent = sqlite3.BalanceChange{
+			ID:             changes[i].ID,
+			ExchangeNameID: changes[i].ExchangeNameID,
+			Account:        changes[i].Account,
+			Asset:          strings.ToLower(changes[i].Asset),
+			Currency:       strings.ToUpper(changes[i].Currency),
+			Amount:         changes[i].Amount,
+			FiatCurrency:   strings.ToUpper(changes[i].FiatCurrency),
+			FiatRate:       changes[i].FiatRate,
+			Timestamp:      changes[i].Timestamp.UTC().Format(sqliteTimeFormat),
+		}
+		err := tempEvent.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertChangesPostgres(ctx context.Context, tx *sql.Tx, changes ...Change) error {
+	for i := range changes {
+		if changes[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			changes[i].ID = freshUUID.String()
+		}
+		var tempEvent = postgres.BalanceChange{
+			ID:             changes[i].ID,
+			ExchangeNameID: changes[i].ExchangeNameID,
+			Account:        changes[i].Account,
+			Asset:          strings.ToLower(changes[i].Asset),
+			Currency:       strings.ToUpper(changes[i].Currency),
+			Amount:         changes[i].Amount,
+			FiatCurrency:   strings.ToUpper(changes[i].FiatCurrency),
+			FiatRate:       changes[i].FiatRate,
+			Timestamp:      changes[i].Timestamp.UTC(),
+		}
+		err := tempEvent.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func getSnapshotsSQLite(mods ...qm.QueryMod) ([]Snapshot, error) {
+	results, err := sqlite3.BalanceSnapshots(mods...).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	names := make(exchangeNames)
+	snapshots := make([]Snapshot, len(results))
+	for i := range results {
+		ts, err := time.Parse(time.RFC3339Nano, results[i].Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		name, err := names.get(results[i].ExchangeNameID)
+		if err != nil {
+			return nil, err
+		}
+		snapshots[i] = Snapshot{
+			ID:             results[i].ID,
+			Exchange:       name,
+			ExchangeNameID: results[i].ExchangeNameID,
+			Account:        results[i].Account,
+			Asset:          results[i].Asset,
+			Currency:       results[i].Currency,
+			Total:          results[i].Total,
+			Hold:           results[i].Hold,
+			FiatCurrency:   results[i].FiatCurrency,
+			FiatRate:       results[i].FiatRate,
+			Timestamp:      ts.UTC(),
+		}
+	}
+	return snapshots, nil
+}
+
+func getSnapshotsPostgres(mods ...qm.QueryMod) ([]Snapshot, error) {
+	results, err := postgres.BalanceSnapshots(mods...).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	names := make(exchangeNames)
+	snapshots := make([]Snapshot, len(results))
+	for i := range results {
+		name, err := names.get(results[i].ExchangeNameID)
+		if err != nil {
+			return nil, err
+		}
+		snapshots[i] = Snapshot{
+			ID:             results[i].ID,
+			Exchange:       name,
+			ExchangeNameID: results[i].ExchangeNameID,
+			Account:        results[i].Account,
+			Asset:          results[i].Asset,
+			Currency:       results[i].Currency,
+			Total:          results[i].Total,
+			Hold:           results[i].Hold,
+			FiatCurrency:   results[i].FiatCurrency,
+			FiatRate:       results[i].FiatRate,
+			Timestamp:      results[i].Timestamp.UTC(),
+		}
+	}
+	return snapshots, nil
+}
+
+func getChangesSQLite(mods ...qm.QueryMod) ([]Change, error) {
+	results, err := sqlite3.BalanceChanges(mods...).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	names := make(exchangeNames)
+	changes := make([]Change, len(results))
+	for i := range results {
+		ts, err := time.Parse(time.RFC3339Nano, results[i].Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		name, err := names.get(results[i].ExchangeNameID)
+		if err != nil {
+			return nil, err
+		}
+		changes[i] = Change{
+			ID:             results[i].ID,
+			Exchange:       name,
+			ExchangeNameID: results[i].ExchangeNameID,
+			Account:        results[i].Account,
+			Asset:          results[i].Asset,
+			Currency:       results[i].Currency,
+			Amount:         results[i].Amount,
+			FiatCurrency:   results[i].FiatCurrency,
+			FiatRate:       results[i].FiatRate,
+			Timestamp:      ts.UTC(),
+		}
+	}
+	return changes, nil
+}
+
+func getChangesPostgres(mods ...qm.QueryMod) ([]Change, error) {
+	results, err := postgres.BalanceChanges(mods...).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	names := make(exchangeNames)
+	changes := make([]Change, len(results))
+	for i := range results {
+		name, err := names.get(results[i].ExchangeNameID)
+		if err != nil {
+			return nil, err
+		}
+		changes[i] = Change{
+			ID:             results[i].ID,
+			Exchange:       name,
+			ExchangeNameID: results[i].ExchangeNameID,
+			Account:        results[i].Account,
+			Asset:          results[i].Asset,
+			Currency:       results[i].Currency,
+			Amount:         results[i].Amount,
+			FiatCurrency:   results[i].FiatCurrency,
+			FiatRate:       results[i].FiatRate,
+			Timestamp:      results[i].Timestamp.UTC(),
+		}
+	}
+	return changes, nil
+}
+
+func generateQuery(exchangeName string) ([]qm.QueryMod, error) {
+	if exchangeName == "" {
+		return nil, nil
+	}
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	return []qm.QueryMod{
+		qm.Where("exchange_name_id = ?", exchangeUUID.String()),
+	}, nil
+}
@@ -0,0 +1,269 @@
+package balance
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+		{
+			Name: "two",
+		},
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+	os.Exit(t)
+}
+
+func TestBalances(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		seedDB func() error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if test.seedDB != nil {
+				err = test.seedDB()
+				if err != nil {
+					t.Error(err)
+				}
+			}
+
+			balanceSQLTester(t)
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func balanceSQLTester(t *testing.T) {
+	t.Helper()
+	firstTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	snapshots := []Snapshot{
+		{
+			Exchange:     testExchanges[0].Name,
+			Asset:        asset.Spot.String(),
+			Currency:     currency.BTC.String(),
+			Total:        1,
+			Hold:         0.5,
+			FiatCurrency: currency.USD.String(),
+			FiatRate:     40000,
+			Timestamp:    firstTime,
+		},
+		{
+			Exchange:     testExchanges[1].Name,
+			Asset:        asset.Spot.String(),
+			Currency:     currency.USDT.String(),
+			Total:        1000,
+			FiatCurrency: currency.USD.String(),
+			FiatRate:     1,
+			Timestamp:    firstTime,
+		},
+		{
+			Exchange:     testExchanges[0].Name,
+			Asset:        asset.Spot.String(),
+			Currency:     currency.BTC.String(),
+			Total:        2,
+			FiatCurrency: currency.USD.String(),
+			FiatRate:     41000,
+			Timestamp:    firstTime.Add(time.Hour),
+		},
+	}
+	err := InsertSnapshots(snapshots...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := GetSnapshotsInRange("", firstTime.Add(-time.Hour), firstTime.Add(time.Hour*2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(resp), 3)
+	}
+	if resp[0].Exchange == "" || resp[2].Total != 2 || !resp[2].Timestamp.Equal(firstTime.Add(time.Hour)) {
+		t.Errorf("received '%+v' expected ordered snapshots", resp)
+	}
+
+	resp, err = GetSnapshotsInRange(testExchanges[1].Name, firstTime.Add(-time.Hour), firstTime.Add(time.Hour*2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 1 || resp[0].Exchange != testExchanges[1].Name || resp[0].Currency != "USDT" {
+		t.Errorf("received '%+v' expected single exchange two snapshot", resp)
+	}
+
+	// replacing a snapshot keeps a single entry per timestamp
+	snapshots[2].ID = ""
+	snapshots[2].Total = 3
+	err = InsertSnapshots(snapshots[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err = GetSnapshotsInRange(testExchanges[0].Name, firstTime.Add(-time.Hour), firstTime.Add(time.Hour*2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 2 || resp[1].Total != 3 {
+		t.Errorf("received '%+v' expected replaced snapshot", resp)
+	}
+
+	err = InsertChanges(Change{
+		Exchange:     testExchanges[0].Name,
+		Account:      "exchange",
+		Asset:        asset.Spot.String(),
+		Currency:     currency.BTC.String(),
+		Amount:       -0.1,
+		FiatCurrency: currency.USD.String(),
+		FiatRate:     40500,
+		Timestamp:    firstTime.Add(time.Minute),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	changes, err := GetChangesInRange("", firstTime, firstTime.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0].Amount != -0.1 || changes[0].Account != "exchange" {
+		t.Errorf("received '%+v' expected single change", changes)
+	}
+
+	_, err = GetChangesInRange("", firstTime, firstTime.Add(-time.Hour))
+	if !errors.Is(err, errInvalidDateRange) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidDateRange)
+	}
+	err = InsertChanges(Change{Exchange: testExchanges[0].Name})
+	if !errors.Is(err, errCurrencyUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errCurrencyUnset)
+	}
+	err = InsertSnapshots(Snapshot{Currency: "BTC"})
+	if !errors.Is(err, errExchangeNameUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errExchangeNameUnset)
+	}
+}
+
+func TestEquityCurve(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	snapshots := []Snapshot{
+		{Exchange: "two", Currency: "USDT", Total: 1000, FiatRate: 1, Timestamp: tt.Add(time.Minute)},
+		{Exchange: "one", Currency: "BTC", Total: 1, FiatRate: 40000, Timestamp: tt},
+		{Exchange: "one", Currency: "ETH", Total: 10, FiatRate: 3000, Timestamp: tt},
+		// ETH is no longer held in the second cycle
+		{Exchange: "one", Currency: "BTC", Total: 2, Hold: 1, FiatRate: 41000, Timestamp: tt.Add(time.Hour)},
+	}
+	curve := EquityCurve(snapshots)
+	expected := []EquityPoint{
+		{Timestamp: tt, Value: 70000},
+		{Timestamp: tt.Add(time.Minute), Value: 71000},
+		{Timestamp: tt.Add(time.Hour), Value: 83000},
+	}
+	if fmt.Sprint(curve) != fmt.Sprint(expected) {
+		t.Errorf("received '%v' expected '%v'", curve, expected)
+	}
+
+	history := CurrencyHistory(snapshots, "btc")
+	if len(history) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(history), 3)
+	}
+	if history[1].Total != 1 || history[2].Total != 2 || history[2].Hold != 1 || history[2].Value != 82000 {
+		t.Errorf("received '%+v' expected btc history", history)
+	}
+
+	if EquityCurve(nil) != nil {
+		t.Error("expected no curve without snapshots")
+	}
+}
+
+func TestCalculateDailyPNL(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2022, 1, 1, 6, 0, 0, 0, time.UTC)
+	days := CalculateDailyPNL([]EquityPoint{
+		{Timestamp: tt, Value: 100},
+		{Timestamp: tt.Add(time.Hour), Value: 110},
+		{Timestamp: tt.Add(time.Hour * 24), Value: 99},
+		{Timestamp: tt.Add(time.Hour * 72), Value: 121},
+	})
+	if len(days) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(days), 3)
+	}
+	if !days[0].Date.Equal(tt.Truncate(time.Hour*24)) || days[0].Open != 100 || days[0].Close != 110 || days[0].PNL != 10 || days[0].PNLPercentage != 10 {
+		t.Errorf("received '%+v' expected first day", days[0])
+	}
+	if days[1].Open != 110 || days[1].PNL != -11 || days[1].PNLPercentage != -10 {
+		t.Errorf("received '%+v' expected second day", days[1])
+	}
+	if days[2].Open != 99 || days[2].PNL != 22 {
+		t.Errorf("received '%+v' expected third day", days[2])
+	}
+}
+
+func seedDB() error {
+	return exchange.InsertMany(testExchanges)
+}
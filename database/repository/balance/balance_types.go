@@ -0,0 +1,73 @@
+package balance
+
+import (
+	"errors"
+	"time"
+)
+
+// sqliteTimeFormat is a fixed width format so that timestamps stored as text
+// are ordered correctly when compared
+const sqliteTimeFormat = "2006-01-02T15:04:05.000000000Z"
+
+var (
+	errExchangeNameUnset = errors.New("exchange name/uuid not set, cannot insert")
+	errCurrencyUnset     = errors.New("currency not set, cannot insert")
+	errInvalidDateRange  = errors.New("invalid date range received")
+)
+
+// Snapshot defines a currency balance held on an exchange account at a point
+// in time. FiatRate values one unit of the currency in FiatCurrency, a zero
+// rate meaning the currency could not be valued when it was recorded
+type Snapshot struct {
+	ID             string
+	Exchange       string
+	ExchangeNameID string
+	Account        string
+	Asset          string
+	Currency       string
+	Total          float64
+	Hold           float64
+	FiatCurrency   string
+	FiatRate       float64
+	Timestamp      time.Time
+}
+
+// Change defines a balance change received for a currency on an exchange
+// account
+type Change struct {
+	ID             string
+	Exchange       string
+	ExchangeNameID string
+	Account        string
+	Asset          string
+	Currency       string
+	Amount         float64
+	FiatCurrency   string
+	FiatRate       float64
+	Timestamp      time.Time
+}
+
+// EquityPoint is the total fiat value of all balances at a point in time
+type EquityPoint struct {
+	Timestamp time.Time
+	Value     float64
+}
+
+// CurrencyPoint is the combined balance of a currency at a point in time
+type CurrencyPoint struct {
+	Timestamp time.Time
+	Total     float64
+	Hold      float64
+	Value     float64
+}
+
+// DailyPNL defines the change in equity over a UTC day. Open is the last
+// equity value of the previous day, or the first value of the day when there
+// is no previous day
+type DailyPNL struct {
+	Date          time.Time
+	Open          float64
+	Close         float64
+	PNL           float64
+	PNLPercentage float64
+}
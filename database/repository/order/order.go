@@ -0,0 +1,368 @@
+package order
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/volatiletech/null"
+)
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, nil
+	}
+	if !db.IsConnected() {
+		return nil, nil
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Upsert inserts or updates orders into the database
+func (db *DBService) Upsert(orders ...*Details) error {
+	if len(orders) == 0 {
+		return nil
+	}
+	for i := range orders {
+		if orders[i] == nil {
+			return errNilOrderDetails
+		}
+		if orders[i].Exchange == "" {
+			return errors.New("exchange name not set, cannot insert")
+		}
+		if orders[i].OrderID == "" {
+			return errors.New("order ID not set, cannot insert")
+		}
+	}
+	ctx := context.Background()
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Upsert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		err = upsertSQLite(ctx, tx, orders...)
+	case database.DBPostgreSQL:
+		err = upsertPostgres(ctx, tx, orders...)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetByExchangeAndID returns an order by its exchange, exchange order ID and asset
+func (db *DBService) GetByExchangeAndID(exchangeName, orderID, asset string) (*Details, error) {
+	var err error
+	var resp []Details
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		resp, err = db.getSQLite(qm.InnerJoin("exchange on exchange.id = order_detail.exchange_name_id"),
+			qm.Where("exchange.name = ? AND order_detail.order_id = ? AND order_detail.asset = ?",
+				strings.ToLower(exchangeName), orderID, strings.ToLower(asset)))
+	case database.DBPostgreSQL:
+		resp, err = db.getPostgres(qm.InnerJoin("exchange on exchange.id = order_detail.exchange_name_id"),
+			qm.Where("exchange.name = ? AND order_detail.order_id = ? AND order_detail.asset = ?",
+				strings.ToLower(exchangeName), orderID, strings.ToLower(asset)))
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(resp) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return &resp[0], nil
+}
+
+// GetOrdersNotInStatus returns all orders which do not match any of the
+// supplied statuses. This is used to load orders that can still change state
+func (db *DBService) GetOrdersNotInStatus(statuses ...string) ([]Details, error) {
+	var mods []qm.QueryMod
+	if len(statuses) > 0 {
+		s := make([]interface{}, len(statuses))
+		for i := range statuses {
+			s[i] = strings.ToUpper(statuses[i])
+		}
+		mods = append(mods, qm.WhereIn("status not in ?", s...))
+	}
+	mods = append(mods, qm.OrderBy("created_at"))
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getSQLite(mods...)
+	case database.DBPostgreSQL:
+		return db.getPostgres(mods...)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+func upsertSQLite(ctx context.Context, tx *sql.Tx, orders ...*Details) error {
+	exchangeIDs := make(map[string]string)
+	for i := range orders {
+		if orders[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			orders[i].ID = freshUUID.String()
+		}
+		lName := strings.ToLower(orders[i].Exchange)
+		exchID, ok := exchangeIDs[lName]
+		if !ok {
+			exch, err := sqlite3.Exchanges(qm.Where("name = ?", lName)).One(ctx, tx)
+			switch {
+			case errors.Is(err, sql.ErrNoRows):
+				var freshUUID uuid.UUID
+				freshUUID, err = uuid.NewV4()
+				if err != nil {
+					return err
+				}
+				exch = &sqlite3.Exchange{ID: freshUUID.String(), Name: lName}
+				err = exch.Insert(ctx, tx, boil.Infer())
+				if err != nil {
+					return fmt.Errorf("could not insert exchange '%v', %w", orders[i].Exchange, err)
+				}
+			case err != nil:
+				return fmt.Errorf("could not retrieve exchange '%v', %w", orders[i].Exchange, err)
+			}
+			exchID = exch.ID
+			exchangeIDs[lName] = exchID
+		}
+		createdAt, updatedAt := orderTimes(orders[i])
+		var tempEvent = sqlite3.OrderDetail{
+			ID:                   orders[i].ID,
+			ExchangeNameID:       exchID,
+			OrderID:              orders[i].OrderID,
+			ClientOrderID:        null.NewString(orders[i].ClientOrderID, orders[i].ClientOrderID != ""),
+			AccountID:            null.NewString(orders[i].AccountID, orders[i].AccountID != ""),
+			Asset:                strings.ToLower(orders[i].Asset),
+			Base:                 strings.ToUpper(orders[i].Base),
+			Quote:                strings.ToUpper(orders[i].Quote),
+			Side:                 strings.ToUpper(orders[i].Side),
+			Type:                 strings.ToUpper(orders[i].Type),
+			Status:               strings.ToUpper(orders[i].Status),
+			Price:                orders[i].Price,
+			Amount:               orders[i].Amount,
+			TriggerPrice:         orders[i].TriggerPrice,
+			AverageExecutedPrice: orders[i].AverageExecutedPrice,
+			ExecutedAmount:       orders[i].ExecutedAmount,
+			RemainingAmount:      orders[i].RemainingAmount,
+			Cost:                 orders[i].Cost,
+			Fee:                  orders[i].Fee,
+			Leverage:             orders[i].Leverage,
+			CreatedAt:            createdAt.Format(time.RFC3339Nano),
+			UpdatedAt:            updatedAt.Format(time.RFC3339Nano),
+		}
+		err := tempEvent.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func upsertPostgres(ctx context.Context, tx *sql.Tx, orders ...*Details) error {
+	exchangeIDs := make(map[string]string)
+	for i := range orders {
+		if orders[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			orders[i].ID = freshUUID.String()
+		}
+		lName := strings.ToLower(orders[i].Exchange)
+		exchID, ok := exchangeIDs[lName]
+		if !ok {
+			exch, err := postgres.Exchanges(qm.Where("name = ?", lName)).One(ctx, tx)
+			switch {
+			case errors.Is(err, sql.ErrNoRows):
+				exch = &postgres.Exchange{Name: lName}
+				err = exch.Insert(ctx, tx, boil.Infer())
+				if err != nil {
+					return fmt.Errorf("could not insert exchange '%v', %w", orders[i].Exchange, err)
+				}
+			case err != nil:
+				return fmt.Errorf("could not retrieve exchange '%v', %w", orders[i].Exchange, err)
+			}
+			exchID = exch.ID
+			exchangeIDs[lName] = exchID
+		}
+		createdAt, updatedAt := orderTimes(orders[i])
+		var tempEvent = postgres.OrderDetail{
+			ID:                   orders[i].ID,
+			ExchangeNameID:       exchID,
+			OrderID:              orders[i].OrderID,
+			ClientOrderID:        null.NewString(orders[i].ClientOrderID, orders[i].ClientOrderID != ""),
+			AccountID:            null.NewString(orders[i].AccountID, orders[i].AccountID != ""),
+			Asset:                strings.ToLower(orders[i].Asset),
+			Base:                 strings.ToUpper(orders[i].Base),
+			Quote:                strings.ToUpper(orders[i].Quote),
+			Side:                 strings.ToUpper(orders[i].Side),
+			Type:                 strings.ToUpper(orders[i].Type),
+			Status:               strings.ToUpper(orders[i].Status),
+			Price:                orders[i].Price,
+			Amount:               orders[i].Amount,
+			TriggerPrice:         orders[i].TriggerPrice,
+			AverageExecutedPrice: orders[i].AverageExecutedPrice,
+			ExecutedAmount:       orders[i].ExecutedAmount,
+			RemainingAmount:      orders[i].RemainingAmount,
+			Cost:                 orders[i].Cost,
+			Fee:                  orders[i].Fee,
+			Leverage:             orders[i].Leverage,
+			CreatedAt:            createdAt,
+			UpdatedAt:            updatedAt,
+		}
+		err := tempEvent.Upsert(ctx, tx, true, []string{"exchange_name_id", "order_id", "asset"}, boil.Infer(), boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// orderTimes returns the UTC creation and update times, defaulting
+// unset values to now
+func orderTimes(o *Details) (createdAt, updatedAt time.Time) {
+	createdAt, updatedAt = o.CreatedAt.UTC(), o.UpdatedAt.UTC()
+	if createdAt.IsZero() {
+		createdAt = time.Now().UTC()
+	}
+	if updatedAt.IsZero() {
+		updatedAt = createdAt
+	}
+	return createdAt, updatedAt
+}
+
+func (db *DBService) getSQLite(mods ...qm.QueryMod) ([]Details, error) {
+	ctx := context.Background()
+	mods = append([]qm.QueryMod{qm.Select("order_detail.*")}, mods...)
+	results, err := sqlite3.OrderDetails(mods...).All(ctx, db.sql)
+	if err != nil {
+		return nil, err
+	}
+	exchanges, err := sqlite3.Exchanges().All(ctx, db.sql)
+	if err != nil {
+		return nil, err
+	}
+	exchangeNames := make(map[string]string, len(exchanges))
+	for i := range exchanges {
+		exchangeNames[exchanges[i].ID] = exchanges[i].Name
+	}
+	resp := make([]Details, 0, len(results))
+	for i := range results {
+		var createdAt, updatedAt time.Time
+		createdAt, err = time.Parse(time.RFC3339Nano, results[i].CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		updatedAt, err = time.Parse(time.RFC3339Nano, results[i].UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, Details{
+			ID:                   results[i].ID,
+			Exchange:             exchangeNames[results[i].ExchangeNameID],
+			OrderID:              results[i].OrderID,
+			ClientOrderID:        results[i].ClientOrderID.String,
+			AccountID:            results[i].AccountID.String,
+			Asset:                results[i].Asset,
+			Base:                 results[i].Base,
+			Quote:                results[i].Quote,
+			Side:                 results[i].Side,
+			Type:                 results[i].Type,
+			Status:               results[i].Status,
+			Price:                results[i].Price,
+			Amount:               results[i].Amount,
+			TriggerPrice:         results[i].TriggerPrice,
+			AverageExecutedPrice: results[i].AverageExecutedPrice,
+			ExecutedAmount:       results[i].ExecutedAmount,
+			RemainingAmount:      results[i].RemainingAmount,
+			Cost:                 results[i].Cost,
+			Fee:                  results[i].Fee,
+			Leverage:             results[i].Leverage,
+			CreatedAt:            createdAt,
+			UpdatedAt:            updatedAt,
+		})
+	}
+	return resp, nil
+}
+
+func (db *DBService) getPostgres(mods ...qm.QueryMod) ([]Details, error) {
+	ctx := context.Background()
+	mods = append([]qm.QueryMod{qm.Select("order_detail.*")}, mods...)
+	results, err := postgres.OrderDetails(mods...).All(ctx, db.sql)
+	if err != nil {
+		return nil, err
+	}
+	exchanges, err := postgres.Exchanges().All(ctx, db.sql)
+	if err != nil {
+		return nil, err
+	}
+	exchangeNames := make(map[string]string, len(exchanges))
+	for i := range exchanges {
+		exchangeNames[exchanges[i].ID] = exchanges[i].Name
+	}
+	resp := make([]Details, 0, len(results))
+	for i := range results {
+		resp = append(resp, Details{
+			ID:                   results[i].ID,
+			Exchange:             exchangeNames[results[i].ExchangeNameID],
+			OrderID:              results[i].OrderID,
+			ClientOrderID:        results[i].ClientOrderID.String,
+			AccountID:            results[i].AccountID.String,
+			Asset:                results[i].Asset,
+			Base:                 results[i].Base,
+			Quote:                results[i].Quote,
+			Side:                 results[i].Side,
+			Type:                 results[i].Type,
+			Status:               results[i].Status,
+			Price:                results[i].Price,
+			Amount:               results[i].Amount,
+			TriggerPrice:         results[i].TriggerPrice,
+			AverageExecutedPrice: results[i].AverageExecutedPrice,
+			ExecutedAmount:       results[i].ExecutedAmount,
+			RemainingAmount:      results[i].RemainingAmount,
+			Cost:                 results[i].Cost,
+			Fee:                  results[i].Fee,
+			Leverage:             results[i].Leverage,
+			CreatedAt:            results[i].CreatedAt,
+			UpdatedAt:            results[i].UpdatedAt,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,183 @@
+package order
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var verbose = false
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestSetup(t *testing.T) {
+	t.Parallel()
+	db, err := Setup(nil)
+	if err != nil {
+		t.Error(err)
+	}
+	if db != nil {
+		t.Error("expected nil service when database is not provided")
+	}
+}
+
+func TestOrders(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			db, err := Setup(dbConn)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = db.Upsert(&Details{OrderID: "1337"})
+			if err == nil {
+				t.Error("expected error for missing exchange name")
+			}
+			err = db.Upsert(nil)
+			if !errors.Is(err, errNilOrderDetails) {
+				t.Errorf("received '%v' expected '%v'", err, errNilOrderDetails)
+			}
+
+			created := time.Now().Add(-time.Hour).Truncate(time.Second)
+			open := &Details{
+				Exchange:  "Binance",
+				OrderID:   "1337",
+				Asset:     "spot",
+				Base:      "btc",
+				Quote:     "usdt",
+				Side:      "buy",
+				Type:      "limit",
+				Status:    "new",
+				Price:     1337,
+				Amount:    1,
+				CreatedAt: created,
+			}
+			filled := &Details{
+				Exchange: "binance",
+				OrderID:  "1338",
+				Asset:    "spot",
+				Base:     "BTC",
+				Quote:    "USDT",
+				Side:     "SELL",
+				Type:     "MARKET",
+				Status:   "FILLED",
+				Amount:   1,
+			}
+			err = db.Upsert(open, filled)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if open.ID == "" {
+				t.Error("expected internal ID to be generated")
+			}
+
+			open.Status = "PARTIALLY_FILLED"
+			open.ExecutedAmount = 0.5
+			open.RemainingAmount = 0.5
+			err = db.Upsert(open)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := db.GetByExchangeAndID("BINANCE", "1337", "SPOT")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.ID != open.ID {
+				t.Errorf("received '%v' expected '%v'", resp.ID, open.ID)
+			}
+			if resp.Exchange != "binance" {
+				t.Errorf("received '%v' expected '%v'", resp.Exchange, "binance")
+			}
+			if resp.Status != "PARTIALLY_FILLED" {
+				t.Errorf("received '%v' expected '%v'", resp.Status, "PARTIALLY_FILLED")
+			}
+			if resp.ExecutedAmount != 0.5 {
+				t.Errorf("received '%v' expected '%v'", resp.ExecutedAmount, 0.5)
+			}
+			if !resp.CreatedAt.Equal(created) {
+				t.Errorf("received '%v' expected '%v'", resp.CreatedAt, created)
+			}
+
+			active, err := db.GetOrdersNotInStatus("FILLED", "CANCELLED")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(active) != 1 {
+				t.Fatalf("received '%v' expected '%v'", len(active), 1)
+			}
+			if active[0].OrderID != "1337" {
+				t.Errorf("received '%v' expected '%v'", active[0].OrderID, "1337")
+			}
+
+			all, err := db.GetOrdersNotInStatus()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(all) != 2 {
+				t.Errorf("received '%v' expected '%v'", len(all), 2)
+			}
+
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package order
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+var errNilOrderDetails = errors.New("nil order details received")
+
+// Details is a DTO for order data tracked by the order manager
+type Details struct {
+	ID                   string
+	Exchange             string
+	OrderID              string
+	ClientOrderID        string
+	AccountID            string
+	Asset                string
+	Base                 string
+	Quote                string
+	Side                 string
+	Type                 string
+	Status               string
+	Price                float64
+	Amount               float64
+	TriggerPrice         float64
+	AverageExecutedPrice float64
+	ExecutedAmount       float64
+	RemainingAmount      float64
+	Cost                 float64
+	Fee                  float64
+	Leverage             float64
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using the order database service
+// without needing to care about implementation
+type IDBService interface {
+	Upsert(orders ...*Details) error
+	GetByExchangeAndID(exchangeName, orderID, asset string) (*Details, error)
+	GetOrdersNotInStatus(statuses ...string) ([]Details, error)
+}
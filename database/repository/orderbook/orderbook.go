@@ -0,0 +1,660 @@
+package orderbook
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// InsertSnapshots saves orderbook snapshots to the database. A snapshot for
+// the same book and timestamp replaces the existing one
+func InsertSnapshots(snapshots ...Snapshot) error {
+	for i := range snapshots {
+		id, err := exchangeNameID(snapshots[i].Exchange, snapshots[i].ExchangeNameID)
+		if err != nil {
+			return err
+		}
+		snapshots[i].ExchangeNameID = id
+	}
+
+	ctx := context.Background()
+	ctx = boil.SkipTimestamps(ctx)
+
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "InsertSnapshots tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		err = insertSnapshotsSQLite(ctx, tx, snapshots...)
+	} else {
+		err = insertSnapshotsPostgres(ctx, tx, snapshots...)
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// InsertDeltas saves orderbook deltas to the database
+func InsertDeltas(deltas ...Delta) error {
+	for i := range deltas {
+		id, err := exchangeNameID(deltas[i].Exchange, deltas[i].ExchangeNameID)
+		if err != nil {
+			return err
+		}
+		deltas[i].ExchangeNameID = id
+	}
+
+	ctx := context.Background()
+	ctx = boil.SkipTimestamps(ctx)
+
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "InsertDeltas tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		err = insertDeltasSQLite(ctx, tx, deltas...)
+	} else {
+		err = insertDeltasPostgres(ctx, tx, deltas...)
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetSnapshotsInRange returns all snapshots of a book in a date range
+func GetSnapshotsInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Snapshot, error) {
+	if exchangeName == "" || assetType == "" || base == "" || quote == "" {
+		return nil, errInvalidArguments
+	}
+	wheres, err := generateQuery(exchangeName, assetType, base, quote)
+	if err != nil {
+		return nil, err
+	}
+	var snapshots []Snapshot
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		wheres = append(wheres,
+			qm.Where("timestamp BETWEEN ? AND ?", startDate.UTC().Format(sqliteTimeFormat), endDate.UTC().Format(sqliteTimeFormat)),
+			qm.OrderBy("timestamp"))
+		snapshots, err = getSnapshotsSQLite(exchangeName, wheres...)
+	} else {
+		wheres = append(wheres,
+			qm.Where("timestamp BETWEEN ? AND ?", startDate.UTC(), endDate.UTC()),
+			qm.OrderBy("timestamp"))
+		snapshots, err = getSnapshotsPostgres(exchangeName, wheres...)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("orderbook.GetSnapshotsInRange %w", err)
+	}
+	return snapshots, nil
+}
+
+// GetLatestSnapshot returns the most recent snapshot of a book taken at or
+// before the supplied time
+func GetLatestSnapshot(exchangeName, assetType, base, quote string, at time.Time) (*Snapshot, error) {
+	if exchangeName == "" || assetType == "" || base == "" || quote == "" {
+		return nil, errInvalidArguments
+	}
+	wheres, err := generateQuery(exchangeName, assetType, base, quote)
+	if err != nil {
+		return nil, err
+	}
+	var snapshots []Snapshot
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		wheres = append(wheres,
+			qm.Where("timestamp <= ?", at.UTC().Format(sqliteTimeFormat)),
+			qm.OrderBy("timestamp desc"),
+			qm.Limit(1))
+		snapshots, err = getSnapshotsSQLite(exchangeName, wheres...)
+	} else {
+		wheres = append(wheres,
+			qm.Where("timestamp <= ?", at.UTC()),
+			qm.OrderBy("timestamp desc"),
+			qm.Limit(1))
+		snapshots, err = getSnapshotsPostgres(exchangeName, wheres...)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("orderbook.GetLatestSnapshot %w", err)
+	}
+	if len(snapshots) == 0 {
+		return nil, fmt.Errorf("%s %s %s %s at %s %w", exchangeName, assetType, base, quote, at, ErrNoSnapshotFound)
+	}
+	return &snapshots[0], nil
+}
+
+// GetDeltasInRange returns all deltas of a book in a date range in the order
+// they were applied
+func GetDeltasInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Delta, error) {
+	if exchangeName == "" || assetType == "" || base == "" || quote == "" {
+		return nil, errInvalidArguments
+	}
+	wheres, err := generateQuery(exchangeName, assetType, base, quote)
+	if err != nil {
+		return nil, err
+	}
+	var deltas []Delta
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		wheres = append(wheres,
+			qm.Where("timestamp BETWEEN ? AND ?", startDate.UTC().Format(sqliteTimeFormat), endDate.UTC().Format(sqliteTimeFormat)),
+			qm.OrderBy("timestamp, sequence"))
+		deltas, err = getDeltasSQLite(exchangeName, wheres...)
+	} else {
+		wheres = append(wheres,
+			qm.Where("timestamp BETWEEN ? AND ?", startDate.UTC(), endDate.UTC()),
+			qm.OrderBy("timestamp, sequence"))
+		deltas, err = getDeltasPostgres(exchangeName, wheres...)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("orderbook.GetDeltasInRange %w", err)
+	}
+	return deltas, nil
+}
+
+// RebuildAt returns the book as it was at the supplied time by applying the
+// stored deltas to the latest snapshot taken before it. Without deltas the
+// latest snapshot is returned as is. Levels of depth limited snapshots are
+// only known up to their worst price, so rebuilt levels beyond it are removed
+func RebuildAt(exchangeName, assetType, base, quote string, at time.Time) (*Snapshot, error) {
+	snapshot, err := GetLatestSnapshot(exchangeName, assetType, base, quote, at)
+	if err != nil {
+		return nil, err
+	}
+	deltas, err := GetDeltasInRange(exchangeName, assetType, base, quote, snapshot.Timestamp, at)
+	if err != nil {
+		return nil, err
+	}
+	// deltas sharing the snapshot's timestamp are already part of it
+	for len(deltas) > 0 && !deltas[0].Timestamp.After(snapshot.Timestamp) {
+		deltas = deltas[1:]
+	}
+	bidLimit, askLimit := depthLimits(snapshot)
+	err = ApplyDeltas(snapshot, deltas...)
+	if err != nil {
+		return nil, err
+	}
+	truncateToDepth(snapshot, bidLimit, askLimit)
+	return snapshot, nil
+}
+
+// depthLimits returns the worst bid and ask prices of a depth limited
+// snapshot. A side holding fewer levels than the depth holds the whole side
+// and has no limit
+func depthLimits(s *Snapshot) (bidLimit, askLimit float64) {
+	if s.Depth <= 0 {
+		return 0, 0
+	}
+	if len(s.Bids) >= s.Depth {
+		bidLimit = s.Bids[len(s.Bids)-1].Price
+	}
+	if len(s.Asks) >= s.Depth {
+		askLimit = s.Asks[len(s.Asks)-1].Price
+	}
+	return bidLimit, askLimit
+}
+
+// truncateToDepth removes bids below and asks above the limits of a depth
+// limited snapshot, as the levels between them and the snapshot's levels are
+// unknown. Zero limits are ignored
+func truncateToDepth(s *Snapshot, bidLimit, askLimit float64) {
+	if bidLimit > 0 {
+		for i := range s.Bids {
+			if s.Bids[i].Price < bidLimit {
+				s.Bids = s.Bids[:i]
+				break
+			}
+		}
+	}
+	if askLimit > 0 {
+		for i := range s.Asks {
+			if s.Asks[i].Price > askLimit {
+				s.Asks = s.Asks[:i]
+				break
+			}
+		}
+	}
+}
+
+// ApplyDeltas applies deltas in order to a snapshot, matching levels by price
+// or ID depending on the delta's action. Levels matched by ID which are not
+// in the snapshot are ignored, as they can sit beyond its depth
+func ApplyDeltas(s *Snapshot, deltas ...Delta) error {
+	if s == nil {
+		return errNilSnapshot
+	}
+	for i := range deltas {
+		switch deltas[i].Action {
+		case UpdateByPrice:
+			s.Bids = updateByPrice(s.Bids, deltas[i].Bids)
+			s.Asks = updateByPrice(s.Asks, deltas[i].Asks)
+		case Amend:
+			updateByID(s.Bids, deltas[i].Bids)
+			updateByID(s.Asks, deltas[i].Asks)
+		case Delete:
+			s.Bids = deleteByID(s.Bids, deltas[i].Bids)
+			s.Asks = deleteByID(s.Asks, deltas[i].Asks)
+		case Insert:
+			s.Bids = append(s.Bids, deltas[i].Bids...)
+			s.Asks = append(s.Asks, deltas[i].Asks...)
+		case UpdateInsert:
+			s.Bids = updateInsertByID(s.Bids, deltas[i].Bids)
+			s.Asks = updateInsertByID(s.Asks, deltas[i].Asks)
+		default:
+			return fmt.Errorf("%w %q", errInvalidAction, deltas[i].Action)
+		}
+		sort.SliceStable(s.Bids, func(x, y int) bool { return s.Bids[x].Price > s.Bids[y].Price })
+		sort.SliceStable(s.Asks, func(x, y int) bool { return s.Asks[x].Price < s.Asks[y].Price })
+		if deltas[i].MaxDepth > 0 {
+			if len(s.Bids) > deltas[i].MaxDepth {
+				s.Bids = s.Bids[:deltas[i].MaxDepth]
+			}
+			if len(s.Asks) > deltas[i].MaxDepth {
+				s.Asks = s.Asks[:deltas[i].MaxDepth]
+			}
+		}
+		s.Timestamp = deltas[i].Timestamp
+		s.UpdateID = deltas[i].UpdateID
+	}
+	return nil
+}
+
+func updateByPrice(levels, updates []Level) []Level {
+updates:
+	for i := range updates {
+		for j := range levels {
+			if levels[j].Price != updates[i].Price {
+				continue
+			}
+			if updates[i].Amount <= 0 {
+				levels = append(levels[:j], levels[j+1:]...)
+				continue updates
+			}
+			levels[j] = updates[i]
+			continue updates
+		}
+		if updates[i].Amount > 0 {
+			levels = append(levels, updates[i])
+		}
+	}
+	return levels
+}
+
+func updateByID(levels, updates []Level) {
+	for i := range updates {
+		for j := range levels {
+			if levels[j].ID != updates[i].ID {
+				continue
+			}
+			// zero prices are sent by some exchanges when only the amount
+			// changes
+			if updates[i].Price > 0 {
+				levels[j].Price = updates[i].Price
+			}
+			levels[j].Amount = updates[i].Amount
+			break
+		}
+	}
+}
+
+func deleteByID(levels, updates []Level) []Level {
+	for i := range updates {
+		for j := range levels {
+			if levels[j].ID == updates[i].ID {
+				levels = append(levels[:j], levels[j+1:]...)
+				break
+			}
+		}
+	}
+	return levels
+}
+
+func updateInsertByID(levels, updates []Level) []Level {
+updates:
+	for i := range updates {
+		for j := range levels {
+			if levels[j].ID == updates[i].ID {
+				levels[j] = updates[i]
+				continue updates
+			}
+		}
+		levels = append(levels, updates[i])
+	}
+	return levels
+}
+
+func exchangeNameID(exchangeName, exchangeNameID string) (string, error) {
+	if exchangeNameID != "" {
+		return exchangeNameID, nil
+	}
+	if exchangeName == "" {
+		return "", errExchangeNameUnset
+	}
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return "", err
+	}
+	return exchangeUUID.String(), nil
+}
+
+func insertSnapshotsSQLite(ctx context.Context, tx *sql.Tx, snapshots ...Snapshot) error {
+	for i := range snapshots {
+		if snapshots[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			snapshots[i].ID = freshUUID.String()
+		}
+		bids, asks, err := marshalLevels(snapshots[i].Bids, snapshots[i].Asks)
+		if err != nil {
+			return err
+		}
+		var tempEvent = sqlite3.OrderbookSnapshot{
+			ID:             snapshots[i].ID,
+			ExchangeNameID: snapshots[i].ExchangeNameID,
+			Asset:          strings.ToLower(snapshots[i].Asset),
+			Base:           strings.ToUpper(snapshots[i].Base),
+			Quote:          strings.ToUpper(snapshots[i].Quote),
+			Timestamp:      snapshots[i].Timestamp.UTC().Format(sqliteTimeFormat),
+			UpdateID:       snapshots[i].UpdateID,
+			Depth:          int64(snapshots[i].Depth),
+			Bids:           bids,
+			Asks:           asks,
+		}
+		err = tempEvent.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertSnapshotsPostgres(ctx context.Context, tx *sql.Tx, snapshots ...Snapshot) error {
+	for i := range snapshots {
+		if snapshots[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			snapshots[i].ID = freshUUID.String()
+		}
+		bids, asks, err := marshalLevels(snapshots[i].Bids, snapshots[i].Asks)
+		if err != nil {
+			return err
+		}
+		var tempEvent = postgres.OrderbookSnapshot{
+			ID:             snapshots[i].ID,
+			ExchangeNameID: snapshots[i].ExchangeNameID,
+			Asset:          strings.ToLower(snapshots[i].Asset),
+			Base:           strings.ToUpper(snapshots[i].Base),
+			Quote:          strings.ToUpper(snapshots[i].Quote),
+			Timestamp:      snapshots[i].Timestamp.UTC(),
+			UpdateID:       snapshots[i].UpdateID,
+			Depth:          snapshots[i].Depth,
+			Bids:           bids,
+			Asks:           asks,
+		}
+		err = tempEvent.Upsert(ctx, tx, true, []string{"exchange_name_id", "asset", "base", "quote", "timestamp"}, boil.Whitelist("update_id", "depth", "bids", "asks"), boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertDeltasSQLite(ctx context.Context, tx *sql.Tx, deltas ...Delta) error {
+	for i := range deltas {
+		if deltas[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			deltas[i].ID = freshUUID.String()
+		}
+		bids, asks, err := marshalLevels(deltas[i].Bids, deltas[i].Asks)
+		if err != nil {
+			return err
+		}
+		var tempEvent = sqlite3.OrderbookDelta{
+			ID:             deltas[i].ID,
+			ExchangeNameID: deltas[i].ExchangeNameID,
+			Asset:          strings.ToLower(deltas[i].Asset),
+			Base:           strings.ToUpper(deltas[i].Base),
+			Quote:          strings.ToUpper(deltas[i].Quote),
+			Timestamp:      deltas[i].Timestamp.UTC().Format(sqliteTimeFormat),
+			Sequence:       deltas[i].Sequence,
+			UpdateID:       deltas[i].UpdateID,
+			Action:         deltas[i].Action,
+			MaxDepth:       int64(deltas[i].MaxDepth),
+			Bids:           bids,
+			Asks:           asks,
+		}
+		err = tempEvent.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertDeltasPostgres(ctx context.Context, tx *sql.Tx, deltas ...Delta) error {
+	for i := range deltas {
+		if deltas[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			deltas[i].ID = freshUUID.String()
+		}
+		bids, asks, err := marshalLevels(deltas[i].Bids, deltas[i].Asks)
+		if err != nil {
+			return err
+		}
+		var tempEvent = postgres.OrderbookDelta{
+			ID:             deltas[i].ID,
+			ExchangeNameID: deltas[i].ExchangeNameID,
+			Asset:          strings.ToLower(deltas[i].Asset),
+			Base:           strings.ToUpper(deltas[i].Base),
+			Quote:          strings.ToUpper(deltas[i].Quote),
+			Timestamp:      deltas[i].Timestamp.UTC(),
+			Sequence:       deltas[i].Sequence,
+			UpdateID:       deltas[i].UpdateID,
+			Action:         deltas[i].Action,
+			MaxDepth:       deltas[i].MaxDepth,
+			Bids:           bids,
+			Asks:           asks,
+		}
+		err = tempEvent.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func getSnapshotsSQLite(exchangeName string, mods ...qm.QueryMod) ([]Snapshot, error) {
+	results, err := sqlite3.OrderbookSnapshots(mods...).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	snapshots := make([]Snapshot, len(results))
+	for i := range results {
+		ts, err := time.Parse(time.RFC3339Nano, results[i].Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		snapshots[i] = Snapshot{
+			ID:             results[i].ID,
+			Exchange:       strings.ToLower(exchangeName),
+			ExchangeNameID: results[i].ExchangeNameID,
+			Asset:          results[i].Asset,
+			Base:           results[i].Base,
+			Quote:          results[i].Quote,
+			Timestamp:      ts.UTC(),
+			UpdateID:       results[i].UpdateID,
+			Depth:          int(results[i].Depth),
+		}
+		snapshots[i].Bids, snapshots[i].Asks, err = unmarshalLevels(results[i].Bids, results[i].Asks)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return snapshots, nil
+}
+
+func getSnapshotsPostgres(exchangeName string, mods ...qm.QueryMod) ([]Snapshot, error) {
+	results, err := postgres.OrderbookSnapshots(mods...).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	snapshots := make([]Snapshot, len(results))
+	for i := range results {
+		snapshots[i] = Snapshot{
+			ID:             results[i].ID,
+			Exchange:       strings.ToLower(exchangeName),
+			ExchangeNameID: results[i].ExchangeNameID,
+			Asset:          results[i].Asset,
+			Base:           results[i].Base,
+			Quote:          results[i].Quote,
+			Timestamp:      results[i].Timestamp.UTC(),
+			UpdateID:       results[i].UpdateID,
+			Depth:          results[i].Depth,
+		}
+		snapshots[i].Bids, snapshots[i].Asks, err = unmarshalLevels(results[i].Bids, results[i].Asks)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return snapshots, nil
+}
+
+func getDeltasSQLite(exchangeName string, mods ...qm.QueryMod) ([]Delta, error) {
+	results, err := sqlite3.OrderbookDeltas(mods...).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	deltas := make([]Delta, len(results))
+	for i := range results {
+		ts, err := time.Parse(time.RFC3339Nano, results[i].Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		deltas[i] = Delta{
+			ID:             results[i].ID,
+			Exchange:       strings.ToLower(exchangeName),
+			ExchangeNameID: results[i].ExchangeNameID,
+			Asset:          results[i].Asset,
+			Base:           results[i].Base,
+			Quote:          results[i].Quote,
+			Timestamp:      ts.UTC(),
+			Sequence:       results[i].Sequence,
+			UpdateID:       results[i].UpdateID,
+			Action:         results[i].Action,
+			MaxDepth:       int(results[i].MaxDepth),
+		}
+		deltas[i].Bids, deltas[i].Asks, err = unmarshalLevels(results[i].Bids, results[i].Asks)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return deltas, nil
+}
+
+func getDeltasPostgres(exchangeName string, mods ...qm.QueryMod) ([]Delta, error) {
+	results, err := postgres.OrderbookDeltas(mods...).All(context.Background(), database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	deltas := make([]Delta, len(results))
+	for i := range results {
+		deltas[i] = Delta{
+			ID:             results[i].ID,
+			Exchange:       strings.ToLower(exchangeName),
+			ExchangeNameID: results[i].ExchangeNameID,
+			Asset:          results[i].Asset,
+			Base:           results[i].Base,
+			Quote:          results[i].Quote,
+			Timestamp:      results[i].Timestamp.UTC(),
+			Sequence:       results[i].Sequence,
+			UpdateID:       results[i].UpdateID,
+			Action:         results[i].Action,
+			MaxDepth:       results[i].MaxDepth,
+		}
+		deltas[i].Bids, deltas[i].Asks, err = unmarshalLevels(results[i].Bids, results[i].Asks)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return deltas, nil
+}
+
+func generateQuery(exchangeName, assetType, base, quote string) ([]qm.QueryMod, error) {
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	return []qm.QueryMod{
+		qm.Where("exchange_name_id = ?", exchangeUUID.String()),
+		qm.Where("asset = ?", strings.ToLower(assetType)),
+		qm.Where("base = ?", strings.ToUpper(base)),
+		qm.Where("quote = ?", strings.ToUpper(quote)),
+	}, nil
+}
+
+func marshalLevels(bids, asks []Level) (bidData, askData string, err error) {
+	b, err := json.Marshal(bids)
+	if err != nil {
+		return "", "", err
+	}
+	a, err := json.Marshal(asks)
+	if err != nil {
+		return "", "", err
+	}
+	return string(b), string(a), nil
+}
+
+func unmarshalLevels(bidData, askData string) (bids, asks []Level, err error) {
+	err = json.Unmarshal([]byte(bidData), &bids)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = json.Unmarshal([]byte(askData), &asks)
+	if err != nil {
+		return nil, nil, err
+	}
+	return bids, asks, nil
+}
@@ -0,0 +1,317 @@
+package orderbook
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+	os.Exit(t)
+}
+
+func TestOrderbooks(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		seedDB func() error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if test.seedDB != nil {
+				err = test.seedDB()
+				if err != nil {
+					t.Error(err)
+				}
+			}
+
+			orderbookSQLTester(t)
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func orderbookSQLTester(t *testing.T) {
+	t.Helper()
+	firstTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	base, quote, a := currency.BTC.String(), currency.USD.String(), asset.Spot.String()
+	snapshots := []Snapshot{
+		{
+			Exchange:  testExchanges[0].Name,
+			Asset:     a,
+			Base:      base,
+			Quote:     quote,
+			Timestamp: firstTime,
+			UpdateID:  1,
+			Depth:     2,
+			Bids:      []Level{{Price: 99, Amount: 1}, {Price: 98, Amount: 2}},
+			Asks:      []Level{{Price: 101, Amount: 1}, {Price: 102, Amount: 2}},
+		},
+		{
+			Exchange:  testExchanges[0].Name,
+			Asset:     a,
+			Base:      base,
+			Quote:     quote,
+			Timestamp: firstTime.Add(time.Minute),
+			UpdateID:  10,
+			Bids:      []Level{{Price: 100, Amount: 1}},
+			Asks:      []Level{{Price: 103, Amount: 1}},
+		},
+	}
+	err := InsertSnapshots(snapshots...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deltas := []Delta{
+		{
+			Exchange:  testExchanges[0].Name,
+			Asset:     a,
+			Base:      base,
+			Quote:     quote,
+			Timestamp: firstTime.Add(time.Millisecond),
+			Sequence:  1,
+			UpdateID:  2,
+			Action:    UpdateByPrice,
+			Bids:      []Level{{Price: 99, Amount: 0}},
+			Asks:      []Level{{Price: 100.5, Amount: 3}},
+		},
+		{
+			Exchange:  testExchanges[0].Name,
+			Asset:     a,
+			Base:      base,
+			Quote:     quote,
+			Timestamp: firstTime.Add(time.Millisecond),
+			Sequence:  2,
+			UpdateID:  3,
+			Action:    UpdateByPrice,
+			Bids:      []Level{{Price: 98, Amount: 5}},
+		},
+		{
+			// levels beyond the depth of the first snapshot are not rebuilt
+			Exchange:  testExchanges[0].Name,
+			Asset:     a,
+			Base:      base,
+			Quote:     quote,
+			Timestamp: firstTime.Add(time.Millisecond * 2),
+			Sequence:  3,
+			UpdateID:  4,
+			Action:    UpdateByPrice,
+			Bids:      []Level{{Price: 90, Amount: 1}},
+			Asks:      []Level{{Price: 105, Amount: 1}},
+		},
+	}
+	err = InsertDeltas(deltas...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := GetSnapshotsInRange(testExchanges[0].Name, a, base, quote, firstTime.Add(-time.Hour), firstTime.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(resp), 2)
+	}
+	if !resp[0].Timestamp.Equal(firstTime) || len(resp[0].Bids) != 2 || resp[0].Asks[1].Price != 102 || resp[0].Depth != 2 {
+		t.Errorf("received '%+v' expected first snapshot", resp[0])
+	}
+
+	_, err = GetLatestSnapshot(testExchanges[0].Name, a, base, quote, firstTime.Add(-time.Second))
+	if !errors.Is(err, ErrNoSnapshotFound) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNoSnapshotFound)
+	}
+
+	book, err := RebuildAt(testExchanges[0].Name, a, base, quote, firstTime.Add(time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if book.UpdateID != 4 {
+		t.Errorf("received '%v' expected '%v'", book.UpdateID, 4)
+	}
+	if len(book.Bids) != 1 || book.Bids[0].Price != 98 || book.Bids[0].Amount != 5 {
+		t.Errorf("received '%+v' expected single 98 bid", book.Bids)
+	}
+	if len(book.Asks) != 3 || book.Asks[0].Price != 100.5 {
+		t.Errorf("received '%+v' expected 100.5 best ask", book.Asks)
+	}
+
+	book, err = RebuildAt(testExchanges[0].Name, a, base, quote, firstTime.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if book.UpdateID != 10 || len(book.Bids) != 1 || book.Bids[0].Price != 100 {
+		t.Errorf("received '%+v' expected latest snapshot", book)
+	}
+
+	// replacing a snapshot keeps a single entry per timestamp
+	snapshots[1].ID = ""
+	snapshots[1].UpdateID = 11
+	err = InsertSnapshots(snapshots[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err = GetSnapshotsInRange(testExchanges[0].Name, a, base, quote, firstTime.Add(-time.Hour), firstTime.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 2 || resp[1].UpdateID != 11 {
+		t.Errorf("received '%+v' expected replaced snapshot", resp)
+	}
+
+	_, err = GetDeltasInRange("", a, base, quote, firstTime, firstTime)
+	if !errors.Is(err, errInvalidArguments) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidArguments)
+	}
+}
+
+func TestApplyDeltas(t *testing.T) {
+	t.Parallel()
+	err := ApplyDeltas(nil)
+	if !errors.Is(err, errNilSnapshot) {
+		t.Errorf("received '%v' expected '%v'", err, errNilSnapshot)
+	}
+	tt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := &Snapshot{
+		Bids: []Level{{Price: 10, Amount: 1, ID: 1}, {Price: 9, Amount: 1, ID: 2}},
+		Asks: []Level{{Price: 11, Amount: 1, ID: 3}, {Price: 12, Amount: 1, ID: 4}},
+	}
+	err = ApplyDeltas(s,
+		Delta{Action: Amend, Bids: []Level{{ID: 1, Amount: 5}, {ID: 1337, Amount: 1}}},
+		Delta{Action: Delete, Asks: []Level{{ID: 3}}},
+		Delta{Action: Insert, Bids: []Level{{Price: 9.5, Amount: 2, ID: 5}}},
+		Delta{Action: UpdateInsert, Asks: []Level{{Price: 13, Amount: 2, ID: 4}, {Price: 11.5, Amount: 1, ID: 6}}, Timestamp: tt, UpdateID: 7},
+	)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	expectedBids := []Level{{Price: 10, Amount: 5, ID: 1}, {Price: 9.5, Amount: 2, ID: 5}, {Price: 9, Amount: 1, ID: 2}}
+	expectedAsks := []Level{{Price: 11.5, Amount: 1, ID: 6}, {Price: 13, Amount: 2, ID: 4}}
+	if fmt.Sprint(s.Bids) != fmt.Sprint(expectedBids) {
+		t.Errorf("received '%v' expected '%v'", s.Bids, expectedBids)
+	}
+	if fmt.Sprint(s.Asks) != fmt.Sprint(expectedAsks) {
+		t.Errorf("received '%v' expected '%v'", s.Asks, expectedAsks)
+	}
+	if !s.Timestamp.Equal(tt) || s.UpdateID != 7 {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", s.Timestamp, s.UpdateID, tt, 7)
+	}
+
+	err = ApplyDeltas(s, Delta{Action: UpdateByPrice, Bids: []Level{{Price: 8, Amount: 1}}, MaxDepth: 2})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(s.Bids) != 2 || s.Bids[1].Price != 9.5 {
+		t.Errorf("received '%v' expected book truncated to depth 2", s.Bids)
+	}
+
+	err = ApplyDeltas(s, Delta{Action: "bad"})
+	if !errors.Is(err, errInvalidAction) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidAction)
+	}
+}
+
+func TestTruncateToDepth(t *testing.T) {
+	t.Parallel()
+	s := &Snapshot{
+		Depth: 2,
+		Bids:  []Level{{Price: 10, Amount: 1}, {Price: 9, Amount: 1}},
+		Asks:  []Level{{Price: 11, Amount: 1}},
+	}
+	bidLimit, askLimit := depthLimits(s)
+	if bidLimit != 9 || askLimit != 0 {
+		t.Fatalf("received '%v' '%v' expected '%v' '%v'", bidLimit, askLimit, 9, 0)
+	}
+	err := ApplyDeltas(s, Delta{
+		Action: UpdateByPrice,
+		Bids:   []Level{{Price: 10, Amount: 0}, {Price: 8, Amount: 1}},
+		Asks:   []Level{{Price: 20, Amount: 1}},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	truncateToDepth(s, bidLimit, askLimit)
+	// the whole ask side was captured so asks are kept at any price
+	if len(s.Bids) != 1 || s.Bids[0].Price != 9 {
+		t.Errorf("received '%v' expected bids truncated to the snapshot depth", s.Bids)
+	}
+	if len(s.Asks) != 2 {
+		t.Errorf("received '%v' expected '%v' asks", s.Asks, 2)
+	}
+
+	s = &Snapshot{Bids: []Level{{Price: 10, Amount: 1}}}
+	bidLimit, askLimit = depthLimits(s)
+	if bidLimit != 0 || askLimit != 0 {
+		t.Errorf("received '%v' '%v' expected no limits for a full snapshot", bidLimit, askLimit)
+	}
+}
+
+func seedDB() error {
+	return exchange.InsertMany(testExchanges)
+}
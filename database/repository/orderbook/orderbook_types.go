@@ -0,0 +1,79 @@
+package orderbook
+
+import (
+	"errors"
+	"time"
+)
+
+// Delta actions describe how the levels of a delta are applied to a book.
+// They match the actions used by the websocket orderbook buffer
+const (
+	// UpdateByPrice amends the amount of a level matched by price, removes it
+	// if the amount is zero or less and inserts it if not found
+	UpdateByPrice = "price"
+	// Amend applies an amount adjustment to a level matched by ID
+	Amend = "update"
+	// Delete removes a level matched by ID
+	Delete = "delete"
+	// Insert adds a level to the book
+	Insert = "insert"
+	// UpdateInsert amends a level matched by ID or inserts it if not found
+	UpdateInsert = "update/insert"
+)
+
+// sqliteTimeFormat is a fixed width format so that timestamps stored as text
+// are ordered correctly when compared
+const sqliteTimeFormat = "2006-01-02T15:04:05.000000000Z"
+
+var (
+	// ErrNoSnapshotFound is returned when there is no snapshot to rebuild a
+	// book from
+	ErrNoSnapshotFound = errors.New("no orderbook snapshot found")
+
+	errExchangeNameUnset = errors.New("exchange name/uuid not set, cannot insert")
+	errInvalidArguments  = errors.New("invalid arguments received")
+	errNilSnapshot       = errors.New("nil orderbook snapshot received")
+	errInvalidAction     = errors.New("invalid orderbook delta action")
+)
+
+// Level defines a price level in its simplest db friendly form
+type Level struct {
+	Price  float64 `json:"price"`
+	Amount float64 `json:"amount"`
+	ID     int64   `json:"id,omitempty"`
+}
+
+// Snapshot defines the top levels of an orderbook at a point in time. Depth
+// is the amount of levels per side the snapshot was limited to, zero when it
+// holds every level of the book
+type Snapshot struct {
+	ID             string
+	Exchange       string
+	ExchangeNameID string
+	Asset          string
+	Base           string
+	Quote          string
+	Timestamp      time.Time
+	UpdateID       int64
+	Depth          int
+	Bids           []Level
+	Asks           []Level
+}
+
+// Delta defines an incremental update to an orderbook. Sequence orders
+// deltas which share a timestamp
+type Delta struct {
+	ID             string
+	Exchange       string
+	ExchangeNameID string
+	Asset          string
+	Base           string
+	Quote          string
+	Timestamp      time.Time
+	Sequence       int64
+	UpdateID       int64
+	Action         string
+	MaxDepth       int
+	Bids           []Level
+	Asks           []Level
+}
@@ -303,7 +303,37 @@ func GetInRange(exchangeName, assetType, base, quote string, startDate, endDate
 	return td, nil
 }
 
-func getInRangeSQLite(exchangeName, assetType, base, quote string, startDate, endDate time.Time) (td []Data, err error) {
+// GetInRangeChunk returns up to limit trades by an exchange in a date range,
+// skipping the first offset trades. Trades are ordered by timestamp and id so
+// that consecutive chunks can be requested without loading the whole range
+func GetInRangeChunk(exchangeName, assetType, base, quote string, startDate, endDate time.Time, offset, limit int) (td []Data, err error) {
+	if limit <= 0 {
+		return nil, errInvalidChunkLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	mods := []qm.QueryMod{
+		qm.OrderBy("id"),
+		qm.Offset(offset),
+		qm.Limit(limit),
+	}
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		td, err = getInRangeSQLite(exchangeName, assetType, base, quote, startDate, endDate, mods...)
+		if err != nil {
+			return td, fmt.Errorf("trade.GetInRangeChunk getInRangeSQLite %w", err)
+		}
+	} else {
+		td, err = getInRangePostgres(exchangeName, assetType, base, quote, startDate, endDate, mods...)
+		if err != nil {
+			return td, fmt.Errorf("trade.GetInRangeChunk getInRangePostgres %w", err)
+		}
+	}
+
+	return td, nil
+}
+
+func getInRangeSQLite(exchangeName, assetType, base, quote string, startDate, endDate time.Time, mods ...qm.QueryMod) (td []Data, err error) {
 	var exchangeUUID uuid.UUID
 	exchangeUUID, err = exchange.UUIDByName(exchangeName)
 	if err != nil {
@@ -316,6 +346,7 @@ func getInRangeSQLite(exchangeName, assetType, base, quote string, startDate, en
 		"quote":            strings.ToUpper(quote),
 	}
 	q := generateQuery(wheres, startDate, endDate, true)
+	q = append(q, mods...)
 	query := sqlite3.Trades(q...)
 	var result []*sqlite3.Trade
 	result, err = query.All(context.Background(), database.DB.SQL)
@@ -345,7 +376,7 @@ func getInRangeSQLite(exchangeName, assetType, base, quote string, startDate, en
 	return td, nil
 }
 
-func getInRangePostgres(exchangeName, assetType, base, quote string, startDate, endDate time.Time) (td []Data, err error) {
+func getInRangePostgres(exchangeName, assetType, base, quote string, startDate, endDate time.Time, mods ...qm.QueryMod) (td []Data, err error) {
 	var exchangeUUID uuid.UUID
 	exchangeUUID, err = exchange.UUIDByName(exchangeName)
 	if err != nil {
@@ -359,6 +390,7 @@ func getInRangePostgres(exchangeName, assetType, base, quote string, startDate,
 	}
 
 	q := generateQuery(wheres, startDate, endDate, false)
+	q = append(q, mods...)
 	query := postgres.Trades(q...)
 	var result []*postgres.Trade
 	result, err = query.All(context.Background(), database.DB.SQL)
@@ -1,6 +1,7 @@
 package trade
 
 import (
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -172,6 +173,47 @@ func tradeSQLTester(t *testing.T) {
 		t.Error("Bad get!")
 	}
 
+	var chunked []Data
+	for offset := 0; ; offset += 7 {
+		var chunk []Data
+		chunk, err = GetInRangeChunk(
+			testExchanges[0].Name,
+			asset.Spot.String(),
+			currency.BTC.String(),
+			currency.USD.String(),
+			firstTime.Add(-time.Hour),
+			firstTime.Add(time.Hour),
+			offset,
+			7)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(chunk) == 0 {
+			break
+		}
+		chunked = append(chunked, chunk...)
+	}
+	if len(chunked) != len(resp) {
+		t.Fatalf("received '%v' chunked trades expected '%v'", len(chunked), len(resp))
+	}
+	for i := range chunked {
+		if !chunked[i].Timestamp.Equal(resp[i].Timestamp) {
+			t.Errorf("received '%v' expected '%v'", chunked[i].Timestamp, resp[i].Timestamp)
+		}
+	}
+	_, err = GetInRangeChunk(
+		testExchanges[0].Name,
+		asset.Spot.String(),
+		currency.BTC.String(),
+		currency.USD.String(),
+		firstTime.Add(-time.Hour),
+		firstTime.Add(time.Hour),
+		0,
+		0)
+	if !errors.Is(err, errInvalidChunkLimit) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidChunkLimit)
+	}
+
 	ranges, err := kline.CalculateCandleDateRanges(firstTime, firstTime.Add(20*time.Minute), kline.OneMin, 100)
 	if err != nil {
 		t.Error(err)
@@ -1,6 +1,11 @@
 package trade
 
-import "time"
+import (
+	"errors"
+	"time"
+)
+
+var errInvalidChunkLimit = errors.New("chunk limit must be greater than zero")
 
 // Data defines trade data in its simplest
 // db friendly form
@@ -0,0 +1,355 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/balance"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupBalanceHistoryManager creates a balance history manager subsystem
+func SetupBalanceHistoryManager(em iExchangeManager, dcm iDatabaseConnectionManager, cfg *config.BalanceHistoryManager) (*BalanceHistoryManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if dcm == nil {
+		return nil, errNilDatabaseConnectionManager
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	fiat := cfg.FiatCurrency
+	if fiat.IsEmpty() {
+		fiat = currency.USD
+	}
+	if !fiat.IsFiatCurrency() {
+		return nil, fmt.Errorf("%w, received %s", errNoFiatCurrency, fiat)
+	}
+	delay := cfg.Delay
+	if delay <= 0 {
+		delay = defaultBalanceHistoryDelay
+	}
+	db := dcm.GetInstance()
+	if db == nil {
+		return nil, database.ErrNilInstance
+	}
+	return &BalanceHistoryManager{
+		delay:                      delay,
+		fiat:                       fiat,
+		verbose:                    cfg.Verbose,
+		exchangeManager:            em,
+		databaseConnectionInstance: db,
+		shutdown:                   make(chan struct{}),
+		tickerLoader:               ticker.GetTicker,
+		fiatConverter:              currency.ConvertCurrency,
+		snapshotSaver:              balance.InsertSnapshots,
+		snapshotLoader:             balance.GetSnapshotsInRange,
+		changeSaver:                balance.InsertChanges,
+	}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *BalanceHistoryManager) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return atomic.LoadInt32(&m.started) == 1
+}
+
+// GetFiatCurrency returns the fiat currency balances are valued in when
+// recorded
+func (m *BalanceHistoryManager) GetFiatCurrency() currency.Code {
+	if m == nil {
+		return currency.Code{}
+	}
+	return m.fiat
+}
+
+// Start runs the subsystem
+func (m *BalanceHistoryManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("balance history manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("balance history manager %w", ErrSubSystemAlreadyStarted)
+	}
+	m.shutdown = make(chan struct{})
+	m.wg.Add(1)
+	go m.run()
+	log.Debugf(log.PortfolioMgr, "Balance history manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem
+func (m *BalanceHistoryManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("balance history manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return fmt.Errorf("balance history manager %w", ErrSubSystemNotStarted)
+	}
+	close(m.shutdown)
+	m.wg.Wait()
+	log.Debugf(log.PortfolioMgr, "Balance history manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// run records the holdings of all exchanges every delay until shutdown
+func (m *BalanceHistoryManager) run() {
+	defer m.wg.Done()
+	tick := time.NewTicker(m.delay)
+	defer tick.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-tick.C:
+			if !m.databaseConnectionInstance.IsConnected() {
+				continue
+			}
+			go func() {
+				if err := m.recordAllHoldings(); err != nil {
+					log.Errorf(log.PortfolioMgr, "Balance history manager %v", err)
+				}
+			}()
+		}
+	}
+}
+
+// recordAllHoldings fetches and records the holdings of every enabled exchange
+// with authenticated API support. All balances of a run share a timestamp
+func (m *BalanceHistoryManager) recordAllHoldings() error {
+	if !atomic.CompareAndSwapInt32(&m.processing, 0, 1) {
+		return fmt.Errorf("cannot record holdings, %w", errAlreadyRunning)
+	}
+	defer atomic.StoreInt32(&m.processing, 0)
+	exchanges, err := m.exchangeManager.GetExchanges()
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for i := range exchanges {
+		if exchanges[i] == nil ||
+			!exchanges[i].IsEnabled() ||
+			!exchanges[i].GetAuthenticatedAPISupport(exchange.RestAuthentication) {
+			continue
+		}
+		holdings := m.fetchHoldings(exchanges[i])
+		if len(holdings.Accounts) == 0 {
+			continue
+		}
+		err = m.RecordHoldings(&holdings, now)
+		if err != nil {
+			log.Errorf(log.PortfolioMgr, "Balance history manager unable to record %s holdings: %v", holdings.Exchange, err)
+		}
+	}
+	return nil
+}
+
+// fetchHoldings returns the holdings of all enabled asset types of an
+// exchange
+func (m *BalanceHistoryManager) fetchHoldings(exch exchange.IBotExchange) account.Holdings {
+	holdings := account.Holdings{Exchange: exch.GetName()}
+	assetTypes := exch.GetAssetTypes(true)
+	for i := range assetTypes {
+		h, err := exch.FetchAccountInfo(context.TODO(), assetTypes[i])
+		if err != nil {
+			if m.verbose {
+				log.Warnf(log.PortfolioMgr, "Balance history manager unable to fetch %s %s holdings: %v", holdings.Exchange, assetTypes[i], err)
+			}
+			continue
+		}
+		for j := range h.Accounts {
+			h.Accounts[j].AssetType = assetTypes[i]
+		}
+		holdings.Accounts = append(holdings.Accounts, h.Accounts...)
+	}
+	return holdings
+}
+
+// RecordHoldings values and saves every balance of an exchange's holdings at
+// the supplied time. Snapshots of an exchange sharing a timestamp replace its
+// previously recorded balances when building equity curves, so all of its
+// accounts should be recorded together
+func (m *BalanceHistoryManager) RecordHoldings(h *account.Holdings, at time.Time) error {
+	if !m.IsRunning() {
+		return fmt.Errorf("balance history manager %w", ErrSubSystemNotStarted)
+	}
+	if h == nil {
+		return fmt.Errorf("%w holdings", common.ErrNilPointer)
+	}
+	var snapshots []balance.Snapshot
+	for i := range h.Accounts {
+		for j := range h.Accounts[i].Currencies {
+			b := &h.Accounts[i].Currencies[j]
+			if b.TotalValue == 0 && b.Hold == 0 {
+				continue
+			}
+			snapshots = append(snapshots, balance.Snapshot{
+				Exchange:     h.Exchange,
+				Account:      h.Accounts[i].ID,
+				Asset:        h.Accounts[i].AssetType.String(),
+				Currency:     b.CurrencyName.String(),
+				Total:        b.TotalValue,
+				Hold:         b.Hold,
+				FiatCurrency: m.fiat.String(),
+				FiatRate:     m.getFiatRate(h.Exchange, h.Accounts[i].AssetType, b.CurrencyName),
+				Timestamp:    at,
+			})
+		}
+	}
+	if len(snapshots) == 0 {
+		return nil
+	}
+	if m.verbose {
+		log.Debugf(log.PortfolioMgr, "Balance history manager recording %d %s balances", len(snapshots), h.Exchange)
+	}
+	return m.snapshotSaver(snapshots...)
+}
+
+// RecordChange values and saves a balance change received from an exchange
+// websocket
+func (m *BalanceHistoryManager) RecordChange(c *account.Change) error {
+	if !m.IsRunning() {
+		return fmt.Errorf("balance history manager %w", ErrSubSystemNotStarted)
+	}
+	if c == nil {
+		return fmt.Errorf("%w change", common.ErrNilPointer)
+	}
+	return m.changeSaver(balance.Change{
+		Exchange:     c.Exchange,
+		Account:      c.Account,
+		Asset:        c.Asset.String(),
+		Currency:     c.Currency.String(),
+		Amount:       c.Amount,
+		FiatCurrency: m.fiat.String(),
+		FiatRate:     m.getFiatRate(c.Exchange, c.Asset, c.Currency),
+		Timestamp:    time.Now(),
+	})
+}
+
+// getFiatRate returns the value of one unit of a currency in the manager's
+// fiat currency. Fiat currencies are converted via the foreign exchange
+// conversion rates, while cryptocurrencies are valued by the exchange's last
+// price against the fiat currency or a valuation quote. Zero is returned when
+// a currency cannot be valued
+func (m *BalanceHistoryManager) getFiatRate(exchName string, a asset.Item, code currency.Code) float64 {
+	if code.Item == m.fiat.Item {
+		return 1
+	}
+	if rate, err := m.fiatConverter(1, code, m.fiat); err == nil {
+		return rate
+	}
+	assets := []asset.Item{a}
+	if a != asset.Spot {
+		assets = append(assets, asset.Spot)
+	}
+	quotes := append([]currency.Code{m.fiat}, balanceValuationQuotes...)
+	for i := range assets {
+		for j := range quotes {
+			if quotes[j].Item == code.Item {
+				continue
+			}
+			tick, err := m.tickerLoader(exchName, currency.NewPair(code, quotes[j]), assets[i])
+			if err != nil || tick.Last <= 0 {
+				continue
+			}
+			quoteRate := 1.0
+			if quotes[j].Item != m.fiat.Item {
+				quoteRate, err = m.fiatConverter(1, quotes[j], m.fiat)
+				if err != nil {
+					continue
+				}
+			}
+			return tick.Last * quoteRate
+		}
+	}
+	if m.verbose {
+		log.Warnf(log.PortfolioMgr, "Balance history manager unable to value %s %s %s in %s", exchName, a, code, m.fiat)
+	}
+	return 0
+}
+
+// GetEquityCurve returns the total value of all balances, or those of a single
+// exchange, at every recorded snapshot between the dates. Balances are valued
+// in the supplied fiat currency, or the manager's when empty
+func (m *BalanceHistoryManager) GetEquityCurve(exchangeName string, fiat currency.Code, startDate, endDate time.Time) ([]balance.EquityPoint, error) {
+	snapshots, err := m.getSnapshots(exchangeName, fiat, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	return balance.EquityCurve(snapshots), nil
+}
+
+// GetCurrencyHistory returns the combined balance of a currency across all
+// exchanges, or a single exchange, at every recorded snapshot between the dates
+func (m *BalanceHistoryManager) GetCurrencyHistory(exchangeName string, code, fiat currency.Code, startDate, endDate time.Time) ([]balance.CurrencyPoint, error) {
+	if code.IsEmpty() {
+		return nil, errCurrencyCodeEmpty
+	}
+	snapshots, err := m.getSnapshots(exchangeName, fiat, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	return balance.CurrencyHistory(snapshots, code.String()), nil
+}
+
+// GetDailyPNL returns the change in value of all balances, or those of a
+// single exchange, for every UTC day between the dates
+func (m *BalanceHistoryManager) GetDailyPNL(exchangeName string, fiat currency.Code, startDate, endDate time.Time) ([]balance.DailyPNL, error) {
+	curve, err := m.GetEquityCurve(exchangeName, fiat, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	return balance.CalculateDailyPNL(curve), nil
+}
+
+// getSnapshots loads recorded snapshots, converting their fiat rates to the
+// requested fiat currency
+func (m *BalanceHistoryManager) getSnapshots(exchangeName string, fiat currency.Code, startDate, endDate time.Time) ([]balance.Snapshot, error) {
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("balance history manager %w", ErrSubSystemNotStarted)
+	}
+	if err := common.StartEndTimeCheck(startDate, endDate); err != nil {
+		return nil, err
+	}
+	if fiat.IsEmpty() {
+		fiat = m.fiat
+	}
+	if !fiat.IsFiatCurrency() {
+		return nil, fmt.Errorf("%w, received %s", errNoFiatCurrency, fiat)
+	}
+	snapshots, err := m.snapshotLoader(exchangeName, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	conversions := make(map[string]float64)
+	for i := range snapshots {
+		if strings.EqualFold(snapshots[i].FiatCurrency, fiat.String()) {
+			continue
+		}
+		rate, ok := conversions[snapshots[i].FiatCurrency]
+		if !ok {
+			rate, err = m.fiatConverter(1, currency.NewCode(snapshots[i].FiatCurrency), fiat)
+			if err != nil {
+				return nil, err
+			}
+			conversions[snapshots[i].FiatCurrency] = rate
+		}
+		snapshots[i].FiatRate *= rate
+		snapshots[i].FiatCurrency = fiat.String()
+	}
+	return snapshots, nil
+}
@@ -0,0 +1,351 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/balance"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+var errFakeTicker = errors.New("no ticker")
+
+// bhfExchange aka balance history fake exchange serves holdings without API
+// calls or credentials
+type bhfExchange struct {
+	exchange.IBotExchange
+}
+
+func (f bhfExchange) IsEnabled() bool {
+	return true
+}
+
+func (f bhfExchange) GetAuthenticatedAPISupport(_ uint8) bool {
+	return true
+}
+
+func (f bhfExchange) GetAssetTypes(_ bool) asset.Items {
+	return asset.Items{asset.Spot}
+}
+
+func (f bhfExchange) FetchAccountInfo(_ context.Context, a asset.Item) (account.Holdings, error) {
+	return account.Holdings{
+		Exchange: f.GetName(),
+		Accounts: []account.SubAccount{
+			{
+				ID:        "1337",
+				AssetType: a,
+				Currencies: []account.Balance{
+					{CurrencyName: currency.BTC, TotalValue: 2, Hold: 1},
+					{CurrencyName: currency.EUR, TotalValue: 100},
+					{CurrencyName: currency.LTC, TotalValue: 10},
+					{CurrencyName: currency.XRP},
+				},
+			},
+		},
+	}, nil
+}
+
+func balanceHistoryDatabase(t *testing.T) *DatabaseConnectionManager {
+	t.Helper()
+	dbInst := &database.Instance{}
+	err := dbInst.SetConfig(&database.Config{Enabled: true})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = dbInst.SetSQLiteConnection(&sql.DB{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	dbInst.SetConnected(true)
+	return &DatabaseConnectionManager{
+		dbConn:  dbInst,
+		started: 1,
+	}
+}
+
+// balanceHistoryManagerSetup returns a started manager storing snapshots and
+// changes in memory, valuing BTC via a USDT ticker and EUR at 1.1 USD
+func balanceHistoryManagerSetup(t *testing.T) (m *BalanceHistoryManager, snapshots *[]balance.Snapshot, changes *[]balance.Change) {
+	t.Helper()
+	em := SetupExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	em.Add(bhfExchange{IBotExchange: exch})
+	m, err = SetupBalanceHistoryManager(em, balanceHistoryDatabase(t), &config.BalanceHistoryManager{FiatCurrency: currency.USD})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	var mtx sync.Mutex
+	snapshots = &[]balance.Snapshot{}
+	changes = &[]balance.Change{}
+	m.tickerLoader = func(_ string, p currency.Pair, _ asset.Item) (*ticker.Price, error) {
+		if p.Base.Item == currency.BTC.Item && p.Quote.Item == currency.USDT.Item {
+			return &ticker.Price{Last: 40000}, nil
+		}
+		return nil, errFakeTicker
+	}
+	m.fiatConverter = func(amount float64, from, to currency.Code) (float64, error) {
+		rates := map[*currency.Item]float64{
+			currency.USD.Item:  1,
+			currency.USDT.Item: 1,
+			currency.EUR.Item:  1.1,
+		}
+		fromRate, ok := rates[from.Item]
+		if !ok {
+			return 0, errFakeTicker
+		}
+		toRate, ok := rates[to.Item]
+		if !ok {
+			return 0, errFakeTicker
+		}
+		return amount * fromRate / toRate, nil
+	}
+	m.snapshotSaver = func(s ...balance.Snapshot) error {
+		mtx.Lock()
+		defer mtx.Unlock()
+		*snapshots = append(*snapshots, s...)
+		return nil
+	}
+	m.snapshotLoader = func(exch string, start, end time.Time) ([]balance.Snapshot, error) {
+		mtx.Lock()
+		defer mtx.Unlock()
+		var resp []balance.Snapshot
+		for i := range *snapshots {
+			if (exch == "" || (*snapshots)[i].Exchange == exch) &&
+				!(*snapshots)[i].Timestamp.Before(start) &&
+				!(*snapshots)[i].Timestamp.After(end) {
+				resp = append(resp, (*snapshots)[i])
+			}
+		}
+		return resp, nil
+	}
+	m.changeSaver = func(c ...balance.Change) error {
+		mtx.Lock()
+		defer mtx.Unlock()
+		*changes = append(*changes, c...)
+		return nil
+	}
+	err = m.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	t.Cleanup(func() {
+		if m.IsRunning() {
+			if err := m.Stop(); err != nil {
+				t.Error(err)
+			}
+		}
+	})
+	return m, snapshots, changes
+}
+
+func TestSetupBalanceHistoryManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupBalanceHistoryManager(nil, nil, nil)
+	if !errors.Is(err, errNilExchangeManager) {
+		t.Errorf("received '%v' expected '%v'", err, errNilExchangeManager)
+	}
+	_, err = SetupBalanceHistoryManager(SetupExchangeManager(), nil, nil)
+	if !errors.Is(err, errNilDatabaseConnectionManager) {
+		t.Errorf("received '%v' expected '%v'", err, errNilDatabaseConnectionManager)
+	}
+	_, err = SetupBalanceHistoryManager(SetupExchangeManager(), &DatabaseConnectionManager{}, nil)
+	if !errors.Is(err, errNilConfig) {
+		t.Errorf("received '%v' expected '%v'", err, errNilConfig)
+	}
+	_, err = SetupBalanceHistoryManager(SetupExchangeManager(), &DatabaseConnectionManager{}, &config.BalanceHistoryManager{FiatCurrency: currency.BTC})
+	if !errors.Is(err, errNoFiatCurrency) {
+		t.Errorf("received '%v' expected '%v'", err, errNoFiatCurrency)
+	}
+	_, err = SetupBalanceHistoryManager(SetupExchangeManager(), &DatabaseConnectionManager{}, &config.BalanceHistoryManager{})
+	if !errors.Is(err, database.ErrNilInstance) {
+		t.Errorf("received '%v' expected '%v'", err, database.ErrNilInstance)
+	}
+	m, err := SetupBalanceHistoryManager(SetupExchangeManager(), balanceHistoryDatabase(t), &config.BalanceHistoryManager{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if m.delay != defaultBalanceHistoryDelay || m.fiat != currency.USD {
+		t.Errorf("received '%v' '%v' expected defaults", m.delay, m.fiat)
+	}
+}
+
+func TestBalanceHistoryManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *BalanceHistoryManager
+	if m.IsRunning() {
+		t.Error("expected false")
+	}
+	err := m.Start()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	err = m.Stop()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+
+	m, err = SetupBalanceHistoryManager(SetupExchangeManager(), balanceHistoryDatabase(t), &config.BalanceHistoryManager{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = m.Stop()
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+	err = m.Start()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	err = m.Start()
+	if !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+	if !m.IsRunning() {
+		t.Error("expected true")
+	}
+	err = m.Stop()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+}
+
+func TestBalanceHistoryRecordAllHoldings(t *testing.T) {
+	t.Parallel()
+	m, snapshots, _ := balanceHistoryManagerSetup(t)
+	err := m.recordAllHoldings()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	// empty balances are not recorded
+	if len(*snapshots) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(*snapshots), 3)
+	}
+	rates := map[string]float64{"BTC": 40000, "EUR": 1.1, "LTC": 0}
+	for _, s := range *snapshots {
+		if s.Account != "1337" || s.Asset != asset.Spot.String() || s.FiatCurrency != "USD" {
+			t.Errorf("received '%+v' expected account details", s)
+		}
+		if s.FiatRate != rates[s.Currency] {
+			t.Errorf("received '%v' expected '%v' for %v", s.FiatRate, rates[s.Currency], s.Currency)
+		}
+		if !s.Timestamp.Equal((*snapshots)[0].Timestamp) {
+			t.Error("expected a single timestamp per run")
+		}
+	}
+
+	err = m.RecordHoldings(nil, time.Now())
+	if !errors.Is(err, common.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilPointer)
+	}
+}
+
+func TestBalanceHistoryRecordChange(t *testing.T) {
+	t.Parallel()
+	m, _, changes := balanceHistoryManagerSetup(t)
+	err := m.RecordChange(nil)
+	if !errors.Is(err, common.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilPointer)
+	}
+	err = m.RecordChange(&account.Change{
+		Exchange: testExchange,
+		Currency: currency.BTC,
+		Asset:    asset.Spot,
+		Amount:   -0.5,
+		Account:  "main",
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(*changes) != 1 || (*changes)[0].Amount != -0.5 || (*changes)[0].FiatRate != 40000 {
+		t.Errorf("received '%+v' expected valued change", *changes)
+	}
+
+	err = m.Stop()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = m.RecordChange(&account.Change{})
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+}
+
+func TestBalanceHistoryQueries(t *testing.T) {
+	t.Parallel()
+	m, snapshots, _ := balanceHistoryManagerSetup(t)
+	tt := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	*snapshots = []balance.Snapshot{
+		{Exchange: testExchange, Currency: "BTC", Total: 1, FiatCurrency: "USD", FiatRate: 40000, Timestamp: tt},
+		{Exchange: testExchange, Currency: "BTC", Total: 1, FiatCurrency: "EUR", FiatRate: 40000, Timestamp: tt.Add(time.Hour * 24)},
+	}
+
+	_, err := m.GetEquityCurve("", currency.USD, tt, tt.Add(-time.Hour))
+	if !errors.Is(err, common.ErrStartAfterEnd) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrStartAfterEnd)
+	}
+	_, err = m.GetEquityCurve("", currency.BTC, tt, tt.Add(time.Hour*48))
+	if !errors.Is(err, errNoFiatCurrency) {
+		t.Errorf("received '%v' expected '%v'", err, errNoFiatCurrency)
+	}
+
+	curve, err := m.GetEquityCurve("", currency.Code{}, tt, tt.Add(time.Hour*48))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(curve) != 2 || curve[0].Value != 40000 || curve[1].Value != 44000 {
+		t.Errorf("received '%+v' expected converted equity curve", curve)
+	}
+
+	curve, err = m.GetEquityCurve(testExchange, currency.EUR, tt, tt.Add(time.Hour*48))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(curve) != 2 || curve[1].Value != 40000 {
+		t.Errorf("received '%+v' expected euro equity curve", curve)
+	}
+
+	_, err = m.GetCurrencyHistory("", currency.Code{}, currency.USD, tt, tt.Add(time.Hour*48))
+	if !errors.Is(err, errCurrencyCodeEmpty) {
+		t.Errorf("received '%v' expected '%v'", err, errCurrencyCodeEmpty)
+	}
+	history, err := m.GetCurrencyHistory("", currency.BTC, currency.USD, tt, tt.Add(time.Hour*48))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(history) != 2 || history[1].Total != 1 || history[1].Value != 44000 {
+		t.Errorf("received '%+v' expected btc history", history)
+	}
+
+	pnl, err := m.GetDailyPNL("", currency.USD, tt, tt.Add(time.Hour*48))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(pnl) != 2 || pnl[1].PNL != 4000 || pnl[1].PNLPercentage != 10 {
+		t.Errorf("received '%+v' expected daily pnl", pnl)
+	}
+
+	err = m.Stop()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = m.GetDailyPNL("", currency.USD, tt, tt.Add(time.Hour*48))
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+}
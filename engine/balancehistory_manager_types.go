@@ -0,0 +1,51 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/balance"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+// BalanceHistoryManagerName is an exported subsystem name
+const BalanceHistoryManagerName = "balance_history_manager"
+
+var (
+	errNoFiatCurrency    = errors.New("balances must be valued in a fiat currency")
+	errCurrencyCodeEmpty = errors.New("currency code is empty")
+
+	// defaultBalanceHistoryDelay is the default time between balance
+	// snapshots of all exchanges
+	defaultBalanceHistoryDelay = time.Minute * 5
+	// balanceValuationQuotes are tried in order to value a cryptocurrency
+	// balance through the exchange's ticker, after the manager's fiat currency
+	balanceValuationQuotes = []currency.Code{currency.USD, currency.USDT, currency.EUR}
+)
+
+// BalanceHistoryManager periodically records the account holdings of all
+// exchanges with authenticated API support, as well as websocket balance
+// changes, to the database. Balances are valued in a fiat currency when
+// recorded so equity curves and daily PnL can be rebuilt from the database
+type BalanceHistoryManager struct {
+	started    int32
+	processing int32
+	delay      time.Duration
+	fiat       currency.Code
+	verbose    bool
+
+	exchangeManager            iExchangeManager
+	databaseConnectionInstance database.IDatabase
+	shutdown                   chan struct{}
+	wg                         sync.WaitGroup
+
+	tickerLoader   func(string, currency.Pair, asset.Item) (*ticker.Price, error)
+	fiatConverter  func(float64, currency.Code, currency.Code) (float64, error)
+	snapshotSaver  func(...balance.Snapshot) error
+	snapshotLoader func(string, time.Time, time.Time) ([]balance.Snapshot, error)
+	changeSaver    func(...balance.Change) error
+}
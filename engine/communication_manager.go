@@ -95,6 +95,14 @@ func (m *CommunicationManager) PushEvent(evt base.Event) {
 	}
 }
 
+// PushEventTo pushes an event directly to a single communications relayer
+func (m *CommunicationManager) PushEventTo(relayer string, evt base.Event) error {
+	if !m.IsRunning() {
+		return fmt.Errorf("communications manager %w", ErrSubSystemNotStarted)
+	}
+	return m.comms.PushEventTo(relayer, evt)
+}
+
 // run takes awaiting messages and pushes them to be handled by communications
 func (m *CommunicationManager) run() {
 	log.Debugf(log.Global, "Communications manager %s", MsgSubSystemStarted)
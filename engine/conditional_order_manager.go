@@ -0,0 +1,509 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupConditionalOrderManager will boot up the ConditionalOrderManager
+func SetupConditionalOrderManager(exchangeManager iExchangeManager, orderManager iOrderSubmitter, communicationsManager iCommsManager, verbose bool) (*ConditionalOrderManager, error) {
+	if exchangeManager == nil {
+		return nil, errNilExchangeManager
+	}
+	if orderManager == nil {
+		return nil, errNilOrderManager
+	}
+	if communicationsManager == nil {
+		return nil, errNilCommunicationsManager
+	}
+	return &ConditionalOrderManager{
+		shutdown:        make(chan struct{}),
+		exchangeManager: exchangeManager,
+		orderManager:    orderManager,
+		commsManager:    communicationsManager,
+		verbose:         verbose,
+		orders:          make(map[uuid.UUID]*ConditionalOrder),
+		watching:        make(map[string]bool),
+	}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *ConditionalOrderManager) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return atomic.LoadInt32(&m.started) == 1
+}
+
+// Start runs the subsystem
+func (m *ConditionalOrderManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("conditional order manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("conditional order manager %w", ErrSubSystemAlreadyStarted)
+	}
+	log.Debugln(log.OrderMgr, "Conditional order manager starting...")
+	m.shutdown = make(chan struct{})
+	m.m.Lock()
+	defer m.m.Unlock()
+	for _, o := range m.orders {
+		if o.Status == ConditionalOrderPending {
+			m.watchExchange(o.Exchange)
+		}
+	}
+	log.Debugln(log.OrderMgr, "Conditional order manager started.")
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem. Pending conditional orders are
+// retained and resume monitoring if the subsystem is started again
+func (m *ConditionalOrderManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("conditional order manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return fmt.Errorf("conditional order manager %w", ErrSubSystemNotStarted)
+	}
+	log.Debugln(log.OrderMgr, "Conditional order manager shutting down...")
+	close(m.shutdown)
+	m.wg.Wait()
+	m.m.Lock()
+	m.watching = make(map[string]bool)
+	m.m.Unlock()
+	log.Debugln(log.OrderMgr, "Conditional order manager shutdown.")
+	return nil
+}
+
+// Add validates and stores a conditional order to be monitored. The returned
+// order contains the generated ID
+func (m *ConditionalOrderManager) Add(o *ConditionalOrder) (*ConditionalOrder, error) {
+	if m == nil {
+		return nil, fmt.Errorf("conditional order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("conditional order manager %w", ErrSubSystemNotStarted)
+	}
+	err := m.validate(o)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	cpy := *o
+	cpy.ID = id
+	cpy.Status = ConditionalOrderPending
+	cpy.CreatedAt = time.Now()
+	cpy.UpdatedAt = cpy.CreatedAt
+	cpy.ReferencePrice = 0
+	if cpy.Type == order.TrailingStop {
+		// seed the trailing reference with the current price where one is
+		// available, otherwise the first price update will set it
+		if t, tErr := ticker.GetTicker(cpy.Exchange, cpy.Pair, cpy.AssetType); tErr == nil && t.Last > 0 {
+			cpy.ReferencePrice = t.Last
+		}
+	}
+
+	m.m.Lock()
+	m.orders[id] = &cpy
+	m.watchExchange(cpy.Exchange)
+	m.m.Unlock()
+
+	log.Infof(log.OrderMgr,
+		"Conditional order manager: Added %s %s %s %s conditional order ID=%v amount=%v trigger=%v",
+		cpy.Exchange, cpy.Pair, cpy.Side, cpy.Type, cpy.ID, cpy.Amount, cpy.TriggerPrice)
+	resp := cpy
+	return &resp, nil
+}
+
+// Cancel stops a pending conditional order from being monitored
+func (m *ConditionalOrderManager) Cancel(id uuid.UUID) error {
+	if m == nil {
+		return fmt.Errorf("conditional order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("conditional order manager %w", ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	o, ok := m.orders[id]
+	if !ok {
+		return fmt.Errorf("%w %v", errConditionalOrderNotFound, id)
+	}
+	if o.Status != ConditionalOrderPending {
+		return fmt.Errorf("%w %v status %v", errConditionalOrderNotPending, id, o.Status)
+	}
+	o.Status = ConditionalOrderCancelled
+	o.UpdatedAt = time.Now()
+	return nil
+}
+
+// GetOrders returns a copy of stored conditional orders, optionally filtered
+// by exchange. Orders which are no longer pending are only returned when
+// includeInactive is set
+func (m *ConditionalOrderManager) GetOrders(exchangeName string, includeInactive bool) ([]ConditionalOrder, error) {
+	if m == nil {
+		return nil, fmt.Errorf("conditional order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("conditional order manager %w", ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	resp := make([]ConditionalOrder, 0, len(m.orders))
+	for _, o := range m.orders {
+		if exchangeName != "" && !strings.EqualFold(o.Exchange, exchangeName) {
+			continue
+		}
+		if !includeInactive && o.Status != ConditionalOrderPending {
+			continue
+		}
+		resp = append(resp, *o)
+	}
+	sortConditionalOrders(resp)
+	return resp, nil
+}
+
+// GetTriggerHistory returns all conditional order triggers, optionally
+// filtered by exchange
+func (m *ConditionalOrderManager) GetTriggerHistory(exchangeName string) ([]ConditionalOrderTrigger, error) {
+	if m == nil {
+		return nil, fmt.Errorf("conditional order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("conditional order manager %w", ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	resp := make([]ConditionalOrderTrigger, 0, len(m.history))
+	for i := range m.history {
+		if exchangeName != "" && !strings.EqualFold(m.history[i].Exchange, exchangeName) {
+			continue
+		}
+		resp = append(resp, m.history[i])
+	}
+	return resp, nil
+}
+
+// validate checks a conditional order before it is stored
+func (m *ConditionalOrderManager) validate(o *ConditionalOrder) error {
+	if o == nil {
+		return errNilConditionalOrder
+	}
+	if o.Exchange == "" {
+		return errConditionalOrderExchangeEmpty
+	}
+	exch, err := m.exchangeManager.GetExchangeByName(o.Exchange)
+	if err != nil {
+		return err
+	}
+	o.Exchange = exch.GetName()
+	if o.Pair.IsEmpty() {
+		return order.ErrPairIsEmpty
+	}
+	if !o.AssetType.IsValid() {
+		return fmt.Errorf("%w %v", asset.ErrNotSupported, o.AssetType)
+	}
+	switch o.Side {
+	case order.Buy, order.Bid:
+		o.Side = order.Buy
+	case order.Sell, order.Ask:
+		o.Side = order.Sell
+	default:
+		return fmt.Errorf("%w received %v", errInvalidConditionalSide, o.Side)
+	}
+	if o.Amount <= 0 {
+		return errInvalidConditionalAmount
+	}
+	switch o.Type {
+	case order.Stop, order.TakeProfit:
+		if o.TriggerPrice <= 0 {
+			return errInvalidTriggerPrice
+		}
+	case order.StopLimit:
+		if o.TriggerPrice <= 0 {
+			return errInvalidTriggerPrice
+		}
+		if o.LimitPrice <= 0 {
+			return errInvalidLimitPrice
+		}
+	case order.TrailingStop:
+		if o.TrailingAmount <= 0 && (o.TrailingPercent <= 0 || o.TrailingPercent >= 100) {
+			return errInvalidTrailingDistance
+		}
+	default:
+		return fmt.Errorf("%w %v", errUnsupportedConditionalType, o.Type)
+	}
+	return nil
+}
+
+// watchExchange starts monitoring the ticker and orderbook feeds of an
+// exchange if it is not already being watched. Must be called with the lock
+// held
+func (m *ConditionalOrderManager) watchExchange(exchangeName string) {
+	if atomic.LoadInt32(&m.started) == 0 {
+		return
+	}
+	name := strings.ToLower(exchangeName)
+	if m.watching[name] {
+		return
+	}
+	m.watching[name] = true
+	m.wg.Add(1)
+	go m.watch(name)
+}
+
+// watch listens for ticker and orderbook updates for an exchange. Feeds which
+// do not yet exist are resubscribed to periodically until available
+func (m *ConditionalOrderManager) watch(exchangeName string) {
+	defer m.wg.Done()
+	var tickerPipe, orderbookPipe *dispatch.Pipe
+	defer func() {
+		for _, p := range []*dispatch.Pipe{tickerPipe, orderbookPipe} {
+			if p == nil {
+				continue
+			}
+			if err := p.Release(); err != nil {
+				log.Errorln(log.DispatchMgr, err)
+			}
+		}
+	}()
+
+	resubscribe := time.NewTimer(0)
+	defer resubscribe.Stop()
+	for {
+		var tickerC, orderbookC chan interface{}
+		if tickerPipe != nil {
+			tickerC = tickerPipe.C
+		}
+		if orderbookPipe != nil {
+			orderbookC = orderbookPipe.C
+		}
+		select {
+		case <-m.shutdown:
+			return
+		case <-resubscribe.C:
+			if tickerPipe == nil {
+				if p, err := ticker.SubscribeToExchangeTickers(exchangeName); err == nil {
+					tickerPipe = &p
+				}
+			}
+			if orderbookPipe == nil {
+				if p, err := orderbook.SubscribeToExchangeOrderbooks(exchangeName); err == nil {
+					orderbookPipe = &p
+				}
+			}
+			if tickerPipe == nil || orderbookPipe == nil {
+				resubscribe.Reset(conditionalOrderResubscribeDelay)
+			}
+		case data, ok := <-tickerC:
+			if !ok {
+				tickerPipe = nil
+				resubscribe.Reset(conditionalOrderResubscribeDelay)
+				continue
+			}
+			m.processUpdate(data)
+		case data, ok := <-orderbookC:
+			if !ok {
+				orderbookPipe = nil
+				resubscribe.Reset(conditionalOrderResubscribeDelay)
+				continue
+			}
+			m.processUpdate(data)
+		}
+	}
+}
+
+// processUpdate checks pending orders against a dispatched ticker or
+// orderbook update. Ticker updates are checked against the last traded price
+// and orderbook updates against the best price the child order would execute
+// against
+func (m *ConditionalOrderManager) processUpdate(data interface{}) {
+	if ptr, ok := data.(*interface{}); ok && ptr != nil {
+		data = *ptr
+	}
+	switch d := data.(type) {
+	case ticker.Price:
+		m.checkTriggers(d.ExchangeName, d.Pair, d.AssetType, func(order.Side) float64 {
+			return d.Last
+		})
+	case *ticker.Price:
+		m.processUpdate(*d)
+	case orderbook.Base:
+		m.checkTriggers(d.Exchange, d.Pair, d.Asset, func(s order.Side) float64 {
+			if s == order.Sell {
+				if len(d.Bids) == 0 {
+					return 0
+				}
+				return d.Bids[0].Price
+			}
+			if len(d.Asks) == 0 {
+				return 0
+			}
+			return d.Asks[0].Price
+		})
+	case *orderbook.Base:
+		m.processUpdate(*d)
+	}
+}
+
+// checkTriggers evaluates all pending orders for the exchange, pair and asset
+// and submits the child orders of any which have triggered
+func (m *ConditionalOrderManager) checkTriggers(exchangeName string, p currency.Pair, a asset.Item, priceFor func(order.Side) float64) {
+	var triggered []ConditionalOrder
+	m.m.Lock()
+	for _, o := range m.orders {
+		if o.Status != ConditionalOrderPending ||
+			o.AssetType != a ||
+			!strings.EqualFold(o.Exchange, exchangeName) ||
+			!o.Pair.Equal(p) {
+			continue
+		}
+		price := priceFor(o.Side)
+		if price <= 0 || !o.shouldTrigger(price) {
+			continue
+		}
+		o.Status = ConditionalOrderTriggered
+		o.TriggeredPrice = price
+		o.TriggeredAt = time.Now()
+		o.UpdatedAt = o.TriggeredAt
+		triggered = append(triggered, *o)
+	}
+	m.m.Unlock()
+
+	for i := range triggered {
+		m.submit(&triggered[i])
+	}
+}
+
+// shouldTrigger returns whether the price meets the order's trigger condition.
+// Trailing stops also update their reference price
+func (o *ConditionalOrder) shouldTrigger(price float64) bool {
+	switch o.Type {
+	case order.Stop, order.StopLimit:
+		if o.Side == order.Sell {
+			return price <= o.TriggerPrice
+		}
+		return price >= o.TriggerPrice
+	case order.TakeProfit:
+		if o.Side == order.Sell {
+			return price >= o.TriggerPrice
+		}
+		return price <= o.TriggerPrice
+	case order.TrailingStop:
+		if o.ReferencePrice == 0 ||
+			(o.Side == order.Sell && price > o.ReferencePrice) ||
+			(o.Side == order.Buy && price < o.ReferencePrice) {
+			o.ReferencePrice = price
+			o.UpdatedAt = time.Now()
+		}
+		o.TriggerPrice = o.trailingTriggerPrice()
+		if o.Side == order.Sell {
+			return price <= o.TriggerPrice
+		}
+		return price >= o.TriggerPrice
+	}
+	return false
+}
+
+// trailingTriggerPrice returns the current trigger price of a trailing stop
+func (o *ConditionalOrder) trailingTriggerPrice() float64 {
+	distance := o.TrailingAmount
+	if distance <= 0 {
+		distance = o.ReferencePrice * o.TrailingPercent / 100
+	}
+	if o.Side == order.Sell {
+		return o.ReferencePrice - distance
+	}
+	return o.ReferencePrice + distance
+}
+
+// submit places the child order of a triggered conditional order via the
+// order manager and records the outcome
+func (m *ConditionalOrderManager) submit(o *ConditionalOrder) {
+	submission := &order.Submit{
+		Exchange:  o.Exchange,
+		Pair:      o.Pair,
+		AssetType: o.AssetType,
+		Side:      o.Side,
+		Type:      order.Market,
+		Amount:    o.Amount,
+	}
+	if o.Type == order.StopLimit {
+		submission.Type = order.Limit
+		submission.Price = o.LimitPrice
+	}
+	status := ConditionalOrderSubmitted
+	var orderID, errMsg string
+	resp, err := m.orderManager.Submit(context.TODO(), submission)
+	if err != nil {
+		status = ConditionalOrderFailed
+		errMsg = err.Error()
+	} else {
+		orderID = resp.OrderID
+	}
+
+	m.m.Lock()
+	if stored, ok := m.orders[o.ID]; ok {
+		stored.Status = status
+		stored.OrderID = orderID
+		stored.Error = errMsg
+		stored.UpdatedAt = time.Now()
+	}
+	m.history = append(m.history, ConditionalOrderTrigger{
+		ConditionalOrderID: o.ID,
+		Exchange:           o.Exchange,
+		Pair:               o.Pair,
+		AssetType:          o.AssetType,
+		Type:               o.Type,
+		Side:               o.Side,
+		TriggerPrice:       o.TriggerPrice,
+		TriggeredPrice:     o.TriggeredPrice,
+		OrderID:            orderID,
+		Error:              errMsg,
+		Time:               o.TriggeredAt,
+	})
+	m.m.Unlock()
+
+	var msg string
+	if err != nil {
+		msg = fmt.Sprintf("Conditional order manager: %s %s %s conditional order ID=%v triggered at %v but failed to submit: %v",
+			o.Exchange, o.Pair, o.Type, o.ID, o.TriggeredPrice, err)
+		log.Errorln(log.OrderMgr, msg)
+	} else {
+		msg = fmt.Sprintf("Conditional order manager: %s %s %s conditional order ID=%v triggered at %v, submitted order ID=%v.",
+			o.Exchange, o.Pair, o.Type, o.ID, o.TriggeredPrice, orderID)
+		log.Infoln(log.OrderMgr, msg)
+	}
+	m.commsManager.PushEvent(base.Event{
+		Type:    "order",
+		Message: msg,
+	})
+	if o.onSubmit != nil {
+		o.onSubmit(orderID, err)
+	}
+}
+
+// sortConditionalOrders sorts orders by creation time
+func sortConditionalOrders(orders []ConditionalOrder) {
+	sort.Slice(orders, func(i, j int) bool {
+		return orders[i].CreatedAt.Before(orders[j].CreatedAt)
+	})
+}
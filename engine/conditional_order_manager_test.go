@@ -0,0 +1,349 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+var errFakeSubmit = errors.New("fake submit error")
+
+// fakeOrderSubmitter records submitted orders instead of sending them to an
+// exchange
+type fakeOrderSubmitter struct {
+	m         sync.Mutex
+	submitted []order.Submit
+	fail      bool
+}
+
+func (f *fakeOrderSubmitter) Submit(_ context.Context, s *order.Submit) (*OrderSubmitResponse, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	if f.fail {
+		return nil, errFakeSubmit
+	}
+	f.submitted = append(f.submitted, *s)
+	return &OrderSubmitResponse{
+		SubmitResponse: order.SubmitResponse{
+			IsOrderPlaced: true,
+			OrderID:       "fakeOrder",
+		},
+	}, nil
+}
+
+func conditionalOrderManagerSetup(t *testing.T) (*ConditionalOrderManager, *fakeOrderSubmitter) {
+	t.Helper()
+	em := SetupExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	em.Add(exch)
+	f := &fakeOrderSubmitter{}
+	m, err := SetupConditionalOrderManager(em, f, &CommunicationManager{}, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	t.Cleanup(func() {
+		if m.IsRunning() {
+			if err := m.Stop(); err != nil {
+				t.Error(err)
+			}
+		}
+	})
+	return m, f
+}
+
+func TestSetupConditionalOrderManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupConditionalOrderManager(nil, nil, nil, false)
+	if !errors.Is(err, errNilExchangeManager) {
+		t.Errorf("error '%v', expected '%v'", err, errNilExchangeManager)
+	}
+	_, err = SetupConditionalOrderManager(SetupExchangeManager(), nil, nil, false)
+	if !errors.Is(err, errNilOrderManager) {
+		t.Errorf("error '%v', expected '%v'", err, errNilOrderManager)
+	}
+	_, err = SetupConditionalOrderManager(SetupExchangeManager(), &fakeOrderSubmitter{}, nil, false)
+	if !errors.Is(err, errNilCommunicationsManager) {
+		t.Errorf("error '%v', expected '%v'", err, errNilCommunicationsManager)
+	}
+	m, err := SetupConditionalOrderManager(SetupExchangeManager(), &fakeOrderSubmitter{}, &CommunicationManager{}, false)
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	if m == nil {
+		t.Error("expected manager")
+	}
+}
+
+func TestConditionalOrderManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *ConditionalOrderManager
+	if m.IsRunning() {
+		t.Error("expected false")
+	}
+	err := m.Start()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+	err = m.Stop()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+
+	m, err = SetupConditionalOrderManager(SetupExchangeManager(), &fakeOrderSubmitter{}, &CommunicationManager{}, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.Stop()
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemNotStarted)
+	}
+	err = m.Start()
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	if !m.IsRunning() {
+		t.Error("expected true")
+	}
+	err = m.Start()
+	if !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+	err = m.Stop()
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+}
+
+func TestConditionalOrderManagerAdd(t *testing.T) {
+	t.Parallel()
+	m, _ := conditionalOrderManagerSetup(t)
+	p := currency.NewPair(currency.BTC, currency.USD)
+	testCases := []struct {
+		name string
+		o    *ConditionalOrder
+		err  error
+	}{
+		{"nil", nil, errNilConditionalOrder},
+		{"no exchange", &ConditionalOrder{}, errConditionalOrderExchangeEmpty},
+		{"unknown exchange", &ConditionalOrder{Exchange: "bruh"}, ErrExchangeNotFound},
+		{"no pair", &ConditionalOrder{Exchange: testExchange}, order.ErrPairIsEmpty},
+		{"bad asset", &ConditionalOrder{Exchange: testExchange, Pair: p, AssetType: "bruh"}, asset.ErrNotSupported},
+		{"bad side", &ConditionalOrder{Exchange: testExchange, Pair: p, AssetType: asset.Spot}, errInvalidConditionalSide},
+		{"no amount", &ConditionalOrder{Exchange: testExchange, Pair: p, AssetType: asset.Spot, Side: order.Sell}, errInvalidConditionalAmount},
+		{"bad type", &ConditionalOrder{Exchange: testExchange, Pair: p, AssetType: asset.Spot, Side: order.Sell, Amount: 1, Type: order.Limit}, errUnsupportedConditionalType},
+		{"stop no trigger", &ConditionalOrder{Exchange: testExchange, Pair: p, AssetType: asset.Spot, Side: order.Sell, Amount: 1, Type: order.Stop}, errInvalidTriggerPrice},
+		{"stop limit no limit", &ConditionalOrder{Exchange: testExchange, Pair: p, AssetType: asset.Spot, Side: order.Sell, Amount: 1, Type: order.StopLimit, TriggerPrice: 1}, errInvalidLimitPrice},
+		{"trailing no distance", &ConditionalOrder{Exchange: testExchange, Pair: p, AssetType: asset.Spot, Side: order.Sell, Amount: 1, Type: order.TrailingStop}, errInvalidTrailingDistance},
+		{"valid", &ConditionalOrder{Exchange: testExchange, Pair: p, AssetType: asset.Spot, Side: order.Ask, Amount: 1, Type: order.TakeProfit, TriggerPrice: 1}, nil},
+	}
+	for i := range testCases {
+		tt := testCases[i]
+		t.Run(tt.name, func(t *testing.T) {
+			o, err := m.Add(tt.o)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("error '%v', expected '%v'", err, tt.err)
+			}
+			if tt.err != nil {
+				return
+			}
+			if o.ID.IsNil() {
+				t.Error("expected ID to be set")
+			}
+			if o.Status != ConditionalOrderPending {
+				t.Errorf("received '%v', expected '%v'", o.Status, ConditionalOrderPending)
+			}
+			if o.Side != order.Sell {
+				t.Errorf("received '%v', expected '%v'", o.Side, order.Sell)
+			}
+		})
+	}
+}
+
+func TestConditionalOrderManagerCancel(t *testing.T) {
+	t.Parallel()
+	m, _ := conditionalOrderManagerSetup(t)
+	err := m.Cancel(uuid.Nil)
+	if !errors.Is(err, errConditionalOrderNotFound) {
+		t.Errorf("error '%v', expected '%v'", err, errConditionalOrderNotFound)
+	}
+	o, err := m.Add(&ConditionalOrder{
+		Exchange:     testExchange,
+		Pair:         currency.NewPair(currency.BTC, currency.USD),
+		AssetType:    asset.Spot,
+		Side:         order.Sell,
+		Type:         order.Stop,
+		Amount:       1,
+		TriggerPrice: 100,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.Cancel(o.ID)
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.Cancel(o.ID)
+	if !errors.Is(err, errConditionalOrderNotPending) {
+		t.Errorf("error '%v', expected '%v'", err, errConditionalOrderNotPending)
+	}
+
+	orders, err := m.GetOrders("", false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(orders) != 0 {
+		t.Errorf("received '%v', expected '%v'", len(orders), 0)
+	}
+	orders, err = m.GetOrders(testExchange, true)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(orders) != 1 || orders[0].Status != ConditionalOrderCancelled {
+		t.Errorf("expected one cancelled order, received %+v", orders)
+	}
+}
+
+func TestConditionalOrderShouldTrigger(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name   string
+		o      ConditionalOrder
+		prices []float64
+		want   bool
+	}{
+		{"sell stop above", ConditionalOrder{Type: order.Stop, Side: order.Sell, TriggerPrice: 100}, []float64{101}, false},
+		{"sell stop hit", ConditionalOrder{Type: order.Stop, Side: order.Sell, TriggerPrice: 100}, []float64{100}, true},
+		{"buy stop below", ConditionalOrder{Type: order.StopLimit, Side: order.Buy, TriggerPrice: 100}, []float64{99}, false},
+		{"buy stop hit", ConditionalOrder{Type: order.StopLimit, Side: order.Buy, TriggerPrice: 100}, []float64{101}, true},
+		{"sell take profit below", ConditionalOrder{Type: order.TakeProfit, Side: order.Sell, TriggerPrice: 100}, []float64{99}, false},
+		{"sell take profit hit", ConditionalOrder{Type: order.TakeProfit, Side: order.Sell, TriggerPrice: 100}, []float64{100}, true},
+		{"buy take profit hit", ConditionalOrder{Type: order.TakeProfit, Side: order.Buy, TriggerPrice: 100}, []float64{99}, true},
+		{"sell trailing follows up", ConditionalOrder{Type: order.TrailingStop, Side: order.Sell, TrailingAmount: 10}, []float64{100, 120, 111}, false},
+		{"sell trailing hit", ConditionalOrder{Type: order.TrailingStop, Side: order.Sell, TrailingAmount: 10}, []float64{100, 120, 110}, true},
+		{"buy trailing percent hit", ConditionalOrder{Type: order.TrailingStop, Side: order.Buy, TrailingPercent: 10}, []float64{100, 80, 88}, true},
+		{"buy trailing percent follows down", ConditionalOrder{Type: order.TrailingStop, Side: order.Buy, TrailingPercent: 10}, []float64{100, 80, 87}, false},
+	}
+	for i := range testCases {
+		tt := testCases[i]
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var got bool
+			for _, p := range tt.prices {
+				got = tt.o.shouldTrigger(p)
+			}
+			if got != tt.want {
+				t.Errorf("received '%v', expected '%v'", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConditionalOrderProcessUpdate(t *testing.T) {
+	t.Parallel()
+	m, f := conditionalOrderManagerSetup(t)
+	p := currency.NewPair(currency.BTC, currency.USD)
+	stop, err := m.Add(&ConditionalOrder{
+		Exchange:     testExchange,
+		Pair:         p,
+		AssetType:    asset.Spot,
+		Side:         order.Sell,
+		Type:         order.StopLimit,
+		Amount:       1,
+		TriggerPrice: 100,
+		LimitPrice:   99,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	takeProfit, err := m.Add(&ConditionalOrder{
+		Exchange:     testExchange,
+		Pair:         p,
+		AssetType:    asset.Spot,
+		Side:         order.Sell,
+		Type:         order.TakeProfit,
+		Amount:       2,
+		TriggerPrice: 200,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+
+	var update interface{} = ticker.Price{
+		ExchangeName: testExchange,
+		Pair:         p,
+		AssetType:    asset.Spot,
+		Last:         150,
+	}
+	m.processUpdate(&update)
+	if len(f.submitted) != 0 {
+		t.Fatalf("received '%v', expected '%v'", len(f.submitted), 0)
+	}
+
+	// sell orders are checked against the best bid
+	m.processUpdate(&orderbook.Base{
+		Exchange: testExchange,
+		Pair:     p,
+		Asset:    asset.Spot,
+		Bids:     orderbook.Items{{Price: 95, Amount: 1}},
+		Asks:     orderbook.Items{{Price: 250, Amount: 1}},
+	})
+	if len(f.submitted) != 1 {
+		t.Fatalf("received '%v', expected '%v'", len(f.submitted), 1)
+	}
+	if f.submitted[0].Type != order.Limit || f.submitted[0].Price != 99 {
+		t.Errorf("expected limit order at 99, received %v at %v", f.submitted[0].Type, f.submitted[0].Price)
+	}
+
+	f.fail = true
+	update = ticker.Price{
+		ExchangeName: testExchange,
+		Pair:         p,
+		AssetType:    asset.Spot,
+		Last:         200,
+	}
+	m.processUpdate(&update)
+
+	orders, err := m.GetOrders("", true)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	for i := range orders {
+		switch orders[i].ID {
+		case stop.ID:
+			if orders[i].Status != ConditionalOrderSubmitted || orders[i].OrderID != "fakeOrder" {
+				t.Errorf("unexpected stop order state %+v", orders[i])
+			}
+		case takeProfit.ID:
+			if orders[i].Status != ConditionalOrderFailed || orders[i].Error == "" {
+				t.Errorf("unexpected take profit order state %+v", orders[i])
+			}
+		}
+	}
+
+	history, err := m.GetTriggerHistory(testExchange)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(history) != 2 {
+		t.Fatalf("received '%v', expected '%v'", len(history), 2)
+	}
+	if history[0].ConditionalOrderID != stop.ID || history[0].TriggeredPrice != 95 {
+		t.Errorf("unexpected trigger %+v", history[0])
+	}
+}
@@ -0,0 +1,120 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// ConditionalOrderManagerName is an exported subsystem name
+const ConditionalOrderManagerName = "conditional_order_manager"
+
+// ConditionalOrderStatus defines the state of a conditional order
+type ConditionalOrderStatus string
+
+// Conditional order statuses
+const (
+	// ConditionalOrderPending orders are monitored and waiting to trigger
+	ConditionalOrderPending ConditionalOrderStatus = "PENDING"
+	// ConditionalOrderTriggered orders have triggered and are being submitted
+	ConditionalOrderTriggered ConditionalOrderStatus = "TRIGGERED"
+	// ConditionalOrderSubmitted orders have had their child order placed
+	ConditionalOrderSubmitted ConditionalOrderStatus = "SUBMITTED"
+	// ConditionalOrderFailed orders triggered but the child order failed
+	ConditionalOrderFailed ConditionalOrderStatus = "FAILED"
+	// ConditionalOrderCancelled orders were cancelled before triggering
+	ConditionalOrderCancelled ConditionalOrderStatus = "CANCELLED"
+)
+
+var (
+	conditionalOrderResubscribeDelay = time.Second * 5
+
+	errConditionalOrderNotFound      = errors.New("conditional order not found")
+	errConditionalOrderNotPending    = errors.New("conditional order is no longer pending")
+	errUnsupportedConditionalType    = errors.New("unsupported conditional order type")
+	errInvalidConditionalSide        = errors.New("conditional order side must be buy or sell")
+	errInvalidTriggerPrice           = errors.New("trigger price must be greater than zero")
+	errInvalidLimitPrice             = errors.New("limit price must be greater than zero")
+	errInvalidTrailingDistance       = errors.New("trailing stop requires either a trailing amount or trailing percentage")
+	errInvalidConditionalAmount      = errors.New("amount must be greater than zero")
+	errNilConditionalOrder           = errors.New("nil conditional order received")
+	errConditionalOrderExchangeEmpty = errors.New("conditional order exchange name is empty")
+)
+
+// ConditionalOrderManager holds stop-loss, take-profit and trailing stop
+// orders locally and monitors ticker and orderbook updates. When a trigger is
+// hit, the child order is submitted via the order manager
+type ConditionalOrderManager struct {
+	started         int32
+	shutdown        chan struct{}
+	wg              sync.WaitGroup
+	exchangeManager iExchangeManager
+	orderManager    iOrderSubmitter
+	commsManager    iCommsManager
+	verbose         bool
+
+	m        sync.Mutex
+	orders   map[uuid.UUID]*ConditionalOrder
+	history  []ConditionalOrderTrigger
+	watching map[string]bool
+}
+
+// ConditionalOrder defines an order which is held by the engine until its
+// trigger condition is met
+type ConditionalOrder struct {
+	ID        uuid.UUID
+	Exchange  string
+	Pair      currency.Pair
+	AssetType asset.Item
+	// Type is one of Stop, StopLimit, TakeProfit or TrailingStop
+	Type order.Type
+	// Side is the side of the child order submitted once triggered
+	Side   order.Side
+	Amount float64
+	// TriggerPrice is the price at which Stop, StopLimit and TakeProfit
+	// orders trigger
+	TriggerPrice float64
+	// LimitPrice is the price of the child limit order for StopLimit orders.
+	// All other types submit a market order
+	LimitPrice float64
+	// TrailingAmount is the absolute distance a trailing stop follows the
+	// market by
+	TrailingAmount float64
+	// TrailingPercent is the percentage distance a trailing stop follows the
+	// market by, used when TrailingAmount is not set
+	TrailingPercent float64
+	Status          ConditionalOrderStatus
+	// ReferencePrice tracks the best price seen by a trailing stop, the
+	// highest price for sell orders and the lowest for buy orders
+	ReferencePrice float64
+	TriggeredPrice float64
+	OrderID        string
+	Error          string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	TriggeredAt    time.Time
+	// onSubmit is called once the child order has been submitted, allowing
+	// order groups to track legs held by the conditional order manager
+	onSubmit func(orderID string, err error)
+}
+
+// ConditionalOrderTrigger is a historical record of a conditional order
+// triggering
+type ConditionalOrderTrigger struct {
+	ConditionalOrderID uuid.UUID
+	Exchange           string
+	Pair               currency.Pair
+	AssetType          asset.Item
+	Type               order.Type
+	Side               order.Side
+	TriggerPrice       float64
+	TriggeredPrice     float64
+	OrderID            string
+	Error              string
+	Time               time.Time
+}
@@ -0,0 +1,340 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupConsolidatedOrderbookManager creates a consolidated orderbook manager
+// subsystem
+func SetupConsolidatedOrderbookManager(em iExchangeManager, cfg *config.ConsolidatedOrderbookManager) (*ConsolidatedOrderbookManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	m := &ConsolidatedOrderbookManager{
+		shutdown:        make(chan struct{}),
+		exchangeManager: em,
+		verbose:         cfg.Verbose,
+		feeOverrides:    make(map[string]float64),
+		fees:            make(map[string]float64),
+		orderbookLoader: orderbook.Get,
+		fiatConverter:   currency.ConvertCurrency,
+	}
+	for k, v := range cfg.Fees {
+		m.feeOverrides[strings.ToLower(k)] = v
+	}
+	for i := range cfg.Books {
+		b := consolidatedBook{
+			pair:      cfg.Books[i].Pair,
+			asset:     asset.Spot,
+			exchanges: cfg.Books[i].Exchanges,
+			quotes:    []quoteConversion{{currency: cfg.Books[i].Pair.Quote, rate: 1}},
+		}
+		if b.pair.IsEmpty() {
+			return nil, fmt.Errorf("consolidated orderbook %d %w", i, errCurrencyPairUnset)
+		}
+		if cfg.Books[i].Asset != "" {
+			a, err := asset.New(cfg.Books[i].Asset)
+			if err != nil {
+				return nil, fmt.Errorf("consolidated orderbook %s %w", b.pair, err)
+			}
+			b.asset = a
+		}
+		for j := range cfg.Books[i].EquivalentQuotes {
+			q := cfg.Books[i].EquivalentQuotes[j]
+			if q.Currency.Match(b.pair.Quote) {
+				continue
+			}
+			b.quotes = append(b.quotes, quoteConversion{currency: q.Currency, rate: q.Rate})
+		}
+		m.books = append(m.books, b)
+	}
+	return m, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *ConsolidatedOrderbookManager) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return atomic.LoadInt32(&m.started) == 1
+}
+
+// Start runs the subsystem, watching the orderbooks of every exchange in a
+// configured consolidated orderbook
+func (m *ConsolidatedOrderbookManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("consolidated orderbook manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("consolidated orderbook manager %w", ErrSubSystemAlreadyStarted)
+	}
+	m.shutdown = make(chan struct{})
+	watching := make(map[string]bool)
+	for i := range m.books {
+		exchanges, err := m.getExchangeNames(&m.books[i])
+		if err != nil {
+			atomic.StoreInt32(&m.started, 0)
+			return err
+		}
+		for j := range exchanges {
+			name := strings.ToLower(exchanges[j])
+			if watching[name] {
+				continue
+			}
+			watching[name] = true
+			m.wg.Add(1)
+			go m.watch(name)
+		}
+	}
+	log.Debugf(log.OrderBook, "Consolidated orderbook manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem
+func (m *ConsolidatedOrderbookManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("consolidated orderbook manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return fmt.Errorf("consolidated orderbook manager %w", ErrSubSystemNotStarted)
+	}
+	close(m.shutdown)
+	m.wg.Wait()
+	log.Debugf(log.OrderBook, "Consolidated orderbook manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// GetConsolidatedOrderbook rebuilds and publishes the consolidated orderbook
+// of a configured pair from the latest exchange orderbooks
+func (m *ConsolidatedOrderbookManager) GetConsolidatedOrderbook(p currency.Pair, a asset.Item) (*orderbook.ConsolidatedBase, error) {
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("consolidated orderbook manager %w", ErrSubSystemNotStarted)
+	}
+	for i := range m.books {
+		if m.books[i].asset == a && m.books[i].pair.Equal(p) {
+			return m.consolidate(&m.books[i])
+		}
+	}
+	return nil, fmt.Errorf("%w for %s %s", errConsolidatedOrderbookNotConfigured, p, a)
+}
+
+// SimulateOrder simulates an order across all exchanges of a consolidated
+// orderbook. Buy amounts are in the quote currency and sell amounts in the
+// base currency
+func (m *ConsolidatedOrderbookManager) SimulateOrder(p currency.Pair, a asset.Item, amount float64, buy bool) (*orderbook.ConsolidatedSimulationResult, error) {
+	c, err := m.GetConsolidatedOrderbook(p, a)
+	if err != nil {
+		return nil, err
+	}
+	return c.SimulateOrder(amount, buy)
+}
+
+// WhaleBomb finds the amount required across all exchanges of a consolidated
+// orderbook to move its fee adjusted price to the price target
+func (m *ConsolidatedOrderbookManager) WhaleBomb(p currency.Pair, a asset.Item, priceTarget float64, buy bool) (*orderbook.ConsolidatedSimulationResult, error) {
+	c, err := m.GetConsolidatedOrderbook(p, a)
+	if err != nil {
+		return nil, err
+	}
+	return c.WhaleBomb(priceTarget, buy)
+}
+
+// getExchangeNames returns the exchanges of a consolidated orderbook, all
+// enabled exchanges when none are configured
+func (m *ConsolidatedOrderbookManager) getExchangeNames(b *consolidatedBook) ([]string, error) {
+	if len(b.exchanges) > 0 {
+		return b.exchanges, nil
+	}
+	exchanges, err := m.exchangeManager.GetExchanges()
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(exchanges))
+	for i := range exchanges {
+		names[i] = exchanges[i].GetName()
+	}
+	return names, nil
+}
+
+// watch rebuilds consolidated orderbooks on orderbook updates of an exchange
+// until shutdown
+func (m *ConsolidatedOrderbookManager) watch(exchangeName string) {
+	defer m.wg.Done()
+	var pipe *dispatch.Pipe
+	defer func() {
+		if pipe == nil {
+			return
+		}
+		if err := pipe.Release(); err != nil {
+			log.Errorln(log.DispatchMgr, err)
+		}
+	}()
+
+	resubscribe := time.NewTimer(0)
+	defer resubscribe.Stop()
+	for {
+		var orderbookC chan interface{}
+		if pipe != nil {
+			orderbookC = pipe.C
+		}
+		select {
+		case <-m.shutdown:
+			return
+		case <-resubscribe.C:
+			p, err := orderbook.SubscribeToExchangeOrderbooks(exchangeName)
+			if err != nil {
+				resubscribe.Reset(consolidatedOrderbookResubscribeDelay)
+				continue
+			}
+			pipe = &p
+		case data, ok := <-orderbookC:
+			if !ok {
+				pipe = nil
+				resubscribe.Reset(consolidatedOrderbookResubscribeDelay)
+				continue
+			}
+			m.processUpdate(data)
+		}
+	}
+}
+
+// processUpdate rebuilds every consolidated orderbook containing the updated
+// exchange orderbook
+func (m *ConsolidatedOrderbookManager) processUpdate(data interface{}) {
+	if ptr, ok := data.(*interface{}); ok && ptr != nil {
+		data = *ptr
+	}
+	var d orderbook.Base
+	switch ob := data.(type) {
+	case orderbook.Base:
+		d = ob
+	case *orderbook.Base:
+		d = *ob
+	default:
+		return
+	}
+	for i := range m.books {
+		if !m.books[i].contains(d.Exchange, d.Pair, d.Asset) {
+			continue
+		}
+		if _, err := m.consolidate(&m.books[i]); err != nil && m.verbose {
+			log.Errorf(log.OrderBook, "Consolidated orderbook manager %s %s %v", m.books[i].pair, m.books[i].asset, err)
+		}
+	}
+}
+
+// contains returns whether an exchange orderbook is merged into the
+// consolidated orderbook
+func (b *consolidatedBook) contains(exchangeName string, p currency.Pair, a asset.Item) bool {
+	if a != b.asset || !p.Base.Match(b.pair.Base) {
+		return false
+	}
+	if len(b.exchanges) > 0 {
+		var found bool
+		for i := range b.exchanges {
+			if strings.EqualFold(b.exchanges[i], exchangeName) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	for i := range b.quotes {
+		if p.Quote.Match(b.quotes[i].currency) {
+			return true
+		}
+	}
+	return false
+}
+
+// consolidate merges the latest exchange orderbooks of a consolidated
+// orderbook and publishes the result
+func (m *ConsolidatedOrderbookManager) consolidate(b *consolidatedBook) (*orderbook.ConsolidatedBase, error) {
+	exchanges, err := m.getExchangeNames(b)
+	if err != nil {
+		return nil, err
+	}
+	var sources []orderbook.ConsolidationSource
+	for i := range b.quotes {
+		rate := b.quotes[i].rate
+		if rate == 0 {
+			rate, err = m.fiatConverter(1, b.quotes[i].currency, b.pair.Quote)
+			if err != nil || rate <= 0 {
+				if m.verbose {
+					log.Warnf(log.OrderBook, "Consolidated orderbook manager cannot convert %s to %s: %v", b.quotes[i].currency, b.pair.Quote, err)
+				}
+				continue
+			}
+		}
+		p := currency.NewPair(b.pair.Base, b.quotes[i].currency)
+		for j := range exchanges {
+			book, err := m.orderbookLoader(exchanges[j], p, b.asset)
+			if err != nil {
+				continue
+			}
+			sources = append(sources, orderbook.ConsolidationSource{
+				Book: book,
+				Rate: rate,
+				Fee:  m.getFee(exchanges[j], p),
+			})
+		}
+	}
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("%s %s %w", b.pair, b.asset, errNoConsolidationSources)
+	}
+	c, err := orderbook.Consolidate(b.pair, b.asset, sources...)
+	if err != nil {
+		return nil, err
+	}
+	return c, orderbook.ProcessConsolidated(c)
+}
+
+// getFee returns the taker fee rate of an exchange pair, either configured or
+// the exchange's offline trade fee. Fees which cannot be determined are zero
+func (m *ConsolidatedOrderbookManager) getFee(exchangeName string, p currency.Pair) float64 {
+	name := strings.ToLower(exchangeName)
+	if fee, ok := m.feeOverrides[name]; ok {
+		return fee
+	}
+	key := name + " " + p.String()
+	m.m.Lock()
+	defer m.m.Unlock()
+	if fee, ok := m.fees[key]; ok {
+		return fee
+	}
+	var fee float64
+	exch, err := m.exchangeManager.GetExchangeByName(exchangeName)
+	if err == nil {
+		fee, err = exch.GetFeeByType(context.TODO(), &exchange.FeeBuilder{
+			FeeType:       exchange.OfflineTradeFee,
+			Pair:          p,
+			PurchasePrice: 1,
+			Amount:        1,
+		})
+	}
+	if err != nil || fee < 0 || fee >= 1 {
+		if m.verbose {
+			log.Warnf(log.OrderBook, "Consolidated orderbook manager cannot determine %s %s fee, using zero: %v", exchangeName, p, err)
+		}
+		fee = 0
+	}
+	m.fees[key] = fee
+	return fee
+}
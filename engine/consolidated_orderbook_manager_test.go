@@ -0,0 +1,254 @@
+package engine
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+var errFakeOrderbook = errors.New("no orderbook")
+
+// consolidatedOrderbookManagerSetup returns a manager merging BTC-USD books of
+// the test exchange and "fake", with USDT converted at 0.5 by the fiat
+// converter and USDC at a configured rate of 1
+func consolidatedOrderbookManagerSetup(t *testing.T) *ConsolidatedOrderbookManager {
+	t.Helper()
+	em := SetupExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	em.Add(exch)
+	m, err := SetupConsolidatedOrderbookManager(em, &config.ConsolidatedOrderbookManager{
+		Books: []config.ConsolidatedOrderbook{
+			{
+				Pair:      currency.NewPair(currency.BTC, currency.USD),
+				Exchanges: []string{testExchange, "fake"},
+				EquivalentQuotes: []config.QuoteConversion{
+					{Currency: currency.USDT},
+					{Currency: currency.USDC, Rate: 1},
+					{Currency: currency.USD, Rate: 2},
+				},
+			},
+		},
+		Fees: map[string]float64{"FAKE": 0.01},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	books := map[string]*orderbook.Base{
+		"bitstamp BTCUSD": {
+			Exchange: testExchange,
+			Pair:     currency.NewPair(currency.BTC, currency.USD),
+			Asset:    asset.Spot,
+			Bids:     orderbook.Items{{Price: 100, Amount: 1}},
+			Asks:     orderbook.Items{{Price: 101, Amount: 1}},
+		},
+		"fake BTCUSDT": {
+			Exchange: "fake",
+			Pair:     currency.NewPair(currency.BTC, currency.USDT),
+			Asset:    asset.Spot,
+			Bids:     orderbook.Items{{Price: 202, Amount: 2}},
+			Asks:     orderbook.Items{{Price: 204, Amount: 2}},
+		},
+	}
+	m.orderbookLoader = func(exchangeName string, p currency.Pair, _ asset.Item) (*orderbook.Base, error) {
+		b, ok := books[strings.ToLower(exchangeName)+" "+p.String()]
+		if !ok {
+			return nil, errFakeOrderbook
+		}
+		return b, nil
+	}
+	m.fiatConverter = func(amount float64, from, to currency.Code) (float64, error) {
+		if from.Item == currency.USDT.Item && to.Item == currency.USD.Item {
+			return amount / 2, nil
+		}
+		return 0, errFakeOrderbook
+	}
+	return m
+}
+
+func TestSetupConsolidatedOrderbookManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupConsolidatedOrderbookManager(nil, nil)
+	if !errors.Is(err, errNilExchangeManager) {
+		t.Errorf("received '%v' expected '%v'", err, errNilExchangeManager)
+	}
+	_, err = SetupConsolidatedOrderbookManager(SetupExchangeManager(), nil)
+	if !errors.Is(err, errNilConfig) {
+		t.Errorf("received '%v' expected '%v'", err, errNilConfig)
+	}
+	_, err = SetupConsolidatedOrderbookManager(SetupExchangeManager(), &config.ConsolidatedOrderbookManager{
+		Books: []config.ConsolidatedOrderbook{{}},
+	})
+	if !errors.Is(err, errCurrencyPairUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errCurrencyPairUnset)
+	}
+	_, err = SetupConsolidatedOrderbookManager(SetupExchangeManager(), &config.ConsolidatedOrderbookManager{
+		Books: []config.ConsolidatedOrderbook{{Pair: currency.NewPair(currency.BTC, currency.USD), Asset: "meow"}},
+	})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, asset.ErrNotSupported)
+	}
+
+	m := consolidatedOrderbookManagerSetup(t)
+	if len(m.books) != 1 || len(m.books[0].quotes) != 3 || m.books[0].asset != asset.Spot {
+		t.Errorf("received '%+v' expected USD quote and two equivalent quotes", m.books)
+	}
+}
+
+func TestConsolidatedOrderbookManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *ConsolidatedOrderbookManager
+	if m.IsRunning() {
+		t.Error("expected nil manager not to be running")
+	}
+	err := m.Start()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	err = m.Stop()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+
+	m = consolidatedOrderbookManagerSetup(t)
+	err = m.Stop()
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+	err = m.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !m.IsRunning() {
+		t.Error("expected manager to be running")
+	}
+	err = m.Start()
+	if !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+	err = m.Stop()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+}
+
+func TestGetConsolidatedOrderbook(t *testing.T) {
+	t.Parallel()
+	m := consolidatedOrderbookManagerSetup(t)
+	p := currency.NewPair(currency.BTC, currency.USD)
+	_, err := m.GetConsolidatedOrderbook(p, asset.Spot)
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+	err = m.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	defer func() {
+		if err = m.Stop(); err != nil {
+			t.Error(err)
+		}
+	}()
+	_, err = m.GetConsolidatedOrderbook(currency.NewPair(currency.LTC, currency.USD), asset.Spot)
+	if !errors.Is(err, errConsolidatedOrderbookNotConfigured) {
+		t.Errorf("received '%v' expected '%v'", err, errConsolidatedOrderbookNotConfigured)
+	}
+
+	c, err := m.GetConsolidatedOrderbook(p, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(c.Exchanges) != 2 || len(c.Bids) != 2 || len(c.Asks) != 2 {
+		t.Fatalf("received '%+v' expected both exchanges merged", c)
+	}
+	// fake's 202 USDT bid is 101 USD less its 1% fee, the test exchange's
+	// 100 USD bid is less its offline fee of 0.25%
+	if c.Bids[0].Exchange != "fake" || math.Abs(c.Bids[0].Price-99.99) > 1e-9 || c.Bids[0].RawPrice != 202 {
+		t.Errorf("received '%+v' expected converted fake bid first", c.Bids[0])
+	}
+	if c.Bids[1].Exchange != testExchange || math.Abs(c.Bids[1].Price-99.75) > 1e-9 || c.Bids[1].Fee != 0.0025 {
+		t.Errorf("received '%+v' expected fee adjusted test exchange bid", c.Bids[1])
+	}
+	stored, err := orderbook.GetConsolidated(p, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(stored.Bids) != 2 {
+		t.Errorf("received '%+v' expected stored consolidated orderbook", stored)
+	}
+
+	r, err := m.SimulateOrder(p, asset.Spot, 1.5, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(r.Venues) != 1 || r.Venues[0].Exchange != "fake" || r.RemainingAmount != 0 {
+		t.Errorf("received '%+v' expected sell filled by fake", r)
+	}
+	r, err = m.WhaleBomb(p, asset.Spot, 102, true)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(r.Venues) != 2 {
+		t.Errorf("received '%+v' expected asks of both exchanges", r)
+	}
+}
+
+func TestConsolidatedOrderbookManagerProcessUpdate(t *testing.T) {
+	t.Parallel()
+	m := consolidatedOrderbookManagerSetup(t)
+	m.books[0].pair = currency.NewPair(currency.BTC, currency.AUD)
+	m.books[0].quotes = []quoteConversion{{currency: currency.AUD, rate: 1}}
+	loaded := make(chan struct{}, 2)
+	m.orderbookLoader = func(exchangeName string, p currency.Pair, a asset.Item) (*orderbook.Base, error) {
+		loaded <- struct{}{}
+		return &orderbook.Base{Exchange: exchangeName, Pair: p, Asset: a, Bids: orderbook.Items{{Price: 1, Amount: 1}}}, nil
+	}
+	// ignored updates do not rebuild the consolidated orderbook
+	m.processUpdate("meow")
+	m.processUpdate(orderbook.Base{Exchange: "fake", Pair: currency.NewPair(currency.BTC, currency.USD), Asset: asset.Spot})
+	m.processUpdate(orderbook.Base{Exchange: "meow", Pair: currency.NewPair(currency.BTC, currency.AUD), Asset: asset.Spot})
+	m.processUpdate(orderbook.Base{Exchange: "fake", Pair: currency.NewPair(currency.BTC, currency.AUD), Asset: asset.Futures})
+	if len(loaded) != 0 {
+		t.Fatalf("received '%v' expected '%v'", len(loaded), 0)
+	}
+
+	var update interface{} = &orderbook.Base{Exchange: "FAKE", Pair: currency.NewPair(currency.BTC, currency.AUD), Asset: asset.Spot}
+	m.processUpdate(&update)
+	if len(loaded) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(loaded), 2)
+	}
+	c, err := orderbook.GetConsolidated(currency.NewPair(currency.BTC, currency.AUD), asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(c.Bids) != 2 || len(c.Exchanges) != 2 {
+		t.Errorf("received '%+v' expected both exchanges merged", c)
+	}
+}
+
+func TestConsolidatedOrderbookManagerGetFee(t *testing.T) {
+	t.Parallel()
+	m := consolidatedOrderbookManagerSetup(t)
+	p := currency.NewPair(currency.BTC, currency.USD)
+	if fee := m.getFee("Fake", p); fee != 0.01 {
+		t.Errorf("received '%v' expected '%v'", fee, 0.01)
+	}
+	if fee := m.getFee(testExchange, p); fee != 0.0025 {
+		t.Errorf("received '%v' expected '%v'", fee, 0.0025)
+	}
+	if fee := m.getFee("meow", p); fee != 0 {
+		t.Errorf("received '%v' expected '%v'", fee, 0)
+	}
+	if len(m.fees) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(m.fees), 2)
+	}
+}
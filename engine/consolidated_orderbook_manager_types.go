@@ -0,0 +1,60 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// ConsolidatedOrderbookManagerName is an exported subsystem name
+const ConsolidatedOrderbookManagerName = "consolidated_orderbook_manager"
+
+var (
+	consolidatedOrderbookResubscribeDelay = time.Second * 5
+
+	errConsolidatedOrderbookNotConfigured = errors.New("consolidated orderbook not configured")
+	errNoConsolidationSources             = errors.New("no exchange orderbooks available to consolidate")
+)
+
+// ConsolidatedOrderbookManager merges the orderbooks of the same pair, and
+// of pairs with equivalent quote currencies, across exchanges. Every level is
+// tagged with its exchange and adjusted by the exchange's taker fee. Books are
+// rebuilt on each exchange orderbook update and published through dispatch
+type ConsolidatedOrderbookManager struct {
+	started         int32
+	shutdown        chan struct{}
+	wg              sync.WaitGroup
+	exchangeManager iExchangeManager
+	verbose         bool
+	books           []consolidatedBook
+	// feeOverrides are configured taker fee rates keyed by lower case
+	// exchange name
+	feeOverrides map[string]float64
+
+	m    sync.Mutex
+	fees map[string]float64
+
+	orderbookLoader func(string, currency.Pair, asset.Item) (*orderbook.Base, error)
+	fiatConverter   func(float64, currency.Code, currency.Code) (float64, error)
+}
+
+// consolidatedBook is a configured consolidated orderbook
+type consolidatedBook struct {
+	pair      currency.Pair
+	asset     asset.Item
+	exchanges []string
+	// quotes holds the quote currency of the pair with a rate of one followed
+	// by its equivalent quote currencies
+	quotes []quoteConversion
+}
+
+// quoteConversion converts prices of a quote currency into the quote currency
+// of a consolidated orderbook. A zero rate uses the foreign exchange rate
+type quoteConversion struct {
+	currency currency.Code
+	rate     float64
+}
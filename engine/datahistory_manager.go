@@ -18,9 +18,11 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjob"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjobresult"
+	orderbooksql "github.com/thrasher-corp/gocryptotrader/database/repository/orderbook"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
@@ -69,6 +71,8 @@ func SetupDataHistoryManager(em iExchangeManager, dcm iDatabaseConnectionManager
 		tradeSaver:                 trade.SaveTradesToDatabase,
 		candleLoader:               kline.LoadFromDatabase,
 		candleSaver:                kline.StoreInDatabase,
+		orderbookLoader:            orderbook.Get,
+		orderbookSaver:             orderbooksql.InsertSnapshots,
 	}, nil
 }
 
@@ -211,6 +215,9 @@ func (m *DataHistoryManager) compareJobsToData(jobs ...*DataHistoryJob) error {
 				return fmt.Errorf("%s could not load candle data: %w", jobs[i].Nickname, err)
 			}
 			jobs[i].rangeHolder.SetHasDataFromCandles(candles.Candles)
+		case dataHistoryOrderbookSnapshotDataType:
+			// snapshots are tracked by the job's results as orderbooks
+			// cannot be retrieved for past intervals
 		default:
 			return fmt.Errorf("%s %w %s", jobs[i].Nickname, errUnknownDataType, jobs[i].DataType)
 		}
@@ -312,17 +319,17 @@ func (m *DataHistoryManager) runJob(job *DataHistoryJob) error {
 			job.Pair)
 	}
 
-	if job.DataType == dataHistoryCandleValidationDataType ||
-		job.DataType == dataHistoryCandleValidationSecondarySourceType {
+	switch job.DataType {
+	case dataHistoryCandleValidationDataType,
+		dataHistoryCandleValidationSecondarySourceType:
 		err = m.runValidationJob(job, exch)
-		if err != nil {
-			return err
-		}
-	} else {
+	case dataHistoryOrderbookSnapshotDataType:
+		err = m.runOrderbookSnapshotJob(job)
+	default:
 		err = m.runDataJob(job, exch)
-		if err != nil {
-			return err
-		}
+	}
+	if err != nil {
+		return err
 	}
 
 	dbJob := m.convertJobToDBModel(job)
@@ -488,6 +495,126 @@ completionCheck:
 	return nil
 }
 
+// runOrderbookSnapshotJob saves the top levels of the job's orderbook once per
+// interval. Orderbooks cannot be retrieved for past intervals, so intervals
+// missed while the job was not running are not captured. The job is completed
+// once its end date has passed
+func (m *DataHistoryManager) runOrderbookSnapshotJob(job *DataHistoryJob) error {
+	if !m.IsRunning() {
+		return ErrSubSystemNotStarted
+	}
+	if job.Interval <= 0 {
+		return fmt.Errorf("job %s %w %s", job.Nickname, kline.ErrUnsupportedInterval, job.Interval)
+	}
+	now := time.Now()
+	if !now.Before(job.EndDate) {
+		var captured, expected int64
+		for t := job.StartDate; t.Before(job.EndDate); t = t.Add(job.Interval.Duration()) {
+			expected++
+			for _, r := range job.Results[t] {
+				if r.Status == dataHistoryStatusComplete {
+					captured++
+					break
+				}
+			}
+		}
+		return m.completeJob(job, captured == expected, captured == 0)
+	}
+	if now.Before(job.StartDate) {
+		return nil
+	}
+	intervalStart := job.StartDate.Add(now.Sub(job.StartDate).Truncate(job.Interval.Duration()))
+	var failures int64
+	for _, r := range job.Results[intervalStart] {
+		if r.Status == dataHistoryStatusComplete {
+			return nil
+		}
+		failures++
+	}
+	if failures >= job.MaxRetryAttempts {
+		return nil
+	}
+	if m.verbose {
+		log.Debugf(log.DataHistory, "running data history job %v orderbook snapshot for interval %s", job.Nickname, intervalStart)
+	}
+	result, err := m.saveOrderbookSnapshot(job, intervalStart)
+	if err != nil {
+		return err
+	}
+	job.Results[intervalStart] = append(job.Results[intervalStart], *result)
+	return nil
+}
+
+// saveOrderbookSnapshot stores the top levels of the job's orderbook, the
+// number of levels per side being set by the job's request size limit
+func (m *DataHistoryManager) saveOrderbookSnapshot(job *DataHistoryJob, intervalStart time.Time) (*DataHistoryJobResult, error) {
+	if !m.IsRunning() {
+		return nil, ErrSubSystemNotStarted
+	}
+	if job == nil {
+		return nil, errNilJob
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	r := &DataHistoryJobResult{
+		ID:                id,
+		JobID:             job.ID,
+		IntervalStartDate: intervalStart,
+		IntervalEndDate:   intervalStart.Add(job.Interval.Duration()),
+		Status:            dataHistoryStatusComplete,
+		Date:              time.Now(),
+	}
+	book, err := m.orderbookLoader(job.Exchange, job.Pair, job.Asset)
+	if err != nil {
+		r.Result = err.Error()
+		r.Status = dataHistoryStatusFailed
+		return r, nil
+	}
+	if len(book.Bids) == 0 && len(book.Asks) == 0 {
+		r.Result = errEmptyOrderbook.Error()
+		r.Status = dataHistoryStatusFailed
+		return r, nil
+	}
+	snapshot := orderbooksql.Snapshot{
+		Exchange:  job.Exchange,
+		Asset:     job.Asset.String(),
+		Base:      job.Pair.Base.String(),
+		Quote:     job.Pair.Quote.String(),
+		Timestamp: book.LastUpdated,
+		UpdateID:  book.LastUpdateID,
+		Depth:     int(job.RequestSizeLimit),
+		Bids:      orderbookLevels(book.Bids, job.RequestSizeLimit),
+		Asks:      orderbookLevels(book.Asks, job.RequestSizeLimit),
+	}
+	if snapshot.Timestamp.IsZero() {
+		snapshot.Timestamp = r.Date
+	}
+	err = m.orderbookSaver(snapshot)
+	if err != nil {
+		r.Result = "could not save results: " + err.Error()
+		r.Status = dataHistoryStatusFailed
+	}
+	return r, nil
+}
+
+// orderbookLevels converts the top levels of one side of a book
+func orderbookLevels(items orderbook.Items, depth int64) []orderbooksql.Level {
+	if depth > 0 && int64(len(items)) > depth {
+		items = items[:depth]
+	}
+	levels := make([]orderbooksql.Level, len(items))
+	for i := range items {
+		levels[i] = orderbooksql.Level{
+			Price:  items[i].Price,
+			Amount: items[i].Amount,
+			ID:     items[i].ID,
+		}
+	}
+	return levels
+}
+
 // runValidationJob verifies existing database candle data against
 // the original API's data, or a secondary exchange source
 func (m *DataHistoryManager) runValidationJob(job *DataHistoryJob, exch exchange.IBotExchange) error {
@@ -1208,6 +1335,15 @@ func (m *DataHistoryManager) validateJob(job *DataHistoryJob) error {
 		log.Warnf(log.DataHistory, "job %s has unset max retry limit, defaulting to %v", job.Nickname, defaultDataHistoryRetryAttempts)
 		job.MaxRetryAttempts = defaultDataHistoryRetryAttempts
 	}
+	if job.DataType == dataHistoryOrderbookSnapshotDataType {
+		if job.Interval <= 0 {
+			return fmt.Errorf("job snapshot interval %s %s %w %s", job.Nickname, job.Interval.Word(), kline.ErrUnsupportedInterval, job.Exchange)
+		}
+		if job.RequestSizeLimit <= 0 {
+			log.Warnf(log.DataHistory, "job %s has unset orderbook depth, defaulting to %v levels", job.Nickname, defaultDataHistoryOrderbookDepth)
+			job.RequestSizeLimit = defaultDataHistoryOrderbookDepth
+		}
+	}
 	if job.RequestSizeLimit <= 0 {
 		job.RequestSizeLimit = defaultDataHistoryRequestSizeLimit
 	}
@@ -17,10 +17,12 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjob"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjobresult"
+	orderbooksql "github.com/thrasher-corp/gocryptotrader/database/repository/orderbook"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 )
 
@@ -527,6 +529,22 @@ func TestValidateJob(t *testing.T) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
 
+	dhj.DataType = dataHistoryOrderbookSnapshotDataType
+	dhj.Interval = 0
+	err = m.validateJob(dhj)
+	if !errors.Is(err, kline.ErrUnsupportedInterval) {
+		t.Errorf("error '%v', expected '%v'", err, kline.ErrUnsupportedInterval)
+	}
+	dhj.Interval = kline.OneMin
+	dhj.RequestSizeLimit = 0
+	err = m.validateJob(dhj)
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	if dhj.RequestSizeLimit != defaultDataHistoryOrderbookDepth {
+		t.Errorf("received '%v', expected '%v'", dhj.RequestSizeLimit, defaultDataHistoryOrderbookDepth)
+	}
+
 	dhj.DataType = dataHistoryCandleValidationSecondarySourceType
 	err = m.validateJob(dhj)
 	if !errors.Is(err, errExchangeNameUnset) {
@@ -1369,6 +1387,111 @@ func TestCompletionCheck(t *testing.T) {
 	}
 }
 
+func TestRunOrderbookSnapshotJob(t *testing.T) {
+	t.Parallel()
+	m, _ := createDHM(t)
+	m.orderbookLoader = dataHistoryOrderbookLoader
+	var saved []orderbooksql.Snapshot
+	m.orderbookSaver = func(s ...orderbooksql.Snapshot) error {
+		saved = append(saved, s...)
+		return nil
+	}
+	j := &DataHistoryJob{
+		Nickname:         "TestRunOrderbookSnapshotJob",
+		Exchange:         testExchange,
+		Asset:            asset.Spot,
+		Pair:             currency.NewPair(currency.BTC, currency.USD),
+		StartDate:        time.Now().Add(-time.Minute).Truncate(time.Minute),
+		EndDate:          time.Now().Add(time.Hour),
+		Interval:         kline.OneMin,
+		DataType:         dataHistoryOrderbookSnapshotDataType,
+		RequestSizeLimit: 1,
+		MaxRetryAttempts: 1,
+		Results:          make(map[time.Time][]DataHistoryJobResult),
+	}
+	err := m.runOrderbookSnapshotJob(j)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v expected %v", err, nil)
+	}
+	if len(saved) != 1 {
+		t.Fatalf("received %v expected %v", len(saved), 1)
+	}
+	if len(saved[0].Bids) != 1 || len(saved[0].Asks) != 1 || saved[0].Bids[0].Price != 99 || saved[0].Depth != 1 {
+		t.Errorf("received '%+v' expected top level of each side", saved[0])
+	}
+	// the interval has been captured
+	err = m.runOrderbookSnapshotJob(j)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v expected %v", err, nil)
+	}
+	if len(saved) != 1 {
+		t.Errorf("received %v expected %v", len(saved), 1)
+	}
+
+	j.EndDate = time.Now().Add(-time.Second)
+	j.StartDate = j.EndDate.Add(-kline.OneMin.Duration() * 2)
+	err = m.runOrderbookSnapshotJob(j)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v expected %v", err, nil)
+	}
+	if j.Status != dataHistoryStatusFailed {
+		t.Errorf("received %v expected %v", j.Status, dataHistoryStatusFailed)
+	}
+
+	j.Interval = 0
+	err = m.runOrderbookSnapshotJob(j)
+	if !errors.Is(err, kline.ErrUnsupportedInterval) {
+		t.Errorf("received %v expected %v", err, kline.ErrUnsupportedInterval)
+	}
+}
+
+func TestSaveOrderbookSnapshot(t *testing.T) {
+	t.Parallel()
+	m, _ := createDHM(t)
+	_, err := m.saveOrderbookSnapshot(nil, time.Time{})
+	if !errors.Is(err, errNilJob) {
+		t.Errorf("received %v expected %v", err, errNilJob)
+	}
+	errTest := errors.New("test error")
+	m.orderbookLoader = orderbook.Get
+	m.orderbookSaver = func(...orderbooksql.Snapshot) error {
+		return errTest
+	}
+	j := &DataHistoryJob{
+		Exchange: testExchange,
+		Asset:    asset.Spot,
+		Pair:     currency.NewPair(currency.BTC, currency.USDT),
+		Interval: kline.OneMin,
+	}
+	r, err := m.saveOrderbookSnapshot(j, time.Now())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v expected %v", err, nil)
+	}
+	if r.Status != dataHistoryStatusFailed {
+		t.Errorf("received %v expected %v", r.Status, dataHistoryStatusFailed)
+	}
+
+	m.orderbookLoader = dataHistoryOrderbookLoader
+	r, err = m.saveOrderbookSnapshot(j, time.Now())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v expected %v", err, nil)
+	}
+	if r.Status != dataHistoryStatusFailed || !strings.Contains(r.Result, errTest.Error()) {
+		t.Errorf("received %v %v expected failed save", r.Status, r.Result)
+	}
+
+	m.orderbookLoader = func(string, currency.Pair, asset.Item) (*orderbook.Base, error) {
+		return &orderbook.Base{}, nil
+	}
+	r, err = m.saveOrderbookSnapshot(j, time.Now())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v expected %v", err, nil)
+	}
+	if r.Result != errEmptyOrderbook.Error() {
+		t.Errorf("received %v expected %v", r.Result, errEmptyOrderbook)
+	}
+}
+
 func TestSaveCandlesInBatches(t *testing.T) {
 	t.Parallel()
 	dhm := DataHistoryManager{
@@ -1530,6 +1653,16 @@ func dataHistoryCandleLoader(exch string, cp currency.Pair, a asset.Item, i klin
 	}, nil
 }
 
+func dataHistoryOrderbookLoader(exch string, cp currency.Pair, a asset.Item) (*orderbook.Base, error) {
+	return &orderbook.Base{
+		Exchange: exch,
+		Pair:     cp,
+		Asset:    a,
+		Bids:     orderbook.Items{{Price: 99, Amount: 1}, {Price: 98, Amount: 1}},
+		Asks:     orderbook.Items{{Price: 101, Amount: 1}, {Price: 102, Amount: 1}},
+	}, nil
+}
+
 func dataHistoryTradeSaver(...trade.Data) error {
 	return nil
 }
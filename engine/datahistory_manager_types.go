@@ -9,8 +9,10 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjob"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjobresult"
+	orderbooksql "github.com/thrasher-corp/gocryptotrader/database/repository/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 )
 
@@ -27,6 +29,7 @@ const (
 	dataHistoryConvertCandlesDataType
 	dataHistoryCandleValidationDataType
 	dataHistoryCandleValidationSecondarySourceType
+	dataHistoryOrderbookSnapshotDataType
 )
 
 // DataHistoryJob status descriptors
@@ -79,13 +82,15 @@ func (d dataHistoryDataType) String() string {
 		return "conversion validation"
 	case 5:
 		return "conversion validation secondary source"
+	case 6:
+		return "orderbook snapshots"
 	}
 	return ""
 }
 
 // Valid ensures the value set is legitimate
 func (d dataHistoryDataType) Valid() bool {
-	return int64(d) >= 0 && int64(d) <= 5
+	return int64(d) >= 0 && int64(d) <= 6
 }
 
 var (
@@ -104,6 +109,7 @@ var (
 	errNilResult                  = errors.New("received nil job result")
 	errJobMustBeActiveOrPaused    = errors.New("job must be active or paused to be set as a prerequisite")
 	errNilCandles                 = errors.New("received nil candles")
+	errEmptyOrderbook             = errors.New("orderbook has no bids or asks")
 
 	// defaultDataHistoryTradeInterval is the default interval size used to verify whether there is any database data
 	// for a trade job
@@ -116,6 +122,9 @@ var (
 	defaultDataHistoryTicker                 = time.Minute
 	defaultDataHistoryTradeRequestSize int64 = 10
 	defaultDecimalPlaceComparison      int64 = 3
+	// defaultDataHistoryOrderbookDepth is the default number of levels per
+	// side saved by an orderbook snapshot job
+	defaultDataHistoryOrderbookDepth int64 = 20
 )
 
 // DataHistoryManager is responsible for synchronising,
@@ -136,6 +145,8 @@ type DataHistoryManager struct {
 	tradeLoader                func(string, string, string, string, time.Time, time.Time) ([]trade.Data, error)
 	tradeSaver                 func(...trade.Data) error
 	candleSaver                func(*kline.Item, bool) (uint64, error)
+	orderbookLoader            func(string, currency.Pair, asset.Item) (*orderbook.Base, error)
+	orderbookSaver             func(...orderbooksql.Snapshot) error
 }
 
 // DataHistoryJob used to gather candle/trade history and save
@@ -30,26 +30,33 @@ import (
 // Engine contains configuration, portfolio manager, exchange & ticker data and is the
 // overarching type across this code base.
 type Engine struct {
-	Config                  *config.Config
-	apiServer               *apiServerManager
-	CommunicationsManager   *CommunicationManager
-	connectionManager       *connectionManager
-	currencyPairSyncer      *syncManager
-	DatabaseManager         *DatabaseConnectionManager
-	DepositAddressManager   *DepositAddressManager
-	eventManager            *eventManager
-	ExchangeManager         *ExchangeManager
-	ntpManager              *ntpManager
-	OrderManager            *OrderManager
-	portfolioManager        *portfolioManager
-	gctScriptManager        *gctscript.GctScriptManager
-	websocketRoutineManager *websocketRoutineManager
-	WithdrawManager         *WithdrawManager
-	dataHistoryManager      *DataHistoryManager
-	currencyStateManager    *CurrencyStateManager
-	Settings                Settings
-	uptime                  time.Time
-	ServicesWG              sync.WaitGroup
+	Config                       *config.Config
+	apiServer                    *apiServerManager
+	CommunicationsManager        *CommunicationManager
+	connectionManager            *connectionManager
+	currencyPairSyncer           *syncManager
+	DatabaseManager              *DatabaseConnectionManager
+	DepositAddressManager        *DepositAddressManager
+	eventManager                 *eventManager
+	ExchangeManager              *ExchangeManager
+	ntpManager                   *ntpManager
+	OrderManager                 *OrderManager
+	portfolioManager             *portfolioManager
+	gctScriptManager             *gctscript.GctScriptManager
+	websocketRoutineManager      *websocketRoutineManager
+	WithdrawManager              *WithdrawManager
+	dataHistoryManager           *DataHistoryManager
+	balanceHistoryManager        *BalanceHistoryManager
+	currencyStateManager         *CurrencyStateManager
+	conditionalOrderManager      *ConditionalOrderManager
+	executionManager             *ExecutionManager
+	riskManager                  *RiskManager
+	marketDataRecorder           *MarketDataRecorder
+	marketDataReplayer           *MarketDataReplayer
+	consolidatedOrderbookManager *ConsolidatedOrderbookManager
+	Settings                     Settings
+	uptime                       time.Time
+	ServicesWG                   sync.WaitGroup
 }
 
 // Bot is a happy global engine to allow various areas of the application
@@ -152,6 +159,18 @@ func validateSettings(b *Engine, s *Settings, flagSet map[string]bool) {
 
 	b.Settings.EnableDataHistoryManager = (flagSet["datahistorymanager"] && b.Settings.EnableDatabaseManager) || b.Config.DataHistoryManager.Enabled
 
+	b.Settings.EnableBalanceHistoryManager = (flagSet["balancehistorymanager"] && b.Settings.EnableDatabaseManager) || b.Config.BalanceHistoryManager.Enabled
+
+	b.Settings.EnableRiskManager = (flagSet["riskmanager"] && b.Settings.EnableRiskManager) || b.Config.RiskManager.Enabled
+
+	b.Settings.EnableMarketDataReplayer = (flagSet["marketdatareplayer"] && b.Settings.EnableMarketDataReplayer) || b.Config.MarketDataReplayer.Enabled
+
+	b.Settings.EnableConsolidatedOrderbookManager = (flagSet["consolidatedorderbookmanager"] && b.Settings.EnableConsolidatedOrderbookManager) || b.Config.ConsolidatedOrderbookManager.Enabled
+
+	// replayed events are not recorded again
+	b.Settings.EnableMarketDataRecorder = ((flagSet["marketdatarecorder"] && b.Settings.EnableMarketDataRecorder) || b.Config.MarketDataRecorder.Enabled) &&
+		!b.Settings.EnableMarketDataReplayer
+
 	b.Settings.EnableCurrencyStateManager = (flagSet["currencystatemanager"] &&
 		b.Settings.EnableCurrencyStateManager) ||
 		b.Config.CurrencyStateManager.Enabled != nil &&
@@ -253,7 +272,14 @@ func PrintSettings(s *Settings) {
 	gctlog.Debugf(gctlog.Global, "\t Enable coinmarketcap analysis: %v", s.EnableCoinmarketcapAnalysis)
 	gctlog.Debugf(gctlog.Global, "\t Enable portfolio manager: %v", s.EnablePortfolioManager)
 	gctlog.Debugf(gctlog.Global, "\t Enable data history manager: %v", s.EnableDataHistoryManager)
+	gctlog.Debugf(gctlog.Global, "\t Enable balance history manager: %v", s.EnableBalanceHistoryManager)
 	gctlog.Debugf(gctlog.Global, "\t Enable currency state manager: %v", s.EnableCurrencyStateManager)
+	gctlog.Debugf(gctlog.Global, "\t Enable conditional order manager: %v", s.EnableConditionalOrderManager)
+	gctlog.Debugf(gctlog.Global, "\t Enable execution manager: %v", s.EnableExecutionManager)
+	gctlog.Debugf(gctlog.Global, "\t Enable risk manager: %v", s.EnableRiskManager)
+	gctlog.Debugf(gctlog.Global, "\t Enable market data recorder: %v", s.EnableMarketDataRecorder)
+	gctlog.Debugf(gctlog.Global, "\t Enable market data replayer: %v", s.EnableMarketDataReplayer)
+	gctlog.Debugf(gctlog.Global, "\t Enable consolidated orderbook manager: %v", s.EnableConsolidatedOrderbookManager)
 	gctlog.Debugf(gctlog.Global, "\t Portfolio manager sleep delay: %v\n", s.PortfolioManagerDelay)
 	gctlog.Debugf(gctlog.Global, "\t Enable gPRC: %v", s.EnableGRPC)
 	gctlog.Debugf(gctlog.Global, "\t Enable gRPC Proxy: %v", s.EnableGRPCProxy)
@@ -467,6 +493,20 @@ func (bot *Engine) Start() error {
 		}
 	}
 
+	if bot.Settings.EnableBalanceHistoryManager {
+		if bot.balanceHistoryManager == nil {
+			bot.balanceHistoryManager, err = SetupBalanceHistoryManager(bot.ExchangeManager, bot.DatabaseManager, &bot.Config.BalanceHistoryManager)
+			if err != nil {
+				gctlog.Errorf(gctlog.Global, "balance history manager unable to setup: %s", err)
+			} else {
+				err = bot.balanceHistoryManager.Start()
+				if err != nil {
+					gctlog.Errorf(gctlog.Global, "balance history manager unable to start: %s", err)
+				}
+			}
+		}
+	}
+
 	bot.WithdrawManager, err = SetupWithdrawManager(bot.ExchangeManager, bot.portfolioManager, bot.Settings.EnableDryRun)
 	if err != nil {
 		return err
@@ -511,11 +551,36 @@ func (bot *Engine) Start() error {
 		bot.OrderManager, err = SetupOrderManager(
 			bot.ExchangeManager,
 			bot.CommunicationsManager,
+			bot.DatabaseManager,
 			&bot.ServicesWG,
 			bot.Settings.Verbose)
 		if err != nil {
 			gctlog.Errorf(gctlog.Global, "Order manager unable to setup: %s", err)
 		} else {
+			// risk checks are attached before the order manager starts so that
+			// no subsystem can submit an order without them
+			if bot.Settings.EnableRiskManager {
+				bot.riskManager, err = SetupRiskManager(
+					bot.ExchangeManager,
+					bot.OrderManager,
+					bot.CommunicationsManager,
+					&bot.Config.RiskManager)
+				if err != nil {
+					gctlog.Errorf(gctlog.Global,
+						"%s unable to setup: %s",
+						RiskManagerName,
+						err)
+				} else {
+					bot.OrderManager.setRiskChecker(bot.riskManager)
+					err = bot.riskManager.Start()
+					if err != nil {
+						gctlog.Errorf(gctlog.Global,
+							"%s unable to start: %s",
+							RiskManagerName,
+							err)
+					}
+				}
+			}
 			err = bot.OrderManager.Start()
 			if err != nil {
 				gctlog.Errorf(gctlog.Global, "Order manager unable to start: %s", err)
@@ -523,6 +588,13 @@ func (bot *Engine) Start() error {
 		}
 	}
 
+	if bot.Settings.EnableRiskManager && bot.OrderManager == nil {
+		gctlog.Errorf(gctlog.Global,
+			"%s unable to setup: %s",
+			RiskManagerName,
+			errNilOrderManager)
+	}
+
 	if bot.Settings.EnableExchangeSyncManager {
 		exchangeSyncCfg := &Config{
 			SyncTicker:           bot.Settings.EnableTickerSyncing,
@@ -552,14 +624,20 @@ func (bot *Engine) Start() error {
 		}
 	}
 
-	if bot.Settings.EnableEventManager {
-		bot.eventManager, err = setupEventManager(bot.CommunicationsManager, bot.ExchangeManager, bot.Settings.EventManagerDelay, bot.Settings.EnableDryRun)
+	if bot.Settings.EnableMarketDataRecorder {
+		bot.marketDataRecorder, err = SetupMarketDataRecorder(&bot.Config.MarketDataRecorder)
 		if err != nil {
-			gctlog.Errorf(gctlog.Global, "Unable to initialise event manager. Err: %s", err)
+			gctlog.Errorf(gctlog.Global,
+				"%s unable to setup: %s",
+				MarketDataRecorderName,
+				err)
 		} else {
-			err = bot.eventManager.Start()
+			err = bot.marketDataRecorder.Start()
 			if err != nil {
-				gctlog.Errorf(gctlog.Global, "failed to start event manager. Err: %s", err)
+				gctlog.Errorf(gctlog.Global,
+					"%s unable to start: %s",
+					MarketDataRecorderName,
+					err)
 			}
 		}
 	}
@@ -569,6 +647,12 @@ func (bot *Engine) Start() error {
 		if err != nil {
 			gctlog.Errorf(gctlog.Global, "Unable to initialise websocket routine manager. Err: %s", err)
 		} else {
+			if bot.marketDataRecorder != nil {
+				bot.websocketRoutineManager.setDataRecorder(bot.marketDataRecorder)
+			}
+			if bot.balanceHistoryManager != nil {
+				bot.websocketRoutineManager.setBalanceRecorder(bot.balanceHistoryManager)
+			}
 			err = bot.websocketRoutineManager.Start()
 			if err != nil {
 				gctlog.Errorf(gctlog.Global, "failed to start websocket routine manager. Err: %s", err)
@@ -576,6 +660,40 @@ func (bot *Engine) Start() error {
 		}
 	}
 
+	if bot.Settings.EnableMarketDataReplayer {
+		var dataHandler iWebsocketDataHandler
+		if bot.websocketRoutineManager != nil {
+			dataHandler = bot.websocketRoutineManager
+		}
+		bot.marketDataReplayer, err = SetupMarketDataReplayer(&bot.Config.MarketDataReplayer, dataHandler)
+		if err != nil {
+			gctlog.Errorf(gctlog.Global,
+				"%s unable to setup: %s",
+				MarketDataReplayerName,
+				err)
+		} else {
+			err = bot.marketDataReplayer.Start()
+			if err != nil {
+				gctlog.Errorf(gctlog.Global,
+					"%s unable to start: %s",
+					MarketDataReplayerName,
+					err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableConsolidatedOrderbookManager {
+		bot.consolidatedOrderbookManager, err = SetupConsolidatedOrderbookManager(bot.ExchangeManager, &bot.Config.ConsolidatedOrderbookManager)
+		if err != nil {
+			gctlog.Errorf(gctlog.Global, "consolidated orderbook manager unable to setup: %s", err)
+		} else {
+			err = bot.consolidatedOrderbookManager.Start()
+			if err != nil {
+				gctlog.Errorf(gctlog.Global, "consolidated orderbook manager unable to start: %s", err)
+			}
+		}
+	}
+
 	if bot.Settings.EnableGCTScriptManager {
 		bot.gctScriptManager, err = gctscript.NewManager(&bot.Config.GCTScript)
 		if err != nil {
@@ -586,6 +704,24 @@ func (bot *Engine) Start() error {
 		}
 	}
 
+	if bot.Settings.EnableEventManager {
+		bot.eventManager, err = setupEventManager(bot.CommunicationsManager,
+			bot.ExchangeManager,
+			bot.OrderManager,
+			bot.gctScriptManager,
+			filepath.Join(bot.Settings.DataDir, EventsFileName),
+			bot.Settings.EventManagerDelay,
+			bot.Settings.EnableDryRun)
+		if err != nil {
+			gctlog.Errorf(gctlog.Global, "Unable to initialise event manager. Err: %s", err)
+		} else {
+			err = bot.eventManager.Start()
+			if err != nil {
+				gctlog.Errorf(gctlog.Global, "failed to start event manager. Err: %s", err)
+			}
+		}
+	}
+
 	if bot.Settings.EnableCurrencyStateManager {
 		bot.currencyStateManager, err = SetupCurrencyStateManager(
 			bot.Config.CurrencyStateManager.Delay,
@@ -605,6 +741,66 @@ func (bot *Engine) Start() error {
 			}
 		}
 	}
+
+	if bot.Settings.EnableConditionalOrderManager {
+		if bot.OrderManager == nil {
+			gctlog.Errorf(gctlog.Global,
+				"%s unable to setup: %s",
+				ConditionalOrderManagerName,
+				errNilOrderManager)
+		} else {
+			bot.conditionalOrderManager, err = SetupConditionalOrderManager(
+				bot.ExchangeManager,
+				bot.OrderManager,
+				bot.CommunicationsManager,
+				bot.Settings.Verbose)
+			if err != nil {
+				gctlog.Errorf(gctlog.Global,
+					"%s unable to setup: %s",
+					ConditionalOrderManagerName,
+					err)
+			} else {
+				bot.OrderManager.setConditionalOrderHolder(bot.conditionalOrderManager)
+				err = bot.conditionalOrderManager.Start()
+				if err != nil {
+					gctlog.Errorf(gctlog.Global,
+						"%s unable to start: %s",
+						ConditionalOrderManagerName,
+						err)
+				}
+			}
+		}
+	}
+
+	if bot.Settings.EnableExecutionManager {
+		if bot.OrderManager == nil {
+			gctlog.Errorf(gctlog.Global,
+				"%s unable to setup: %s",
+				ExecutionManagerName,
+				errNilOrderManager)
+		} else {
+			bot.executionManager, err = SetupExecutionManager(
+				bot.ExchangeManager,
+				bot.OrderManager,
+				bot.CommunicationsManager,
+				bot.DatabaseManager,
+				bot.Settings.Verbose)
+			if err != nil {
+				gctlog.Errorf(gctlog.Global,
+					"%s unable to setup: %s",
+					ExecutionManagerName,
+					err)
+			} else {
+				err = bot.executionManager.Start()
+				if err != nil {
+					gctlog.Errorf(gctlog.Global,
+						"%s unable to start: %s",
+						ExecutionManagerName,
+						err)
+				}
+			}
+		}
+	}
 	return nil
 }
 
@@ -624,6 +820,21 @@ func (bot *Engine) Stop() {
 			gctlog.Errorf(gctlog.Global, "GCTScript manager unable to stop. Error: %v", err)
 		}
 	}
+	if bot.executionManager.IsRunning() {
+		if err := bot.executionManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Execution manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.conditionalOrderManager.IsRunning() {
+		if err := bot.conditionalOrderManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Conditional order manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.riskManager.IsRunning() {
+		if err := bot.riskManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Risk manager unable to stop. Error: %v", err)
+		}
+	}
 	if bot.OrderManager.IsRunning() {
 		if err := bot.OrderManager.Stop(); err != nil {
 			gctlog.Errorf(gctlog.Global, "Order manager unable to stop. Error: %v", err)
@@ -669,21 +880,41 @@ func (bot *Engine) Stop() {
 			gctlog.Errorf(gctlog.DataHistory, "data history manager unable to stop. Error: %v", err)
 		}
 	}
+	if bot.balanceHistoryManager.IsRunning() {
+		if err := bot.balanceHistoryManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.PortfolioMgr, "balance history manager unable to stop. Error: %v", err)
+		}
+	}
 	if bot.DatabaseManager.IsRunning() {
 		if err := bot.DatabaseManager.Stop(); err != nil {
 			gctlog.Errorf(gctlog.Global, "Database manager unable to stop. Error: %v", err)
 		}
 	}
+	if bot.consolidatedOrderbookManager.IsRunning() {
+		if err := bot.consolidatedOrderbookManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.OrderBook, "consolidated orderbook manager unable to stop. Error: %v", err)
+		}
+	}
 	if dispatch.IsRunning() {
 		if err := dispatch.Stop(); err != nil {
 			gctlog.Errorf(gctlog.DispatchMgr, "Dispatch system unable to stop. Error: %v", err)
 		}
 	}
+	if bot.marketDataReplayer.IsRunning() {
+		if err := bot.marketDataReplayer.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Market data replayer unable to stop. Error: %v", err)
+		}
+	}
 	if bot.websocketRoutineManager.IsRunning() {
 		if err := bot.websocketRoutineManager.Stop(); err != nil {
 			gctlog.Errorf(gctlog.Global, "websocket routine manager unable to stop. Error: %v", err)
 		}
 	}
+	if bot.marketDataRecorder.IsRunning() {
+		if err := bot.marketDataRecorder.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Market data recorder unable to stop. Error: %v", err)
+		}
+	}
 	if bot.currencyStateManager.IsRunning() {
 		if err := bot.currencyStateManager.Stop(); err != nil {
 			gctlog.Errorf(gctlog.Global,
@@ -1,22 +1,36 @@
 package engine
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"sync/atomic"
 	"time"
 
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/ta/indicators"
+	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
-// setupEventManager loads and validates the communications manager config
-func setupEventManager(comManager iCommsManager, exchangeManager iExchangeManager, sleepDelay time.Duration, verbose bool) (*eventManager, error) {
+// setupEventManager loads and validates the communications manager config.
+// The order and script managers are optional and only required by order and
+// script actions. Events are persisted to storagePath when it is set
+func setupEventManager(comManager iEventCommsManager, exchangeManager iExchangeManager, orderManager iEventOrderManager, scriptManager iEventScriptManager, storagePath string, sleepDelay time.Duration, verbose bool) (*eventManager, error) {
 	if comManager == nil {
 		return nil, errNilComManager
 	}
@@ -29,9 +43,14 @@ func setupEventManager(comManager iCommsManager, exchangeManager iExchangeManage
 	return &eventManager{
 		comms:           comManager,
 		exchangeManager: exchangeManager,
+		orderManager:    orderManager,
+		scriptManager:   scriptManager,
+		storagePath:     storagePath,
 		verbose:         verbose,
 		sleepDelay:      sleepDelay,
 		shutdown:        make(chan struct{}),
+		prices:          make(map[string]*priceHistory),
+		candles:         make(map[string]*candleCache),
 	}, nil
 }
 
@@ -43,7 +62,11 @@ func (m *eventManager) Start() error {
 	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
 		return fmt.Errorf("event manager %w", ErrSubSystemAlreadyStarted)
 	}
-	log.Debugf(log.EventMgr, "Event Manager started. SleepDelay: %v\n", EventSleepDelay.String())
+	if err := m.loadEvents(); err != nil {
+		atomic.StoreInt32(&m.started, 0)
+		return fmt.Errorf("event manager unable to load events: %w", err)
+	}
+	log.Debugf(log.EventMgr, "Event Manager started. SleepDelay: %v\n", m.sleepDelay.String())
 	m.shutdown = make(chan struct{})
 	go m.run()
 	return nil
@@ -71,70 +94,244 @@ func (m *eventManager) Stop() error {
 
 func (m *eventManager) run() {
 	t := time.NewTicker(m.sleepDelay)
-	select {
-	case <-m.shutdown:
-		return
-	case <-t.C:
-		total, executed := m.getEventCounter()
-		if total > 0 && executed != total {
-			m.m.Lock()
-			for i := range m.events {
-				m.executeEvent(i)
+	defer t.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-t.C:
+			total, executed := m.getEventCounter()
+			if total > 0 && executed != total {
+				m.checkEvents()
 			}
-			m.m.Unlock()
 		}
 	}
 }
 
-func (m *eventManager) executeEvent(i int) {
-	if !m.events[i].Executed {
+// checkEvents evaluates all pending events and executes the ones whose
+// conditions are met. Conditions are evaluated on a copy of the pending events
+// and actions are executed without holding the lock, as both may call
+// exchanges
+func (m *eventManager) checkEvents() {
+	m.m.Lock()
+	pending := make([]Event, 0, len(m.events))
+	for i := range m.events {
+		if !m.events[i].Executed {
+			pending = append(pending, m.events[i])
+		}
+	}
+	m.m.Unlock()
+
+	var met []int64
+	for i := range pending {
 		if m.verbose {
-			log.Debugf(log.EventMgr, "Events: Processing event %s.\n", m.events[i].String())
+			log.Debugf(log.EventMgr, "Events: Processing event %s.\n", pending[i].String())
 		}
-		err := m.checkEventCondition(&m.events[i])
+		err := m.checkEventCondition(&pending[i])
 		if err != nil {
-			msg := fmt.Sprintf(
-				"Events: ID: %d triggered on %s successfully [%v]\n", m.events[i].ID,
-				m.events[i].Exchange, m.events[i].String(),
-			)
-			log.Infoln(log.EventMgr, msg)
-			m.comms.PushEvent(base.Event{Type: "event", Message: msg})
+			if m.verbose {
+				log.Debugf(log.EventMgr, "Events: ID: %d %v\n", pending[i].ID, err)
+			}
+			continue
+		}
+		met = append(met, pending[i].ID)
+	}
+	if len(met) == 0 {
+		return
+	}
+
+	// events removed or executed while their conditions were evaluated are
+	// skipped
+	var triggered []Event
+	m.m.Lock()
+	for i := range m.events {
+		if m.events[i].Executed {
+			continue
+		}
+		for j := range met {
+			if m.events[i].ID != met[j] {
+				continue
+			}
 			m.events[i].Executed = true
-		} else if m.verbose {
-			log.Debugf(log.EventMgr, "%v", err)
+			m.events[i].ExecutedAt = time.Now()
+			triggered = append(triggered, m.events[i])
+			break
+		}
+	}
+	if len(triggered) > 0 {
+		m.saveEvents()
+	}
+	m.m.Unlock()
+
+	for i := range triggered {
+		m.executeEvent(&triggered[i])
+	}
+}
+
+// executeEvent announces a triggered event and performs its actions
+func (m *eventManager) executeEvent(e *Event) {
+	msg := fmt.Sprintf(
+		"Events: ID: %d triggered on %s successfully [%v]\n", e.ID,
+		e.Exchange, e.String(),
+	)
+	log.Infoln(log.EventMgr, msg)
+	if e.Action != "" {
+		m.comms.PushEvent(base.Event{Type: "event", Message: msg})
+	}
+	for i := range e.Actions {
+		err := m.executeAction(e, &e.Actions[i])
+		if err != nil {
+			log.Errorf(log.EventMgr, "Events: ID: %d failed to perform action %s. Err: %v\n", e.ID, e.Actions[i].Type, err)
+		}
+	}
+}
+
+// executeAction performs a single event action
+func (m *eventManager) executeAction(e *Event, a *EventAction) error {
+	switch a.Type {
+	case ActionNotify:
+		msg := a.Message
+		if msg == "" {
+			msg = fmt.Sprintf("Events: ID: %d triggered on %s [%v]", e.ID, e.Exchange, e.String())
+		}
+		evt := base.Event{Type: "event", Message: msg}
+		if a.Relayer == "" {
+			m.comms.PushEvent(evt)
+			return nil
+		}
+		return m.comms.PushEventTo(a.Relayer, evt)
+	case ActionSubmitOrder:
+		if m.orderManager == nil {
+			return errNoOrderManager
+		}
+		resp, err := m.orderManager.Submit(context.TODO(), &order.Submit{
+			Exchange:  e.Exchange,
+			Pair:      e.Pair,
+			AssetType: e.Asset,
+			Side:      a.Order.Side,
+			Type:      a.Order.Type,
+			Amount:    a.Order.Amount,
+			Price:     a.Order.Price,
+			ClientID:  a.Order.ClientID,
+		})
+		if err != nil {
+			return err
+		}
+		log.Infof(log.EventMgr, "Events: ID: %d submitted order %s on %s\n", e.ID, resp.InternalOrderID, e.Exchange)
+		return nil
+	case ActionCancelOrders:
+		if m.orderManager == nil {
+			return errNoOrderManager
+		}
+		orders, err := m.orderManager.GetOrdersActive(&order.Filter{
+			Exchange:  e.Exchange,
+			Pair:      e.Pair,
+			AssetType: e.Asset,
+		})
+		if err != nil {
+			return err
+		}
+		var errs []string
+		for i := range orders {
+			err = m.orderManager.Cancel(context.TODO(), &order.Cancel{
+				Exchange:      orders[i].Exchange,
+				ID:            orders[i].ID,
+				ClientOrderID: orders[i].ClientOrderID,
+				Side:          orders[i].Side,
+				Pair:          orders[i].Pair,
+				AssetType:     orders[i].AssetType,
+			})
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("%s: %v", orders[i].ID, err))
+			}
+		}
+		if len(errs) > 0 {
+			return errors.New(strings.Join(errs, ", "))
+		}
+		log.Infof(log.EventMgr, "Events: ID: %d cancelled %d orders on %s\n", e.ID, len(orders), e.Exchange)
+		return nil
+	case ActionRunScript:
+		if m.scriptManager == nil {
+			return errNoScriptManager
 		}
+		if !m.scriptManager.IsRunning() {
+			return gctscript.ErrScriptingDisabled
+		}
+		gctVM := m.scriptManager.New()
+		if gctVM == nil {
+			return errors.New("unable to create VM instance")
+		}
+		script := a.Script
+		if !filepath.IsAbs(script) {
+			script = filepath.Join(gctscript.ScriptPath, script)
+		}
+		if err := gctVM.Load(script); err != nil {
+			return err
+		}
+		go gctVM.CompileAndRun()
+		log.Infof(log.EventMgr, "Events: ID: %d started script %s\n", e.ID, gctVM.ShortName())
+		return nil
 	}
+	return errInvalidAction
 }
 
 // Add adds an event to the Events chain and returns an index/eventID
 // and an error
 func (m *eventManager) Add(exchange, item string, condition EventConditionParams, p currency.Pair, a asset.Item, action string) (int64, error) {
+	return m.AddEvent(&Event{
+		Exchange:  exchange,
+		Item:      item,
+		Condition: condition,
+		Pair:      p,
+		Asset:     a,
+		Action:    action,
+	})
+}
+
+// AddEvent adds an event which may use a condition tree and actions and
+// returns its ID
+func (m *eventManager) AddEvent(e *Event) (int64, error) {
 	if m == nil {
 		return 0, fmt.Errorf("event manager %w", ErrNilSubsystem)
 	}
 	if atomic.LoadInt32(&m.started) == 0 {
 		return 0, fmt.Errorf("event manager %w", ErrSubSystemNotStarted)
 	}
-	err := m.isValidEvent(exchange, item, condition, action)
+	if e == nil {
+		return 0, errNilEvent
+	}
+	evt := *e
+	evt.Item = strings.ToUpper(evt.Item)
+	evt.Action = strings.ToUpper(evt.Action)
+	if evt.Conditions != nil {
+		evt.Conditions = evt.Conditions.normalise()
+	}
+	evt.Actions = make([]EventAction, len(e.Actions))
+	for i := range e.Actions {
+		evt.Actions[i] = e.Actions[i]
+		evt.Actions[i].Type = strings.ToUpper(evt.Actions[i].Type)
+		if e.Actions[i].Order != nil {
+			o := *e.Actions[i].Order
+			evt.Actions[i].Order = &o
+		}
+	}
+	if len(evt.Actions) == 0 {
+		evt.Actions = nil
+	}
+	err := m.isValidEvent(&evt)
 	if err != nil {
 		return 0, err
 	}
-	evt := Event{
-		Exchange:  exchange,
-		Item:      item,
-		Condition: condition,
-		Pair:      p,
-		Asset:     a,
-		Action:    action,
-		Executed:  false,
-	}
+	evt.Executed = false
+	evt.CreatedAt = time.Now()
+	evt.ExecutedAt = time.Time{}
+
 	m.m.Lock()
-	if len(m.events) > 0 {
-		evt.ID = int64(len(m.events) + 1)
-	}
+	defer m.m.Unlock()
+	m.nextID++
+	evt.ID = m.nextID
 	m.events = append(m.events, evt)
-	m.m.Unlock()
-
+	m.saveEvents()
 	return evt.ID, nil
 }
 
@@ -148,12 +345,28 @@ func (m *eventManager) Remove(eventID int64) bool {
 	for i := range m.events {
 		if m.events[i].ID == eventID {
 			m.events = append(m.events[:i], m.events[i+1:]...)
+			m.saveEvents()
 			return true
 		}
 	}
 	return false
 }
 
+// GetEvents returns a copy of all events on the chain
+func (m *eventManager) GetEvents() ([]Event, error) {
+	if m == nil {
+		return nil, fmt.Errorf("event manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("event manager %w", ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	events := make([]Event, len(m.events))
+	copy(events, m.events)
+	return events, nil
+}
+
 // getEventCounter displays the amount of total events on the chain and the
 // events that have been executed.
 func (m *eventManager) getEventCounter() (total, executed int) {
@@ -171,6 +384,53 @@ func (m *eventManager) getEventCounter() (total, executed int) {
 	return total, executed
 }
 
+// loadEvents restores persisted events and continues ID assignment from the
+// highest stored ID
+func (m *eventManager) loadEvents() error {
+	if m.storagePath == "" || !file.Exists(m.storagePath) {
+		return nil
+	}
+	data, err := os.ReadFile(m.storagePath)
+	if err != nil {
+		return err
+	}
+	var events []Event
+	err = json.Unmarshal(data, &events)
+	if err != nil {
+		return err
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	m.events = events
+	m.nextID = 0
+	for i := range events {
+		if events[i].ID > m.nextID {
+			m.nextID = events[i].ID
+		}
+	}
+	if m.verbose {
+		log.Debugf(log.EventMgr, "Events: Loaded %d events from %s\n", len(events), m.storagePath)
+	}
+	return nil
+}
+
+// saveEvents persists all events to storage, the lock must be held by the
+// caller
+func (m *eventManager) saveEvents() {
+	if m.storagePath == "" {
+		return
+	}
+	data, err := json.MarshalIndent(m.events, "", " ")
+	if err != nil {
+		log.Errorf(log.EventMgr, "Events: Unable to marshal events. Err: %v\n", err)
+		return
+	}
+	err = file.Write(m.storagePath, data)
+	if err != nil {
+		log.Errorf(log.EventMgr, "Events: Unable to save events to %s. Err: %v\n", m.storagePath, err)
+	}
+}
+
 // checkEventCondition will check the event structure to see if there is a condition
 // met
 func (m *eventManager) checkEventCondition(e *Event) error {
@@ -183,52 +443,409 @@ func (m *eventManager) checkEventCondition(e *Event) error {
 	if e == nil {
 		return errNilEvent
 	}
-	if e.Item == ItemPrice {
-		return e.processTicker()
+	return m.checkConditionNode(e, e.conditionTree(), time.Now())
+}
+
+// checkConditionNode evaluates a condition tree node, returning nil when it is
+// met. Every child is evaluated so that percent change conditions keep
+// sampling prices regardless of their siblings
+func (m *eventManager) checkConditionNode(e *Event, n *EventConditionNode, now time.Time) error {
+	if n.Condition != nil {
+		return m.checkCondition(e, n.Condition, now)
+	}
+	var firstErr error
+	var met bool
+	for i := range n.Children {
+		err := m.checkConditionNode(e, &n.Children[i], now)
+		if err == nil {
+			met = true
+		} else if firstErr == nil {
+			firstErr = err
+		}
+	}
+	if n.Operator == ConditionOr && met {
+		return nil
+	}
+	if n.Operator == ConditionAnd && firstErr == nil {
+		return nil
+	}
+	if firstErr == nil {
+		return errConditionNotMet
 	}
-	return e.processOrderbook()
+	return firstErr
 }
 
-// isValidEvent checks the actions to be taken and returns an error if incorrect
-func (m *eventManager) isValidEvent(exchange, item string, condition EventConditionParams, action string) error {
-	exchange = strings.ToUpper(exchange)
-	item = strings.ToUpper(item)
-	action = strings.ToUpper(action)
+// checkCondition evaluates a single condition against current market data
+func (m *eventManager) checkCondition(e *Event, c *EventCondition, now time.Time) error {
+	switch c.Item {
+	case ItemPrice:
+		t, err := e.getTicker()
+		if err != nil {
+			return err
+		}
+		return shouldProcessEvent(c.Condition, t.Last, c.Price)
+	case ItemOrderbook:
+		return e.processOrderbook(c)
+	case ItemVolume:
+		t, err := e.getTicker()
+		if err != nil {
+			return err
+		}
+		return shouldProcessEvent(c.Condition, t.Volume, c.Value)
+	case ItemPercentChange:
+		t, err := e.getTicker()
+		if err != nil {
+			return err
+		}
+		change, err := m.percentChange(e, c.Window, t.Last, now)
+		if err != nil {
+			return err
+		}
+		return shouldProcessEvent(c.Condition, change, c.Value)
+	case ItemSpread:
+		spread, err := e.spread()
+		if err != nil {
+			return err
+		}
+		return shouldProcessEvent(c.Condition, spread, c.Value)
+	case ItemIndicator:
+		value, err := m.indicatorValue(e, c, now)
+		if err != nil {
+			return err
+		}
+		return shouldProcessEvent(c.Condition, value, c.Value)
+	}
+	return errInvalidItem
+}
 
-	if !m.isValidExchange(exchange) {
-		return errExchangeDisabled
+// percentChange samples the current price and returns the percentage change
+// from the most recent sample at least window old
+func (m *eventManager) percentChange(e *Event, window time.Duration, price float64, now time.Time) (float64, error) {
+	key := e.marketKey()
+	m.dataMtx.Lock()
+	defer m.dataMtx.Unlock()
+	h, ok := m.prices[key]
+	if !ok {
+		h = &priceHistory{}
+		m.prices[key] = h
+	}
+	if window > h.window {
+		h.window = window
+	}
+	if len(h.points) == 0 || now.After(h.points[len(h.points)-1].time) {
+		h.points = append(h.points, pricePoint{price: price, time: now})
+	}
+	// Keep the newest sample older than the longest window so that a base
+	// price remains available
+	cutoff := now.Add(-h.window)
+	for len(h.points) > 1 && !h.points[1].time.After(cutoff) {
+		h.points = h.points[1:]
 	}
 
-	if !isValidItem(item) {
-		return errInvalidItem
+	target := now.Add(-window)
+	for i := len(h.points) - 1; i >= 0; i-- {
+		if h.points[i].time.After(target) {
+			continue
+		}
+		if h.points[i].price == 0 {
+			return 0, errTickerLastPriceZero
+		}
+		return (price - h.points[i].price) / h.points[i].price * 100, nil
 	}
+	return 0, errNotEnoughPriceData
+}
 
-	if !isValidCondition(condition.Condition) {
-		return errInvalidCondition
+// indicatorValue calculates the configured indicator over recent candles and
+// returns its latest value
+func (m *eventManager) indicatorValue(e *Event, c *EventCondition, now time.Time) (float64, error) {
+	candles, err := m.getCandles(e, c, now)
+	if err != nil {
+		return 0, err
+	}
+	ohlcv := &objects.Array{}
+	for i := range candles.Candles {
+		ohlcv.Value = append(ohlcv.Value, &objects.Array{Value: []objects.Object{
+			&objects.Time{Value: candles.Candles[i].Time},
+			&objects.Float{Value: candles.Candles[i].Open},
+			&objects.Float{Value: candles.Candles[i].High},
+			&objects.Float{Value: candles.Candles[i].Low},
+			&objects.Float{Value: candles.Candles[i].Close},
+			&objects.Float{Value: candles.Candles[i].Volume},
+		}})
 	}
+	return calculateIndicator(c.Indicator, ohlcv, c.Period)
+}
 
-	if item == ItemPrice {
-		if condition.Price <= 0 {
-			return errInvalidCondition
+// getCandles returns candles for an indicator condition, fetching them from
+// the exchange once per candle interval. Results shorter than requested are
+// cached as well, as the exchange has no more candles until the next interval
+func (m *eventManager) getCandles(e *Event, c *EventCondition, now time.Time) (*kline.Item, error) {
+	key := e.marketKey() + " " + c.Interval.Word()
+	count := indicatorCandleCount(c)
+	m.dataMtx.Lock()
+	cached, ok := m.candles[key]
+	m.dataMtx.Unlock()
+	if ok && now.Before(cached.expires) &&
+		(cached.requested >= count || len(cached.candles.Candles) >= count) {
+		return &cached.candles, nil
+	}
+
+	exch, err := m.exchangeManager.GetExchangeByName(e.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	interval := c.Interval.Duration()
+	end := now.Truncate(interval)
+	start := end.Add(-interval * time.Duration(count))
+	candles, err := exch.GetHistoricCandles(context.TODO(), e.Pair, e.Asset, start, end, c.Interval)
+	if err != nil {
+		return nil, err
+	}
+	if len(candles.Candles) == 0 {
+		return nil, errNotEnoughCandles
+	}
+	cached = &candleCache{candles: candles, requested: count, expires: end.Add(interval)}
+	m.dataMtx.Lock()
+	m.candles[key] = cached
+	m.dataMtx.Unlock()
+	return &candles, nil
+}
+
+// indicatorCandleCount returns the amount of candles fetched for an indicator
+func indicatorCandleCount(c *EventCondition) int {
+	period := c.Period
+	if c.Indicator == IndicatorMACD {
+		period = macdSlowPeriod + macdSignalPeriod
+	}
+	count := int(period) * indicatorCandleMultiplier
+	if count < minIndicatorCandles {
+		count = minIndicatorCandles
+	}
+	return count
+}
+
+// calculateIndicator runs the gctscript indicator module against the OHLCV
+// data and returns the latest value
+func calculateIndicator(indicator string, ohlcv *objects.Array, period int64) (float64, error) {
+	var module map[string]objects.Object
+	args := []objects.Object{ohlcv, &objects.Int{Value: period}}
+	switch indicator {
+	case IndicatorRSI:
+		module = indicators.RsiModule
+	case IndicatorEMA:
+		module = indicators.EMAModule
+	case IndicatorSMA:
+		module = indicators.SMAModule
+	case IndicatorATR:
+		module = indicators.AtrModule
+	case IndicatorMFI:
+		module = indicators.MfiModule
+	case IndicatorOBV:
+		module = indicators.ObvModule
+		args = args[:1]
+	case IndicatorMACD:
+		module = indicators.MACDModule
+		args = []objects.Object{
+			ohlcv,
+			&objects.Int{Value: macdFastPeriod},
+			&objects.Int{Value: macdSlowPeriod},
+			&objects.Int{Value: macdSignalPeriod},
 		}
+	default:
+		return 0, fmt.Errorf("%w %s", errInvalidIndicator, indicator)
+	}
+	fn, ok := module["calculate"].(*objects.UserFunction)
+	if !ok {
+		return 0, fmt.Errorf("%w %s", errInvalidIndicator, indicator)
+	}
+	result, err := fn.Value(args...)
+	if err != nil {
+		return 0, err
 	}
 
-	if item == ItemOrderbook {
-		if condition.OrderbookAmount <= 0 {
-			return errInvalidCondition
+	var values []objects.Object
+	switch r := result.(type) {
+	case *indicators.RSI:
+		values = r.Value
+	case *indicators.EMA:
+		values = r.Value
+	case *indicators.SMA:
+		values = r.Value
+	case *indicators.ATR:
+		values = r.Value
+	case *indicators.MFI:
+		values = r.Value
+	case *indicators.OBV:
+		values = r.Value
+	case *indicators.MACD:
+		values = r.Value
+	}
+	if len(values) == 0 {
+		return 0, errNotEnoughCandles
+	}
+	last := values[len(values)-1]
+	if hist, ok := last.(*objects.Array); ok {
+		// MACD values hold the histogram, MACD and signal line
+		if len(hist.Value) == 0 {
+			return 0, errNotEnoughCandles
 		}
+		last = hist.Value[0]
 	}
+	value, ok := objects.ToFloat64(last)
+	if !ok || math.IsNaN(value) {
+		return 0, errNotEnoughCandles
+	}
+	return value, nil
+}
 
-	if strings.Contains(action, ",") {
-		a := strings.Split(action, ",")
+// isValidEvent checks the event conditions and actions and returns an error
+// if incorrect
+func (m *eventManager) isValidEvent(e *Event) error {
+	if !m.isValidExchange(e.Exchange) {
+		return errExchangeDisabled
+	}
 
-		if a[0] != ActionSMSNotify {
-			return errInvalidAction
+	if e.Conditions != nil {
+		if err := isValidConditionNode(e.Conditions, 1); err != nil {
+			return err
 		}
-	} else if action != ActionConsolePrint && action != ActionTest {
+	} else if err := isValidEventCondition(&EventCondition{Item: e.Item, EventConditionParams: e.Condition}); err != nil {
+		return err
+	}
+
+	if e.Action == "" && len(e.Actions) == 0 {
 		return errInvalidAction
 	}
+	if e.Action != "" {
+		if strings.Contains(e.Action, ",") {
+			a := strings.Split(e.Action, ",")
+
+			if a[0] != ActionSMSNotify {
+				return errInvalidAction
+			}
+		} else if e.Action != ActionConsolePrint && e.Action != ActionTest {
+			return errInvalidAction
+		}
+	}
 
+	for i := range e.Actions {
+		if err := m.isValidAction(&e.Actions[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isValidConditionNode validates a condition tree
+func isValidConditionNode(n *EventConditionNode, depth int) error {
+	if n == nil {
+		return errInvalidConditionTree
+	}
+	if depth > maxConditionDepth {
+		return fmt.Errorf("%w, exceeds maximum depth of %d", errInvalidConditionTree, maxConditionDepth)
+	}
+	if n.Condition != nil {
+		if n.Operator != "" || len(n.Children) > 0 {
+			return fmt.Errorf("%w, condition nodes cannot have an operator or children", errInvalidConditionTree)
+		}
+		return isValidEventCondition(n.Condition)
+	}
+	if n.Operator != ConditionAnd && n.Operator != ConditionOr {
+		return fmt.Errorf("%w, operator must be %s or %s", errInvalidConditionTree, ConditionAnd, ConditionOr)
+	}
+	if len(n.Children) == 0 {
+		return fmt.Errorf("%w, %s requires at least one child", errInvalidConditionTree, n.Operator)
+	}
+	for i := range n.Children {
+		if err := isValidConditionNode(&n.Children[i], depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isValidEventCondition validates a single condition
+func isValidEventCondition(c *EventCondition) error {
+	if !isValidItem(c.Item) {
+		return errInvalidItem
+	}
+
+	if !isValidCondition(c.Condition) {
+		return errInvalidCondition
+	}
+
+	switch strings.ToUpper(c.Item) {
+	case ItemPrice:
+		if c.Price <= 0 {
+			return errInvalidCondition
+		}
+	case ItemOrderbook:
+		if c.OrderbookAmount <= 0 {
+			return errInvalidCondition
+		}
+	case ItemPercentChange:
+		if c.Window <= 0 {
+			return fmt.Errorf("%w, window must be set", errInvalidCondition)
+		}
+	case ItemSpread, ItemVolume:
+		if c.Value < 0 {
+			return errInvalidCondition
+		}
+	case ItemIndicator:
+		switch c.Indicator {
+		case IndicatorRSI, IndicatorEMA, IndicatorSMA, IndicatorATR, IndicatorMFI:
+			if c.Period <= 0 {
+				return fmt.Errorf("%w, period must be set", errInvalidCondition)
+			}
+		case IndicatorOBV, IndicatorMACD:
+		default:
+			return fmt.Errorf("%w %s", errInvalidIndicator, c.Indicator)
+		}
+		if c.Interval <= 0 {
+			return fmt.Errorf("%w, interval must be set", errInvalidCondition)
+		}
+	}
+	return nil
+}
+
+// isValidAction validates an event action and ensures the subsystems it
+// relies on are available
+func (m *eventManager) isValidAction(a *EventAction) error {
+	switch a.Type {
+	case ActionNotify:
+	case ActionSubmitOrder:
+		if m.orderManager == nil {
+			return errNoOrderManager
+		}
+		if a.Order == nil {
+			return fmt.Errorf("%w, %s requires an order", errInvalidAction, a.Type)
+		}
+		if a.Order.Side != order.Buy && a.Order.Side != order.Sell {
+			return fmt.Errorf("%s %w", a.Type, order.ErrSideIsInvalid)
+		}
+		if a.Order.Type != order.Market && a.Order.Type != order.Limit {
+			return fmt.Errorf("%s %w", a.Type, order.ErrTypeIsInvalid)
+		}
+		if a.Order.Amount <= 0 {
+			return fmt.Errorf("%s %w", a.Type, order.ErrAmountIsInvalid)
+		}
+		if a.Order.Type == order.Limit && a.Order.Price <= 0 {
+			return fmt.Errorf("%s %w", a.Type, order.ErrPriceMustBeSetIfLimitOrder)
+		}
+	case ActionCancelOrders:
+		if m.orderManager == nil {
+			return errNoOrderManager
+		}
+	case ActionRunScript:
+		if m.scriptManager == nil {
+			return errNoScriptManager
+		}
+		if a.Script == "" {
+			return fmt.Errorf("%w, %s requires a script", errInvalidAction, a.Type)
+		}
+	default:
+		return fmt.Errorf("%w %s", errInvalidAction, a.Type)
+	}
 	return nil
 }
 
@@ -251,7 +868,7 @@ func isValidCondition(condition string) bool {
 func isValidItem(item string) bool {
 	item = strings.ToUpper(item)
 	switch item {
-	case ItemPrice, ItemOrderbook:
+	case ItemPrice, ItemOrderbook, ItemPercentChange, ItemSpread, ItemVolume, ItemIndicator:
 		return true
 	}
 	return false
@@ -259,26 +876,65 @@ func isValidItem(item string) bool {
 
 // String turns the structure event into a string
 func (e *Event) String() string {
+	actions := make([]string, 0, len(e.Actions)+1)
+	if e.Action != "" {
+		actions = append(actions, e.Action)
+	}
+	for i := range e.Actions {
+		actions = append(actions, e.Actions[i].Type)
+	}
 	return fmt.Sprintf(
-		"If the %s [%s] %s on %s meets the following %v then %s.", e.Pair.String(),
-		strings.ToUpper(e.Asset.String()), e.Item, e.Exchange, e.Condition, e.Action,
+		"If the %s [%s] on %s meets %s then %s.", e.Pair.String(),
+		strings.ToUpper(e.Asset.String()), e.Exchange, e.conditionTree().String(),
+		strings.Join(actions, ", "),
 	)
 }
 
-func (e *Event) processTicker() error {
+// conditionTree returns the event condition tree, wrapping the single item
+// condition when no tree is set
+func (e *Event) conditionTree() *EventConditionNode {
+	if e.Conditions != nil {
+		return e.Conditions
+	}
+	return &EventConditionNode{Condition: &EventCondition{Item: e.Item, EventConditionParams: e.Condition}}
+}
+
+// marketKey returns a key unique to the event exchange, pair and asset
+func (e *Event) marketKey() string {
+	return strings.ToLower(e.Exchange + " " + e.Pair.String() + " " + e.Asset.String())
+}
+
+func (e *Event) getTicker() (*ticker.Price, error) {
 	t, err := ticker.GetTicker(e.Exchange, e.Pair, e.Asset)
 	if err != nil {
-		return fmt.Errorf("failed to get ticker. Err: %w", err)
+		return nil, fmt.Errorf("failed to get ticker. Err: %w", err)
 	}
 
 	if t.Last == 0 {
-		return errTickerLastPriceZero
+		return nil, errTickerLastPriceZero
+	}
+	return t, nil
+}
+
+// spread returns the difference between the best ask and bid as a percentage
+// of the mid price
+func (e *Event) spread() (float64, error) {
+	ob, err := orderbook.Get(e.Exchange, e.Pair, e.Asset)
+	if err != nil {
+		return 0, fmt.Errorf("events: Failed to get orderbook. Err: %w", err)
+	}
+	if len(ob.Bids) == 0 || len(ob.Asks) == 0 {
+		return 0, errEmptyOrderbookSide
+	}
+	mid := (ob.Asks[0].Price + ob.Bids[0].Price) / 2
+	if mid == 0 {
+		return 0, errEmptyOrderbookSide
 	}
-	return e.shouldProcessEvent(t.Last, e.Condition.Price)
+	return (ob.Asks[0].Price - ob.Bids[0].Price) / mid * 100, nil
 }
 
-func (e *Event) shouldProcessEvent(actual, threshold float64) error {
-	switch e.Condition.Condition {
+func shouldProcessEvent(condition string, actual, threshold float64) error {
+	switch condition {
 	case ConditionGreaterThan:
 		if actual > threshold {
 			return nil
@@ -300,36 +956,94 @@ func (e *Event) shouldProcessEvent(actual, threshold float64) error {
 			return nil
 		}
 	}
-	return errors.New("does not meet conditions")
+	return errConditionNotMet
 }
 
-func (e *Event) processOrderbook() error {
+// processOrderbook checks whether any orderbook level subtotal on the
+// requested sides meets the condition
+func (e *Event) processOrderbook(c *EventCondition) error {
 	ob, err := orderbook.Get(e.Exchange, e.Pair, e.Asset)
 	if err != nil {
 		return fmt.Errorf("events: Failed to get orderbook. Err: %w", err)
 	}
-	if !e.Condition.CheckBids && !e.Condition.CheckAsks {
+	if !c.CheckBids && !c.CheckAsks {
 		return nil
 	}
 
-	if e.Condition.CheckBids {
+	if c.CheckBids {
 		for x := range ob.Bids {
 			subtotal := ob.Bids[x].Amount * ob.Bids[x].Price
-			err = e.shouldProcessEvent(subtotal, e.Condition.OrderbookAmount)
-			if err == nil {
+			if shouldProcessEvent(c.Condition, subtotal, c.OrderbookAmount) == nil {
 				log.Debugf(log.EventMgr, "Events: Bid Amount: %f Price: %v Subtotal: %v\n", ob.Bids[x].Amount, ob.Bids[x].Price, subtotal)
+				return nil
 			}
 		}
 	}
 
-	if e.Condition.CheckAsks {
+	if c.CheckAsks {
 		for x := range ob.Asks {
 			subtotal := ob.Asks[x].Amount * ob.Asks[x].Price
-			err = e.shouldProcessEvent(subtotal, e.Condition.OrderbookAmount)
-			if err == nil {
+			if shouldProcessEvent(c.Condition, subtotal, c.OrderbookAmount) == nil {
 				log.Debugf(log.EventMgr, "Events: Ask Amount: %f Price: %v Subtotal: %v\n", ob.Asks[x].Amount, ob.Asks[x].Price, subtotal)
+				return nil
 			}
 		}
 	}
-	return err
+	return errConditionNotMet
+}
+
+// normalise returns a copy of the condition tree with upper cased operators,
+// items and indicators
+func (n *EventConditionNode) normalise() *EventConditionNode {
+	cpy := &EventConditionNode{Operator: strings.ToUpper(n.Operator)}
+	if n.Condition != nil {
+		c := *n.Condition
+		c.Item = strings.ToUpper(c.Item)
+		c.Indicator = strings.ToUpper(c.Indicator)
+		cpy.Condition = &c
+	}
+	for i := range n.Children {
+		cpy.Children = append(cpy.Children, *n.Children[i].normalise())
+	}
+	return cpy
+}
+
+// String returns a readable form of the condition tree
+func (n *EventConditionNode) String() string {
+	if n.Condition != nil {
+		return n.Condition.String()
+	}
+	children := make([]string, len(n.Children))
+	for i := range n.Children {
+		children[i] = n.Children[i].String()
+	}
+	return "(" + strings.Join(children, " "+n.Operator+" ") + ")"
+}
+
+// String returns a readable form of the condition
+func (c *EventCondition) String() string {
+	switch c.Item {
+	case ItemPrice:
+		return fmt.Sprintf("%s %s %v", c.Item, c.Condition, c.Price)
+	case ItemOrderbook:
+		var sides []string
+		if c.CheckBids {
+			sides = append(sides, "bids")
+		}
+		if c.CheckAsks {
+			sides = append(sides, "asks")
+		}
+		return fmt.Sprintf("%s [%s] %s %v", c.Item, strings.Join(sides, ","), c.Condition, c.OrderbookAmount)
+	case ItemPercentChange:
+		return fmt.Sprintf("%s over %s %s %v%%", c.Item, c.Window, c.Condition, c.Value)
+	case ItemSpread:
+		return fmt.Sprintf("%s %s %v%%", c.Item, c.Condition, c.Value)
+	case ItemIndicator:
+		name := c.Indicator
+		if c.Period > 0 && c.Indicator != IndicatorMACD && c.Indicator != IndicatorOBV {
+			name += "(" + strconv.FormatInt(c.Period, 10) + ")"
+		}
+		return fmt.Sprintf("%s %s %s %v", name, c.Interval.Short(), c.Condition, c.Value)
+	}
+	return fmt.Sprintf("%s %s %v", c.Item, c.Condition, c.Value)
 }
@@ -3,27 +3,36 @@ package engine
 import (
 	"context"
 	"errors"
+	"path/filepath"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 )
 
 func TestSetupEventManager(t *testing.T) {
 	t.Parallel()
-	_, err := setupEventManager(nil, nil, 0, false)
+	_, err := setupEventManager(nil, nil, nil, nil, "", 0, false)
 	if !errors.Is(err, errNilComManager) {
 		t.Errorf("error '%v', expected '%v'", err, errNilComManager)
 	}
 
-	_, err = setupEventManager(&CommunicationManager{}, nil, 0, false)
+	_, err = setupEventManager(&CommunicationManager{}, nil, nil, nil, "", 0, false)
 	if !errors.Is(err, errNilExchangeManager) {
 		t.Errorf("error '%v', expected '%v'", err, errNilExchangeManager)
 	}
 
-	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, nil, nil, "", 0, false)
 	if !errors.Is(err, nil) {
 		t.Fatalf("error '%v', expected '%v'", err, nil)
 	}
@@ -36,7 +45,7 @@ func TestSetupEventManager(t *testing.T) {
 }
 
 func TestEventManagerStart(t *testing.T) {
-	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, nil, nil, "", 0, false)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -59,7 +68,7 @@ func TestEventManagerStart(t *testing.T) {
 
 func TestEventManagerIsRunning(t *testing.T) {
 	t.Parallel()
-	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, nil, nil, "", 0, false)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -82,7 +91,7 @@ func TestEventManagerIsRunning(t *testing.T) {
 
 func TestEventManagerStop(t *testing.T) {
 	t.Parallel()
-	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, nil, nil, "", 0, false)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -108,7 +117,7 @@ func TestEventManagerStop(t *testing.T) {
 func TestEventManagerAdd(t *testing.T) {
 	t.Parallel()
 	em := SetupExchangeManager()
-	m, err := setupEventManager(&CommunicationManager{}, em, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, em, nil, nil, "", 0, false)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -160,7 +169,7 @@ func TestEventManagerAdd(t *testing.T) {
 func TestEventManagerRemove(t *testing.T) {
 	t.Parallel()
 	em := SetupExchangeManager()
-	m, err := setupEventManager(&CommunicationManager{}, em, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, em, nil, nil, "", 0, false)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -199,7 +208,7 @@ func TestEventManagerRemove(t *testing.T) {
 func TestGetEventCounter(t *testing.T) {
 	t.Parallel()
 	em := SetupExchangeManager()
-	m, err := setupEventManager(&CommunicationManager{}, em, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, em, nil, nil, "", 0, false)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -240,7 +249,7 @@ func TestGetEventCounter(t *testing.T) {
 
 func TestCheckEventCondition(t *testing.T) {
 	em := SetupExchangeManager()
-	m, err := setupEventManager(&CommunicationManager{}, em, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, em, nil, nil, "", 0, false)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -322,3 +331,492 @@ func TestCheckEventCondition(t *testing.T) {
 	}
 	m.m.Unlock()
 }
+
+// fakeEventOrderManager records event order actions instead of sending them
+// to an exchange
+type fakeEventOrderManager struct {
+	fakeOrderSubmitter
+	active    []order.Detail
+	cancelled []string
+}
+
+func (f *fakeEventOrderManager) Cancel(_ context.Context, c *order.Cancel) error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	f.cancelled = append(f.cancelled, c.ID)
+	return nil
+}
+
+func (f *fakeEventOrderManager) GetOrdersActive(_ *order.Filter) ([]order.Detail, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	return f.active, nil
+}
+
+// fakeEventComms records pushed events per relayer
+type fakeEventComms struct {
+	m      sync.Mutex
+	pushed map[string][]string
+}
+
+func (f *fakeEventComms) PushEvent(evt base.Event) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	f.pushed[""] = append(f.pushed[""], evt.Message)
+}
+
+func (f *fakeEventComms) PushEventTo(relayer string, evt base.Event) error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	f.pushed[relayer] = append(f.pushed[relayer], evt.Message)
+	return nil
+}
+
+func eventManagerSetup(t *testing.T, storagePath string) (*eventManager, *fakeEventOrderManager, *fakeEventComms) {
+	t.Helper()
+	em := SetupExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	em.Add(exch)
+	om := &fakeEventOrderManager{}
+	comms := &fakeEventComms{pushed: make(map[string][]string)}
+	m, err := setupEventManager(comms, em, om, nil, storagePath, time.Hour, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	return m, om, comms
+}
+
+func TestEventManagerAddEvent(t *testing.T) {
+	t.Parallel()
+	m, _, _ := eventManagerSetup(t, "")
+	p := currency.NewPair(currency.BTC, currency.USD)
+	priceLeaf := EventConditionNode{Condition: &EventCondition{
+		Item:                 ItemPrice,
+		EventConditionParams: EventConditionParams{Condition: ConditionGreaterThan, Price: 1337},
+	}}
+	evt := &Event{
+		Exchange:   testExchange,
+		Pair:       p,
+		Asset:      asset.Spot,
+		Conditions: &EventConditionNode{Operator: "xor", Children: []EventConditionNode{priceLeaf}},
+		Actions:    []EventAction{{Type: ActionNotify}},
+	}
+	_, err := m.AddEvent(evt)
+	if !errors.Is(err, errInvalidConditionTree) {
+		t.Errorf("error '%v', expected '%v'", err, errInvalidConditionTree)
+	}
+
+	evt.Conditions = &EventConditionNode{Operator: ConditionAnd}
+	_, err = m.AddEvent(evt)
+	if !errors.Is(err, errInvalidConditionTree) {
+		t.Errorf("error '%v', expected '%v'", err, errInvalidConditionTree)
+	}
+
+	nested := priceLeaf
+	for i := 0; i < maxConditionDepth; i++ {
+		nested = EventConditionNode{Operator: ConditionOr, Children: []EventConditionNode{nested}}
+	}
+	evt.Conditions = &nested
+	_, err = m.AddEvent(evt)
+	if !errors.Is(err, errInvalidConditionTree) {
+		t.Errorf("error '%v', expected '%v'", err, errInvalidConditionTree)
+	}
+
+	evt.Conditions = &EventConditionNode{Operator: ConditionAnd, Children: []EventConditionNode{
+		priceLeaf,
+		{Condition: &EventCondition{
+			Item:                 ItemPercentChange,
+			EventConditionParams: EventConditionParams{Condition: ConditionLessThan},
+			Value:                -5,
+		}},
+	}}
+	_, err = m.AddEvent(evt)
+	if !errors.Is(err, errInvalidCondition) {
+		t.Errorf("error '%v', expected '%v'", err, errInvalidCondition)
+	}
+
+	evt.Conditions.Children[1].Condition = &EventCondition{
+		Item:                 ItemIndicator,
+		EventConditionParams: EventConditionParams{Condition: ConditionLessThan},
+		Indicator:            "bbands",
+		Interval:             kline.OneHour,
+		Value:                30,
+	}
+	_, err = m.AddEvent(evt)
+	if !errors.Is(err, errInvalidIndicator) {
+		t.Errorf("error '%v', expected '%v'", err, errInvalidIndicator)
+	}
+
+	evt.Conditions.Children[1].Condition.Indicator = "rsi"
+	evt.Conditions.Children[1].Condition.Period = 14
+	evt.Actions = []EventAction{{Type: ActionSubmitOrder}}
+	_, err = m.AddEvent(evt)
+	if !errors.Is(err, errInvalidAction) {
+		t.Errorf("error '%v', expected '%v'", err, errInvalidAction)
+	}
+
+	evt.Actions = []EventAction{{Type: "submit_order", Order: &EventOrder{Side: order.Buy, Type: order.Limit, Amount: 1}}}
+	_, err = m.AddEvent(evt)
+	if !errors.Is(err, order.ErrPriceMustBeSetIfLimitOrder) {
+		t.Errorf("error '%v', expected '%v'", err, order.ErrPriceMustBeSetIfLimitOrder)
+	}
+
+	evt.Actions = append(evt.Actions, EventAction{Type: ActionRunScript, Script: "test.gct"})
+	evt.Actions[0].Order.Price = 1000
+	_, err = m.AddEvent(evt)
+	if !errors.Is(err, errNoScriptManager) {
+		t.Errorf("error '%v', expected '%v'", err, errNoScriptManager)
+	}
+
+	evt.Actions = evt.Actions[:1]
+	id, err := m.AddEvent(evt)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if id != 1 {
+		t.Errorf("received '%v', expected '%v'", id, 1)
+	}
+	events, err := m.GetEvents()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if events[0].Conditions.Children[1].Condition.Indicator != IndicatorRSI {
+		t.Errorf("received '%v', expected '%v'", events[0].Conditions.Children[1].Condition.Indicator, IndicatorRSI)
+	}
+	if events[0].Actions[0].Type != ActionSubmitOrder {
+		t.Errorf("received '%v', expected '%v'", events[0].Actions[0].Type, ActionSubmitOrder)
+	}
+
+	if !m.Remove(id) {
+		t.Fatal("expected true")
+	}
+	id, err = m.AddEvent(evt)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if id != 2 {
+		t.Errorf("received '%v', expected '%v'", id, 2)
+	}
+}
+
+func TestCheckConditionNode(t *testing.T) {
+	t.Parallel()
+	m, _, _ := eventManagerSetup(t, "")
+	p := currency.NewPair(currency.XRP, currency.EUR)
+	err := ticker.ProcessTicker(&ticker.Price{
+		ExchangeName: testExchange,
+		Pair:         p,
+		AssetType:    asset.Spot,
+		Last:         100,
+		Volume:       5000,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	evt := &Event{Exchange: testExchange, Pair: p, Asset: asset.Spot}
+	price := EventConditionNode{Condition: &EventCondition{
+		Item:                 ItemPrice,
+		EventConditionParams: EventConditionParams{Condition: ConditionGreaterThan, Price: 90},
+	}}
+	volume := EventConditionNode{Condition: &EventCondition{
+		Item:                 ItemVolume,
+		EventConditionParams: EventConditionParams{Condition: ConditionGreaterThan},
+		Value:                10000,
+	}}
+
+	and := &EventConditionNode{Operator: ConditionAnd, Children: []EventConditionNode{price, volume}}
+	err = m.checkConditionNode(evt, and, time.Now())
+	if !errors.Is(err, errConditionNotMet) {
+		t.Errorf("error '%v', expected '%v'", err, errConditionNotMet)
+	}
+
+	or := &EventConditionNode{Operator: ConditionOr, Children: []EventConditionNode{volume, price}}
+	err = m.checkConditionNode(evt, or, time.Now())
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+
+	volume.Condition.Value = 1000
+	err = m.checkConditionNode(evt, and, time.Now())
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+
+	nested := &EventConditionNode{Operator: ConditionAnd, Children: []EventConditionNode{
+		*or,
+		{Condition: &EventCondition{
+			Item:                 ItemPrice,
+			EventConditionParams: EventConditionParams{Condition: ConditionLessThan, Price: 50},
+		}},
+	}}
+	err = m.checkConditionNode(evt, nested, time.Now())
+	if !errors.Is(err, errConditionNotMet) {
+		t.Errorf("error '%v', expected '%v'", err, errConditionNotMet)
+	}
+}
+
+func TestPercentChange(t *testing.T) {
+	t.Parallel()
+	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, nil, nil, "", 0, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	evt := &Event{Exchange: testExchange, Pair: currency.NewPair(currency.BTC, currency.USD), Asset: asset.Spot}
+	start := time.Now()
+	_, err = m.percentChange(evt, time.Minute, 100, start)
+	if !errors.Is(err, errNotEnoughPriceData) {
+		t.Errorf("error '%v', expected '%v'", err, errNotEnoughPriceData)
+	}
+	_, err = m.percentChange(evt, time.Minute, 110, start.Add(time.Second*30))
+	if !errors.Is(err, errNotEnoughPriceData) {
+		t.Errorf("error '%v', expected '%v'", err, errNotEnoughPriceData)
+	}
+	change, err := m.percentChange(evt, time.Minute, 90, start.Add(time.Minute))
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if change != -10 {
+		t.Errorf("received '%v', expected '%v'", change, -10)
+	}
+	change, err = m.percentChange(evt, time.Minute, 121, start.Add(time.Second*95))
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if change != 10 {
+		t.Errorf("received '%v', expected '%v'", change, 10)
+	}
+	if len(m.prices[evt.marketKey()].points) != 3 {
+		t.Errorf("received '%v', expected '%v'", len(m.prices[evt.marketKey()].points), 3)
+	}
+}
+
+func TestGetCandlesCache(t *testing.T) {
+	t.Parallel()
+	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, nil, nil, "", 0, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	evt := &Event{Exchange: testExchange, Pair: currency.NewPair(currency.BTC, currency.USD), Asset: asset.Spot}
+	c := &EventCondition{Item: ItemIndicator, Indicator: IndicatorSMA, Period: 5, Interval: kline.OneHour}
+	now := time.Now()
+	// the exchange returned fewer candles than requested, the result is
+	// reused until the next candle is due rather than fetched every check
+	m.candles[evt.marketKey()+" "+c.Interval.Word()] = &candleCache{
+		candles:   kline.Item{Candles: []kline.Candle{{Close: 1}}},
+		requested: indicatorCandleCount(c),
+		expires:   now.Add(time.Minute),
+	}
+	candles, err := m.getCandles(evt, c, now)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(candles.Candles) != 1 {
+		t.Errorf("received '%v', expected '%v'", len(candles.Candles), 1)
+	}
+
+	// a longer period needs more candles than were requested
+	_, err = m.getCandles(evt, &EventCondition{Item: ItemIndicator, Indicator: IndicatorSMA, Period: 50, Interval: kline.OneHour}, now)
+	if !errors.Is(err, ErrExchangeNotFound) {
+		t.Errorf("error '%v', expected '%v'", err, ErrExchangeNotFound)
+	}
+	_, err = m.getCandles(evt, c, now.Add(time.Minute))
+	if !errors.Is(err, ErrExchangeNotFound) {
+		t.Errorf("error '%v', expected '%v'", err, ErrExchangeNotFound)
+	}
+}
+
+func TestEventSpread(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.XRP, currency.AUD)
+	evt := &Event{Exchange: testExchange, Pair: p, Asset: asset.Spot}
+	_, err := evt.spread()
+	if err == nil {
+		t.Error("expected error")
+	}
+	ob := orderbook.Base{
+		Exchange: testExchange,
+		Pair:     p,
+		Asset:    asset.Spot,
+		Bids:     orderbook.Items{{Price: 99, Amount: 1}},
+		Asks:     orderbook.Items{{Price: 101, Amount: 1}},
+	}
+	err = ob.Process()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	spread, err := evt.spread()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if spread != 2 {
+		t.Errorf("received '%v', expected '%v'", spread, 2)
+	}
+}
+
+func TestCalculateIndicator(t *testing.T) {
+	t.Parallel()
+	ohlcv := &objects.Array{}
+	start := time.Now().Add(-time.Hour * 60)
+	for i := 0; i < 60; i++ {
+		c := float64(i + 1)
+		ohlcv.Value = append(ohlcv.Value, &objects.Array{Value: []objects.Object{
+			&objects.Time{Value: start.Add(time.Hour * time.Duration(i))},
+			&objects.Float{Value: c},
+			&objects.Float{Value: c + 1},
+			&objects.Float{Value: c - 1},
+			&objects.Float{Value: c},
+			&objects.Float{Value: 100},
+		}})
+	}
+	_, err := calculateIndicator("bbands", ohlcv, 5)
+	if !errors.Is(err, errInvalidIndicator) {
+		t.Errorf("error '%v', expected '%v'", err, errInvalidIndicator)
+	}
+	sma, err := calculateIndicator(IndicatorSMA, ohlcv, 5)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if sma != 58 {
+		t.Errorf("received '%v', expected '%v'", sma, 58)
+	}
+	rsi, err := calculateIndicator(IndicatorRSI, ohlcv, 14)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if rsi != 100 {
+		t.Errorf("received '%v', expected '%v'", rsi, 100)
+	}
+	_, err = calculateIndicator(IndicatorMACD, ohlcv, 0)
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	_, err = calculateIndicator(IndicatorOBV, ohlcv, 0)
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+}
+
+func TestCheckEventsActions(t *testing.T) {
+	t.Parallel()
+	m, om, comms := eventManagerSetup(t, "")
+	p := currency.NewPair(currency.LTC, currency.GBP)
+	om.active = []order.Detail{{Exchange: testExchange, ID: "1337", Pair: p, AssetType: asset.Spot}}
+	err := ticker.ProcessTicker(&ticker.Price{
+		ExchangeName: testExchange,
+		Pair:         p,
+		AssetType:    asset.Spot,
+		Last:         100,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	_, err = m.AddEvent(&Event{
+		Exchange: testExchange,
+		Item:     ItemPrice,
+		Condition: EventConditionParams{
+			Condition: ConditionGreaterThanOrEqual,
+			Price:     100,
+		},
+		Pair:  p,
+		Asset: asset.Spot,
+		Actions: []EventAction{
+			{Type: ActionCancelOrders},
+			{Type: ActionSubmitOrder, Order: &EventOrder{Side: order.Sell, Type: order.Market, Amount: 2}},
+			{Type: ActionNotify, Relayer: "telegram", Message: "sold"},
+			{Type: ActionNotify},
+		},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	m.checkEvents()
+	total, executed := m.getEventCounter()
+	if total != 1 || executed != 1 {
+		t.Fatalf("received '%v/%v', expected '%v/%v'", executed, total, 1, 1)
+	}
+	if len(om.cancelled) != 1 || om.cancelled[0] != "1337" {
+		t.Errorf("received '%v', expected '%v'", om.cancelled, []string{"1337"})
+	}
+	if len(om.submitted) != 1 {
+		t.Fatalf("received '%v', expected '%v'", len(om.submitted), 1)
+	}
+	if om.submitted[0].Side != order.Sell || om.submitted[0].Amount != 2 || !om.submitted[0].Pair.Equal(p) {
+		t.Errorf("received '%+v', unexpected order", om.submitted[0])
+	}
+	if len(comms.pushed["telegram"]) != 1 || comms.pushed["telegram"][0] != "sold" {
+		t.Errorf("received '%v', expected '%v'", comms.pushed["telegram"], []string{"sold"})
+	}
+	if len(comms.pushed[""]) != 1 {
+		t.Errorf("received '%v', expected '%v'", len(comms.pushed[""]), 1)
+	}
+
+	m.checkEvents()
+	if len(om.submitted) != 1 {
+		t.Errorf("received '%v', expected '%v'", len(om.submitted), 1)
+	}
+}
+
+func TestEventManagerPersistence(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), EventsFileName)
+	m, _, _ := eventManagerSetup(t, path)
+	cond := EventConditionParams{
+		Condition: ConditionGreaterThan,
+		Price:     1337,
+	}
+	p := currency.NewPair(currency.BTC, currency.USD)
+	_, err := m.Add(testExchange, ItemPrice, cond, p, asset.Spot, ActionTest)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	id, err := m.AddEvent(&Event{
+		Exchange: testExchange,
+		Conditions: &EventConditionNode{Operator: ConditionOr, Children: []EventConditionNode{
+			{Condition: &EventCondition{Item: ItemSpread, EventConditionParams: EventConditionParams{Condition: ConditionGreaterThan}, Value: 1}},
+			{Condition: &EventCondition{Item: ItemPercentChange, EventConditionParams: EventConditionParams{Condition: ConditionLessThan}, Value: -5, Window: time.Hour}},
+		}},
+		Pair:    p,
+		Asset:   asset.Spot,
+		Actions: []EventAction{{Type: ActionNotify, Relayer: "slack"}},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.Stop()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+
+	restored, _, _ := eventManagerSetup(t, path)
+	events, err := restored.GetEvents()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(events) != 2 {
+		t.Fatalf("received '%v', expected '%v'", len(events), 2)
+	}
+	if events[1].ID != id || !events[1].Pair.Equal(p) || events[1].Asset != asset.Spot {
+		t.Errorf("received '%+v', unexpected event", events[1])
+	}
+	if events[1].Conditions.Children[1].Condition.Window != time.Hour {
+		t.Errorf("received '%v', expected '%v'", events[1].Conditions.Children[1].Condition.Window, time.Hour)
+	}
+	if events[1].Actions[0].Relayer != "slack" {
+		t.Errorf("received '%v', expected '%v'", events[1].Actions[0].Relayer, "slack")
+	}
+	nextID, err := restored.Add(testExchange, ItemPrice, cond, p, asset.Spot, ActionTest)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if nextID != id+1 {
+		t.Errorf("received '%v', expected '%v'", nextID, id+1)
+	}
+}
@@ -1,18 +1,27 @@
 package engine
 
 import (
+	"context"
 	"errors"
 	"sync"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 )
 
 // Event const vars
 const (
-	ItemPrice     = "PRICE"
-	ItemOrderbook = "ORDERBOOK"
+	ItemPrice         = "PRICE"
+	ItemOrderbook     = "ORDERBOOK"
+	ItemPercentChange = "PERCENT_CHANGE"
+	ItemSpread        = "SPREAD"
+	ItemVolume        = "VOLUME"
+	ItemIndicator     = "INDICATOR"
 
 	ConditionGreaterThan        = ">"
 	ConditionGreaterThanOrEqual = ">="
@@ -20,55 +29,199 @@ const (
 	ConditionLessThanOrEqual    = "<="
 	ConditionIsEqual            = "=="
 
+	ConditionAnd = "AND"
+	ConditionOr  = "OR"
+
 	ActionSMSNotify    = "SMS"
 	ActionConsolePrint = "CONSOLE_PRINT"
 	ActionTest         = "ACTION_TEST"
+	ActionNotify       = "NOTIFY"
+	ActionSubmitOrder  = "SUBMIT_ORDER"
+	ActionCancelOrders = "CANCEL_ORDERS"
+	ActionRunScript    = "RUN_SCRIPT"
+
+	IndicatorRSI  = "RSI"
+	IndicatorEMA  = "EMA"
+	IndicatorSMA  = "SMA"
+	IndicatorMACD = "MACD"
+	IndicatorATR  = "ATR"
+	IndicatorMFI  = "MFI"
+	IndicatorOBV  = "OBV"
 
 	defaultSleepDelay = time.Millisecond * 500
+	// EventsFileName is the file events are persisted to within the data
+	// directory
+	EventsFileName = "events.json"
+	// maxConditionDepth limits how deeply condition trees can be nested
+	maxConditionDepth = 8
+	// indicatorCandleMultiplier sets how many candles are fetched relative
+	// to the indicator period so that smoothed indicators settle
+	indicatorCandleMultiplier = 3
+	minIndicatorCandles       = 50
+	macdFastPeriod            = 12
+	macdSlowPeriod            = 26
+	macdSignalPeriod          = 9
 )
 
 // vars related to events package
 var (
-	EventSleepDelay        = defaultSleepDelay
-	errInvalidItem         = errors.New("invalid item")
-	errInvalidCondition    = errors.New("invalid conditional option")
-	errInvalidAction       = errors.New("invalid action")
-	errExchangeDisabled    = errors.New("desired exchange is disabled")
-	errNilEvent            = errors.New("nil event received")
-	errNilComManager       = errors.New("nil communications manager received")
-	errTickerLastPriceZero = errors.New("ticker last price is 0")
+	EventSleepDelay         = defaultSleepDelay
+	errInvalidItem          = errors.New("invalid item")
+	errInvalidCondition     = errors.New("invalid conditional option")
+	errInvalidAction        = errors.New("invalid action")
+	errExchangeDisabled     = errors.New("desired exchange is disabled")
+	errNilEvent             = errors.New("nil event received")
+	errNilComManager        = errors.New("nil communications manager received")
+	errTickerLastPriceZero  = errors.New("ticker last price is 0")
+	errConditionNotMet      = errors.New("does not meet conditions")
+	errInvalidConditionTree = errors.New("invalid condition tree")
+	errInvalidIndicator     = errors.New("invalid indicator")
+	errNotEnoughPriceData   = errors.New("not enough price history for window")
+	errNotEnoughCandles     = errors.New("not enough candles to calculate indicator")
+	errEmptyOrderbookSide   = errors.New("orderbook has no bids or asks")
+	errNoOrderManager       = errors.New("order manager is required for order actions")
+	errNoScriptManager      = errors.New("script manager is required for script actions")
+	errEventNotFound        = errors.New("event not found")
 )
 
 // EventConditionParams holds the event condition variables
 type EventConditionParams struct {
-	Condition string
-	Price     float64
+	Condition string  `json:"condition"`
+	Price     float64 `json:"price,omitempty"`
+
+	CheckBids       bool    `json:"checkBids,omitempty"`
+	CheckAsks       bool    `json:"checkAsks,omitempty"`
+	OrderbookAmount float64 `json:"orderbookAmount,omitempty"`
+}
+
+// EventCondition is a single comparison of an item against a threshold.
+// PRICE compares against Price, ORDERBOOK against OrderbookAmount and all
+// other items against Value
+type EventCondition struct {
+	Item string `json:"item"`
+	EventConditionParams
+	// Value is the threshold for percent change, spread, volume and
+	// indicator items. Percent change and spread are expressed in percent
+	Value float64 `json:"value,omitempty"`
+	// Window is the lookback used for percent change items
+	Window time.Duration `json:"window,omitempty"`
+	// Interval is the candle interval indicators are calculated on
+	Interval kline.Interval `json:"interval,omitempty"`
+	// Indicator is the indicator to calculate, MACD compares the histogram
+	// using 12, 26 and 9 periods
+	Indicator string `json:"indicator,omitempty"`
+	Period    int64  `json:"period,omitempty"`
+}
 
-	CheckBids       bool
-	CheckAsks       bool
-	OrderbookAmount float64
+// EventConditionNode is a node of a condition tree. Branch nodes combine
+// their children with AND or OR, leaf nodes hold a single condition
+type EventConditionNode struct {
+	Operator  string               `json:"operator,omitempty"`
+	Children  []EventConditionNode `json:"children,omitempty"`
+	Condition *EventCondition      `json:"condition,omitempty"`
+}
+
+// EventOrder holds the order submitted by a SUBMIT_ORDER action. The order is
+// placed on the event exchange, pair and asset
+type EventOrder struct {
+	Side     order.Side `json:"side"`
+	Type     order.Type `json:"type"`
+	Amount   float64    `json:"amount"`
+	Price    float64    `json:"price,omitempty"`
+	ClientID string     `json:"clientID,omitempty"`
+}
+
+// EventAction is an action performed when an event triggers
+type EventAction struct {
+	Type string `json:"type"`
+	// Message overrides the default event description for NOTIFY actions
+	Message string `json:"message,omitempty"`
+	// Relayer restricts NOTIFY actions to a single communications relayer,
+	// all enabled relayers are notified when empty
+	Relayer string      `json:"relayer,omitempty"`
+	Order   *EventOrder `json:"order,omitempty"`
+	// Script is the script file run by RUN_SCRIPT actions, relative paths
+	// are resolved against the script directory
+	Script string `json:"script,omitempty"`
 }
 
 // Event struct holds the event variables
 type Event struct {
-	ID        int64
-	Exchange  string
-	Item      string
-	Condition EventConditionParams
-	Pair      currency.Pair
-	Asset     asset.Item
-	Action    string
-	Executed  bool
+	ID        int64                `json:"id"`
+	Exchange  string               `json:"exchange"`
+	Item      string               `json:"item,omitempty"`
+	Condition EventConditionParams `json:"conditionParams"`
+	// Conditions replaces Item and Condition with a condition tree when set
+	Conditions *EventConditionNode `json:"conditions,omitempty"`
+	Pair       currency.Pair       `json:"pair"`
+	Asset      asset.Item          `json:"asset"`
+	Action     string              `json:"action,omitempty"`
+	// Actions are performed in order after Action when the event triggers
+	Actions    []EventAction `json:"actions,omitempty"`
+	Executed   bool          `json:"executed"`
+	CreatedAt  time.Time     `json:"createdAt"`
+	ExecutedAt time.Time     `json:"executedAt"`
+}
+
+// iEventCommsManager limits exposure of accessible functions to the
+// communications manager for event notifications
+type iEventCommsManager interface {
+	iCommsManager
+	PushEventTo(relayer string, evt base.Event) error
+}
+
+// iEventOrderManager limits exposure of accessible functions to the order
+// manager for event order actions
+type iEventOrderManager interface {
+	Submit(context.Context, *order.Submit) (*OrderSubmitResponse, error)
+	Cancel(context.Context, *order.Cancel) error
+	GetOrdersActive(*order.Filter) ([]order.Detail, error)
+}
+
+// iEventScriptManager limits exposure of accessible functions to the script
+// manager for event script actions
+type iEventScriptManager interface {
+	IsRunning() bool
+	New() *gctscript.VM
+}
+
+// pricePoint is a ticker price sampled by the event manager
+type pricePoint struct {
+	price float64
+	time  time.Time
+}
+
+// priceHistory holds sampled prices for a market along with the longest
+// window requested for it
+type priceHistory struct {
+	points []pricePoint
+	window time.Duration
+}
+
+// candleCache holds candles fetched for indicator conditions until the next
+// candle is due, along with the amount of candles requested
+type candleCache struct {
+	candles   kline.Item
+	requested int
+	expires   time.Time
 }
 
 // eventManager holds communication manager data
 type eventManager struct {
 	started         int32
-	comms           iCommsManager
+	comms           iEventCommsManager
 	events          []Event
 	verbose         bool
 	sleepDelay      time.Duration
 	exchangeManager iExchangeManager
+	orderManager    iEventOrderManager
+	scriptManager   iEventScriptManager
+	storagePath     string
+	nextID          int64
 	shutdown        chan struct{}
 	m               sync.Mutex
+
+	dataMtx sync.Mutex
+	prices  map[string]*priceHistory
+	candles map[string]*candleCache
 }
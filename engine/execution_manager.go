@@ -0,0 +1,810 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupExecutionManager will boot up the ExecutionManager. If a database
+// connection manager is supplied and connected, VWAP volume profiles are
+// loaded from stored candles before falling back to the exchange
+func SetupExecutionManager(exchangeManager iExchangeManager, orderManager iOrderExecutor, communicationsManager iCommsManager, databaseConnectionManager iDatabaseConnectionManager, verbose bool) (*ExecutionManager, error) {
+	if exchangeManager == nil {
+		return nil, errNilExchangeManager
+	}
+	if orderManager == nil {
+		return nil, errNilOrderManager
+	}
+	if communicationsManager == nil {
+		return nil, errNilCommunicationsManager
+	}
+	return &ExecutionManager{
+		shutdown:        make(chan struct{}),
+		exchangeManager: exchangeManager,
+		orderManager:    orderManager,
+		commsManager:    communicationsManager,
+		dbManager:       databaseConnectionManager,
+		verbose:         verbose,
+		orders:          make(map[uuid.UUID]*ParentOrder),
+	}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *ExecutionManager) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return atomic.LoadInt32(&m.started) == 1
+}
+
+// Start runs the subsystem
+func (m *ExecutionManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("execution manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("execution manager %w", ErrSubSystemAlreadyStarted)
+	}
+	log.Debugln(log.OrderMgr, "Execution manager starting...")
+	m.shutdown = make(chan struct{})
+	m.wg.Add(1)
+	go m.run()
+	log.Debugln(log.OrderMgr, "Execution manager started.")
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem. Parent orders are retained and
+// continue from their next due slice if the subsystem is started again
+func (m *ExecutionManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("execution manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return fmt.Errorf("execution manager %w", ErrSubSystemNotStarted)
+	}
+	log.Debugln(log.OrderMgr, "Execution manager shutting down...")
+	close(m.shutdown)
+	m.wg.Wait()
+	log.Debugln(log.OrderMgr, "Execution manager shutdown.")
+	return nil
+}
+
+// run periodically submits due slices and tracks child order fills
+func (m *ExecutionManager) run() {
+	defer m.wg.Done()
+	tick := time.NewTicker(executionManagerDelay)
+	defer tick.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case t := <-tick.C:
+			m.process(t)
+		}
+	}
+}
+
+// Submit validates a parent order, builds its schedule and begins executing
+// it. The returned order contains the generated ID and schedule
+func (m *ExecutionManager) Submit(ctx context.Context, o *ParentOrder) (*ParentOrder, error) {
+	if m == nil {
+		return nil, fmt.Errorf("execution manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("execution manager %w", ErrSubSystemNotStarted)
+	}
+	if o == nil {
+		return nil, errNilExecutionOrder
+	}
+	cpy := o.copy()
+	exch, err := m.validate(&cpy)
+	if err != nil {
+		return nil, err
+	}
+	err = m.buildSchedule(ctx, exch, &cpy)
+	if err != nil {
+		return nil, err
+	}
+
+	cpy.ID, err = uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	cpy.Status = ExecutionActive
+	cpy.CreatedAt = time.Now()
+	cpy.UpdatedAt = cpy.CreatedAt
+	if t, tErr := ticker.GetTicker(cpy.Exchange, cpy.Pair, cpy.AssetType); tErr == nil {
+		cpy.ArrivalPrice = t.Last
+	}
+
+	log.Infof(log.OrderMgr,
+		"Execution manager: Added %s %s %s %s parent order ID=%v amount=%v slices=%v from %v to %v",
+		cpy.Exchange, cpy.Pair, cpy.Side, cpy.Strategy, cpy.ID, cpy.Amount, len(cpy.Schedule),
+		cpy.StartTime.Format(common.SimpleTimeFormat), cpy.EndTime.Format(common.SimpleTimeFormat))
+	resp := cpy.copy()
+	m.m.Lock()
+	m.orders[cpy.ID] = &cpy
+	m.m.Unlock()
+	return &resp, nil
+}
+
+// Pause stops an active parent order from submitting further slices. Slices
+// which fall due while paused are carried over once resumed
+func (m *ExecutionManager) Pause(id uuid.UUID) error {
+	if m == nil {
+		return fmt.Errorf("execution manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("execution manager %w", ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	o, ok := m.orders[id]
+	if !ok {
+		return fmt.Errorf("%w %v", errExecutionOrderNotFound, id)
+	}
+	if o.Status != ExecutionActive {
+		return fmt.Errorf("%w %v status %v", errExecutionOrderNotActive, id, o.Status)
+	}
+	o.Status = ExecutionPaused
+	o.UpdatedAt = time.Now()
+	return nil
+}
+
+// Resume continues a paused parent order
+func (m *ExecutionManager) Resume(id uuid.UUID) error {
+	if m == nil {
+		return fmt.Errorf("execution manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("execution manager %w", ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	o, ok := m.orders[id]
+	if !ok {
+		return fmt.Errorf("%w %v", errExecutionOrderNotFound, id)
+	}
+	if o.Status != ExecutionPaused {
+		return fmt.Errorf("%w %v status %v", errExecutionOrderNotPaused, id, o.Status)
+	}
+	o.Status = ExecutionActive
+	o.UpdatedAt = time.Now()
+	return nil
+}
+
+// Cancel stops a parent order from submitting further slices and cancels any
+// of its child orders which are still open
+func (m *ExecutionManager) Cancel(ctx context.Context, id uuid.UUID) error {
+	if m == nil {
+		return fmt.Errorf("execution manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("execution manager %w", ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	o, ok := m.orders[id]
+	if !ok {
+		m.m.Unlock()
+		return fmt.Errorf("%w %v", errExecutionOrderNotFound, id)
+	}
+	if o.isFinished() {
+		m.m.Unlock()
+		return fmt.Errorf("%w %v status %v", errExecutionOrderFinished, id, o.Status)
+	}
+	o.Status = ExecutionCancelled
+	o.UpdatedAt = time.Now()
+	var open []int
+	for i := range o.Schedule {
+		switch {
+		case o.Schedule[i].Status == ExecutionSlicePending:
+			o.Schedule[i].Status = ExecutionSliceCancelled
+		case o.Schedule[i].OrderID != "" && !isInactiveOrderStatus(o.Schedule[i].OrderStatus):
+			open = append(open, i)
+		}
+	}
+	cpy := o.copy()
+	m.m.Unlock()
+
+	var errs common.Errors
+	for _, i := range open {
+		err := m.cancelChild(ctx, &cpy, i)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("slice %d: %w", i, err))
+		}
+	}
+	m.notify(fmt.Sprintf("Execution manager: %s %s %s parent order ID=%v cancelled, executed %v of %v.",
+		cpy.Exchange, cpy.Pair, cpy.Strategy, cpy.ID, cpy.ExecutedAmount, cpy.Amount), false)
+	if errs != nil {
+		return errs
+	}
+	return nil
+}
+
+// GetOrder returns a copy of a parent order and its schedule
+func (m *ExecutionManager) GetOrder(id uuid.UUID) (*ParentOrder, error) {
+	if m == nil {
+		return nil, fmt.Errorf("execution manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("execution manager %w", ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	o, ok := m.orders[id]
+	if !ok {
+		return nil, fmt.Errorf("%w %v", errExecutionOrderNotFound, id)
+	}
+	m.refreshFills(o)
+	resp := o.copy()
+	return &resp, nil
+}
+
+// GetOrders returns a copy of stored parent orders, optionally filtered by
+// exchange. Completed, cancelled and failed orders are only returned when
+// includeInactive is set
+func (m *ExecutionManager) GetOrders(exchangeName string, includeInactive bool) ([]ParentOrder, error) {
+	if m == nil {
+		return nil, fmt.Errorf("execution manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("execution manager %w", ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	resp := make([]ParentOrder, 0, len(m.orders))
+	for _, o := range m.orders {
+		if exchangeName != "" && !strings.EqualFold(o.Exchange, exchangeName) {
+			continue
+		}
+		if !includeInactive && o.isFinished() {
+			continue
+		}
+		m.refreshFills(o)
+		resp = append(resp, o.copy())
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].CreatedAt.Before(resp[j].CreatedAt)
+	})
+	return resp, nil
+}
+
+// validate checks a parent order and applies defaults
+func (m *ExecutionManager) validate(o *ParentOrder) (exchange.IBotExchange, error) {
+	if o.Exchange == "" {
+		return nil, errExecutionExchangeEmpty
+	}
+	exch, err := m.exchangeManager.GetExchangeByName(o.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	o.Exchange = exch.GetName()
+	if o.Pair.IsEmpty() {
+		return nil, order.ErrPairIsEmpty
+	}
+	if !o.AssetType.IsValid() {
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, o.AssetType)
+	}
+	switch o.Side {
+	case order.Buy, order.Bid:
+		o.Side = order.Buy
+	case order.Sell, order.Ask:
+		o.Side = order.Sell
+	default:
+		return nil, fmt.Errorf("%w received %v", errInvalidExecutionSide, o.Side)
+	}
+	o.Strategy = ExecutionStrategy(strings.ToUpper(string(o.Strategy)))
+	if o.Strategy != TWAP && o.Strategy != VWAP {
+		return nil, fmt.Errorf("%w %v", errUnsupportedExecutionType, o.Strategy)
+	}
+	if o.Amount <= 0 {
+		return nil, errInvalidExecutionAmount
+	}
+	if o.LimitPrice < 0 {
+		return nil, errInvalidExecutionLimitPrice
+	}
+	if o.Slices <= 0 {
+		return nil, errInvalidExecutionSlices
+	}
+	if o.StartTime.IsZero() {
+		o.StartTime = time.Now()
+	}
+	if !o.EndTime.After(o.StartTime) || !o.EndTime.After(time.Now()) {
+		return nil, errInvalidExecutionWindow
+	}
+	if o.Strategy == VWAP {
+		if o.VolumeInterval == 0 {
+			o.VolumeInterval = defaultVWAPInterval
+		}
+		if o.VolumeLookback <= 0 {
+			o.VolumeLookback = defaultVWAPLookback
+		}
+	}
+	return exch, nil
+}
+
+// buildSchedule splits the parent order into slices weighted by its strategy
+// and conforms each slice amount to the exchange order execution limits
+func (m *ExecutionManager) buildSchedule(ctx context.Context, exch exchange.IBotExchange, o *ParentOrder) error {
+	sliceDuration := o.EndTime.Sub(o.StartTime) / time.Duration(o.Slices)
+	weights := equalWeights(o.Slices)
+	if o.Strategy == VWAP {
+		candles, err := m.loadVolumeHistory(ctx, exch, o)
+		if err != nil {
+			log.Warnf(log.OrderMgr,
+				"Execution manager: %s %s unable to load volume history, falling back to equal weights: %v",
+				o.Exchange, o.Pair, err)
+		} else {
+			weights = volumeProfileWeights(candles, o.VolumeInterval.Duration(), o.StartTime, sliceDuration, o.Slices)
+		}
+	}
+	o.Schedule = make([]ExecutionSlice, o.Slices)
+	for i := range o.Schedule {
+		o.Schedule[i] = ExecutionSlice{
+			ScheduledAt: o.StartTime.Add(sliceDuration * time.Duration(i)),
+			Weight:      weights[i],
+			Status:      ExecutionSlicePending,
+		}
+	}
+
+	limits, err := exch.GetOrderExecutionLimits(o.AssetType, o.Pair)
+	if err != nil && !errors.Is(err, order.ErrExchangeLimitNotLoaded) {
+		return err
+	}
+	return o.allocate(limits)
+}
+
+// loadVolumeHistory returns historic candles used to build a VWAP volume
+// profile, preferring the candle repository when the database is connected
+func (m *ExecutionManager) loadVolumeHistory(ctx context.Context, exch exchange.IBotExchange, o *ParentOrder) ([]kline.Candle, error) {
+	end := time.Now().Truncate(o.VolumeInterval.Duration())
+	start := end.Add(-o.VolumeLookback)
+	if m.dbManager != nil {
+		if db := m.dbManager.GetInstance(); db != nil && db.IsConnected() {
+			k, err := kline.LoadFromDatabase(o.Exchange, o.Pair, o.AssetType, o.VolumeInterval, start, end)
+			if err == nil && len(k.Candles) > 0 {
+				return k.Candles, nil
+			}
+			if m.verbose {
+				log.Debugf(log.OrderMgr,
+					"Execution manager: %s %s no stored candles for volume profile, using exchange: %v",
+					o.Exchange, o.Pair, err)
+			}
+		}
+	}
+	k, err := exch.GetHistoricCandles(ctx, o.Pair, o.AssetType, start, end, o.VolumeInterval)
+	if err != nil {
+		return nil, err
+	}
+	if len(k.Candles) == 0 {
+		return nil, errNoVolumeHistory
+	}
+	return k.Candles, nil
+}
+
+// equalWeights returns evenly distributed TWAP weights
+func equalWeights(slices int) []float64 {
+	weights := make([]float64, slices)
+	for i := range weights {
+		weights[i] = 1 / float64(slices)
+	}
+	return weights
+}
+
+// volumeProfileWeights distributes historic candle volume across the slices
+// of an execution window by time of day. Candle volume is assumed to trade
+// evenly across the candle so a candle which overlaps several slices is split
+// between them. Windows of a day or more, or no volume, use equal weights
+func volumeProfileWeights(candles []kline.Candle, candleDuration time.Duration, start time.Time, sliceDuration time.Duration, slices int) []float64 {
+	const day = time.Hour * 24
+	if sliceDuration >= day || candleDuration <= 0 || candleDuration > day {
+		return equalWeights(slices)
+	}
+	weights := make([]float64, slices)
+	var total float64
+	for i := range weights {
+		sliceStart := timeOfDay(start.Add(sliceDuration * time.Duration(i)))
+		sliceEnd := sliceStart + sliceDuration
+		for j := range candles {
+			candleStart := timeOfDay(candles[j].Time)
+			for _, shift := range []time.Duration{-day, 0, day} {
+				from, to := candleStart+shift, candleStart+shift+candleDuration
+				if from < sliceStart {
+					from = sliceStart
+				}
+				if to > sliceEnd {
+					to = sliceEnd
+				}
+				if to > from {
+					weights[i] += candles[j].Volume * float64(to-from) / float64(candleDuration)
+				}
+			}
+		}
+		total += weights[i]
+	}
+	if total <= 0 {
+		return equalWeights(slices)
+	}
+	for i := range weights {
+		weights[i] /= total
+	}
+	return weights
+}
+
+// timeOfDay returns the duration since midnight UTC
+func timeOfDay(t time.Time) time.Duration {
+	t = t.UTC()
+	return t.Sub(t.Truncate(time.Hour * 24))
+}
+
+// allocate sets the amount of each slice from its weight, conformed to the
+// exchange limits. Amounts too small to be submitted are carried over to the
+// next slice and any remainder after the final slice is merged into the last
+// slice which can hold it
+func (o *ParentOrder) allocate(limits *order.Limits) error {
+	orderType := o.childOrderType()
+	var allocated, cumulative float64
+	last := -1
+	for i := range o.Schedule {
+		cumulative += o.Schedule[i].Weight
+		target := o.Amount*cumulative - allocated
+		if i == len(o.Schedule)-1 {
+			target = o.Amount - allocated
+		}
+		amount := limits.ConformToAmount(target)
+		if amount <= 0 || limits.Conforms(o.LimitPrice, amount, orderType) != nil {
+			amount = 0
+		}
+		o.Schedule[i].Amount = amount
+		allocated += amount
+		if amount > 0 {
+			last = i
+		}
+	}
+	if last == -1 {
+		return fmt.Errorf("%w amount: %v slices: %v", errExecutionAmountUnschedulable, o.Amount, len(o.Schedule))
+	}
+	if remainder := o.Amount - allocated; remainder > 0 && last != len(o.Schedule)-1 {
+		merged := limits.ConformToAmount(o.Schedule[last].Amount + remainder)
+		if limits.Conforms(o.LimitPrice, merged, orderType) == nil {
+			o.Schedule[last].Amount = merged
+		}
+	}
+	return nil
+}
+
+// childOrderType returns the order type submitted for each slice
+func (o *ParentOrder) childOrderType() order.Type {
+	if o.LimitPrice > 0 {
+		return order.Limit
+	}
+	return order.Market
+}
+
+// process submits the latest due slice of each active parent order and
+// completes orders which have no pending slices or open child orders. When
+// several slices are due at once, such as after a pause, the earlier slices
+// are skipped and their amount carried over
+func (m *ExecutionManager) process(now time.Time) {
+	type dueSlice struct {
+		id    uuid.UUID
+		index int
+	}
+	var due []dueSlice
+	var finished []ParentOrder
+	m.m.Lock()
+	for id, o := range m.orders {
+		if o.Status != ExecutionActive {
+			continue
+		}
+		m.refreshFills(o)
+		index := -1
+		pending := false
+		for i := range o.Schedule {
+			if o.Schedule[i].Status != ExecutionSlicePending {
+				continue
+			}
+			if o.Schedule[i].ScheduledAt.After(now) {
+				pending = true
+				break
+			}
+			if index >= 0 {
+				o.Schedule[index].Status = ExecutionSliceSkipped
+				o.Schedule[index].Error = "slice missed, amount carried over"
+			}
+			index = i
+		}
+		if index >= 0 {
+			due = append(due, dueSlice{id: id, index: index})
+			continue
+		}
+		if pending || o.hasOpenChildren() {
+			continue
+		}
+		o.Status = ExecutionCompleted
+		if o.ExecutedAmount == 0 {
+			o.Status = ExecutionFailed
+		}
+		o.UpdatedAt = now
+		finished = append(finished, o.copy())
+	}
+	m.m.Unlock()
+
+	for i := range due {
+		m.executeSlice(due[i].id, due[i].index)
+	}
+	for i := range finished {
+		m.notify(fmt.Sprintf("Execution manager: %s %s %s parent order ID=%v %s, executed %v of %v at average price %v against benchmark %v (%.2f bps).",
+			finished[i].Exchange, finished[i].Pair, finished[i].Strategy, finished[i].ID, strings.ToLower(string(finished[i].Status)),
+			finished[i].ExecutedAmount, finished[i].Amount, finished[i].AverageFillPrice, finished[i].BenchmarkPrice, finished[i].SlippageBps),
+			finished[i].Status == ExecutionFailed)
+	}
+}
+
+// executeSlice submits the child order of a due slice. The slice amount
+// includes any amount carried over from earlier slices and is conformed to
+// the exchange limits at the time of submission
+func (m *ExecutionManager) executeSlice(id uuid.UUID, index int) {
+	m.m.Lock()
+	o, ok := m.orders[id]
+	if !ok || o.Status != ExecutionActive {
+		m.m.Unlock()
+		return
+	}
+	var planned float64
+	for i := 0; i <= index; i++ {
+		planned += o.Schedule[i].Amount
+	}
+	target := planned - o.SubmittedAmount
+	if index == len(o.Schedule)-1 {
+		target = o.Amount - o.SubmittedAmount
+	}
+	cpy := o.copy()
+	m.m.Unlock()
+
+	var marketPrice float64
+	if t, err := ticker.GetTicker(cpy.Exchange, cpy.Pair, cpy.AssetType); err == nil {
+		marketPrice = t.Last
+	}
+	var amount float64
+	exch, err := m.exchangeManager.GetExchangeByName(cpy.Exchange)
+	if err == nil {
+		var limits *order.Limits
+		limits, err = exch.GetOrderExecutionLimits(cpy.AssetType, cpy.Pair)
+		if errors.Is(err, order.ErrExchangeLimitNotLoaded) {
+			err = nil
+		}
+		if err == nil {
+			amount = limits.ConformToAmount(target)
+			if amount > 0 {
+				err = limits.Conforms(cpy.LimitPrice, amount, cpy.childOrderType())
+			}
+		}
+	}
+
+	var resp *OrderSubmitResponse
+	if err == nil && amount > 0 {
+		resp, err = m.orderManager.Submit(context.TODO(), &order.Submit{
+			Exchange:  cpy.Exchange,
+			Pair:      cpy.Pair,
+			AssetType: cpy.AssetType,
+			Side:      cpy.Side,
+			Type:      cpy.childOrderType(),
+			Amount:    amount,
+			Price:     cpy.LimitPrice,
+		})
+	}
+
+	m.m.Lock()
+	o, ok = m.orders[id]
+	if !ok {
+		m.m.Unlock()
+		return
+	}
+	slice := &o.Schedule[index]
+	slice.MarketPrice = marketPrice
+	slice.SubmittedAt = time.Now()
+	o.UpdatedAt = slice.SubmittedAt
+	final := index == len(o.Schedule)-1
+	switch {
+	case err != nil && final:
+		slice.Status = ExecutionSliceFailed
+		slice.Error = err.Error()
+		o.Error = err.Error()
+	case err != nil || amount <= 0:
+		slice.Status = ExecutionSliceSkipped
+		if err != nil {
+			slice.Error = err.Error()
+		}
+	default:
+		slice.Status = ExecutionSliceSubmitted
+		slice.OrderID = resp.OrderID
+		slice.SubmittedAmount = amount
+		slice.OrderStatus = order.New
+		if resp.FullyMatched {
+			slice.OrderStatus = order.Filled
+			slice.ExecutedAmount = amount
+			slice.AveragePrice = resp.Rate
+			if slice.AveragePrice == 0 {
+				slice.AveragePrice = marketPrice
+			}
+		}
+		o.SubmittedAmount += amount
+	}
+	m.refreshFills(o)
+	cancelled := o.Status == ExecutionCancelled && slice.Status == ExecutionSliceSubmitted
+	cpy = o.copy()
+	m.m.Unlock()
+
+	switch {
+	case cancelled:
+		// the parent order was cancelled while this slice was in flight
+		if cErr := m.cancelChild(context.TODO(), &cpy, index); cErr != nil {
+			log.Errorf(log.OrderMgr, "Execution manager: %s parent order ID=%v unable to cancel slice %d order ID=%v: %v",
+				cpy.Exchange, cpy.ID, index, cpy.Schedule[index].OrderID, cErr)
+		}
+	case err != nil && final:
+		m.notify(fmt.Sprintf("Execution manager: %s %s %s parent order ID=%v final slice failed: %v",
+			cpy.Exchange, cpy.Pair, cpy.Strategy, cpy.ID, err), true)
+	case err != nil:
+		log.Warnf(log.OrderMgr, "Execution manager: %s %s %s parent order ID=%v slice %d skipped, amount carried over: %v",
+			cpy.Exchange, cpy.Pair, cpy.Strategy, cpy.ID, index, err)
+	case amount > 0:
+		log.Infof(log.OrderMgr, "Execution manager: %s %s %s parent order ID=%v slice %d/%d submitted order ID=%v amount=%v",
+			cpy.Exchange, cpy.Pair, cpy.Strategy, cpy.ID, index+1, len(cpy.Schedule), cpy.Schedule[index].OrderID, amount)
+	}
+}
+
+// cancelChild cancels an open child order via the order manager
+func (m *ExecutionManager) cancelChild(ctx context.Context, o *ParentOrder, index int) error {
+	err := m.orderManager.Cancel(ctx, &order.Cancel{
+		Exchange:  o.Exchange,
+		ID:        o.Schedule[index].OrderID,
+		Pair:      o.Pair,
+		AssetType: o.AssetType,
+		Side:      o.Side,
+	})
+	m.m.Lock()
+	defer m.m.Unlock()
+	stored, ok := m.orders[o.ID]
+	if !ok {
+		return err
+	}
+	if err != nil {
+		stored.Schedule[index].Error = err.Error()
+		return err
+	}
+	stored.Schedule[index].OrderStatus = order.Cancelled
+	stored.UpdatedAt = time.Now()
+	return nil
+}
+
+// refreshFills updates the executed amounts of submitted slices from the order
+// manager and recalculates the parent fill and benchmark prices. Must be
+// called with the lock held
+func (m *ExecutionManager) refreshFills(o *ParentOrder) {
+	for i := range o.Schedule {
+		slice := &o.Schedule[i]
+		if slice.OrderID == "" || isInactiveOrderStatus(slice.OrderStatus) {
+			continue
+		}
+		od, err := m.orderManager.GetByExchangeAndID(o.Exchange, slice.OrderID)
+		if err != nil {
+			if m.verbose {
+				log.Debugf(log.OrderMgr, "Execution manager: %s parent order ID=%v unable to refresh order ID=%v: %v",
+					o.Exchange, o.ID, slice.OrderID, err)
+			}
+			continue
+		}
+		slice.OrderStatus = od.Status
+		executed := od.ExecutedAmount
+		if executed == 0 && od.Status == order.Filled {
+			executed = od.Amount
+		}
+		price := od.AverageExecutedPrice
+		if price == 0 {
+			price = od.Price
+		}
+		if price == 0 {
+			price = slice.MarketPrice
+		}
+		if executed > 0 {
+			slice.ExecutedAmount = executed
+			slice.AveragePrice = price
+		}
+	}
+	o.calculateFills()
+}
+
+// calculateFills recalculates the executed amount, average fill price,
+// benchmark and slippage of a parent order from its slices. The benchmark
+// weights each sampled market price evenly for TWAP and by slice weight for
+// VWAP
+func (o *ParentOrder) calculateFills() {
+	var executed, notional, benchmark, benchmarkWeight float64
+	for i := range o.Schedule {
+		slice := &o.Schedule[i]
+		executed += slice.ExecutedAmount
+		notional += slice.ExecutedAmount * slice.AveragePrice
+		if slice.Status != ExecutionSliceSubmitted || slice.MarketPrice <= 0 {
+			continue
+		}
+		w := 1.0
+		if o.Strategy == VWAP {
+			w = slice.Weight
+		}
+		benchmark += slice.MarketPrice * w
+		benchmarkWeight += w
+	}
+	o.ExecutedAmount = executed
+	o.AverageFillPrice = 0
+	if executed > 0 {
+		o.AverageFillPrice = notional / executed
+	}
+	o.BenchmarkPrice = 0
+	if benchmarkWeight > 0 {
+		o.BenchmarkPrice = benchmark / benchmarkWeight
+	}
+	o.SlippageBps = 0
+	if o.BenchmarkPrice > 0 && o.AverageFillPrice > 0 {
+		o.SlippageBps = (o.AverageFillPrice - o.BenchmarkPrice) / o.BenchmarkPrice * 10000
+		if o.Side == order.Sell {
+			o.SlippageBps = -o.SlippageBps
+		}
+	}
+}
+
+// hasOpenChildren returns whether any submitted child order is still open
+func (o *ParentOrder) hasOpenChildren() bool {
+	for i := range o.Schedule {
+		if o.Schedule[i].OrderID != "" && !isInactiveOrderStatus(o.Schedule[i].OrderStatus) {
+			return true
+		}
+	}
+	return false
+}
+
+// isFinished returns whether the parent order can no longer change state
+func (o *ParentOrder) isFinished() bool {
+	return o.Status == ExecutionCompleted ||
+		o.Status == ExecutionCancelled ||
+		o.Status == ExecutionFailed
+}
+
+// copy returns a copy of the parent order and its schedule
+func (o *ParentOrder) copy() ParentOrder {
+	cpy := *o
+	if o.Schedule != nil {
+		cpy.Schedule = make([]ExecutionSlice, len(o.Schedule))
+		copy(cpy.Schedule, o.Schedule)
+	}
+	return cpy
+}
+
+// notify logs and pushes an execution event to the communications manager
+func (m *ExecutionManager) notify(msg string, isError bool) {
+	if isError {
+		log.Errorln(log.OrderMgr, msg)
+	} else {
+		log.Infoln(log.OrderMgr, msg)
+	}
+	m.commsManager.PushEvent(base.Event{
+		Type:    "order",
+		Message: msg,
+	})
+}
@@ -0,0 +1,513 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+// emfExchange aka execution manager fake exchange overrides exchange
+// functions to serve limits and candles without API calls
+type emfExchange struct {
+	exchange.IBotExchange
+	limits  *order.ExecutionLimits
+	candles []kline.Candle
+}
+
+func (f emfExchange) GetOrderExecutionLimits(a asset.Item, cp currency.Pair) (*order.Limits, error) {
+	return f.limits.GetOrderExecutionLimits(a, cp)
+}
+
+func (f emfExchange) GetHistoricCandles(_ context.Context, p currency.Pair, a asset.Item, _, _ time.Time, interval kline.Interval) (kline.Item, error) {
+	return kline.Item{
+		Exchange: f.GetName(),
+		Pair:     p,
+		Asset:    a,
+		Interval: interval,
+		Candles:  f.candles,
+	}, nil
+}
+
+// fakeOrderExecutor records submitted orders and serves their details
+// without an exchange
+type fakeOrderExecutor struct {
+	m         sync.Mutex
+	orders    map[string]*order.Detail
+	cancelled []string
+	fail      bool
+}
+
+func (f *fakeOrderExecutor) Submit(_ context.Context, s *order.Submit) (*OrderSubmitResponse, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	if f.fail {
+		return nil, errFakeSubmit
+	}
+	id := "child" + strconv.Itoa(len(f.orders))
+	f.orders[id] = &order.Detail{
+		Exchange:  s.Exchange,
+		ID:        id,
+		Pair:      s.Pair,
+		AssetType: s.AssetType,
+		Side:      s.Side,
+		Type:      s.Type,
+		Amount:    s.Amount,
+		Price:     s.Price,
+		Status:    order.New,
+	}
+	return &OrderSubmitResponse{
+		SubmitResponse: order.SubmitResponse{
+			IsOrderPlaced: true,
+			OrderID:       id,
+		},
+	}, nil
+}
+
+func (f *fakeOrderExecutor) Cancel(_ context.Context, c *order.Cancel) error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	od, ok := f.orders[c.ID]
+	if !ok {
+		return ErrOrderNotFound
+	}
+	od.Status = order.Cancelled
+	f.cancelled = append(f.cancelled, c.ID)
+	return nil
+}
+
+func (f *fakeOrderExecutor) GetByExchangeAndID(_, id string) (*order.Detail, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	od, ok := f.orders[id]
+	if !ok {
+		return nil, ErrOrderNotFound
+	}
+	cpy := *od
+	return &cpy, nil
+}
+
+// fill marks a child order as filled at a price
+func (f *fakeOrderExecutor) fill(id string, price float64) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	od := f.orders[id]
+	od.Status = order.Filled
+	od.ExecutedAmount = od.Amount
+	od.AverageExecutedPrice = price
+}
+
+func executionManagerSetup(t *testing.T, levels []order.MinMaxLevel, candles []kline.Candle) (*ExecutionManager, *fakeOrderExecutor) {
+	t.Helper()
+	em := SetupExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	limits := &order.ExecutionLimits{}
+	if len(levels) > 0 {
+		err = limits.LoadLimits(levels)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	em.Add(emfExchange{IBotExchange: exch, limits: limits, candles: candles})
+	f := &fakeOrderExecutor{orders: make(map[string]*order.Detail)}
+	m, err := SetupExecutionManager(em, f, &CommunicationManager{}, nil, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	t.Cleanup(func() {
+		if m.IsRunning() {
+			if err := m.Stop(); err != nil {
+				t.Error(err)
+			}
+		}
+	})
+	return m, f
+}
+
+func TestSetupExecutionManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupExecutionManager(nil, nil, nil, nil, false)
+	if !errors.Is(err, errNilExchangeManager) {
+		t.Errorf("error '%v', expected '%v'", err, errNilExchangeManager)
+	}
+	_, err = SetupExecutionManager(SetupExchangeManager(), nil, nil, nil, false)
+	if !errors.Is(err, errNilOrderManager) {
+		t.Errorf("error '%v', expected '%v'", err, errNilOrderManager)
+	}
+	_, err = SetupExecutionManager(SetupExchangeManager(), &fakeOrderExecutor{}, nil, nil, false)
+	if !errors.Is(err, errNilCommunicationsManager) {
+		t.Errorf("error '%v', expected '%v'", err, errNilCommunicationsManager)
+	}
+	m, err := SetupExecutionManager(SetupExchangeManager(), &fakeOrderExecutor{}, &CommunicationManager{}, nil, false)
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	if m == nil {
+		t.Error("expected manager")
+	}
+}
+
+func TestExecutionManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *ExecutionManager
+	if m.IsRunning() {
+		t.Error("expected nil manager to not be running")
+	}
+	err := m.Start()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+	err = m.Stop()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+
+	m, err = SetupExecutionManager(SetupExchangeManager(), &fakeOrderExecutor{}, &CommunicationManager{}, nil, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.Stop()
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemNotStarted)
+	}
+	_, err = m.Submit(context.Background(), &ParentOrder{})
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemNotStarted)
+	}
+	err = m.Start()
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.Start()
+	if !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+	err = m.Stop()
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+}
+
+func TestExecutionManagerSubmit(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USD)
+	m, _ := executionManagerSetup(t, []order.MinMaxLevel{{
+		Pair:       p,
+		Asset:      asset.Spot,
+		MinAmount:  0.2,
+		StepAmount: 0.1,
+	}}, nil)
+
+	end := time.Now().Add(time.Hour * 2)
+	tt := []struct {
+		name string
+		o    *ParentOrder
+		err  error
+	}{
+		{"nil", nil, errNilExecutionOrder},
+		{"no exchange", &ParentOrder{}, errExecutionExchangeEmpty},
+		{"unknown exchange", &ParentOrder{Exchange: "bruh"}, ErrExchangeNotFound},
+		{"no pair", &ParentOrder{Exchange: testExchange}, order.ErrPairIsEmpty},
+		{"bad asset", &ParentOrder{Exchange: testExchange, Pair: p, AssetType: "bruh"}, asset.ErrNotSupported},
+		{"bad side", &ParentOrder{Exchange: testExchange, Pair: p, AssetType: asset.Spot}, errInvalidExecutionSide},
+		{"bad strategy", &ParentOrder{Exchange: testExchange, Pair: p, AssetType: asset.Spot, Side: order.Buy, Strategy: "POV"}, errUnsupportedExecutionType},
+		{"no amount", &ParentOrder{Exchange: testExchange, Pair: p, AssetType: asset.Spot, Side: order.Buy, Strategy: TWAP}, errInvalidExecutionAmount},
+		{"negative limit", &ParentOrder{Exchange: testExchange, Pair: p, AssetType: asset.Spot, Side: order.Buy, Strategy: TWAP, Amount: 1, LimitPrice: -1}, errInvalidExecutionLimitPrice},
+		{"no slices", &ParentOrder{Exchange: testExchange, Pair: p, AssetType: asset.Spot, Side: order.Buy, Strategy: TWAP, Amount: 1}, errInvalidExecutionSlices},
+		{"no end", &ParentOrder{Exchange: testExchange, Pair: p, AssetType: asset.Spot, Side: order.Buy, Strategy: TWAP, Amount: 1, Slices: 4}, errInvalidExecutionWindow},
+		{"below limits", &ParentOrder{Exchange: testExchange, Pair: p, AssetType: asset.Spot, Side: order.Buy, Strategy: TWAP, Amount: 0.1, Slices: 4, EndTime: end}, errExecutionAmountUnschedulable},
+		{"valid", &ParentOrder{Exchange: testExchange, Pair: p, AssetType: asset.Spot, Side: order.Bid, Strategy: "twap", Amount: 1, Slices: 4, EndTime: end}, nil},
+	}
+	for x := range tt {
+		tc := tt[x]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := m.Submit(context.Background(), tc.o)
+			if !errors.Is(err, tc.err) {
+				t.Errorf("error '%v', expected '%v'", err, tc.err)
+			}
+		})
+	}
+
+	o, err := m.Submit(context.Background(), &ParentOrder{
+		Exchange:  testExchange,
+		Pair:      p,
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Strategy:  TWAP,
+		Amount:    1,
+		Slices:    4,
+		StartTime: end.Add(-time.Hour),
+		EndTime:   end,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if o.Status != ExecutionActive || o.ID.IsNil() {
+		t.Errorf("received status %v id %v, expected active order with id", o.Status, o.ID)
+	}
+	// cumulative targets of 0.25 are conformed to the 0.1 step
+	expected := []float64{0.2, 0.3, 0.2, 0.3}
+	for i := range o.Schedule {
+		if o.Schedule[i].Amount != expected[i] {
+			t.Errorf("slice %d received amount %v, expected %v", i, o.Schedule[i].Amount, expected[i])
+		}
+		if want := end.Add(-time.Hour).Add(time.Minute * 15 * time.Duration(i)); !o.Schedule[i].ScheduledAt.Equal(want) {
+			t.Errorf("slice %d scheduled at %v, expected %v", i, o.Schedule[i].ScheduledAt, want)
+		}
+	}
+}
+
+func TestVolumeProfileWeights(t *testing.T) {
+	t.Parallel()
+	day := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	candles := []kline.Candle{
+		{Time: day.Add(time.Hour * 10), Volume: 10},
+		{Time: day.Add(time.Hour * 11), Volume: 30},
+		{Time: day.Add(time.Hour * 34), Volume: 10},
+		{Time: day.Add(time.Hour * 35), Volume: 30},
+		{Time: day.Add(time.Hour * 23), Volume: 20},
+	}
+	start := day.Add(time.Hour * 24 * 7).Add(time.Hour * 10)
+	weights := volumeProfileWeights(candles, time.Hour, start, time.Hour, 2)
+	if weights[0] != 0.25 || weights[1] != 0.75 {
+		t.Errorf("received %v, expected [0.25 0.75]", weights)
+	}
+
+	// half hour slices split each hourly candle evenly
+	weights = volumeProfileWeights(candles, time.Hour, start, time.Minute*30, 4)
+	expected := []float64{0.125, 0.125, 0.375, 0.375}
+	for i := range expected {
+		if weights[i] != expected[i] {
+			t.Errorf("received %v, expected %v", weights, expected)
+			break
+		}
+	}
+
+	// slices crossing midnight wrap around to the start of the day
+	weights = volumeProfileWeights(candles, time.Hour, day.Add(time.Hour*23), time.Hour, 2)
+	if weights[0] != 1 || weights[1] != 0 {
+		t.Errorf("received %v, expected [1 0]", weights)
+	}
+
+	weights = volumeProfileWeights(nil, time.Hour, start, time.Hour, 4)
+	for i := range weights {
+		if weights[i] != 0.25 {
+			t.Errorf("received %v, expected equal weights without volume", weights)
+			break
+		}
+	}
+}
+
+func TestExecutionManagerSubmitVWAP(t *testing.T) {
+	t.Parallel()
+	start := time.Now().UTC().Truncate(time.Hour).Add(time.Hour * 24)
+	m, _ := executionManagerSetup(t, nil, []kline.Candle{
+		{Time: start.Add(-time.Hour * 24), Volume: 1},
+		{Time: start.Add(-time.Hour * 23), Volume: 3},
+	})
+	o, err := m.Submit(context.Background(), &ParentOrder{
+		Exchange:  testExchange,
+		Pair:      currency.NewPair(currency.BTC, currency.USD),
+		AssetType: asset.Spot,
+		Side:      order.Sell,
+		Strategy:  VWAP,
+		Amount:    2,
+		Slices:    2,
+		StartTime: start,
+		EndTime:   start.Add(time.Hour * 2),
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if o.VolumeInterval != defaultVWAPInterval || o.VolumeLookback != defaultVWAPLookback {
+		t.Errorf("received interval %v lookback %v, expected defaults", o.VolumeInterval, o.VolumeLookback)
+	}
+	if o.Schedule[0].Amount != 0.5 || o.Schedule[1].Amount != 1.5 {
+		t.Errorf("received amounts %v and %v, expected 0.5 and 1.5", o.Schedule[0].Amount, o.Schedule[1].Amount)
+	}
+}
+
+func TestExecutionManagerProcess(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.XRP, currency.USDT)
+	m, f := executionManagerSetup(t, nil, nil)
+	err := ticker.ProcessTicker(&ticker.Price{
+		ExchangeName: testExchange,
+		Pair:         p,
+		AssetType:    asset.Spot,
+		Last:         100,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now().Add(time.Hour)
+	o, err := m.Submit(context.Background(), &ParentOrder{
+		Exchange:  testExchange,
+		Pair:      p,
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Strategy:  TWAP,
+		Amount:    3,
+		Slices:    3,
+		StartTime: start,
+		EndTime:   start.Add(time.Hour * 3),
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if o.ArrivalPrice != 100 {
+		t.Errorf("received arrival price %v, expected 100", o.ArrivalPrice)
+	}
+
+	m.process(start)
+	o, err = m.GetOrder(o.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if o.Schedule[0].Status != ExecutionSliceSubmitted || o.Schedule[0].SubmittedAmount != 1 || o.SubmittedAmount != 1 {
+		t.Fatalf("received slice %+v, expected first slice submitted for 1", o.Schedule[0])
+	}
+	f.fill(o.Schedule[0].OrderID, 101)
+
+	err = m.Pause(o.ID)
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.Pause(o.ID)
+	if !errors.Is(err, errExecutionOrderNotActive) {
+		t.Errorf("error '%v', expected '%v'", err, errExecutionOrderNotActive)
+	}
+	m.process(start.Add(time.Hour))
+	o, err = m.GetOrder(o.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if o.Schedule[1].Status != ExecutionSlicePending {
+		t.Errorf("received status %v, expected paused order to not submit", o.Schedule[1].Status)
+	}
+	if o.ExecutedAmount != 1 || o.AverageFillPrice != 101 || o.BenchmarkPrice != 100 || o.SlippageBps != 100 {
+		t.Errorf("received executed %v average %v benchmark %v slippage %v, expected 1 101 100 100",
+			o.ExecutedAmount, o.AverageFillPrice, o.BenchmarkPrice, o.SlippageBps)
+	}
+
+	err = m.Resume(o.ID)
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.Resume(o.ID)
+	if !errors.Is(err, errExecutionOrderNotPaused) {
+		t.Errorf("error '%v', expected '%v'", err, errExecutionOrderNotPaused)
+	}
+	m.process(start.Add(time.Hour * 2))
+	o, err = m.GetOrder(o.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if o.Schedule[1].Status != ExecutionSliceSkipped {
+		t.Errorf("received status %v, expected missed slice to be skipped", o.Schedule[1].Status)
+	}
+	if o.Schedule[2].Status != ExecutionSliceSubmitted || o.Schedule[2].SubmittedAmount != 2 {
+		t.Fatalf("received slice %+v, expected final slice to carry over the missed amount", o.Schedule[2])
+	}
+
+	m.process(start.Add(time.Hour * 3))
+	o, err = m.GetOrder(o.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if o.Status != ExecutionActive {
+		t.Errorf("received status %v, expected order to remain active with open children", o.Status)
+	}
+	f.fill(o.Schedule[2].OrderID, 104)
+	m.process(start.Add(time.Hour * 3))
+	o, err = m.GetOrder(o.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if o.Status != ExecutionCompleted || o.ExecutedAmount != 3 || o.AverageFillPrice != 103 {
+		t.Errorf("received status %v executed %v average %v, expected completed 3 at 103",
+			o.Status, o.ExecutedAmount, o.AverageFillPrice)
+	}
+	err = m.Cancel(context.Background(), o.ID)
+	if !errors.Is(err, errExecutionOrderFinished) {
+		t.Errorf("error '%v', expected '%v'", err, errExecutionOrderFinished)
+	}
+}
+
+func TestExecutionManagerCancel(t *testing.T) {
+	t.Parallel()
+	m, f := executionManagerSetup(t, nil, nil)
+	start := time.Now().Add(time.Hour)
+	o, err := m.Submit(context.Background(), &ParentOrder{
+		Exchange:   testExchange,
+		Pair:       currency.NewPair(currency.LTC, currency.USD),
+		AssetType:  asset.Spot,
+		Side:       order.Sell,
+		Strategy:   TWAP,
+		Amount:     2,
+		LimitPrice: 50,
+		Slices:     2,
+		StartTime:  start,
+		EndTime:    start.Add(time.Hour),
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	m.process(start)
+
+	err = m.Cancel(context.Background(), o.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	o, err = m.GetOrder(o.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if o.Status != ExecutionCancelled ||
+		o.Schedule[0].OrderStatus != order.Cancelled ||
+		o.Schedule[1].Status != ExecutionSliceCancelled {
+		t.Errorf("received status %v first child %v second slice %v, expected cancelled",
+			o.Status, o.Schedule[0].OrderStatus, o.Schedule[1].Status)
+	}
+	if len(f.cancelled) != 1 || f.cancelled[0] != o.Schedule[0].OrderID {
+		t.Errorf("received cancelled %v, expected child order %v", f.cancelled, o.Schedule[0].OrderID)
+	}
+
+	orders, err := m.GetOrders(testExchange, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(orders) != 0 {
+		t.Errorf("received %v orders, expected cancelled order to be excluded", len(orders))
+	}
+	orders, err = m.GetOrders(testExchange, true)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(orders) != 1 {
+		t.Errorf("received %v orders, expected 1", len(orders))
+	}
+
+	err = m.Cancel(context.Background(), [16]byte{})
+	if !errors.Is(err, errExecutionOrderNotFound) {
+		t.Errorf("error '%v', expected '%v'", err, errExecutionOrderNotFound)
+	}
+}
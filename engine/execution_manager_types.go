@@ -0,0 +1,164 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// ExecutionManagerName is an exported subsystem name
+const ExecutionManagerName = "execution_manager"
+
+// ExecutionStrategy defines how a parent order is scheduled into slices
+type ExecutionStrategy string
+
+// Execution strategies
+const (
+	// TWAP splits the parent order into equally sized slices evenly spaced
+	// over the execution window
+	TWAP ExecutionStrategy = "TWAP"
+	// VWAP weights each slice by the historic volume traded at the same time
+	// of day as the slice
+	VWAP ExecutionStrategy = "VWAP"
+)
+
+// ExecutionStatus defines the state of a parent order
+type ExecutionStatus string
+
+// Parent order statuses
+const (
+	// ExecutionActive parent orders are submitting slices as they fall due
+	ExecutionActive ExecutionStatus = "ACTIVE"
+	// ExecutionPaused parent orders do not submit slices until resumed
+	ExecutionPaused ExecutionStatus = "PAUSED"
+	// ExecutionCompleted parent orders have submitted all slices and all
+	// child orders are no longer open
+	ExecutionCompleted ExecutionStatus = "COMPLETED"
+	// ExecutionCancelled parent orders were cancelled before completion
+	ExecutionCancelled ExecutionStatus = "CANCELLED"
+	// ExecutionFailed parent orders completed without any amount executed
+	ExecutionFailed ExecutionStatus = "FAILED"
+)
+
+// ExecutionSliceStatus defines the state of a single scheduled slice
+type ExecutionSliceStatus string
+
+// Slice statuses
+const (
+	// ExecutionSlicePending slices are waiting for their scheduled time
+	ExecutionSlicePending ExecutionSliceStatus = "PENDING"
+	// ExecutionSliceSubmitted slices have had their child order placed
+	ExecutionSliceSubmitted ExecutionSliceStatus = "SUBMITTED"
+	// ExecutionSliceSkipped slices were not submitted, their amount is
+	// carried over to the next slice
+	ExecutionSliceSkipped ExecutionSliceStatus = "SKIPPED"
+	// ExecutionSliceFailed slices failed to submit their child order
+	ExecutionSliceFailed ExecutionSliceStatus = "FAILED"
+	// ExecutionSliceCancelled slices were pending when the parent order was
+	// cancelled
+	ExecutionSliceCancelled ExecutionSliceStatus = "CANCELLED"
+)
+
+var (
+	executionManagerDelay           = time.Second
+	defaultVWAPInterval             = kline.OneHour
+	defaultVWAPLookback             = time.Hour * 24 * 7
+	errExecutionOrderNotFound       = errors.New("execution order not found")
+	errNilExecutionOrder            = errors.New("nil execution order received")
+	errExecutionExchangeEmpty       = errors.New("execution order exchange name is empty")
+	errUnsupportedExecutionType     = errors.New("unsupported execution strategy")
+	errInvalidExecutionSide         = errors.New("execution order side must be buy or sell")
+	errInvalidExecutionAmount       = errors.New("amount must be greater than zero")
+	errInvalidExecutionSlices       = errors.New("slices must be greater than zero")
+	errInvalidExecutionWindow       = errors.New("end time must be after the start time and in the future")
+	errInvalidExecutionLimitPrice   = errors.New("limit price cannot be negative")
+	errExecutionAmountUnschedulable = errors.New("amount cannot be scheduled within the exchange order execution limits")
+	errExecutionOrderNotActive      = errors.New("execution order is not active")
+	errExecutionOrderNotPaused      = errors.New("execution order is not paused")
+	errExecutionOrderFinished       = errors.New("execution order has already finished")
+	errNoVolumeHistory              = errors.New("no historic volume available")
+)
+
+// ExecutionManager splits parent orders into child orders over time using
+// TWAP and VWAP schedules. Child orders are submitted via the order manager
+// and their fills tracked against a market benchmark
+type ExecutionManager struct {
+	started         int32
+	shutdown        chan struct{}
+	wg              sync.WaitGroup
+	exchangeManager iExchangeManager
+	orderManager    iOrderExecutor
+	commsManager    iCommsManager
+	dbManager       iDatabaseConnectionManager
+	verbose         bool
+
+	m      sync.Mutex
+	orders map[uuid.UUID]*ParentOrder
+}
+
+// ParentOrder is an order which the execution manager splits into child
+// orders according to its strategy
+type ParentOrder struct {
+	ID        uuid.UUID
+	Exchange  string
+	Pair      currency.Pair
+	AssetType asset.Item
+	Side      order.Side
+	Strategy  ExecutionStrategy
+	Amount    float64
+	// LimitPrice submits child orders as limit orders at this price. When
+	// unset child orders are market orders
+	LimitPrice float64
+	// StartTime defaults to the time the order is added
+	StartTime time.Time
+	EndTime   time.Time
+	Slices    int
+	// VolumeInterval is the candle interval used to build the VWAP volume
+	// profile
+	VolumeInterval kline.Interval
+	// VolumeLookback is how far back historic volume is loaded for the VWAP
+	// volume profile
+	VolumeLookback time.Duration
+	Status         ExecutionStatus
+	Schedule       []ExecutionSlice
+	// ArrivalPrice is the last traded price when the order was added
+	ArrivalPrice float64
+	// BenchmarkPrice is the market price sampled at each submitted slice,
+	// averaged evenly for TWAP and by slice weight for VWAP
+	BenchmarkPrice   float64
+	SubmittedAmount  float64
+	ExecutedAmount   float64
+	AverageFillPrice float64
+	// SlippageBps is the difference between the average fill price and the
+	// benchmark in basis points. Positive values underperform the benchmark
+	SlippageBps float64
+	Error       string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// ExecutionSlice is a single scheduled child order of a parent order
+type ExecutionSlice struct {
+	ScheduledAt time.Time
+	// Weight is the proportion of the parent order scheduled in this slice
+	Weight float64
+	// Amount is the planned amount conformed to the exchange limits. Amounts
+	// which cannot be submitted are carried over to the next slice
+	Amount          float64
+	SubmittedAmount float64
+	ExecutedAmount  float64
+	AveragePrice    float64
+	// MarketPrice is the last traded price when the slice was submitted
+	MarketPrice float64
+	OrderID     string
+	OrderStatus order.Status
+	Status      ExecutionSliceStatus
+	Error       string
+	SubmittedAt time.Time
+}
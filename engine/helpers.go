@@ -47,21 +47,28 @@ var (
 // GetSubsystemsStatus returns the status of various subsystems
 func (bot *Engine) GetSubsystemsStatus() map[string]bool {
 	return map[string]bool{
-		CommunicationsManagerName:     bot.CommunicationsManager.IsRunning(),
-		ConnectionManagerName:         bot.connectionManager.IsRunning(),
-		OrderManagerName:              bot.OrderManager.IsRunning(),
-		PortfolioManagerName:          bot.portfolioManager.IsRunning(),
-		NTPManagerName:                bot.ntpManager.IsRunning(),
-		DatabaseConnectionManagerName: bot.DatabaseManager.IsRunning(),
-		SyncManagerName:               bot.Settings.EnableExchangeSyncManager,
-		grpcName:                      bot.Settings.EnableGRPC,
-		grpcProxyName:                 bot.Settings.EnableGRPCProxy,
-		vm.Name:                       bot.gctScriptManager.IsRunning(),
-		DeprecatedName:                bot.Settings.EnableDeprecatedRPC,
-		WebsocketName:                 bot.Settings.EnableWebsocketRPC,
-		dispatch.Name:                 dispatch.IsRunning(),
-		dataHistoryManagerName:        bot.dataHistoryManager.IsRunning(),
-		CurrencyStateManagementName:   bot.currencyStateManager.IsRunning(),
+		CommunicationsManagerName:        bot.CommunicationsManager.IsRunning(),
+		ConnectionManagerName:            bot.connectionManager.IsRunning(),
+		OrderManagerName:                 bot.OrderManager.IsRunning(),
+		PortfolioManagerName:             bot.portfolioManager.IsRunning(),
+		NTPManagerName:                   bot.ntpManager.IsRunning(),
+		DatabaseConnectionManagerName:    bot.DatabaseManager.IsRunning(),
+		SyncManagerName:                  bot.Settings.EnableExchangeSyncManager,
+		grpcName:                         bot.Settings.EnableGRPC,
+		grpcProxyName:                    bot.Settings.EnableGRPCProxy,
+		vm.Name:                          bot.gctScriptManager.IsRunning(),
+		DeprecatedName:                   bot.Settings.EnableDeprecatedRPC,
+		WebsocketName:                    bot.Settings.EnableWebsocketRPC,
+		dispatch.Name:                    dispatch.IsRunning(),
+		dataHistoryManagerName:           bot.dataHistoryManager.IsRunning(),
+		BalanceHistoryManagerName:        bot.balanceHistoryManager.IsRunning(),
+		CurrencyStateManagementName:      bot.currencyStateManager.IsRunning(),
+		ConditionalOrderManagerName:      bot.conditionalOrderManager.IsRunning(),
+		ExecutionManagerName:             bot.executionManager.IsRunning(),
+		RiskManagerName:                  bot.riskManager.IsRunning(),
+		MarketDataRecorderName:           bot.marketDataRecorder.IsRunning(),
+		MarketDataReplayerName:           bot.marketDataReplayer.IsRunning(),
+		ConsolidatedOrderbookManagerName: bot.consolidatedOrderbookManager.IsRunning(),
 	}
 }
 
@@ -137,6 +144,7 @@ func (bot *Engine) SetSubsystem(subSystemName string, enable bool) error {
 				bot.OrderManager, err = SetupOrderManager(
 					bot.ExchangeManager,
 					bot.CommunicationsManager,
+					bot.DatabaseManager,
 					&bot.ServicesWG,
 					bot.Settings.Verbose)
 				if err != nil {
@@ -256,6 +264,20 @@ func (bot *Engine) SetSubsystem(subSystemName string, enable bool) error {
 			return bot.dataHistoryManager.Start()
 		}
 		return bot.dataHistoryManager.Stop()
+	case BalanceHistoryManagerName:
+		if enable {
+			if bot.balanceHistoryManager == nil {
+				bot.balanceHistoryManager, err = SetupBalanceHistoryManager(bot.ExchangeManager, bot.DatabaseManager, &bot.Config.BalanceHistoryManager)
+				if err != nil {
+					return err
+				}
+				if bot.websocketRoutineManager != nil {
+					bot.websocketRoutineManager.setBalanceRecorder(bot.balanceHistoryManager)
+				}
+			}
+			return bot.balanceHistoryManager.Start()
+		}
+		return bot.balanceHistoryManager.Stop()
 	case vm.Name:
 		if enable {
 			if bot.gctScriptManager == nil {
@@ -280,6 +302,103 @@ func (bot *Engine) SetSubsystem(subSystemName string, enable bool) error {
 			return bot.currencyStateManager.Start()
 		}
 		return bot.currencyStateManager.Stop()
+	case ConditionalOrderManagerName:
+		if enable {
+			if bot.conditionalOrderManager == nil {
+				if bot.OrderManager == nil {
+					return errNilOrderManager
+				}
+				bot.conditionalOrderManager, err = SetupConditionalOrderManager(
+					bot.ExchangeManager,
+					bot.OrderManager,
+					bot.CommunicationsManager,
+					bot.Settings.Verbose)
+				if err != nil {
+					return err
+				}
+				bot.OrderManager.setConditionalOrderHolder(bot.conditionalOrderManager)
+			}
+			return bot.conditionalOrderManager.Start()
+		}
+		return bot.conditionalOrderManager.Stop()
+	case ExecutionManagerName:
+		if enable {
+			if bot.executionManager == nil {
+				if bot.OrderManager == nil {
+					return errNilOrderManager
+				}
+				bot.executionManager, err = SetupExecutionManager(
+					bot.ExchangeManager,
+					bot.OrderManager,
+					bot.CommunicationsManager,
+					bot.DatabaseManager,
+					bot.Settings.Verbose)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.executionManager.Start()
+		}
+		return bot.executionManager.Stop()
+	case RiskManagerName:
+		if enable {
+			if bot.riskManager == nil {
+				if bot.OrderManager == nil {
+					return errNilOrderManager
+				}
+				bot.riskManager, err = SetupRiskManager(
+					bot.ExchangeManager,
+					bot.OrderManager,
+					bot.CommunicationsManager,
+					&bot.Config.RiskManager)
+				if err != nil {
+					return err
+				}
+				bot.OrderManager.setRiskChecker(bot.riskManager)
+			}
+			return bot.riskManager.Start()
+		}
+		return bot.riskManager.Stop()
+	case MarketDataRecorderName:
+		if enable {
+			if bot.marketDataRecorder == nil {
+				bot.marketDataRecorder, err = SetupMarketDataRecorder(&bot.Config.MarketDataRecorder)
+				if err != nil {
+					return err
+				}
+				if bot.websocketRoutineManager != nil {
+					bot.websocketRoutineManager.setDataRecorder(bot.marketDataRecorder)
+				}
+			}
+			return bot.marketDataRecorder.Start()
+		}
+		return bot.marketDataRecorder.Stop()
+	case MarketDataReplayerName:
+		if enable {
+			if bot.marketDataReplayer == nil {
+				var dataHandler iWebsocketDataHandler
+				if bot.websocketRoutineManager != nil {
+					dataHandler = bot.websocketRoutineManager
+				}
+				bot.marketDataReplayer, err = SetupMarketDataReplayer(&bot.Config.MarketDataReplayer, dataHandler)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.marketDataReplayer.Start()
+		}
+		return bot.marketDataReplayer.Stop()
+	case ConsolidatedOrderbookManagerName:
+		if enable {
+			if bot.consolidatedOrderbookManager == nil {
+				bot.consolidatedOrderbookManager, err = SetupConsolidatedOrderbookManager(bot.ExchangeManager, &bot.Config.ConsolidatedOrderbookManager)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.consolidatedOrderbookManager.Start()
+		}
+		return bot.consolidatedOrderbookManager.Stop()
 	}
 	return fmt.Errorf("%s: %w", subSystemName, errSubsystemNotFound)
 }
@@ -864,7 +983,9 @@ func verifyCert(pemData []byte) error {
 	return nil
 }
 
-func checkCerts(certDir string) error {
+// CheckCerts ensures a valid TLS certificate and key exist in the directory
+// for gRPC servers, generating a new self signed pair if missing or expired
+func CheckCerts(certDir string) error {
 	certFile := filepath.Join(certDir, "cert.pem")
 	keyFile := filepath.Join(certDir, "key.pem")
 
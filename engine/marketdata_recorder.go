@@ -0,0 +1,326 @@
+package engine
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupMarketDataRecorder will boot up the MarketDataRecorder
+func SetupMarketDataRecorder(cfg *config.MarketDataRecorder) (*MarketDataRecorder, error) {
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if cfg.Directory == "" {
+		return nil, errNoMarketDataDirectory
+	}
+	if cfg.FlushInterval <= 0 {
+		return nil, fmt.Errorf("%w %v", errInvalidFlushInterval, cfg.FlushInterval)
+	}
+	exchanges := make(map[string]bool, len(cfg.Exchanges))
+	for i := range cfg.Exchanges {
+		exchanges[strings.ToLower(cfg.Exchanges[i])] = true
+	}
+	return &MarketDataRecorder{
+		cfg:       *cfg,
+		exchanges: exchanges,
+		shutdown:  make(chan struct{}),
+		files:     make(map[string]*marketDataFile),
+	}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *MarketDataRecorder) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return atomic.LoadInt32(&m.started) == 1
+}
+
+// Start runs the subsystem
+func (m *MarketDataRecorder) Start() error {
+	if m == nil {
+		return fmt.Errorf("market data recorder %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("market data recorder %w", ErrSubSystemAlreadyStarted)
+	}
+	m.shutdown = make(chan struct{})
+	m.wg.Add(1)
+	go m.flushLoop()
+	log.Debugf(log.WebsocketMgr, "Market data recorder started, recording to %s.", m.cfg.Directory)
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem, writing all recorded events to
+// disk
+func (m *MarketDataRecorder) Stop() error {
+	if m == nil {
+		return fmt.Errorf("market data recorder %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return fmt.Errorf("market data recorder %w", ErrSubSystemNotStarted)
+	}
+	close(m.shutdown)
+	m.wg.Wait()
+
+	m.m.Lock()
+	defer m.m.Unlock()
+	var errs common.Errors
+	for exch, f := range m.files {
+		if err := f.close(); err != nil {
+			errs = append(errs, err)
+		}
+		delete(m.files, exch)
+	}
+	log.Debugln(log.WebsocketMgr, "Market data recorder shut down.")
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// Record writes a websocket event to the exchange's file for the day. Events
+// which are not market, order or account data are ignored
+func (m *MarketDataRecorder) Record(exchName string, data interface{}) error {
+	if m == nil {
+		return fmt.Errorf("market data recorder %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("market data recorder %w", ErrSubSystemNotStarted)
+	}
+	exch := strings.ToLower(exchName)
+	if len(m.exchanges) > 0 && !m.exchanges[exch] {
+		return nil
+	}
+	// events are timed while locked so each file is in time order
+	m.m.Lock()
+	defer m.m.Unlock()
+	now := time.Now()
+	records, err := newMarketDataRecords(exchName, data, now)
+	if err != nil {
+		if errors.Is(err, errUnsupportedMarketData) {
+			return nil
+		}
+		return err
+	}
+	if len(records) == 0 {
+		return nil
+	}
+	f, err := m.getFile(exch, now)
+	if err != nil {
+		return err
+	}
+	for i := range records {
+		err = f.enc.Encode(&records[i])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getFile returns the exchange's file for the day of t, closing the file of
+// the previous day. Each day's file is opened as a new segment, so a
+// restarted recorder never appends to a file which may not have been closed
+// cleanly
+func (m *MarketDataRecorder) getFile(exch string, t time.Time) (*marketDataFile, error) {
+	day := t.UTC().Format(marketDataDayFormat)
+	f, ok := m.files[exch]
+	if ok && f.day == day {
+		return f, nil
+	}
+	if ok {
+		err := f.close()
+		if err != nil {
+			log.Errorf(log.WebsocketMgr, "Market data recorder unable to close %s file for %s: %v", exch, f.day, err)
+		}
+		delete(m.files, exch)
+	}
+	dir := filepath.Join(m.cfg.Directory, exch)
+	err := common.CreateDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	segment, err := nextMarketDataSegment(dir, day)
+	if err != nil {
+		return nil, err
+	}
+	osFile, err := os.OpenFile(filepath.Join(dir, marketDataFileName(day, segment)), os.O_EXCL|os.O_CREATE|os.O_WRONLY, 0o640)
+	if err != nil {
+		return nil, err
+	}
+	gz := gzip.NewWriter(osFile)
+	f = &marketDataFile{
+		day: day,
+		f:   osFile,
+		gz:  gz,
+		enc: json.NewEncoder(gz),
+	}
+	m.files[exch] = f
+	return f, nil
+}
+
+// nextMarketDataSegment returns the segment number following the last
+// segment recorded for the day
+func nextMarketDataSegment(dir, day string) (int, error) {
+	files, err := filepath.Glob(filepath.Join(dir, day+".*"+marketDataFileExtension))
+	if err != nil {
+		return 0, err
+	}
+	next := 1
+	for i := range files {
+		fileDay, segment, ok := parseMarketDataFileName(filepath.Base(files[i]))
+		if ok && fileDay == day && segment >= next {
+			next = segment + 1
+		}
+	}
+	return next, nil
+}
+
+// marketDataFileName returns the name of a day's segment file
+func marketDataFileName(day string, segment int) string {
+	return fmt.Sprintf("%s.%0*d%s", day, marketDataSegmentWidth, segment, marketDataFileExtension)
+}
+
+// parseMarketDataFileName returns the day and segment of a recorded file
+func parseMarketDataFileName(name string) (day string, segment int, ok bool) {
+	if !strings.HasSuffix(name, marketDataFileExtension) {
+		return "", 0, false
+	}
+	split := strings.Split(strings.TrimSuffix(name, marketDataFileExtension), ".")
+	if len(split) != 2 {
+		return "", 0, false
+	}
+	if _, err := time.Parse(marketDataDayFormat, split[0]); err != nil {
+		return "", 0, false
+	}
+	segment, err := strconv.Atoi(split[1])
+	if err != nil || segment < 1 {
+		return "", 0, false
+	}
+	return split[0], segment, true
+}
+
+// flushLoop periodically writes buffered events to disk
+func (m *MarketDataRecorder) flushLoop() {
+	defer m.wg.Done()
+	t := time.NewTicker(m.cfg.FlushInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-t.C:
+			m.m.Lock()
+			for exch, f := range m.files {
+				err := f.gz.Flush()
+				if err != nil {
+					log.Errorf(log.WebsocketMgr, "Market data recorder unable to flush %s events: %v", exch, err)
+				}
+			}
+			m.m.Unlock()
+		}
+	}
+}
+
+// close writes the remaining events and closes the file
+func (f *marketDataFile) close() error {
+	gzErr := f.gz.Close()
+	err := f.f.Close()
+	if gzErr != nil {
+		return gzErr
+	}
+	return err
+}
+
+// newMarketDataRecords converts a websocket event into records. Trades and
+// fills are recorded individually as each can hold a different pair
+func newMarketDataRecords(exchName string, data interface{}, t time.Time) ([]marketDataRecord, error) {
+	switch d := data.(type) {
+	case *ticker.Price:
+		r, err := newMarketDataRecord(exchName, marketDataTicker, &d.Pair, d, t)
+		if err != nil {
+			return nil, err
+		}
+		return []marketDataRecord{r}, nil
+	case *orderbook.Base:
+		r, err := newMarketDataRecord(exchName, marketDataOrderbook, &d.Pair, d, t)
+		if err != nil {
+			return nil, err
+		}
+		return []marketDataRecord{r}, nil
+	case *order.Detail:
+		r, err := newMarketDataRecord(exchName, marketDataOrder, &d.Pair, d, t)
+		if err != nil {
+			return nil, err
+		}
+		return []marketDataRecord{r}, nil
+	case account.Change:
+		r, err := newMarketDataRecord(exchName, marketDataAccount, nil, d, t)
+		if err != nil {
+			return nil, err
+		}
+		return []marketDataRecord{r}, nil
+	case []trade.Data:
+		records := make([]marketDataRecord, len(d))
+		for i := range d {
+			r, err := newMarketDataRecord(exchName, marketDataTrade, &d[i].CurrencyPair, &d[i], t)
+			if err != nil {
+				return nil, err
+			}
+			records[i] = r
+		}
+		return records, nil
+	case []fill.Data:
+		records := make([]marketDataRecord, len(d))
+		for i := range d {
+			r, err := newMarketDataRecord(exchName, marketDataFill, &d[i].CurrencyPair, &d[i], t)
+			if err != nil {
+				return nil, err
+			}
+			records[i] = r
+		}
+		return records, nil
+	}
+	return nil, fmt.Errorf("%w %T", errUnsupportedMarketData, data)
+}
+
+func newMarketDataRecord(exchName, dataType string, p *currency.Pair, data interface{}, t time.Time) (marketDataRecord, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return marketDataRecord{}, err
+	}
+	r := marketDataRecord{
+		Time:     t,
+		Exchange: exchName,
+		Type:     dataType,
+		Data:     payload,
+	}
+	if p != nil {
+		r.Pair = &marketDataPair{
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+			Delimiter: p.Delimiter,
+		}
+	}
+	return r, nil
+}
@@ -0,0 +1,258 @@
+package engine
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+func TestSetupMarketDataRecorder(t *testing.T) {
+	t.Parallel()
+	_, err := SetupMarketDataRecorder(nil)
+	if !errors.Is(err, errNilConfig) {
+		t.Errorf("received '%v' expected '%v'", err, errNilConfig)
+	}
+	_, err = SetupMarketDataRecorder(&config.MarketDataRecorder{})
+	if !errors.Is(err, errNoMarketDataDirectory) {
+		t.Errorf("received '%v' expected '%v'", err, errNoMarketDataDirectory)
+	}
+	_, err = SetupMarketDataRecorder(&config.MarketDataRecorder{Directory: t.TempDir()})
+	if !errors.Is(err, errInvalidFlushInterval) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidFlushInterval)
+	}
+	m, err := SetupMarketDataRecorder(&config.MarketDataRecorder{
+		Directory:     t.TempDir(),
+		FlushInterval: time.Second,
+		Exchanges:     []string{"Bitstamp"},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !m.exchanges["bitstamp"] {
+		t.Error("expected exchange filter to be set")
+	}
+}
+
+func TestMarketDataRecorderStartStop(t *testing.T) {
+	t.Parallel()
+	var m *MarketDataRecorder
+	if m.IsRunning() {
+		t.Error("expected false")
+	}
+	err := m.Start()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	err = m.Stop()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	m, err = SetupMarketDataRecorder(&config.MarketDataRecorder{
+		Directory:     t.TempDir(),
+		FlushInterval: time.Millisecond,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = m.Stop()
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+	err = m.Start()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	err = m.Start()
+	if !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+	if !m.IsRunning() {
+		t.Error("expected true")
+	}
+	err = m.Stop()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+}
+
+func TestMarketDataRecorderRecord(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	m, err := SetupMarketDataRecorder(&config.MarketDataRecorder{
+		Directory:     dir,
+		FlushInterval: time.Millisecond,
+		Exchanges:     []string{"Bitstamp"},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = m.Record("Bitstamp", &ticker.Price{})
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+	err = m.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	pair := currency.NewPairWithDelimiter("DOGE", "USDT", "")
+	events := []interface{}{
+		&ticker.Price{ExchangeName: "Bitstamp", Pair: pair, AssetType: asset.Spot, Last: 1337},
+		&orderbook.Base{Exchange: "Bitstamp", Pair: pair, Asset: asset.Spot, Bids: orderbook.Items{{Price: 1, Amount: 1}}},
+		[]trade.Data{
+			{Exchange: "Bitstamp", CurrencyPair: pair, AssetType: asset.Spot, Price: 1, Amount: 1},
+			{Exchange: "Bitstamp", CurrencyPair: pair, AssetType: asset.Spot, Price: 2, Amount: 1},
+		},
+		"ignored",
+		stream.KlineData{},
+	}
+	for i := range events {
+		err = m.Record("Bitstamp", events[i])
+		if !errors.Is(err, nil) {
+			t.Errorf("received '%v' expected '%v'", err, nil)
+		}
+	}
+	// exchanges not in the config are not recorded
+	err = m.Record("Binance", events[0])
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	err = m.Stop()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	day := time.Now().UTC().Format(marketDataDayFormat)
+	records := readMarketDataFile(t, filepath.Join(dir, "bitstamp", marketDataFileName(day, 1)))
+	if len(records) != 4 {
+		t.Fatalf("received '%v' expected '%v'", len(records), 4)
+	}
+	expectedTypes := []string{marketDataTicker, marketDataOrderbook, marketDataTrade, marketDataTrade}
+	for i := range records {
+		if records[i].Type != expectedTypes[i] {
+			t.Errorf("received '%v' expected '%v'", records[i].Type, expectedTypes[i])
+		}
+		if records[i].Pair == nil || records[i].Pair.Base != "DOGE" || records[i].Pair.Quote != "USDT" {
+			t.Errorf("received '%+v' expected DOGE USDT pair", records[i].Pair)
+		}
+	}
+	if _, err = os.Stat(filepath.Join(dir, "binance")); !os.IsNotExist(err) {
+		t.Error("expected filtered exchange not to be recorded")
+	}
+
+	// restarting records to a new segment for the day
+	err = m.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = m.Record("Bitstamp", events[0])
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	err = m.Stop()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	records = readMarketDataFile(t, filepath.Join(dir, "bitstamp", marketDataFileName(day, 1)))
+	if len(records) != 4 {
+		t.Errorf("received '%v' expected '%v'", len(records), 4)
+	}
+	records = readMarketDataFile(t, filepath.Join(dir, "bitstamp", marketDataFileName(day, 2)))
+	if len(records) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(records), 1)
+	}
+}
+
+func TestParseMarketDataFileName(t *testing.T) {
+	t.Parallel()
+	tt := []struct {
+		name    string
+		day     string
+		segment int
+		ok      bool
+	}{
+		{name: marketDataFileName("2022-01-01", 1), day: "2022-01-01", segment: 1, ok: true},
+		{name: marketDataFileName("2022-01-01", 1234), day: "2022-01-01", segment: 1234, ok: true},
+		{name: "2022-01-01" + marketDataFileExtension},
+		{name: "2022-01-01.0" + marketDataFileExtension},
+		{name: "2022-01-01.a" + marketDataFileExtension},
+		{name: "notes.001" + marketDataFileExtension},
+		{name: "2022-01-01.001.json"},
+	}
+	for i := range tt {
+		day, segment, ok := parseMarketDataFileName(tt[i].name)
+		if day != tt[i].day || segment != tt[i].segment || ok != tt[i].ok {
+			t.Errorf("%s received '%v' '%v' '%v' expected '%v' '%v' '%v'",
+				tt[i].name, day, segment, ok, tt[i].day, tt[i].segment, tt[i].ok)
+		}
+	}
+	if name := marketDataFileName("2022-01-01", 1); name != "2022-01-01.001"+marketDataFileExtension {
+		t.Errorf("received '%v' expected '%v'", name, "2022-01-01.001"+marketDataFileExtension)
+	}
+}
+
+func TestNewMarketDataRecords(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	pair := currency.NewPair(currency.BTC, currency.USD)
+	_, err := newMarketDataRecords("test", "test", tt)
+	if !errors.Is(err, errUnsupportedMarketData) {
+		t.Errorf("received '%v' expected '%v'", err, errUnsupportedMarketData)
+	}
+	events := []interface{}{
+		&order.Detail{Exchange: "test", Pair: pair, ID: "1"},
+		account.Change{Exchange: "test", Currency: currency.BTC, Amount: 1},
+		[]fill.Data{{Exchange: "test", CurrencyPair: pair}},
+	}
+	expected := []string{marketDataOrder, marketDataAccount, marketDataFill}
+	for i := range events {
+		r, err := newMarketDataRecords("test", events[i], tt)
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v' expected '%v'", err, nil)
+		}
+		if len(r) != 1 || r[0].Type != expected[i] || !r[0].Time.Equal(tt) {
+			t.Errorf("received '%+v' expected '%v' record", r, expected[i])
+		}
+	}
+}
+
+func readMarketDataFile(t *testing.T, path string) []marketDataRecord {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dec := json.NewDecoder(gz)
+	var records []marketDataRecord
+	for {
+		var r marketDataRecord
+		err = dec.Decode(&r)
+		if errors.Is(err, io.EOF) {
+			return records
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, r)
+	}
+}
@@ -0,0 +1,78 @@
+package engine
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+// MarketDataRecorderName is an exported subsystem name
+const MarketDataRecorderName = "market_data_recorder"
+
+const (
+	// marketDataFileExtension is the extension of the gzip compressed JSON
+	// lines files events are recorded to
+	marketDataFileExtension = ".jsonl.gz"
+	// marketDataDayFormat names each file by the UTC day of its events
+	marketDataDayFormat = "2006-01-02"
+	// marketDataSegmentWidth zero pads the segment number following the day
+	// in each file name
+	marketDataSegmentWidth = 3
+
+	marketDataTicker    = "ticker"
+	marketDataOrderbook = "orderbook"
+	marketDataTrade     = "trade"
+	marketDataOrder     = "order"
+	marketDataAccount   = "account"
+	marketDataFill      = "fill"
+)
+
+var (
+	errUnsupportedMarketData = errors.New("unsupported market data type")
+	errNoMarketDataDirectory = errors.New("no market data directory set")
+	errInvalidFlushInterval  = errors.New("invalid flush interval")
+)
+
+// MarketDataRecorder writes the normalised events received by the websocket
+// routine manager to gzip compressed JSON lines files per exchange and UTC
+// day, so they can be replayed by the market data replayer
+type MarketDataRecorder struct {
+	started   int32
+	cfg       config.MarketDataRecorder
+	exchanges map[string]bool
+	shutdown  chan struct{}
+	wg        sync.WaitGroup
+
+	m     sync.Mutex
+	files map[string]*marketDataFile
+}
+
+// marketDataFile is an exchange's segment file for the current day
+type marketDataFile struct {
+	day string
+	f   *os.File
+	gz  *gzip.Writer
+	enc *json.Encoder
+}
+
+// marketDataRecord is a single recorded event. Pairs are stored separately as
+// pairs without a delimiter cannot be reliably parsed from their string
+type marketDataRecord struct {
+	Time     time.Time       `json:"time"`
+	Exchange string          `json:"exchange"`
+	Type     string          `json:"type"`
+	Pair     *marketDataPair `json:"pair,omitempty"`
+	Data     json.RawMessage `json:"data"`
+}
+
+// marketDataPair holds the currencies of a recorded pair
+type marketDataPair struct {
+	Base      string `json:"base"`
+	Quote     string `json:"quote"`
+	Delimiter string `json:"delimiter,omitempty"`
+}
@@ -0,0 +1,386 @@
+package engine
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupMarketDataReplayer will boot up the MarketDataReplayer. The data
+// handler receives replayed order, account and fill events and can be nil
+func SetupMarketDataReplayer(cfg *config.MarketDataReplayer, dataHandler iWebsocketDataHandler) (*MarketDataReplayer, error) {
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if cfg.Directory == "" {
+		return nil, errNoMarketDataDirectory
+	}
+	return &MarketDataReplayer{
+		cfg:         *cfg,
+		dataHandler: dataHandler,
+		shutdown:    make(chan struct{}),
+	}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *MarketDataReplayer) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return atomic.LoadInt32(&m.started) == 1
+}
+
+// Start runs the subsystem, replaying all recorded events in the configured
+// time range. The subsystem stops once all events are replayed
+func (m *MarketDataReplayer) Start() error {
+	if m == nil {
+		return fmt.Errorf("market data replayer %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("market data replayer %w", ErrSubSystemAlreadyStarted)
+	}
+	m.shutdown = make(chan struct{})
+	atomic.StoreInt64(&m.replayed, 0)
+	m.wg.Add(1)
+	go func(shutdown chan struct{}) {
+		defer m.wg.Done()
+		err := m.replay(shutdown)
+		if err != nil {
+			log.Errorf(log.WebsocketMgr, "Market data replayer error: %v", err)
+		}
+		log.Debugf(log.WebsocketMgr, "Market data replayer finished, %d events replayed.", atomic.LoadInt64(&m.replayed))
+		atomic.CompareAndSwapInt32(&m.started, 1, 0)
+	}(m.shutdown)
+	log.Debugf(log.WebsocketMgr, "Market data replayer started, replaying from %s.", m.cfg.Directory)
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem, ending any replay in progress
+func (m *MarketDataReplayer) Stop() error {
+	if m == nil {
+		return fmt.Errorf("market data replayer %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return fmt.Errorf("market data replayer %w", ErrSubSystemNotStarted)
+	}
+	close(m.shutdown)
+	m.wg.Wait()
+	log.Debugln(log.WebsocketMgr, "Market data replayer shut down.")
+	return nil
+}
+
+// replay merges the recorded events of each exchange in time order and
+// processes them, waiting between events to match the configured speed
+func (m *MarketDataReplayer) replay(shutdown <-chan struct{}) error {
+	readers, err := m.getReaders()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		for i := range readers {
+			readers[i].close()
+		}
+	}()
+
+	var firstEvent, replayStart time.Time
+	for {
+		select {
+		case <-shutdown:
+			return nil
+		default:
+		}
+		var r *marketDataReader
+		for i := range readers {
+			if err = readers[i].load(m.cfg.StartTime, m.cfg.EndTime); err != nil {
+				return err
+			}
+			if readers[i].next == nil {
+				continue
+			}
+			if r == nil || readers[i].next.Time.Before(r.next.Time) {
+				r = readers[i]
+			}
+		}
+		if r == nil {
+			return nil
+		}
+		record := r.next
+		r.next = nil
+
+		if m.cfg.Speed > 0 {
+			if firstEvent.IsZero() {
+				firstEvent = record.Time
+				replayStart = time.Now()
+			}
+			offset := time.Duration(float64(record.Time.Sub(firstEvent)) / m.cfg.Speed)
+			if wait := time.Until(replayStart.Add(offset)); wait > 0 {
+				timer := time.NewTimer(wait)
+				select {
+				case <-shutdown:
+					timer.Stop()
+					return nil
+				case <-timer.C:
+				}
+			}
+		}
+
+		data, err := record.event()
+		if err != nil {
+			log.Errorf(log.WebsocketMgr, "Market data replayer %s %s event error: %v", record.Exchange, record.Type, err)
+			continue
+		}
+		err = m.process(record.Exchange, data)
+		if err != nil {
+			log.Errorf(log.WebsocketMgr, "Market data replayer %s %s event error: %v", record.Exchange, record.Type, err)
+		}
+		atomic.AddInt64(&m.replayed, 1)
+	}
+}
+
+// process feeds a replayed event to its service, or to the data handler for
+// order, account and fill events
+func (m *MarketDataReplayer) process(exchName string, data interface{}) error {
+	switch d := data.(type) {
+	case *ticker.Price:
+		return ticker.ProcessTicker(d)
+	case *orderbook.Base:
+		return d.Process()
+	case []trade.Data:
+		return trade.AddTradesToBuffer(exchName, d...)
+	}
+	if m.dataHandler == nil {
+		return nil
+	}
+	return m.dataHandler.WebsocketDataHandler(exchName, data)
+}
+
+// getReaders returns a reader for each exchange with recorded files in the
+// configured time range
+func (m *MarketDataReplayer) getReaders() ([]*marketDataReader, error) {
+	exchanges := make([]string, len(m.cfg.Exchanges))
+	for i := range m.cfg.Exchanges {
+		exchanges[i] = strings.ToLower(m.cfg.Exchanges[i])
+	}
+	if len(exchanges) == 0 {
+		dirs, err := ioutil.ReadDir(m.cfg.Directory)
+		if err != nil {
+			return nil, err
+		}
+		for i := range dirs {
+			if dirs[i].IsDir() {
+				exchanges = append(exchanges, dirs[i].Name())
+			}
+		}
+	}
+	var startDay, endDay string
+	if !m.cfg.StartTime.IsZero() {
+		startDay = m.cfg.StartTime.UTC().Format(marketDataDayFormat)
+	}
+	if !m.cfg.EndTime.IsZero() {
+		endDay = m.cfg.EndTime.UTC().Format(marketDataDayFormat)
+	}
+
+	var readers []*marketDataReader
+	for i := range exchanges {
+		files, err := filepath.Glob(filepath.Join(m.cfg.Directory, exchanges[i], "*"+marketDataFileExtension))
+		if err != nil {
+			return nil, err
+		}
+		type segmentFile struct {
+			path    string
+			day     string
+			segment int
+		}
+		var inRange []segmentFile
+		for j := range files {
+			day, segment, ok := parseMarketDataFileName(filepath.Base(files[j]))
+			if !ok {
+				continue
+			}
+			if (startDay != "" && day < startDay) || (endDay != "" && day > endDay) {
+				continue
+			}
+			inRange = append(inRange, segmentFile{path: files[j], day: day, segment: segment})
+		}
+		if len(inRange) == 0 {
+			continue
+		}
+		// days are zero padded so sort as strings, segments are compared as
+		// numbers as they can outgrow their padding
+		sort.Slice(inRange, func(x, y int) bool {
+			if inRange[x].day != inRange[y].day {
+				return inRange[x].day < inRange[y].day
+			}
+			return inRange[x].segment < inRange[y].segment
+		})
+		paths := make([]string, len(inRange))
+		for j := range inRange {
+			paths[j] = inRange[j].path
+		}
+		readers = append(readers, &marketDataReader{
+			exchange: exchanges[i],
+			files:    paths,
+		})
+	}
+	if len(readers) == 0 {
+		return nil, fmt.Errorf("%w in %s", errNoMarketData, m.cfg.Directory)
+	}
+	return readers, nil
+}
+
+// load reads the next record within the time range if one is not loaded
+func (r *marketDataReader) load(start, end time.Time) error {
+	for r.next == nil && !r.done {
+		if r.dec == nil {
+			if len(r.files) == 0 {
+				r.done = true
+				return nil
+			}
+			path := r.files[0]
+			r.files = r.files[1:]
+			err := r.open(path)
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					// nothing was written before the recorder stopped
+					continue
+				}
+				return err
+			}
+		}
+		var record marketDataRecord
+		err := r.dec.Decode(&record)
+		if err != nil {
+			name := r.f.Name()
+			r.close()
+			if errors.Is(err, io.EOF) {
+				continue
+			}
+			if errors.Is(err, io.ErrUnexpectedEOF) {
+				// the recorder did not shut down cleanly
+				log.Warnf(log.WebsocketMgr, "Market data replayer %s is truncated, continuing with the next file", name)
+				continue
+			}
+			return fmt.Errorf("%s %w", name, err)
+		}
+		if !start.IsZero() && record.Time.Before(start) {
+			continue
+		}
+		if !end.IsZero() && record.Time.After(end) {
+			r.done = true
+			return nil
+		}
+		r.next = &record
+	}
+	return nil
+}
+
+func (r *marketDataReader) open(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		if closeErr := f.Close(); closeErr != nil {
+			log.Errorf(log.WebsocketMgr, "Market data replayer unable to close %s: %v", path, closeErr)
+		}
+		return fmt.Errorf("%s %w", path, err)
+	}
+	r.f = f
+	r.gz = gz
+	r.dec = json.NewDecoder(gz)
+	return nil
+}
+
+func (r *marketDataReader) close() {
+	if r.f == nil {
+		return
+	}
+	if err := r.gz.Close(); err != nil {
+		log.Errorf(log.WebsocketMgr, "Market data replayer unable to close %s: %v", r.f.Name(), err)
+	}
+	if err := r.f.Close(); err != nil {
+		log.Errorf(log.WebsocketMgr, "Market data replayer unable to close %s: %v", r.f.Name(), err)
+	}
+	r.f = nil
+	r.gz = nil
+	r.dec = nil
+}
+
+// event decodes the record into the type originally sent to the websocket
+// data handler
+func (r *marketDataRecord) event() (interface{}, error) {
+	var err error
+	switch r.Type {
+	case marketDataTicker:
+		var d ticker.Price
+		if err = json.Unmarshal(r.Data, &d); err != nil {
+			return nil, err
+		}
+		r.setPair(&d.Pair)
+		return &d, nil
+	case marketDataOrderbook:
+		var d orderbook.Base
+		if err = json.Unmarshal(r.Data, &d); err != nil {
+			return nil, err
+		}
+		r.setPair(&d.Pair)
+		return &d, nil
+	case marketDataTrade:
+		var d trade.Data
+		if err = json.Unmarshal(r.Data, &d); err != nil {
+			return nil, err
+		}
+		r.setPair(&d.CurrencyPair)
+		return []trade.Data{d}, nil
+	case marketDataOrder:
+		var d order.Detail
+		if err = json.Unmarshal(r.Data, &d); err != nil {
+			return nil, err
+		}
+		r.setPair(&d.Pair)
+		return &d, nil
+	case marketDataAccount:
+		var d account.Change
+		if err = json.Unmarshal(r.Data, &d); err != nil {
+			return nil, err
+		}
+		return d, nil
+	case marketDataFill:
+		var d fill.Data
+		if err = json.Unmarshal(r.Data, &d); err != nil {
+			return nil, err
+		}
+		r.setPair(&d.CurrencyPair)
+		return []fill.Data{d}, nil
+	}
+	return nil, fmt.Errorf("%w %s", errUnsupportedMarketData, r.Type)
+}
+
+// setPair replaces the pair parsed from its string with the recorded
+// currencies
+func (r *marketDataRecord) setPair(p *currency.Pair) {
+	if r.Pair == nil {
+		return
+	}
+	*p = currency.NewPairWithDelimiter(r.Pair.Base, r.Pair.Quote, r.Pair.Delimiter)
+}
@@ -0,0 +1,330 @@
+package engine
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+type replayedEvent struct {
+	exchange string
+	data     interface{}
+}
+
+type fakeWebsocketDataHandler struct {
+	m      sync.Mutex
+	events []replayedEvent
+}
+
+func (f *fakeWebsocketDataHandler) WebsocketDataHandler(exchName string, data interface{}) error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	f.events = append(f.events, replayedEvent{exchange: exchName, data: data})
+	return nil
+}
+
+type timedEvent struct {
+	time time.Time
+	data interface{}
+}
+
+// writeMarketDataFile writes events to an exchange's segment file for the day
+// as the market data recorder would
+func writeMarketDataFile(t *testing.T, dir, exchName, day string, segment int, events ...timedEvent) string {
+	t.Helper()
+	exchDir := filepath.Join(dir, exchName)
+	err := os.MkdirAll(exchDir, 0o750)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(exchDir, marketDataFileName(day, segment))
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	gz := gzip.NewWriter(f)
+	enc := json.NewEncoder(gz)
+	for i := range events {
+		records, err := newMarketDataRecords(exchName, events[i].data, events[i].time)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for j := range records {
+			err = enc.Encode(&records[j])
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	err = gz.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestSetupMarketDataReplayer(t *testing.T) {
+	t.Parallel()
+	_, err := SetupMarketDataReplayer(nil, nil)
+	if !errors.Is(err, errNilConfig) {
+		t.Errorf("received '%v' expected '%v'", err, errNilConfig)
+	}
+	_, err = SetupMarketDataReplayer(&config.MarketDataReplayer{}, nil)
+	if !errors.Is(err, errNoMarketDataDirectory) {
+		t.Errorf("received '%v' expected '%v'", err, errNoMarketDataDirectory)
+	}
+	m, err := SetupMarketDataReplayer(&config.MarketDataReplayer{Directory: t.TempDir()}, &fakeWebsocketDataHandler{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if m == nil {
+		t.Error("expected replayer")
+	}
+}
+
+func TestMarketDataReplayerGetReaders(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	m, err := SetupMarketDataReplayer(&config.MarketDataReplayer{Directory: filepath.Join(dir, "missing")}, nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = m.getReaders()
+	if !os.IsNotExist(err) {
+		t.Errorf("received '%v' expected not exist error", err)
+	}
+	m.cfg.Directory = dir
+	_, err = m.getReaders()
+	if !errors.Is(err, errNoMarketData) {
+		t.Errorf("received '%v' expected '%v'", err, errNoMarketData)
+	}
+
+	writeMarketDataFile(t, dir, "exch", "2022-01-01", 1)
+	writeMarketDataFile(t, dir, "exch", "2022-01-03", 1)
+	writeMarketDataFile(t, dir, "exch", "2022-01-02", 10)
+	writeMarketDataFile(t, dir, "exch", "2022-01-02", 2)
+	for _, name := range []string{"notes" + marketDataFileExtension, "2022-01-02" + marketDataFileExtension, "2022-01-02.0" + marketDataFileExtension} {
+		err = ioutil.WriteFile(filepath.Join(dir, "exch", name), nil, 0o600)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	m.cfg.StartTime = time.Date(2022, 1, 2, 12, 0, 0, 0, time.UTC)
+	readers, err := m.getReaders()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(readers) != 1 || len(readers[0].files) != 3 {
+		t.Fatalf("received '%v' readers expected '1' with '3' files", len(readers))
+	}
+	// segments are read in order within each day
+	expected := []string{marketDataFileName("2022-01-02", 2), marketDataFileName("2022-01-02", 10), marketDataFileName("2022-01-03", 1)}
+	for i := range expected {
+		if filepath.Base(readers[0].files[i]) != expected[i] {
+			t.Errorf("received '%v' expected '%v'", filepath.Base(readers[0].files[i]), expected[i])
+		}
+	}
+	m.cfg.Exchanges = []string{"Other"}
+	_, err = m.getReaders()
+	if !errors.Is(err, errNoMarketData) {
+		t.Errorf("received '%v' expected '%v'", err, errNoMarketData)
+	}
+}
+
+func TestMarketDataReplayerReplay(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	day := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	pair := currency.NewPairWithDelimiter("REPLAY", "USDT", "")
+	const exchA, exchB = "ReplayExchangeA", "ReplayExchangeB"
+	writeMarketDataFile(t, dir, exchA, "2022-01-01", 1,
+		timedEvent{day.Add(time.Hour * 10), &ticker.Price{ExchangeName: exchA, Pair: pair, AssetType: asset.Spot, Last: 1}},
+		timedEvent{day.Add(time.Hour * 11), account.Change{Exchange: exchA, Currency: currency.BTC, Asset: asset.Spot, Amount: 1}},
+		timedEvent{day.Add(time.Hour * 12), &orderbook.Base{
+			Exchange: exchA,
+			Pair:     pair,
+			Asset:    asset.Spot,
+			Bids:     orderbook.Items{{Price: 1336, Amount: 1}},
+			Asks:     orderbook.Items{{Price: 1338, Amount: 1}},
+		}},
+	)
+	writeMarketDataFile(t, dir, exchA, "2022-01-02", 1,
+		timedEvent{day.Add(time.Hour * 33), []fill.Data{{Exchange: exchA, CurrencyPair: pair, Price: 1}}},
+	)
+	writeMarketDataFile(t, dir, exchB, "2022-01-01", 1,
+		timedEvent{day.Add(time.Hour*10 + time.Minute*30), &order.Detail{Exchange: exchB, Pair: pair, ID: "1337"}},
+		timedEvent{day.Add(time.Hour * 13), &ticker.Price{ExchangeName: exchB, Pair: pair, AssetType: asset.Spot, Last: 2}},
+	)
+	// files from a recorder which did not shut down cleanly are read up to
+	// the point they were truncated
+	truncated := writeMarketDataFile(t, dir, "truncated", "2022-01-01", 1,
+		timedEvent{day.Add(time.Hour * 14), &order.Detail{Exchange: "truncated", Pair: pair, ID: "1"}},
+	)
+	contents, err := ioutil.ReadFile(truncated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(truncated, contents[:len(contents)-10], 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = common.CreateDir(filepath.Join(dir, "empty"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, "empty", marketDataFileName("2022-01-01", 1)), nil, 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h := &fakeWebsocketDataHandler{}
+	m, err := SetupMarketDataReplayer(&config.MarketDataReplayer{
+		Directory: dir,
+		StartTime: day.Add(time.Hour*10 + time.Minute*15),
+		EndTime:   day.Add(time.Hour * 23),
+	}, h)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = m.replay(make(chan struct{}))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	if len(h.events) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(h.events), 3)
+	}
+	d, ok := h.events[0].data.(*order.Detail)
+	if !ok || h.events[0].exchange != exchB || d.ID != "1337" {
+		t.Errorf("received '%+v' expected order from '%v'", h.events[0], exchB)
+	}
+	if !d.Pair.Equal(pair) || d.Pair.Base.String() != "REPLAY" {
+		t.Errorf("received '%v' expected '%v'", d.Pair, pair)
+	}
+	if _, ok = h.events[1].data.(account.Change); !ok || h.events[1].exchange != exchA {
+		t.Errorf("received '%+v' expected account change from '%v'", h.events[1], exchA)
+	}
+	if d, ok = h.events[2].data.(*order.Detail); !ok || d.ID != "1" {
+		t.Errorf("received '%+v' expected truncated file order", h.events[2])
+	}
+
+	// the first ticker is before the start time
+	_, err = ticker.GetTicker(exchA, pair, asset.Spot)
+	if err == nil {
+		t.Error("expected ticker before start time not to be replayed")
+	}
+	tick, err := ticker.GetTicker(exchB, pair, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if tick.Last != 2 {
+		t.Errorf("received '%v' expected '%v'", tick.Last, 2)
+	}
+	ob, err := orderbook.Get(exchA, pair, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(ob.Bids) != 1 || ob.Bids[0].Price != 1336 {
+		t.Errorf("received '%+v' expected bid at 1336", ob.Bids)
+	}
+}
+
+func TestMarketDataReplayerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *MarketDataReplayer
+	if m.IsRunning() {
+		t.Error("expected false")
+	}
+	err := m.Start()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+	err = m.Stop()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNilSubsystem)
+	}
+
+	dir := t.TempDir()
+	day := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	writeMarketDataFile(t, dir, "exch", "2022-01-01", 1,
+		timedEvent{day, account.Change{Exchange: "exch", Currency: currency.BTC}},
+		timedEvent{day.Add(time.Second), account.Change{Exchange: "exch", Currency: currency.BTC}},
+		timedEvent{day.Add(time.Hour), account.Change{Exchange: "exch", Currency: currency.BTC}},
+	)
+	h := &fakeWebsocketDataHandler{}
+	m, err = SetupMarketDataReplayer(&config.MarketDataReplayer{
+		Directory: dir,
+		Speed:     20,
+	}, h)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = m.Stop()
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+	start := time.Now()
+	err = m.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = m.Start()
+	if !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+	// the second event is replayed a twentieth of a second after the first
+	// and the third is not reached before stopping
+	for {
+		h.m.Lock()
+		replayed := len(h.events)
+		h.m.Unlock()
+		if replayed == 2 {
+			break
+		}
+		if time.Since(start) > time.Second*5 {
+			t.Fatal("timed out waiting for replayed events")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if elapsed := time.Since(start); elapsed < time.Millisecond*50 {
+		t.Errorf("received '%v' expected at least '%v'", elapsed, time.Millisecond*50)
+	}
+	err = m.Stop()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(h.events) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(h.events), 2)
+	}
+
+	// the subsystem stops once all events are replayed
+	m.cfg.Speed = 0
+	err = m.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	m.wg.Wait()
+	if m.IsRunning() {
+		t.Error("expected replayer to stop once finished")
+	}
+	if len(h.events) != 5 {
+		t.Errorf("received '%v' expected '%v'", len(h.events), 5)
+	}
+}
@@ -0,0 +1,40 @@
+package engine
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"os"
+	"sync"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+// MarketDataReplayerName is an exported subsystem name
+const MarketDataReplayerName = "market_data_replayer"
+
+var errNoMarketData = errors.New("no recorded market data found")
+
+// MarketDataReplayer replays events recorded by the market data recorder in
+// time order across exchanges. Tickers, orderbooks and trades are processed
+// by their services, publishing them via dispatch, while order, account and
+// fill events are passed to the websocket data handler
+type MarketDataReplayer struct {
+	started     int32
+	cfg         config.MarketDataReplayer
+	dataHandler iWebsocketDataHandler
+	shutdown    chan struct{}
+	wg          sync.WaitGroup
+	replayed    int64
+}
+
+// marketDataReader reads the recorded files of an exchange in day order
+type marketDataReader struct {
+	exchange string
+	files    []string
+	f        *os.File
+	gz       *gzip.Reader
+	dec      *json.Decoder
+	next     *marketDataRecord
+	done     bool
+}
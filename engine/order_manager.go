@@ -13,14 +13,16 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	dborder "github.com/thrasher-corp/gocryptotrader/database/repository/order"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
-// SetupOrderManager will boot up the OrderManager
-func SetupOrderManager(exchangeManager iExchangeManager, communicationsManager iCommsManager, wg *sync.WaitGroup, verbose bool) (*OrderManager, error) {
+// SetupOrderManager will boot up the OrderManager. If a database connection
+// manager is supplied and connected, orders are persisted to the database
+func SetupOrderManager(exchangeManager iExchangeManager, communicationsManager iCommsManager, databaseConnectionManager iDatabaseConnectionManager, wg *sync.WaitGroup, verbose bool) (*OrderManager, error) {
 	if exchangeManager == nil {
 		return nil, errNilExchangeManager
 	}
@@ -31,7 +33,7 @@ func SetupOrderManager(exchangeManager iExchangeManager, communicationsManager i
 		return nil, errNilWaitGroup
 	}
 
-	return &OrderManager{
+	m := &OrderManager{
 		shutdown: make(chan struct{}),
 		orderStore: store{
 			Orders:          make(map[string][]*order.Detail),
@@ -39,8 +41,19 @@ func SetupOrderManager(exchangeManager iExchangeManager, communicationsManager i
 			commsManager:    communicationsManager,
 			wg:              wg,
 		},
-		verbose: verbose,
-	}, nil
+		verbose:   verbose,
+		positions: order.SetupPositionController(),
+	}
+	if databaseConnectionManager != nil {
+		db, err := dborder.Setup(databaseConnectionManager.GetInstance())
+		if err != nil {
+			return nil, err
+		}
+		if db != nil {
+			m.orderDB = db
+		}
+	}
+	return m, nil
 }
 
 // IsRunning safely checks whether the subsystem is running
@@ -61,7 +74,11 @@ func (m *OrderManager) Start() error {
 	}
 	log.Debugln(log.OrderMgr, "Order manager starting...")
 	m.shutdown = make(chan struct{})
-	go m.run()
+	loaded, err := m.loadPersistedOrders()
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Order manager unable to load persisted orders: %v", err)
+	}
+	go m.run(loaded)
 	return nil
 }
 
@@ -97,8 +114,9 @@ func (m *OrderManager) gracefulShutdown() {
 }
 
 // run will periodically process orders
-func (m *OrderManager) run() {
+func (m *OrderManager) run(persisted []order.Detail) {
 	log.Debugln(log.OrderMgr, "Order manager started.")
+	m.reconcilePersistedOrders(persisted)
 	m.processOrders()
 	tick := time.NewTicker(orderManagerDelay)
 	m.orderStore.wg.Add(1)
@@ -213,6 +231,10 @@ func (m *OrderManager) Cancel(ctx context.Context, cancel *order.Cancel) error {
 	}
 
 	od.Status = order.Cancelled
+	m.persistOrder(od)
+	cpy := od.Copy()
+	m.checkLinkedOrders(&cpy)
+	m.cancelLinkedOrderGroup(ctx, &cpy)
 	msg := fmt.Sprintf("Order manager: Exchange %s order ID=%v cancelled.",
 		od.Exchange, od.ID)
 	log.Debugln(log.OrderMgr, msg)
@@ -251,6 +273,8 @@ func (m *OrderManager) GetOrderInfo(ctx context.Context, exchangeName, orderID s
 	if err != nil {
 		return order.Detail{}, err
 	}
+	m.persistOrder(&upsertResponse.OrderDetails)
+	m.checkLinkedOrders(&upsertResponse.OrderDetails)
 
 	return upsertResponse.OrderDetails, nil
 }
@@ -346,6 +370,13 @@ func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (*order.Mo
 	// XXX: This comes with a race condition, because [request -> changes] are not
 	// atomic.
 	err = m.orderStore.modifyExisting(mod.ID, &res)
+	if err == nil {
+		var od *order.Detail
+		od, err = m.orderStore.getByExchangeAndID(mod.Exchange, mod.ID)
+		if err == nil {
+			m.persistOrder(od)
+		}
+	}
 
 	// Notify observers.
 	var message string
@@ -375,10 +406,22 @@ func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (*Ord
 	if err != nil {
 		return nil, err
 	}
-	exch, err := m.orderStore.exchangeManager.GetExchangeByName(newOrder.Exchange)
+	err = m.checkRisk(newOrder)
 	if err != nil {
 		return nil, err
 	}
+	resp, accepted, err := m.submit(ctx, newOrder)
+	m.releaseRisk(newOrder, accepted)
+	return resp, err
+}
+
+// submit sends an order which passed the risk checks to the exchange,
+// returning whether the exchange accepted it
+func (m *OrderManager) submit(ctx context.Context, newOrder *order.Submit) (resp *OrderSubmitResponse, accepted bool, err error) {
+	exch, err := m.orderStore.exchangeManager.GetExchangeByName(newOrder.Exchange)
+	if err != nil {
+		return nil, false, err
+	}
 
 	// Checks for exchange min max limits for order amounts before order
 	// execution can occur
@@ -388,7 +431,7 @@ func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (*Ord
 		newOrder.Amount,
 		newOrder.Type)
 	if err != nil {
-		return nil, fmt.Errorf("order manager: exchange %s unable to place order: %w",
+		return nil, false, fmt.Errorf("order manager: exchange %s unable to place order: %w",
 			newOrder.Exchange,
 			err)
 	}
@@ -397,7 +440,7 @@ func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (*Ord
 	// the currency pair
 	err = exch.CanTradePair(newOrder.Pair, newOrder.AssetType)
 	if err != nil {
-		return nil, fmt.Errorf("order manager: exchange %s cannot trade pair %s %s: %w",
+		return nil, false, fmt.Errorf("order manager: exchange %s cannot trade pair %s %s: %w",
 			newOrder.Exchange,
 			newOrder.Pair,
 			newOrder.AssetType,
@@ -406,10 +449,40 @@ func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (*Ord
 
 	result, err := exch.SubmitOrder(ctx, newOrder)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
+	resp, err = m.processSubmittedOrder(newOrder, result)
+	return resp, result.IsOrderPlaced, err
+}
 
-	return m.processSubmittedOrder(newOrder, result)
+// setRiskChecker sets the pre-trade risk checks run on every submitted order
+func (m *OrderManager) setRiskChecker(r iRiskChecker) {
+	m.riskMtx.Lock()
+	m.riskChecker = r
+	m.riskMtx.Unlock()
+}
+
+// checkRisk runs the pre-trade risk checks when a risk checker is running
+func (m *OrderManager) checkRisk(newOrder *order.Submit) error {
+	m.riskMtx.Lock()
+	r := m.riskChecker
+	m.riskMtx.Unlock()
+	if r == nil || !r.IsRunning() {
+		return nil
+	}
+	return r.CheckOrder(newOrder)
+}
+
+// releaseRisk releases the limits the risk checker reserved for a submitted
+// order, which is done even if the risk checker has since stopped
+func (m *OrderManager) releaseRisk(newOrder *order.Submit, accepted bool) {
+	m.riskMtx.Lock()
+	r := m.riskChecker
+	m.riskMtx.Unlock()
+	if r == nil {
+		return
+	}
+	r.ReleaseOrder(newOrder, accepted)
 }
 
 // SubmitFakeOrder runs through the same process as order submission
@@ -455,19 +528,7 @@ func (m *OrderManager) GetOrdersSnapshot(s order.Status) []order.Detail {
 	if m == nil || atomic.LoadInt32(&m.started) == 0 {
 		return nil
 	}
-	var os []order.Detail
-	for _, v := range m.orderStore.Orders {
-		for i := range v {
-			if s != v[i].Status &&
-				s != order.AnyStatus &&
-				s != "" {
-				continue
-			}
-			os = append(os, *v[i])
-		}
-	}
-
-	return os
+	return m.orderStore.getSnapshot(s)
 }
 
 // GetOrdersFiltered returns a snapshot of all orders in the order store.
@@ -532,7 +593,7 @@ func (m *OrderManager) processSubmittedOrder(newOrder *order.Submit, result orde
 	if result.FullyMatched {
 		status = order.Filled
 	}
-	err = m.orderStore.add(&order.Detail{
+	od := &order.Detail{
 		ImmediateOrCancel: newOrder.ImmediateOrCancel,
 		HiddenOrder:       newOrder.HiddenOrder,
 		FillOrKill:        newOrder.FillOrKill,
@@ -561,10 +622,13 @@ func (m *OrderManager) processSubmittedOrder(newOrder *order.Submit, result orde
 		LastUpdated:       time.Now(),
 		Pair:              newOrder.Pair,
 		Leverage:          newOrder.Leverage,
-	})
+	}
+	err = m.orderStore.add(od)
 	if err != nil {
 		return nil, fmt.Errorf("unable to add %v order %v to orderStore: %s", newOrder.Exchange, result.OrderID, err)
 	}
+	m.persistOrder(od)
+	m.trackFuturesPosition(od)
 
 	return &OrderSubmitResponse{
 		SubmitResponse: order.SubmitResponse{
@@ -718,7 +782,13 @@ func (m *OrderManager) Add(o *order.Detail) error {
 		return fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
 	}
 
-	return m.orderStore.add(o)
+	err := m.orderStore.add(o)
+	if err != nil {
+		return err
+	}
+	m.persistOrder(o)
+	m.trackFuturesPosition(o)
+	return nil
 }
 
 // GetByExchangeAndID returns a copy of an order from an exchange if it matches the ID
@@ -747,7 +817,18 @@ func (m *OrderManager) UpdateExistingOrder(od *order.Detail) error {
 	if atomic.LoadInt32(&m.started) == 0 {
 		return fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
 	}
-	return m.orderStore.updateExisting(od)
+	err := m.orderStore.updateExisting(od)
+	if err != nil {
+		return err
+	}
+	updated, err := m.orderStore.getByExchangeAndID(od.Exchange, od.ID)
+	if err != nil {
+		return err
+	}
+	m.persistOrder(updated)
+	cpy := updated.Copy()
+	m.checkLinkedOrders(&cpy)
+	return nil
 }
 
 // UpsertOrder updates an existing order or adds a new one to the orderstore
@@ -781,6 +862,9 @@ func (m *OrderManager) UpsertOrder(od *order.Detail) (resp *OrderUpsertResponse,
 		return nil, err
 	}
 
+	m.persistOrder(&upsertResponse.OrderDetails)
+	m.checkLinkedOrders(&upsertResponse.OrderDetails)
+
 	status := "updated"
 	if upsertResponse.IsNewOrder {
 		status = "added"
@@ -797,6 +881,229 @@ func (m *OrderManager) UpsertOrder(od *order.Detail) (resp *OrderUpsertResponse,
 	return upsertResponse, nil
 }
 
+// checkLinkedOrders passes an order update to any order group, iceberg
+// order or futures position tracking the order
+func (m *OrderManager) checkLinkedOrders(od *order.Detail) {
+	m.checkOrderGroups(od)
+	m.checkIcebergOrders(od)
+	m.trackFuturesPosition(od)
+}
+
+// persistOrder writes the order to the database if one is configured.
+// Failures are logged as the in-memory store remains the source of truth
+// while the engine is running
+func (m *OrderManager) persistOrder(od *order.Detail) {
+	if m.orderDB == nil || od == nil {
+		return
+	}
+	if od.ID == "" {
+		// orders cannot be matched to the exchange without an ID
+		return
+	}
+	cpy := od.Copy()
+	err := m.orderDB.Upsert(orderDetailToDBDetails(&cpy))
+	if err != nil {
+		log.Errorf(log.OrderMgr,
+			"Order manager: Unable to persist %s order ID=%v internal ID=%v: %v",
+			cpy.Exchange, cpy.ID, cpy.InternalOrderID, err)
+	}
+}
+
+// loadPersistedOrders loads all orders which were not in a final state when
+// the bot last ran and adds them to the order store
+func (m *OrderManager) loadPersistedOrders() ([]order.Detail, error) {
+	if m.orderDB == nil {
+		return nil, nil
+	}
+	statuses := make([]string, len(inactiveOrderStatuses))
+	for i := range inactiveOrderStatuses {
+		statuses[i] = inactiveOrderStatuses[i].String()
+	}
+	persisted, err := m.orderDB.GetOrdersNotInStatus(statuses...)
+	if err != nil {
+		return nil, err
+	}
+	loaded := make([]order.Detail, 0, len(persisted))
+	for i := range persisted {
+		od, err := dbDetailsToOrderDetail(&persisted[i])
+		if err != nil {
+			log.Errorf(log.OrderMgr,
+				"Order manager: Unable to load persisted %s order ID=%v: %v",
+				persisted[i].Exchange, persisted[i].OrderID, err)
+			continue
+		}
+		if !od.IsActive() {
+			continue
+		}
+		err = m.orderStore.add(od)
+		if err != nil {
+			if !errors.Is(err, ErrOrdersAlreadyExists) {
+				log.Errorf(log.OrderMgr,
+					"Order manager: Unable to load persisted %s order ID=%v: %v",
+					od.Exchange, od.ID, err)
+			}
+			continue
+		}
+		loaded = append(loaded, od.Copy())
+	}
+	if len(loaded) > 0 {
+		log.Infof(log.OrderMgr, "Order manager: Loaded %d persisted order(s).", len(loaded))
+	}
+	return loaded, nil
+}
+
+// reconcilePersistedOrders compares the orders loaded from the database
+// against the active orders of each exchange. Orders which are still open are
+// updated, orders which are no longer open are fetched individually where
+// supported or otherwise marked as closed
+func (m *OrderManager) reconcilePersistedOrders(persisted []order.Detail) {
+	if len(persisted) == 0 {
+		return
+	}
+	type exchangeAsset struct {
+		exchange string
+		asset    asset.Item
+	}
+	grouped := make(map[exchangeAsset][]order.Detail)
+	for i := range persisted {
+		key := exchangeAsset{strings.ToLower(persisted[i].Exchange), persisted[i].AssetType}
+		grouped[key] = append(grouped[key], persisted[i])
+	}
+	for key, orders := range grouped {
+		exch, err := m.orderStore.exchangeManager.GetExchangeByName(key.exchange)
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Order manager: Unable to reconcile orders: %v", err)
+			continue
+		}
+		if !exch.GetAuthenticatedAPISupport(exchange.RestAuthentication) {
+			log.Warnf(log.OrderMgr,
+				"Order manager: Unable to reconcile %d persisted %s %s order(s), authenticated API support disabled",
+				len(orders), exch.GetName(), key.asset)
+			continue
+		}
+		var pairs currency.Pairs
+		for i := range orders {
+			if !pairs.Contains(orders[i].Pair, true) {
+				pairs = append(pairs, orders[i].Pair)
+			}
+		}
+		active, err := exch.GetActiveOrders(context.TODO(), &order.GetOrdersRequest{
+			Side:      order.AnySide,
+			Type:      order.AnyType,
+			Pairs:     pairs,
+			AssetType: key.asset,
+		})
+		if err != nil {
+			log.Errorf(log.OrderMgr,
+				"Order manager: Unable to get active orders for %s and asset type %s: %s",
+				exch.GetName(),
+				key.asset,
+				err)
+			continue
+		}
+		stillActive := make(map[string]bool, len(active))
+		for i := range active {
+			stillActive[active[i].ID] = true
+			_, err = m.UpsertOrder(&active[i])
+			if err != nil {
+				log.Error(log.OrderMgr, err)
+			}
+		}
+		canFetch := exch.GetBase().GetSupportedFeatures().RESTCapabilities.GetOrder
+		for i := range orders {
+			if stillActive[orders[i].ID] {
+				continue
+			}
+			if canFetch {
+				err = m.FetchAndUpdateExchangeOrder(exch, &orders[i], orders[i].AssetType)
+				if err == nil {
+					continue
+				}
+				log.Errorf(log.OrderMgr,
+					"Order manager: Unable to fetch persisted %s order ID=%v: %v",
+					exch.GetName(), orders[i].ID, err)
+			}
+			orders[i].Status = order.Closed
+			orders[i].LastUpdated = time.Now()
+			err = m.UpdateExistingOrder(&orders[i])
+			if err != nil {
+				log.Error(log.OrderMgr, err)
+			}
+		}
+	}
+}
+
+// orderDetailToDBDetails converts an order to its database representation
+func orderDetailToDBDetails(od *order.Detail) *dborder.Details {
+	return &dborder.Details{
+		ID:                   od.InternalOrderID,
+		Exchange:             od.Exchange,
+		OrderID:              od.ID,
+		ClientOrderID:        od.ClientOrderID,
+		AccountID:            od.AccountID,
+		Asset:                od.AssetType.String(),
+		Base:                 od.Pair.Base.String(),
+		Quote:                od.Pair.Quote.String(),
+		Side:                 od.Side.String(),
+		Type:                 od.Type.String(),
+		Status:               od.Status.String(),
+		Price:                od.Price,
+		Amount:               od.Amount,
+		TriggerPrice:         od.TriggerPrice,
+		AverageExecutedPrice: od.AverageExecutedPrice,
+		ExecutedAmount:       od.ExecutedAmount,
+		RemainingAmount:      od.RemainingAmount,
+		Cost:                 od.Cost,
+		Fee:                  od.Fee,
+		Leverage:             od.Leverage,
+		CreatedAt:            od.Date,
+		UpdatedAt:            od.LastUpdated,
+	}
+}
+
+// dbDetailsToOrderDetail converts a database order to an order detail
+func dbDetailsToOrderDetail(d *dborder.Details) (*order.Detail, error) {
+	a, err := asset.New(d.Asset)
+	if err != nil {
+		return nil, err
+	}
+	side, err := order.StringToOrderSide(d.Side)
+	if err != nil {
+		return nil, err
+	}
+	oType, err := order.StringToOrderType(d.Type)
+	if err != nil {
+		return nil, err
+	}
+	status, err := order.StringToOrderStatus(d.Status)
+	if err != nil {
+		return nil, err
+	}
+	return &order.Detail{
+		Exchange:             d.Exchange,
+		InternalOrderID:      d.ID,
+		ID:                   d.OrderID,
+		ClientOrderID:        d.ClientOrderID,
+		AccountID:            d.AccountID,
+		AssetType:            a,
+		Pair:                 currency.NewPair(currency.NewCode(d.Base), currency.NewCode(d.Quote)),
+		Side:                 side,
+		Type:                 oType,
+		Status:               status,
+		Price:                d.Price,
+		Amount:               d.Amount,
+		TriggerPrice:         d.TriggerPrice,
+		AverageExecutedPrice: d.AverageExecutedPrice,
+		ExecutedAmount:       d.ExecutedAmount,
+		RemainingAmount:      d.RemainingAmount,
+		Cost:                 d.Cost,
+		Fee:                  d.Fee,
+		Leverage:             d.Leverage,
+		Date:                 d.CreatedAt,
+		LastUpdated:          d.UpdatedAt,
+	}, nil
+}
+
 // get returns all orders for all exchanges
 // should not be exported as it can have large impact if used improperly
 func (s *store) get() map[string][]*order.Detail {
@@ -1004,6 +1311,26 @@ func (s *store) getFilteredOrders(f *order.Filter) ([]order.Detail, error) {
 	return os, nil
 }
 
+// getSnapshot returns a copy of all orders matching the status, or all orders
+// when the status is empty or any
+func (s *store) getSnapshot(status order.Status) []order.Detail {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	var os []order.Detail
+	for _, e := range s.Orders {
+		for i := range e {
+			if status != e[i].Status &&
+				status != order.AnyStatus &&
+				status != "" {
+				continue
+			}
+			os = append(os, e[i].Copy())
+		}
+	}
+	return os
+}
+
 // getActiveOrders returns copy of the orders that are active
 func (s *store) getActiveOrders(f *order.Filter) []order.Detail {
 	s.m.RLock()
@@ -0,0 +1,104 @@
+package engine
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// trackFuturesPosition applies any new fills of a futures order to its
+// position
+func (m *OrderManager) trackFuturesPosition(od *order.Detail) {
+	if m.positions == nil || od == nil || !od.AssetType.IsFutures() {
+		return
+	}
+	// Market orders are submitted without a price, their fills are applied
+	// once an update with the average executed price is received
+	if od.AverageExecutedPrice == 0 && od.Price == 0 {
+		return
+	}
+	err := m.positions.TrackNewOrder(od)
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Order manager: Exchange %s unable to track %s %s position for order ID=%v: %v",
+			od.Exchange, od.AssetType, od.Pair, od.ID, err)
+	}
+}
+
+// GetFuturesPositions returns futures positions tracked from order fills
+// matching the exchange, asset and pair. Empty values match all positions.
+// Open positions are marked to the latest ticker price when available
+func (m *OrderManager) GetFuturesPositions(exch string, a asset.Item, p currency.Pair) ([]order.PositionStats, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	positions, err := m.positions.GetPositions(exch, a, p)
+	if err != nil {
+		return nil, err
+	}
+	var marked bool
+	for i := range positions {
+		if positions[i].Status != order.Open {
+			continue
+		}
+		t, err := ticker.GetTicker(positions[i].Exchange, positions[i].Pair, positions[i].Asset)
+		if err != nil || t.Last <= 0 {
+			continue
+		}
+		err = m.positions.UpdateMarkPrice(positions[i].Exchange, positions[i].Asset, positions[i].Pair, t.Last, t.LastUpdated)
+		if err != nil {
+			return nil, err
+		}
+		marked = true
+	}
+	if !marked {
+		return positions, nil
+	}
+	return m.positions.GetPositions(exch, a, p)
+}
+
+// AddFuturesFundingPayment records a funding payment for a futures position.
+// When rate is non-zero the payment is calculated from the rate and the
+// latest mark price, otherwise payment is recorded as is. The recorded
+// payment is returned
+func (m *OrderManager) AddFuturesFundingPayment(exch string, a asset.Item, p currency.Pair, payment, rate float64, t time.Time) (float64, error) {
+	if m == nil {
+		return 0, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return 0, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	if t.IsZero() {
+		t = time.Now()
+	}
+	if rate == 0 {
+		return payment, m.positions.AddFundingPayment(exch, a, p, payment, t)
+	}
+	if tick, err := ticker.GetTicker(exch, p, a); err == nil && tick.Last > 0 {
+		err = m.positions.UpdateMarkPrice(exch, a, p, tick.Last, tick.LastUpdated)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return m.positions.ApplyFundingRate(exch, a, p, rate, t)
+}
+
+// SetFuturesContractType sets whether a futures pair is a linear or inverse
+// contract. It must be set before the first fill for the pair is tracked
+func (m *OrderManager) SetFuturesContractType(exch string, a asset.Item, p currency.Pair, ct order.ContractType) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	return m.positions.SetContractType(exch, a, p, ct)
+}
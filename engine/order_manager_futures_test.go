@@ -0,0 +1,97 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+func TestGetFuturesPositions(t *testing.T) {
+	t.Parallel()
+	m, _ := orderGroupsSetup(t)
+	p := currency.NewPair(currency.ETH, currency.USDT)
+	_, err := m.UpsertOrder(&order.Detail{
+		Exchange:  testExchange,
+		ID:        "futures1",
+		Pair:      p,
+		AssetType: asset.USDTMarginedFutures,
+		Side:      order.Buy,
+		Type:      order.Market,
+		Amount:    2,
+		Status:    order.New,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	positions, err := m.GetFuturesPositions(testExchange, asset.USDTMarginedFutures, currency.Pair{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(positions) != 0 {
+		t.Fatalf("received '%v', expected '%v'", len(positions), 0)
+	}
+
+	_, err = m.UpsertOrder(&order.Detail{
+		Exchange:             testExchange,
+		ID:                   "futures1",
+		Pair:                 p,
+		AssetType:            asset.USDTMarginedFutures,
+		Side:                 order.Buy,
+		Type:                 order.Market,
+		Amount:               2,
+		ExecutedAmount:       2,
+		AverageExecutedPrice: 1000,
+		Fee:                  1,
+		Status:               order.Filled,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = ticker.ProcessTicker(&ticker.Price{
+		ExchangeName: testExchange,
+		Pair:         p,
+		AssetType:    asset.USDTMarginedFutures,
+		Last:         1100,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	positions, err = m.GetFuturesPositions(testExchange, asset.USDTMarginedFutures, p)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(positions) != 1 {
+		t.Fatalf("received '%v', expected '%v'", len(positions), 1)
+	}
+	pos := positions[0]
+	if pos.Side != order.Long || pos.Size != 2 || pos.EntryPrice != 1000 || pos.MarkPrice != 1100 {
+		t.Fatalf("received '%+v', unexpected position", pos)
+	}
+	if pos.UnrealisedPNL != 200 || pos.TotalPNL != 199 {
+		t.Errorf("received '%+v', unexpected profit and loss", pos)
+	}
+
+	payment, err := m.AddFuturesFundingPayment(testExchange, asset.USDTMarginedFutures, p, 0, 0.001, time.Time{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if payment != -2.2 {
+		t.Errorf("received '%v', expected '%v'", payment, -2.2)
+	}
+
+	err = m.SetFuturesContractType(testExchange, asset.USDTMarginedFutures, p, order.InverseContract)
+	if err == nil {
+		t.Error("expected error changing contract type of a tracked position")
+	}
+
+	var nilManager *OrderManager
+	_, err = nilManager.GetFuturesPositions("", "", currency.Pair{})
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+}
@@ -0,0 +1,641 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SubmitOCO submits two or more orders as a one-cancels-other group. Once any
+// leg fills, the remaining legs are cancelled
+func (m *OrderManager) SubmitOCO(ctx context.Context, legs ...*order.Submit) (*OrderGroup, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	if len(legs) < 2 {
+		return nil, errOrderGroupLegsRequired
+	}
+	g := &OrderGroup{Type: OneCancelsOther}
+	for i := range legs {
+		if legs[i] == nil {
+			return nil, errNilOrder
+		}
+		g.Legs = append(g.Legs, OrderGroupLeg{
+			Role:   OrderGroupLegOCO,
+			Submit: *legs[i],
+		})
+	}
+	return m.submitOrderGroup(ctx, g)
+}
+
+// SubmitBracket submits an entry order along with a take-profit and stop-loss
+// pair. The exit orders are only submitted once the entry order fills, after
+// which they act as a one-cancels-other group
+func (m *OrderManager) SubmitBracket(ctx context.Context, entry, takeProfit, stopLoss *order.Submit) (*OrderGroup, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	if entry == nil || takeProfit == nil || stopLoss == nil {
+		return nil, errNilOrder
+	}
+	g := &OrderGroup{
+		Type: Bracket,
+		Legs: []OrderGroupLeg{
+			{Role: OrderGroupLegEntry, Submit: *entry},
+			{Role: OrderGroupLegTakeProfit, Submit: *takeProfit},
+			{Role: OrderGroupLegStopLoss, Submit: *stopLoss},
+		},
+	}
+	return m.submitOrderGroup(ctx, g)
+}
+
+// CancelOrderGroup cancels all outstanding legs of an active order group
+func (m *OrderManager) CancelOrderGroup(ctx context.Context, id uuid.UUID) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	m.groupsMtx.Lock()
+	g, ok := m.orderGroups[id]
+	if !ok {
+		m.groupsMtx.Unlock()
+		return fmt.Errorf("%w %v", errOrderGroupNotFound, id)
+	}
+	if g.Status != OrderGroupActive {
+		m.groupsMtx.Unlock()
+		return fmt.Errorf("%w %v status %v", errOrderGroupNotActive, id, g.Status)
+	}
+	g.Status = OrderGroupCancelled
+	g.UpdatedAt = time.Now()
+	toCancel := g.liveLegs(-1)
+	for i := range g.Legs {
+		if g.Legs[i].Status == "" {
+			// prevent legs which have not been submitted from activating
+			g.Legs[i].Status = order.Cancelled
+		}
+	}
+	m.groupsMtx.Unlock()
+
+	m.notifyOrderGroup(id, OrderGroupCancelled)
+	return m.cancelOrderGroupLegs(ctx, id, toCancel)
+}
+
+// GetOrderGroup returns a copy of an order group
+func (m *OrderManager) GetOrderGroup(id uuid.UUID) (*OrderGroup, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	m.groupsMtx.Lock()
+	defer m.groupsMtx.Unlock()
+	g, ok := m.orderGroups[id]
+	if !ok {
+		return nil, fmt.Errorf("%w %v", errOrderGroupNotFound, id)
+	}
+	cpy := g.copy()
+	return &cpy, nil
+}
+
+// GetOrderGroups returns a copy of all order groups, optionally filtered by
+// exchange. Groups which are no longer active are only returned when
+// includeInactive is set
+func (m *OrderManager) GetOrderGroups(exchangeName string, includeInactive bool) ([]OrderGroup, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	m.groupsMtx.Lock()
+	defer m.groupsMtx.Unlock()
+	resp := make([]OrderGroup, 0, len(m.orderGroups))
+	for _, g := range m.orderGroups {
+		if exchangeName != "" && !strings.EqualFold(g.Legs[0].Submit.Exchange, exchangeName) {
+			continue
+		}
+		if !includeInactive && g.Status != OrderGroupActive {
+			continue
+		}
+		resp = append(resp, g.copy())
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].CreatedAt.Before(resp[j].CreatedAt)
+	})
+	return resp, nil
+}
+
+// setConditionalOrderHolder allows order groups to hold stop and take-profit
+// legs via the conditional order manager
+func (m *OrderManager) setConditionalOrderHolder(h iConditionalOrderHolder) {
+	m.groupsMtx.Lock()
+	m.conditionalOrders = h
+	m.groupsMtx.Unlock()
+}
+
+// submitOrderGroup validates and stores an order group before submitting its
+// initial legs. If any initial leg fails, the legs already placed are
+// cancelled
+func (m *OrderManager) submitOrderGroup(ctx context.Context, g *OrderGroup) (*OrderGroup, error) {
+	err := m.validateOrderGroup(g)
+	if err != nil {
+		return nil, err
+	}
+	g.ID, err = uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	g.Status = OrderGroupActive
+	g.CreatedAt = time.Now()
+	g.UpdatedAt = g.CreatedAt
+
+	var initial []int
+	for i := range g.Legs {
+		if g.Legs[i].Role == OrderGroupLegOCO || g.Legs[i].Role == OrderGroupLegEntry {
+			initial = append(initial, i)
+			g.Legs[i].Status = order.Pending
+		}
+	}
+
+	log.Infof(log.OrderMgr, "Order manager: Submitting %s order group ID=%v for %s %s with %d legs",
+		g.Type, g.ID, g.Legs[0].Submit.Exchange, g.Legs[0].Submit.Pair, len(g.Legs))
+
+	m.groupsMtx.Lock()
+	if m.orderGroups == nil {
+		m.orderGroups = make(map[uuid.UUID]*OrderGroup)
+	}
+	m.orderGroups[g.ID] = g
+	m.groupsMtx.Unlock()
+
+	err = m.activateOrderGroupLegs(ctx, g.ID, initial)
+	if err != nil {
+		m.failOrderGroup(ctx, g.ID, err)
+		resp, getErr := m.GetOrderGroup(g.ID)
+		if getErr != nil {
+			return nil, getErr
+		}
+		return resp, err
+	}
+	return m.GetOrderGroup(g.ID)
+}
+
+// validateOrderGroup ensures all legs of an order group can be submitted and
+// are related to each other
+func (m *OrderManager) validateOrderGroup(g *OrderGroup) error {
+	var entry *order.Submit
+	for i := range g.Legs {
+		s := &g.Legs[i].Submit
+		if i > 0 {
+			first := &g.Legs[0].Submit
+			if !strings.EqualFold(s.Exchange, first.Exchange) ||
+				!s.Pair.Equal(first.Pair) ||
+				s.AssetType != first.AssetType {
+				return errOrderGroupLegMismatch
+			}
+		}
+		switch s.Side {
+		case order.Bid:
+			s.Side = order.Buy
+		case order.Ask:
+			s.Side = order.Sell
+		}
+		switch g.Legs[i].Role {
+		case OrderGroupLegEntry:
+			if s.Type != order.Market && s.Type != order.Limit {
+				return fmt.Errorf("%w entry %v", errOrderGroupInvalidLegType, s.Type)
+			}
+			entry = s
+		case OrderGroupLegOCO, OrderGroupLegTakeProfit, OrderGroupLegStopLoss:
+			if s.Type == order.Market {
+				return fmt.Errorf("%w %s %v", errOrderGroupInvalidLegType, g.Legs[i].Role, s.Type)
+			}
+		}
+		err := m.validateOrderGroupLeg(s)
+		if err != nil {
+			return fmt.Errorf("order group leg %d: %w", i, err)
+		}
+	}
+	if entry == nil {
+		return nil
+	}
+	for i := range g.Legs {
+		if g.Legs[i].Role == OrderGroupLegEntry {
+			continue
+		}
+		if g.Legs[i].Submit.Side == entry.Side {
+			return errOrderGroupInvalidExitSide
+		}
+		if g.Legs[i].Submit.Amount > entry.Amount {
+			return errOrderGroupExitAmountTooLarge
+		}
+	}
+	return nil
+}
+
+// validateOrderGroupLeg checks a single leg. Market and limit legs use the
+// standard order manager validation and conditional legs are checked against
+// the conditional order requirements
+func (m *OrderManager) validateOrderGroupLeg(s *order.Submit) error {
+	switch s.Type {
+	case order.Market, order.Limit:
+		return m.validate(s)
+	case order.Stop, order.StopLimit, order.TakeProfit:
+		m.groupsMtx.Lock()
+		holder := m.conditionalOrders
+		m.groupsMtx.Unlock()
+		if holder == nil {
+			return errConditionalOrdersUnavailable
+		}
+		if s.Side != order.Buy && s.Side != order.Sell {
+			return order.ErrSideIsInvalid
+		}
+		if s.Amount <= 0 {
+			return errInvalidConditionalAmount
+		}
+		if s.TriggerPrice <= 0 {
+			return errInvalidTriggerPrice
+		}
+		if s.Type == order.StopLimit && s.Price <= 0 {
+			return errInvalidLimitPrice
+		}
+		return nil
+	}
+	return fmt.Errorf("%w %v", errOrderGroupInvalidLegType, s.Type)
+}
+
+// activateOrderGroupLegs submits legs to the exchange, or to the conditional
+// order manager for stop and take-profit legs
+func (m *OrderManager) activateOrderGroupLegs(ctx context.Context, id uuid.UUID, legs []int) error {
+	for _, i := range legs {
+		m.groupsMtx.Lock()
+		g, ok := m.orderGroups[id]
+		if !ok {
+			m.groupsMtx.Unlock()
+			return fmt.Errorf("%w %v", errOrderGroupNotFound, id)
+		}
+		s := g.Legs[i].Submit
+		holder := m.conditionalOrders
+		m.groupsMtx.Unlock()
+
+		switch s.Type {
+		case order.Stop, order.StopLimit, order.TakeProfit:
+			if holder == nil {
+				m.recordOrderGroupLegError(id, i, errConditionalOrdersUnavailable)
+				return errConditionalOrdersUnavailable
+			}
+			co, err := holder.Add(&ConditionalOrder{
+				Exchange:     s.Exchange,
+				Pair:         s.Pair,
+				AssetType:    s.AssetType,
+				Type:         s.Type,
+				Side:         s.Side,
+				Amount:       s.Amount,
+				TriggerPrice: s.TriggerPrice,
+				LimitPrice:   s.Price,
+				onSubmit:     m.conditionalOrderGroupLegSubmitted(id, i),
+			})
+			if err != nil {
+				m.recordOrderGroupLegError(id, i, err)
+				return fmt.Errorf("order group %v leg %d: %w", id, i, err)
+			}
+			m.groupsMtx.Lock()
+			g.Legs[i].ConditionalOrderID = co.ID
+			g.Legs[i].Status = order.Pending
+			g.UpdatedAt = time.Now()
+			m.groupsMtx.Unlock()
+		default:
+			resp, err := m.Submit(ctx, &s)
+			if err != nil {
+				m.recordOrderGroupLegError(id, i, err)
+				return fmt.Errorf("order group %v leg %d: %w", id, i, err)
+			}
+			m.groupsMtx.Lock()
+			g.Legs[i].OrderID = resp.OrderID
+			g.Legs[i].InternalOrderID = resp.InternalOrderID
+			g.Legs[i].Status = order.New
+			g.UpdatedAt = time.Now()
+			m.groupsMtx.Unlock()
+
+			// the order may have filled before the leg was linked to it
+			od, err := m.orderStore.getByExchangeAndID(s.Exchange, resp.OrderID)
+			if err == nil {
+				cpy := od.Copy()
+				m.checkOrderGroups(&cpy)
+			}
+		}
+	}
+	return nil
+}
+
+// conditionalOrderGroupLegSubmitted returns a callback which links the order
+// submitted by the conditional order manager to the leg. As the child order
+// is placed once the trigger is hit, the remaining legs are cancelled
+func (m *OrderManager) conditionalOrderGroupLegSubmitted(id uuid.UUID, leg int) func(string, error) {
+	return func(orderID string, err error) {
+		if err != nil {
+			m.recordOrderGroupLegError(id, leg, err)
+			m.failOrderGroup(context.TODO(), id, fmt.Errorf("order group %v leg %d: %w", id, leg, err))
+			return
+		}
+		m.groupsMtx.Lock()
+		g, ok := m.orderGroups[id]
+		if !ok {
+			m.groupsMtx.Unlock()
+			return
+		}
+		g.Legs[leg].OrderID = orderID
+		g.Legs[leg].Status = order.New
+		g.UpdatedAt = time.Now()
+		if g.Status != OrderGroupActive {
+			m.groupsMtx.Unlock()
+			return
+		}
+		g.Status = OrderGroupCompleted
+		toCancel := g.liveLegs(leg)
+		m.groupsMtx.Unlock()
+
+		m.notifyOrderGroup(id, OrderGroupCompleted)
+		err = m.cancelOrderGroupLegs(context.TODO(), id, toCancel)
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Order manager: order group %v %v", id, err)
+		}
+	}
+}
+
+// checkOrderGroups processes an order update for any order group leg linked
+// to the order. Fills on an entry leg activate the exit legs, while fills on
+// any other leg cancel the remaining legs
+func (m *OrderManager) checkOrderGroups(od *order.Detail) {
+	if od == nil || od.ID == "" {
+		return
+	}
+	m.groupsMtx.Lock()
+	g, idx := m.findOrderGroupLeg(od.Exchange, od.ID)
+	if g == nil {
+		m.groupsMtx.Unlock()
+		return
+	}
+	leg := &g.Legs[idx]
+	leg.Status = od.Status
+	leg.ExecutedAmount = od.ExecutedAmount
+	if leg.InternalOrderID == "" {
+		leg.InternalOrderID = od.InternalOrderID
+	}
+	g.UpdatedAt = time.Now()
+	if g.Status != OrderGroupActive {
+		m.groupsMtx.Unlock()
+		return
+	}
+
+	filled := od.Status == order.Filled || (od.Amount > 0 && od.ExecutedAmount >= od.Amount)
+	hasFill := filled || od.Status == order.PartiallyFilled || od.ExecutedAmount > 0
+	var toActivate, toCancel []int
+	switch {
+	case leg.Role == OrderGroupLegEntry:
+		switch {
+		case filled:
+			toActivate = g.pendingLegs()
+		case od.IsInactive() && hasFill:
+			// the entry stopped filling part way, only protect the amount
+			// which was filled
+			toActivate = g.pendingLegs()
+			for _, i := range toActivate {
+				if g.Legs[i].Submit.Amount > od.ExecutedAmount {
+					g.Legs[i].Submit.Amount = od.ExecutedAmount
+				}
+			}
+		case od.IsInactive():
+			g.Status = OrderGroupCancelled
+		}
+		for _, i := range toActivate {
+			g.Legs[i].Status = order.Pending
+		}
+	case hasFill:
+		g.Status = OrderGroupCompleted
+		toCancel = g.liveLegs(idx)
+	case od.IsInactive() && len(g.liveLegs(idx)) == 0:
+		g.Status = OrderGroupCancelled
+	}
+	id, status := g.ID, g.Status
+	m.groupsMtx.Unlock()
+
+	if status != OrderGroupActive {
+		m.notifyOrderGroup(id, status)
+	}
+	if len(toActivate) > 0 {
+		err := m.activateOrderGroupLegs(context.TODO(), id, toActivate)
+		if err != nil {
+			m.failOrderGroup(context.TODO(), id, err)
+		}
+	}
+	if len(toCancel) > 0 {
+		err := m.cancelOrderGroupLegs(context.TODO(), id, toCancel)
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Order manager: order group %v %v", id, err)
+		}
+	}
+}
+
+// cancelOrderGroupLegs cancels the supplied legs on the exchange, or with the
+// conditional order manager if the leg has not triggered
+func (m *OrderManager) cancelOrderGroupLegs(ctx context.Context, id uuid.UUID, legs []int) error {
+	var errs common.Errors
+	for _, i := range legs {
+		m.groupsMtx.Lock()
+		g, ok := m.orderGroups[id]
+		if !ok {
+			m.groupsMtx.Unlock()
+			return fmt.Errorf("%w %v", errOrderGroupNotFound, id)
+		}
+		leg := g.Legs[i]
+		holder := m.conditionalOrders
+		m.groupsMtx.Unlock()
+
+		var err error
+		switch {
+		case leg.OrderID == "" && !leg.ConditionalOrderID.IsNil():
+			if holder == nil {
+				err = errConditionalOrdersUnavailable
+				break
+			}
+			err = holder.Cancel(leg.ConditionalOrderID)
+		case leg.OrderID != "":
+			err = m.Cancel(ctx, &order.Cancel{
+				Exchange:  leg.Submit.Exchange,
+				ID:        leg.OrderID,
+				Pair:      leg.Submit.Pair,
+				AssetType: leg.Submit.AssetType,
+				Side:      leg.Submit.Side,
+			})
+		default:
+			continue
+		}
+
+		m.groupsMtx.Lock()
+		if err != nil {
+			g.Legs[i].Error = err.Error()
+			errs = append(errs, fmt.Errorf("leg %d: %w", i, err))
+		} else {
+			g.Legs[i].Status = order.Cancelled
+		}
+		g.UpdatedAt = time.Now()
+		m.groupsMtx.Unlock()
+	}
+	if errs != nil {
+		return errs
+	}
+	return nil
+}
+
+// cancelLinkedOrderGroup cancels the remaining legs of an active order group
+// when one of its exit legs is cancelled through the order manager. A
+// cancelled entry leg is handled by checkOrderGroups
+func (m *OrderManager) cancelLinkedOrderGroup(ctx context.Context, od *order.Detail) {
+	if od == nil || od.ID == "" {
+		return
+	}
+	m.groupsMtx.Lock()
+	g, idx := m.findOrderGroupLeg(od.Exchange, od.ID)
+	if g == nil || g.Status != OrderGroupActive || g.Legs[idx].Role == OrderGroupLegEntry {
+		m.groupsMtx.Unlock()
+		return
+	}
+	id := g.ID
+	m.groupsMtx.Unlock()
+
+	err := m.CancelOrderGroup(ctx, id)
+	if err != nil && !errors.Is(err, errOrderGroupNotActive) {
+		log.Errorf(log.OrderMgr, "Order manager: order group %v %v", id, err)
+	}
+}
+
+// failOrderGroup marks an order group as failed and cancels any legs which
+// remain live
+func (m *OrderManager) failOrderGroup(ctx context.Context, id uuid.UUID, reason error) {
+	m.groupsMtx.Lock()
+	g, ok := m.orderGroups[id]
+	if !ok || g.Status != OrderGroupActive {
+		m.groupsMtx.Unlock()
+		return
+	}
+	g.Status = OrderGroupFailed
+	g.Error = reason.Error()
+	g.UpdatedAt = time.Now()
+	toCancel := g.liveLegs(-1)
+	m.groupsMtx.Unlock()
+
+	m.notifyOrderGroup(id, OrderGroupFailed)
+	err := m.cancelOrderGroupLegs(ctx, id, toCancel)
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Order manager: order group %v %v", id, err)
+	}
+}
+
+// recordOrderGroupLegError stores a leg submission error against the leg
+func (m *OrderManager) recordOrderGroupLegError(id uuid.UUID, leg int, err error) {
+	m.groupsMtx.Lock()
+	defer m.groupsMtx.Unlock()
+	g, ok := m.orderGroups[id]
+	if !ok {
+		return
+	}
+	g.Legs[leg].Status = order.Rejected
+	g.Legs[leg].Error = err.Error()
+	g.UpdatedAt = time.Now()
+}
+
+// notifyOrderGroup logs and pushes a communications event when an order group
+// changes status
+func (m *OrderManager) notifyOrderGroup(id uuid.UUID, status OrderGroupStatus) {
+	msg := fmt.Sprintf("Order manager: order group ID=%v status=%v.", id, status)
+	if status == OrderGroupFailed {
+		log.Errorln(log.OrderMgr, msg)
+	} else {
+		log.Infoln(log.OrderMgr, msg)
+	}
+	m.orderStore.commsManager.PushEvent(base.Event{
+		Type:    "order",
+		Message: msg,
+	})
+}
+
+// findOrderGroupLeg returns the group and leg index linked to an exchange
+// order. Must be called with the lock held
+func (m *OrderManager) findOrderGroupLeg(exchangeName, orderID string) (*OrderGroup, int) {
+	for _, g := range m.orderGroups {
+		for i := range g.Legs {
+			if g.Legs[i].OrderID == orderID &&
+				strings.EqualFold(g.Legs[i].Submit.Exchange, exchangeName) {
+				return g, i
+			}
+		}
+	}
+	return nil, -1
+}
+
+// pendingLegs returns the legs which have not yet been submitted
+func (g *OrderGroup) pendingLegs() []int {
+	var resp []int
+	for i := range g.Legs {
+		if g.Legs[i].Status == "" {
+			resp = append(resp, i)
+		}
+	}
+	return resp
+}
+
+// liveLegs returns the legs which are placed on the exchange or held by the
+// conditional order manager, excluding the supplied leg
+func (g *OrderGroup) liveLegs(exclude int) []int {
+	var resp []int
+	for i := range g.Legs {
+		if i == exclude {
+			continue
+		}
+		if g.Legs[i].OrderID == "" && g.Legs[i].ConditionalOrderID.IsNil() {
+			continue
+		}
+		if isInactiveOrderStatus(g.Legs[i].Status) {
+			continue
+		}
+		resp = append(resp, i)
+	}
+	return resp
+}
+
+// copy returns a copy of the order group which does not share its legs
+func (g *OrderGroup) copy() OrderGroup {
+	cpy := *g
+	cpy.Legs = make([]OrderGroupLeg, len(g.Legs))
+	copy(cpy.Legs, g.Legs)
+	return cpy
+}
+
+// isInactiveOrderStatus returns whether an order status cannot move on
+func isInactiveOrderStatus(s order.Status) bool {
+	for i := range inactiveOrderStatuses {
+		if s == inactiveOrderStatuses[i] {
+			return true
+		}
+	}
+	return false
+}
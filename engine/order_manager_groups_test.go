@@ -0,0 +1,370 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// ogfExchange aka order group fake exchange places every order it receives
+type ogfExchange struct {
+	omfExchange
+	orders *int64
+}
+
+func (f ogfExchange) SubmitOrder(_ context.Context, _ *order.Submit) (order.SubmitResponse, error) {
+	return order.SubmitResponse{
+		IsOrderPlaced: true,
+		OrderID:       "group" + strconv.FormatInt(atomic.AddInt64(f.orders, 1), 10),
+	}, nil
+}
+
+func (f ogfExchange) CanTradePair(_ currency.Pair, _ asset.Item) error {
+	return nil
+}
+
+// fakeConditionalHolder stores conditional order group legs without
+// monitoring prices
+type fakeConditionalHolder struct {
+	m         sync.Mutex
+	added     []ConditionalOrder
+	cancelled []uuid.UUID
+}
+
+func (f *fakeConditionalHolder) Add(o *ConditionalOrder) (*ConditionalOrder, error) {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	cpy := *o
+	cpy.ID = id
+	f.m.Lock()
+	f.added = append(f.added, cpy)
+	f.m.Unlock()
+	return &cpy, nil
+}
+
+func (f *fakeConditionalHolder) Cancel(id uuid.UUID) error {
+	f.m.Lock()
+	f.cancelled = append(f.cancelled, id)
+	f.m.Unlock()
+	return nil
+}
+
+func orderGroupsSetup(t *testing.T) (*OrderManager, *fakeConditionalHolder) {
+	t.Helper()
+	var wg sync.WaitGroup
+	em := SetupExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	em.Add(ogfExchange{
+		omfExchange: omfExchange{IBotExchange: exch},
+		orders:      new(int64),
+	})
+	m, err := SetupOrderManager(em, &CommunicationManager{}, nil, &wg, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	m.started = 1
+	h := &fakeConditionalHolder{}
+	m.setConditionalOrderHolder(h)
+	return m, h
+}
+
+func orderGroupLeg(side order.Side, orderType order.Type, amount, price, trigger float64) *order.Submit {
+	return &order.Submit{
+		Exchange:     testExchange,
+		Pair:         currency.NewPair(currency.BTC, currency.USD),
+		AssetType:    asset.Spot,
+		Side:         side,
+		Type:         orderType,
+		Amount:       amount,
+		Price:        price,
+		TriggerPrice: trigger,
+	}
+}
+
+func fillOrderGroupLeg(t *testing.T, m *OrderManager, orderID string, status order.Status, executed float64) {
+	t.Helper()
+	od, err := m.GetByExchangeAndID(testExchange, orderID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	od.Status = status
+	od.ExecutedAmount = executed
+	err = m.UpdateExistingOrder(od)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+}
+
+func TestSubmitOrderGroupValidation(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	_, err := m.SubmitOCO(context.Background())
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+	m, _ = orderGroupsSetup(t)
+	_, err = m.SubmitOCO(context.Background(), orderGroupLeg(order.Sell, order.Limit, 1, 100, 0))
+	if !errors.Is(err, errOrderGroupLegsRequired) {
+		t.Errorf("error '%v', expected '%v'", err, errOrderGroupLegsRequired)
+	}
+	_, err = m.SubmitOCO(context.Background(), orderGroupLeg(order.Sell, order.Limit, 1, 100, 0), nil)
+	if !errors.Is(err, errNilOrder) {
+		t.Errorf("error '%v', expected '%v'", err, errNilOrder)
+	}
+	mismatch := orderGroupLeg(order.Sell, order.Limit, 1, 100, 0)
+	mismatch.AssetType = asset.Margin
+	_, err = m.SubmitOCO(context.Background(), orderGroupLeg(order.Sell, order.Limit, 1, 100, 0), mismatch)
+	if !errors.Is(err, errOrderGroupLegMismatch) {
+		t.Errorf("error '%v', expected '%v'", err, errOrderGroupLegMismatch)
+	}
+	_, err = m.SubmitOCO(context.Background(), orderGroupLeg(order.Sell, order.Limit, 1, 100, 0), orderGroupLeg(order.Sell, order.Market, 1, 0, 0))
+	if !errors.Is(err, errOrderGroupInvalidLegType) {
+		t.Errorf("error '%v', expected '%v'", err, errOrderGroupInvalidLegType)
+	}
+	_, err = m.SubmitOCO(context.Background(), orderGroupLeg(order.Sell, order.Limit, 1, 100, 0), orderGroupLeg(order.Sell, order.Stop, 1, 0, 0))
+	if !errors.Is(err, errInvalidTriggerPrice) {
+		t.Errorf("error '%v', expected '%v'", err, errInvalidTriggerPrice)
+	}
+	_, err = m.SubmitBracket(context.Background(),
+		orderGroupLeg(order.Buy, order.Limit, 1, 100, 0),
+		orderGroupLeg(order.Buy, order.Limit, 1, 110, 0),
+		orderGroupLeg(order.Sell, order.Stop, 1, 0, 90))
+	if !errors.Is(err, errOrderGroupInvalidExitSide) {
+		t.Errorf("error '%v', expected '%v'", err, errOrderGroupInvalidExitSide)
+	}
+	_, err = m.SubmitBracket(context.Background(),
+		orderGroupLeg(order.Buy, order.Limit, 1, 100, 0),
+		orderGroupLeg(order.Sell, order.Limit, 2, 110, 0),
+		orderGroupLeg(order.Sell, order.Stop, 1, 0, 90))
+	if !errors.Is(err, errOrderGroupExitAmountTooLarge) {
+		t.Errorf("error '%v', expected '%v'", err, errOrderGroupExitAmountTooLarge)
+	}
+
+	m.setConditionalOrderHolder(nil)
+	_, err = m.SubmitOCO(context.Background(), orderGroupLeg(order.Sell, order.Limit, 1, 100, 0), orderGroupLeg(order.Sell, order.Stop, 1, 0, 90))
+	if !errors.Is(err, errConditionalOrdersUnavailable) {
+		t.Errorf("error '%v', expected '%v'", err, errConditionalOrdersUnavailable)
+	}
+}
+
+func TestSubmitOCO(t *testing.T) {
+	t.Parallel()
+	m, h := orderGroupsSetup(t)
+	g, err := m.SubmitOCO(context.Background(),
+		orderGroupLeg(order.Sell, order.Limit, 1, 110, 0),
+		orderGroupLeg(order.Sell, order.Limit, 1, 120, 0),
+		orderGroupLeg(order.Sell, order.Stop, 1, 0, 90))
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if g.Status != OrderGroupActive {
+		t.Fatalf("received '%v', expected '%v'", g.Status, OrderGroupActive)
+	}
+	if g.Legs[0].OrderID == "" || g.Legs[1].OrderID == "" {
+		t.Fatal("expected limit legs to be placed")
+	}
+	if g.Legs[2].ConditionalOrderID.IsNil() || len(h.added) != 1 {
+		t.Fatal("expected stop leg to be held as a conditional order")
+	}
+
+	fillOrderGroupLeg(t, m, g.Legs[0].OrderID, order.PartiallyFilled, 0.5)
+
+	g, err = m.GetOrderGroup(g.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if g.Status != OrderGroupCompleted {
+		t.Errorf("received '%v', expected '%v'", g.Status, OrderGroupCompleted)
+	}
+	if g.Legs[0].Status != order.PartiallyFilled || g.Legs[0].ExecutedAmount != 0.5 {
+		t.Errorf("unexpected filled leg %+v", g.Legs[0])
+	}
+	if g.Legs[1].Status != order.Cancelled {
+		t.Errorf("received '%v', expected '%v'", g.Legs[1].Status, order.Cancelled)
+	}
+	if g.Legs[2].Status != order.Cancelled || len(h.cancelled) != 1 || h.cancelled[0] != g.Legs[2].ConditionalOrderID {
+		t.Errorf("expected conditional leg to be cancelled %+v", g.Legs[2])
+	}
+
+	active, err := m.GetOrderGroups(testExchange, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(active) != 0 {
+		t.Errorf("received '%v', expected '%v'", len(active), 0)
+	}
+}
+
+func TestSubmitBracket(t *testing.T) {
+	t.Parallel()
+	m, h := orderGroupsSetup(t)
+	g, err := m.SubmitBracket(context.Background(),
+		orderGroupLeg(order.Buy, order.Limit, 2, 100, 0),
+		orderGroupLeg(order.Sell, order.Limit, 2, 120, 0),
+		orderGroupLeg(order.Sell, order.Stop, 2, 0, 90))
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if g.Legs[0].OrderID == "" {
+		t.Fatal("expected entry to be placed")
+	}
+	if g.Legs[1].Status != "" || g.Legs[2].Status != "" {
+		t.Fatal("expected exits to wait for the entry to fill")
+	}
+
+	// entry stops filling part way, exits are sized to the filled amount
+	fillOrderGroupLeg(t, m, g.Legs[0].OrderID, order.PartiallyCancelled, 1.5)
+	g, err = m.GetOrderGroup(g.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if g.Status != OrderGroupActive {
+		t.Fatalf("received '%v', expected '%v'", g.Status, OrderGroupActive)
+	}
+	if g.Legs[1].OrderID == "" || g.Legs[1].Submit.Amount != 1.5 {
+		t.Errorf("expected take-profit to be placed for 1.5 %+v", g.Legs[1])
+	}
+	if len(h.added) != 1 || h.added[0].Amount != 1.5 || h.added[0].TriggerPrice != 90 {
+		t.Fatalf("expected stop-loss to be held for 1.5 %+v", h.added)
+	}
+
+	// stop-loss triggers and the take-profit is cancelled
+	h.added[0].onSubmit("stopLossOrder", nil)
+	g, err = m.GetOrderGroup(g.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if g.Status != OrderGroupCompleted {
+		t.Errorf("received '%v', expected '%v'", g.Status, OrderGroupCompleted)
+	}
+	if g.Legs[2].OrderID != "stopLossOrder" {
+		t.Errorf("received '%v', expected '%v'", g.Legs[2].OrderID, "stopLossOrder")
+	}
+	if g.Legs[1].Status != order.Cancelled {
+		t.Errorf("received '%v', expected '%v'", g.Legs[1].Status, order.Cancelled)
+	}
+}
+
+func TestBracketEntryCancelled(t *testing.T) {
+	t.Parallel()
+	m, h := orderGroupsSetup(t)
+	g, err := m.SubmitBracket(context.Background(),
+		orderGroupLeg(order.Buy, order.Limit, 1, 100, 0),
+		orderGroupLeg(order.Sell, order.Limit, 1, 120, 0),
+		orderGroupLeg(order.Sell, order.Stop, 1, 0, 90))
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	fillOrderGroupLeg(t, m, g.Legs[0].OrderID, order.Cancelled, 0)
+	g, err = m.GetOrderGroup(g.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if g.Status != OrderGroupCancelled {
+		t.Errorf("received '%v', expected '%v'", g.Status, OrderGroupCancelled)
+	}
+	if len(h.added) != 0 || g.Legs[1].OrderID != "" {
+		t.Error("expected exits not to be submitted")
+	}
+}
+
+func TestCancelOrderGroup(t *testing.T) {
+	t.Parallel()
+	m, _ := orderGroupsSetup(t)
+	err := m.CancelOrderGroup(context.Background(), uuid.Nil)
+	if !errors.Is(err, errOrderGroupNotFound) {
+		t.Errorf("error '%v', expected '%v'", err, errOrderGroupNotFound)
+	}
+	g, err := m.SubmitBracket(context.Background(),
+		orderGroupLeg(order.Buy, order.Limit, 1, 100, 0),
+		orderGroupLeg(order.Sell, order.Limit, 1, 120, 0),
+		orderGroupLeg(order.Sell, order.Stop, 1, 0, 90))
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.CancelOrderGroup(context.Background(), g.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.CancelOrderGroup(context.Background(), g.ID)
+	if !errors.Is(err, errOrderGroupNotActive) {
+		t.Errorf("error '%v', expected '%v'", err, errOrderGroupNotActive)
+	}
+	g, err = m.GetOrderGroup(g.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	for i := range g.Legs {
+		if g.Legs[i].Status != order.Cancelled {
+			t.Errorf("leg %d received '%v', expected '%v'", i, g.Legs[i].Status, order.Cancelled)
+		}
+	}
+
+	// a late fill on the entry must not activate the exits
+	fillOrderGroupLeg(t, m, g.Legs[0].OrderID, order.Filled, 1)
+	g, err = m.GetOrderGroup(g.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if g.Status != OrderGroupCancelled || g.Legs[1].OrderID != "" {
+		t.Errorf("unexpected group state %+v", g)
+	}
+}
+
+func TestCancelOrderGroupLeg(t *testing.T) {
+	t.Parallel()
+	m, h := orderGroupsSetup(t)
+	g, err := m.SubmitOCO(context.Background(),
+		orderGroupLeg(order.Sell, order.Limit, 1, 110, 0),
+		orderGroupLeg(order.Sell, order.Limit, 1, 120, 0),
+		orderGroupLeg(order.Sell, order.Stop, 1, 0, 90))
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.Cancel(context.Background(), &order.Cancel{
+		Exchange:  testExchange,
+		ID:        g.Legs[0].OrderID,
+		Pair:      g.Legs[0].Submit.Pair,
+		AssetType: g.Legs[0].Submit.AssetType,
+		Side:      g.Legs[0].Submit.Side,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	g, err = m.GetOrderGroup(g.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if g.Status != OrderGroupCancelled {
+		t.Errorf("received '%v', expected '%v'", g.Status, OrderGroupCancelled)
+	}
+	for i := range g.Legs {
+		if g.Legs[i].Status != order.Cancelled {
+			t.Errorf("leg %d received '%v', expected '%v'", i, g.Legs[i].Status, order.Cancelled)
+		}
+	}
+	od, err := m.GetByExchangeAndID(testExchange, g.Legs[1].OrderID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if od.Status != order.Cancelled {
+		t.Errorf("received '%v', expected '%v'", od.Status, order.Cancelled)
+	}
+	if len(h.cancelled) != 1 || h.cancelled[0] != g.Legs[2].ConditionalOrderID {
+		t.Error("expected conditional leg to be cancelled")
+	}
+}
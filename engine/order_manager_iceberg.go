@@ -0,0 +1,543 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SubmitIceberg submits an iceberg order which keeps a visible clip on the
+// book and replenishes it from the remaining amount each time a clip fills
+func (m *OrderManager) SubmitIceberg(ctx context.Context, o *IcebergOrder) (*IcebergOrder, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	if o == nil {
+		return nil, errNilOrder
+	}
+	cpy := o.copy()
+	cpy.Clips = nil
+	err := m.validateIceberg(&cpy)
+	if err != nil {
+		return nil, err
+	}
+	cpy.ID, err = uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	cpy.Status = IcebergActive
+	cpy.CreatedAt = time.Now()
+	cpy.UpdatedAt = cpy.CreatedAt
+	cpy.calculateProgress()
+
+	log.Infof(log.OrderMgr, "Order manager: Submitting iceberg order ID=%v for %s %s %s amount=%v visible=%v price=%v",
+		cpy.ID, cpy.Submit.Exchange, cpy.Submit.Pair, cpy.Submit.Side, cpy.Submit.Amount, cpy.VisibleAmount, cpy.Submit.Price)
+
+	m.icebergMtx.Lock()
+	if m.icebergOrders == nil {
+		m.icebergOrders = make(map[uuid.UUID]*IcebergOrder)
+	}
+	m.icebergOrders[cpy.ID] = &cpy
+	m.icebergMtx.Unlock()
+
+	err = m.replenishIceberg(ctx, cpy.ID)
+	resp, getErr := m.GetIcebergOrder(cpy.ID)
+	if getErr != nil {
+		return nil, getErr
+	}
+	return resp, err
+}
+
+// CancelIcebergOrder cancels an active iceberg order and its visible clip
+func (m *OrderManager) CancelIcebergOrder(ctx context.Context, id uuid.UUID) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	m.icebergMtx.Lock()
+	o, ok := m.icebergOrders[id]
+	if !ok {
+		m.icebergMtx.Unlock()
+		return fmt.Errorf("%w %v", errIcebergOrderNotFound, id)
+	}
+	if o.Status != IcebergActive {
+		m.icebergMtx.Unlock()
+		return fmt.Errorf("%w %v status %v", errIcebergOrderNotActive, id, o.Status)
+	}
+	o.Status = IcebergCancelled
+	o.UpdatedAt = time.Now()
+	live := o.liveClip()
+	var clip IcebergClip
+	if live >= 0 {
+		clip = o.Clips[live]
+	}
+	s := o.Submit
+	m.icebergMtx.Unlock()
+
+	m.notifyIceberg(id, IcebergCancelled)
+	if live < 0 || clip.OrderID == "" {
+		return nil
+	}
+	err := m.Cancel(ctx, &order.Cancel{
+		Exchange:  s.Exchange,
+		ID:        clip.OrderID,
+		Pair:      s.Pair,
+		AssetType: s.AssetType,
+		Side:      s.Side,
+	})
+	if err != nil {
+		m.icebergMtx.Lock()
+		o.Clips[live].Error = err.Error()
+		m.icebergMtx.Unlock()
+		return fmt.Errorf("iceberg order %v clip %d: %w", id, live, err)
+	}
+	return nil
+}
+
+// GetIcebergOrder returns a copy of an iceberg order and its clips
+func (m *OrderManager) GetIcebergOrder(id uuid.UUID) (*IcebergOrder, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	m.icebergMtx.Lock()
+	defer m.icebergMtx.Unlock()
+	o, ok := m.icebergOrders[id]
+	if !ok {
+		return nil, fmt.Errorf("%w %v", errIcebergOrderNotFound, id)
+	}
+	cpy := o.copy()
+	return &cpy, nil
+}
+
+// GetIcebergOrders returns a copy of all iceberg orders, optionally filtered by
+// exchange. Orders which are no longer active are only returned when
+// includeInactive is set
+func (m *OrderManager) GetIcebergOrders(exchangeName string, includeInactive bool) ([]IcebergOrder, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	m.icebergMtx.Lock()
+	defer m.icebergMtx.Unlock()
+	resp := make([]IcebergOrder, 0, len(m.icebergOrders))
+	for _, o := range m.icebergOrders {
+		if exchangeName != "" && !strings.EqualFold(o.Submit.Exchange, exchangeName) {
+			continue
+		}
+		if !includeInactive && o.Status != IcebergActive {
+			continue
+		}
+		resp = append(resp, o.copy())
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].CreatedAt.Before(resp[j].CreatedAt)
+	})
+	return resp, nil
+}
+
+// validateIceberg checks an iceberg order and ensures its visible amount can
+// be placed within the exchange order execution limits
+func (m *OrderManager) validateIceberg(o *IcebergOrder) error {
+	switch o.Submit.Side {
+	case order.Bid:
+		o.Submit.Side = order.Buy
+	case order.Ask:
+		o.Submit.Side = order.Sell
+	}
+	if o.Submit.Type != order.Limit {
+		return fmt.Errorf("%w received %v", errIcebergLimitOrderRequired, o.Submit.Type)
+	}
+	err := m.validate(&o.Submit)
+	if err != nil {
+		return err
+	}
+	if o.VisibleAmount <= 0 || o.VisibleAmount >= o.Submit.Amount {
+		return errIcebergInvalidVisible
+	}
+	if o.AmountVariance < 0 || o.AmountVariance >= 1 {
+		return errIcebergInvalidAmountVar
+	}
+	if o.PriceVariance < 0 || o.PriceVariance >= o.Submit.Price {
+		return errIcebergInvalidPriceVar
+	}
+	limits, err := m.icebergLimits(o.Submit.Exchange, &o.Submit)
+	if err != nil {
+		return err
+	}
+	clip := limits.ConformToAmount(o.VisibleAmount)
+	if clip <= 0 {
+		return fmt.Errorf("%w visible amount %v", errIcebergClipOutsideLimits, o.VisibleAmount)
+	}
+	err = limits.Conforms(limits.ConformToPrice(o.Submit.Price), clip, order.Limit)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errIcebergClipOutsideLimits, err)
+	}
+	return nil
+}
+
+// icebergLimits returns the order execution limits for an iceberg order. A
+// nil limit is returned when the exchange has no limits loaded
+func (m *OrderManager) icebergLimits(exchangeName string, s *order.Submit) (*order.Limits, error) {
+	exch, err := m.orderStore.exchangeManager.GetExchangeByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	limits, err := exch.GetOrderExecutionLimits(s.AssetType, s.Pair)
+	if err != nil && !errors.Is(err, order.ErrExchangeLimitNotLoaded) {
+		return nil, err
+	}
+	return limits, nil
+}
+
+// replenishIceberg submits the next visible clip of an iceberg order. Clip
+// amounts and prices are randomised within the configured variance and
+// conformed to the exchange step sizes. A remainder too small to be submitted
+// on its own is included in the final clip
+func (m *OrderManager) replenishIceberg(ctx context.Context, id uuid.UUID) error {
+	m.icebergMtx.Lock()
+	o, ok := m.icebergOrders[id]
+	if !ok {
+		m.icebergMtx.Unlock()
+		return fmt.Errorf("%w %v", errIcebergOrderNotFound, id)
+	}
+	if o.Status != IcebergActive || o.liveClip() >= 0 {
+		m.icebergMtx.Unlock()
+		return nil
+	}
+	s := o.Submit
+	remaining := o.RemainingAmount
+	visible, amountVariance, priceVariance := o.VisibleAmount, o.AmountVariance, o.PriceVariance
+	m.icebergMtx.Unlock()
+
+	limits, err := m.icebergLimits(s.Exchange, &s)
+	if err != nil {
+		m.failIceberg(id, err)
+		return err
+	}
+	price := limits.ConformToPrice(randomise(s.Price, priceVariance))
+	if price <= 0 {
+		price = limits.ConformToPrice(s.Price)
+	}
+	amount := limits.ConformToAmount(randomise(visible, visible*amountVariance))
+	if amount <= 0 {
+		amount = limits.ConformToAmount(visible)
+	}
+	leftover, _ := decimal.NewFromFloat(remaining).Sub(decimal.NewFromFloat(amount)).Float64()
+	leftover = limits.ConformToAmount(leftover)
+	if amount >= remaining || leftover <= 0 || limits.Conforms(price, leftover, order.Limit) != nil {
+		amount = limits.ConformToAmount(remaining)
+	}
+	if amount <= 0 || limits.Conforms(price, amount, order.Limit) != nil {
+		// the remainder cannot be placed so the order is as complete as the
+		// exchange allows
+		m.completeIceberg(id)
+		return nil
+	}
+
+	m.icebergMtx.Lock()
+	if o.Status != IcebergActive {
+		m.icebergMtx.Unlock()
+		return nil
+	}
+	o.Clips = append(o.Clips, IcebergClip{
+		Amount:      amount,
+		Price:       price,
+		Status:      order.Pending,
+		SubmittedAt: time.Now(),
+	})
+	clip := len(o.Clips) - 1
+	m.icebergMtx.Unlock()
+
+	s.Amount = amount
+	s.Price = price
+	resp, err := m.Submit(ctx, &s)
+	if err != nil {
+		m.icebergMtx.Lock()
+		o.Clips[clip].Status = order.Rejected
+		o.Clips[clip].Error = err.Error()
+		m.icebergMtx.Unlock()
+		err = fmt.Errorf("iceberg order %v clip %d: %w", id, clip, err)
+		m.failIceberg(id, err)
+		return err
+	}
+	m.icebergMtx.Lock()
+	o.Clips[clip].OrderID = resp.OrderID
+	o.Clips[clip].InternalOrderID = resp.InternalOrderID
+	o.Clips[clip].Status = order.New
+	o.UpdatedAt = time.Now()
+	cancelled := o.Status != IcebergActive
+	m.icebergMtx.Unlock()
+
+	if m.verbose {
+		log.Debugf(log.OrderMgr, "Order manager: iceberg order ID=%v clip %d submitted order ID=%v amount=%v price=%v",
+			id, clip, resp.OrderID, amount, price)
+	}
+	if cancelled {
+		// the iceberg was cancelled while the clip was being submitted
+		return m.Cancel(ctx, &order.Cancel{
+			Exchange:  s.Exchange,
+			ID:        resp.OrderID,
+			Pair:      s.Pair,
+			AssetType: s.AssetType,
+			Side:      s.Side,
+		})
+	}
+
+	// the clip may have filled before it was linked to the iceberg
+	od, err := m.orderStore.getByExchangeAndID(s.Exchange, resp.OrderID)
+	if err == nil {
+		cpy := od.Copy()
+		m.checkIcebergOrders(&cpy)
+	}
+	return nil
+}
+
+// checkIcebergOrders processes an order update for any iceberg clip linked to
+// the order. A filled clip is replenished from the remaining amount, while a
+// clip cancelled or rejected on the exchange ends the iceberg order
+func (m *OrderManager) checkIcebergOrders(od *order.Detail) {
+	if od == nil || od.ID == "" {
+		return
+	}
+	m.icebergMtx.Lock()
+	o, idx := m.findIcebergClip(od.Exchange, od.ID)
+	if o == nil {
+		m.icebergMtx.Unlock()
+		return
+	}
+	clip := &o.Clips[idx]
+	wasLive := !isInactiveOrderStatus(clip.Status)
+	clip.Status = od.Status
+	if od.ExecutedAmount > 0 {
+		clip.ExecutedAmount = od.ExecutedAmount
+	}
+	filled := od.Status == order.Filled || (od.Amount > 0 && od.ExecutedAmount >= od.Amount)
+	if filled && clip.ExecutedAmount == 0 {
+		clip.ExecutedAmount = clip.Amount
+	}
+	if od.AverageExecutedPrice > 0 {
+		clip.AverageExecutedPrice = od.AverageExecutedPrice
+	} else if clip.ExecutedAmount > 0 && clip.AverageExecutedPrice == 0 {
+		clip.AverageExecutedPrice = clip.Price
+	}
+	if clip.InternalOrderID == "" {
+		clip.InternalOrderID = od.InternalOrderID
+	}
+	executedBefore := o.ExecutedAmount
+	o.calculateProgress()
+	o.UpdatedAt = time.Now()
+	id, status := o.ID, o.Status
+	progress := o.ExecutedAmount != executedBefore
+	executed, total, average := o.ExecutedAmount, o.Submit.Amount, o.AverageExecutedPrice
+	var replenish bool
+	if status == IcebergActive && wasLive && (filled || od.IsInactive()) {
+		switch {
+		case filled:
+			replenish = true
+		case clip.Status == order.Cancelled || clip.Status == order.PartiallyCancelled:
+			o.Status = IcebergCancelled
+		default:
+			o.Status = IcebergFailed
+			o.Error = fmt.Sprintf("clip %d order ID=%v status %v", idx, od.ID, od.Status)
+		}
+	}
+	if status == IcebergActive && filled && o.RemainingAmount <= 0 {
+		o.Status = IcebergCompleted
+		replenish = false
+	}
+	newStatus := o.Status
+	m.icebergMtx.Unlock()
+
+	if progress {
+		msg := fmt.Sprintf("Order manager: iceberg order ID=%v executed %v of %v at average price %v.",
+			id, executed, total, average)
+		log.Infoln(log.OrderMgr, msg)
+		m.orderStore.commsManager.PushEvent(base.Event{
+			Type:    "order",
+			Message: msg,
+		})
+	}
+	if newStatus != status {
+		m.notifyIceberg(id, newStatus)
+	}
+	if replenish {
+		err := m.replenishIceberg(context.TODO(), id)
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Order manager: iceberg order %v %v", id, err)
+		}
+	}
+}
+
+// completeIceberg marks an active iceberg order as completed
+func (m *OrderManager) completeIceberg(id uuid.UUID) {
+	m.icebergMtx.Lock()
+	o, ok := m.icebergOrders[id]
+	if !ok || o.Status != IcebergActive {
+		m.icebergMtx.Unlock()
+		return
+	}
+	o.Status = IcebergCompleted
+	o.UpdatedAt = time.Now()
+	m.icebergMtx.Unlock()
+	m.notifyIceberg(id, IcebergCompleted)
+}
+
+// failIceberg marks an active iceberg order as failed
+func (m *OrderManager) failIceberg(id uuid.UUID, reason error) {
+	m.icebergMtx.Lock()
+	o, ok := m.icebergOrders[id]
+	if !ok || o.Status != IcebergActive {
+		m.icebergMtx.Unlock()
+		return
+	}
+	o.Status = IcebergFailed
+	o.Error = reason.Error()
+	o.UpdatedAt = time.Now()
+	m.icebergMtx.Unlock()
+	m.notifyIceberg(id, IcebergFailed)
+}
+
+// notifyIceberg logs and pushes a communications event when an iceberg order
+// changes status
+func (m *OrderManager) notifyIceberg(id uuid.UUID, status IcebergStatus) {
+	msg := fmt.Sprintf("Order manager: iceberg order ID=%v status=%v.", id, status)
+	if status == IcebergFailed {
+		log.Errorln(log.OrderMgr, msg)
+	} else {
+		log.Infoln(log.OrderMgr, msg)
+	}
+	m.orderStore.commsManager.PushEvent(base.Event{
+		Type:    "order",
+		Message: msg,
+	})
+}
+
+// findIcebergClip returns the iceberg order and clip index linked to an
+// exchange order. Must be called with the lock held
+func (m *OrderManager) findIcebergClip(exchangeName, orderID string) (*IcebergOrder, int) {
+	for _, o := range m.icebergOrders {
+		for i := range o.Clips {
+			if o.Clips[i].OrderID == orderID &&
+				strings.EqualFold(o.Submit.Exchange, exchangeName) {
+				return o, i
+			}
+		}
+	}
+	return nil, -1
+}
+
+// Detail returns the aggregate progress of the iceberg order as a single
+// logical order
+func (o *IcebergOrder) Detail() order.Detail {
+	d := order.Detail{
+		HiddenOrder:          true,
+		Price:                o.Submit.Price,
+		Amount:               o.Submit.Amount,
+		AverageExecutedPrice: o.AverageExecutedPrice,
+		ExecutedAmount:       o.ExecutedAmount,
+		RemainingAmount:      o.RemainingAmount,
+		Cost:                 o.ExecutedAmount * o.AverageExecutedPrice,
+		Exchange:             o.Submit.Exchange,
+		InternalOrderID:      o.ID.String(),
+		ClientOrderID:        o.Submit.ClientOrderID,
+		ClientID:             o.Submit.ClientID,
+		Type:                 o.Submit.Type,
+		Side:                 o.Submit.Side,
+		AssetType:            o.Submit.AssetType,
+		Pair:                 o.Submit.Pair,
+		Date:                 o.CreatedAt,
+		LastUpdated:          o.UpdatedAt,
+	}
+	switch o.Status {
+	case IcebergActive:
+		d.Status = order.Active
+		if o.ExecutedAmount > 0 {
+			d.Status = order.PartiallyFilled
+		}
+	case IcebergCompleted:
+		d.Status = order.Filled
+		d.CloseTime = o.UpdatedAt
+	case IcebergCancelled:
+		d.Status = order.Cancelled
+		if o.ExecutedAmount > 0 {
+			d.Status = order.PartiallyCancelled
+		}
+		d.CloseTime = o.UpdatedAt
+	case IcebergFailed:
+		d.Status = order.Rejected
+		d.CloseTime = o.UpdatedAt
+	}
+	return d
+}
+
+// calculateProgress aggregates the executed amount and average price across
+// all clips. Amounts are summed as decimals so the remaining amount can be
+// conformed to the exchange step size without float drift
+func (o *IcebergOrder) calculateProgress() {
+	executed := decimal.Zero
+	var notional float64
+	for i := range o.Clips {
+		executed = executed.Add(decimal.NewFromFloat(o.Clips[i].ExecutedAmount))
+		notional += o.Clips[i].ExecutedAmount * o.Clips[i].AverageExecutedPrice
+	}
+	o.ExecutedAmount, _ = executed.Float64()
+	o.AverageExecutedPrice = 0
+	if o.ExecutedAmount > 0 {
+		o.AverageExecutedPrice = notional / o.ExecutedAmount
+	}
+	o.RemainingAmount, _ = decimal.NewFromFloat(o.Submit.Amount).Sub(executed).Float64()
+	if o.RemainingAmount < 0 {
+		o.RemainingAmount = 0
+	}
+}
+
+// liveClip returns the index of the clip which is being submitted or is on
+// the book, or -1 if there is none
+func (o *IcebergOrder) liveClip() int {
+	for i := range o.Clips {
+		if !isInactiveOrderStatus(o.Clips[i].Status) {
+			return i
+		}
+	}
+	return -1
+}
+
+// copy returns a copy of the iceberg order which does not share its clips
+func (o *IcebergOrder) copy() IcebergOrder {
+	cpy := *o
+	if o.Clips != nil {
+		cpy.Clips = make([]IcebergClip, len(o.Clips))
+		copy(cpy.Clips, o.Clips)
+	}
+	return cpy
+}
+
+// randomise shifts a value by a uniformly random amount of up to variance in
+// either direction
+func randomise(value, variance float64) float64 {
+	if variance <= 0 {
+		return value
+	}
+	return value + (rand.Float64()*2-1)*variance // nolint:gosec // basic number generation required, no need for crypto/rand
+}
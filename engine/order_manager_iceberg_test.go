@@ -0,0 +1,306 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// iffExchange aka iceberg fake exchange places every order it receives and
+// serves order execution limits
+type iffExchange struct {
+	ogfExchange
+	limits *order.ExecutionLimits
+}
+
+func (f iffExchange) GetOrderExecutionLimits(a asset.Item, cp currency.Pair) (*order.Limits, error) {
+	return f.limits.GetOrderExecutionLimits(a, cp)
+}
+
+func (f iffExchange) CheckOrderExecutionLimits(a asset.Item, cp currency.Pair, price, amount float64, orderType order.Type) error {
+	return f.limits.CheckOrderExecutionLimits(a, cp, price, amount, orderType)
+}
+
+func icebergSetup(t *testing.T) *OrderManager {
+	t.Helper()
+	m, _ := orderGroupsSetup(t)
+	exch, err := m.orderStore.exchangeManager.GetExchangeByName(testExchange)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	limits := &order.ExecutionLimits{}
+	err = limits.LoadLimits([]order.MinMaxLevel{
+		{
+			Pair:       currency.NewPair(currency.BTC, currency.USD),
+			Asset:      asset.Spot,
+			MinPrice:   1,
+			MaxPrice:   1000000,
+			StepPrice:  0.5,
+			MinAmount:  0.1,
+			MaxAmount:  100,
+			StepAmount: 0.1,
+		},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	m.orderStore.exchangeManager.(*ExchangeManager).Add(iffExchange{
+		ogfExchange: exch.(ogfExchange),
+		limits:      limits,
+	})
+	return m
+}
+
+func icebergOrder(amount, visible float64) *IcebergOrder {
+	return &IcebergOrder{
+		Submit:        *orderGroupLeg(order.Buy, order.Limit, amount, 100, 0),
+		VisibleAmount: visible,
+	}
+}
+
+func TestSubmitIcebergValidation(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	_, err := m.SubmitIceberg(context.Background(), icebergOrder(1, 0.4))
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+	m = icebergSetup(t)
+	_, err = m.SubmitIceberg(context.Background(), nil)
+	if !errors.Is(err, errNilOrder) {
+		t.Errorf("error '%v', expected '%v'", err, errNilOrder)
+	}
+
+	tt := []struct {
+		name   string
+		modify func(o *IcebergOrder)
+		err    error
+	}{
+		{"market", func(o *IcebergOrder) { o.Submit.Type = order.Market }, errIcebergLimitOrderRequired},
+		{"no visible", func(o *IcebergOrder) { o.VisibleAmount = 0 }, errIcebergInvalidVisible},
+		{"visible too large", func(o *IcebergOrder) { o.VisibleAmount = 1 }, errIcebergInvalidVisible},
+		{"amount variance", func(o *IcebergOrder) { o.AmountVariance = 1 }, errIcebergInvalidAmountVar},
+		{"price variance", func(o *IcebergOrder) { o.PriceVariance = 100 }, errIcebergInvalidPriceVar},
+		{"visible below step", func(o *IcebergOrder) { o.VisibleAmount = 0.05 }, errIcebergClipOutsideLimits},
+	}
+	for i := range tt {
+		o := icebergOrder(1, 0.4)
+		tt[i].modify(o)
+		_, err = m.SubmitIceberg(context.Background(), o)
+		if !errors.Is(err, tt[i].err) {
+			t.Errorf("%s: error '%v', expected '%v'", tt[i].name, err, tt[i].err)
+		}
+	}
+	orders, err := m.GetIcebergOrders("", true)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(orders) != 0 {
+		t.Errorf("received %v iceberg orders, expected 0", len(orders))
+	}
+}
+
+func TestSubmitIceberg(t *testing.T) {
+	t.Parallel()
+	m := icebergSetup(t)
+	resp, err := m.SubmitIceberg(context.Background(), icebergOrder(1, 0.4))
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if resp.Status != IcebergActive {
+		t.Errorf("received status %v, expected %v", resp.Status, IcebergActive)
+	}
+
+	// each fill replenishes the visible clip until the remainder is placed
+	expected := []float64{0.4, 0.4, 0.2}
+	for i := range expected {
+		resp, err = m.GetIcebergOrder(resp.ID)
+		if !errors.Is(err, nil) {
+			t.Fatalf("error '%v', expected '%v'", err, nil)
+		}
+		if len(resp.Clips) != i+1 {
+			t.Fatalf("received %v clips, expected %v", len(resp.Clips), i+1)
+		}
+		clip := resp.Clips[i]
+		if clip.Amount != expected[i] || clip.Price != 100 || clip.Status != order.New {
+			t.Errorf("clip %d received amount %v price %v status %v, expected %v 100 %v",
+				i, clip.Amount, clip.Price, clip.Status, expected[i], order.New)
+		}
+		if d := resp.Detail(); i > 0 && d.Status != order.PartiallyFilled {
+			t.Errorf("received aggregate status %v, expected %v", d.Status, order.PartiallyFilled)
+		}
+		fillOrderGroupLeg(t, m, clip.OrderID, order.Filled, clip.Amount)
+	}
+
+	resp, err = m.GetIcebergOrder(resp.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if resp.Status != IcebergCompleted {
+		t.Errorf("received status %v, expected %v", resp.Status, IcebergCompleted)
+	}
+	d := resp.Detail()
+	if d.Status != order.Filled || d.ExecutedAmount != 1 || d.RemainingAmount != 0 ||
+		d.AverageExecutedPrice != 100 || !d.HiddenOrder {
+		t.Errorf("received aggregate order %+v", d)
+	}
+	orders, err := m.GetIcebergOrders(testExchange, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(orders) != 0 {
+		t.Errorf("received %v active iceberg orders, expected 0", len(orders))
+	}
+}
+
+func TestSubmitIcebergPartialFill(t *testing.T) {
+	t.Parallel()
+	m := icebergSetup(t)
+	resp, err := m.SubmitIceberg(context.Background(), icebergOrder(1, 0.4))
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	fillOrderGroupLeg(t, m, resp.Clips[0].OrderID, order.PartiallyFilled, 0.1)
+	resp, err = m.GetIcebergOrder(resp.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(resp.Clips) != 1 {
+		t.Fatalf("received %v clips, expected 1", len(resp.Clips))
+	}
+	if resp.ExecutedAmount != 0.1 || resp.RemainingAmount != 0.9 {
+		t.Errorf("received executed %v remaining %v, expected 0.1 0.9", resp.ExecutedAmount, resp.RemainingAmount)
+	}
+}
+
+func TestSubmitIcebergRandomised(t *testing.T) {
+	t.Parallel()
+	m := icebergSetup(t)
+	o := icebergOrder(10, 1)
+	o.AmountVariance = 0.5
+	o.PriceVariance = 5
+	resp, err := m.SubmitIceberg(context.Background(), o)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	limits, err := m.icebergLimits(testExchange, &resp.Submit)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	for i := 0; i < 5; i++ {
+		clip := resp.Clips[len(resp.Clips)-1]
+		if clip.Amount < 0.5 || clip.Amount > 1.5 {
+			t.Errorf("clip %d amount %v outside variance", i, clip.Amount)
+		}
+		if clip.Price < 95 || clip.Price > 105 {
+			t.Errorf("clip %d price %v outside variance", i, clip.Price)
+		}
+		if limits.ConformToAmount(clip.Amount) != clip.Amount ||
+			limits.ConformToPrice(clip.Price) != clip.Price {
+			t.Errorf("clip %d amount %v price %v do not conform to step sizes", i, clip.Amount, clip.Price)
+		}
+		fillOrderGroupLeg(t, m, clip.OrderID, order.Filled, clip.Amount)
+		resp, err = m.GetIcebergOrder(resp.ID)
+		if !errors.Is(err, nil) {
+			t.Fatalf("error '%v', expected '%v'", err, nil)
+		}
+	}
+}
+
+func TestCancelIcebergOrder(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	err := m.CancelIcebergOrder(context.Background(), uuid.Nil)
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+	m = icebergSetup(t)
+	err = m.CancelIcebergOrder(context.Background(), uuid.Nil)
+	if !errors.Is(err, errIcebergOrderNotFound) {
+		t.Errorf("error '%v', expected '%v'", err, errIcebergOrderNotFound)
+	}
+	resp, err := m.SubmitIceberg(context.Background(), icebergOrder(1, 0.4))
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	fillOrderGroupLeg(t, m, resp.Clips[0].OrderID, order.Filled, 0.4)
+	err = m.CancelIcebergOrder(context.Background(), resp.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	resp, err = m.GetIcebergOrder(resp.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if resp.Status != IcebergCancelled {
+		t.Errorf("received status %v, expected %v", resp.Status, IcebergCancelled)
+	}
+	od, err := m.GetByExchangeAndID(testExchange, resp.Clips[1].OrderID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if od.Status != order.Cancelled {
+		t.Errorf("received clip order status %v, expected %v", od.Status, order.Cancelled)
+	}
+	if d := resp.Detail(); d.Status != order.PartiallyCancelled || d.ExecutedAmount != 0.4 {
+		t.Errorf("received aggregate status %v executed %v, expected %v 0.4",
+			d.Status, d.ExecutedAmount, order.PartiallyCancelled)
+	}
+	err = m.CancelIcebergOrder(context.Background(), resp.ID)
+	if !errors.Is(err, errIcebergOrderNotActive) {
+		t.Errorf("error '%v', expected '%v'", err, errIcebergOrderNotActive)
+	}
+}
+
+func TestIcebergClipCancelledOnExchange(t *testing.T) {
+	t.Parallel()
+	m := icebergSetup(t)
+	resp, err := m.SubmitIceberg(context.Background(), icebergOrder(1, 0.4))
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	fillOrderGroupLeg(t, m, resp.Clips[0].OrderID, order.Cancelled, 0)
+	resp, err = m.GetIcebergOrder(resp.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if resp.Status != IcebergCancelled || len(resp.Clips) != 1 {
+		t.Errorf("received status %v with %v clips, expected %v with 1",
+			resp.Status, len(resp.Clips), IcebergCancelled)
+	}
+	if d := resp.Detail(); d.Status != order.Cancelled {
+		t.Errorf("received aggregate status %v, expected %v", d.Status, order.Cancelled)
+	}
+}
+
+func TestIcebergClipCancelled(t *testing.T) {
+	t.Parallel()
+	m := icebergSetup(t)
+	resp, err := m.SubmitIceberg(context.Background(), icebergOrder(1, 0.4))
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.Cancel(context.Background(), &order.Cancel{
+		Exchange:  testExchange,
+		ID:        resp.Clips[0].OrderID,
+		Pair:      resp.Submit.Pair,
+		AssetType: resp.Submit.AssetType,
+		Side:      resp.Submit.Side,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	resp, err = m.GetIcebergOrder(resp.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if resp.Status != IcebergCancelled || len(resp.Clips) != 1 {
+		t.Errorf("received status %v with %v clips, expected %v with 1",
+			resp.Status, len(resp.Clips), IcebergCancelled)
+	}
+}
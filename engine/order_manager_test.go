@@ -10,6 +10,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/convert"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	dborder "github.com/thrasher-corp/gocryptotrader/database/repository/order"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -89,21 +90,21 @@ func (f omfExchange) ModifyOrder(ctx context.Context, action *order.Modify) (ord
 }
 
 func TestSetupOrderManager(t *testing.T) {
-	_, err := SetupOrderManager(nil, nil, nil, false)
+	_, err := SetupOrderManager(nil, nil, nil, nil, false)
 	if !errors.Is(err, errNilExchangeManager) {
 		t.Errorf("error '%v', expected '%v'", err, errNilExchangeManager)
 	}
 
-	_, err = SetupOrderManager(SetupExchangeManager(), nil, nil, false)
+	_, err = SetupOrderManager(SetupExchangeManager(), nil, nil, nil, false)
 	if !errors.Is(err, errNilCommunicationsManager) {
 		t.Errorf("error '%v', expected '%v'", err, errNilCommunicationsManager)
 	}
-	_, err = SetupOrderManager(SetupExchangeManager(), &CommunicationManager{}, nil, false)
+	_, err = SetupOrderManager(SetupExchangeManager(), &CommunicationManager{}, nil, nil, false)
 	if !errors.Is(err, errNilWaitGroup) {
 		t.Errorf("error '%v', expected '%v'", err, errNilWaitGroup)
 	}
 	var wg sync.WaitGroup
-	_, err = SetupOrderManager(SetupExchangeManager(), &CommunicationManager{}, &wg, false)
+	_, err = SetupOrderManager(SetupExchangeManager(), &CommunicationManager{}, nil, &wg, false)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -116,7 +117,7 @@ func TestOrderManagerStart(t *testing.T) {
 		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
 	}
 	var wg sync.WaitGroup
-	m, err = SetupOrderManager(SetupExchangeManager(), &CommunicationManager{}, &wg, false)
+	m, err = SetupOrderManager(SetupExchangeManager(), &CommunicationManager{}, nil, &wg, false)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -137,7 +138,7 @@ func TestOrderManagerIsRunning(t *testing.T) {
 	}
 
 	var wg sync.WaitGroup
-	m, err := SetupOrderManager(SetupExchangeManager(), &CommunicationManager{}, &wg, false)
+	m, err := SetupOrderManager(SetupExchangeManager(), &CommunicationManager{}, nil, &wg, false)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -162,7 +163,7 @@ func TestOrderManagerStop(t *testing.T) {
 	}
 
 	var wg sync.WaitGroup
-	m, err = SetupOrderManager(SetupExchangeManager(), &CommunicationManager{}, &wg, false)
+	m, err = SetupOrderManager(SetupExchangeManager(), &CommunicationManager{}, nil, &wg, false)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -205,7 +206,7 @@ func OrdersSetup(t *testing.T) *OrderManager {
 		IBotExchange: exch,
 	}
 	em.Add(fakeExchange)
-	m, err := SetupOrderManager(em, &CommunicationManager{}, &wg, false)
+	m, err := SetupOrderManager(em, &CommunicationManager{}, nil, &wg, false)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -767,7 +768,7 @@ func TestProcessOrders(t *testing.T) {
 		IBotExchange: exch,
 	}
 	em.Add(fakeExchange)
-	m, err := SetupOrderManager(em, &CommunicationManager{}, &wg, false)
+	m, err := SetupOrderManager(em, &CommunicationManager{}, nil, &wg, false)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -1152,3 +1153,275 @@ func Test_getActiveOrders(t *testing.T) {
 		t.Errorf("Test_getActiveOrders - Expected 0 results, got: %d", len(res))
 	}
 }
+
+// fakeOrderDB stores persisted orders in memory
+type fakeOrderDB struct {
+	mu     sync.Mutex
+	orders map[string]dborder.Details
+}
+
+func (f *fakeOrderDB) Upsert(orders ...*dborder.Details) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.orders == nil {
+		f.orders = make(map[string]dborder.Details)
+	}
+	for i := range orders {
+		f.orders[orders[i].OrderID] = *orders[i]
+	}
+	return nil
+}
+
+func (f *fakeOrderDB) GetByExchangeAndID(_, orderID, _ string) (*dborder.Details, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	d, ok := f.orders[orderID]
+	if !ok {
+		return nil, ErrOrderNotFound
+	}
+	return &d, nil
+}
+
+func (f *fakeOrderDB) GetOrdersNotInStatus(statuses ...string) ([]dborder.Details, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var resp []dborder.Details
+orders:
+	for _, d := range f.orders {
+		for i := range statuses {
+			if d.Status == statuses[i] {
+				continue orders
+			}
+		}
+		resp = append(resp, d)
+	}
+	return resp, nil
+}
+
+// persistedOrdersSetup sets up an order manager without exchange API access
+func persistedOrdersSetup(t *testing.T) *OrderManager {
+	t.Helper()
+	var wg sync.WaitGroup
+	em := SetupExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	em.Add(omfExchange{
+		IBotExchange: exch,
+	})
+	m, err := SetupOrderManager(em, &CommunicationManager{}, nil, &wg, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	m.started = 1
+	return m
+}
+
+func TestPersistOrder(t *testing.T) {
+	m := persistedOrdersSetup(t)
+	db := &fakeOrderDB{}
+	m.orderDB = db
+	err := m.Add(&order.Detail{
+		Exchange:  testExchange,
+		ID:        "TestPersistOrder",
+		AssetType: asset.Spot,
+		Pair:      currency.NewPair(currency.BTC, currency.USD),
+		Side:      order.Buy,
+		Type:      order.Limit,
+		Status:    order.New,
+		Price:     1337,
+		Amount:    1,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	persisted, err := db.GetByExchangeAndID(testExchange, "TestPersistOrder", asset.Spot.String())
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if persisted.Status != order.New.String() {
+		t.Errorf("received '%v', expected '%v'", persisted.Status, order.New)
+	}
+	if persisted.ID == "" {
+		t.Error("expected internal order ID to be persisted")
+	}
+
+	err = m.Cancel(context.Background(), &order.Cancel{
+		Exchange:  testExchange,
+		ID:        "TestPersistOrder",
+		AssetType: asset.Spot,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	persisted, err = db.GetByExchangeAndID(testExchange, "TestPersistOrder", asset.Spot.String())
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if persisted.Status != order.Cancelled.String() {
+		t.Errorf("received '%v', expected '%v'", persisted.Status, order.Cancelled)
+	}
+}
+
+func TestLoadPersistedOrders(t *testing.T) {
+	m := persistedOrdersSetup(t)
+	loaded, err := m.loadPersistedOrders()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(loaded) != 0 {
+		t.Errorf("received '%v', expected '%v'", len(loaded), 0)
+	}
+
+	db := &fakeOrderDB{}
+	m.orderDB = db
+	err = db.Upsert(&dborder.Details{
+		ID:       "1337",
+		Exchange: testExchange,
+		OrderID:  "open",
+		Asset:    asset.Spot.String(),
+		Base:     "BTC",
+		Quote:    "USD",
+		Side:     order.Buy.String(),
+		Type:     order.Limit.String(),
+		Status:   order.PartiallyFilled.String(),
+		Amount:   1,
+	}, &dborder.Details{
+		Exchange: testExchange,
+		OrderID:  "filled",
+		Asset:    asset.Spot.String(),
+		Base:     "BTC",
+		Quote:    "USD",
+		Side:     order.Buy.String(),
+		Type:     order.Limit.String(),
+		Status:   order.Filled.String(),
+		Amount:   1,
+	}, &dborder.Details{
+		Exchange: testExchange,
+		OrderID:  "bad asset",
+		Asset:    "bad asset",
+		Status:   order.New.String(),
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+
+	loaded, err = m.loadPersistedOrders()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(loaded) != 1 {
+		t.Fatalf("received '%v', expected '%v'", len(loaded), 1)
+	}
+	od, err := m.orderStore.getByExchangeAndID(testExchange, "open")
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if od.InternalOrderID != "1337" {
+		t.Errorf("received '%v', expected '%v'", od.InternalOrderID, "1337")
+	}
+	if !od.Pair.Equal(currency.NewPair(currency.BTC, currency.USD)) {
+		t.Errorf("received '%v', expected '%v'", od.Pair, currency.NewPair(currency.BTC, currency.USD))
+	}
+	_, err = m.orderStore.getByExchangeAndID(testExchange, "filled")
+	if !errors.Is(err, ErrOrderNotFound) {
+		t.Errorf("error '%v', expected '%v'", err, ErrOrderNotFound)
+	}
+}
+
+func TestReconcilePersistedOrders(t *testing.T) {
+	m := persistedOrdersSetup(t)
+	db := &fakeOrderDB{}
+	m.orderDB = db
+	exch, err := m.orderStore.exchangeManager.GetExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pair := currency.NewPair(currency.BTC, currency.USD)
+	persisted := []order.Detail{
+		{
+			Exchange:  testExchange,
+			ID:        "Order3-unknown-to-active",
+			AssetType: asset.Spot,
+			Pair:      pair,
+			Status:    order.New,
+		},
+		{
+			Exchange:  testExchange,
+			ID:        "no-longer-active",
+			AssetType: asset.Spot,
+			Pair:      pair,
+			Status:    order.New,
+		},
+	}
+	for i := range persisted {
+		err = m.orderStore.add(&persisted[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// authenticated support is disabled, orders are left untouched
+	m.reconcilePersistedOrders(persisted)
+	od, err := m.orderStore.getByExchangeAndID(testExchange, "no-longer-active")
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if od.Status != order.New {
+		t.Errorf("received '%v', expected '%v'", od.Status, order.New)
+	}
+
+	exch.GetBase().API.AuthenticatedSupport = true
+	exch.GetBase().Features.Supports.RESTCapabilities.GetOrder = true
+	m.reconcilePersistedOrders(persisted)
+	od, err = m.orderStore.getByExchangeAndID(testExchange, "Order3-unknown-to-active")
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if od.Status != order.Active {
+		t.Errorf("received '%v', expected '%v'", od.Status, order.Active)
+	}
+	od, err = m.orderStore.getByExchangeAndID(testExchange, "no-longer-active")
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if od.Status != order.Cancelled {
+		t.Errorf("received '%v', expected '%v'", od.Status, order.Cancelled)
+	}
+	persistedOrder, err := db.GetByExchangeAndID(testExchange, "no-longer-active", asset.Spot.String())
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if persistedOrder.Status != order.Cancelled.String() {
+		t.Errorf("received '%v', expected '%v'", persistedOrder.Status, order.Cancelled)
+	}
+
+	// orders which cannot be fetched individually are closed
+	exch.GetBase().Features.Supports.RESTCapabilities.GetOrder = false
+	err = m.orderStore.add(&order.Detail{
+		Exchange:  testExchange,
+		ID:        "closed",
+		AssetType: asset.Spot,
+		Pair:      pair,
+		Status:    order.New,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.reconcilePersistedOrders([]order.Detail{{
+		Exchange:  testExchange,
+		ID:        "closed",
+		AssetType: asset.Spot,
+		Pair:      pair,
+		Status:    order.New,
+	}})
+	od, err = m.orderStore.getByExchangeAndID(testExchange, "closed")
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if od.Status != order.Closed {
+		t.Errorf("received '%v', expected '%v'", od.Status, order.Closed)
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"sync"
 	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	dborder "github.com/thrasher-corp/gocryptotrader/database/repository/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
@@ -23,6 +25,36 @@ var (
 	// ErrOrderIDCannotBeEmpty occurs when an order does not have an ID
 	ErrOrderIDCannotBeEmpty = errors.New("orderID cannot be empty")
 	errNilOrder             = errors.New("nil order received")
+
+	errOrderGroupNotFound           = errors.New("order group not found")
+	errOrderGroupNotActive          = errors.New("order group is no longer active")
+	errOrderGroupLegsRequired       = errors.New("order group requires at least two legs")
+	errOrderGroupLegMismatch        = errors.New("order group legs must share the same exchange, pair and asset")
+	errOrderGroupInvalidLegType     = errors.New("order group leg type is not supported")
+	errOrderGroupInvalidExitSide    = errors.New("bracket exit orders must be on the opposite side of the entry")
+	errOrderGroupExitAmountTooLarge = errors.New("bracket exit amount cannot exceed the entry amount")
+	errConditionalOrdersUnavailable = errors.New("conditional order manager is not available to hold stop and take-profit legs")
+
+	errIcebergOrderNotFound      = errors.New("iceberg order not found")
+	errIcebergOrderNotActive     = errors.New("iceberg order is no longer active")
+	errIcebergLimitOrderRequired = errors.New("iceberg orders must be limit orders")
+	errIcebergInvalidVisible     = errors.New("iceberg visible amount must be greater than zero and less than the order amount")
+	errIcebergInvalidAmountVar   = errors.New("iceberg amount variance must be between zero and one")
+	errIcebergInvalidPriceVar    = errors.New("iceberg price variance must be at least zero and less than the order price")
+	errIcebergClipOutsideLimits  = errors.New("iceberg visible amount does not conform to the exchange order execution limits")
+
+	// inactiveOrderStatuses are statuses which an order cannot move on from
+	// and are not reloaded from the database on startup
+	inactiveOrderStatuses = []order.Status{
+		order.Filled,
+		order.Cancelled,
+		order.InsufficientBalance,
+		order.MarketUnavailable,
+		order.Rejected,
+		order.PartiallyCancelled,
+		order.Expired,
+		order.Closed,
+	}
 )
 
 type orderManagerConfig struct {
@@ -52,6 +84,19 @@ type OrderManager struct {
 	orderStore       store
 	cfg              orderManagerConfig
 	verbose          bool
+	orderDB          dborder.IDBService
+
+	groupsMtx         sync.Mutex
+	orderGroups       map[uuid.UUID]*OrderGroup
+	conditionalOrders iConditionalOrderHolder
+
+	icebergMtx    sync.Mutex
+	icebergOrders map[uuid.UUID]*IcebergOrder
+
+	riskMtx     sync.Mutex
+	riskChecker iRiskChecker
+
+	positions *order.PositionController
 }
 
 // OrderSubmitResponse contains the order response along with an internal order ID
@@ -66,3 +111,127 @@ type OrderUpsertResponse struct {
 	OrderDetails order.Detail
 	IsNewOrder   bool
 }
+
+// OrderGroupType defines how the legs of an order group relate to each other
+type OrderGroupType string
+
+// Order group types
+const (
+	// OneCancelsOther groups cancel all remaining legs once any leg fills
+	OneCancelsOther OrderGroupType = "OCO"
+	// Bracket groups submit an entry order and once it fills, submit a
+	// take-profit and stop-loss pair which act as one-cancels-other
+	Bracket OrderGroupType = "BRACKET"
+)
+
+// OrderGroupStatus defines the state of an order group
+type OrderGroupStatus string
+
+// Order group statuses
+const (
+	// OrderGroupActive groups are monitoring their legs for fills
+	OrderGroupActive OrderGroupStatus = "ACTIVE"
+	// OrderGroupCompleted groups have had a closing leg fill and all sibling
+	// legs cancelled
+	OrderGroupCompleted OrderGroupStatus = "COMPLETED"
+	// OrderGroupCancelled groups were cancelled, or all legs became inactive
+	// without filling
+	OrderGroupCancelled OrderGroupStatus = "CANCELLED"
+	// OrderGroupFailed groups were unable to submit a leg
+	OrderGroupFailed OrderGroupStatus = "FAILED"
+)
+
+// OrderGroupLegRole defines the purpose of a leg within an order group
+type OrderGroupLegRole string
+
+// Order group leg roles
+const (
+	OrderGroupLegOCO        OrderGroupLegRole = "OCO"
+	OrderGroupLegEntry      OrderGroupLegRole = "ENTRY"
+	OrderGroupLegTakeProfit OrderGroupLegRole = "TAKE_PROFIT"
+	OrderGroupLegStopLoss   OrderGroupLegRole = "STOP_LOSS"
+)
+
+// OrderGroup links orders together so that fills on one leg can cancel or
+// activate the others
+type OrderGroup struct {
+	ID        uuid.UUID
+	Type      OrderGroupType
+	Status    OrderGroupStatus
+	Legs      []OrderGroupLeg
+	Error     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// OrderGroupLeg is a single order within an order group. Market and limit
+// legs are submitted to the exchange directly, while stop, stop limit and
+// take-profit legs are held by the conditional order manager
+type OrderGroupLeg struct {
+	Role   OrderGroupLegRole
+	Submit order.Submit
+	// OrderID and InternalOrderID are set once the leg has been placed on
+	// the exchange
+	OrderID         string
+	InternalOrderID string
+	// ConditionalOrderID is set while the leg is held by the conditional
+	// order manager
+	ConditionalOrderID uuid.UUID
+	Status             order.Status
+	ExecutedAmount     float64
+	Error              string
+}
+
+// IcebergStatus defines the state of an iceberg order
+type IcebergStatus string
+
+// Iceberg order statuses
+const (
+	// IcebergActive orders have a visible clip on the book
+	IcebergActive IcebergStatus = "ACTIVE"
+	// IcebergCompleted orders have filled their total amount
+	IcebergCompleted IcebergStatus = "COMPLETED"
+	// IcebergCancelled orders were cancelled, or had their visible clip
+	// cancelled on the exchange
+	IcebergCancelled IcebergStatus = "CANCELLED"
+	// IcebergFailed orders were unable to place a visible clip
+	IcebergFailed IcebergStatus = "FAILED"
+)
+
+// IcebergOrder is a limit order emulated by the order manager which only
+// shows a visible clip on the book at a time. When a clip fills, a new clip is
+// submitted from the remaining amount
+type IcebergOrder struct {
+	ID uuid.UUID
+	// Submit holds the total amount and price of the logical order
+	Submit order.Submit
+	// VisibleAmount is the amount shown on the book by each clip
+	VisibleAmount float64
+	// AmountVariance randomises each clip amount by up to this fraction of
+	// the visible amount in either direction
+	AmountVariance float64
+	// PriceVariance randomises each clip price by up to this absolute amount
+	// in either direction
+	PriceVariance        float64
+	Status               IcebergStatus
+	Clips                []IcebergClip
+	ExecutedAmount       float64
+	RemainingAmount      float64
+	AverageExecutedPrice float64
+	Error                string
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+}
+
+// IcebergClip is a single visible order of an iceberg order
+type IcebergClip struct {
+	OrderID              string
+	InternalOrderID      string
+	Amount               float64
+	Price                float64
+	ExecutedAmount       float64
+	AverageExecutedPrice float64
+	Status               order.Status
+	Error                string
+	SubmittedAt          time.Time
+}
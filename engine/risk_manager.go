@@ -0,0 +1,414 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupRiskManager will boot up the RiskManager
+func SetupRiskManager(exchangeManager iExchangeManager, orderManager iRiskOrderManager, communicationsManager iCommsManager, cfg *config.RiskManager) (*RiskManager, error) {
+	if exchangeManager == nil {
+		return nil, errNilExchangeManager
+	}
+	if orderManager == nil {
+		return nil, errNilOrderManager
+	}
+	if communicationsManager == nil {
+		return nil, errNilCommunicationsManager
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	return &RiskManager{
+		exchangeManager: exchangeManager,
+		orderManager:    orderManager,
+		commsManager:    communicationsManager,
+		cfg:             *cfg,
+		orderTimes:      make(map[string][]time.Time),
+		reserved:        make(map[*order.Submit]riskReservation),
+	}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *RiskManager) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return atomic.LoadInt32(&m.started) == 1
+}
+
+// Start runs the subsystem
+func (m *RiskManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("risk manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("risk manager %w", ErrSubSystemAlreadyStarted)
+	}
+	log.Debugln(log.OrderMgr, "Risk manager started.")
+	return nil
+}
+
+// Stop attempts to shutdown the subsystem. Orders are no longer checked while
+// the subsystem is stopped
+func (m *RiskManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("risk manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return fmt.Errorf("risk manager %w", ErrSubSystemNotStarted)
+	}
+	log.Debugln(log.OrderMgr, "Risk manager shut down.")
+	return nil
+}
+
+// CheckOrder runs all configured pre-trade checks against an order about to
+// be submitted. Rejections are audited and pushed to the communications
+// manager. Orders which pass reserve an order rate slot and their exposure
+// until released by ReleaseOrder
+func (m *RiskManager) CheckOrder(s *order.Submit) error {
+	if m == nil {
+		return fmt.Errorf("risk manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("risk manager %w", ErrSubSystemNotStarted)
+	}
+	if s == nil {
+		return errNilOrder
+	}
+	err := m.check(s, time.Now())
+	if err != nil {
+		m.reject(s, err)
+		return fmt.Errorf("risk manager: %w", err)
+	}
+	return nil
+}
+
+// ReleaseOrder releases the pending exposure reserved for an order once it
+// has been submitted. Orders the exchange did not accept also release their
+// order rate slot
+func (m *RiskManager) ReleaseOrder(s *order.Submit, accepted bool) {
+	if m == nil || s == nil {
+		return
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	r, ok := m.reserved[s]
+	if !ok {
+		return
+	}
+	delete(m.reserved, s)
+	if accepted {
+		return
+	}
+	times := m.orderTimes[r.exchange]
+	for i := range times {
+		if times[i].Equal(r.time) {
+			m.orderTimes[r.exchange] = append(times[:i:i], times[i+1:]...)
+			return
+		}
+	}
+}
+
+// ActivateKillSwitch cancels all orders across all exchanges via the order
+// manager and blocks new orders until the kill switch is deactivated
+func (m *RiskManager) ActivateKillSwitch(ctx context.Context, reason string) error {
+	if m == nil {
+		return fmt.Errorf("risk manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("risk manager %w", ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	m.killSwitch = true
+	m.killReason = reason
+	m.m.Unlock()
+
+	msg := fmt.Sprintf("Risk manager: kill switch activated, cancelling all orders. Reason: %s", reason)
+	m.notify(msg, "killswitch")
+
+	exchanges, err := m.exchangeManager.GetExchanges()
+	if err != nil {
+		return err
+	}
+	m.orderManager.CancelAllOrders(ctx, exchanges)
+	return nil
+}
+
+// DeactivateKillSwitch allows new orders to be submitted again
+func (m *RiskManager) DeactivateKillSwitch() error {
+	if m == nil {
+		return fmt.Errorf("risk manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("risk manager %w", ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	if !m.killSwitch {
+		m.m.Unlock()
+		return errRiskKillSwitchNotActive
+	}
+	m.killSwitch = false
+	m.killReason = ""
+	m.m.Unlock()
+
+	m.notify("Risk manager: kill switch deactivated, new orders are allowed.", "killswitch")
+	return nil
+}
+
+// GetStatus returns the configured limits and the current risk state
+func (m *RiskManager) GetStatus() (*RiskStatus, error) {
+	if m == nil {
+		return nil, fmt.Errorf("risk manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("risk manager %w", ErrSubSystemNotStarted)
+	}
+	now := time.Now()
+	m.m.Lock()
+	defer m.m.Unlock()
+	resp := &RiskStatus{
+		Limits:           m.cfg,
+		KillSwitch:       m.killSwitch,
+		KillSwitchReason: m.killReason,
+		DailyPnL:         m.dailyPnL(now),
+		OrderRate:        make(map[string]int, len(m.orderTimes)),
+	}
+	for exch := range m.orderTimes {
+		resp.OrderRate[exch] = len(m.recentOrders(exch, now))
+	}
+	return resp, nil
+}
+
+// check evaluates each enabled risk limit in turn, returning the first breach
+func (m *RiskManager) check(s *order.Submit, now time.Time) error {
+	m.m.Lock()
+	defer m.m.Unlock()
+	if m.killSwitch {
+		return errRiskKillSwitchActive
+	}
+
+	var last float64
+	if t, err := ticker.GetTicker(s.Exchange, s.Pair, s.AssetType); err == nil {
+		last = t.Last
+	}
+	price := s.Price
+	if s.Type == order.Market || price <= 0 {
+		price = last
+	}
+
+	if m.cfg.MaxPriceDeviation > 0 && s.Type != order.Market && s.Price > 0 {
+		if last <= 0 {
+			return fmt.Errorf("%w: price band requires a ticker for %s %s %s",
+				errRiskNoReferencePrice, s.Exchange, s.Pair, s.AssetType)
+		}
+		deviation := math.Abs(s.Price-last) / last
+		if deviation > m.cfg.MaxPriceDeviation {
+			return fmt.Errorf("%w: price %v last %v deviation %.4f max %v",
+				errRiskPriceOutsideBand, s.Price, last, deviation, m.cfg.MaxPriceDeviation)
+		}
+	}
+
+	if m.cfg.MaxOrderNotional > 0 {
+		if price <= 0 {
+			return fmt.Errorf("%w: notional requires a price or ticker for %s %s %s",
+				errRiskNoReferencePrice, s.Exchange, s.Pair, s.AssetType)
+		}
+		notional := s.Amount * price
+		if notional > m.cfg.MaxOrderNotional {
+			return fmt.Errorf("%w: notional %v max %v",
+				errRiskMaxOrderNotional, notional, m.cfg.MaxOrderNotional)
+		}
+	}
+
+	sign := riskSideSign(s.Side)
+	key := riskPendingKey(s, sign)
+	if m.cfg.MaxPositionPerPair > 0 {
+		position, open := m.position(s.Exchange, s.Pair, s.AssetType, s.Side)
+		open += m.pending(key)
+		projected := position + open + sign*s.Amount
+		// orders which reduce the position are always allowed
+		if math.Abs(projected) > m.cfg.MaxPositionPerPair &&
+			math.Abs(projected) > math.Abs(position) {
+			return fmt.Errorf("%w: position %v open %v projected %v max %v",
+				errRiskMaxPosition, position, open, projected, m.cfg.MaxPositionPerPair)
+		}
+	}
+
+	if m.cfg.MaxDailyLoss > 0 {
+		pnl := m.dailyPnL(now)
+		if pnl <= -m.cfg.MaxDailyLoss {
+			return fmt.Errorf("%w: daily pnl %v max loss %v",
+				errRiskDailyLossLimit, pnl, m.cfg.MaxDailyLoss)
+		}
+	}
+
+	exch := strings.ToLower(s.Exchange)
+	recent := m.recentOrders(exch, now)
+	if m.cfg.MaxOrdersPerInterval > 0 && int64(len(recent)) >= m.cfg.MaxOrdersPerInterval {
+		m.orderTimes[exch] = recent
+		return fmt.Errorf("%w: %d orders within %v",
+			errRiskMaxOrderRate, len(recent), m.cfg.OrderRateInterval)
+	}
+
+	// orders are checked concurrently so the times are kept in order
+	i := sort.Search(len(recent), func(i int) bool {
+		return recent[i].After(now)
+	})
+	times := make([]time.Time, 0, len(recent)+1)
+	times = append(append(append(times, recent[:i]...), now), recent[i:]...)
+	m.orderTimes[exch] = times
+	m.reserved[s] = riskReservation{
+		exchange: exch,
+		time:     now,
+		key:      key,
+		amount:   sign * s.Amount,
+	}
+	return nil
+}
+
+// pending returns the exposure reserved by orders being submitted for the
+// pending key. Must be called with the lock held
+func (m *RiskManager) pending(key string) float64 {
+	var amount float64
+	for _, r := range m.reserved {
+		if r.key == key {
+			amount += r.amount
+		}
+	}
+	return amount
+}
+
+// riskPendingKey groups reserved exposure by exchange, asset, pair and side
+func riskPendingKey(s *order.Submit, sign float64) string {
+	return strings.ToLower(s.Exchange) + s.AssetType.String() + s.Pair.String() + strconv.FormatFloat(sign, 'f', 0, 64)
+}
+
+// position returns the net executed position for an exchange pair and the
+// signed remaining amount of open orders on the same side as the new order.
+// Must be called with the lock held
+func (m *RiskManager) position(exchangeName string, p currency.Pair, a asset.Item, side order.Side) (position, open float64) {
+	sign := riskSideSign(side)
+	orders := m.orderManager.GetOrdersSnapshot("")
+	for i := range orders {
+		if !strings.EqualFold(orders[i].Exchange, exchangeName) ||
+			orders[i].AssetType != a ||
+			!orders[i].Pair.Equal(p) {
+			continue
+		}
+		orderSign := riskSideSign(orders[i].Side)
+		position += orderSign * orders[i].ExecutedAmount
+		if orderSign == sign && !isInactiveOrderStatus(orders[i].Status) {
+			remaining := orders[i].Amount - orders[i].ExecutedAmount
+			if remaining > 0 {
+				open += orderSign * remaining
+			}
+		}
+	}
+	return position, open
+}
+
+// dailyPnL returns the profit or loss of orders placed since the start of the
+// UTC day, marked to the last ticker price or the last fill price when no
+// ticker is available. Must be called with the lock held
+func (m *RiskManager) dailyPnL(now time.Time) float64 {
+	type holding struct {
+		exchange  string
+		pair      currency.Pair
+		asset     asset.Item
+		cash      float64
+		amount    float64
+		lastPrice float64
+	}
+	start := now.UTC().Truncate(time.Hour * 24)
+	holdings := make(map[string]*holding)
+	orders := m.orderManager.GetOrdersSnapshot("")
+	for i := range orders {
+		if orders[i].ExecutedAmount <= 0 || orders[i].Date.Before(start) {
+			continue
+		}
+		price := orders[i].AverageExecutedPrice
+		if price <= 0 {
+			price = orders[i].Price
+		}
+		key := strings.ToLower(orders[i].Exchange) + orders[i].AssetType.String() + orders[i].Pair.String()
+		h, ok := holdings[key]
+		if !ok {
+			h = &holding{
+				exchange: orders[i].Exchange,
+				pair:     orders[i].Pair,
+				asset:    orders[i].AssetType,
+			}
+			holdings[key] = h
+		}
+		sign := riskSideSign(orders[i].Side)
+		h.amount += sign * orders[i].ExecutedAmount
+		h.cash -= sign*orders[i].ExecutedAmount*price + orders[i].Fee
+		h.lastPrice = price
+	}
+	var pnl float64
+	for _, h := range holdings {
+		mark := h.lastPrice
+		if t, err := ticker.GetTicker(h.exchange, h.pair, h.asset); err == nil && t.Last > 0 {
+			mark = t.Last
+		}
+		pnl += h.cash + h.amount*mark
+	}
+	return pnl
+}
+
+// recentOrders returns the order times for an exchange which fall within the
+// order rate interval. Must be called with the lock held
+func (m *RiskManager) recentOrders(exch string, now time.Time) []time.Time {
+	times := m.orderTimes[exch]
+	cutoff := now.Add(-m.cfg.OrderRateInterval)
+	i := sort.Search(len(times), func(i int) bool {
+		return times[i].After(cutoff)
+	})
+	return times[i:]
+}
+
+// reject logs, audits and notifies a rejected order
+func (m *RiskManager) reject(s *order.Submit, reason error) {
+	msg := fmt.Sprintf("Risk manager: rejected %s %s %s %s %s order amount=%v price=%v: %v",
+		s.Exchange, s.Pair, s.AssetType, s.Side, s.Type, s.Amount, s.Price, reason)
+	m.notify(msg, s.Exchange)
+}
+
+// notify logs a risk event, records it as an audit event and pushes it to the
+// communications manager
+func (m *RiskManager) notify(msg, identifier string) {
+	log.Warnln(log.OrderMgr, msg)
+	audit.Event(identifier, riskAuditType, msg)
+	m.commsManager.PushEvent(base.Event{
+		Type:    "order",
+		Message: msg,
+	})
+}
+
+// riskSideSign returns 1 for orders which increase a position and -1 for
+// orders which decrease it
+func riskSideSign(s order.Side) float64 {
+	switch s {
+	case order.Buy, order.Bid, order.Long:
+		return 1
+	case order.Sell, order.Ask, order.Short:
+		return -1
+	}
+	return 0
+}
@@ -0,0 +1,506 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+// fakeRiskOrderManager serves a fixed order snapshot and records cancel all
+// requests
+type fakeRiskOrderManager struct {
+	m         sync.Mutex
+	orders    []order.Detail
+	cancelled []string
+}
+
+func (f *fakeRiskOrderManager) GetOrdersSnapshot(_ order.Status) []order.Detail {
+	f.m.Lock()
+	defer f.m.Unlock()
+	return append([]order.Detail(nil), f.orders...)
+}
+
+func (f *fakeRiskOrderManager) CancelAllOrders(_ context.Context, exchanges []exchange.IBotExchange) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	for i := range exchanges {
+		f.cancelled = append(f.cancelled, exchanges[i].GetName())
+	}
+}
+
+func riskManagerSetup(t *testing.T, cfg *config.RiskManager) (*RiskManager, *fakeRiskOrderManager) {
+	t.Helper()
+	em := SetupExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	em.Add(exch)
+	if cfg.OrderRateInterval == 0 {
+		cfg.OrderRateInterval = time.Minute
+	}
+	f := &fakeRiskOrderManager{}
+	m, err := SetupRiskManager(em, f, &CommunicationManager{}, cfg)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	return m, f
+}
+
+func riskOrder(p currency.Pair, side order.Side, orderType order.Type, amount, price float64) *order.Submit {
+	return &order.Submit{
+		Exchange:  testExchange,
+		Pair:      p,
+		AssetType: asset.Spot,
+		Side:      side,
+		Type:      orderType,
+		Amount:    amount,
+		Price:     price,
+	}
+}
+
+func TestSetupRiskManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupRiskManager(nil, nil, nil, nil)
+	if !errors.Is(err, errNilExchangeManager) {
+		t.Errorf("error '%v', expected '%v'", err, errNilExchangeManager)
+	}
+	_, err = SetupRiskManager(SetupExchangeManager(), nil, nil, nil)
+	if !errors.Is(err, errNilOrderManager) {
+		t.Errorf("error '%v', expected '%v'", err, errNilOrderManager)
+	}
+	_, err = SetupRiskManager(SetupExchangeManager(), &fakeRiskOrderManager{}, nil, nil)
+	if !errors.Is(err, errNilCommunicationsManager) {
+		t.Errorf("error '%v', expected '%v'", err, errNilCommunicationsManager)
+	}
+	_, err = SetupRiskManager(SetupExchangeManager(), &fakeRiskOrderManager{}, &CommunicationManager{}, nil)
+	if !errors.Is(err, errNilConfig) {
+		t.Errorf("error '%v', expected '%v'", err, errNilConfig)
+	}
+	m, err := SetupRiskManager(SetupExchangeManager(), &fakeRiskOrderManager{}, &CommunicationManager{}, &config.RiskManager{})
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	if m == nil {
+		t.Error("expected risk manager")
+	}
+}
+
+func TestRiskManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *RiskManager
+	if m.IsRunning() {
+		t.Error("expected not running")
+	}
+	err := m.Start()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+	err = m.Stop()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+	m, _ = riskManagerSetup(t, &config.RiskManager{})
+	err = m.Start()
+	if !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+	err = m.Stop()
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.Stop()
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemNotStarted)
+	}
+	err = m.CheckOrder(&order.Submit{})
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemNotStarted)
+	}
+}
+
+func TestRiskManagerCheckOrder(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.LTC, currency.USDC)
+	err := ticker.ProcessTicker(&ticker.Price{
+		ExchangeName: testExchange,
+		Pair:         p,
+		AssetType:    asset.Spot,
+		Last:         100,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	noTicker := currency.NewPair(currency.LTC, currency.JPY)
+
+	tt := []struct {
+		name   string
+		cfg    config.RiskManager
+		orders []order.Detail
+		submit *order.Submit
+		err    error
+	}{
+		{
+			name:   "no limits",
+			submit: riskOrder(noTicker, order.Buy, order.Market, 1000, 0),
+		},
+		{
+			name:   "notional allowed",
+			cfg:    config.RiskManager{MaxOrderNotional: 500},
+			submit: riskOrder(p, order.Buy, order.Limit, 5, 100),
+		},
+		{
+			name:   "notional exceeded",
+			cfg:    config.RiskManager{MaxOrderNotional: 500},
+			submit: riskOrder(p, order.Buy, order.Limit, 5.1, 100),
+			err:    errRiskMaxOrderNotional,
+		},
+		{
+			name:   "market notional uses ticker",
+			cfg:    config.RiskManager{MaxOrderNotional: 500},
+			submit: riskOrder(p, order.Sell, order.Market, 6, 0),
+			err:    errRiskMaxOrderNotional,
+		},
+		{
+			name:   "market notional without ticker",
+			cfg:    config.RiskManager{MaxOrderNotional: 500},
+			submit: riskOrder(noTicker, order.Sell, order.Market, 1, 0),
+			err:    errRiskNoReferencePrice,
+		},
+		{
+			name:   "price within band",
+			cfg:    config.RiskManager{MaxPriceDeviation: 0.05},
+			submit: riskOrder(p, order.Buy, order.Limit, 1, 105),
+		},
+		{
+			name:   "price outside band",
+			cfg:    config.RiskManager{MaxPriceDeviation: 0.05},
+			submit: riskOrder(p, order.Sell, order.Limit, 1, 94),
+			err:    errRiskPriceOutsideBand,
+		},
+		{
+			name:   "price band without ticker",
+			cfg:    config.RiskManager{MaxPriceDeviation: 0.05},
+			submit: riskOrder(noTicker, order.Sell, order.Limit, 1, 94),
+			err:    errRiskNoReferencePrice,
+		},
+		{
+			name: "position exceeded with open orders",
+			cfg:  config.RiskManager{MaxPositionPerPair: 2},
+			orders: []order.Detail{
+				{Exchange: testExchange, Pair: p, AssetType: asset.Spot, Side: order.Buy, Status: order.Filled, Amount: 1, ExecutedAmount: 1},
+				{Exchange: testExchange, Pair: p, AssetType: asset.Spot, Side: order.Buy, Status: order.Active, Amount: 0.5},
+			},
+			submit: riskOrder(p, order.Buy, order.Limit, 0.6, 100),
+			err:    errRiskMaxPosition,
+		},
+		{
+			name: "position allowed on another pair",
+			cfg:  config.RiskManager{MaxPositionPerPair: 2},
+			orders: []order.Detail{
+				{Exchange: testExchange, Pair: noTicker, AssetType: asset.Spot, Side: order.Buy, Status: order.Filled, Amount: 2, ExecutedAmount: 2},
+			},
+			submit: riskOrder(p, order.Buy, order.Limit, 2, 100),
+		},
+		{
+			name: "position reduction allowed",
+			cfg:  config.RiskManager{MaxPositionPerPair: 2},
+			orders: []order.Detail{
+				{Exchange: testExchange, Pair: p, AssetType: asset.Spot, Side: order.Buy, Status: order.Filled, Amount: 5, ExecutedAmount: 5},
+			},
+			submit: riskOrder(p, order.Sell, order.Limit, 1, 100),
+		},
+		{
+			name: "daily loss within limit",
+			cfg:  config.RiskManager{MaxDailyLoss: 50},
+			orders: []order.Detail{
+				{Exchange: testExchange, Pair: p, AssetType: asset.Spot, Side: order.Buy, Status: order.Filled, Amount: 1, ExecutedAmount: 1, AverageExecutedPrice: 140, Date: time.Now()},
+			},
+			submit: riskOrder(p, order.Buy, order.Limit, 1, 100),
+		},
+		{
+			name: "daily loss exceeded",
+			cfg:  config.RiskManager{MaxDailyLoss: 50},
+			orders: []order.Detail{
+				{Exchange: testExchange, Pair: p, AssetType: asset.Spot, Side: order.Buy, Status: order.Filled, Amount: 1, ExecutedAmount: 1, AverageExecutedPrice: 140, Fee: 10, Date: time.Now()},
+			},
+			submit: riskOrder(p, order.Buy, order.Limit, 1, 100),
+			err:    errRiskDailyLossLimit,
+		},
+		{
+			name: "daily loss ignores previous days",
+			cfg:  config.RiskManager{MaxDailyLoss: 50},
+			orders: []order.Detail{
+				{Exchange: testExchange, Pair: p, AssetType: asset.Spot, Side: order.Buy, Status: order.Filled, Amount: 1, ExecutedAmount: 1, AverageExecutedPrice: 1000, Date: time.Now().Add(-time.Hour * 48)},
+			},
+			submit: riskOrder(p, order.Buy, order.Limit, 1, 100),
+		},
+	}
+	for i := range tt {
+		test := tt[i]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			m, f := riskManagerSetup(t, &test.cfg)
+			f.orders = test.orders
+			err := m.CheckOrder(test.submit)
+			if !errors.Is(err, test.err) {
+				t.Errorf("error '%v', expected '%v'", err, test.err)
+			}
+		})
+	}
+}
+
+func TestRiskManagerOrderRate(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.LTC, currency.CHF)
+	m, _ := riskManagerSetup(t, &config.RiskManager{MaxOrdersPerInterval: 2})
+	orders := make([]*order.Submit, 2)
+	for i := range orders {
+		orders[i] = riskOrder(p, order.Buy, order.Limit, 1, 100)
+		err := m.CheckOrder(orders[i])
+		if !errors.Is(err, nil) {
+			t.Fatalf("error '%v', expected '%v'", err, nil)
+		}
+	}
+	// orders being submitted hold their order rate slot
+	err := m.CheckOrder(riskOrder(p, order.Buy, order.Limit, 1, 100))
+	if !errors.Is(err, errRiskMaxOrderRate) {
+		t.Errorf("error '%v', expected '%v'", err, errRiskMaxOrderRate)
+	}
+
+	// orders rejected by the exchange release their slot
+	m.ReleaseOrder(orders[0], false)
+	m.ReleaseOrder(orders[1], true)
+	if len(m.reserved) != 0 {
+		t.Errorf("received %v reserved orders, expected 0", len(m.reserved))
+	}
+	status, err := m.GetStatus()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if rate := status.OrderRate[strings.ToLower(testExchange)]; rate != 1 {
+		t.Errorf("received order rate %v, expected 1", rate)
+	}
+	err = m.CheckOrder(riskOrder(p, order.Buy, order.Limit, 1, 100))
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.CheckOrder(riskOrder(p, order.Buy, order.Limit, 1, 100))
+	if !errors.Is(err, errRiskMaxOrderRate) {
+		t.Errorf("error '%v', expected '%v'", err, errRiskMaxOrderRate)
+	}
+
+	// orders outside the interval no longer count towards the rate
+	m.m.Lock()
+	m.cfg.OrderRateInterval = time.Nanosecond
+	m.m.Unlock()
+	err = m.CheckOrder(riskOrder(p, order.Buy, order.Limit, 1, 100))
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+}
+
+func TestRiskManagerPendingPosition(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.LTC, currency.SEK)
+	m, _ := riskManagerSetup(t, &config.RiskManager{MaxPositionPerPair: 2})
+	pending := riskOrder(p, order.Buy, order.Limit, 1.5, 100)
+	err := m.CheckOrder(pending)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	// the order being submitted counts towards the position
+	err = m.CheckOrder(riskOrder(p, order.Buy, order.Limit, 1, 100))
+	if !errors.Is(err, errRiskMaxPosition) {
+		t.Errorf("error '%v', expected '%v'", err, errRiskMaxPosition)
+	}
+	err = m.CheckOrder(riskOrder(p, order.Sell, order.Limit, 1, 100))
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	m.ReleaseOrder(pending, false)
+	err = m.CheckOrder(riskOrder(p, order.Buy, order.Limit, 1, 100))
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+}
+
+func TestRiskSideSign(t *testing.T) {
+	t.Parallel()
+	for _, s := range []order.Side{order.Buy, order.Bid, order.Long} {
+		if sign := riskSideSign(s); sign != 1 {
+			t.Errorf("received %v for %s, expected 1", sign, s)
+		}
+	}
+	for _, s := range []order.Side{order.Sell, order.Ask, order.Short} {
+		if sign := riskSideSign(s); sign != -1 {
+			t.Errorf("received %v for %s, expected -1", sign, s)
+		}
+	}
+	if sign := riskSideSign(order.AnySide); sign != 0 {
+		t.Errorf("received %v for %s, expected 0", sign, order.AnySide)
+	}
+}
+
+func TestRiskManagerKillSwitch(t *testing.T) {
+	t.Parallel()
+	var m *RiskManager
+	err := m.ActivateKillSwitch(context.Background(), "test")
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("error '%v', expected '%v'", err, ErrNilSubsystem)
+	}
+	m, f := riskManagerSetup(t, &config.RiskManager{})
+	err = m.DeactivateKillSwitch()
+	if !errors.Is(err, errRiskKillSwitchNotActive) {
+		t.Errorf("error '%v', expected '%v'", err, errRiskKillSwitchNotActive)
+	}
+	err = m.ActivateKillSwitch(context.Background(), "test")
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if len(f.cancelled) != 1 || f.cancelled[0] != testExchange {
+		t.Errorf("received cancelled exchanges %v, expected %v", f.cancelled, testExchange)
+	}
+	p := currency.NewPair(currency.LTC, currency.EUR)
+	err = m.CheckOrder(riskOrder(p, order.Sell, order.Limit, 1, 100))
+	if !errors.Is(err, errRiskKillSwitchActive) {
+		t.Errorf("error '%v', expected '%v'", err, errRiskKillSwitchActive)
+	}
+	status, err := m.GetStatus()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if !status.KillSwitch || status.KillSwitchReason != "test" {
+		t.Errorf("received kill switch %v reason %v, expected true test", status.KillSwitch, status.KillSwitchReason)
+	}
+	err = m.DeactivateKillSwitch()
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.CheckOrder(riskOrder(p, order.Sell, order.Limit, 1, 100))
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+}
+
+func TestOrderManagerRiskChecker(t *testing.T) {
+	t.Parallel()
+	om, _ := orderGroupsSetup(t)
+	rm, err := SetupRiskManager(om.orderStore.exchangeManager, om, &CommunicationManager{}, &config.RiskManager{MaxOrderNotional: 100, OrderRateInterval: time.Minute})
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	om.setRiskChecker(rm)
+
+	// a stopped risk manager does not block orders
+	_, err = om.Submit(context.Background(), orderGroupLeg(order.Buy, order.Limit, 2, 100, 0))
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = rm.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	_, err = om.Submit(context.Background(), orderGroupLeg(order.Buy, order.Limit, 2, 100, 0))
+	if !errors.Is(err, errRiskMaxOrderNotional) {
+		t.Errorf("error '%v', expected '%v'", err, errRiskMaxOrderNotional)
+	}
+	_, err = om.Submit(context.Background(), orderGroupLeg(order.Buy, order.Limit, 1, 100, 0))
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	if orders := om.GetOrdersSnapshot(""); len(orders) != 2 {
+		t.Errorf("received %v orders, expected 2", len(orders))
+	}
+	// only the order accepted while running counts towards the order rate
+	status, err := rm.GetStatus()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	if rate := status.OrderRate[strings.ToLower(testExchange)]; rate != 1 {
+		t.Errorf("received order rate %v, expected 1", rate)
+	}
+}
+
+func TestRiskManagerConcurrentOrders(t *testing.T) {
+	t.Parallel()
+	tt := []struct {
+		name string
+		cfg  config.RiskManager
+		err  error
+	}{
+		{
+			name: "position",
+			cfg:  config.RiskManager{MaxPositionPerPair: 4, OrderRateInterval: time.Minute},
+			err:  errRiskMaxPosition,
+		},
+		{
+			name: "order rate",
+			cfg:  config.RiskManager{MaxOrdersPerInterval: 4, OrderRateInterval: time.Minute},
+			err:  errRiskMaxOrderRate,
+		},
+	}
+	for i := range tt {
+		test := tt[i]
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			om, _ := orderGroupsSetup(t)
+			rm, err := SetupRiskManager(om.orderStore.exchangeManager, om, &CommunicationManager{}, &test.cfg)
+			if !errors.Is(err, nil) {
+				t.Fatalf("error '%v', expected '%v'", err, nil)
+			}
+			err = rm.Start()
+			if !errors.Is(err, nil) {
+				t.Fatalf("error '%v', expected '%v'", err, nil)
+			}
+			om.setRiskChecker(rm)
+
+			// orders submitted at once cannot all pass the limit before
+			// any of them is accepted by the exchange
+			var wg sync.WaitGroup
+			var rejected int64
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					_, err := om.Submit(context.Background(), orderGroupLeg(order.Buy, order.Limit, 1, 100, 0))
+					switch {
+					case errors.Is(err, test.err):
+						atomic.AddInt64(&rejected, 1)
+					case err != nil:
+						t.Errorf("error '%v', expected '%v'", err, test.err)
+					}
+				}()
+			}
+			wg.Wait()
+			if orders := om.GetOrdersSnapshot(""); len(orders) != 4 {
+				t.Errorf("received %v orders, expected 4", len(orders))
+			}
+			if rejected != 6 {
+				t.Errorf("received %v rejected orders, expected 6", rejected)
+			}
+			rm.m.Lock()
+			reserved := len(rm.reserved)
+			rm.m.Unlock()
+			if reserved != 0 {
+				t.Errorf("received %v reserved orders, expected 0", reserved)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// RiskManagerName is an exported subsystem name
+const RiskManagerName = "risk_manager"
+
+// riskAuditType is the audit event type used for risk manager events
+const riskAuditType = "risk"
+
+var (
+	errRiskKillSwitchActive    = errors.New("kill switch is active, new orders are blocked")
+	errRiskMaxOrderNotional    = errors.New("order notional exceeds the maximum allowed")
+	errRiskMaxPosition         = errors.New("order would exceed the maximum position for the pair")
+	errRiskDailyLossLimit      = errors.New("daily loss limit reached, new orders are blocked")
+	errRiskPriceOutsideBand    = errors.New("order price deviates from the last ticker price by more than allowed")
+	errRiskMaxOrderRate        = errors.New("order rate for the exchange exceeds the maximum allowed")
+	errRiskNoReferencePrice    = errors.New("no reference price available to check order")
+	errRiskKillSwitchNotActive = errors.New("kill switch is not active")
+)
+
+// RiskManager runs pre-trade risk checks on every order submitted via the
+// order manager and provides a kill switch to cancel all orders and block new
+// ones
+type RiskManager struct {
+	started         int32
+	exchangeManager iExchangeManager
+	orderManager    iRiskOrderManager
+	commsManager    iCommsManager
+
+	m          sync.Mutex
+	cfg        config.RiskManager
+	killSwitch bool
+	killReason string
+	orderTimes map[string][]time.Time
+	// reserved holds the orders which passed the checks and are being
+	// submitted, so concurrent orders count towards the limits before the
+	// exchange accepts them
+	reserved map[*order.Submit]riskReservation
+}
+
+// riskReservation is the order rate slot and pending exposure reserved for an
+// order being submitted
+type riskReservation struct {
+	exchange string
+	time     time.Time
+	key      string
+	amount   float64
+}
+
+// RiskStatus is a snapshot of the risk manager limits and current state
+type RiskStatus struct {
+	Limits           config.RiskManager
+	KillSwitch       bool
+	KillSwitchReason string
+	// DailyPnL is the profit or loss since the start of the UTC day summed
+	// across all pairs in their quote currency
+	DailyPnL float64
+	// OrderRate is the number of orders submitted per exchange within the
+	// order rate interval
+	OrderRate map[string]int
+}
@@ -111,8 +111,8 @@ func (s *RPCServer) authenticateClient(ctx context.Context) (context.Context, er
 // StartRPCServer starts a gRPC server with TLS auth
 func StartRPCServer(engine *Engine) {
 	targetDir := utils.GetTLSDir(engine.Settings.DataDir)
-	if err := checkCerts(targetDir); err != nil {
-		log.Errorf(log.GRPCSys, "gRPC checkCerts failed. err: %s\n", err)
+	if err := CheckCerts(targetDir); err != nil {
+		log.Errorf(log.GRPCSys, "gRPC CheckCerts failed. err: %s\n", err)
 		return
 	}
 	log.Debugf(log.GRPCSys, "gRPC server support enabled. Starting gRPC server on https://%v.\n", engine.Config.RemoteControl.GRPC.ListenAddress)
@@ -1483,19 +1483,22 @@ func (s *RPCServer) ModifyOrder(ctx context.Context, r *gctrpc.ModifyOrderReques
 
 // GetEvents returns the stored events list
 func (s *RPCServer) GetEvents(_ context.Context, _ *gctrpc.GetEventsRequest) (*gctrpc.GetEventsResponse, error) {
-	return &gctrpc.GetEventsResponse{}, common.ErrNotYetImplemented
+	events, err := s.eventManager.GetEvents()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetEventsResponse{Events: make([]*gctrpc.EventDetails, len(events))}
+	for i := range events {
+		resp.Events[i] = eventToRPC(&events[i])
+	}
+	return resp, nil
 }
 
 // AddEvent adds an event
 func (s *RPCServer) AddEvent(_ context.Context, r *gctrpc.AddEventRequest) (*gctrpc.AddEventResponse, error) {
-	evtCondition := EventConditionParams{
-		CheckBids:       r.ConditionParams.CheckBids,
-		CheckAsks:       r.ConditionParams.CheckAsks,
-		Condition:       r.ConditionParams.Condition,
-		OrderbookAmount: r.ConditionParams.OrderbookAmount,
-		Price:           r.ConditionParams.Price,
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
 	}
-
 	p := currency.NewPairWithDelimiter(r.Pair.Base,
 		r.Pair.Quote, r.Pair.Delimiter)
 
@@ -1514,7 +1517,27 @@ func (s *RPCServer) AddEvent(_ context.Context, r *gctrpc.AddEventRequest) (*gct
 		return nil, err
 	}
 
-	id, err := s.eventManager.Add(r.Exchange, r.Item, evtCondition, p, a, r.Action)
+	evt := &Event{
+		Exchange:  r.Exchange,
+		Item:      r.Item,
+		Condition: eventConditionParamsFromRPC(r.ConditionParams),
+		Pair:      p,
+		Asset:     a,
+		Action:    r.Action,
+	}
+	if r.Conditions != nil {
+		evt.Conditions = eventConditionNodeFromRPC(r.Conditions)
+	}
+	for i := range r.Actions {
+		var action EventAction
+		action, err = eventActionFromRPC(r.Actions[i])
+		if err != nil {
+			return nil, err
+		}
+		evt.Actions = append(evt.Actions, action)
+	}
+
+	id, err := s.eventManager.AddEvent(evt)
 	if err != nil {
 		return nil, err
 	}
@@ -4106,3 +4129,1144 @@ func (s *RPCServer) CurrencyStateTradingPair(_ context.Context, r *gctrpc.Curren
 		cp,
 		asset.Item(r.Asset))
 }
+
+// AddConditionalOrder adds a stop-loss, take-profit or trailing stop order to
+// be held by the engine and submitted once its trigger is hit
+func (s *RPCServer) AddConditionalOrder(_ context.Context, r *gctrpc.AddConditionalOrderRequest) (*gctrpc.ConditionalOrderDetails, error) {
+	a, err := asset.New(r.Asset)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+
+	p := currency.Pair{
+		Delimiter: r.Pair.Delimiter,
+		Base:      currency.NewCode(r.Pair.Base),
+		Quote:     currency.NewCode(r.Pair.Quote),
+	}
+
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkParams(r.Exchange, exch, a, p)
+	if err != nil {
+		return nil, err
+	}
+
+	o, err := s.conditionalOrderManager.Add(&ConditionalOrder{
+		Exchange:        r.Exchange,
+		Pair:            p,
+		AssetType:       a,
+		Side:            order.Side(strings.ToUpper(r.Side)),
+		Type:            order.Type(strings.ToUpper(r.OrderType)),
+		Amount:          r.Amount,
+		TriggerPrice:    r.TriggerPrice,
+		LimitPrice:      r.LimitPrice,
+		TrailingAmount:  r.TrailingAmount,
+		TrailingPercent: r.TrailingPercent,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return conditionalOrderToRPC(o), nil
+}
+
+// CancelConditionalOrder cancels a pending conditional order
+func (s *RPCServer) CancelConditionalOrder(_ context.Context, r *gctrpc.CancelConditionalOrderRequest) (*gctrpc.GenericResponse, error) {
+	id, err := uuid.FromString(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	err = s.conditionalOrderManager.Cancel(id)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess,
+		Data: fmt.Sprintf("conditional order %v cancelled", id)}, nil
+}
+
+// GetConditionalOrders returns conditional orders held by the engine
+func (s *RPCServer) GetConditionalOrders(_ context.Context, r *gctrpc.GetConditionalOrdersRequest) (*gctrpc.GetConditionalOrdersResponse, error) {
+	orders, err := s.conditionalOrderManager.GetOrders(r.Exchange, r.IncludeInactive)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetConditionalOrdersResponse{
+		Orders: make([]*gctrpc.ConditionalOrderDetails, len(orders)),
+	}
+	for i := range orders {
+		resp.Orders[i] = conditionalOrderToRPC(&orders[i])
+	}
+	return resp, nil
+}
+
+// GetConditionalOrderHistory returns the trigger history of conditional orders
+func (s *RPCServer) GetConditionalOrderHistory(_ context.Context, r *gctrpc.GetConditionalOrderHistoryRequest) (*gctrpc.GetConditionalOrderHistoryResponse, error) {
+	history, err := s.conditionalOrderManager.GetTriggerHistory(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetConditionalOrderHistoryResponse{
+		Triggers: make([]*gctrpc.ConditionalOrderTrigger, len(history)),
+	}
+	for i := range history {
+		resp.Triggers[i] = &gctrpc.ConditionalOrderTrigger{
+			ConditionalOrderId: history[i].ConditionalOrderID.String(),
+			Exchange:           history[i].Exchange,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: history[i].Pair.Delimiter,
+				Base:      history[i].Pair.Base.String(),
+				Quote:     history[i].Pair.Quote.String(),
+			},
+			Asset:          history[i].AssetType.String(),
+			Side:           history[i].Side.String(),
+			OrderType:      history[i].Type.String(),
+			TriggerPrice:   history[i].TriggerPrice,
+			TriggeredPrice: history[i].TriggeredPrice,
+			OrderId:        history[i].OrderID,
+			Error:          history[i].Error,
+			Time:           history[i].Time.Format(common.SimpleTimeFormat),
+		}
+	}
+	return resp, nil
+}
+
+// conditionalOrderToRPC converts a conditional order to its RPC representation
+func conditionalOrderToRPC(o *ConditionalOrder) *gctrpc.ConditionalOrderDetails {
+	resp := &gctrpc.ConditionalOrderDetails{
+		Id:       o.ID.String(),
+		Exchange: o.Exchange,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: o.Pair.Delimiter,
+			Base:      o.Pair.Base.String(),
+			Quote:     o.Pair.Quote.String(),
+		},
+		Asset:           o.AssetType.String(),
+		Side:            o.Side.String(),
+		OrderType:       o.Type.String(),
+		Amount:          o.Amount,
+		TriggerPrice:    o.TriggerPrice,
+		LimitPrice:      o.LimitPrice,
+		TrailingAmount:  o.TrailingAmount,
+		TrailingPercent: o.TrailingPercent,
+		ReferencePrice:  o.ReferencePrice,
+		Status:          string(o.Status),
+		TriggeredPrice:  o.TriggeredPrice,
+		OrderId:         o.OrderID,
+		Error:           o.Error,
+		CreatedAt:       o.CreatedAt.Format(common.SimpleTimeFormat),
+		UpdatedAt:       o.UpdatedAt.Format(common.SimpleTimeFormat),
+	}
+	if !o.TriggeredAt.IsZero() {
+		resp.TriggeredAt = o.TriggeredAt.Format(common.SimpleTimeFormat)
+	}
+	return resp
+}
+
+// SubmitOCOOrder submits a group of orders where a fill on any leg cancels
+// the remaining legs
+func (s *RPCServer) SubmitOCOOrder(ctx context.Context, r *gctrpc.SubmitOCOOrderRequest) (*gctrpc.OrderGroupDetails, error) {
+	a, p, err := s.orderGroupParams(r.Exchange, r.Pair, r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	legs := make([]*order.Submit, len(r.Legs))
+	for i := range r.Legs {
+		legs[i] = orderGroupLegToSubmit(r.Exchange, p, a, r.Legs[i])
+	}
+	g, err := s.OrderManager.SubmitOCO(ctx, legs...)
+	if g == nil {
+		return nil, err
+	}
+	return orderGroupToRPC(g), err
+}
+
+// SubmitBracketOrder submits an entry order which, once filled, submits a
+// take-profit and stop-loss pair as one-cancels-other
+func (s *RPCServer) SubmitBracketOrder(ctx context.Context, r *gctrpc.SubmitBracketOrderRequest) (*gctrpc.OrderGroupDetails, error) {
+	a, p, err := s.orderGroupParams(r.Exchange, r.Pair, r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	if r.Entry == nil || r.TakeProfit == nil || r.StopLoss == nil {
+		return nil, errNilOrder
+	}
+	g, err := s.OrderManager.SubmitBracket(ctx,
+		orderGroupLegToSubmit(r.Exchange, p, a, r.Entry),
+		orderGroupLegToSubmit(r.Exchange, p, a, r.TakeProfit),
+		orderGroupLegToSubmit(r.Exchange, p, a, r.StopLoss))
+	if g == nil {
+		return nil, err
+	}
+	return orderGroupToRPC(g), err
+}
+
+// CancelOrderGroup cancels all outstanding legs of an order group
+func (s *RPCServer) CancelOrderGroup(ctx context.Context, r *gctrpc.CancelOrderGroupRequest) (*gctrpc.GenericResponse, error) {
+	id, err := uuid.FromString(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	err = s.OrderManager.CancelOrderGroup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess,
+		Data: fmt.Sprintf("order group %v cancelled", id)}, nil
+}
+
+// GetOrderGroup returns an order group and the state of its legs
+func (s *RPCServer) GetOrderGroup(_ context.Context, r *gctrpc.GetOrderGroupRequest) (*gctrpc.OrderGroupDetails, error) {
+	id, err := uuid.FromString(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	g, err := s.OrderManager.GetOrderGroup(id)
+	if err != nil {
+		return nil, err
+	}
+	return orderGroupToRPC(g), nil
+}
+
+// GetOrderGroups returns order groups managed by the order manager
+func (s *RPCServer) GetOrderGroups(_ context.Context, r *gctrpc.GetOrderGroupsRequest) (*gctrpc.GetOrderGroupsResponse, error) {
+	groups, err := s.OrderManager.GetOrderGroups(r.Exchange, r.IncludeInactive)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetOrderGroupsResponse{
+		Groups: make([]*gctrpc.OrderGroupDetails, len(groups)),
+	}
+	for i := range groups {
+		resp.Groups[i] = orderGroupToRPC(&groups[i])
+	}
+	return resp, nil
+}
+
+// orderGroupParams validates the shared exchange, pair and asset of an order
+// group request
+func (s *RPCServer) orderGroupParams(exchName string, pair *gctrpc.CurrencyPair, assetType string) (asset.Item, currency.Pair, error) {
+	a, err := asset.New(assetType)
+	if err != nil {
+		return "", currency.Pair{}, err
+	}
+	if pair == nil {
+		return "", currency.Pair{}, errCurrencyPairUnset
+	}
+	p := currency.Pair{
+		Delimiter: pair.Delimiter,
+		Base:      currency.NewCode(pair.Base),
+		Quote:     currency.NewCode(pair.Quote),
+	}
+	exch, err := s.GetExchangeByName(exchName)
+	if err != nil {
+		return "", currency.Pair{}, err
+	}
+	err = checkParams(exchName, exch, a, p)
+	if err != nil {
+		return "", currency.Pair{}, err
+	}
+	return a, p, nil
+}
+
+// orderGroupLegToSubmit converts an RPC order group leg to an order
+// submission
+func orderGroupLegToSubmit(exchName string, p currency.Pair, a asset.Item, leg *gctrpc.OrderGroupLegRequest) *order.Submit {
+	if leg == nil {
+		return nil
+	}
+	return &order.Submit{
+		Exchange:      exchName,
+		Pair:          p,
+		AssetType:     a,
+		Side:          order.Side(strings.ToUpper(leg.Side)),
+		Type:          order.Type(strings.ToUpper(leg.OrderType)),
+		Amount:        leg.Amount,
+		Price:         leg.Price,
+		TriggerPrice:  leg.TriggerPrice,
+		ClientID:      leg.ClientId,
+		ClientOrderID: leg.ClientId,
+	}
+}
+
+// orderGroupToRPC converts an order group to its RPC representation
+func orderGroupToRPC(g *OrderGroup) *gctrpc.OrderGroupDetails {
+	resp := &gctrpc.OrderGroupDetails{
+		Id:        g.ID.String(),
+		Type:      string(g.Type),
+		Status:    string(g.Status),
+		Legs:      make([]*gctrpc.OrderGroupLeg, len(g.Legs)),
+		Error:     g.Error,
+		CreatedAt: g.CreatedAt.Format(common.SimpleTimeFormat),
+		UpdatedAt: g.UpdatedAt.Format(common.SimpleTimeFormat),
+	}
+	for i := range g.Legs {
+		leg := &g.Legs[i]
+		if i == 0 {
+			resp.Exchange = leg.Submit.Exchange
+			resp.Pair = &gctrpc.CurrencyPair{
+				Delimiter: leg.Submit.Pair.Delimiter,
+				Base:      leg.Submit.Pair.Base.String(),
+				Quote:     leg.Submit.Pair.Quote.String(),
+			}
+			resp.Asset = leg.Submit.AssetType.String()
+		}
+		var conditionalID string
+		if !leg.ConditionalOrderID.IsNil() {
+			conditionalID = leg.ConditionalOrderID.String()
+		}
+		resp.Legs[i] = &gctrpc.OrderGroupLeg{
+			Role:               string(leg.Role),
+			Side:               leg.Submit.Side.String(),
+			OrderType:          leg.Submit.Type.String(),
+			Amount:             leg.Submit.Amount,
+			Price:              leg.Submit.Price,
+			TriggerPrice:       leg.Submit.TriggerPrice,
+			OrderId:            leg.OrderID,
+			InternalOrderId:    leg.InternalOrderID,
+			ConditionalOrderId: conditionalID,
+			Status:             leg.Status.String(),
+			ExecutedAmount:     leg.ExecutedAmount,
+			Error:              leg.Error,
+		}
+	}
+	return resp
+}
+
+// SubmitExecutionOrder submits a parent order which is split into child
+// orders over time using a TWAP or VWAP schedule
+func (s *RPCServer) SubmitExecutionOrder(ctx context.Context, r *gctrpc.SubmitExecutionOrderRequest) (*gctrpc.ExecutionOrderDetails, error) {
+	a, p, err := s.orderGroupParams(r.Exchange, r.Pair, r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	var start time.Time
+	if r.Start != "" {
+		start, err = time.Parse(common.SimpleTimeFormat, r.Start)
+		if err != nil {
+			return nil, fmt.Errorf("%w cannot parse start time %v", errInvalidTimes, err)
+		}
+	}
+	end, err := time.Parse(common.SimpleTimeFormat, r.End)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse end time %v", errInvalidTimes, err)
+	}
+	o, err := s.executionManager.Submit(ctx, &ParentOrder{
+		Exchange:       r.Exchange,
+		Pair:           p,
+		AssetType:      a,
+		Side:           order.Side(strings.ToUpper(r.Side)),
+		Strategy:       ExecutionStrategy(strings.ToUpper(r.Strategy)),
+		Amount:         r.Amount,
+		LimitPrice:     r.LimitPrice,
+		StartTime:      start,
+		EndTime:        end,
+		Slices:         int(r.Slices),
+		VolumeInterval: kline.Interval(r.VolumeInterval),
+		VolumeLookback: time.Duration(r.VolumeLookback),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return executionOrderToRPC(o), nil
+}
+
+// PauseExecutionOrder stops a parent order from submitting further slices
+// until resumed
+func (s *RPCServer) PauseExecutionOrder(_ context.Context, r *gctrpc.ExecutionOrderRequest) (*gctrpc.GenericResponse, error) {
+	id, err := uuid.FromString(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	err = s.executionManager.Pause(id)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess,
+		Data: fmt.Sprintf("execution order %v paused", id)}, nil
+}
+
+// ResumeExecutionOrder continues a paused parent order
+func (s *RPCServer) ResumeExecutionOrder(_ context.Context, r *gctrpc.ExecutionOrderRequest) (*gctrpc.GenericResponse, error) {
+	id, err := uuid.FromString(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	err = s.executionManager.Resume(id)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess,
+		Data: fmt.Sprintf("execution order %v resumed", id)}, nil
+}
+
+// CancelExecutionOrder cancels a parent order and any of its open child
+// orders
+func (s *RPCServer) CancelExecutionOrder(ctx context.Context, r *gctrpc.ExecutionOrderRequest) (*gctrpc.GenericResponse, error) {
+	id, err := uuid.FromString(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	err = s.executionManager.Cancel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess,
+		Data: fmt.Sprintf("execution order %v cancelled", id)}, nil
+}
+
+// GetExecutionOrder returns a parent order, its schedule and fill progress
+func (s *RPCServer) GetExecutionOrder(_ context.Context, r *gctrpc.ExecutionOrderRequest) (*gctrpc.ExecutionOrderDetails, error) {
+	id, err := uuid.FromString(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	o, err := s.executionManager.GetOrder(id)
+	if err != nil {
+		return nil, err
+	}
+	return executionOrderToRPC(o), nil
+}
+
+// GetExecutionOrders returns parent orders managed by the execution manager
+func (s *RPCServer) GetExecutionOrders(_ context.Context, r *gctrpc.GetExecutionOrdersRequest) (*gctrpc.GetExecutionOrdersResponse, error) {
+	orders, err := s.executionManager.GetOrders(r.Exchange, r.IncludeInactive)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetExecutionOrdersResponse{
+		Orders: make([]*gctrpc.ExecutionOrderDetails, len(orders)),
+	}
+	for i := range orders {
+		resp.Orders[i] = executionOrderToRPC(&orders[i])
+	}
+	return resp, nil
+}
+
+// executionOrderToRPC converts a parent order to its RPC representation
+func executionOrderToRPC(o *ParentOrder) *gctrpc.ExecutionOrderDetails {
+	resp := &gctrpc.ExecutionOrderDetails{
+		Id:       o.ID.String(),
+		Exchange: o.Exchange,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: o.Pair.Delimiter,
+			Base:      o.Pair.Base.String(),
+			Quote:     o.Pair.Quote.String(),
+		},
+		Asset:            o.AssetType.String(),
+		Side:             o.Side.String(),
+		Strategy:         string(o.Strategy),
+		Amount:           o.Amount,
+		LimitPrice:       o.LimitPrice,
+		Start:            o.StartTime.Format(common.SimpleTimeFormat),
+		End:              o.EndTime.Format(common.SimpleTimeFormat),
+		Status:           string(o.Status),
+		Schedule:         make([]*gctrpc.ExecutionSlice, len(o.Schedule)),
+		ArrivalPrice:     o.ArrivalPrice,
+		BenchmarkPrice:   o.BenchmarkPrice,
+		SubmittedAmount:  o.SubmittedAmount,
+		ExecutedAmount:   o.ExecutedAmount,
+		AverageFillPrice: o.AverageFillPrice,
+		SlippageBps:      o.SlippageBps,
+		Error:            o.Error,
+		CreatedAt:        o.CreatedAt.Format(common.SimpleTimeFormat),
+		UpdatedAt:        o.UpdatedAt.Format(common.SimpleTimeFormat),
+	}
+	for i := range o.Schedule {
+		slice := &o.Schedule[i]
+		resp.Schedule[i] = &gctrpc.ExecutionSlice{
+			ScheduledAt:     slice.ScheduledAt.Format(common.SimpleTimeFormat),
+			Weight:          slice.Weight,
+			Amount:          slice.Amount,
+			SubmittedAmount: slice.SubmittedAmount,
+			ExecutedAmount:  slice.ExecutedAmount,
+			AveragePrice:    slice.AveragePrice,
+			MarketPrice:     slice.MarketPrice,
+			OrderId:         slice.OrderID,
+			Status:          string(slice.Status),
+			Error:           slice.Error,
+		}
+		if slice.OrderStatus != "" {
+			resp.Schedule[i].OrderStatus = slice.OrderStatus.String()
+		}
+		if !slice.SubmittedAt.IsZero() {
+			resp.Schedule[i].SubmittedAt = slice.SubmittedAt.Format(common.SimpleTimeFormat)
+		}
+	}
+	return resp
+}
+
+// SubmitIcebergOrder submits an iceberg order which is placed on the exchange
+// one visible clip at a time
+func (s *RPCServer) SubmitIcebergOrder(ctx context.Context, r *gctrpc.SubmitIcebergOrderRequest) (*gctrpc.IcebergOrderDetails, error) {
+	a, p, err := s.orderGroupParams(r.Exchange, r.Pair, r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	o, err := s.OrderManager.SubmitIceberg(ctx, &IcebergOrder{
+		Submit: order.Submit{
+			Exchange:  r.Exchange,
+			Pair:      p,
+			AssetType: a,
+			Side:      order.Side(strings.ToUpper(r.Side)),
+			Type:      order.Limit,
+			Amount:    r.Amount,
+			Price:     r.Price,
+			ClientID:  r.ClientId,
+		},
+		VisibleAmount:  r.VisibleAmount,
+		AmountVariance: r.AmountVariance,
+		PriceVariance:  r.PriceVariance,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return icebergOrderToRPC(o), nil
+}
+
+// CancelIcebergOrder cancels an iceberg order and its visible clip
+func (s *RPCServer) CancelIcebergOrder(ctx context.Context, r *gctrpc.IcebergOrderRequest) (*gctrpc.GenericResponse, error) {
+	id, err := uuid.FromString(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	err = s.OrderManager.CancelIcebergOrder(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess,
+		Data: fmt.Sprintf("iceberg order %v cancelled", id)}, nil
+}
+
+// GetIcebergOrder returns an iceberg order, its clips and aggregate progress
+func (s *RPCServer) GetIcebergOrder(_ context.Context, r *gctrpc.IcebergOrderRequest) (*gctrpc.IcebergOrderDetails, error) {
+	id, err := uuid.FromString(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	o, err := s.OrderManager.GetIcebergOrder(id)
+	if err != nil {
+		return nil, err
+	}
+	return icebergOrderToRPC(o), nil
+}
+
+// GetIcebergOrders returns iceberg orders managed by the order manager
+func (s *RPCServer) GetIcebergOrders(_ context.Context, r *gctrpc.GetIcebergOrdersRequest) (*gctrpc.GetIcebergOrdersResponse, error) {
+	orders, err := s.OrderManager.GetIcebergOrders(r.Exchange, r.IncludeInactive)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetIcebergOrdersResponse{
+		Orders: make([]*gctrpc.IcebergOrderDetails, len(orders)),
+	}
+	for i := range orders {
+		resp.Orders[i] = icebergOrderToRPC(&orders[i])
+	}
+	return resp, nil
+}
+
+// icebergOrderToRPC converts an iceberg order to its RPC representation
+func icebergOrderToRPC(o *IcebergOrder) *gctrpc.IcebergOrderDetails {
+	resp := &gctrpc.IcebergOrderDetails{
+		Id:       o.ID.String(),
+		Exchange: o.Submit.Exchange,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: o.Submit.Pair.Delimiter,
+			Base:      o.Submit.Pair.Base.String(),
+			Quote:     o.Submit.Pair.Quote.String(),
+		},
+		Asset:                o.Submit.AssetType.String(),
+		Side:                 o.Submit.Side.String(),
+		Amount:               o.Submit.Amount,
+		Price:                o.Submit.Price,
+		VisibleAmount:        o.VisibleAmount,
+		AmountVariance:       o.AmountVariance,
+		PriceVariance:        o.PriceVariance,
+		Status:               string(o.Status),
+		OrderStatus:          o.Detail().Status.String(),
+		Clips:                make([]*gctrpc.IcebergClip, len(o.Clips)),
+		ExecutedAmount:       o.ExecutedAmount,
+		RemainingAmount:      o.RemainingAmount,
+		AverageExecutedPrice: o.AverageExecutedPrice,
+		Error:                o.Error,
+		CreatedAt:            o.CreatedAt.Format(common.SimpleTimeFormat),
+		UpdatedAt:            o.UpdatedAt.Format(common.SimpleTimeFormat),
+	}
+	for i := range o.Clips {
+		resp.Clips[i] = &gctrpc.IcebergClip{
+			OrderId:              o.Clips[i].OrderID,
+			Amount:               o.Clips[i].Amount,
+			Price:                o.Clips[i].Price,
+			ExecutedAmount:       o.Clips[i].ExecutedAmount,
+			AverageExecutedPrice: o.Clips[i].AverageExecutedPrice,
+			Status:               o.Clips[i].Status.String(),
+			Error:                o.Clips[i].Error,
+			SubmittedAt:          o.Clips[i].SubmittedAt.Format(common.SimpleTimeFormat),
+		}
+	}
+	return resp
+}
+
+// GetRiskManagerStatus returns the risk manager limits, kill switch state,
+// daily profit or loss and order rate per exchange
+func (s *RPCServer) GetRiskManagerStatus(_ context.Context, _ *gctrpc.GetRiskManagerStatusRequest) (*gctrpc.GetRiskManagerStatusResponse, error) {
+	status, err := s.riskManager.GetStatus()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetRiskManagerStatusResponse{
+		MaxOrderNotional:     status.Limits.MaxOrderNotional,
+		MaxPositionPerPair:   status.Limits.MaxPositionPerPair,
+		MaxDailyLoss:         status.Limits.MaxDailyLoss,
+		MaxPriceDeviation:    status.Limits.MaxPriceDeviation,
+		MaxOrdersPerInterval: status.Limits.MaxOrdersPerInterval,
+		OrderRateInterval:    int64(status.Limits.OrderRateInterval),
+		KillSwitch:           status.KillSwitch,
+		KillSwitchReason:     status.KillSwitchReason,
+		DailyPnl:             status.DailyPnL,
+		OrderRate:            make(map[string]int64, len(status.OrderRate)),
+	}
+	for exch, rate := range status.OrderRate {
+		resp.OrderRate[exch] = int64(rate)
+	}
+	return resp, nil
+}
+
+// SetKillSwitch activates the kill switch, cancelling all orders and blocking
+// new ones, or deactivates it
+func (s *RPCServer) SetKillSwitch(ctx context.Context, r *gctrpc.SetKillSwitchRequest) (*gctrpc.GenericResponse, error) {
+	if !r.Active {
+		err := s.riskManager.DeactivateKillSwitch()
+		if err != nil {
+			return nil, err
+		}
+		return &gctrpc.GenericResponse{Status: MsgStatusSuccess,
+			Data: "kill switch deactivated"}, nil
+	}
+	reason := r.Reason
+	if reason == "" {
+		reason = "activated via gRPC"
+	}
+	err := s.riskManager.ActivateKillSwitch(ctx, reason)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess,
+		Data: "kill switch activated, all orders cancelled"}, nil
+}
+
+// eventConditionParamsFromRPC converts gRPC condition params, a nil value
+// returns empty params
+func eventConditionParamsFromRPC(c *gctrpc.ConditionParams) EventConditionParams {
+	return EventConditionParams{
+		Condition:       c.GetCondition(),
+		Price:           c.GetPrice(),
+		CheckBids:       c.GetCheckBids(),
+		CheckAsks:       c.GetCheckAsks(),
+		OrderbookAmount: c.GetOrderbookAmount(),
+	}
+}
+
+// eventConditionNodeFromRPC converts a gRPC condition tree
+func eventConditionNodeFromRPC(n *gctrpc.EventConditionNode) *EventConditionNode {
+	node := &EventConditionNode{Operator: n.Operator}
+	if n.Condition != nil {
+		node.Condition = &EventCondition{
+			Item:                 n.Condition.Item,
+			EventConditionParams: eventConditionParamsFromRPC(n.Condition.ConditionParams),
+			Value:                n.Condition.Value,
+			Window:               time.Duration(n.Condition.Window),
+			Interval:             kline.Interval(n.Condition.Interval),
+			Indicator:            n.Condition.Indicator,
+			Period:               n.Condition.Period,
+		}
+	}
+	for i := range n.Children {
+		if n.Children[i] == nil {
+			continue
+		}
+		node.Children = append(node.Children, *eventConditionNodeFromRPC(n.Children[i]))
+	}
+	return node
+}
+
+// eventActionFromRPC converts a gRPC event action
+func eventActionFromRPC(a *gctrpc.EventAction) (EventAction, error) {
+	if a == nil {
+		return EventAction{}, errInvalidAction
+	}
+	action := EventAction{
+		Type:    a.Type,
+		Message: a.Message,
+		Relayer: a.Relayer,
+		Script:  a.Script,
+	}
+	if a.Order != nil {
+		side, err := order.StringToOrderSide(a.Order.Side)
+		if err != nil {
+			return EventAction{}, err
+		}
+		oType, err := order.StringToOrderType(a.Order.Type)
+		if err != nil {
+			return EventAction{}, err
+		}
+		action.Order = &EventOrder{
+			Side:     side,
+			Type:     oType,
+			Amount:   a.Order.Amount,
+			Price:    a.Order.Price,
+			ClientID: a.Order.ClientId,
+		}
+	}
+	return action, nil
+}
+
+// eventToRPC converts an event to its gRPC representation
+func eventToRPC(e *Event) *gctrpc.EventDetails {
+	resp := &gctrpc.EventDetails{
+		Id:       e.ID,
+		Exchange: e.Exchange,
+		Item:     e.Item,
+		ConditionParams: &gctrpc.ConditionParams{
+			Condition:       e.Condition.Condition,
+			Price:           e.Condition.Price,
+			CheckBids:       e.Condition.CheckBids,
+			CheckAsks:       e.Condition.CheckAsks,
+			OrderbookAmount: e.Condition.OrderbookAmount,
+		},
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: e.Pair.Delimiter,
+			Base:      e.Pair.Base.String(),
+			Quote:     e.Pair.Quote.String(),
+		},
+		Action:      e.Action,
+		Executed:    e.Executed,
+		AssetType:   e.Asset.String(),
+		Description: e.String(),
+		CreatedAt:   e.CreatedAt.Format(common.SimpleTimeFormat),
+	}
+	if e.Conditions != nil {
+		resp.Conditions = eventConditionNodeToRPC(e.Conditions)
+	}
+	if !e.ExecutedAt.IsZero() {
+		resp.ExecutedAt = e.ExecutedAt.Format(common.SimpleTimeFormat)
+	}
+	for i := range e.Actions {
+		action := &gctrpc.EventAction{
+			Type:    e.Actions[i].Type,
+			Message: e.Actions[i].Message,
+			Relayer: e.Actions[i].Relayer,
+			Script:  e.Actions[i].Script,
+		}
+		if e.Actions[i].Order != nil {
+			action.Order = &gctrpc.EventOrder{
+				Side:     e.Actions[i].Order.Side.String(),
+				Type:     e.Actions[i].Order.Type.String(),
+				Amount:   e.Actions[i].Order.Amount,
+				Price:    e.Actions[i].Order.Price,
+				ClientId: e.Actions[i].Order.ClientID,
+			}
+		}
+		resp.Actions = append(resp.Actions, action)
+	}
+	return resp
+}
+
+// eventConditionNodeToRPC converts a condition tree to its gRPC
+// representation
+func eventConditionNodeToRPC(n *EventConditionNode) *gctrpc.EventConditionNode {
+	node := &gctrpc.EventConditionNode{Operator: n.Operator}
+	if n.Condition != nil {
+		node.Condition = &gctrpc.EventCondition{
+			Item: n.Condition.Item,
+			ConditionParams: &gctrpc.ConditionParams{
+				Condition:       n.Condition.Condition,
+				Price:           n.Condition.Price,
+				CheckBids:       n.Condition.CheckBids,
+				CheckAsks:       n.Condition.CheckAsks,
+				OrderbookAmount: n.Condition.OrderbookAmount,
+			},
+			Value:     n.Condition.Value,
+			Window:    int64(n.Condition.Window),
+			Interval:  int64(n.Condition.Interval),
+			Indicator: n.Condition.Indicator,
+			Period:    n.Condition.Period,
+		}
+	}
+	for i := range n.Children {
+		node.Children = append(node.Children, eventConditionNodeToRPC(&n.Children[i]))
+	}
+	return node
+}
+
+// GetFuturesPositions returns futures positions tracked from order fills.
+// Exchange, asset and pair are optional filters
+func (s *RPCServer) GetFuturesPositions(_ context.Context, r *gctrpc.GetFuturesPositionsRequest) (*gctrpc.GetFuturesPositionsResponse, error) {
+	var a asset.Item
+	if r.Asset != "" {
+		var err error
+		a, err = asset.New(r.Asset)
+		if err != nil {
+			return nil, err
+		}
+		if !a.IsFutures() {
+			return nil, fmt.Errorf("%s %w", a, order.ErrNotFuturesAsset)
+		}
+	}
+	var p currency.Pair
+	if r.Pair != nil {
+		p = currency.Pair{
+			Delimiter: r.Pair.Delimiter,
+			Base:      currency.NewCode(r.Pair.Base),
+			Quote:     currency.NewCode(r.Pair.Quote),
+		}
+	}
+	if r.Exchange != "" {
+		if _, err := s.GetExchangeByName(r.Exchange); err != nil {
+			return nil, err
+		}
+	}
+	positions, err := s.OrderManager.GetFuturesPositions(r.Exchange, a, p)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetFuturesPositionsResponse{
+		Positions: make([]*gctrpc.FuturesPosition, len(positions)),
+	}
+	for i := range positions {
+		resp.Positions[i] = futuresPositionToRPC(&positions[i])
+	}
+	return resp, nil
+}
+
+// AddFuturesFundingPayment records a funding payment for a futures position,
+// either as a payment amount or calculated from a funding rate
+func (s *RPCServer) AddFuturesFundingPayment(_ context.Context, r *gctrpc.AddFuturesFundingPaymentRequest) (*gctrpc.GenericResponse, error) {
+	a, p, err := s.orderGroupParams(r.Exchange, r.Pair, r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	payment, err := s.OrderManager.AddFuturesFundingPayment(r.Exchange, a, p, r.Payment, r.Rate, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{
+		Status: MsgStatusSuccess,
+		Data:   fmt.Sprintf("funding payment of %v recorded for %s %s %s", payment, r.Exchange, a, p),
+	}, nil
+}
+
+// SetFuturesContractType sets whether a futures pair is a linear or inverse
+// contract for position profit and loss calculations
+func (s *RPCServer) SetFuturesContractType(_ context.Context, r *gctrpc.SetFuturesContractTypeRequest) (*gctrpc.GenericResponse, error) {
+	a, p, err := s.orderGroupParams(r.Exchange, r.Pair, r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	ct, err := order.StringToContractType(r.ContractType)
+	if err != nil {
+		return nil, err
+	}
+	err = s.OrderManager.SetFuturesContractType(r.Exchange, a, p, ct)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{
+		Status: MsgStatusSuccess,
+		Data:   fmt.Sprintf("%s %s %s set to %s contract", r.Exchange, a, p, ct),
+	}, nil
+}
+
+// futuresPositionToRPC converts a futures position to its gRPC representation
+func futuresPositionToRPC(pos *order.PositionStats) *gctrpc.FuturesPosition {
+	resp := &gctrpc.FuturesPosition{
+		Exchange: pos.Exchange,
+		Asset:    pos.Asset.String(),
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: pos.Pair.Delimiter,
+			Base:      pos.Pair.Base.String(),
+			Quote:     pos.Pair.Quote.String(),
+		},
+		ContractType:  pos.ContractType.String(),
+		Status:        pos.Status.String(),
+		Side:          pos.Side.String(),
+		Size:          pos.Size,
+		EntryPrice:    pos.EntryPrice,
+		MarkPrice:     pos.MarkPrice,
+		RealisedPnl:   pos.RealisedPNL,
+		UnrealisedPnl: pos.UnrealisedPNL,
+		Fees:          pos.Fees,
+		Funding:       pos.Funding,
+		TotalPnl:      pos.TotalPNL,
+		OpenedAt:      pos.OpenedAt.Format(common.SimpleTimeFormat),
+		LastUpdated:   pos.LastUpdated.Format(common.SimpleTimeFormat),
+	}
+	for i := range pos.FundingPayments {
+		resp.FundingPayments = append(resp.FundingPayments, &gctrpc.FuturesFundingPayment{
+			Payment: pos.FundingPayments[i].Payment,
+			Rate:    pos.FundingPayments[i].Rate,
+			Time:    pos.FundingPayments[i].Time.Format(common.SimpleTimeFormat),
+		})
+	}
+	return resp
+}
+
+// parseBalanceHistoryRange parses the start and end times of a balance
+// history request
+func parseBalanceHistoryRange(start, end string) (startTime, endTime time.Time, err error) {
+	startTime, err = time.Parse(common.SimpleTimeFormat, start)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w cannot parse start time %v", errInvalidTimes, err)
+	}
+	endTime, err = time.Parse(common.SimpleTimeFormat, end)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w cannot parse end time %v", errInvalidTimes, err)
+	}
+	return startTime, endTime, nil
+}
+
+// balanceHistoryFiat returns the requested fiat currency or the balance
+// history manager's when unset
+func (s *RPCServer) balanceHistoryFiat(fiat string) currency.Code {
+	if fiat == "" {
+		return s.balanceHistoryManager.GetFiatCurrency()
+	}
+	return currency.NewCode(fiat)
+}
+
+// GetEquityCurve returns the recorded total value of all exchange balances, or
+// those of a single exchange, over time
+func (s *RPCServer) GetEquityCurve(_ context.Context, r *gctrpc.GetEquityCurveRequest) (*gctrpc.GetEquityCurveResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	start, end, err := parseBalanceHistoryRange(r.Start, r.End)
+	if err != nil {
+		return nil, err
+	}
+	fiat := s.balanceHistoryFiat(r.FiatCurrency)
+	curve, err := s.balanceHistoryManager.GetEquityCurve(r.Exchange, fiat, start, end)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetEquityCurveResponse{
+		FiatCurrency: fiat.String(),
+		Equity:       make([]*gctrpc.EquityPoint, len(curve)),
+	}
+	for i := range curve {
+		resp.Equity[i] = &gctrpc.EquityPoint{
+			Timestamp: curve[i].Timestamp.Format(common.SimpleTimeFormat),
+			Value:     curve[i].Value,
+		}
+	}
+	return resp, nil
+}
+
+// GetBalanceHistory returns the recorded balance of a currency across all
+// exchanges, or a single exchange, over time
+func (s *RPCServer) GetBalanceHistory(_ context.Context, r *gctrpc.GetBalanceHistoryRequest) (*gctrpc.GetBalanceHistoryResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	start, end, err := parseBalanceHistoryRange(r.Start, r.End)
+	if err != nil {
+		return nil, err
+	}
+	code := currency.NewCode(r.Currency)
+	fiat := s.balanceHistoryFiat(r.FiatCurrency)
+	history, err := s.balanceHistoryManager.GetCurrencyHistory(r.Exchange, code, fiat, start, end)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetBalanceHistoryResponse{
+		Currency:     code.String(),
+		FiatCurrency: fiat.String(),
+		Balances:     make([]*gctrpc.BalanceHistoryPoint, len(history)),
+	}
+	for i := range history {
+		resp.Balances[i] = &gctrpc.BalanceHistoryPoint{
+			Timestamp: history[i].Timestamp.Format(common.SimpleTimeFormat),
+			Total:     history[i].Total,
+			Hold:      history[i].Hold,
+			Value:     history[i].Value,
+		}
+	}
+	return resp, nil
+}
+
+// GetDailyPNL returns the profit and loss of all exchange balances, or those
+// of a single exchange, for every UTC day
+func (s *RPCServer) GetDailyPNL(_ context.Context, r *gctrpc.GetDailyPNLRequest) (*gctrpc.GetDailyPNLResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	start, end, err := parseBalanceHistoryRange(r.Start, r.End)
+	if err != nil {
+		return nil, err
+	}
+	fiat := s.balanceHistoryFiat(r.FiatCurrency)
+	days, err := s.balanceHistoryManager.GetDailyPNL(r.Exchange, fiat, start, end)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetDailyPNLResponse{
+		FiatCurrency: fiat.String(),
+		Days:         make([]*gctrpc.DailyPNL, len(days)),
+	}
+	for i := range days {
+		resp.Days[i] = &gctrpc.DailyPNL{
+			Date:          days[i].Date.Format(common.SimpleTimeFormat),
+			Open:          days[i].Open,
+			Close:         days[i].Close,
+			Pnl:           days[i].PNL,
+			PnlPercentage: days[i].PNLPercentage,
+		}
+		resp.TotalPnl += days[i].PNL
+	}
+	return resp, nil
+}
+
+// parseConsolidatedOrderbookRequest returns the pair and asset of a
+// consolidated orderbook request, defaulting to spot
+func parseConsolidatedOrderbookRequest(rp *gctrpc.CurrencyPair, assetType string) (currency.Pair, asset.Item, error) {
+	if rp == nil {
+		return currency.Pair{}, "", errCurrencyPairUnset
+	}
+	p := currency.Pair{
+		Delimiter: rp.Delimiter,
+		Base:      currency.NewCode(rp.Base),
+		Quote:     currency.NewCode(rp.Quote),
+	}
+	if assetType == "" {
+		return p, asset.Spot, nil
+	}
+	a, err := asset.New(assetType)
+	if err != nil {
+		return currency.Pair{}, "", err
+	}
+	return p, a, nil
+}
+
+// consolidatedItemsToRPC converts consolidated orderbook levels to their RPC
+// representation
+func consolidatedItemsToRPC(items orderbook.ConsolidatedItems) []*gctrpc.ConsolidatedOrderbookItem {
+	resp := make([]*gctrpc.ConsolidatedOrderbookItem, len(items))
+	for i := range items {
+		resp[i] = &gctrpc.ConsolidatedOrderbookItem{
+			Exchange: items[i].Exchange,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: items[i].Pair.Delimiter,
+				Base:      items[i].Pair.Base.String(),
+				Quote:     items[i].Pair.Quote.String(),
+			},
+			Amount:   items[i].Amount,
+			Price:    items[i].Price,
+			RawPrice: items[i].RawPrice,
+			Fee:      items[i].Fee,
+		}
+	}
+	return resp
+}
+
+// consolidatedSimulationToRPC converts a consolidated orderbook simulation to
+// its RPC representation
+func consolidatedSimulationToRPC(result *orderbook.ConsolidatedSimulationResult) *gctrpc.SimulateConsolidatedOrderResponse {
+	resp := &gctrpc.SimulateConsolidatedOrderResponse{
+		Orders:             consolidatedItemsToRPC(result.Orders),
+		Venues:             make([]*gctrpc.VenueAllocation, len(result.Venues)),
+		Amount:             result.Amount,
+		AveragePrice:       result.AveragePrice,
+		MinimumPrice:       result.MinimumPrice,
+		MaximumPrice:       result.MaximumPrice,
+		PercentageGainLoss: result.PercentageGainOrLoss,
+		RemainingAmount:    result.RemainingAmount,
+		Status:             result.Status,
+	}
+	for i := range result.Venues {
+		resp.Venues[i] = &gctrpc.VenueAllocation{
+			Exchange: result.Venues[i].Exchange,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: result.Venues[i].Pair.Delimiter,
+				Base:      result.Venues[i].Pair.Base.String(),
+				Quote:     result.Venues[i].Pair.Quote.String(),
+			},
+			BaseAmount:   result.Venues[i].BaseAmount,
+			QuoteAmount:  result.Venues[i].QuoteAmount,
+			AveragePrice: result.Venues[i].AveragePrice,
+		}
+	}
+	return resp
+}
+
+// GetConsolidatedOrderbook returns the orderbook of a pair merged across
+// exchanges with every level tagged by exchange and adjusted for fees
+func (s *RPCServer) GetConsolidatedOrderbook(_ context.Context, r *gctrpc.GetConsolidatedOrderbookRequest) (*gctrpc.GetConsolidatedOrderbookResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	p, a, err := parseConsolidatedOrderbookRequest(r.Pair, r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.consolidatedOrderbookManager.GetConsolidatedOrderbook(p, a)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GetConsolidatedOrderbookResponse{
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: c.Pair.Delimiter,
+			Base:      c.Pair.Base.String(),
+			Quote:     c.Pair.Quote.String(),
+		},
+		Asset:       c.Asset.String(),
+		Exchanges:   c.Exchanges,
+		Bids:        consolidatedItemsToRPC(c.Bids),
+		Asks:        consolidatedItemsToRPC(c.Asks),
+		LastUpdated: c.LastUpdated.Format(common.SimpleTimeFormat),
+	}, nil
+}
+
+// SimulateConsolidatedOrder simulates an order across all exchanges of a
+// consolidated orderbook. Buy amounts are in the quote currency and sell
+// amounts in the base currency
+func (s *RPCServer) SimulateConsolidatedOrder(_ context.Context, r *gctrpc.SimulateConsolidatedOrderRequest) (*gctrpc.SimulateConsolidatedOrderResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	p, a, err := parseConsolidatedOrderbookRequest(r.Pair, r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	buy := strings.EqualFold(r.Side, order.Buy.String()) ||
+		strings.EqualFold(r.Side, order.Bid.String())
+	result, err := s.consolidatedOrderbookManager.SimulateOrder(p, a, r.Amount, buy)
+	if err != nil {
+		return nil, err
+	}
+	return consolidatedSimulationToRPC(result), nil
+}
+
+// ConsolidatedWhaleBomb finds the amount required across all exchanges of a
+// consolidated orderbook to reach a price target
+func (s *RPCServer) ConsolidatedWhaleBomb(_ context.Context, r *gctrpc.ConsolidatedWhaleBombRequest) (*gctrpc.SimulateConsolidatedOrderResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	p, a, err := parseConsolidatedOrderbookRequest(r.Pair, r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	buy := strings.EqualFold(r.Side, order.Buy.String()) ||
+		strings.EqualFold(r.Side, order.Bid.String())
+	result, err := s.consolidatedOrderbookManager.WhaleBomb(p, a, r.PriceTarget, buy)
+	if err != nil {
+		return nil, err
+	}
+	return consolidatedSimulationToRPC(result), nil
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/drivers"
 	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/balance"
 	dbexchange "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
 	sqltrade "github.com/thrasher-corp/gocryptotrader/database/repository/trade"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -1043,7 +1044,7 @@ func TestGetOrders(t *testing.T) {
 		RequestFormat: &currency.PairFormat{Uppercase: true}}
 	em.Add(exch)
 	var wg sync.WaitGroup
-	om, err := SetupOrderManager(em, engerino.CommunicationsManager, &wg, false)
+	om, err := SetupOrderManager(em, engerino.CommunicationsManager, nil, &wg, false)
 	if !errors.Is(err, nil) {
 		t.Errorf("received '%v', expected '%v'", err, nil)
 	}
@@ -1151,7 +1152,7 @@ func TestGetOrder(t *testing.T) {
 		RequestFormat: &currency.PairFormat{Uppercase: true}}
 	em.Add(exch)
 	var wg sync.WaitGroup
-	om, err := SetupOrderManager(em, engerino.CommunicationsManager, &wg, false)
+	om, err := SetupOrderManager(em, engerino.CommunicationsManager, nil, &wg, false)
 	if !errors.Is(err, nil) {
 		t.Errorf("received '%v', expected '%v'", err, nil)
 	}
@@ -1680,7 +1681,7 @@ func TestGetManagedOrders(t *testing.T) {
 		RequestFormat: &currency.PairFormat{Uppercase: true}}
 	em.Add(exch)
 	var wg sync.WaitGroup
-	om, err := SetupOrderManager(em, engerino.CommunicationsManager, &wg, false)
+	om, err := SetupOrderManager(em, engerino.CommunicationsManager, nil, &wg, false)
 	if !errors.Is(err, nil) {
 		t.Errorf("received '%v', expected '%v'", err, nil)
 	}
@@ -1977,3 +1978,141 @@ func TestCurrencyStateTradingPair(t *testing.T) {
 		t.Fatalf("received: %v, but expected: %v", err, nil)
 	}
 }
+
+func TestBalanceHistoryRPCs(t *testing.T) {
+	t.Parallel()
+	_, err := (&RPCServer{Engine: &Engine{}}).GetEquityCurve(context.Background(), &gctrpc.GetEquityCurveRequest{
+		Start: "2022-01-01 00:00:00",
+		End:   "2022-01-03 00:00:00",
+	})
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+
+	m, snapshots, _ := balanceHistoryManagerSetup(t)
+	tt := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	*snapshots = append(*snapshots,
+		balance.Snapshot{Exchange: testExchange, Currency: "BTC", Total: 1, FiatCurrency: "USD", FiatRate: 40000, Timestamp: tt},
+		balance.Snapshot{Exchange: testExchange, Currency: "BTC", Total: 1, FiatCurrency: "USD", FiatRate: 44000, Timestamp: tt.Add(time.Hour * 24)},
+	)
+	s := RPCServer{Engine: &Engine{balanceHistoryManager: m}}
+
+	_, err = s.GetEquityCurve(context.Background(), nil)
+	if !errors.Is(err, errNilRequestData) {
+		t.Errorf("received '%v' expected '%v'", err, errNilRequestData)
+	}
+	_, err = s.GetEquityCurve(context.Background(), &gctrpc.GetEquityCurveRequest{Start: "bad"})
+	if !errors.Is(err, errInvalidTimes) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidTimes)
+	}
+
+	curve, err := s.GetEquityCurve(context.Background(), &gctrpc.GetEquityCurveRequest{
+		Start: "2022-01-01 00:00:00",
+		End:   "2022-01-03 00:00:00",
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if curve.FiatCurrency != "USD" || len(curve.Equity) != 2 || curve.Equity[1].Value != 44000 {
+		t.Errorf("received '%+v' expected equity curve", curve)
+	}
+
+	history, err := s.GetBalanceHistory(context.Background(), &gctrpc.GetBalanceHistoryRequest{
+		Exchange:     testExchange,
+		Currency:     "btc",
+		FiatCurrency: "EUR",
+		Start:        "2022-01-01 00:00:00",
+		End:          "2022-01-03 00:00:00",
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if history.FiatCurrency != "EUR" || len(history.Balances) != 2 || history.Balances[1].Value != 40000 {
+		t.Errorf("received '%+v' expected euro btc history", history)
+	}
+
+	pnl, err := s.GetDailyPNL(context.Background(), &gctrpc.GetDailyPNLRequest{
+		Start: "2022-01-01 00:00:00",
+		End:   "2022-01-03 00:00:00",
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(pnl.Days) != 2 || pnl.TotalPnl != 4000 || pnl.Days[1].Date != "2022-01-02 00:00:00" {
+		t.Errorf("received '%+v' expected daily pnl", pnl)
+	}
+}
+
+func TestConsolidatedOrderbookRPCs(t *testing.T) {
+	t.Parallel()
+	pair := &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD"}
+	_, err := (&RPCServer{Engine: &Engine{}}).GetConsolidatedOrderbook(context.Background(), &gctrpc.GetConsolidatedOrderbookRequest{Pair: pair})
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrSubSystemNotStarted)
+	}
+
+	m := consolidatedOrderbookManagerSetup(t)
+	err = m.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	defer func() {
+		if err = m.Stop(); err != nil {
+			t.Error(err)
+		}
+	}()
+	s := RPCServer{Engine: &Engine{consolidatedOrderbookManager: m}}
+
+	_, err = s.GetConsolidatedOrderbook(context.Background(), nil)
+	if !errors.Is(err, errNilRequestData) {
+		t.Errorf("received '%v' expected '%v'", err, errNilRequestData)
+	}
+	_, err = s.GetConsolidatedOrderbook(context.Background(), &gctrpc.GetConsolidatedOrderbookRequest{})
+	if !errors.Is(err, errCurrencyPairUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errCurrencyPairUnset)
+	}
+	_, err = s.GetConsolidatedOrderbook(context.Background(), &gctrpc.GetConsolidatedOrderbookRequest{Pair: pair, Asset: "meow"})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, asset.ErrNotSupported)
+	}
+
+	book, err := s.GetConsolidatedOrderbook(context.Background(), &gctrpc.GetConsolidatedOrderbookRequest{Pair: pair})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if book.Asset != asset.Spot.String() || len(book.Exchanges) != 2 || len(book.Bids) != 2 || book.Bids[0].Pair.Quote != "USDT" {
+		t.Errorf("received '%+v' expected consolidated orderbook", book)
+	}
+
+	_, err = s.SimulateConsolidatedOrder(context.Background(), nil)
+	if !errors.Is(err, errNilRequestData) {
+		t.Errorf("received '%v' expected '%v'", err, errNilRequestData)
+	}
+	sim, err := s.SimulateConsolidatedOrder(context.Background(), &gctrpc.SimulateConsolidatedOrderRequest{
+		Pair:   pair,
+		Amount: 2.5,
+		Side:   "sell",
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(sim.Venues) != 2 || len(sim.Orders) != 2 || sim.RemainingAmount != 0 {
+		t.Errorf("received '%+v' expected sell across both exchanges", sim)
+	}
+
+	_, err = s.ConsolidatedWhaleBomb(context.Background(), nil)
+	if !errors.Is(err, errNilRequestData) {
+		t.Errorf("received '%v' expected '%v'", err, errNilRequestData)
+	}
+	sim, err = s.ConsolidatedWhaleBomb(context.Background(), &gctrpc.ConsolidatedWhaleBombRequest{
+		Pair:        pair,
+		PriceTarget: 101,
+		Side:        "buy",
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(sim.Venues) != 1 || sim.Venues[0].Exchange != testExchange {
+		t.Errorf("received '%+v' expected test exchange ask taken", sim)
+	}
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 
+	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
@@ -60,6 +62,62 @@ type iOrderManager interface {
 	UpdateExistingOrder(*order.Detail) error
 }
 
+// iConditionalOrderHolder limits exposure to the conditional order manager for
+// order groups which hold stop and take-profit legs locally
+type iConditionalOrderHolder interface {
+	Add(*ConditionalOrder) (*ConditionalOrder, error)
+	Cancel(uuid.UUID) error
+}
+
+// iOrderSubmitter limits exposure to the order manager for subsystems which
+// only need to place orders
+type iOrderSubmitter interface {
+	Submit(context.Context, *order.Submit) (*OrderSubmitResponse, error)
+}
+
+// iOrderExecutor limits exposure to the order manager for subsystems which
+// place orders and follow them through to completion
+type iOrderExecutor interface {
+	iOrderSubmitter
+	Cancel(context.Context, *order.Cancel) error
+	GetByExchangeAndID(string, string) (*order.Detail, error)
+}
+
+// iRiskOrderManager limits exposure to the order manager for the risk manager
+type iRiskOrderManager interface {
+	GetOrdersSnapshot(order.Status) []order.Detail
+	CancelAllOrders(context.Context, []exchange.IBotExchange)
+}
+
+// iRiskChecker defines the pre-trade checks the order manager runs before
+// submitting an order, and the release of the limits reserved for the order
+// once it has been submitted
+type iRiskChecker interface {
+	IsRunning() bool
+	CheckOrder(*order.Submit) error
+	ReleaseOrder(s *order.Submit, accepted bool)
+}
+
+// iMarketDataRecorder defines the recording of websocket events by the
+// websocket routine manager
+type iMarketDataRecorder interface {
+	IsRunning() bool
+	Record(string, interface{}) error
+}
+
+// iBalanceRecorder defines the recording of websocket balance changes by the
+// websocket routine manager
+type iBalanceRecorder interface {
+	IsRunning() bool
+	RecordChange(*account.Change) error
+}
+
+// iWebsocketDataHandler limits exposure of the websocket routine manager to
+// the market data replayer
+type iWebsocketDataHandler interface {
+	WebsocketDataHandler(string, interface{}) error
+}
+
 // iPortfolioManager limits exposure of accessible functions to portfolio manager
 type iPortfolioManager interface {
 	GetPortfolioSummary() portfolio.Summary
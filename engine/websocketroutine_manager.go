@@ -159,6 +159,7 @@ func (m *websocketRoutineManager) WebsocketDataHandler(exchName string, data int
 		return fmt.Errorf("exchange %s nil data sent to websocket",
 			exchName)
 	}
+	m.recordData(exchName, data)
 
 	switch d := data.(type) {
 	case string:
@@ -247,6 +248,7 @@ func (m *websocketRoutineManager) WebsocketDataHandler(exchName string, data int
 		if m.verbose {
 			m.printAccountHoldingsChangeSummary(d)
 		}
+		m.recordBalanceChange(&d)
 	case []trade.Data:
 		if m.verbose {
 			log.Infof(log.Trade, "%+v", d)
@@ -266,6 +268,53 @@ func (m *websocketRoutineManager) WebsocketDataHandler(exchName string, data int
 	return nil
 }
 
+// setDataRecorder sets the recorder which records all websocket events
+// handled
+func (m *websocketRoutineManager) setDataRecorder(r iMarketDataRecorder) {
+	m.recorderMtx.Lock()
+	m.dataRecorder = r
+	m.recorderMtx.Unlock()
+}
+
+// recordData records the websocket event when a recorder is running
+func (m *websocketRoutineManager) recordData(exchName string, data interface{}) {
+	if m == nil {
+		return
+	}
+	m.recorderMtx.Lock()
+	r := m.dataRecorder
+	m.recorderMtx.Unlock()
+	if r == nil || !r.IsRunning() {
+		return
+	}
+	err := r.Record(exchName, data)
+	if err != nil {
+		log.Errorf(log.WebsocketMgr, "exchange %s websocket data recording error: %v", exchName, err)
+	}
+}
+
+// setBalanceRecorder sets the recorder which records all websocket balance
+// changes handled
+func (m *websocketRoutineManager) setBalanceRecorder(r iBalanceRecorder) {
+	m.balanceMtx.Lock()
+	m.balanceRecorder = r
+	m.balanceMtx.Unlock()
+}
+
+// recordBalanceChange records the balance change when a recorder is running
+func (m *websocketRoutineManager) recordBalanceChange(c *account.Change) {
+	m.balanceMtx.Lock()
+	r := m.balanceRecorder
+	m.balanceMtx.Unlock()
+	if r == nil || !r.IsRunning() {
+		return
+	}
+	err := r.RecordChange(c)
+	if err != nil {
+		log.Errorf(log.WebsocketMgr, "exchange %s websocket balance change recording error: %v", c.Exchange, err)
+	}
+}
+
 // FormatCurrency is a method that formats and returns a currency pair
 // based on the user currency display preferences
 func (m *websocketRoutineManager) FormatCurrency(p currency.Pair) currency.Pair {
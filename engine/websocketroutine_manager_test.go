@@ -7,6 +7,7 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
@@ -132,7 +133,7 @@ func TestWebsocketRoutineManagerHandleData(t *testing.T) {
 	exch.SetDefaults()
 	em.Add(exch)
 
-	om, err := SetupOrderManager(em, &CommunicationManager{}, &wg, false)
+	om, err := SetupOrderManager(em, &CommunicationManager{}, nil, &wg, false)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -258,3 +259,88 @@ func TestWebsocketRoutineManagerHandleData(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+type fakeMarketDataRecorder struct {
+	running bool
+	events  []interface{}
+}
+
+func (f *fakeMarketDataRecorder) IsRunning() bool {
+	return f.running
+}
+
+func (f *fakeMarketDataRecorder) Record(_ string, data interface{}) error {
+	f.events = append(f.events, data)
+	return nil
+}
+
+func TestWebsocketRoutineManagerRecordData(t *testing.T) {
+	t.Parallel()
+	var m *websocketRoutineManager
+	m.recordData("test", "test")
+
+	m, err := setupWebsocketRoutineManager(SetupExchangeManager(), &OrderManager{}, &syncManager{}, &config.CurrencyConfig{}, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	r := &fakeMarketDataRecorder{}
+	m.setDataRecorder(r)
+	err = m.WebsocketDataHandler("test", stream.KlineData{})
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	if len(r.events) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(r.events), 0)
+	}
+	r.running = true
+	err = m.WebsocketDataHandler("test", stream.KlineData{})
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	if len(r.events) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(r.events), 1)
+	}
+}
+
+type fakeBalanceRecorder struct {
+	running bool
+	changes []*account.Change
+}
+
+func (f *fakeBalanceRecorder) IsRunning() bool {
+	return f.running
+}
+
+func (f *fakeBalanceRecorder) RecordChange(c *account.Change) error {
+	f.changes = append(f.changes, c)
+	return nil
+}
+
+func TestWebsocketRoutineManagerRecordBalanceChange(t *testing.T) {
+	t.Parallel()
+	m, err := setupWebsocketRoutineManager(SetupExchangeManager(), &OrderManager{}, &syncManager{}, &config.CurrencyConfig{}, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.WebsocketDataHandler("test", account.Change{Exchange: "test"})
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	r := &fakeBalanceRecorder{}
+	m.setBalanceRecorder(r)
+	err = m.WebsocketDataHandler("test", account.Change{Exchange: "test"})
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	if len(r.changes) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(r.changes), 0)
+	}
+	r.running = true
+	err = m.WebsocketDataHandler("test", account.Change{Exchange: "test", Amount: 1})
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	if len(r.changes) != 1 || r.changes[0].Amount != 1 {
+		t.Errorf("received '%v' expected '%v'", len(r.changes), 1)
+	}
+}
@@ -17,6 +17,12 @@ type websocketRoutineManager struct {
 	currencyConfig  *config.CurrencyConfig
 	shutdown        chan struct{}
 	wg              sync.WaitGroup
+
+	recorderMtx  sync.Mutex
+	dataRecorder iMarketDataRecorder
+
+	balanceMtx      sync.Mutex
+	balanceRecorder iBalanceRecorder
 }
 
 var (
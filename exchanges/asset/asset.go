@@ -99,6 +99,17 @@ func (a Item) IsValid() bool {
 	return false
 }
 
+// IsFutures returns whether the asset type is a futures contract which
+// results in a position
+func (a Item) IsFutures() bool {
+	switch a {
+	case PerpetualContract, PerpetualSwap, Futures, UpsideProfitContract,
+		DownsideProfitContract, CoinMarginedFutures, USDTMarginedFutures:
+		return true
+	}
+	return false
+}
+
 // New takes an input matches to relevant package assets
 func New(input string) (Item, error) {
 	input = strings.ToLower(input)
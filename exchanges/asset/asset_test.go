@@ -61,6 +61,16 @@ func TestIsValid(t *testing.T) {
 	}
 }
 
+func TestIsFutures(t *testing.T) {
+	if Spot.IsFutures() || Margin.IsFutures() {
+		t.Fatal("TestIsFutures returned an unexpected result")
+	}
+
+	if !PerpetualSwap.IsFutures() || !CoinMarginedFutures.IsFutures() {
+		t.Fatal("TestIsFutures returned an unexpected result")
+	}
+}
+
 func TestNew(t *testing.T) {
 	if _, err := New("Spota"); err == nil {
 		t.Fatal("TestNew returned an unexpected result")
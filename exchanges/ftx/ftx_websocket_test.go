@@ -2,14 +2,19 @@ package ftx
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 )
@@ -412,3 +417,120 @@ func TestParsingWSOBData2(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+// websocketMockFile is the recording of the FTX websocket replayed by
+// TestWsOrderbookReplay
+const websocketMockFile = mock.DefaultWebsocketDirectory + "ftx/ftx.json"
+
+// recordWebsocket records the live FTX websocket to websocketMockFile instead
+// of replaying it
+var recordWebsocket = false
+
+func TestWsOrderbookReplay(t *testing.T) {
+	var cfg config.Config
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exchCfg, err := cfg.GetExchangeConfig("FTX")
+	if err != nil {
+		t.Fatal(err)
+	}
+	exchCfg.Features.Enabled.Websocket = true
+	// publish every orderbook update rather than throttling them
+	var publishPeriod time.Duration
+	exchCfg.Orderbook.PublishPeriod = &publishPeriod
+	var ws FTX
+	ws.SetDefaults()
+	ws.Websocket = sharedtestvalues.NewTestWebsocket()
+	err = ws.Setup(exchCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if recordWebsocket {
+		var recorder *mock.WebsocketRecorder
+		recorder, err = mock.NewWebsocketRecorder(websocketMockFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ws.Websocket.SetRecorder(recorder)
+		err = ws.Websocket.Connect()
+		if err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(time.Second * 5)
+		err = ws.Websocket.Shutdown()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = recorder.Save()
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Skip("websocket recorded, set recordWebsocket to false to replay it")
+	}
+
+	serverURL, err := mock.NewWebsocketVCRServer(websocketMockFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replayURL, err := mock.WebsocketReplayURL(serverURL, ftxWSURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ws.Websocket.SetWebsocketURL(replayURL, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ws.Websocket.Connect()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err = ws.Websocket.Shutdown(); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	// the recording holds a partial orderbook followed by two checksummed
+	// updates, removing the best ask then adding a new best bid and ask
+	p := currency.NewPair(currency.BTC, currency.USD)
+	expectedBids := orderbook.Items{{Price: 57000.5, Amount: 0.75}, {Price: 57000, Amount: 1}, {Price: 56999, Amount: 2}, {Price: 56998.5, Amount: 0.25}}
+	expectedAsks := orderbook.Items{{Price: 57001.5, Amount: 2}, {Price: 57002, Amount: 1}, {Price: 57003.5, Amount: 3}}
+	var tickerReceived bool
+	timeout := time.After(time.Second * 5)
+	for {
+		select {
+		case <-timeout:
+			t.Fatal("timed out waiting for the replayed orderbook")
+		case data := <-ws.Websocket.ToRoutine:
+			switch d := data.(type) {
+			case error:
+				t.Fatal(d)
+			case *ticker.Price:
+				if d.Last != 57000.5 || !d.Pair.Equal(p) {
+					t.Errorf("received '%+v' expected replayed ticker", d)
+				}
+				tickerReceived = true
+			case *orderbook.Base:
+				if !d.Pair.Equal(p) || d.Asset != asset.Spot ||
+					!reflect.DeepEqual(d.Bids, expectedBids) ||
+					!reflect.DeepEqual(d.Asks, expectedAsks) {
+					continue
+				}
+				if !tickerReceived {
+					t.Error("expected replayed ticker before the orderbook")
+				}
+				ob, err := ws.Websocket.Orderbook.GetOrderbook(p, asset.Spot)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if ws.CalcUpdateOBChecksum(ob) != 1834988520 {
+					t.Errorf("received '%+v' expected buffered orderbook matching the last checksum", ob)
+				}
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package mock
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+)
+
+// DefaultWebsocketDirectory defines the main websocket mock directory
+const DefaultWebsocketDirectory = "../../testdata/websocket_mock/"
+
+var errNoWebsocketMockPath = errors.New("no path to websocket mock file supplied")
+
+// WebsocketVCRMock defines the main websocket mock JSON file, holding the
+// recorded frames of each connection by the connection's host and path
+type WebsocketVCRMock struct {
+	Connections map[string]*WebsocketConnectionMock `json:"connections"`
+}
+
+// WebsocketConnectionMock defines the frames recorded over a connection
+type WebsocketConnectionMock struct {
+	// Connected are frames sent by the server before any request is sent
+	Connected []WebsocketFrame `json:"connected"`
+	// Exchanges pair each request with the frames received after it
+	Exchanges []WebsocketExchange `json:"exchanges"`
+
+	// recording is set once the connection is recorded by a recorder
+	recording bool
+}
+
+// WebsocketExchange defines a request sent over a connection and the frames
+// received until the next request
+type WebsocketExchange struct {
+	Request   WebsocketFrame   `json:"request"`
+	Responses []WebsocketFrame `json:"responses"`
+}
+
+// WebsocketFrame defines a recorded message. JSON payloads are stored as is,
+// anything else is stored as text. Compressed frames are stored after being
+// decompressed by the connection and are replayed as text frames
+type WebsocketFrame struct {
+	Data json.RawMessage `json:"data,omitempty"`
+	Text string          `json:"text,omitempty"`
+}
+
+// WebsocketRecorder records the frames sent and received over websocket
+// connections so they can be replayed by a websocket VCR server
+type WebsocketRecorder struct {
+	path string
+	m    sync.Mutex
+	mock WebsocketVCRMock
+}
+
+// NewWebsocketRecorder returns a recorder which saves to the path. Recordings
+// of connections already in the file are replaced as they are recorded
+func NewWebsocketRecorder(path string) (*WebsocketRecorder, error) {
+	if path == "" {
+		return nil, errNoWebsocketMockPath
+	}
+	r := &WebsocketRecorder{path: path}
+	contents, err := ioutil.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		return nil, err
+	default:
+		err = json.Unmarshal(contents, &r.mock)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if r.mock.Connections == nil {
+		r.mock.Connections = make(map[string]*WebsocketConnectionMock)
+	}
+	return r, nil
+}
+
+// RecordSent records a request sent over the connection. Frames received
+// afterwards are recorded as its responses
+func (r *WebsocketRecorder) RecordSent(connectionURL string, payload []byte) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	c, err := r.getConnection(connectionURL)
+	if err != nil {
+		return err
+	}
+	c.Exchanges = append(c.Exchanges, WebsocketExchange{Request: newWebsocketFrame(payload)})
+	return nil
+}
+
+// RecordReceived records a frame received over the connection
+func (r *WebsocketRecorder) RecordReceived(connectionURL string, payload []byte) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	c, err := r.getConnection(connectionURL)
+	if err != nil {
+		return err
+	}
+	if len(c.Exchanges) == 0 {
+		c.Connected = append(c.Connected, newWebsocketFrame(payload))
+		return nil
+	}
+	latest := &c.Exchanges[len(c.Exchanges)-1]
+	latest.Responses = append(latest.Responses, newWebsocketFrame(payload))
+	return nil
+}
+
+// Save writes all recorded connections to the recorder's file
+func (r *WebsocketRecorder) Save() error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	data, err := json.MarshalIndent(r.mock, "", " ")
+	if err != nil {
+		return err
+	}
+	err = common.CreateDir(filepath.Dir(r.path))
+	if err != nil {
+		return err
+	}
+	return file.Write(r.path, data)
+}
+
+// getConnection returns the connection's recording, replacing any recording
+// loaded from file the first time the connection is seen
+func (r *WebsocketRecorder) getConnection(connectionURL string) (*WebsocketConnectionMock, error) {
+	key, err := WebsocketConnectionKey(connectionURL)
+	if err != nil {
+		return nil, err
+	}
+	c, ok := r.mock.Connections[key]
+	if !ok || !c.recording {
+		c = &WebsocketConnectionMock{recording: true}
+		r.mock.Connections[key] = c
+	}
+	return c, nil
+}
+
+// WebsocketConnectionKey returns the host, path and query of the connection
+// URL which its frames are recorded under
+func WebsocketConnectionKey(connectionURL string) (string, error) {
+	u, err := url.Parse(connectionURL)
+	if err != nil {
+		return "", err
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("websocket connection URL %v has no host", connectionURL)
+	}
+	key := u.Host + u.Path
+	if u.RawQuery != "" {
+		key += "?" + u.RawQuery
+	}
+	return key, nil
+}
+
+// WebsocketReplayURL returns the URL to connect to the websocket VCR server
+// in place of the recorded connection URL
+func WebsocketReplayURL(serverURL, connectionURL string) (string, error) {
+	key, err := WebsocketConnectionKey(connectionURL)
+	if err != nil {
+		return "", err
+	}
+	return serverURL + "/" + key, nil
+}
+
+func newWebsocketFrame(payload []byte) WebsocketFrame {
+	if json.Valid(payload) {
+		// copy as the connection may reuse the payload's buffer
+		data := make([]byte, len(payload))
+		copy(data, payload)
+		return WebsocketFrame{Data: data}
+	}
+	return WebsocketFrame{Text: string(payload)}
+}
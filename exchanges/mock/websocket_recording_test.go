@@ -0,0 +1,99 @@
+package mock
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+const testWebsocketURL = "wss://stream.binance.com:9443/stream"
+
+func TestNewWebsocketRecorder(t *testing.T) {
+	t.Parallel()
+	_, err := NewWebsocketRecorder("")
+	if !errors.Is(err, errNoWebsocketMockPath) {
+		t.Errorf("received '%v' expected '%v'", err, errNoWebsocketMockPath)
+	}
+	path := filepath.Join(t.TempDir(), "exchange", "exchange.json")
+	r, err := NewWebsocketRecorder(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = r.RecordReceived(testWebsocketURL, []byte(`{"welcome":true}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = r.RecordSent(testWebsocketURL, []byte(`{"method":"SUBSCRIBE","params":["btcusdt@depth"],"id":1}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = r.RecordReceived(testWebsocketURL, []byte(`{"result":null,"id":1}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = r.RecordReceived(testWebsocketURL, []byte("pong"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = r.RecordSent(":bad", []byte("ping"))
+	if err == nil {
+		t.Error("expected error for invalid connection URL")
+	}
+	err = r.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// recordings are loaded, then replaced once the connection is recorded again
+	r, err = NewWebsocketRecorder(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := r.mock.Connections["stream.binance.com:9443/stream"]
+	if c == nil {
+		t.Fatal("expected connection recording to be loaded")
+	}
+	if len(c.Connected) != 1 || len(c.Exchanges) != 1 || len(c.Exchanges[0].Responses) != 2 {
+		t.Fatalf("received '%v' connected frames and '%v' exchanges, expected '1' and '1' with '2' responses", len(c.Connected), len(c.Exchanges))
+	}
+	if c.Exchanges[0].Responses[1].Text != "pong" {
+		t.Errorf("received '%v' expected '%v'", c.Exchanges[0].Responses[1].Text, "pong")
+	}
+	err = r.RecordSent(testWebsocketURL, []byte("ping"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c = r.mock.Connections["stream.binance.com:9443/stream"]
+	if len(c.Connected) != 0 || len(c.Exchanges) != 1 {
+		t.Errorf("received '%v' connected frames and '%v' exchanges, expected '0' and '1'", len(c.Connected), len(c.Exchanges))
+	}
+}
+
+func TestWebsocketConnectionKey(t *testing.T) {
+	t.Parallel()
+	key, err := WebsocketConnectionKey("wss://ws.kraken.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key != "ws.kraken.com" {
+		t.Errorf("received '%v' expected '%v'", key, "ws.kraken.com")
+	}
+	key, err = WebsocketConnectionKey(testWebsocketURL + "?streams=btcusdt@depth")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key != "stream.binance.com:9443/stream?streams=btcusdt@depth" {
+		t.Errorf("received '%v' expected '%v'", key, "stream.binance.com:9443/stream?streams=btcusdt@depth")
+	}
+	_, err = WebsocketConnectionKey("/stream")
+	if err == nil {
+		t.Error("expected error for URL without host")
+	}
+	replayURL, err := WebsocketReplayURL("ws://127.0.0.1:1337", testWebsocketURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayURL != "ws://127.0.0.1:1337/stream.binance.com:9443/stream" {
+		t.Errorf("received '%v' expected '%v'", replayURL, "ws://127.0.0.1:1337/stream.binance.com:9443/stream")
+	}
+}
@@ -0,0 +1,301 @@
+package mock
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+
+	"github.com/gorilla/websocket"
+)
+
+var errNoWebsocketMatch = errors.New("no recorded websocket request matched")
+
+// websocketDeltaKeys are request fields which differ between runs, such as
+// message IDs and authentication values. Their values are not matched and
+// are echoed back in place of recorded values in responses
+var websocketDeltaKeys = []string{
+	"id",
+	"reqid",
+	"req_id",
+	"nonce",
+	"timestamp",
+	"signature",
+	"sign",
+	"tonce",
+	"key",
+	"token",
+}
+
+// NewWebsocketVCRServer starts a new websocket VCR server for replaying
+// recorded websocket connections for testing purposes and returns the server
+// URL. Connect to a recorded connection using WebsocketReplayURL
+func NewWebsocketVCRServer(path string) (string, error) {
+	if path == "" {
+		return "", errNoWebsocketMockPath
+	}
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	if !json.Valid(contents) {
+		return "", fmt.Errorf("contents of file %s are not valid JSON", path)
+	}
+	var m WebsocketVCRMock
+	err = json.Unmarshal(contents, &m)
+	if err != nil {
+		return "", err
+	}
+	server := httptest.NewServer(&websocketVCRHandler{
+		mock: m,
+		upgrader: websocket.Upgrader{
+			CheckOrigin: func(*http.Request) bool { return true },
+		},
+	})
+	return "ws" + strings.TrimPrefix(server.URL, "http"), nil
+}
+
+// websocketVCRHandler replays recorded frames to each connection
+type websocketVCRHandler struct {
+	mock     WebsocketVCRMock
+	upgrader websocket.Upgrader
+}
+
+// ServeHTTP upgrades the request and replays the recording matching its path.
+// Each connection replays its recording from the start
+func (h *websocketVCRHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key := strings.TrimPrefix(r.URL.Path, "/")
+	if r.URL.RawQuery != "" {
+		key += "?" + r.URL.RawQuery
+	}
+	recording, ok := h.mock.Connections[key]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		_, err := w.Write([]byte("There is no mock websocket data available for " + key + ". Please follow README.md in the mock package."))
+		if err != nil {
+			log.Println("Mock Test Failure - Writing to HTTP connection", err)
+		}
+		return
+	}
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Println("Mock Test Failure - Websocket upgrade error", err)
+		return
+	}
+	defer conn.Close()
+
+	for i := range recording.Connected {
+		err = writeWebsocketFrame(conn, recording.Connected[i].payload())
+		if err != nil {
+			return
+		}
+	}
+	replayed := make([]bool, len(recording.Exchanges))
+	for {
+		_, request, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		responses, err := MatchAndGetWebsocketResponses(recording.Exchanges, replayed, request)
+		if err != nil {
+			// unrecorded requests such as pings are not responded to
+			log.Printf("Mock Websocket - %v for %s", err, request)
+			continue
+		}
+		for i := range responses {
+			err = writeWebsocketFrame(conn, responses[i])
+			if err != nil {
+				return
+			}
+		}
+	}
+}
+
+// MatchAndGetWebsocketResponses matches the request against the first
+// exchange not yet replayed and returns its responses. Request fields which
+// differ between runs are ignored and their received values replace the
+// recorded values in the responses, allowing subscriptions and requests
+// waiting on IDs to be matched
+func MatchAndGetWebsocketResponses(exchanges []WebsocketExchange, replayed []bool, request []byte) ([][]byte, error) {
+	var received interface{}
+	isJSON := json.Valid(request) && decodeJSON(request, &received) == nil
+	for i := range exchanges {
+		if replayed[i] {
+			continue
+		}
+		recorded := exchanges[i].Request
+		if recorded.Data == nil {
+			if isJSON || recorded.Text != string(request) {
+				continue
+			}
+			replayed[i] = true
+			return exchanges[i].responses(nil), nil
+		}
+		if !isJSON {
+			continue
+		}
+		var recordedRequest interface{}
+		err := decodeJSON(recorded.Data, &recordedRequest)
+		if err != nil {
+			return nil, err
+		}
+		deltas := make(map[string][2]interface{})
+		if !matchWebsocketJSON(recordedRequest, received, deltas) {
+			continue
+		}
+		replayed[i] = true
+		return exchanges[i].responses(deltas), nil
+	}
+	return nil, errNoWebsocketMatch
+}
+
+// matchWebsocketJSON reports whether the recorded and received JSON values
+// match. Arrays match regardless of order, as subscription lists may be
+// built from maps. Delta key values are collected rather than matched
+func matchWebsocketJSON(recorded, received interface{}, deltas map[string][2]interface{}) bool {
+	switch r := recorded.(type) {
+	case map[string]interface{}:
+		v, ok := received.(map[string]interface{})
+		if !ok || len(r) != len(v) {
+			return false
+		}
+		for key, recordedVal := range r {
+			receivedVal, ok := v[key]
+			if !ok {
+				return false
+			}
+			if isWebsocketDeltaKey(key) {
+				if !reflect.DeepEqual(recordedVal, receivedVal) {
+					deltas[key] = [2]interface{}{recordedVal, receivedVal}
+				}
+				continue
+			}
+			if !matchWebsocketJSON(recordedVal, receivedVal, deltas) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		v, ok := received.([]interface{})
+		if !ok || len(r) != len(v) {
+			return false
+		}
+		used := make([]bool, len(v))
+		for i := range r {
+			var found bool
+			for j := range v {
+				if used[j] {
+					continue
+				}
+				// only keep the deltas of the element which matches
+				elementDeltas := make(map[string][2]interface{})
+				if !matchWebsocketJSON(r[i], v[j], elementDeltas) {
+					continue
+				}
+				for key, d := range elementDeltas {
+					deltas[key] = d
+				}
+				used[j] = true
+				found = true
+				break
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(recorded, received)
+	}
+}
+
+// replaceWebsocketDeltas replaces recorded delta key values with the values
+// received, returning whether any value was replaced
+func replaceWebsocketDeltas(v interface{}, deltas map[string][2]interface{}) bool {
+	var replaced bool
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for key, fieldVal := range val {
+			if d, ok := deltas[key]; ok && reflect.DeepEqual(fieldVal, d[0]) {
+				val[key] = d[1]
+				replaced = true
+				continue
+			}
+			if replaceWebsocketDeltas(fieldVal, deltas) {
+				replaced = true
+			}
+		}
+	case []interface{}:
+		for i := range val {
+			if replaceWebsocketDeltas(val[i], deltas) {
+				replaced = true
+			}
+		}
+	}
+	return replaced
+}
+
+// responses returns the exchange's response payloads with any delta values
+// replaced by those received
+func (e *WebsocketExchange) responses(deltas map[string][2]interface{}) [][]byte {
+	resp := make([][]byte, len(e.Responses))
+	for i := range e.Responses {
+		resp[i] = e.Responses[i].payload()
+		if len(deltas) == 0 || e.Responses[i].Data == nil {
+			continue
+		}
+		var v interface{}
+		if decodeJSON(e.Responses[i].Data, &v) != nil || !replaceWebsocketDeltas(v, deltas) {
+			continue
+		}
+		replaced, err := json.Marshal(v)
+		if err != nil {
+			continue
+		}
+		resp[i] = replaced
+	}
+	return resp
+}
+
+// payload returns the frame's message. JSON payloads are compacted as saved
+// recordings are indented
+func (f *WebsocketFrame) payload() []byte {
+	if f.Data != nil {
+		var b bytes.Buffer
+		if json.Compact(&b, f.Data) != nil {
+			return f.Data
+		}
+		return b.Bytes()
+	}
+	return []byte(f.Text)
+}
+
+func isWebsocketDeltaKey(key string) bool {
+	for i := range websocketDeltaKeys {
+		if strings.EqualFold(key, websocketDeltaKeys[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeJSON decodes numbers as json.Number so large IDs are kept exact
+func decodeJSON(data []byte, v interface{}) error {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	return d.Decode(v)
+}
+
+func writeWebsocketFrame(conn *websocket.Conn, payload []byte) error {
+	err := conn.WriteMessage(websocket.TextMessage, payload)
+	if err != nil {
+		log.Println("Mock Test Failure - Websocket write error", err)
+	}
+	return err
+}
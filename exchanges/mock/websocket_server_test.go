@@ -0,0 +1,138 @@
+package mock
+
+import (
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func testWebsocketExchanges() []WebsocketExchange {
+	return []WebsocketExchange{
+		{
+			Request: WebsocketFrame{Data: json.RawMessage(`{"method":"SUBSCRIBE","params":["btcusdt@depth","ethusdt@depth"],"id":123456789012}`)},
+			Responses: []WebsocketFrame{
+				{Data: json.RawMessage(`{"result":null,"id":123456789012}`)},
+				{Data: json.RawMessage(`{"stream":"btcusdt@depth","data":{"u":1}}`)},
+			},
+		},
+		{
+			Request:   WebsocketFrame{Text: "ping"},
+			Responses: []WebsocketFrame{{Text: "pong"}},
+		},
+	}
+}
+
+func TestMatchAndGetWebsocketResponses(t *testing.T) {
+	t.Parallel()
+	exchanges := testWebsocketExchanges()
+	replayed := make([]bool, len(exchanges))
+	_, err := MatchAndGetWebsocketResponses(exchanges, replayed, []byte(`{"method":"SUBSCRIBE","params":["btcusdt@trade"],"id":5}`))
+	if !errors.Is(err, errNoWebsocketMatch) {
+		t.Errorf("received '%v' expected '%v'", err, errNoWebsocketMatch)
+	}
+	// subscriptions match regardless of order and the received ID is echoed
+	resp, err := MatchAndGetWebsocketResponses(exchanges, replayed, []byte(`{"method":"SUBSCRIBE","params":["ethusdt@depth","btcusdt@depth"],"id":5}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(resp), 2)
+	}
+	if string(resp[0]) != `{"id":5,"result":null}` {
+		t.Errorf("received '%s' expected '%v'", resp[0], `{"id":5,"result":null}`)
+	}
+	if string(resp[1]) != `{"stream":"btcusdt@depth","data":{"u":1}}` {
+		t.Errorf("received '%s' expected '%v'", resp[1], `{"stream":"btcusdt@depth","data":{"u":1}}`)
+	}
+	// an exchange is only replayed once
+	_, err = MatchAndGetWebsocketResponses(exchanges, replayed, []byte(`{"method":"SUBSCRIBE","params":["ethusdt@depth","btcusdt@depth"],"id":6}`))
+	if !errors.Is(err, errNoWebsocketMatch) {
+		t.Errorf("received '%v' expected '%v'", err, errNoWebsocketMatch)
+	}
+	resp, err = MatchAndGetWebsocketResponses(exchanges, replayed, []byte("ping"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 1 || string(resp[0]) != "pong" {
+		t.Errorf("received '%s' expected '%v'", resp, "pong")
+	}
+}
+
+func TestNewWebsocketVCRServer(t *testing.T) {
+	t.Parallel()
+	_, err := NewWebsocketVCRServer("")
+	if !errors.Is(err, errNoWebsocketMockPath) {
+		t.Errorf("received '%v' expected '%v'", err, errNoWebsocketMockPath)
+	}
+	path := filepath.Join(t.TempDir(), "exchange.json")
+	r, err := NewWebsocketRecorder(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.mock.Connections["stream.binance.com:9443/stream"] = &WebsocketConnectionMock{
+		Connected: []WebsocketFrame{{Data: json.RawMessage(`{"welcome":true}`)}},
+		Exchanges: testWebsocketExchanges(),
+	}
+	err = r.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverURL, err := NewWebsocketVCRServer(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unrecordedURL, err := WebsocketReplayURL(serverURL, "wss://ws.kraken.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = websocket.DefaultDialer.Dial(unrecordedURL, nil)
+	if !errors.Is(err, websocket.ErrBadHandshake) {
+		t.Errorf("received '%v' expected '%v'", err, websocket.ErrBadHandshake)
+	}
+
+	replayURL, err := WebsocketReplayURL(serverURL, testWebsocketURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, _, err := websocket.DefaultDialer.Dial(replayURL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	err = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectWebsocketFrame(t, conn, `{"welcome":true}`)
+	// unrecorded requests are ignored
+	err = conn.WriteMessage(websocket.TextMessage, []byte("hello"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = conn.WriteJSON(map[string]interface{}{
+		"method": "SUBSCRIBE",
+		"params": []string{"btcusdt@depth", "ethusdt@depth"},
+		"id":     1337,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectWebsocketFrame(t, conn, `{"id":1337,"result":null}`)
+	expectWebsocketFrame(t, conn, `{"stream":"btcusdt@depth","data":{"u":1}}`)
+}
+
+func expectWebsocketFrame(t *testing.T, conn *websocket.Conn, expected string) {
+	t.Helper()
+	_, msg, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(msg) != expected {
+		t.Errorf("received '%s' expected '%v'", msg, expected)
+	}
+}
@@ -0,0 +1,405 @@
+package order
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// SetupPositionController returns a position controller ready to track
+// futures positions
+func SetupPositionController() *PositionController {
+	return &PositionController{
+		positions:     make(map[positionKey]*PositionTracker),
+		contractTypes: make(map[positionKey]ContractType),
+	}
+}
+
+// String returns the contract type as a string
+func (c ContractType) String() string {
+	switch c {
+	case LinearContract:
+		return "linear"
+	case InverseContract:
+		return "inverse"
+	}
+	return "unknown"
+}
+
+// StringToContractType converts a string to a contract type
+func StringToContractType(ct string) (ContractType, error) {
+	switch strings.ToLower(ct) {
+	case "linear":
+		return LinearContract, nil
+	case "inverse":
+		return InverseContract, nil
+	}
+	return UnknownContract, fmt.Errorf("%q %w", ct, errInvalidContractType)
+}
+
+// DefaultContractType returns the contract type assumed for an asset when none
+// has been set. Coin margined futures are inverse, all other futures linear
+func DefaultContractType(a asset.Item) ContractType {
+	if a == asset.CoinMarginedFutures {
+		return InverseContract
+	}
+	return LinearContract
+}
+
+func newPositionKey(exch string, a asset.Item, p currency.Pair) positionKey {
+	return positionKey{
+		exchange: strings.ToLower(exch),
+		asset:    a,
+		base:     p.Base.Upper().String(),
+		quote:    p.Quote.Upper().String(),
+	}
+}
+
+// SetContractType overrides the contract type used to calculate profit and
+// loss for a pair. It must be set before the first fill is tracked
+func (c *PositionController) SetContractType(exch string, a asset.Item, p currency.Pair, ct ContractType) error {
+	if ct != LinearContract && ct != InverseContract {
+		return fmt.Errorf("%w %v", errInvalidContractType, ct)
+	}
+	if !a.IsFutures() {
+		return fmt.Errorf("%w %v", ErrNotFuturesAsset, a)
+	}
+	key := newPositionKey(exch, a, p)
+	c.m.Lock()
+	defer c.m.Unlock()
+	if t, ok := c.positions[key]; ok && t.contractType != ct {
+		return errContractTypeLocked
+	}
+	c.contractTypes[key] = ct
+	return nil
+}
+
+// TrackNewOrder applies any newly executed amount of a futures order to its
+// position. Order details carry cumulative executed amounts so repeated
+// updates for the same order only apply the difference
+func (c *PositionController) TrackNewOrder(d *Detail) error {
+	if d == nil {
+		return errNilDetail
+	}
+	if !d.AssetType.IsFutures() {
+		return fmt.Errorf("%w %v", ErrNotFuturesAsset, d.AssetType)
+	}
+	if d.Exchange == "" {
+		return errExchangeNameUnset
+	}
+	if d.Pair.IsEmpty() {
+		return ErrPairIsEmpty
+	}
+	var sign decimal.Decimal
+	switch d.Side {
+	case Buy, Bid, Long:
+		sign = decimal.NewFromInt(1)
+	case Sell, Ask, Short:
+		sign = decimal.NewFromInt(-1)
+	default:
+		return fmt.Errorf("%w %v", ErrSideIsInvalid, d.Side)
+	}
+
+	executed := decimal.NewFromFloat(d.ExecutedAmount)
+	if executed.IsZero() && d.Status == Filled {
+		executed = decimal.NewFromFloat(d.Amount)
+	}
+	price := decimal.NewFromFloat(d.AverageExecutedPrice)
+	if price.IsZero() {
+		price = decimal.NewFromFloat(d.Price)
+	}
+	id := d.ID
+	if id == "" {
+		id = d.InternalOrderID
+	}
+	t := d.LastUpdated
+	if t.IsZero() {
+		t = d.Date
+	}
+	if t.IsZero() {
+		t = time.Now()
+	}
+
+	c.m.Lock()
+	defer c.m.Unlock()
+	tracker := c.getTracker(d.Exchange, d.AssetType, d.Pair)
+	if _, ok := tracker.finished[id]; ok {
+		// late or duplicate update for an order that has already been applied
+		return nil
+	}
+	fill := tracker.fills[id]
+	delta := executed.Sub(fill.amount)
+	fee := decimal.NewFromFloat(d.Fee)
+	if feeDelta := fee.Sub(fill.fee); feeDelta.IsPositive() {
+		tracker.fees = tracker.fees.Add(feeDelta)
+		fill.fee = fee
+		tracker.lastUpdated = t
+	}
+	if !delta.IsPositive() {
+		tracker.updateFill(id, fill, isFinishedOrder(d))
+		return nil
+	}
+	if !price.IsPositive() {
+		return fmt.Errorf("%s %s %w", d.Exchange, id, errPriceUnset)
+	}
+	// Derive the price of this fill from the change in executed notional so
+	// that partial fills at different prices are applied correctly
+	notional := executed.Mul(price)
+	fillPrice := notional.Sub(fill.notional).Div(delta)
+	if !fillPrice.IsPositive() {
+		fillPrice = price
+	}
+	tracker.applyFill(delta.Mul(sign), fillPrice, t)
+	fill.amount = executed
+	fill.notional = notional
+	tracker.updateFill(id, fill, isFinishedOrder(d))
+	return nil
+}
+
+// isFinishedOrder returns true when an order can receive no further fills
+func isFinishedOrder(d *Detail) bool {
+	if d.Amount > 0 {
+		return d.IsInactive()
+	}
+	switch d.Status {
+	case Filled, Cancelled, PartiallyCancelled, Rejected, Expired, Closed,
+		InsufficientBalance, MarketUnavailable:
+		return true
+	}
+	return false
+}
+
+// UpdateMarkPrice sets the price used to calculate unrealised profit and loss
+// for a position
+func (c *PositionController) UpdateMarkPrice(exch string, a asset.Item, p currency.Pair, price float64, t time.Time) error {
+	if price <= 0 {
+		return errPriceUnset
+	}
+	c.m.Lock()
+	defer c.m.Unlock()
+	tracker, ok := c.positions[newPositionKey(exch, a, p)]
+	if !ok {
+		return fmt.Errorf("%s %s %s %w", exch, a, p, ErrPositionNotFound)
+	}
+	tracker.markPrice = decimal.NewFromFloat(price)
+	tracker.updateUnrealised()
+	if t.After(tracker.lastUpdated) {
+		tracker.lastUpdated = t
+	}
+	return nil
+}
+
+// AddFundingPayment records a funding payment for a position, positive
+// payments are received and negative payments are paid
+func (c *PositionController) AddFundingPayment(exch string, a asset.Item, p currency.Pair, payment float64, t time.Time) error {
+	c.m.Lock()
+	defer c.m.Unlock()
+	tracker, ok := c.positions[newPositionKey(exch, a, p)]
+	if !ok {
+		return fmt.Errorf("%s %s %s %w", exch, a, p, ErrPositionNotFound)
+	}
+	tracker.addFunding(FundingPayment{Payment: payment, Time: t})
+	return nil
+}
+
+// ApplyFundingRate calculates and records the funding payment for a position
+// from a funding rate and the current mark price. Longs pay shorts when the
+// rate is positive. The payment is returned
+func (c *PositionController) ApplyFundingRate(exch string, a asset.Item, p currency.Pair, rate float64, t time.Time) (float64, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	tracker, ok := c.positions[newPositionKey(exch, a, p)]
+	if !ok {
+		return 0, fmt.Errorf("%s %s %s %w", exch, a, p, ErrPositionNotFound)
+	}
+	if tracker.size.IsZero() || !tracker.markPrice.IsPositive() {
+		return 0, errFundingNoPositionValue
+	}
+	// Position value is in the settlement currency: quote for linear and base
+	// for inverse contracts
+	value := tracker.size.Mul(tracker.markPrice)
+	if tracker.contractType == InverseContract {
+		value = tracker.size.Div(tracker.markPrice)
+	}
+	payment, _ := value.Mul(decimal.NewFromFloat(rate)).Neg().Float64()
+	tracker.addFunding(FundingPayment{Payment: payment, Rate: rate, Time: t})
+	return payment, nil
+}
+
+// GetPositions returns positions matching the exchange, asset and pair. Empty
+// filter values match all positions
+func (c *PositionController) GetPositions(exch string, a asset.Item, p currency.Pair) ([]PositionStats, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	var resp []PositionStats
+	for k, v := range c.positions {
+		if exch != "" && k.exchange != strings.ToLower(exch) {
+			continue
+		}
+		if a != "" && k.asset != a {
+			continue
+		}
+		if !p.IsEmpty() && (k.base != p.Base.Upper().String() || k.quote != p.Quote.Upper().String()) {
+			continue
+		}
+		resp = append(resp, v.stats())
+	}
+	if len(resp) == 0 && exch != "" && a != "" && !p.IsEmpty() {
+		return nil, fmt.Errorf("%s %s %s %w", exch, a, p, ErrPositionNotFound)
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		if resp[i].Exchange != resp[j].Exchange {
+			return resp[i].Exchange < resp[j].Exchange
+		}
+		if resp[i].Asset != resp[j].Asset {
+			return resp[i].Asset < resp[j].Asset
+		}
+		return resp[i].Pair.String() < resp[j].Pair.String()
+	})
+	return resp, nil
+}
+
+// getTracker returns the tracker for a position, creating it when required.
+// The lock must be held by the caller
+func (c *PositionController) getTracker(exch string, a asset.Item, p currency.Pair) *PositionTracker {
+	key := newPositionKey(exch, a, p)
+	tracker, ok := c.positions[key]
+	if ok {
+		return tracker
+	}
+	ct, ok := c.contractTypes[key]
+	if !ok {
+		ct = DefaultContractType(a)
+	}
+	tracker = &PositionTracker{
+		exchange:     exch,
+		asset:        a,
+		pair:         p,
+		contractType: ct,
+		fills:        make(map[string]orderFill),
+		finished:     make(map[string]struct{}),
+	}
+	c.positions[key] = tracker
+	return tracker
+}
+
+// updateFill stores the amounts applied for an order. Finished orders are
+// dropped from the fills and remembered, up to maxFinishedOrders, so that
+// late updates for them are not applied a second time
+func (p *PositionTracker) updateFill(id string, fill orderFill, finished bool) {
+	if !finished {
+		p.fills[id] = fill
+		return
+	}
+	delete(p.fills, id)
+	p.finished[id] = struct{}{}
+	p.finishedIDs = append(p.finishedIDs, id)
+	if len(p.finishedIDs) > maxFinishedOrders {
+		delete(p.finished, p.finishedIDs[0])
+		p.finishedIDs = p.finishedIDs[1:]
+	}
+}
+
+// applyFill adjusts the position by a signed amount at a price, realising
+// profit and loss on any reduced amount
+func (p *PositionTracker) applyFill(amount, price decimal.Decimal, t time.Time) {
+	if p.size.IsZero() || p.size.Sign() == amount.Sign() {
+		if p.size.IsZero() {
+			p.openedAt = t
+			p.entryPrice = price
+		} else {
+			p.entryPrice = p.averageEntry(amount.Abs(), price)
+		}
+		p.size = p.size.Add(amount)
+	} else {
+		closing := decimal.Min(amount.Abs(), p.size.Abs())
+		if p.size.IsNegative() {
+			closing = closing.Neg()
+		}
+		p.realisedPNL = p.realisedPNL.Add(p.pnl(closing, p.entryPrice, price))
+		p.size = p.size.Add(amount)
+		switch {
+		case p.size.IsZero():
+			p.entryPrice = decimal.Zero
+		case p.size.Sign() == amount.Sign():
+			// The fill closed the position and opened one on the other side
+			p.entryPrice = price
+			p.openedAt = t
+		}
+	}
+	if p.markPrice.IsZero() {
+		p.markPrice = price
+	}
+	p.updateUnrealised()
+	p.lastUpdated = t
+}
+
+// averageEntry returns the entry price after increasing the position. Linear
+// contracts use the arithmetic mean and inverse contracts the harmonic mean of
+// fill prices weighted by size
+func (p *PositionTracker) averageEntry(amount, price decimal.Decimal) decimal.Decimal {
+	size := p.size.Abs()
+	total := size.Add(amount)
+	if p.contractType == InverseContract {
+		return total.Div(size.Div(p.entryPrice).Add(amount.Div(price)))
+	}
+	return size.Mul(p.entryPrice).Add(amount.Mul(price)).Div(total)
+}
+
+// pnl returns the profit or loss of a signed amount between entry and exit
+// prices in the contract settlement currency
+func (p *PositionTracker) pnl(amount, entry, exit decimal.Decimal) decimal.Decimal {
+	if entry.IsZero() || exit.IsZero() {
+		return decimal.Zero
+	}
+	if p.contractType == InverseContract {
+		return amount.Mul(decimal.NewFromInt(1).Div(entry).Sub(decimal.NewFromInt(1).Div(exit)))
+	}
+	return amount.Mul(exit.Sub(entry))
+}
+
+func (p *PositionTracker) updateUnrealised() {
+	p.unrealisedPNL = p.pnl(p.size, p.entryPrice, p.markPrice)
+}
+
+func (p *PositionTracker) addFunding(f FundingPayment) {
+	p.funding = p.funding.Add(decimal.NewFromFloat(f.Payment))
+	p.fundingPayments = append(p.fundingPayments, f)
+	if f.Time.After(p.lastUpdated) {
+		p.lastUpdated = f.Time
+	}
+}
+
+func (p *PositionTracker) stats() PositionStats {
+	s := PositionStats{
+		Exchange:        p.exchange,
+		Asset:           p.asset,
+		Pair:            p.pair,
+		ContractType:    p.contractType,
+		Status:          Closed,
+		Side:            UnknownSide,
+		FundingPayments: append([]FundingPayment(nil), p.fundingPayments...),
+		OpenedAt:        p.openedAt,
+		LastUpdated:     p.lastUpdated,
+	}
+	switch {
+	case p.size.IsPositive():
+		s.Status, s.Side = Open, Long
+	case p.size.IsNegative():
+		s.Status, s.Side = Open, Short
+	}
+	s.Size, _ = p.size.Abs().Float64()
+	s.EntryPrice, _ = p.entryPrice.Float64()
+	s.MarkPrice, _ = p.markPrice.Float64()
+	s.RealisedPNL, _ = p.realisedPNL.Float64()
+	s.UnrealisedPNL, _ = p.unrealisedPNL.Float64()
+	s.Fees, _ = p.fees.Float64()
+	s.Funding, _ = p.funding.Float64()
+	s.TotalPNL, _ = p.realisedPNL.Add(p.unrealisedPNL).Add(p.funding).Sub(p.fees).Float64()
+	return s
+}
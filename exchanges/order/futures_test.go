@@ -0,0 +1,390 @@
+package order
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+const testFuturesExchange = "test"
+
+func approxEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func getPosition(t *testing.T, c *PositionController, a asset.Item, p currency.Pair) PositionStats {
+	t.Helper()
+	positions, err := c.GetPositions(testFuturesExchange, a, p)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if len(positions) != 1 {
+		t.Fatalf("received '%v', expected '%v'", len(positions), 1)
+	}
+	return positions[0]
+}
+
+func TestTrackNewOrder(t *testing.T) {
+	t.Parallel()
+	c := SetupPositionController()
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	err := c.TrackNewOrder(nil)
+	if !errors.Is(err, errNilDetail) {
+		t.Errorf("received '%v', expected '%v'", err, errNilDetail)
+	}
+	err = c.TrackNewOrder(&Detail{Exchange: testFuturesExchange, AssetType: asset.Spot, Pair: p})
+	if !errors.Is(err, ErrNotFuturesAsset) {
+		t.Errorf("received '%v', expected '%v'", err, ErrNotFuturesAsset)
+	}
+	err = c.TrackNewOrder(&Detail{AssetType: asset.USDTMarginedFutures, Pair: p})
+	if !errors.Is(err, errExchangeNameUnset) {
+		t.Errorf("received '%v', expected '%v'", err, errExchangeNameUnset)
+	}
+	err = c.TrackNewOrder(&Detail{Exchange: testFuturesExchange, AssetType: asset.USDTMarginedFutures, Pair: p, Side: AnySide})
+	if !errors.Is(err, ErrSideIsInvalid) {
+		t.Errorf("received '%v', expected '%v'", err, ErrSideIsInvalid)
+	}
+	_, err = c.GetPositions(testFuturesExchange, asset.USDTMarginedFutures, p)
+	if !errors.Is(err, ErrPositionNotFound) {
+		t.Errorf("received '%v', expected '%v'", err, ErrPositionNotFound)
+	}
+}
+
+func TestLinearPosition(t *testing.T) {
+	t.Parallel()
+	c := SetupPositionController()
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	tm := time.Now()
+	buy := &Detail{
+		Exchange:             testFuturesExchange,
+		AssetType:            asset.USDTMarginedFutures,
+		Pair:                 p,
+		ID:                   "1",
+		Side:                 Buy,
+		Amount:               2,
+		ExecutedAmount:       1,
+		AverageExecutedPrice: 100,
+		Fee:                  0.1,
+		LastUpdated:          tm,
+	}
+	err := c.TrackNewOrder(buy)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	// repeated updates without new executions are ignored
+	err = c.TrackNewOrder(buy)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	buy.ExecutedAmount = 2
+	buy.AverageExecutedPrice = 150
+	buy.Fee = 0.2
+	buy.Status = Filled
+	err = c.TrackNewOrder(buy)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	pos := getPosition(t, c, asset.USDTMarginedFutures, p)
+	if pos.Side != Long || pos.Status != Open || pos.Size != 2 || pos.EntryPrice != 150 {
+		t.Fatalf("received '%+v', unexpected position", pos)
+	}
+	if !approxEqual(pos.Fees, 0.2) {
+		t.Errorf("received '%v', expected '%v'", pos.Fees, 0.2)
+	}
+
+	err = c.UpdateMarkPrice(testFuturesExchange, asset.USDTMarginedFutures, p, 175, tm)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	pos = getPosition(t, c, asset.USDTMarginedFutures, p)
+	if pos.UnrealisedPNL != 50 {
+		t.Errorf("received '%v', expected '%v'", pos.UnrealisedPNL, 50)
+	}
+
+	// selling through the position realises the long and opens a short
+	err = c.TrackNewOrder(&Detail{
+		Exchange:  testFuturesExchange,
+		AssetType: asset.USDTMarginedFutures,
+		Pair:      p,
+		ID:        "2",
+		Side:      Sell,
+		Amount:    3,
+		Price:     300,
+		Status:    Filled,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	pos = getPosition(t, c, asset.USDTMarginedFutures, p)
+	if pos.Side != Short || pos.Size != 1 || pos.EntryPrice != 300 {
+		t.Fatalf("received '%+v', unexpected position", pos)
+	}
+	if pos.RealisedPNL != 300 {
+		t.Errorf("received '%v', expected '%v'", pos.RealisedPNL, 300)
+	}
+	err = c.UpdateMarkPrice(testFuturesExchange, asset.USDTMarginedFutures, p, 250, tm)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	pos = getPosition(t, c, asset.USDTMarginedFutures, p)
+	if pos.UnrealisedPNL != 50 {
+		t.Errorf("received '%v', expected '%v'", pos.UnrealisedPNL, 50)
+	}
+	if !approxEqual(pos.TotalPNL, 349.8) {
+		t.Errorf("received '%v', expected '%v'", pos.TotalPNL, 349.8)
+	}
+
+	err = c.TrackNewOrder(&Detail{
+		Exchange:  testFuturesExchange,
+		AssetType: asset.USDTMarginedFutures,
+		Pair:      p,
+		ID:        "3",
+		Side:      Buy,
+		Amount:    1,
+		Price:     250,
+		Status:    Filled,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	pos = getPosition(t, c, asset.USDTMarginedFutures, p)
+	if pos.Status != Closed || pos.Size != 0 || pos.UnrealisedPNL != 0 || pos.RealisedPNL != 350 {
+		t.Errorf("received '%+v', unexpected position", pos)
+	}
+}
+
+func TestInversePosition(t *testing.T) {
+	t.Parallel()
+	c := SetupPositionController()
+	p := currency.NewPair(currency.BTC, currency.USD)
+	for i, price := range []float64{50000, 40000} {
+		err := c.TrackNewOrder(&Detail{
+			Exchange:  testFuturesExchange,
+			AssetType: asset.CoinMarginedFutures,
+			Pair:      p,
+			ID:        string(rune('a' + i)),
+			Side:      Buy,
+			Amount:    1000,
+			Price:     price,
+			Status:    Filled,
+		})
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v', expected '%v'", err, nil)
+		}
+	}
+	pos := getPosition(t, c, asset.CoinMarginedFutures, p)
+	if pos.ContractType != InverseContract {
+		t.Fatalf("received '%v', expected '%v'", pos.ContractType, InverseContract)
+	}
+	// harmonic mean of 50000 and 40000 weighted by contracts
+	if !approxEqual(pos.EntryPrice, 2000/(1000.0/50000+1000.0/40000)) {
+		t.Errorf("received '%v', unexpected entry price", pos.EntryPrice)
+	}
+	err := c.UpdateMarkPrice(testFuturesExchange, asset.CoinMarginedFutures, p, 50000, time.Now())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	pos = getPosition(t, c, asset.CoinMarginedFutures, p)
+	if !approxEqual(pos.UnrealisedPNL, 0.005) {
+		t.Errorf("received '%v', expected '%v'", pos.UnrealisedPNL, 0.005)
+	}
+
+	payment, err := c.ApplyFundingRate(testFuturesExchange, asset.CoinMarginedFutures, p, 0.0001, time.Now())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if !approxEqual(payment, -0.000004) {
+		t.Errorf("received '%v', expected '%v'", payment, -0.000004)
+	}
+
+	err = c.TrackNewOrder(&Detail{
+		Exchange:  testFuturesExchange,
+		AssetType: asset.CoinMarginedFutures,
+		Pair:      p,
+		ID:        "c",
+		Side:      Sell,
+		Amount:    2000,
+		Price:     50000,
+		Status:    Filled,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	pos = getPosition(t, c, asset.CoinMarginedFutures, p)
+	if pos.Status != Closed || !approxEqual(pos.RealisedPNL, 0.005) {
+		t.Errorf("received '%+v', unexpected position", pos)
+	}
+	if !approxEqual(pos.TotalPNL, 0.004996) {
+		t.Errorf("received '%v', expected '%v'", pos.TotalPNL, 0.004996)
+	}
+}
+
+func TestStringToContractType(t *testing.T) {
+	t.Parallel()
+	ct, err := StringToContractType("Linear")
+	if err != nil || ct != LinearContract {
+		t.Errorf("received '%v' '%v', expected '%v'", ct, err, LinearContract)
+	}
+	ct, err = StringToContractType("inverse")
+	if err != nil || ct != InverseContract {
+		t.Errorf("received '%v' '%v', expected '%v'", ct, err, InverseContract)
+	}
+	_, err = StringToContractType("quanto")
+	if !errors.Is(err, errInvalidContractType) {
+		t.Errorf("received '%v', expected '%v'", err, errInvalidContractType)
+	}
+}
+
+func TestSetContractType(t *testing.T) {
+	t.Parallel()
+	c := SetupPositionController()
+	p := currency.NewPair(currency.BTC, currency.USD)
+	err := c.SetContractType(testFuturesExchange, asset.PerpetualSwap, p, UnknownContract)
+	if !errors.Is(err, errInvalidContractType) {
+		t.Errorf("received '%v', expected '%v'", err, errInvalidContractType)
+	}
+	err = c.SetContractType(testFuturesExchange, asset.Spot, p, InverseContract)
+	if !errors.Is(err, ErrNotFuturesAsset) {
+		t.Errorf("received '%v', expected '%v'", err, ErrNotFuturesAsset)
+	}
+	err = c.SetContractType(testFuturesExchange, asset.PerpetualSwap, p, InverseContract)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	err = c.TrackNewOrder(&Detail{
+		Exchange:  testFuturesExchange,
+		AssetType: asset.PerpetualSwap,
+		Pair:      currency.NewPairWithDelimiter("btc", "usd", "-"),
+		ID:        "1",
+		Side:      Short,
+		Amount:    100,
+		Price:     20000,
+		Status:    Filled,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	pos := getPosition(t, c, asset.PerpetualSwap, p)
+	if pos.ContractType != InverseContract || pos.Side != Short {
+		t.Errorf("received '%+v', unexpected position", pos)
+	}
+	err = c.SetContractType(testFuturesExchange, asset.PerpetualSwap, p, LinearContract)
+	if !errors.Is(err, errContractTypeLocked) {
+		t.Errorf("received '%v', expected '%v'", err, errContractTypeLocked)
+	}
+}
+
+func TestFundingPayments(t *testing.T) {
+	t.Parallel()
+	c := SetupPositionController()
+	p := currency.NewPair(currency.ETH, currency.USDT)
+	err := c.AddFundingPayment(testFuturesExchange, asset.PerpetualSwap, p, 1, time.Now())
+	if !errors.Is(err, ErrPositionNotFound) {
+		t.Errorf("received '%v', expected '%v'", err, ErrPositionNotFound)
+	}
+	err = c.TrackNewOrder(&Detail{
+		Exchange:  testFuturesExchange,
+		AssetType: asset.PerpetualSwap,
+		Pair:      p,
+		ID:        "1",
+		Side:      Sell,
+		Amount:    2,
+		Price:     100,
+		Status:    Filled,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	payment, err := c.ApplyFundingRate(testFuturesExchange, asset.PerpetualSwap, p, 0.01, time.Now())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	// shorts receive funding when the rate is positive
+	if payment != 2 {
+		t.Errorf("received '%v', expected '%v'", payment, 2)
+	}
+	err = c.AddFundingPayment(testFuturesExchange, asset.PerpetualSwap, p, -0.5, time.Now())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	pos := getPosition(t, c, asset.PerpetualSwap, p)
+	if pos.Funding != 1.5 || len(pos.FundingPayments) != 2 || pos.TotalPNL != 1.5 {
+		t.Errorf("received '%+v', unexpected position", pos)
+	}
+	all, err := c.GetPositions("", "", currency.Pair{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if len(all) != 1 {
+		t.Errorf("received '%v', expected '%v'", len(all), 1)
+	}
+}
+
+func TestFinishedOrderFills(t *testing.T) {
+	t.Parallel()
+	c := SetupPositionController()
+	p := currency.NewPair(currency.LTC, currency.USDT)
+	o := &Detail{
+		Exchange:       testFuturesExchange,
+		AssetType:      asset.USDTMarginedFutures,
+		Pair:           p,
+		ID:             "1",
+		Side:           Buy,
+		Amount:         2,
+		ExecutedAmount: 1,
+		Price:          100,
+		Status:         PartiallyFilled,
+	}
+	err := c.TrackNewOrder(o)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	tracker := c.positions[newPositionKey(testFuturesExchange, asset.USDTMarginedFutures, p)]
+	if len(tracker.fills) != 1 {
+		t.Fatalf("received '%v', expected '%v'", len(tracker.fills), 1)
+	}
+	o.Status = Cancelled
+	err = c.TrackNewOrder(o)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	if len(tracker.fills) != 0 {
+		t.Errorf("received '%v', expected '%v'", len(tracker.fills), 0)
+	}
+	// late updates for a finished order are not applied again
+	o.Status = PartiallyFilled
+	err = c.TrackNewOrder(o)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	pos := getPosition(t, c, asset.USDTMarginedFutures, p)
+	if pos.Size != 1 || len(tracker.fills) != 0 {
+		t.Errorf("received '%+v', unexpected position", pos)
+	}
+
+	for i := 0; i < maxFinishedOrders+1; i++ {
+		err = c.TrackNewOrder(&Detail{
+			Exchange:  testFuturesExchange,
+			AssetType: asset.USDTMarginedFutures,
+			Pair:      p,
+			ID:        "f" + strconv.Itoa(i),
+			Side:      Sell,
+			Amount:    1,
+			Status:    Cancelled,
+		})
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v', expected '%v'", err, nil)
+		}
+	}
+	if len(tracker.finished) != maxFinishedOrders || len(tracker.finishedIDs) != maxFinishedOrders {
+		t.Errorf("received '%v', expected '%v'", len(tracker.finished), maxFinishedOrders)
+	}
+	if _, ok := tracker.finished["1"]; ok {
+		t.Error("expected the oldest finished order to be forgotten")
+	}
+}
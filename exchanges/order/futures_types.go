@@ -0,0 +1,125 @@
+package order
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var (
+	// ErrNotFuturesAsset is returned when attempting to track a position for
+	// an asset which is not a futures contract
+	ErrNotFuturesAsset = errors.New("asset type is not a futures asset")
+	// ErrPositionNotFound is returned when no position is tracked for an
+	// exchange, asset and pair
+	ErrPositionNotFound = errors.New("position not found")
+
+	errNilDetail              = errors.New("order detail is nil")
+	errExchangeNameUnset      = errors.New("exchange name is unset")
+	errInvalidContractType    = errors.New("invalid contract type")
+	errPriceUnset             = errors.New("price is unset")
+	errContractTypeLocked     = errors.New("contract type cannot change while a position is tracked")
+	errFundingNoPositionValue = errors.New("no open position or mark price to apply funding rate to")
+)
+
+// maxFinishedOrders is the number of finished order IDs remembered per
+// position to ignore late updates for orders that have already been applied
+const maxFinishedOrders = 1000
+
+// ContractType determines how position profit and loss is calculated
+type ContractType uint8
+
+// Contract types
+const (
+	UnknownContract ContractType = iota
+	// LinearContract positions are sized in the base currency and settle
+	// profit and loss in the quote currency
+	LinearContract
+	// InverseContract positions are sized in quote currency contracts and
+	// settle profit and loss in the base currency
+	InverseContract
+)
+
+// PositionController tracks futures positions per exchange, asset and pair
+// from order fills
+type PositionController struct {
+	m             sync.Mutex
+	positions     map[positionKey]*PositionTracker
+	contractTypes map[positionKey]ContractType
+}
+
+// positionKey uniquely identifies a position regardless of pair formatting
+type positionKey struct {
+	exchange string
+	asset    asset.Item
+	base     string
+	quote    string
+}
+
+// PositionTracker holds a single futures position. Size is signed, positive
+// for long positions and negative for short positions
+type PositionTracker struct {
+	exchange        string
+	asset           asset.Item
+	pair            currency.Pair
+	contractType    ContractType
+	size            decimal.Decimal
+	entryPrice      decimal.Decimal
+	markPrice       decimal.Decimal
+	realisedPNL     decimal.Decimal
+	unrealisedPNL   decimal.Decimal
+	fees            decimal.Decimal
+	funding         decimal.Decimal
+	openedAt        time.Time
+	lastUpdated     time.Time
+	fills           map[string]orderFill
+	finished        map[string]struct{}
+	finishedIDs     []string
+	fundingPayments []FundingPayment
+}
+
+// orderFill holds the cumulative executed amount, notional and fee already
+// applied to a position for an order
+type orderFill struct {
+	amount   decimal.Decimal
+	notional decimal.Decimal
+	fee      decimal.Decimal
+}
+
+// FundingPayment is a funding payment received, when positive, or paid,
+// when negative, for a position in its settlement currency
+type FundingPayment struct {
+	Payment float64
+	Rate    float64
+	Time    time.Time
+}
+
+// PositionStats is a snapshot of a futures position. Profit and loss, fees and
+// funding are denominated in the quote currency for linear contracts and the
+// base currency for inverse contracts
+type PositionStats struct {
+	Exchange     string
+	Asset        asset.Item
+	Pair         currency.Pair
+	ContractType ContractType
+	Status       Status
+	Side         Side
+	Size         float64
+	EntryPrice   float64
+	MarkPrice    float64
+	// RealisedPNL excludes fees and funding payments
+	RealisedPNL     float64
+	UnrealisedPNL   float64
+	Fees            float64
+	Funding         float64
+	FundingPayments []FundingPayment
+	// TotalPNL is realised and unrealised profit and loss plus funding less
+	// fees
+	TotalPNL    float64
+	OpenedAt    time.Time
+	LastUpdated time.Time
+}
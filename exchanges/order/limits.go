@@ -408,3 +408,28 @@ func (l *Limits) ConformToAmount(amount float64) float64 {
 	fVal, _ := rVal.Float64()
 	return fVal
 }
+
+// ConformToPrice conforms price to its price interval, rounding down to the
+// nearest step
+func (l *Limits) ConformToPrice(price float64) float64 {
+	if l == nil {
+		// For when we return a nil pointer we can assume there's nothing to
+		// check
+		return price
+	}
+	l.m.Lock()
+	defer l.m.Unlock()
+	if l.stepIncrementSizePrice == 0 || price == l.stepIncrementSizePrice {
+		return price
+	}
+
+	if price < l.stepIncrementSizePrice {
+		return 0
+	}
+
+	dPrice := decimal.NewFromFloat(price)
+	dStep := decimal.NewFromFloat(l.stepIncrementSizePrice)
+	// subtract modulus to get the floor
+	fVal, _ := dPrice.Sub(dPrice.Mod(dStep)).Float64()
+	return fVal
+}
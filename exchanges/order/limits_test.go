@@ -406,3 +406,32 @@ func TestConformToAmount(t *testing.T) {
 		t.Fatal("unexpected amount", val)
 	}
 }
+
+func TestConformToPrice(t *testing.T) {
+	t.Parallel()
+	var tt *Limits
+	if tt.ConformToPrice(1.001) != 1.001 {
+		t.Fatal("value should not be changed")
+	}
+
+	tt = &Limits{}
+	if val := tt.ConformToPrice(1.23); val != 1.23 {
+		t.Fatal("unexpected price", val)
+	}
+
+	tt.stepIncrementSizePrice = 0.5
+	if val := tt.ConformToPrice(0.4); val != 0 {
+		t.Error("unexpected price", val)
+	}
+	if val := tt.ConformToPrice(0.5); val != 0.5 {
+		t.Error("unexpected price", val)
+	}
+	if val := tt.ConformToPrice(20000.99); val != 20000.5 {
+		t.Error("unexpected price", val)
+	}
+
+	tt.stepIncrementSizePrice = 0.01
+	if val := tt.ConformToPrice(1.23456); val != 1.23 {
+		t.Error("unexpected price", val)
+	}
+}
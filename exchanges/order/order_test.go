@@ -552,6 +552,8 @@ var stringsToOrderSide = []struct {
 	{"ask", Ask, nil},
 	{"ASK", Ask, nil},
 	{"aSk", Ask, nil},
+	{"long", Long, nil},
+	{"SHORT", Short, nil},
 	{"any", AnySide, nil},
 	{"ANY", AnySide, nil},
 	{"aNy", AnySide, nil},
@@ -921,33 +923,35 @@ func TestUpdateOrderFromDetail(t *testing.T) {
 	}
 
 	om := Detail{
-		ImmediateOrCancel: true,
-		HiddenOrder:       true,
-		FillOrKill:        true,
-		PostOnly:          true,
-		Leverage:          1,
-		Price:             1,
-		Amount:            1,
-		LimitPriceUpper:   1,
-		LimitPriceLower:   1,
-		TriggerPrice:      1,
-		TargetAmount:      1,
-		ExecutedAmount:    1,
-		RemainingAmount:   1,
-		Fee:               1,
-		Exchange:          "1",
-		InternalOrderID:   "1",
-		ID:                "1",
-		AccountID:         "1",
-		ClientID:          "1",
-		WalletAddress:     "1",
-		Type:              "1",
-		Side:              "1",
-		Status:            "1",
-		AssetType:         "1",
-		LastUpdated:       updated,
-		Pair:              pair,
-		Trades:            []TradeHistory{},
+		ImmediateOrCancel:    true,
+		HiddenOrder:          true,
+		FillOrKill:           true,
+		PostOnly:             true,
+		Leverage:             1,
+		Price:                1,
+		Amount:               1,
+		LimitPriceUpper:      1,
+		LimitPriceLower:      1,
+		TriggerPrice:         1,
+		TargetAmount:         1,
+		ExecutedAmount:       1,
+		AverageExecutedPrice: 1,
+		Cost:                 1,
+		RemainingAmount:      1,
+		Fee:                  1,
+		Exchange:             "1",
+		InternalOrderID:      "1",
+		ID:                   "1",
+		AccountID:            "1",
+		ClientID:             "1",
+		WalletAddress:        "1",
+		Type:                 "1",
+		Side:                 "1",
+		Status:               "1",
+		AssetType:            "1",
+		LastUpdated:          updated,
+		Pair:                 pair,
+		Trades:               []TradeHistory{},
 	}
 
 	od.UpdateOrderFromDetail(&om)
@@ -990,6 +994,12 @@ func TestUpdateOrderFromDetail(t *testing.T) {
 	if od.ExecutedAmount != 1 {
 		t.Error("Failed to update")
 	}
+	if od.AverageExecutedPrice != 1 {
+		t.Error("Failed to update")
+	}
+	if od.Cost != 1 {
+		t.Error("Failed to update")
+	}
 	if od.RemainingAmount != 1 {
 		t.Error("Failed to update")
 	}
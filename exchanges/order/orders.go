@@ -100,6 +100,14 @@ func (d *Detail) UpdateOrderFromDetail(m *Detail) {
 		d.ExecutedAmount = m.ExecutedAmount
 		updated = true
 	}
+	if m.AverageExecutedPrice > 0 && m.AverageExecutedPrice != d.AverageExecutedPrice {
+		d.AverageExecutedPrice = m.AverageExecutedPrice
+		updated = true
+	}
+	if m.Cost > 0 && m.Cost != d.Cost {
+		d.Cost = m.Cost
+		updated = true
+	}
 	if m.Fee > 0 && m.Fee != d.Fee {
 		d.Fee = m.Fee
 		updated = true
@@ -714,6 +722,10 @@ func StringToOrderSide(side string) (Side, error) {
 		return Bid, nil
 	case strings.EqualFold(side, Ask.String()):
 		return Ask, nil
+	case strings.EqualFold(side, Long.String()):
+		return Long, nil
+	case strings.EqualFold(side, Short.String()):
+		return Short, nil
 	case strings.EqualFold(side, AnySide.String()):
 		return AnySide, nil
 	default:
@@ -0,0 +1,307 @@
+package orderbook
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	math "github.com/thrasher-corp/gocryptotrader/common/math"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// Consolidate merges exchange orderbooks into a single orderbook for a pair.
+// Each level is converted into the quote currency of the pair by its source's
+// rate and adjusted by its fee. Levels with the same price keep the order of
+// the sources
+func Consolidate(p currency.Pair, a asset.Item, sources ...ConsolidationSource) (*ConsolidatedBase, error) {
+	if p.IsEmpty() {
+		return nil, errPairNotSet
+	}
+	if !a.IsValid() {
+		return nil, errAssetTypeNotSet
+	}
+	c := &ConsolidatedBase{Pair: p, Asset: a}
+	for i := range sources {
+		book := sources[i].Book
+		if book == nil {
+			return nil, errNilConsolidationSource
+		}
+		if sources[i].Rate <= 0 {
+			return nil, fmt.Errorf("%s %s %w", book.Exchange, book.Pair, errInvalidConversionRate)
+		}
+		if sources[i].Fee < 0 || sources[i].Fee >= 1 {
+			return nil, fmt.Errorf("%s %s %w", book.Exchange, book.Pair, errInvalidFee)
+		}
+		for j := range book.Bids {
+			c.Bids = append(c.Bids, ConsolidatedItem{
+				Exchange: book.Exchange,
+				Pair:     book.Pair,
+				Amount:   book.Bids[j].Amount,
+				Price:    book.Bids[j].Price * sources[i].Rate * (1 - sources[i].Fee),
+				RawPrice: book.Bids[j].Price,
+				Fee:      sources[i].Fee,
+			})
+		}
+		for j := range book.Asks {
+			c.Asks = append(c.Asks, ConsolidatedItem{
+				Exchange: book.Exchange,
+				Pair:     book.Pair,
+				Amount:   book.Asks[j].Amount,
+				Price:    book.Asks[j].Price * sources[i].Rate * (1 + sources[i].Fee),
+				RawPrice: book.Asks[j].Price,
+				Fee:      sources[i].Fee,
+			})
+		}
+		if book.LastUpdated.After(c.LastUpdated) {
+			c.LastUpdated = book.LastUpdated
+		}
+		if !containsExchange(c.Exchanges, book.Exchange) {
+			c.Exchanges = append(c.Exchanges, book.Exchange)
+		}
+	}
+	sort.SliceStable(c.Bids, func(i, j int) bool { return c.Bids[i].Price > c.Bids[j].Price })
+	sort.SliceStable(c.Asks, func(i, j int) bool { return c.Asks[i].Price < c.Asks[j].Price })
+	return c, nil
+}
+
+func containsExchange(exchanges []string, exchangeName string) bool {
+	for i := range exchanges {
+		if strings.EqualFold(exchanges[i], exchangeName) {
+			return true
+		}
+	}
+	return false
+}
+
+// SimulateOrder walks the consolidated orderbook across exchanges. Buy
+// amounts are in the quote currency and sell amounts in the base currency
+func (c *ConsolidatedBase) SimulateOrder(amount float64, buy bool) (*ConsolidatedSimulationResult, error) {
+	if amount <= 0 {
+		return nil, errAmountInvalid
+	}
+	r := &ConsolidatedSimulationResult{RemainingAmount: amount}
+	if buy {
+		for i := range c.Asks {
+			if r.RemainingAmount <= 0 {
+				break
+			}
+			level := c.Asks[i]
+			cost := level.Price * level.Amount
+			if cost > r.RemainingAmount {
+				level.Amount = r.RemainingAmount / level.Price
+				cost = r.RemainingAmount
+			}
+			r.RemainingAmount -= cost
+			r.Amount += level.Amount
+			r.Orders = append(r.Orders, level)
+		}
+		if r.Amount > 0 {
+			r.AveragePrice = (amount - r.RemainingAmount) / r.Amount
+		}
+	} else {
+		for i := range c.Bids {
+			if r.RemainingAmount <= 0 {
+				break
+			}
+			level := c.Bids[i]
+			if level.Amount > r.RemainingAmount {
+				level.Amount = r.RemainingAmount
+			}
+			r.RemainingAmount -= level.Amount
+			r.Amount += level.Amount * level.Price
+			r.Orders = append(r.Orders, level)
+		}
+		if filled := amount - r.RemainingAmount; filled > 0 {
+			r.AveragePrice = r.Amount / filled
+		}
+	}
+	if r.RemainingAmount < 0 {
+		r.RemainingAmount = 0
+	}
+	r.summarise(buy)
+	if buy {
+		r.Status = fmt.Sprintf("Buying %v %v worth of %v across %d exchanges will send the price from %v to %v [%.2f%%] and take %v orders.",
+			amount, c.Pair.Quote, c.Pair.Base, len(r.Venues), r.MinimumPrice, r.MaximumPrice,
+			r.PercentageGainOrLoss, len(r.Orders))
+	} else {
+		r.Status = fmt.Sprintf("Selling %v %v worth of %v across %d exchanges will send the price from %v to %v [%.2f%%] and take %v orders.",
+			amount, c.Pair.Base, c.Pair.Quote, len(r.Venues), r.MaximumPrice, r.MinimumPrice,
+			r.PercentageGainOrLoss, len(r.Orders))
+	}
+	return r, nil
+}
+
+// WhaleBomb finds the amount required across exchanges to move the fee
+// adjusted price of the consolidated orderbook to the price target
+func (c *ConsolidatedBase) WhaleBomb(priceTarget float64, buy bool) (*ConsolidatedSimulationResult, error) {
+	if priceTarget <= 0 {
+		return nil, errPriceTargetInvalid
+	}
+	r := &ConsolidatedSimulationResult{}
+	if buy {
+		for i := range c.Asks {
+			r.Orders = append(r.Orders, c.Asks[i])
+			r.Amount += c.Asks[i].Price * c.Asks[i].Amount
+			if c.Asks[i].Price >= priceTarget {
+				break
+			}
+		}
+	} else {
+		for i := range c.Bids {
+			r.Orders = append(r.Orders, c.Bids[i])
+			r.Amount += c.Bids[i].Amount
+			if c.Bids[i].Price <= priceTarget {
+				break
+			}
+		}
+	}
+	r.summarise(buy)
+	var err error
+	if buy {
+		if r.MaximumPrice < priceTarget {
+			err = errPriceTargetNotReached
+		}
+		r.Status = fmt.Sprintf("Buying %v %v worth of %v across %d exchanges will send the price from %v to %v [%.2f%%] and take %v orders.",
+			r.Amount, c.Pair.Quote, c.Pair.Base, len(r.Venues), r.MinimumPrice, r.MaximumPrice,
+			r.PercentageGainOrLoss, len(r.Orders))
+	} else {
+		if len(r.Orders) == 0 || r.MinimumPrice > priceTarget {
+			err = errPriceTargetNotReached
+		}
+		r.Status = fmt.Sprintf("Selling %v %v worth of %v across %d exchanges will send the price from %v to %v [%.2f%%] and take %v orders.",
+			r.Amount, c.Pair.Base, c.Pair.Quote, len(r.Venues), r.MaximumPrice, r.MinimumPrice,
+			r.PercentageGainOrLoss, len(r.Orders))
+	}
+	return r, err
+}
+
+// summarise sets the price range and per exchange allocations of the orders
+// taken from the consolidated orderbook
+func (r *ConsolidatedSimulationResult) summarise(buy bool) {
+	if len(r.Orders) == 0 {
+		return
+	}
+	first, last := r.Orders[0].Price, r.Orders[len(r.Orders)-1].Price
+	r.MinimumPrice, r.MaximumPrice = first, last
+	if !buy {
+		r.MinimumPrice, r.MaximumPrice = last, first
+	}
+	r.PercentageGainOrLoss = math.CalculatePercentageGainOrLoss(last, first)
+	for i := range r.Orders {
+		o := &r.Orders[i]
+		var v *VenueAllocation
+		for j := range r.Venues {
+			if r.Venues[j].Exchange == o.Exchange && r.Venues[j].Pair.Equal(o.Pair) {
+				v = &r.Venues[j]
+				break
+			}
+		}
+		if v == nil {
+			r.Venues = append(r.Venues, VenueAllocation{Exchange: o.Exchange, Pair: o.Pair})
+			v = &r.Venues[len(r.Venues)-1]
+		}
+		v.BaseAmount += o.Amount
+		v.QuoteAmount += o.Amount * o.Price
+	}
+	for i := range r.Venues {
+		if r.Venues[i].BaseAmount > 0 {
+			r.Venues[i].AveragePrice = r.Venues[i].QuoteAmount / r.Venues[i].BaseAmount
+		}
+	}
+}
+
+// copyConsolidated returns a copy of a consolidated orderbook which does not
+// share its levels
+func copyConsolidated(c *ConsolidatedBase) *ConsolidatedBase {
+	cpy := *c
+	cpy.Bids = append(ConsolidatedItems(nil), c.Bids...)
+	cpy.Asks = append(ConsolidatedItems(nil), c.Asks...)
+	cpy.Exchanges = append([]string(nil), c.Exchanges...)
+	return &cpy
+}
+
+// ProcessConsolidated stores a consolidated orderbook and publishes it to all
+// consolidated orderbook subscribers
+func ProcessConsolidated(c *ConsolidatedBase) error {
+	return service.UpdateConsolidated(c)
+}
+
+// GetConsolidated returns the latest consolidated orderbook for a pair
+func GetConsolidated(p currency.Pair, a asset.Item) (*ConsolidatedBase, error) {
+	return service.GetConsolidated(p, a)
+}
+
+// SubscribeToConsolidatedOrderbooks returns a pipe to all consolidated
+// orderbook updates
+func SubscribeToConsolidatedOrderbooks() (dispatch.Pipe, error) {
+	service.Lock()
+	id, err := service.getConsolidatedID()
+	service.Unlock()
+	if err != nil {
+		return dispatch.Pipe{}, err
+	}
+	return service.Mux.Subscribe(id)
+}
+
+// getConsolidatedID returns the dispatch ID of consolidated orderbooks,
+// creating it on first use. The service must be locked
+func (s *Service) getConsolidatedID() (uuid.UUID, error) {
+	if s.consolidatedID != (uuid.UUID{}) {
+		return s.consolidatedID, nil
+	}
+	id, err := s.Mux.GetID()
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+	s.consolidatedID = id
+	return id, nil
+}
+
+// UpdateConsolidated stores a consolidated orderbook, replacing the previous
+// orderbook of the pair, and publishes it
+func (s *Service) UpdateConsolidated(c *ConsolidatedBase) error {
+	if c == nil {
+		return errNilConsolidationSource
+	}
+	if c.Pair.IsEmpty() {
+		return errPairNotSet
+	}
+	if !c.Asset.IsValid() {
+		return errAssetTypeNotSet
+	}
+	c = copyConsolidated(c)
+	s.Lock()
+	id, err := s.getConsolidatedID()
+	if err != nil {
+		s.Unlock()
+		return err
+	}
+	m1, ok := s.consolidated[c.Asset]
+	if !ok {
+		m1 = make(map[*currency.Item]map[*currency.Item]*ConsolidatedBase)
+		s.consolidated[c.Asset] = m1
+	}
+	m2, ok := m1[c.Pair.Base.Item]
+	if !ok {
+		m2 = make(map[*currency.Item]*ConsolidatedBase)
+		m1[c.Pair.Base.Item] = m2
+	}
+	m2[c.Pair.Quote.Item] = c
+	s.Unlock()
+	return s.Mux.Publish([]uuid.UUID{id}, copyConsolidated(c))
+}
+
+// GetConsolidated returns a copy of the latest consolidated orderbook for a
+// pair
+func (s *Service) GetConsolidated(p currency.Pair, a asset.Item) (*ConsolidatedBase, error) {
+	s.Lock()
+	defer s.Unlock()
+	c, ok := s.consolidated[a][p.Base.Item][p.Quote.Item]
+	if !ok {
+		return nil, fmt.Errorf("consolidated %w for %s %s", errCannotFindOrderbook, p, a)
+	}
+	return copyConsolidated(c), nil
+}
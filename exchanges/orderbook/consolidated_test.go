@@ -0,0 +1,228 @@
+package orderbook
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func consolidatedSetup(t *testing.T) *ConsolidatedBase {
+	t.Helper()
+	tt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	c, err := Consolidate(currency.NewPair(currency.BTC, currency.USD), asset.Spot,
+		ConsolidationSource{
+			Book: &Base{
+				Exchange:    "a",
+				Pair:        currency.NewPair(currency.BTC, currency.USD),
+				Bids:        Items{{Price: 100, Amount: 1}, {Price: 98, Amount: 2}},
+				Asks:        Items{{Price: 101, Amount: 1}, {Price: 103, Amount: 2}},
+				LastUpdated: tt,
+			},
+			Rate: 1,
+		},
+		ConsolidationSource{
+			Book: &Base{
+				Exchange:    "b",
+				Pair:        currency.NewPair(currency.BTC, currency.USDT),
+				Bids:        Items{{Price: 200, Amount: 1}},
+				Asks:        Items{{Price: 201, Amount: 1}},
+				LastUpdated: tt.Add(time.Second),
+			},
+			// USDT trades at half the value of USD in this book
+			Rate: 0.5,
+			Fee:  0.01,
+		},
+	)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	return c
+}
+
+func TestConsolidate(t *testing.T) {
+	t.Parallel()
+	_, err := Consolidate(currency.Pair{}, asset.Spot)
+	if !errors.Is(err, errPairNotSet) {
+		t.Errorf("received '%v' expected '%v'", err, errPairNotSet)
+	}
+	p := currency.NewPair(currency.BTC, currency.USD)
+	_, err = Consolidate(p, "")
+	if !errors.Is(err, errAssetTypeNotSet) {
+		t.Errorf("received '%v' expected '%v'", err, errAssetTypeNotSet)
+	}
+	_, err = Consolidate(p, asset.Spot, ConsolidationSource{})
+	if !errors.Is(err, errNilConsolidationSource) {
+		t.Errorf("received '%v' expected '%v'", err, errNilConsolidationSource)
+	}
+	_, err = Consolidate(p, asset.Spot, ConsolidationSource{Book: &Base{}})
+	if !errors.Is(err, errInvalidConversionRate) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidConversionRate)
+	}
+	_, err = Consolidate(p, asset.Spot, ConsolidationSource{Book: &Base{}, Rate: 1, Fee: 1})
+	if !errors.Is(err, errInvalidFee) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidFee)
+	}
+
+	c := consolidatedSetup(t)
+	if len(c.Exchanges) != 2 || len(c.Bids) != 3 || len(c.Asks) != 3 {
+		t.Fatalf("received '%+v' expected merged book", c)
+	}
+	// exchange b bid of 200 USDT is 100 USD less a 1% fee
+	if c.Bids[0].Exchange != "a" || c.Bids[1].Exchange != "b" || c.Bids[1].Price != 99 || c.Bids[1].RawPrice != 200 {
+		t.Errorf("received '%+v' expected fee adjusted bids", c.Bids)
+	}
+	if c.Asks[0].Exchange != "a" || c.Asks[1].Exchange != "b" || c.Asks[1].Price != 101.505 {
+		t.Errorf("received '%+v' expected fee adjusted asks", c.Asks)
+	}
+	if !c.LastUpdated.Equal(time.Date(2022, 1, 1, 0, 0, 1, 0, time.UTC)) {
+		t.Errorf("received '%v' expected latest update", c.LastUpdated)
+	}
+}
+
+func TestConsolidatedSimulateOrder(t *testing.T) {
+	t.Parallel()
+	c := consolidatedSetup(t)
+	_, err := c.SimulateOrder(0, true)
+	if !errors.Is(err, errAmountInvalid) {
+		t.Errorf("received '%v' expected '%v'", err, errAmountInvalid)
+	}
+
+	// sell 2.5 BTC into bids of a 100, b 99 and a 98
+	r, err := c.SimulateOrder(2.5, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if r.Amount != 248 || r.RemainingAmount != 0 || len(r.Orders) != 3 {
+		t.Errorf("received '%+v' expected 248 quote received", r)
+	}
+	if r.MaximumPrice != 100 || r.MinimumPrice != 98 || len(r.Venues) != 2 {
+		t.Errorf("received '%+v' expected price range across two venues", r)
+	}
+	if r.Venues[0].Exchange != "a" || r.Venues[0].BaseAmount != 1.5 || r.Venues[1].QuoteAmount != 99 {
+		t.Errorf("received '%+v' expected venue allocations", r.Venues)
+	}
+
+	// buying with 151.7525 USD takes a's first ask then half of b's
+	r, err = c.SimulateOrder(151.7525, true)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if math.Abs(r.Amount-1.5) > 1e-9 || r.RemainingAmount > 1e-9 || len(r.Venues) != 2 {
+		t.Errorf("received '%+v' expected 1.5 BTC bought", r)
+	}
+	if r.MinimumPrice != 101 || r.MaximumPrice != 101.505 || math.Abs(r.AveragePrice-101.16833333) > 1e-6 {
+		t.Errorf("received '%+v' expected buy price range", r)
+	}
+
+	// insufficient liquidity leaves a remaining amount
+	r, err = c.SimulateOrder(10, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if r.RemainingAmount != 6 || r.Status == "" {
+		t.Errorf("received '%+v' expected remaining amount", r)
+	}
+}
+
+func TestConsolidatedWhaleBomb(t *testing.T) {
+	t.Parallel()
+	c := consolidatedSetup(t)
+	_, err := c.WhaleBomb(0, true)
+	if !errors.Is(err, errPriceTargetInvalid) {
+		t.Errorf("received '%v' expected '%v'", err, errPriceTargetInvalid)
+	}
+	r, err := c.WhaleBomb(102, true)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(r.Orders) != 3 || r.MaximumPrice != 103 || math.Abs(r.Amount-408.505) > 1e-9 {
+		t.Errorf("received '%+v' expected three asks taken", r)
+	}
+	_, err = c.WhaleBomb(104, true)
+	if !errors.Is(err, errPriceTargetNotReached) {
+		t.Errorf("received '%v' expected '%v'", err, errPriceTargetNotReached)
+	}
+	r, err = c.WhaleBomb(99, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(r.Orders) != 2 || r.Amount != 2 || r.MinimumPrice != 99 {
+		t.Errorf("received '%+v' expected two bids taken", r)
+	}
+	_, err = c.WhaleBomb(97, false)
+	if !errors.Is(err, errPriceTargetNotReached) {
+		t.Errorf("received '%v' expected '%v'", err, errPriceTargetNotReached)
+	}
+}
+
+func TestProcessConsolidated(t *testing.T) {
+	t.Parallel()
+	err := ProcessConsolidated(nil)
+	if !errors.Is(err, errNilConsolidationSource) {
+		t.Errorf("received '%v' expected '%v'", err, errNilConsolidationSource)
+	}
+	err = ProcessConsolidated(&ConsolidatedBase{})
+	if !errors.Is(err, errPairNotSet) {
+		t.Errorf("received '%v' expected '%v'", err, errPairNotSet)
+	}
+	p := currency.NewPair(currency.LTC, currency.DOGE)
+	_, err = GetConsolidated(p, asset.Margin)
+	if !errors.Is(err, errCannotFindOrderbook) {
+		t.Errorf("received '%v' expected '%v'", err, errCannotFindOrderbook)
+	}
+
+	pipe, err := SubscribeToConsolidatedOrderbooks()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	defer func() {
+		if err := pipe.Release(); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	c := &ConsolidatedBase{
+		Pair:  p,
+		Asset: asset.Spot,
+		Bids:  ConsolidatedItems{{Exchange: "a", Price: 1, Amount: 1}},
+	}
+	err = ProcessConsolidated(c)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	// dispatch drops updates while no receiver is waiting so the book is
+	// republished until received
+	timeout := time.After(time.Second * 5)
+	republish := time.NewTicker(time.Millisecond * 10)
+	defer republish.Stop()
+	for published := false; !published; {
+		select {
+		case data := <-pipe.C:
+			if ptr, ok := data.(*interface{}); ok {
+				data = *ptr
+			}
+			b, ok := data.(ConsolidatedBase)
+			published = ok && b.Pair.Equal(p)
+		case <-republish.C:
+			if err = ProcessConsolidated(c); err != nil {
+				t.Fatal(err)
+			}
+		case <-timeout:
+			t.Fatal("expected consolidated orderbook to be published")
+		}
+	}
+
+	// stored books are copies
+	c.Bids[0].Price = 2
+	stored, err := GetConsolidated(p, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if stored.Bids[0].Price != 1 {
+		t.Errorf("received '%v' expected '%v'", stored.Bids[0].Price, 1)
+	}
+}
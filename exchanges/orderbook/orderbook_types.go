@@ -32,16 +32,27 @@ var (
 	errIDDuplication       = errors.New("id duplication")
 	errPeriodUnset         = errors.New("funding rate period is unset")
 	errNotEnoughLiquidity  = errors.New("not enough liquidity")
+
+	errNilConsolidationSource = errors.New("consolidation source orderbook is nil")
+	errInvalidConversionRate  = errors.New("conversion rate must be greater than zero")
+	errInvalidFee             = errors.New("fee must be between zero and one")
+	errPriceTargetInvalid     = errors.New("price target is invalid")
+	errPriceTargetNotReached  = errors.New("unable to hit price target due to insufficient orderbook items")
 )
 
 var service = Service{
-	books: make(map[string]Exchange),
-	Mux:   dispatch.GetNewMux(),
+	books:        make(map[string]Exchange),
+	consolidated: make(map[asset.Item]map[*currency.Item]map[*currency.Item]*ConsolidatedBase),
+	Mux:          dispatch.GetNewMux(),
 }
 
 // Service provides a store for difference exchange orderbooks
 type Service struct {
 	books map[string]Exchange
+	// consolidated stores the latest consolidated orderbooks which are all
+	// published under consolidatedID
+	consolidated   map[asset.Item]map[*currency.Item]map[*currency.Item]*ConsolidatedBase
+	consolidatedID uuid.UUID
 	*dispatch.Mux
 	sync.Mutex
 }
@@ -115,3 +126,71 @@ type options struct {
 	restSnapshot     bool
 	idAligned        bool
 }
+
+// ConsolidationSource defines an exchange orderbook merged into a consolidated
+// orderbook
+type ConsolidationSource struct {
+	Book *Base
+	// Rate converts prices in the quote currency of the book's pair into the
+	// quote currency of the consolidated orderbook
+	Rate float64
+	// Fee is the taker fee rate of the exchange e.g. 0.001 for 0.1%
+	Fee float64
+}
+
+// ConsolidatedItem defines an orderbook level tagged with its source exchange.
+// Price is converted into the quote currency of the consolidated orderbook and
+// includes the fee, increasing asks and decreasing bids, so levels across
+// exchanges are ranked by what a taker would actually pay or receive
+type ConsolidatedItem struct {
+	Exchange string
+	Pair     currency.Pair
+	Amount   float64
+	Price    float64
+	// RawPrice is the price of the level on the exchange in the quote
+	// currency of its pair
+	RawPrice float64
+	Fee      float64
+}
+
+// ConsolidatedItems defines a slice of consolidated orderbook items
+type ConsolidatedItems []ConsolidatedItem
+
+// ConsolidatedBase defines an orderbook merged from the orderbooks of a pair,
+// or pairs with equivalent quote currencies, across exchanges
+type ConsolidatedBase struct {
+	Pair        currency.Pair
+	Asset       asset.Item
+	Bids        ConsolidatedItems
+	Asks        ConsolidatedItems
+	Exchanges   []string
+	LastUpdated time.Time
+}
+
+// VenueAllocation defines the portion of a simulated order filled by a
+// single exchange pair
+type VenueAllocation struct {
+	Exchange     string
+	Pair         currency.Pair
+	BaseAmount   float64
+	QuoteAmount  float64
+	AveragePrice float64
+}
+
+// ConsolidatedSimulationResult defines the result of walking a consolidated
+// orderbook. Amount follows the orderbook calculators, being the base received
+// when simulating a buy and the quote received when simulating a sell, or the
+// quote spent and base sold to reach a whale bomb price target
+type ConsolidatedSimulationResult struct {
+	Amount               float64
+	AveragePrice         float64
+	MinimumPrice         float64
+	MaximumPrice         float64
+	PercentageGainOrLoss float64
+	// RemainingAmount is the amount of a simulated order which could not be
+	// filled due to insufficient liquidity
+	RemainingAmount float64
+	Orders          ConsolidatedItems
+	Venues          []VenueAllocation
+	Status          string
+}
@@ -48,6 +48,7 @@ func (w *Orderbook) Setup(cfg *config.Exchange, sortBuffer, sortBufferByUpdateID
 	w.dataHandler = dataHandler
 	w.ob = make(map[currency.Code]map[currency.Code]map[asset.Item]*orderbookHolder)
 	w.verbose = cfg.Verbose
+	w.saveDeltas = cfg.Orderbook.SaveWebsocketDeltas
 
 	// set default publish period if missing
 	orderbookPublishPeriod := config.DefaultOrderbookPublishPeriod
@@ -197,9 +198,16 @@ func (w *Orderbook) processBufferUpdate(o *orderbookHolder, u *Update) (bool, er
 // price level
 func (w *Orderbook) processObUpdate(o *orderbookHolder, u *Update) error {
 	if w.updateEntriesByID {
-		return o.updateByIDAndAction(u)
+		err := o.updateByIDAndAction(u)
+		if err != nil {
+			return err
+		}
+	} else {
+		o.updateByPrice(u)
+	}
+	if w.saveDeltas {
+		addDeltaToBuffer(w.exchangeName, u, w.updateEntriesByID)
 	}
-	o.updateByPrice(u)
 	return nil
 }
 
@@ -305,6 +313,10 @@ func (w *Orderbook) LoadSnapshot(book *orderbook.Base) error {
 		}
 	}
 
+	if w.saveDeltas {
+		addSnapshotToBuffer(w.exchangeName, book)
+	}
+
 	w.dataHandler <- holder.ob.Retrieve()
 	holder.ob.Publish()
 	return nil
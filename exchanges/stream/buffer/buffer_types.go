@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	orderbooksql "github.com/thrasher-corp/gocryptotrader/database/repository/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
@@ -22,6 +23,7 @@ type Orderbook struct {
 	dataHandler           chan interface{}
 	verbose               bool
 	publishPeriod         time.Duration
+	saveDeltas            bool
 	m                     sync.Mutex
 }
 
@@ -68,3 +70,20 @@ const (
 	// to book
 	UpdateInsert Action = "update/insert"
 )
+
+// DefaultDeltaProcessorInterval is the default interval to save buffered
+// orderbook deltas to the database
+const DefaultDeltaProcessorInterval = time.Second * 15
+
+var deltaSaver = deltaProcessor{interval: DefaultDeltaProcessorInterval}
+
+// deltaProcessor batches loaded orderbook snapshots and applied orderbook
+// updates and saves them to the database
+type deltaProcessor struct {
+	mutex     sync.Mutex
+	running   bool
+	sequence  int64
+	interval  time.Duration
+	buffer    []orderbooksql.Delta
+	snapshots []orderbooksql.Snapshot
+}
@@ -0,0 +1,159 @@
+package buffer
+
+import (
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	orderbooksql "github.com/thrasher-corp/gocryptotrader/database/repository/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// addDeltaToBuffer queues an applied update to be saved to the database,
+// starting the processor if it is not running. Updates are dropped when the
+// database is disabled
+func addDeltaToBuffer(exchangeName string, u *Update, updateEntriesByID bool) {
+	if database.DB == nil {
+		return
+	}
+	if cfg := database.DB.GetConfig(); cfg == nil || !cfg.Enabled {
+		return
+	}
+	deltaSaver.add(newDelta(exchangeName, u, updateEntriesByID))
+}
+
+// addSnapshotToBuffer queues a loaded orderbook snapshot to be saved to the
+// database, so deltas applied after a resync are rebuilt from the full book.
+// Snapshots are dropped when the database is disabled
+func addSnapshotToBuffer(exchangeName string, book *orderbook.Base) {
+	if database.DB == nil {
+		return
+	}
+	if cfg := database.DB.GetConfig(); cfg == nil || !cfg.Enabled {
+		return
+	}
+	deltaSaver.addSnapshot(newSnapshot(exchangeName, book))
+}
+
+// add appends a delta to the buffer, sequencing it so deltas sharing a
+// timestamp are rebuilt in the order they were applied
+func (p *deltaProcessor) add(d orderbooksql.Delta) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.sequence++
+	d.Sequence = p.sequence
+	p.buffer = append(p.buffer, d)
+	p.start()
+}
+
+// addSnapshot appends a snapshot to the buffer
+func (p *deltaProcessor) addSnapshot(s orderbooksql.Snapshot) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.snapshots = append(p.snapshots, s)
+	p.start()
+}
+
+// start runs the processor if it is not running, the mutex must be held by
+// the caller
+func (p *deltaProcessor) start() {
+	if !p.running {
+		p.running = true
+		go p.run()
+	}
+}
+
+// run saves buffered snapshots and deltas to the database in batches until no
+// more are received. Snapshots are saved first so deltas always have a book
+// to be applied to
+func (p *deltaProcessor) run() {
+	p.mutex.Lock()
+	ticker := time.NewTicker(p.interval)
+	p.mutex.Unlock()
+	defer ticker.Stop()
+	for range ticker.C {
+		snapshots, deltas := p.flush()
+		if len(snapshots) == 0 && len(deltas) == 0 {
+			return
+		}
+		if len(snapshots) > 0 {
+			err := orderbooksql.InsertSnapshots(snapshots...)
+			if err != nil {
+				log.Errorf(log.WebsocketMgr, "unable to save %d orderbook snapshots: %v", len(snapshots), err)
+			}
+		}
+		if len(deltas) > 0 {
+			err := orderbooksql.InsertDeltas(deltas...)
+			if err != nil {
+				log.Errorf(log.WebsocketMgr, "unable to save %d orderbook deltas: %v", len(deltas), err)
+			}
+		}
+	}
+}
+
+// flush returns the buffered snapshots and deltas and clears the buffer,
+// marking the processor as stopped if there is nothing to save
+func (p *deltaProcessor) flush() ([]orderbooksql.Snapshot, []orderbooksql.Delta) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	snapshots, deltas := p.snapshots, p.buffer
+	p.snapshots, p.buffer = nil, nil
+	if len(snapshots) == 0 && len(deltas) == 0 {
+		p.running = false
+	}
+	return snapshots, deltas
+}
+
+// newSnapshot converts every level of a loaded orderbook, leaving the depth
+// unset as the whole book is kept
+func newSnapshot(exchangeName string, book *orderbook.Base) orderbooksql.Snapshot {
+	ts := book.LastUpdated
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+	return orderbooksql.Snapshot{
+		Exchange:  exchangeName,
+		Asset:     book.Asset.String(),
+		Base:      book.Pair.Base.String(),
+		Quote:     book.Pair.Quote.String(),
+		Timestamp: ts,
+		UpdateID:  book.LastUpdateID,
+		Bids:      toLevels(book.Bids),
+		Asks:      toLevels(book.Asks),
+	}
+}
+
+func newDelta(exchangeName string, u *Update, updateEntriesByID bool) orderbooksql.Delta {
+	action := orderbooksql.UpdateByPrice
+	if updateEntriesByID {
+		action = string(u.Action)
+	}
+	ts := u.UpdateTime
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+	return orderbooksql.Delta{
+		Exchange:  exchangeName,
+		Asset:     u.Asset.String(),
+		Base:      u.Pair.Base.String(),
+		Quote:     u.Pair.Quote.String(),
+		Timestamp: ts,
+		UpdateID:  u.UpdateID,
+		Action:    action,
+		MaxDepth:  u.MaxDepth,
+		Bids:      toLevels(u.Bids),
+		Asks:      toLevels(u.Asks),
+	}
+}
+
+func toLevels(items []orderbook.Item) []orderbooksql.Level {
+	levels := make([]orderbooksql.Level, len(items))
+	for i := range items {
+		levels[i] = orderbooksql.Level{
+			Price:  items[i].Price,
+			Amount: items[i].Amount,
+			ID:     items[i].ID,
+		}
+	}
+	return levels
+}
@@ -0,0 +1,102 @@
+package buffer
+
+import (
+	"testing"
+	"time"
+
+	orderbooksql "github.com/thrasher-corp/gocryptotrader/database/repository/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+func TestNewDelta(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	u := &Update{
+		UpdateID:   1337,
+		UpdateTime: tt,
+		Asset:      asset.Spot,
+		Action:     Delete,
+		Bids:       []orderbook.Item{{Price: 1, Amount: 2, ID: 3}},
+		Pair:       cp,
+		MaxDepth:   10,
+	}
+	d := newDelta(exchangeName, u, false)
+	if d.Action != orderbooksql.UpdateByPrice {
+		t.Errorf("received '%v' expected '%v'", d.Action, orderbooksql.UpdateByPrice)
+	}
+	d = newDelta(exchangeName, u, true)
+	if d.Action != string(Delete) {
+		t.Errorf("received '%v' expected '%v'", d.Action, Delete)
+	}
+	if d.Exchange != exchangeName || d.Asset != "spot" || d.Base != "BTC" || d.Quote != "USD" {
+		t.Errorf("received '%+v' expected exchangeTest spot BTC USD", d)
+	}
+	if !d.Timestamp.Equal(tt) || d.UpdateID != 1337 || d.MaxDepth != 10 {
+		t.Errorf("received '%+v' expected update details", d)
+	}
+	if len(d.Bids) != 1 || d.Bids[0] != (orderbooksql.Level{Price: 1, Amount: 2, ID: 3}) || len(d.Asks) != 0 {
+		t.Errorf("received '%+v' '%+v' expected converted levels", d.Bids, d.Asks)
+	}
+
+	u.UpdateTime = time.Time{}
+	if d = newDelta(exchangeName, u, true); d.Timestamp.IsZero() {
+		t.Error("expected timestamp to be set")
+	}
+}
+
+func TestDeltaProcessor(t *testing.T) {
+	t.Parallel()
+	// marked running so no deltas are saved
+	p := deltaProcessor{running: true}
+	p.add(orderbooksql.Delta{UpdateID: 1})
+	p.add(orderbooksql.Delta{UpdateID: 2})
+	p.addSnapshot(orderbooksql.Snapshot{UpdateID: 3})
+	snapshots, deltas := p.flush()
+	if len(snapshots) != 1 || snapshots[0].UpdateID != 3 {
+		t.Errorf("received '%+v' expected buffered snapshot", snapshots)
+	}
+	if len(deltas) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(deltas), 2)
+	}
+	if deltas[0].Sequence != 1 || deltas[1].Sequence != 2 {
+		t.Errorf("received '%v' '%v' expected sequenced deltas", deltas[0].Sequence, deltas[1].Sequence)
+	}
+	if !p.running {
+		t.Error("expected processor to be running")
+	}
+	if snapshots, deltas = p.flush(); len(snapshots) != 0 || len(deltas) != 0 {
+		t.Errorf("received '%v' '%v' expected '%v'", len(snapshots), len(deltas), 0)
+	}
+	if p.running {
+		t.Error("expected processor to be stopped")
+	}
+}
+
+func TestNewSnapshot(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	book := &orderbook.Base{
+		Pair:         cp,
+		Asset:        asset.Spot,
+		LastUpdated:  tt,
+		LastUpdateID: 1337,
+		Bids:         orderbook.Items{{Price: 2, Amount: 1}, {Price: 1, Amount: 1}},
+		Asks:         orderbook.Items{{Price: 3, Amount: 1, ID: 4}},
+	}
+	s := newSnapshot(exchangeName, book)
+	if s.Exchange != exchangeName || s.Asset != "spot" || s.Base != "BTC" || s.Quote != "USD" {
+		t.Errorf("received '%+v' expected exchangeTest spot BTC USD", s)
+	}
+	if !s.Timestamp.Equal(tt) || s.UpdateID != 1337 || s.Depth != 0 {
+		t.Errorf("received '%+v' expected full book snapshot details", s)
+	}
+	if len(s.Bids) != 2 || len(s.Asks) != 1 || s.Asks[0] != (orderbooksql.Level{Price: 3, Amount: 1, ID: 4}) {
+		t.Errorf("received '%+v' '%+v' expected every level converted", s.Bids, s.Asks)
+	}
+
+	book.LastUpdated = time.Time{}
+	if s = newSnapshot(exchangeName, book); s.Timestamp.IsZero() {
+		t.Error("expected timestamp to be set")
+	}
+}
@@ -25,6 +25,13 @@ type Connection interface {
 	Shutdown() error
 }
 
+// Recorder records the frames sent and received over a connection so they
+// can be replayed in tests, see the mock package
+type Recorder interface {
+	RecordSent(connectionURL string, payload []byte) error
+	RecordReceived(connectionURL string, payload []byte) error
+}
+
 // Response defines generalised data from the stream connection
 type Response struct {
 	Type int
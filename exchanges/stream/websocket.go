@@ -202,6 +202,9 @@ func (w *Websocket) SetupNewConnection(c ConnectionSetup) error {
 		Match:             w.Match,
 		RateLimit:         c.RateLimit,
 	}
+	w.recorderMtx.Lock()
+	newConn.SetRecorder(w.recorder)
+	w.recorderMtx.Unlock()
 
 	if c.Authenticated {
 		w.AuthConn = newConn
@@ -212,6 +215,20 @@ func (w *Websocket) SetupNewConnection(c ConnectionSetup) error {
 	return nil
 }
 
+// SetRecorder sets the recorder used to record the frames of the websocket's
+// connections, allowing exchange streams to be replayed in tests
+func (w *Websocket) SetRecorder(r Recorder) {
+	w.recorderMtx.Lock()
+	defer w.recorderMtx.Unlock()
+	w.recorder = r
+	if c, ok := w.Conn.(*WebsocketConnection); ok {
+		c.SetRecorder(r)
+	}
+	if c, ok := w.AuthConn.(*WebsocketConnection); ok {
+		c.SetRecorder(r)
+	}
+}
+
 // Connect initiates a websocket connection by using a package defined connection
 // function
 func (w *Websocket) Connect() error {
@@ -5,6 +5,7 @@ import (
 	"compress/flate"
 	"compress/gzip"
 	"crypto/rand"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"math/big"
@@ -113,11 +114,24 @@ func (w *WebsocketConnection) SendJSONMessage(data interface{}) error {
 				w.ExchangeName)
 		}
 	}
+	if r := w.getRecorder(); r != nil {
+		payload, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+		w.recordSent(r, payload)
+	}
 	return w.Connection.WriteJSON(data)
 }
 
 // SendRawMessage sends a message over the connection without JSON encoding it
 func (w *WebsocketConnection) SendRawMessage(messageType int, message []byte) error {
+	return w.sendRawMessage(messageType, message, true)
+}
+
+// sendRawMessage sends a message over the connection, recording it when a
+// recorder is set and record is true
+func (w *WebsocketConnection) sendRawMessage(messageType int, message []byte, record bool) error {
 	if !w.IsConnected() {
 		return fmt.Errorf("%v websocket connection: cannot send message to a disconnected websocket",
 			w.ExchangeName)
@@ -143,9 +157,40 @@ func (w *WebsocketConnection) SendRawMessage(messageType int, message []byte) er
 		return fmt.Errorf("%v websocket connection: cannot send message to a disconnected websocket",
 			w.ExchangeName)
 	}
+	if r := w.getRecorder(); record && r != nil &&
+		(messageType == websocket.TextMessage || messageType == websocket.BinaryMessage) {
+		w.recordSent(r, message)
+	}
 	return w.Connection.WriteMessage(messageType, message)
 }
 
+// SetRecorder sets the recorder used to record all frames sent and received
+// over the connection, a nil recorder stops recording
+func (w *WebsocketConnection) SetRecorder(r Recorder) {
+	w.recorderMtx.Lock()
+	w.recorder = r
+	w.recorderMtx.Unlock()
+}
+
+// getRecorder returns the recorder set on the connection
+func (w *WebsocketConnection) getRecorder() Recorder {
+	w.recorderMtx.RLock()
+	defer w.recorderMtx.RUnlock()
+	return w.recorder
+}
+
+// recordSent records a message sent over the connection, logging any
+// recording error so that streams are unaffected
+func (w *WebsocketConnection) recordSent(r Recorder, message []byte) {
+	err := r.RecordSent(w.URL, message)
+	if err != nil {
+		log.Errorf(log.WebsocketMgr,
+			"%v websocket connection: recording sent message error: %v",
+			w.ExchangeName,
+			err)
+	}
+}
+
 // SetupPingHandler will automatically send ping or pong messages based on
 // WebsocketPingHandler configuration
 func (w *WebsocketConnection) SetupPingHandler(handler PingHandler) {
@@ -174,7 +219,8 @@ func (w *WebsocketConnection) SetupPingHandler(handler PingHandler) {
 				ticker.Stop()
 				return
 			case <-ticker.C:
-				err := w.SendRawMessage(handler.MessageType, handler.Message)
+				// pings are not recorded as they cannot be matched on replay
+				err := w.sendRawMessage(handler.MessageType, handler.Message, false)
 				if err != nil {
 					log.Errorf(log.WebsocketMgr,
 						"%v websocket connection: ping handler failed to send message [%s]",
@@ -245,6 +291,15 @@ func (w *WebsocketConnection) ReadMessage() Response {
 			w.ExchangeName,
 			string(standardMessage))
 	}
+	if r := w.getRecorder(); r != nil {
+		err = r.RecordReceived(w.URL, standardMessage)
+		if err != nil {
+			log.Errorf(log.WebsocketMgr,
+				"%v websocket connection: recording received message error: %v",
+				w.ExchangeName,
+				err)
+		}
+	}
 	return Response{Raw: standardMessage, Type: mType}
 }
 
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
@@ -18,6 +19,7 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 )
 
@@ -1341,3 +1343,127 @@ func TestWebsocketConnectionShutdown(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+type testRecorder struct {
+	sent     [][]byte
+	received [][]byte
+}
+
+func (r *testRecorder) RecordSent(_ string, payload []byte) error {
+	r.sent = append(r.sent, payload)
+	return nil
+}
+
+func (r *testRecorder) RecordReceived(_ string, payload []byte) error {
+	r.received = append(r.received, payload)
+	return nil
+}
+
+func TestWebsocketConnectionRecorder(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "exchange.json")
+	recording, err := mock.NewWebsocketRecorder(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = recording.RecordSent(websocketTestURL, []byte(`{"event":"subscribe","reqid":1,"pair":["XBT/USD"],"subscription":{"name":"book"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = recording.RecordReceived(websocketTestURL, []byte(`{"reqid":1}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = recording.RecordSent(websocketTestURL, []byte(`{"event":"subscribe","reqid":2,"pair":["XBT/USD"],"subscription":{"name":"book"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = recording.RecordReceived(websocketTestURL, []byte(`{"reqid":2}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = recording.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverURL, err := mock.NewWebsocketVCRServer(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replayURL, err := mock.WebsocketReplayURL(serverURL, websocketTestURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	web := Websocket{
+		exchangeName:      "exchangeName",
+		Wg:                new(sync.WaitGroup),
+		ShutdownC:         make(chan struct{}),
+		TrafficAlert:      make(chan struct{}),
+		ReadMessageErrors: make(chan error),
+	}
+	err = web.SetupNewConnection(ConnectionSetup{URL: replayURL, ResponseMaxLimit: time.Second * 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wc, ok := web.Conn.(*WebsocketConnection)
+	if !ok {
+		t.Fatal("unexpected connection type")
+	}
+	r := &testRecorder{}
+	web.SetRecorder(r)
+	if wc.getRecorder() != r {
+		t.Fatal("recorder not set on existing connection")
+	}
+	err = web.SetupNewConnection(ConnectionSetup{URL: replayURL, Authenticated: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if authConn, ok := web.AuthConn.(*WebsocketConnection); !ok || authConn.getRecorder() != r {
+		t.Fatal("recorder not set on new connection")
+	}
+	err = wc.Dial(&dialer, http.Header{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = wc.SendJSONMessage(testRequest{
+		Event:        "subscribe",
+		RequestID:    1337,
+		Pairs:        []string{"XBT/USD"},
+		Subscription: testRequestData{Name: "book"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp := wc.ReadMessage()
+	if string(resp.Raw) != `{"reqid":1337}` {
+		t.Errorf("received '%s' expected '%v'", resp.Raw, `{"reqid":1337}`)
+	}
+	if len(r.sent) != 1 || len(r.received) != 1 {
+		t.Fatalf("received '%v' sent and '%v' received frames, expected '1' and '1'", len(r.sent), len(r.received))
+	}
+	if string(r.received[0]) != `{"reqid":1337}` {
+		t.Errorf("received '%s' expected '%v'", r.received[0], `{"reqid":1337}`)
+	}
+
+	// the recorder can be replaced while the connection is being read from
+	err = wc.SendJSONMessage(testRequest{
+		Event:        "subscribe",
+		RequestID:    1338,
+		Pairs:        []string{"XBT/USD"},
+		Subscription: testRequestData{Name: "book"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	read := make(chan Response)
+	go func() { read <- wc.ReadMessage() }()
+	web.SetRecorder(&testRecorder{})
+	if resp = <-read; string(resp.Raw) != `{"reqid":1338}` {
+		t.Errorf("received '%s' expected '%v'", resp.Raw, `{"reqid":1338}`)
+	}
+	err = wc.Shutdown()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
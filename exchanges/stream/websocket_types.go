@@ -91,6 +91,10 @@ type Websocket struct {
 	Conn Connection
 	// Authenticated stream connection
 	AuthConn Connection
+
+	// recorder when set records the frames of new connections
+	recorder    Recorder
+	recorderMtx sync.Mutex
 }
 
 // WebsocketSetup defines variables for setting up a websocket connection
@@ -135,4 +139,9 @@ type WebsocketConnection struct {
 	ResponseMaxLimit  time.Duration
 	Traffic           chan struct{}
 	readMessageErrors chan error
+
+	// recorder when set records all frames sent and received, it is guarded
+	// as it can be set while the connection is being read from
+	recorder    Recorder
+	recorderMtx sync.RWMutex
 }
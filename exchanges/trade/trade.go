@@ -169,6 +169,23 @@ func GetTradesInRange(exchangeName, assetType, base, quote string, startDate, en
 	return SQLDataToTrade(results...)
 }
 
+// GetTradesInRangeChunk returns up to limit trades from the database in a date
+// range, skipping the first offset trades, so large ranges can be processed
+// in chunks
+func GetTradesInRangeChunk(exchangeName, assetType, base, quote string, startDate, endDate time.Time, offset, limit int) ([]Data, error) {
+	if exchangeName == "" || assetType == "" || base == "" || quote == "" || startDate.IsZero() || endDate.IsZero() {
+		return nil, errors.New("invalid arguments received")
+	}
+	if !database.DB.IsConnected() {
+		return nil, fmt.Errorf("cannot process trades in range %s-%s as %w", startDate, endDate, database.ErrDatabaseNotConnected)
+	}
+	results, err := tradesql.GetInRangeChunk(exchangeName, assetType, base, quote, startDate, endDate, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return SQLDataToTrade(results...)
+}
+
 // HasTradesInRanges Creates an executes an SQL query to verify if a trade exists within a timeframe
 func HasTradesInRanges(exchangeName, assetType, base, quote string, rangeHolder *kline.IntervalRangeHolder) error {
 	if exchangeName == "" || assetType == "" || base == "" || quote == "" {
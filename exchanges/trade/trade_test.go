@@ -251,3 +251,11 @@ func TestGetTradesInRange(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestGetTradesInRangeChunk(t *testing.T) {
+	t.Parallel()
+	_, err := GetTradesInRangeChunk("", "", "", "", time.Time{}, time.Time{}, 0, 1)
+	if err != nil && err.Error() != "invalid arguments received" {
+		t.Error(err)
+	}
+}
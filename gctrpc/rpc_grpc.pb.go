@@ -112,6 +112,36 @@ type GoCryptoTraderClient interface {
 	CurrencyStateDeposit(ctx context.Context, in *CurrencyStateDepositRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	CurrencyStateWithdraw(ctx context.Context, in *CurrencyStateWithdrawRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	CurrencyStateTradingPair(ctx context.Context, in *CurrencyStateTradingPairRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	AddConditionalOrder(ctx context.Context, in *AddConditionalOrderRequest, opts ...grpc.CallOption) (*ConditionalOrderDetails, error)
+	CancelConditionalOrder(ctx context.Context, in *CancelConditionalOrderRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetConditionalOrders(ctx context.Context, in *GetConditionalOrdersRequest, opts ...grpc.CallOption) (*GetConditionalOrdersResponse, error)
+	GetConditionalOrderHistory(ctx context.Context, in *GetConditionalOrderHistoryRequest, opts ...grpc.CallOption) (*GetConditionalOrderHistoryResponse, error)
+	SubmitOCOOrder(ctx context.Context, in *SubmitOCOOrderRequest, opts ...grpc.CallOption) (*OrderGroupDetails, error)
+	SubmitBracketOrder(ctx context.Context, in *SubmitBracketOrderRequest, opts ...grpc.CallOption) (*OrderGroupDetails, error)
+	CancelOrderGroup(ctx context.Context, in *CancelOrderGroupRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetOrderGroup(ctx context.Context, in *GetOrderGroupRequest, opts ...grpc.CallOption) (*OrderGroupDetails, error)
+	GetOrderGroups(ctx context.Context, in *GetOrderGroupsRequest, opts ...grpc.CallOption) (*GetOrderGroupsResponse, error)
+	SubmitExecutionOrder(ctx context.Context, in *SubmitExecutionOrderRequest, opts ...grpc.CallOption) (*ExecutionOrderDetails, error)
+	PauseExecutionOrder(ctx context.Context, in *ExecutionOrderRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	ResumeExecutionOrder(ctx context.Context, in *ExecutionOrderRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	CancelExecutionOrder(ctx context.Context, in *ExecutionOrderRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetExecutionOrder(ctx context.Context, in *ExecutionOrderRequest, opts ...grpc.CallOption) (*ExecutionOrderDetails, error)
+	GetExecutionOrders(ctx context.Context, in *GetExecutionOrdersRequest, opts ...grpc.CallOption) (*GetExecutionOrdersResponse, error)
+	SubmitIcebergOrder(ctx context.Context, in *SubmitIcebergOrderRequest, opts ...grpc.CallOption) (*IcebergOrderDetails, error)
+	CancelIcebergOrder(ctx context.Context, in *IcebergOrderRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetIcebergOrder(ctx context.Context, in *IcebergOrderRequest, opts ...grpc.CallOption) (*IcebergOrderDetails, error)
+	GetIcebergOrders(ctx context.Context, in *GetIcebergOrdersRequest, opts ...grpc.CallOption) (*GetIcebergOrdersResponse, error)
+	GetRiskManagerStatus(ctx context.Context, in *GetRiskManagerStatusRequest, opts ...grpc.CallOption) (*GetRiskManagerStatusResponse, error)
+	SetKillSwitch(ctx context.Context, in *SetKillSwitchRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetFuturesPositions(ctx context.Context, in *GetFuturesPositionsRequest, opts ...grpc.CallOption) (*GetFuturesPositionsResponse, error)
+	AddFuturesFundingPayment(ctx context.Context, in *AddFuturesFundingPaymentRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	SetFuturesContractType(ctx context.Context, in *SetFuturesContractTypeRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetEquityCurve(ctx context.Context, in *GetEquityCurveRequest, opts ...grpc.CallOption) (*GetEquityCurveResponse, error)
+	GetBalanceHistory(ctx context.Context, in *GetBalanceHistoryRequest, opts ...grpc.CallOption) (*GetBalanceHistoryResponse, error)
+	GetDailyPNL(ctx context.Context, in *GetDailyPNLRequest, opts ...grpc.CallOption) (*GetDailyPNLResponse, error)
+	GetConsolidatedOrderbook(ctx context.Context, in *GetConsolidatedOrderbookRequest, opts ...grpc.CallOption) (*GetConsolidatedOrderbookResponse, error)
+	SimulateConsolidatedOrder(ctx context.Context, in *SimulateConsolidatedOrderRequest, opts ...grpc.CallOption) (*SimulateConsolidatedOrderResponse, error)
+	ConsolidatedWhaleBomb(ctx context.Context, in *ConsolidatedWhaleBombRequest, opts ...grpc.CallOption) (*SimulateConsolidatedOrderResponse, error)
 }
 
 type goCryptoTraderClient struct {
@@ -1106,6 +1136,276 @@ func (c *goCryptoTraderClient) CurrencyStateTradingPair(ctx context.Context, in
 	return out, nil
 }
 
+func (c *goCryptoTraderClient) AddConditionalOrder(ctx context.Context, in *AddConditionalOrderRequest, opts ...grpc.CallOption) (*ConditionalOrderDetails, error) {
+	out := new(ConditionalOrderDetails)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddConditionalOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) CancelConditionalOrder(ctx context.Context, in *CancelConditionalOrderRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/CancelConditionalOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetConditionalOrders(ctx context.Context, in *GetConditionalOrdersRequest, opts ...grpc.CallOption) (*GetConditionalOrdersResponse, error) {
+	out := new(GetConditionalOrdersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetConditionalOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetConditionalOrderHistory(ctx context.Context, in *GetConditionalOrderHistoryRequest, opts ...grpc.CallOption) (*GetConditionalOrderHistoryResponse, error) {
+	out := new(GetConditionalOrderHistoryResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetConditionalOrderHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) SubmitOCOOrder(ctx context.Context, in *SubmitOCOOrderRequest, opts ...grpc.CallOption) (*OrderGroupDetails, error) {
+	out := new(OrderGroupDetails)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SubmitOCOOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) SubmitBracketOrder(ctx context.Context, in *SubmitBracketOrderRequest, opts ...grpc.CallOption) (*OrderGroupDetails, error) {
+	out := new(OrderGroupDetails)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SubmitBracketOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) CancelOrderGroup(ctx context.Context, in *CancelOrderGroupRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/CancelOrderGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetOrderGroup(ctx context.Context, in *GetOrderGroupRequest, opts ...grpc.CallOption) (*OrderGroupDetails, error) {
+	out := new(OrderGroupDetails)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrderGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetOrderGroups(ctx context.Context, in *GetOrderGroupsRequest, opts ...grpc.CallOption) (*GetOrderGroupsResponse, error) {
+	out := new(GetOrderGroupsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrderGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) SubmitExecutionOrder(ctx context.Context, in *SubmitExecutionOrderRequest, opts ...grpc.CallOption) (*ExecutionOrderDetails, error) {
+	out := new(ExecutionOrderDetails)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SubmitExecutionOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) PauseExecutionOrder(ctx context.Context, in *ExecutionOrderRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/PauseExecutionOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) ResumeExecutionOrder(ctx context.Context, in *ExecutionOrderRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/ResumeExecutionOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) CancelExecutionOrder(ctx context.Context, in *ExecutionOrderRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/CancelExecutionOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetExecutionOrder(ctx context.Context, in *ExecutionOrderRequest, opts ...grpc.CallOption) (*ExecutionOrderDetails, error) {
+	out := new(ExecutionOrderDetails)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExecutionOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetExecutionOrders(ctx context.Context, in *GetExecutionOrdersRequest, opts ...grpc.CallOption) (*GetExecutionOrdersResponse, error) {
+	out := new(GetExecutionOrdersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExecutionOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) SubmitIcebergOrder(ctx context.Context, in *SubmitIcebergOrderRequest, opts ...grpc.CallOption) (*IcebergOrderDetails, error) {
+	out := new(IcebergOrderDetails)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SubmitIcebergOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) CancelIcebergOrder(ctx context.Context, in *IcebergOrderRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/CancelIcebergOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetIcebergOrder(ctx context.Context, in *IcebergOrderRequest, opts ...grpc.CallOption) (*IcebergOrderDetails, error) {
+	out := new(IcebergOrderDetails)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetIcebergOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetIcebergOrders(ctx context.Context, in *GetIcebergOrdersRequest, opts ...grpc.CallOption) (*GetIcebergOrdersResponse, error) {
+	out := new(GetIcebergOrdersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetIcebergOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetRiskManagerStatus(ctx context.Context, in *GetRiskManagerStatusRequest, opts ...grpc.CallOption) (*GetRiskManagerStatusResponse, error) {
+	out := new(GetRiskManagerStatusResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetRiskManagerStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) SetKillSwitch(ctx context.Context, in *SetKillSwitchRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SetKillSwitch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetFuturesPositions(ctx context.Context, in *GetFuturesPositionsRequest, opts ...grpc.CallOption) (*GetFuturesPositionsResponse, error) {
+	out := new(GetFuturesPositionsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetFuturesPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) AddFuturesFundingPayment(ctx context.Context, in *AddFuturesFundingPaymentRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddFuturesFundingPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) SetFuturesContractType(ctx context.Context, in *SetFuturesContractTypeRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SetFuturesContractType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetEquityCurve(ctx context.Context, in *GetEquityCurveRequest, opts ...grpc.CallOption) (*GetEquityCurveResponse, error) {
+	out := new(GetEquityCurveResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetEquityCurve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetBalanceHistory(ctx context.Context, in *GetBalanceHistoryRequest, opts ...grpc.CallOption) (*GetBalanceHistoryResponse, error) {
+	out := new(GetBalanceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetBalanceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetDailyPNL(ctx context.Context, in *GetDailyPNLRequest, opts ...grpc.CallOption) (*GetDailyPNLResponse, error) {
+	out := new(GetDailyPNLResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetDailyPNL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetConsolidatedOrderbook(ctx context.Context, in *GetConsolidatedOrderbookRequest, opts ...grpc.CallOption) (*GetConsolidatedOrderbookResponse, error) {
+	out := new(GetConsolidatedOrderbookResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetConsolidatedOrderbook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) SimulateConsolidatedOrder(ctx context.Context, in *SimulateConsolidatedOrderRequest, opts ...grpc.CallOption) (*SimulateConsolidatedOrderResponse, error) {
+	out := new(SimulateConsolidatedOrderResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SimulateConsolidatedOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) ConsolidatedWhaleBomb(ctx context.Context, in *ConsolidatedWhaleBombRequest, opts ...grpc.CallOption) (*SimulateConsolidatedOrderResponse, error) {
+	out := new(SimulateConsolidatedOrderResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/ConsolidatedWhaleBomb", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GoCryptoTraderServer is the server API for GoCryptoTrader service.
 // All implementations must embed UnimplementedGoCryptoTraderServer
 // for forward compatibility
@@ -1204,6 +1504,36 @@ type GoCryptoTraderServer interface {
 	CurrencyStateDeposit(context.Context, *CurrencyStateDepositRequest) (*GenericResponse, error)
 	CurrencyStateWithdraw(context.Context, *CurrencyStateWithdrawRequest) (*GenericResponse, error)
 	CurrencyStateTradingPair(context.Context, *CurrencyStateTradingPairRequest) (*GenericResponse, error)
+	AddConditionalOrder(context.Context, *AddConditionalOrderRequest) (*ConditionalOrderDetails, error)
+	CancelConditionalOrder(context.Context, *CancelConditionalOrderRequest) (*GenericResponse, error)
+	GetConditionalOrders(context.Context, *GetConditionalOrdersRequest) (*GetConditionalOrdersResponse, error)
+	GetConditionalOrderHistory(context.Context, *GetConditionalOrderHistoryRequest) (*GetConditionalOrderHistoryResponse, error)
+	SubmitOCOOrder(context.Context, *SubmitOCOOrderRequest) (*OrderGroupDetails, error)
+	SubmitBracketOrder(context.Context, *SubmitBracketOrderRequest) (*OrderGroupDetails, error)
+	CancelOrderGroup(context.Context, *CancelOrderGroupRequest) (*GenericResponse, error)
+	GetOrderGroup(context.Context, *GetOrderGroupRequest) (*OrderGroupDetails, error)
+	GetOrderGroups(context.Context, *GetOrderGroupsRequest) (*GetOrderGroupsResponse, error)
+	SubmitExecutionOrder(context.Context, *SubmitExecutionOrderRequest) (*ExecutionOrderDetails, error)
+	PauseExecutionOrder(context.Context, *ExecutionOrderRequest) (*GenericResponse, error)
+	ResumeExecutionOrder(context.Context, *ExecutionOrderRequest) (*GenericResponse, error)
+	CancelExecutionOrder(context.Context, *ExecutionOrderRequest) (*GenericResponse, error)
+	GetExecutionOrder(context.Context, *ExecutionOrderRequest) (*ExecutionOrderDetails, error)
+	GetExecutionOrders(context.Context, *GetExecutionOrdersRequest) (*GetExecutionOrdersResponse, error)
+	SubmitIcebergOrder(context.Context, *SubmitIcebergOrderRequest) (*IcebergOrderDetails, error)
+	CancelIcebergOrder(context.Context, *IcebergOrderRequest) (*GenericResponse, error)
+	GetIcebergOrder(context.Context, *IcebergOrderRequest) (*IcebergOrderDetails, error)
+	GetIcebergOrders(context.Context, *GetIcebergOrdersRequest) (*GetIcebergOrdersResponse, error)
+	GetRiskManagerStatus(context.Context, *GetRiskManagerStatusRequest) (*GetRiskManagerStatusResponse, error)
+	SetKillSwitch(context.Context, *SetKillSwitchRequest) (*GenericResponse, error)
+	GetFuturesPositions(context.Context, *GetFuturesPositionsRequest) (*GetFuturesPositionsResponse, error)
+	AddFuturesFundingPayment(context.Context, *AddFuturesFundingPaymentRequest) (*GenericResponse, error)
+	SetFuturesContractType(context.Context, *SetFuturesContractTypeRequest) (*GenericResponse, error)
+	GetEquityCurve(context.Context, *GetEquityCurveRequest) (*GetEquityCurveResponse, error)
+	GetBalanceHistory(context.Context, *GetBalanceHistoryRequest) (*GetBalanceHistoryResponse, error)
+	GetDailyPNL(context.Context, *GetDailyPNLRequest) (*GetDailyPNLResponse, error)
+	GetConsolidatedOrderbook(context.Context, *GetConsolidatedOrderbookRequest) (*GetConsolidatedOrderbookResponse, error)
+	SimulateConsolidatedOrder(context.Context, *SimulateConsolidatedOrderRequest) (*SimulateConsolidatedOrderResponse, error)
+	ConsolidatedWhaleBomb(context.Context, *ConsolidatedWhaleBombRequest) (*SimulateConsolidatedOrderResponse, error)
 	mustEmbedUnimplementedGoCryptoTraderServer()
 }
 
@@ -1493,6 +1823,96 @@ func (UnimplementedGoCryptoTraderServer) CurrencyStateWithdraw(context.Context,
 func (UnimplementedGoCryptoTraderServer) CurrencyStateTradingPair(context.Context, *CurrencyStateTradingPairRequest) (*GenericResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrencyStateTradingPair not implemented")
 }
+func (UnimplementedGoCryptoTraderServer) AddConditionalOrder(context.Context, *AddConditionalOrderRequest) (*ConditionalOrderDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddConditionalOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) CancelConditionalOrder(context.Context, *CancelConditionalOrderRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelConditionalOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetConditionalOrders(context.Context, *GetConditionalOrdersRequest) (*GetConditionalOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConditionalOrders not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetConditionalOrderHistory(context.Context, *GetConditionalOrderHistoryRequest) (*GetConditionalOrderHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConditionalOrderHistory not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) SubmitOCOOrder(context.Context, *SubmitOCOOrderRequest) (*OrderGroupDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitOCOOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) SubmitBracketOrder(context.Context, *SubmitBracketOrderRequest) (*OrderGroupDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBracketOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) CancelOrderGroup(context.Context, *CancelOrderGroupRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrderGroup not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetOrderGroup(context.Context, *GetOrderGroupRequest) (*OrderGroupDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderGroup not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetOrderGroups(context.Context, *GetOrderGroupsRequest) (*GetOrderGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderGroups not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) SubmitExecutionOrder(context.Context, *SubmitExecutionOrderRequest) (*ExecutionOrderDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitExecutionOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) PauseExecutionOrder(context.Context, *ExecutionOrderRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseExecutionOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) ResumeExecutionOrder(context.Context, *ExecutionOrderRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeExecutionOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) CancelExecutionOrder(context.Context, *ExecutionOrderRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelExecutionOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetExecutionOrder(context.Context, *ExecutionOrderRequest) (*ExecutionOrderDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExecutionOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetExecutionOrders(context.Context, *GetExecutionOrdersRequest) (*GetExecutionOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExecutionOrders not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) SubmitIcebergOrder(context.Context, *SubmitIcebergOrderRequest) (*IcebergOrderDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitIcebergOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) CancelIcebergOrder(context.Context, *IcebergOrderRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelIcebergOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetIcebergOrder(context.Context, *IcebergOrderRequest) (*IcebergOrderDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIcebergOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetIcebergOrders(context.Context, *GetIcebergOrdersRequest) (*GetIcebergOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIcebergOrders not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetRiskManagerStatus(context.Context, *GetRiskManagerStatusRequest) (*GetRiskManagerStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRiskManagerStatus not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) SetKillSwitch(context.Context, *SetKillSwitchRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetKillSwitch not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetFuturesPositions(context.Context, *GetFuturesPositionsRequest) (*GetFuturesPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFuturesPositions not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) AddFuturesFundingPayment(context.Context, *AddFuturesFundingPaymentRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFuturesFundingPayment not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) SetFuturesContractType(context.Context, *SetFuturesContractTypeRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFuturesContractType not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetEquityCurve(context.Context, *GetEquityCurveRequest) (*GetEquityCurveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEquityCurve not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetBalanceHistory(context.Context, *GetBalanceHistoryRequest) (*GetBalanceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalanceHistory not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetDailyPNL(context.Context, *GetDailyPNLRequest) (*GetDailyPNLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDailyPNL not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetConsolidatedOrderbook(context.Context, *GetConsolidatedOrderbookRequest) (*GetConsolidatedOrderbookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsolidatedOrderbook not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) SimulateConsolidatedOrder(context.Context, *SimulateConsolidatedOrderRequest) (*SimulateConsolidatedOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateConsolidatedOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) ConsolidatedWhaleBomb(context.Context, *ConsolidatedWhaleBombRequest) (*SimulateConsolidatedOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsolidatedWhaleBomb not implemented")
+}
 func (UnimplementedGoCryptoTraderServer) mustEmbedUnimplementedGoCryptoTraderServer() {}
 
 // UnsafeGoCryptoTraderServer may be embedded to opt out of forward compatibility for this service.
@@ -3216,43 +3636,583 @@ func _GoCryptoTrader_CurrencyStateTradingPair_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
-// GoCryptoTrader_ServiceDesc is the grpc.ServiceDesc for GoCryptoTrader service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var GoCryptoTrader_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "gctrpc.GoCryptoTrader",
-	HandlerType: (*GoCryptoTraderServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetInfo",
-			Handler:    _GoCryptoTrader_GetInfo_Handler,
-		},
-		{
-			MethodName: "GetSubsystems",
-			Handler:    _GoCryptoTrader_GetSubsystems_Handler,
-		},
-		{
-			MethodName: "EnableSubsystem",
-			Handler:    _GoCryptoTrader_EnableSubsystem_Handler,
-		},
-		{
-			MethodName: "DisableSubsystem",
-			Handler:    _GoCryptoTrader_DisableSubsystem_Handler,
-		},
-		{
-			MethodName: "GetRPCEndpoints",
-			Handler:    _GoCryptoTrader_GetRPCEndpoints_Handler,
-		},
-		{
-			MethodName: "GetCommunicationRelayers",
-			Handler:    _GoCryptoTrader_GetCommunicationRelayers_Handler,
-		},
-		{
-			MethodName: "GetExchanges",
-			Handler:    _GoCryptoTrader_GetExchanges_Handler,
-		},
-		{
-			MethodName: "DisableExchange",
+func _GoCryptoTrader_AddConditionalOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddConditionalOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).AddConditionalOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/AddConditionalOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).AddConditionalOrder(ctx, req.(*AddConditionalOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_CancelConditionalOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelConditionalOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).CancelConditionalOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelConditionalOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).CancelConditionalOrder(ctx, req.(*CancelConditionalOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetConditionalOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConditionalOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetConditionalOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetConditionalOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetConditionalOrders(ctx, req.(*GetConditionalOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetConditionalOrderHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConditionalOrderHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetConditionalOrderHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetConditionalOrderHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetConditionalOrderHistory(ctx, req.(*GetConditionalOrderHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_SubmitOCOOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitOCOOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).SubmitOCOOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/SubmitOCOOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).SubmitOCOOrder(ctx, req.(*SubmitOCOOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_SubmitBracketOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitBracketOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).SubmitBracketOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/SubmitBracketOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).SubmitBracketOrder(ctx, req.(*SubmitBracketOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_CancelOrderGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).CancelOrderGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelOrderGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).CancelOrderGroup(ctx, req.(*CancelOrderGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetOrderGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetOrderGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetOrderGroup(ctx, req.(*GetOrderGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetOrderGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetOrderGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetOrderGroups(ctx, req.(*GetOrderGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_SubmitExecutionOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitExecutionOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).SubmitExecutionOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/SubmitExecutionOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).SubmitExecutionOrder(ctx, req.(*SubmitExecutionOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_PauseExecutionOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecutionOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).PauseExecutionOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/PauseExecutionOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).PauseExecutionOrder(ctx, req.(*ExecutionOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_ResumeExecutionOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecutionOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).ResumeExecutionOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/ResumeExecutionOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).ResumeExecutionOrder(ctx, req.(*ExecutionOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_CancelExecutionOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecutionOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).CancelExecutionOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelExecutionOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).CancelExecutionOrder(ctx, req.(*ExecutionOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetExecutionOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecutionOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetExecutionOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExecutionOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetExecutionOrder(ctx, req.(*ExecutionOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetExecutionOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExecutionOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetExecutionOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExecutionOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetExecutionOrders(ctx, req.(*GetExecutionOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_SubmitIcebergOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitIcebergOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).SubmitIcebergOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/SubmitIcebergOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).SubmitIcebergOrder(ctx, req.(*SubmitIcebergOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_CancelIcebergOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IcebergOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).CancelIcebergOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelIcebergOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).CancelIcebergOrder(ctx, req.(*IcebergOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetIcebergOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IcebergOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetIcebergOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetIcebergOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetIcebergOrder(ctx, req.(*IcebergOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetIcebergOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIcebergOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetIcebergOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetIcebergOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetIcebergOrders(ctx, req.(*GetIcebergOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetRiskManagerStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRiskManagerStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetRiskManagerStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetRiskManagerStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetRiskManagerStatus(ctx, req.(*GetRiskManagerStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_SetKillSwitch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetKillSwitchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).SetKillSwitch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/SetKillSwitch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).SetKillSwitch(ctx, req.(*SetKillSwitchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetFuturesPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFuturesPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetFuturesPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetFuturesPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetFuturesPositions(ctx, req.(*GetFuturesPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_AddFuturesFundingPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFuturesFundingPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).AddFuturesFundingPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/AddFuturesFundingPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).AddFuturesFundingPayment(ctx, req.(*AddFuturesFundingPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_SetFuturesContractType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFuturesContractTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).SetFuturesContractType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/SetFuturesContractType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).SetFuturesContractType(ctx, req.(*SetFuturesContractTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetEquityCurve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEquityCurveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetEquityCurve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetEquityCurve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetEquityCurve(ctx, req.(*GetEquityCurveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetBalanceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetBalanceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetBalanceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetBalanceHistory(ctx, req.(*GetBalanceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetDailyPNL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDailyPNLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetDailyPNL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetDailyPNL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetDailyPNL(ctx, req.(*GetDailyPNLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetConsolidatedOrderbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConsolidatedOrderbookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetConsolidatedOrderbook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetConsolidatedOrderbook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetConsolidatedOrderbook(ctx, req.(*GetConsolidatedOrderbookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_SimulateConsolidatedOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateConsolidatedOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).SimulateConsolidatedOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/SimulateConsolidatedOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).SimulateConsolidatedOrder(ctx, req.(*SimulateConsolidatedOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_ConsolidatedWhaleBomb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsolidatedWhaleBombRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).ConsolidatedWhaleBomb(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/ConsolidatedWhaleBomb",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).ConsolidatedWhaleBomb(ctx, req.(*ConsolidatedWhaleBombRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GoCryptoTrader_ServiceDesc is the grpc.ServiceDesc for GoCryptoTrader service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GoCryptoTrader_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gctrpc.GoCryptoTrader",
+	HandlerType: (*GoCryptoTraderServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetInfo",
+			Handler:    _GoCryptoTrader_GetInfo_Handler,
+		},
+		{
+			MethodName: "GetSubsystems",
+			Handler:    _GoCryptoTrader_GetSubsystems_Handler,
+		},
+		{
+			MethodName: "EnableSubsystem",
+			Handler:    _GoCryptoTrader_EnableSubsystem_Handler,
+		},
+		{
+			MethodName: "DisableSubsystem",
+			Handler:    _GoCryptoTrader_DisableSubsystem_Handler,
+		},
+		{
+			MethodName: "GetRPCEndpoints",
+			Handler:    _GoCryptoTrader_GetRPCEndpoints_Handler,
+		},
+		{
+			MethodName: "GetCommunicationRelayers",
+			Handler:    _GoCryptoTrader_GetCommunicationRelayers_Handler,
+		},
+		{
+			MethodName: "GetExchanges",
+			Handler:    _GoCryptoTrader_GetExchanges_Handler,
+		},
+		{
+			MethodName: "DisableExchange",
 			Handler:    _GoCryptoTrader_DisableExchange_Handler,
 		},
 		{
@@ -3575,6 +4535,126 @@ var GoCryptoTrader_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CurrencyStateTradingPair",
 			Handler:    _GoCryptoTrader_CurrencyStateTradingPair_Handler,
 		},
+		{
+			MethodName: "AddConditionalOrder",
+			Handler:    _GoCryptoTrader_AddConditionalOrder_Handler,
+		},
+		{
+			MethodName: "CancelConditionalOrder",
+			Handler:    _GoCryptoTrader_CancelConditionalOrder_Handler,
+		},
+		{
+			MethodName: "GetConditionalOrders",
+			Handler:    _GoCryptoTrader_GetConditionalOrders_Handler,
+		},
+		{
+			MethodName: "GetConditionalOrderHistory",
+			Handler:    _GoCryptoTrader_GetConditionalOrderHistory_Handler,
+		},
+		{
+			MethodName: "SubmitOCOOrder",
+			Handler:    _GoCryptoTrader_SubmitOCOOrder_Handler,
+		},
+		{
+			MethodName: "SubmitBracketOrder",
+			Handler:    _GoCryptoTrader_SubmitBracketOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrderGroup",
+			Handler:    _GoCryptoTrader_CancelOrderGroup_Handler,
+		},
+		{
+			MethodName: "GetOrderGroup",
+			Handler:    _GoCryptoTrader_GetOrderGroup_Handler,
+		},
+		{
+			MethodName: "GetOrderGroups",
+			Handler:    _GoCryptoTrader_GetOrderGroups_Handler,
+		},
+		{
+			MethodName: "SubmitExecutionOrder",
+			Handler:    _GoCryptoTrader_SubmitExecutionOrder_Handler,
+		},
+		{
+			MethodName: "PauseExecutionOrder",
+			Handler:    _GoCryptoTrader_PauseExecutionOrder_Handler,
+		},
+		{
+			MethodName: "ResumeExecutionOrder",
+			Handler:    _GoCryptoTrader_ResumeExecutionOrder_Handler,
+		},
+		{
+			MethodName: "CancelExecutionOrder",
+			Handler:    _GoCryptoTrader_CancelExecutionOrder_Handler,
+		},
+		{
+			MethodName: "GetExecutionOrder",
+			Handler:    _GoCryptoTrader_GetExecutionOrder_Handler,
+		},
+		{
+			MethodName: "GetExecutionOrders",
+			Handler:    _GoCryptoTrader_GetExecutionOrders_Handler,
+		},
+		{
+			MethodName: "SubmitIcebergOrder",
+			Handler:    _GoCryptoTrader_SubmitIcebergOrder_Handler,
+		},
+		{
+			MethodName: "CancelIcebergOrder",
+			Handler:    _GoCryptoTrader_CancelIcebergOrder_Handler,
+		},
+		{
+			MethodName: "GetIcebergOrder",
+			Handler:    _GoCryptoTrader_GetIcebergOrder_Handler,
+		},
+		{
+			MethodName: "GetIcebergOrders",
+			Handler:    _GoCryptoTrader_GetIcebergOrders_Handler,
+		},
+		{
+			MethodName: "GetRiskManagerStatus",
+			Handler:    _GoCryptoTrader_GetRiskManagerStatus_Handler,
+		},
+		{
+			MethodName: "SetKillSwitch",
+			Handler:    _GoCryptoTrader_SetKillSwitch_Handler,
+		},
+		{
+			MethodName: "GetFuturesPositions",
+			Handler:    _GoCryptoTrader_GetFuturesPositions_Handler,
+		},
+		{
+			MethodName: "AddFuturesFundingPayment",
+			Handler:    _GoCryptoTrader_AddFuturesFundingPayment_Handler,
+		},
+		{
+			MethodName: "SetFuturesContractType",
+			Handler:    _GoCryptoTrader_SetFuturesContractType_Handler,
+		},
+		{
+			MethodName: "GetEquityCurve",
+			Handler:    _GoCryptoTrader_GetEquityCurve_Handler,
+		},
+		{
+			MethodName: "GetBalanceHistory",
+			Handler:    _GoCryptoTrader_GetBalanceHistory_Handler,
+		},
+		{
+			MethodName: "GetDailyPNL",
+			Handler:    _GoCryptoTrader_GetDailyPNL_Handler,
+		},
+		{
+			MethodName: "GetConsolidatedOrderbook",
+			Handler:    _GoCryptoTrader_GetConsolidatedOrderbook_Handler,
+		},
+		{
+			MethodName: "SimulateConsolidatedOrder",
+			Handler:    _GoCryptoTrader_SimulateConsolidatedOrder_Handler,
+		},
+		{
+			MethodName: "ConsolidatedWhaleBomb",
+			Handler:    _GoCryptoTrader_ConsolidatedWhaleBomb_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -36,6 +36,7 @@ func main() {
 	flag.BoolVar(&settings.EnableAllPairs, "enableallpairs", false, "enables all pairs for enabled exchanges")
 	flag.BoolVar(&settings.EnablePortfolioManager, "portfoliomanager", true, "enables the portfolio manager")
 	flag.BoolVar(&settings.EnableDataHistoryManager, "datahistorymanager", false, "enables the data history manager")
+	flag.BoolVar(&settings.EnableBalanceHistoryManager, "balancehistorymanager", false, "enables recording of account balances and balance changes to the database")
 	flag.DurationVar(&settings.PortfolioManagerDelay, "portfoliomanagerdelay", time.Duration(0), "sets the portfolio managers sleep delay between updates")
 	flag.BoolVar(&settings.EnableGRPC, "grpc", true, "enables the grpc server")
 	flag.BoolVar(&settings.EnableGRPCProxy, "grpcproxy", false, "enables the grpc proxy server")
@@ -56,6 +57,12 @@ func main() {
 	flag.BoolVar(&settings.EnableNTPClient, "ntpclient", true, "enables the NTP client to check system clock drift")
 	flag.BoolVar(&settings.EnableDispatcher, "dispatch", true, "enables the dispatch system")
 	flag.BoolVar(&settings.EnableCurrencyStateManager, "currencystatemanager", true, "enables the currency state manager")
+	flag.BoolVar(&settings.EnableConditionalOrderManager, "conditionalordermanager", true, "enables the conditional order manager for engine held stop-loss, take-profit and trailing stop orders")
+	flag.BoolVar(&settings.EnableExecutionManager, "executionmanager", true, "enables the execution manager for TWAP and VWAP scheduled parent orders")
+	flag.BoolVar(&settings.EnableRiskManager, "riskmanager", false, "enables the risk manager for pre-trade order checks and the kill switch, limits are set in the config")
+	flag.BoolVar(&settings.EnableMarketDataRecorder, "marketdatarecorder", false, "enables recording of websocket market, order and account events to compressed files per exchange and day")
+	flag.BoolVar(&settings.EnableMarketDataReplayer, "marketdatareplayer", false, "enables replaying of recorded market data events, the time range and speed are set in the config")
+	flag.BoolVar(&settings.EnableConsolidatedOrderbookManager, "consolidatedorderbookmanager", false, "enables merging of exchange orderbooks into consolidated cross exchange orderbooks, the pairs are set in the config")
 	flag.IntVar(&settings.DispatchMaxWorkerAmount, "dispatchworkers", dispatch.DefaultMaxWorkers, "sets the dispatch package max worker generation limit")
 	flag.IntVar(&settings.DispatchJobsLimit, "dispatchjobslimit", dispatch.DefaultJobsLimit, "sets the dispatch package max jobs limit")
 